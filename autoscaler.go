@@ -0,0 +1,140 @@
+package work
+
+import (
+	"time"
+)
+
+const (
+	defaultAutoscaleInterval = 10 * time.Second
+
+	// How many samples in a row have to be under half the target latency before the autoscaler scales down, so that
+	// a brief lull in a bursty workload doesn't undo the workers it needed.
+	autoscaleDownSamples = 3
+)
+
+// AutoscaleOptions configures WorkerPool.Autoscale.
+type AutoscaleOptions struct {
+	MinConcurrency uint // Fewest workers to run
+	MaxConcurrency uint // Most workers to run
+
+	// TargetLatency is how long jobs should wait in the pool's queues at most. The pool scales up while the oldest job
+	// in any of its queues has waited longer than this, and down once they've all waited less than half of it for a
+	// few samples in a row.
+	TargetLatency time.Duration
+
+	Interval time.Duration // How often to check the queues; defaults to 10 seconds
+	Step     uint          // How many workers to add or remove at a time; defaults to 1
+}
+
+type autoscaler struct {
+	opts   AutoscaleOptions
+	wp     *WorkerPool
+	client *Client
+	logger Logger
+
+	// samples under half the target latency in a row
+	quietSamples int
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+func newAutoscaler(wp *WorkerPool, opts AutoscaleOptions) *autoscaler {
+	if opts.Interval <= 0 {
+		opts.Interval = defaultAutoscaleInterval
+	}
+	if opts.Step == 0 {
+		opts.Step = 1
+	}
+	if opts.MaxConcurrency < opts.MinConcurrency {
+		opts.MaxConcurrency = opts.MinConcurrency
+	}
+
+	return &autoscaler{
+		opts:   opts,
+		wp:     wp,
+		client: NewClient(wp.namespace, wp.pool).SetLogger(wp.logger),
+		logger: wp.logger,
+
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}
+}
+
+func (a *autoscaler) start() {
+	go a.loop()
+}
+
+func (a *autoscaler) stop() {
+	a.stopChan <- struct{}{}
+	<-a.doneStoppingChan
+}
+
+func (a *autoscaler) loop() {
+	ticker := time.NewTicker(a.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.stopChan:
+			a.doneStoppingChan <- struct{}{}
+			return
+		case <-ticker.C:
+			latency, err := a.latency()
+			if err != nil {
+				logError(a.logger, "autoscaler.latency", err)
+				continue
+			}
+			current := a.wp.currentConcurrency()
+			if next := a.next(current, latency); next != current {
+				a.wp.SetConcurrency(next)
+			}
+		}
+	}
+}
+
+// latency returns the longest any job has waited in the pool's queues.
+func (a *autoscaler) latency() (time.Duration, error) {
+	queues, err := a.client.Queues()
+	if err != nil {
+		return 0, err
+	}
+
+	var latency int64
+	for _, q := range queues {
+		if _, ok := a.wp.jobTypes[q.JobName]; ok && q.Latency > latency {
+			latency = q.Latency
+		}
+	}
+	return time.Duration(latency) * time.Second, nil
+}
+
+// next returns the concurrency the pool should have, given its current concurrency and the latency of its queues.
+func (a *autoscaler) next(current uint, latency time.Duration) uint {
+	next := current
+	switch {
+	case latency > a.opts.TargetLatency:
+		a.quietSamples = 0
+		next = current + a.opts.Step
+	case latency < a.opts.TargetLatency/2:
+		a.quietSamples++
+		if a.quietSamples >= autoscaleDownSamples {
+			a.quietSamples = 0
+			if current > a.opts.Step {
+				next = current - a.opts.Step
+			} else {
+				next = 0
+			}
+		}
+	default:
+		a.quietSamples = 0
+	}
+
+	if next > a.opts.MaxConcurrency {
+		next = a.opts.MaxConcurrency
+	}
+	if next < a.opts.MinConcurrency {
+		next = a.opts.MinConcurrency
+	}
+	return next
+}
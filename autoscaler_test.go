@@ -0,0 +1,66 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoscalerNext(t *testing.T) {
+	a := &autoscaler{opts: AutoscaleOptions{MinConcurrency: 2, MaxConcurrency: 6, TargetLatency: 10 * time.Second, Step: 2}}
+
+	// Scales up straight away, up to the max.
+	assert.EqualValues(t, 4, a.next(2, 11*time.Second))
+	assert.EqualValues(t, 6, a.next(4, time.Minute))
+	assert.EqualValues(t, 6, a.next(6, time.Minute))
+
+	// Holds between half the target and the target.
+	assert.EqualValues(t, 6, a.next(6, 7*time.Second))
+
+	// Scales down after a few quiet samples in a row, down to the min.
+	assert.EqualValues(t, 6, a.next(6, 0))
+	assert.EqualValues(t, 6, a.next(6, 0))
+	assert.EqualValues(t, 4, a.next(6, 0))
+	assert.EqualValues(t, 4, a.next(4, 0))
+	assert.EqualValues(t, 4, a.next(4, 6*time.Second)) // resets the quiet samples
+	assert.EqualValues(t, 4, a.next(4, 0))
+	assert.EqualValues(t, 4, a.next(4, 0))
+	assert.EqualValues(t, 2, a.next(4, 0))
+	for i := 0; i < autoscaleDownSamples; i++ {
+		assert.EqualValues(t, 2, a.next(2, 0))
+	}
+
+	// Starts within the bounds.
+	assert.EqualValues(t, 2, a.next(1, 7*time.Second))
+	assert.EqualValues(t, 6, a.next(10, 7*time.Second))
+}
+
+func TestWorkerPoolAutoscale(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+
+	// Make the queue look backed up.
+	setNowEpochSecondsMock(1425263409)
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, nil)
+	assert.NoError(t, err)
+	resetNowEpochSecondsMock()
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job(job1, func(job *Job) error {
+		return nil
+	})
+	wp.Pause()
+	wp.Autoscale(AutoscaleOptions{MinConcurrency: 1, MaxConcurrency: 3, TargetLatency: time.Second, Interval: 10 * time.Millisecond})
+	wp.Start()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for wp.currentConcurrency() < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	wp.Stop()
+	assert.EqualValues(t, 3, wp.currentConcurrency())
+	assert.Nil(t, wp.autoscaler)
+}
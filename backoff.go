@@ -0,0 +1,51 @@
+package work
+
+import (
+	"math/rand"
+	"time"
+)
+
+// ExponentialBackoff returns a BackoffCalculator that waits a random time between 0 and base*2^(fails-1), capped at
+// max, before each retry. Spreading retries out at random ("full jitter") stops jobs that failed together, eg, during
+// an outage of a service they call, from all being retried at the same moment once it recovers.
+// Example: JobOptions{Backoff: work.ExponentialBackoff(10*time.Second, time.Hour)}
+func ExponentialBackoff(base, max time.Duration) BackoffCalculator {
+	if base <= 0 || max < base {
+		panic("work: ExponentialBackoff needs 0 < base <= max")
+	}
+
+	return func(job *Job) int64 {
+		ceiling := base
+		for i := int64(1); i < job.Fails && ceiling <= max/2; i++ {
+			ceiling *= 2
+		}
+		if ceiling > max {
+			ceiling = max
+		}
+		return backoffSeconds(time.Duration(rand.Int63n(int64(ceiling) + 1)))
+	}
+}
+
+// LinearBackoff returns a BackoffCalculator that waits step longer before each retry: step after the first failure,
+// 2*step after the second, and so on.
+func LinearBackoff(step time.Duration) BackoffCalculator {
+	return func(job *Job) int64 {
+		return backoffSeconds(step * time.Duration(job.Fails))
+	}
+}
+
+// FixedBackoff returns a BackoffCalculator that always waits d before retrying.
+func FixedBackoff(d time.Duration) BackoffCalculator {
+	return func(job *Job) int64 {
+		return backoffSeconds(d)
+	}
+}
+
+// backoffSeconds converts d to whole seconds, rounding up, as retries are scheduled to the second.
+func backoffSeconds(d time.Duration) int64 {
+	secs := int64((d + time.Second - 1) / time.Second)
+	if secs < 1 {
+		return 1
+	}
+	return secs
+}
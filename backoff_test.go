@@ -0,0 +1,69 @@
+package work
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffExponential(t *testing.T) {
+	backoff := ExponentialBackoff(10*time.Second, time.Minute)
+
+	maxSeen := map[int64]int64{}
+	for i := 0; i < 1000; i++ {
+		for fails := int64(1); fails <= 10; fails++ {
+			secs := backoff(&Job{Fails: fails})
+			assert.True(t, secs >= 1)
+			if secs > maxSeen[fails] {
+				maxSeen[fails] = secs
+			}
+		}
+	}
+
+	assert.True(t, maxSeen[1] <= 10)
+	assert.True(t, maxSeen[2] <= 20)
+	assert.True(t, maxSeen[3] <= 40)
+	assert.True(t, maxSeen[3] > 20) // jitter covers the whole range
+	for fails := int64(4); fails <= 10; fails++ {
+		assert.True(t, maxSeen[fails] <= 60)
+	}
+
+	// Huge failure counts don't overflow.
+	secs := ExponentialBackoff(time.Second, 1<<62)(&Job{Fails: 200})
+	assert.True(t, secs >= 1)
+
+	assert.Panics(t, func() { ExponentialBackoff(0, time.Minute) })
+	assert.Panics(t, func() { ExponentialBackoff(time.Minute, time.Second) })
+}
+
+func TestBackoffLinearAndFixed(t *testing.T) {
+	linear := LinearBackoff(30 * time.Second)
+	assert.EqualValues(t, 30, linear(&Job{Fails: 1}))
+	assert.EqualValues(t, 90, linear(&Job{Fails: 3}))
+
+	fixed := FixedBackoff(1500 * time.Millisecond)
+	assert.EqualValues(t, 2, fixed(&Job{Fails: 1}))
+	assert.EqualValues(t, 2, fixed(&Job{Fails: 7}))
+
+	assert.EqualValues(t, 1, FixedBackoff(0)(&Job{Fails: 1}))
+}
+
+func TestBackoffRetryIn(t *testing.T) {
+	jt := &jobType{JobOptions: JobOptions{Backoff: FixedBackoff(time.Hour)}}
+	job := &Job{Fails: 1}
+
+	assert.EqualValues(t, 3600, jt.calcBackoff(job, fmt.Errorf("sorry kid")))
+	assert.EqualValues(t, 90, jt.calcBackoff(job, RetryIn(90*time.Second, fmt.Errorf("rate limited"))))
+	assert.EqualValues(t, 90, jt.calcBackoff(job, fmt.Errorf("calling api: %w", RetryIn(90*time.Second, nil))))
+
+	errUnavailable := fmt.Errorf("unavailable")
+	err := RetryIn(time.Minute, errUnavailable)
+	assert.EqualError(t, err, "unavailable")
+	assert.True(t, errors.Is(err, errUnavailable))
+	assert.EqualError(t, RetryIn(time.Minute, nil), "retry in 1m0s")
+	// It can still be combined with ErrNoRetry, which wins.
+	assert.True(t, errors.Is(RetryIn(time.Minute, ErrNoRetry), ErrNoRetry))
+}
@@ -0,0 +1,90 @@
+package work
+
+import (
+	"encoding/json"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// JobChain is a sequence of jobs that are run one after the other. Each step is only enqueued once the previous one
+// has succeeded, so a step that fails for the last time stops the chain. Make one with Chain and enqueue it with
+// Enqueuer.EnqueueChain.
+type JobChain struct {
+	steps []ChainStep
+}
+
+// ChainStep is a job that's yet to be enqueued as part of a chain.
+type ChainStep struct {
+	Name string                 `json:"name"`
+	Args map[string]interface{} `json:"args,omitempty"`
+}
+
+// Chain starts a chain of jobs whose first step is jobName with args.
+// Example: work.Chain("resize", work.Q{"image_id": 4}).Then("upload", nil).Then("notify", work.Q{"user_id": 2})
+func Chain(jobName string, args map[string]interface{}) *JobChain {
+	return &JobChain{steps: []ChainStep{{Name: jobName, Args: args}}}
+}
+
+// Then adds a step that's enqueued after the previous step succeeds. If the previous step sets a result with
+// Job.SetResult that is a JSON object, its fields are added to args; args takes precedence if a field is in both.
+func (c *JobChain) Then(jobName string, args map[string]interface{}) *JobChain {
+	c.steps = append(c.steps, ChainStep{Name: jobName, Args: args})
+	return c
+}
+
+// nextChainJob returns the job for the step following job in its chain, or nil if job is the last step.
+func nextChainJob(job *Job) *Job {
+	if len(job.Chain) == 0 {
+		return nil
+	}
+	step := job.Chain[0]
+
+	args := make(map[string]interface{})
+	if len(job.result) > 0 {
+		var output map[string]interface{}
+		if err := json.Unmarshal(job.result, &output); err == nil {
+			for k, v := range output {
+				args[k] = v
+			}
+		}
+	}
+	for k, v := range step.Args {
+		args[k] = v
+	}
+
+	next := &Job{
+		Name:          step.Name,
+		ID:            makeIdentifier(),
+		EnqueuedAt:    nowEpochSeconds(),
+		Args:          args,
+		codec:         job.codec,
+		compressAbove: job.compressAbove,
+	}
+	if len(job.Chain) > 1 {
+		next.Chain = job.Chain[1:]
+	}
+	return next
+}
+
+func terminateAndEnqueueNext(w *worker, job *Job, fate terminateOp) terminateOp {
+	next := nextChainJob(job)
+	if next == nil {
+		return fate
+	}
+	if jt := w.jobTypes[next.Name]; jt != nil {
+		next.partitionArg = jt.PartitionKey
+	}
+	rawJSON, err := next.serialize()
+	if err != nil {
+		logError(w.logger, "worker.terminate_and_enqueue_next.serialize", err)
+		return fate
+	}
+	return func(conn redis.Conn) {
+		fate(conn)
+		conn.Send("LPUSH", redisKeyJobs(w.namespace, next.Name), rawJSON)
+		conn.Send("LPUSH", redisKeyJobsNotify(w.namespace, next.Name), 1)
+		conn.Send("LTRIM", redisKeyJobsNotify(w.namespace, next.Name), 0, maxJobNotifications-1)
+		conn.Send("PUBLISH", redisChannelJobsWake(w.namespace, next.Name), 1)
+		conn.Send("SADD", redisKeyKnownJobs(w.namespace), next.Name)
+	}
+}
@@ -1,10 +1,13 @@
 package work
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
@@ -13,24 +16,134 @@ import (
 // no object was actually deleted by those commmands.
 var ErrNotDeleted = fmt.Errorf("nothing deleted")
 
+// ErrWorkerPoolNotFound is returned by Client.WorkerPoolStatus when the worker pool doesn't have a heartbeat.
+var ErrWorkerPoolNotFound = fmt.Errorf("worker pool not found")
+
+// ErrJobNotFound is returned by Client.DeadJob when there's no such dead job, and by Client.RescheduleJob when there's
+// no such scheduled job.
+var ErrJobNotFound = fmt.Errorf("job not found")
+
 // ErrNotRetried is returned by functions that retry jobs to indicate that although the redis commands were successful,
 // no object was actually retried by those commmands.
 var ErrNotRetried = fmt.Errorf("nothing retried")
 
+// ErrNotEnqueued is returned by Client.RunScheduledJobNow to indicate that although the redis commands were successful,
+// the job wasn't enqueued, because it's no longer scheduled or no worker pool knows its name.
+var ErrNotEnqueued = fmt.Errorf("nothing enqueued")
+
 // Client implements all of the functionality of the web UI. It can be used to inspect the status of a running cluster and retry dead jobs.
 type Client struct {
 	namespace string
-	pool      *redis.Pool
+	pool      Pool
+	logger    Logger
+
+	staleAfter time.Duration // see SetStalePoolThreshold; 0 means the reaper's default
+	hideStale  bool
+	unredacted bool // see Unredacted
 }
 
 // NewClient creates a new Client with the specified redis namespace and connection pool.
-func NewClient(namespace string, pool *redis.Pool) *Client {
+func NewClient(namespace string, pool Pool) *Client {
 	return &Client{
 		namespace: namespace,
 		pool:      pool,
 	}
 }
 
+// SetLogger sets the Logger that errors are logged to, in addition to being returned.
+func (c *Client) SetLogger(logger Logger) *Client {
+	c.logger = logger
+	return c
+}
+
+// SetStalePoolThreshold sets how long since its last heartbeat a worker pool is flagged as stale, ie, probably dead
+// but not yet reaped (default is 10 seconds, twice the default WorkerPoolOptions.HeartbeatPeriod, as per the reaper).
+func (c *Client) SetStalePoolThreshold(threshold time.Duration) *Client {
+	c.staleAfter = threshold
+	return c
+}
+
+// HideStalePools leaves stale worker pools out of WorkerPoolHeartbeats, see SetStalePoolThreshold. StalePools still
+// returns them.
+func (c *Client) HideStalePools(hide bool) *Client {
+	c.hideStale = hide
+	return c
+}
+
+// Unredacted returns a copy of c whose methods return jobs with their arguments as they're stored, rather than redacted
+// as per RedactArgs, eg, for tests that check the arguments jobs were enqueued with. Don't show its jobs to people.
+func (c *Client) Unredacted() *Client {
+	c2 := *c
+	c2.unredacted = true
+	return &c2
+}
+
+// redacted returns job with its arguments redacted as per RedactArgs, unless c is Unredacted.
+func (c *Client) redacted(job *Job) *Job {
+	if c.unredacted {
+		return job
+	}
+	return job.redacted()
+}
+
+// WithContext returns a copy of c whose calls give up once ctx is done, returning its error, eg, so that a handler
+// doesn't outlive its request's deadline when Redis is slow.
+// Example: jobs, count, err := client.WithContext(r.Context()).DeadJobs(1)
+func (c *Client) WithContext(ctx context.Context) *Client {
+	pool := c.pool
+	if p, ok := pool.(*contextPool); ok {
+		pool = p.Pool
+	}
+	c2 := *c
+	c2.pool = &contextPool{Pool: pool, ctx: ctx}
+	return &c2
+}
+
+// Namespaces returns the namespaces that worker pools have run in, found by scanning Redis for their known jobs. With
+// Redis Cluster only the node that pool connects to is scanned.
+func Namespaces(pool Pool) ([]string, error) {
+	return NamespacesContext(context.Background(), pool)
+}
+
+// NamespacesContext is like Namespaces, but gives up once ctx is done, returning its error.
+func NamespacesContext(ctx context.Context, pool Pool) ([]string, error) {
+	conn := getConn(ctx, pool)
+	defer conn.Close()
+	return namespaces(conn)
+}
+
+func namespaces(conn redis.Conn) ([]string, error) {
+
+	suffix := redisKeyKnownJobs(":")
+	var namespaces []string
+	cursor := "0"
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", "*"+suffix, "COUNT", 1000))
+		if err != nil {
+			logError(nil, "namespaces.scan", err)
+			return nil, err
+		}
+
+		var keys []string
+		if _, err := redis.Scan(values, &cursor, &keys); err != nil {
+			logError(nil, "namespaces.scan", err)
+			return nil, err
+		}
+		for _, key := range keys {
+			if ns := strings.TrimSuffix(key, suffix); ns != "" {
+				namespaces = append(namespaces, ns)
+			}
+		}
+
+		if cursor == "0" {
+			break
+		}
+	}
+
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
+
 // WorkerPoolHeartbeat represents the heartbeat from a worker pool. WorkerPool's write a heartbeat every 5 seconds so we know they're alive and includes config information.
 type WorkerPoolHeartbeat struct {
 	WorkerPoolID string   `json:"worker_pool_id"`
@@ -41,10 +154,73 @@ type WorkerPoolHeartbeat struct {
 	Host         string   `json:"host"`
 	Pid          int      `json:"pid"`
 	WorkerIDs    []string `json:"worker_ids"`
+
+	PeriodicJobs []*PeriodicJob `json:"periodic_jobs,omitempty"`
+
+	// Stale is set if the pool hasn't sent a heartbeat within the client's stale pool threshold, so it's probably dead
+	// and waiting to be reaped. See Client.SetStalePoolThreshold.
+	Stale bool `json:"stale,omitempty"`
+
+	// Version and Labels are set by the pool's WorkerPoolOptions, eg, to tie it to a deployment.
+	Version string            `json:"version,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+}
+
+// PeriodicJob represents a job a worker pool enqueues periodically, as set up with WorkerPool.PeriodicallyEnqueue.
+type PeriodicJob struct {
+	Name     string                 `json:"name"`
+	Spec     string                 `json:"spec"`
+	Location string                 `json:"location,omitempty"` // Time zone the spec is in, if it's not the pool's local time zone
+	Args     map[string]interface{} `json:"args,omitempty"`
+
+	// LastEnqueuedAt is when a worker pool last scheduled the job's upcoming runs, and NextDueAt is when the next of them
+	// is due, both in epoch seconds. If NextDueAt is in the past, runs are being missed, eg, because no worker pool is
+	// scheduling them. Both are 0 if the job's runs haven't been scheduled yet.
+	LastEnqueuedAt int64 `json:"last_enqueued_at"`
+	NextDueAt      int64 `json:"next_due_at"`
 }
 
 // WorkerPoolHeartbeats queries Redis and returns all WorkerPoolHeartbeat's it finds (even for those worker pools which don't have a current heartbeat).
+// Pools whose heartbeat is out of date are flagged as Stale, or left out if the client is set to HideStalePools.
 func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
+	heartbeats, err := c.workerPoolHeartbeats()
+	if err != nil || !c.hideStale {
+		return heartbeats, err
+	}
+	return filterHeartbeats(heartbeats, false), nil
+}
+
+// StalePools returns the heartbeats of the worker pools that haven't sent one within the client's stale pool threshold,
+// see SetStalePoolThreshold. They're probably dead, and are cleaned up when the reaper next runs.
+func (c *Client) StalePools() ([]*WorkerPoolHeartbeat, error) {
+	heartbeats, err := c.workerPoolHeartbeats()
+	if err != nil {
+		return nil, err
+	}
+	return filterHeartbeats(heartbeats, true), nil
+}
+
+// filterHeartbeats returns the heartbeats whose Stale flag is stale.
+func filterHeartbeats(heartbeats []*WorkerPoolHeartbeat, stale bool) []*WorkerPoolHeartbeat {
+	filtered := make([]*WorkerPoolHeartbeat, 0, len(heartbeats))
+	for _, heartbeat := range heartbeats {
+		if heartbeat.Stale == stale {
+			filtered = append(filtered, heartbeat)
+		}
+	}
+	return filtered
+}
+
+// flagStale sets heartbeat.Stale if the pool's last heartbeat is older than the client's stale pool threshold.
+func (c *Client) flagStale(heartbeat *WorkerPoolHeartbeat) {
+	threshold := c.staleAfter
+	if threshold <= 0 {
+		threshold = deadTime
+	}
+	heartbeat.Stale = heartbeat.HeartbeatAt+int64(threshold/time.Second) <= nowEpochSeconds()
+}
+
+func (c *Client) workerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 	conn := c.pool.Get()
 	defer conn.Close()
 
@@ -62,7 +238,7 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("worker_pool_statuses.flush", err)
+		logError(c.logger, "worker_pool_statuses.flush", err)
 		return nil, err
 	}
 
@@ -71,50 +247,187 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 	for _, wpid := range workerPoolIDs {
 		vals, err := redis.Strings(conn.Receive())
 		if err != nil {
-			logError("worker_pool_statuses.receive", err)
+			logError(c.logger, "worker_pool_statuses.receive", err)
 			return nil, err
 		}
 
-		heartbeat := &WorkerPoolHeartbeat{
-			WorkerPoolID: wpid,
+		heartbeat, err := parseHeartbeat(wpid, vals)
+		if err != nil {
+			logError(c.logger, "worker_pool_statuses.parse", err)
+			return nil, err
 		}
+		c.flagStale(heartbeat)
 
-		for i := 0; i < len(vals)-1; i += 2 {
-			key := vals[i]
-			value := vals[i+1]
+		heartbeats = append(heartbeats, heartbeat)
+	}
 
-			var err error
-			if key == "heartbeat_at" {
-				heartbeat.HeartbeatAt, err = strconv.ParseInt(value, 10, 64)
-			} else if key == "started_at" {
-				heartbeat.StartedAt, err = strconv.ParseInt(value, 10, 64)
-			} else if key == "job_names" {
-				heartbeat.JobNames = strings.Split(value, ",")
-				sort.Strings(heartbeat.JobNames)
-			} else if key == "concurrency" {
-				var vv uint64
-				vv, err = strconv.ParseUint(value, 10, 0)
-				heartbeat.Concurrency = uint(vv)
-			} else if key == "host" {
-				heartbeat.Host = value
-			} else if key == "pid" {
-				var vv int64
-				vv, err = strconv.ParseInt(value, 10, 0)
-				heartbeat.Pid = int(vv)
-			} else if key == "worker_ids" {
-				heartbeat.WorkerIDs = strings.Split(value, ",")
-				sort.Strings(heartbeat.WorkerIDs)
-			}
+	return heartbeats, nil
+}
+
+// PeriodicJobs returns the periodic jobs of the worker pools with a heartbeat, sorted by name and then spec. A job that's
+// set up the same way by several pools is only returned once.
+func (c *Client) PeriodicJobs() ([]*PeriodicJob, error) {
+	heartbeats, err := c.WorkerPoolHeartbeats()
+	if err != nil {
+		logError(c.logger, "client.periodic_jobs.heartbeats", err)
+		return nil, err
+	}
+
+	byID := map[string]*PeriodicJob{}
+	for _, heartbeat := range heartbeats {
+		for _, pj := range heartbeat.PeriodicJobs {
+			rawArgs, err := encodePeriodicArgs(pj.Args)
 			if err != nil {
-				logError("worker_pool_statuses.parse", err)
+				logError(c.logger, "client.periodic_jobs.args", err)
 				return nil, err
 			}
+			byID[makePeriodicID(pj.Name, pj.Spec, pj.Location, rawArgs)] = pj
 		}
+	}
 
-		heartbeats = append(heartbeats, heartbeat)
+	ids := make([]string, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
 	}
+	sort.Strings(ids)
 
-	return heartbeats, nil
+	periodicJobs := make([]*PeriodicJob, 0, len(ids))
+	for _, id := range ids {
+		periodicJobs = append(periodicJobs, byID[id])
+	}
+	if len(ids) == 0 {
+		return periodicJobs, nil
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	args := redis.Args{redisKeyPeriodicJobRuns(c.namespace)}.AddFlat(ids)
+	values, err := redis.ByteSlices(conn.Do("HMGET", args...))
+	if err != nil {
+		logError(c.logger, "client.periodic_jobs.hmget", err)
+		return nil, err
+	}
+	for i, rawJSON := range values {
+		if rawJSON == nil {
+			continue
+		}
+		var pjr periodicJobRuns
+		if err := json.Unmarshal(rawJSON, &pjr); err != nil {
+			logError(c.logger, "client.periodic_jobs.unmarshal", err)
+			return nil, err
+		}
+		periodicJobs[i].LastEnqueuedAt = pjr.LastEnqueuedAt
+		periodicJobs[i].NextDueAt = pjr.NextDueAt
+	}
+
+	return periodicJobs, nil
+}
+
+// ReaperEvents returns the most recent times a worker pool cleaned up after a dead one, newest first. Up to 1000 are
+// kept.
+func (c *Client) ReaperEvents() ([]*ReaperEvent, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	values, err := redis.ByteSlices(conn.Do("LRANGE", redisKeyReaperEvents(c.namespace), 0, -1))
+	if err != nil {
+		logError(c.logger, "client.reaper_events.lrange", err)
+		return nil, err
+	}
+
+	events := make([]*ReaperEvent, 0, len(values))
+	for _, rawJSON := range values {
+		var event ReaperEvent
+		if err := json.Unmarshal(rawJSON, &event); err != nil {
+			logError(c.logger, "client.reaper_events.unmarshal", err)
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// parseHeartbeat parses the heartbeat hash of the worker pool with ID wpid, as returned by HGETALL.
+func parseHeartbeat(wpid string, vals []string) (*WorkerPoolHeartbeat, error) {
+	heartbeat := &WorkerPoolHeartbeat{
+		WorkerPoolID: wpid,
+	}
+
+	for i := 0; i < len(vals)-1; i += 2 {
+		key := vals[i]
+		value := vals[i+1]
+
+		var err error
+		if key == "heartbeat_at" {
+			heartbeat.HeartbeatAt, err = strconv.ParseInt(value, 10, 64)
+		} else if key == "started_at" {
+			heartbeat.StartedAt, err = strconv.ParseInt(value, 10, 64)
+		} else if key == "job_names" {
+			heartbeat.JobNames = strings.Split(value, ",")
+			sort.Strings(heartbeat.JobNames)
+		} else if key == "concurrency" {
+			var vv uint64
+			vv, err = strconv.ParseUint(value, 10, 0)
+			heartbeat.Concurrency = uint(vv)
+		} else if key == "host" {
+			heartbeat.Host = value
+		} else if key == "pid" {
+			var vv int64
+			vv, err = strconv.ParseInt(value, 10, 0)
+			heartbeat.Pid = int(vv)
+		} else if key == "worker_ids" {
+			heartbeat.WorkerIDs = strings.Split(value, ",")
+			sort.Strings(heartbeat.WorkerIDs)
+		} else if key == "periodic_jobs" && value != "" {
+			err = json.Unmarshal([]byte(value), &heartbeat.PeriodicJobs)
+		} else if key == "version" {
+			heartbeat.Version = value
+		} else if key == "labels" && value != "" {
+			err = json.Unmarshal([]byte(value), &heartbeat.Labels)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return heartbeat, nil
+}
+
+// WorkerPoolStatus is a worker pool's heartbeat along with the latest observation of each of its workers.
+type WorkerPoolStatus struct {
+	*WorkerPoolHeartbeat
+	Workers []*WorkerObservation `json:"workers"`
+}
+
+// WorkerPoolStatus returns the heartbeat of the worker pool with ID workerPoolID and what its workers are doing.
+// Returns ErrWorkerPoolNotFound if it doesn't have a heartbeat.
+func (c *Client) WorkerPoolStatus(workerPoolID string) (*WorkerPoolStatus, error) {
+	conn := c.pool.Get()
+	vals, err := redis.Strings(conn.Do("HGETALL", redisKeyHeartbeat(c.namespace, workerPoolID)))
+	conn.Close()
+	if err != nil {
+		logError(c.logger, "worker_pool_status.heartbeat", err)
+		return nil, err
+	}
+	if len(vals) == 0 {
+		return nil, ErrWorkerPoolNotFound
+	}
+
+	heartbeat, err := parseHeartbeat(workerPoolID, vals)
+	if err != nil {
+		logError(c.logger, "worker_pool_status.parse", err)
+		return nil, err
+	}
+	c.flagStale(heartbeat)
+
+	workers, err := c.workerObservations(heartbeat.WorkerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WorkerPoolStatus{WorkerPoolHeartbeat: heartbeat, Workers: workers}, nil
 }
 
 // WorkerObservation represents the latest observation taken from a worker. The observation indicates whether the worker is busy processing a job, and if so, information about that job.
@@ -129,16 +442,26 @@ type WorkerObservation struct {
 	ArgsJSON  string `json:"args_json"`
 	Checkin   string `json:"checkin"`
 	CheckinAt int64  `json:"checkin_at"`
+
+	// If the job has called Job.SetProgress:
+	ProgressDone  int64 `json:"progress_done,omitempty"`
+	ProgressTotal int64 `json:"progress_total,omitempty"`
+}
+
+// PercentDone returns how far through its work the worker's job is, as per Job.SetProgress, from 0 to 100. It returns
+// -1 if the job hasn't reported its progress.
+func (o *WorkerObservation) PercentDone() float64 {
+	if o.ProgressTotal <= 0 {
+		return -1
+	}
+	return 100 * float64(o.ProgressDone) / float64(o.ProgressTotal)
 }
 
 // WorkerObservations returns all of the WorkerObservation's it finds for all worker pools' workers.
 func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
-	conn := c.pool.Get()
-	defer conn.Close()
-
 	hbs, err := c.WorkerPoolHeartbeats()
 	if err != nil {
-		logError("worker_observations.worker_pool_heartbeats", err)
+		logError(c.logger, "worker_observations.worker_pool_heartbeats", err)
 		return nil, err
 	}
 
@@ -147,13 +470,20 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 		workerIDs = append(workerIDs, hb.WorkerIDs...)
 	}
 
+	return c.workerObservations(workerIDs)
+}
+
+func (c *Client) workerObservations(workerIDs []string) ([]*WorkerObservation, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
 	for _, wid := range workerIDs {
 		key := redisKeyWorkerObservation(c.namespace, wid)
 		conn.Send("HGETALL", key)
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("worker_observations.flush", err)
+		logError(c.logger, "worker_observations.flush", err)
 		return nil, err
 	}
 
@@ -162,7 +492,7 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 	for _, wid := range workerIDs {
 		vals, err := redis.Strings(conn.Receive())
 		if err != nil {
-			logError("worker_observations.receive", err)
+			logError(c.logger, "worker_observations.receive", err)
 			return nil, err
 		}
 
@@ -189,9 +519,13 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 				ob.Checkin = value
 			} else if key == "checkin_at" {
 				ob.CheckinAt, err = strconv.ParseInt(value, 10, 64)
+			} else if key == "progress_done" {
+				ob.ProgressDone, err = strconv.ParseInt(value, 10, 64)
+			} else if key == "progress_total" {
+				ob.ProgressTotal, err = strconv.ParseInt(value, 10, 64)
 			}
 			if err != nil {
-				logError("worker_observations.parse", err)
+				logError(c.logger, "worker_observations.parse", err)
 				return nil, err
 			}
 		}
@@ -207,6 +541,17 @@ type Queue struct {
 	JobName string `json:"job_name"`
 	Count   int64  `json:"count"`
 	Latency int64  `json:"latency"`
+	Paused  bool   `json:"paused"`
+
+	// OldestEnqueuedAt is when the next job to be processed, not counting jobs enqueued with a priority or on any but
+	// the first of a sharded queue's shards, was enqueued, in epoch seconds, or 0 if there isn't one. LatencySeconds
+	// is how long ago that was, the same as Latency, for alerting on.
+	OldestEnqueuedAt int64 `json:"oldest_enqueued_at"`
+	LatencySeconds   int64 `json:"latency_seconds"`
+
+	// InProgress is how many of the queue's jobs worker pools are running, including those of dead pools that haven't
+	// been reaped yet.
+	InProgress int64 `json:"in_progress"`
 }
 
 // Queues returns the Queue's it finds.
@@ -225,71 +570,189 @@ func (c *Client) Queues() ([]*Queue, error) {
 	}
 	sort.Strings(jobNames)
 
-	for _, jobName := range jobNames {
+	poolIDs, err := redis.Strings(conn.Do("SMEMBERS", redisKeyWorkerPools(c.namespace)))
+	if err != nil {
+		logError(c.logger, "client.queues.smembers", err)
+		return nil, err
+	}
+
+	shards, err := c.queueShards(conn, jobNames)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, jobName := range jobNames {
 		conn.Send("LLEN", redisKeyJobs(c.namespace, jobName))
+		conn.Send("ZCARD", redisKeyJobsPriority(c.namespace, jobName))
+		conn.Send("EXISTS", redisKeyJobsPaused(c.namespace, jobName))
+		for _, poolID := range poolIDs {
+			conn.Send("LLEN", redisKeyJobsInProgress(c.namespace, poolID, jobName))
+		}
+		for shard := 1; shard < shards[i]; shard++ {
+			conn.Send("LLEN", redisKeyJobsShard(c.namespace, jobName, shard))
+		}
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("client.queues.flush", err)
+		logError(c.logger, "client.queues.flush", err)
 		return nil, err
 	}
 
 	queues := make([]*Queue, 0, len(jobNames))
+	listCounts := make([]int64, 0, len(jobNames))
 
-	for _, jobName := range jobNames {
+	for i, jobName := range jobNames {
 		count, err := redis.Int64(conn.Receive())
 		if err != nil {
-			logError("client.queues.receive", err)
+			logError(c.logger, "client.queues.receive", err)
 			return nil, err
 		}
+		priorityCount, err := redis.Int64(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.queues.receive", err)
+			return nil, err
+		}
+		paused, err := redis.Bool(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.queues.receive", err)
+			return nil, err
+		}
+
+		var inProgress int64
+		for range poolIDs {
+			n, err := redis.Int64(conn.Receive())
+			if err != nil {
+				logError(c.logger, "client.queues.receive", err)
+				return nil, err
+			}
+			inProgress += n
+		}
+
+		var shardCount int64
+		for shard := 1; shard < shards[i]; shard++ {
+			n, err := redis.Int64(conn.Receive())
+			if err != nil {
+				logError(c.logger, "client.queues.receive", err)
+				return nil, err
+			}
+			shardCount += n
+		}
 
 		queue := &Queue{
-			JobName: jobName,
-			Count:   count,
+			JobName:    jobName,
+			Count:      count + shardCount + priorityCount,
+			Paused:     paused,
+			InProgress: inProgress,
 		}
 
 		queues = append(queues, queue)
+		listCounts = append(listCounts, count)
 	}
 
-	for _, s := range queues {
-		if s.Count > 0 {
+	for i, s := range queues {
+		if listCounts[i] > 0 {
 			conn.Send("LINDEX", redisKeyJobs(c.namespace, s.JobName), -1)
 		}
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("client.queues.flush2", err)
+		logError(c.logger, "client.queues.flush2", err)
 		return nil, err
 	}
 
 	now := nowEpochSeconds()
 
-	for _, s := range queues {
-		if s.Count > 0 {
+	for i, s := range queues {
+		if listCounts[i] > 0 {
 			b, err := redis.Bytes(conn.Receive())
 			if err != nil {
 				// maybe the list items were already consumed between the LLEN and
 				// LINDEX calls, so if we don't get any items here, update the count and
 				// move on
 				if err == redis.ErrNil {
-					s.Count = 0
+					s.Count -= listCounts[i]
 					continue
 				}
-				logError("client.queues.receive2", err)
+				logError(c.logger, "client.queues.receive2", err)
 				return nil, err
 			}
 
 			job, err := newJob(b, nil, nil)
 			if err != nil {
-				logError("client.queues.new_job", err)
+				logError(c.logger, "client.queues.new_job", err)
+				continue
 			}
+			s.OldestEnqueuedAt = job.EnqueuedAt
 			s.Latency = now - job.EnqueuedAt
+			s.LatencySeconds = s.Latency
 		}
 	}
 
 	return queues, nil
 }
 
+// queueShards returns how many shards each of the job types' queues is split into, see JobOptions.Shards, which is 0 if
+// it isn't sharded.
+func (c *Client) queueShards(conn redis.Conn, jobNames []string) ([]int, error) {
+	for _, jobName := range jobNames {
+		conn.Send("HGET", redisKeyJobsShards(c.namespace, jobName), "count")
+	}
+	if err := conn.Flush(); err != nil {
+		logError(c.logger, "client.queue_shards.flush", err)
+		return nil, err
+	}
+
+	shards := make([]int, len(jobNames))
+	for i := range jobNames {
+		n, err := redis.Int(conn.Receive())
+		if err != nil && err != redis.ErrNil {
+			logError(c.logger, "client.queue_shards.receive", err)
+			return nil, err
+		}
+		shards[i] = n
+	}
+	return shards, nil
+}
+
+// JobResult returns the result of the job with the given ID, or nil if it hasn't finished or its result has expired.
+// See Job.SetResult.
+func (c *Client) JobResult(jobID string) (*JobResult, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	res, err := getJobResult(conn, c.namespace, jobID)
+	if err != nil {
+		logError(c.logger, "client.job_result", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// PauseQueue stops workers in every worker pool from starting jobs with the given name until UnpauseQueue is called.
+// Jobs that are already running carry on, and new jobs can still be enqueued.
+func (c *Client) PauseQueue(jobName string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("SET", redisKeyJobsPaused(c.namespace, jobName), "1"); err != nil {
+		logError(c.logger, "client.pause_queue", err)
+		return err
+	}
+	return nil
+}
+
+// UnpauseQueue lets workers start jobs with the given name again after PauseQueue.
+func (c *Client) UnpauseQueue(jobName string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("DEL", redisKeyJobsPaused(c.namespace, jobName)); err != nil {
+		logError(c.logger, "client.unpause_queue", err)
+		return err
+	}
+	return nil
+}
+
 // RetryJob represents a job in the retry queue.
 type RetryJob struct {
 	RetryAt int64 `json:"retry_at"`
@@ -302,18 +765,73 @@ type ScheduledJob struct {
 	*Job
 }
 
+// RunAtTime returns the time the job is scheduled to run at, as RunAt is in epoch seconds.
+func (j *ScheduledJob) RunAtTime() time.Time {
+	return time.Unix(j.RunAt, 0)
+}
+
+// RecurringJob represents a job enqueued with Enqueuer.EnqueueEvery.
+type RecurringJob struct {
+	ID       string                 `json:"id"`
+	Name     string                 `json:"name"`
+	Args     map[string]interface{} `json:"args"`
+	Interval int64                  `json:"interval"` // in seconds
+}
+
 // DeadJob represents a job in the dead queue.
 type DeadJob struct {
 	DiedAt int64 `json:"died_at"`
 	*Job
 }
 
+// JobFilter selects the jobs returned by Client.FilterScheduledJobs, FilterRetryJobs and FilterDeadJobs. Empty fields
+// match all jobs.
+type JobFilter struct {
+	Name         string // Jobs with this name
+	ArgsContains string // Jobs whose JSON encoded arguments contain this, eg, "acme"
+	ArgPath      string // Jobs whose argument at this dot separated path, eg, "account.id", is ArgValue
+	ArgValue     string
+}
+
+func (f JobFilter) match(job *Job) bool {
+	if f.Name != "" && job.Name != f.Name {
+		return false
+	}
+	if f.ArgsContains != "" {
+		args, err := json.Marshal(job.Args)
+		if err != nil || !strings.Contains(string(args), f.ArgsContains) {
+			return false
+		}
+	}
+	if f.ArgPath != "" {
+		var v interface{} = job.Args
+		for _, k := range strings.Split(f.ArgPath, ".") {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			if v, ok = m[k]; !ok {
+				return false
+			}
+		}
+		if fmt.Sprint(v) != f.ArgValue {
+			return false
+		}
+	}
+	return true
+}
+
 // ScheduledJobs returns a list of ScheduledJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of scheduled jobs is also returned.
 func (c *Client) ScheduledJobs(page uint) ([]*ScheduledJob, int64, error) {
+	return c.FilterScheduledJobs(page, JobFilter{})
+}
+
+// FilterScheduledJobs is like ScheduledJobs, but only returns and counts the jobs that match filter.
+func (c *Client) FilterScheduledJobs(page uint, filter JobFilter) ([]*ScheduledJob, int64, error) {
 	key := redisKeyScheduled(c.namespace)
-	jobsWithScores, count, err := c.getZsetPage(key, page)
+	jobsWithScores, count, err := c.getZsetPage(key, page, filter)
 	if err != nil {
-		logError("client.scheduled_jobs.get_zset_page", err)
+		logError(c.logger, "client.scheduled_jobs.get_zset_page", err)
 		return nil, 0, err
 	}
 
@@ -326,12 +844,42 @@ func (c *Client) ScheduledJobs(page uint) ([]*ScheduledJob, int64, error) {
 	return jobs, count, nil
 }
 
+// RecurringJobs returns the jobs enqueued with Enqueuer.EnqueueEvery, sorted by ID.
+func (c *Client) RecurringJobs() ([]*RecurringJob, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	values, err := redis.ByteSlices(conn.Do("HVALS", redisKeyRecurring(c.namespace)))
+	if err != nil {
+		logError(c.logger, "client.recurring_jobs.hvals", err)
+		return nil, err
+	}
+
+	jobs := make([]*RecurringJob, 0, len(values))
+	for _, rawJSON := range values {
+		var rj RecurringJob
+		if err := json.Unmarshal(rawJSON, &rj); err != nil {
+			logError(c.logger, "client.recurring_jobs.unmarshal", err)
+			return nil, err
+		}
+		jobs = append(jobs, &rj)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+
+	return jobs, nil
+}
+
 // RetryJobs returns a list of RetryJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of retry jobs is also returned.
 func (c *Client) RetryJobs(page uint) ([]*RetryJob, int64, error) {
+	return c.FilterRetryJobs(page, JobFilter{})
+}
+
+// FilterRetryJobs is like RetryJobs, but only returns and counts the jobs that match filter.
+func (c *Client) FilterRetryJobs(page uint, filter JobFilter) ([]*RetryJob, int64, error) {
 	key := redisKeyRetry(c.namespace)
-	jobsWithScores, count, err := c.getZsetPage(key, page)
+	jobsWithScores, count, err := c.getZsetPage(key, page, filter)
 	if err != nil {
-		logError("client.retry_jobs.get_zset_page", err)
+		logError(c.logger, "client.retry_jobs.get_zset_page", err)
 		return nil, 0, err
 	}
 
@@ -346,10 +894,15 @@ func (c *Client) RetryJobs(page uint) ([]*RetryJob, int64, error) {
 
 // DeadJobs returns a list of DeadJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of dead jobs is also returned.
 func (c *Client) DeadJobs(page uint) ([]*DeadJob, int64, error) {
+	return c.FilterDeadJobs(page, JobFilter{})
+}
+
+// FilterDeadJobs is like DeadJobs, but only returns and counts the jobs that match filter.
+func (c *Client) FilterDeadJobs(page uint, filter JobFilter) ([]*DeadJob, int64, error) {
 	key := redisKeyDead(c.namespace)
-	jobsWithScores, count, err := c.getZsetPage(key, page)
+	jobsWithScores, count, err := c.getZsetPage(key, page, filter)
 	if err != nil {
-		logError("client.dead_jobs.get_zset_page", err)
+		logError(c.logger, "client.dead_jobs.get_zset_page", err)
 		return nil, 0, err
 	}
 
@@ -362,33 +915,285 @@ func (c *Client) DeadJobs(page uint) ([]*DeadJob, int64, error) {
 	return jobs, count, nil
 }
 
-// DeleteDeadJob deletes a dead job from Redis.
-func (c *Client) DeleteDeadJob(diedAt int64, jobID string) error {
-	ok, _, err := c.deleteZsetJob(redisKeyDead(c.namespace), diedAt, jobID)
-	if err != nil {
-		return err
-	}
-	if !ok {
-		return ErrNotDeleted
-	}
-	return nil
+// EachDeadJob calls fn with each dead job that matches filter, oldest first, without loading them all into memory at
+// once. It stops at the first error fn returns, returning that error.
+func (c *Client) EachDeadJob(filter JobFilter, fn func(job *DeadJob) error) error {
+	const batchSize = 1000
+	key := redisKeyDead(c.namespace)
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	for offset := 0; ; offset += batchSize {
+		values, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, "-inf", "+inf", "WITHSCORES", "LIMIT", offset, batchSize))
+		if err != nil {
+			logError(c.logger, "client.each_dead_job.values", err)
+			return err
+		}
+
+		var batch []jobScore
+		if err := redis.ScanSlice(values, &batch); err != nil {
+			logError(c.logger, "client.each_dead_job.scan_slice", err)
+			return err
+		}
+
+		for _, jws := range batch {
+			job, err := c.newJob(jws.JobBytes, "client.each_dead_job.new_job")
+			if err != nil {
+				return err
+			}
+			job = c.redacted(job)
+			if !filter.match(job) {
+				continue
+			}
+			if err := fn(&DeadJob{DiedAt: jws.Score, Job: job}); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < batchSize {
+			return nil
+		}
+	}
+}
+
+// EachQueuedJob calls fn with each job waiting on jobName's queue, without loading them all into memory at once. Jobs
+// enqueued with a priority come first, in the order they'll run, followed by the rest, oldest first. Their arguments
+// are redacted as per RedactArgs. It stops at the first error fn returns, returning that error.
+func (c *Client) EachQueuedJob(jobName string, fn func(job *Job) error) error {
+	const batchSize = 1000
+	each := func(job *Job) error { return fn(c.redacted(job)) }
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	for offset := 0; ; offset += batchSize {
+		values, err := redis.ByteSlices(conn.Do("ZRANGE", redisKeyJobsPriority(c.namespace, jobName), offset, offset+batchSize-1))
+		if err != nil {
+			logError(c.logger, "client.each_queued_job.zrange", err)
+			return err
+		}
+		if err := c.eachJob(values, each); err != nil {
+			return err
+		}
+		if len(values) < batchSize {
+			break
+		}
+	}
+
+	// Jobs are pushed onto the head of the list and popped off the tail
+	for end := -1; ; end -= batchSize {
+		values, err := redis.ByteSlices(conn.Do("LRANGE", redisKeyJobs(c.namespace, jobName), end-batchSize+1, end))
+		if err != nil {
+			logError(c.logger, "client.each_queued_job.lrange", err)
+			return err
+		}
+		for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+			values[i], values[j] = values[j], values[i]
+		}
+		if err := c.eachJob(values, each); err != nil {
+			return err
+		}
+		if len(values) < batchSize {
+			return nil
+		}
+	}
+}
+
+// QueuedJobs returns the jobs waiting on queueName's queue, in the order they'll run, as per EachQueuedJob. The page
+// param is 1-based; each page is 20 items. The total number of jobs on the queue is also returned. queueName is the
+// name of the queue's jobs.
+func (c *Client) QueuedJobs(queueName string, page uint) ([]*Job, int64, error) {
+	const pageSize = 20
+
+	if page == 0 {
+		page = 1
+	}
+	start := int64(page-1) * pageSize
+	end := start + pageSize // exclusive
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	priorityKey := redisKeyJobsPriority(c.namespace, queueName)
+	listKey := redisKeyJobs(c.namespace, queueName)
+	priorityCount, err := redis.Int64(conn.Do("ZCARD", priorityKey))
+	if err != nil {
+		logError(c.logger, "client.queued_jobs.zcard", err)
+		return nil, 0, err
+	}
+	listCount, err := redis.Int64(conn.Do("LLEN", listKey))
+	if err != nil {
+		logError(c.logger, "client.queued_jobs.llen", err)
+		return nil, 0, err
+	}
+
+	var values [][]byte
+	if start < priorityCount {
+		values, err = redis.ByteSlices(conn.Do("ZRANGE", priorityKey, start, end-1))
+		if err != nil {
+			logError(c.logger, "client.queued_jobs.zrange", err)
+			return nil, 0, err
+		}
+	}
+	if end > priorityCount && start < priorityCount+listCount {
+		// Jobs are pushed onto the head of the list and popped off the tail
+		from := start - priorityCount
+		if from < 0 {
+			from = 0
+		}
+		to := end - priorityCount - 1
+		listValues, err := redis.ByteSlices(conn.Do("LRANGE", listKey, -to-1, -from-1))
+		if err != nil {
+			logError(c.logger, "client.queued_jobs.lrange", err)
+			return nil, 0, err
+		}
+		for i := len(listValues) - 1; i >= 0; i-- {
+			values = append(values, listValues[i])
+		}
+	}
+
+	jobs := make([]*Job, 0, len(values))
+	err = c.eachJob(values, func(job *Job) error {
+		jobs = append(jobs, c.redacted(job))
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return jobs, priorityCount + listCount, nil
 }
 
-// KillJob flags a job to be stopped.
+// newJob decodes a job for the client's listings. A job whose args can't be decoded, eg, as their codec isn't
+// registered in this process, is returned without them, so that it can still be listed, retried and deleted, and the
+// error is logged as key.
+func (c *Client) newJob(rawJSON []byte, key string) (*Job, error) {
+	job, err := newJob(rawJSON, nil, nil)
+	if err == nil {
+		return job, nil
+	}
+	logError(c.logger, key, err)
+	if job, undecodedErr := newUndecodedJob(rawJSON); undecodedErr == nil {
+		return job, nil
+	}
+	return nil, err
+}
+
+// eachJob decodes each of values and calls fn with it, stopping at the first error.
+func (c *Client) eachJob(values [][]byte, fn func(job *Job) error) error {
+	for _, rawJSON := range values {
+		job, err := c.newJob(rawJSON, "client.each_job.new_job")
+		if err != nil {
+			return err
+		}
+		if err := fn(job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeadJob returns a single dead job, with its full error and backtrace. It returns ErrJobNotFound if the job isn't dead.
+func (c *Client) DeadJob(diedAt int64, jobID string) (*DeadJob, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	rawJobs, err := redis.ByteSlices(conn.Do("ZRANGEBYSCORE", redisKeyDead(c.namespace), diedAt, diedAt))
+	if err != nil {
+		logError(c.logger, "client.dead_job.zrangebyscore", err)
+		return nil, err
+	}
+
+	for _, rawJob := range rawJobs {
+		job, err := c.newJob(rawJob, "client.dead_job.new_job")
+		if err != nil {
+			return nil, err
+		}
+		if job.ID == jobID {
+			return &DeadJob{DiedAt: diedAt, Job: c.redacted(job)}, nil
+		}
+	}
+
+	return nil, ErrJobNotFound
+}
+
+// DeleteDeadJob deletes a dead job from Redis.
+func (c *Client) DeleteDeadJob(diedAt int64, jobID string) error {
+	ok, _, err := c.deleteZsetJob(redisKeyDead(c.namespace), diedAt, jobID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotDeleted
+	}
+	return nil
+}
+
+// DeadJobRef identifies a dead job by the DiedAt and ID of a DeadJob.
+type DeadJobRef struct {
+	DiedAt int64  `json:"died_at"`
+	JobID  string `json:"job_id"`
+}
+
+// DeleteDeadJobs deletes the dead jobs from Redis, returning how many were deleted. Jobs that aren't dead anymore are
+// skipped.
+func (c *Client) DeleteDeadJobs(jobs []DeadJobRef) (int64, error) {
+	var deleted int64
+	for _, j := range jobs {
+		ok, _, err := c.deleteZsetJob(redisKeyDead(c.namespace), j.DiedAt, j.JobID)
+		if err != nil {
+			return deleted, err
+		}
+		if ok {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// KillJob flags a job to be stopped. It's the same as CancelJob.
 func (c *Client) KillJob(jobID string) error {
+	return c.CancelJob(jobID)
+}
+
+// CancelJob flags a running job to be stopped. Cancellation is cooperative: handlers that take a context.Context
+// have it cancelled within a second or so, and other handlers can check Job.Cancelled or Job.Alive as they go. A
+// cancelled job that returns an error isn't retried, and is sent to the dead queue unless its job type sets SkipDead.
+// The flag expires after an hour if the job doesn't pick it up.
+func (c *Client) CancelJob(jobID string) error {
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	return conn.Send("SETEX", redisKeyKilledJob(c.namespace, jobID), 60*60, 1)
+	_, err := conn.Do("SETEX", redisKeyKilledJob(c.namespace, jobID), 60*60, 1)
+	if err != nil {
+		logError(c.logger, "client.cancel_job", err)
+	}
+	return err
 }
 
 // RetryDeadJob retries a dead job. The job will be re-queued on the normal work queue for eventual processing by a worker.
 func (c *Client) RetryDeadJob(diedAt int64, jobID string) error {
+	cnt, err := c.RetryDeadJobs([]DeadJobRef{{DiedAt: diedAt, JobID: jobID}})
+	if err != nil {
+		return err
+	}
+
+	if cnt == 0 {
+		return ErrNotRetried
+	}
+
+	return nil
+}
+
+// RetryDeadJobs retries the dead jobs as per RetryDeadJob, returning how many were retried. Jobs that aren't dead
+// anymore are skipped.
+func (c *Client) RetryDeadJobs(jobs []DeadJobRef) (int64, error) {
 	// Get queues for job names
 	queues, err := c.Queues()
 	if err != nil {
-		logError("client.retry_all_dead_jobs.queues", err)
-		return err
+		logError(c.logger, "client.retry_all_dead_jobs.queues", err)
+		return 0, err
 	}
 
 	// Extract job names
@@ -399,30 +1204,33 @@ func (c *Client) RetryDeadJob(diedAt int64, jobID string) error {
 
 	script := redis.NewScript(len(jobNames)+1, redisLuaRequeueSingleDeadCmd)
 
-	args := make([]interface{}, 0, len(jobNames)+1+3)
-	args = append(args, redisKeyDead(c.namespace)) // KEY[1]
+	keys := make([]interface{}, 0, len(jobNames)+1+1)
+	keys = append(keys, redisKeyDead(c.namespace)) // KEY[1]
 	for _, jobName := range jobNames {
-		args = append(args, redisKeyJobs(c.namespace, jobName)) // KEY[2, 3, ...]
+		keys = append(keys, redisKeyJobs(c.namespace, jobName)) // KEY[2, 3, ...]
 	}
-	args = append(args, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
-	args = append(args, nowEpochSeconds())
-	args = append(args, diedAt)
-	args = append(args, jobID)
+	keys = append(keys, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
 
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	cnt, err := redis.Int64(script.Do(conn, args...))
-	if err != nil {
-		logError("client.retry_dead_job.do", err)
-		return err
-	}
+	var retried int64
+	for _, j := range jobs {
+		args := make([]interface{}, 0, len(keys)+3)
+		args = append(args, keys...)
+		args = append(args, nowEpochSeconds())
+		args = append(args, j.DiedAt)
+		args = append(args, j.JobID)
 
-	if cnt == 0 {
-		return ErrNotRetried
+		cnt, err := redis.Int64(script.Do(conn, args...))
+		if err != nil {
+			logError(c.logger, "client.retry_dead_job.do", err)
+			return retried, err
+		}
+		retried += cnt
 	}
 
-	return nil
+	return retried, nil
 }
 
 // RetryAllDeadJobs requeues all dead jobs. In other words, it puts them all back on the normal work queue for workers to pull from and process.
@@ -430,7 +1238,7 @@ func (c *Client) RetryAllDeadJobs() error {
 	// Get queues for job names
 	queues, err := c.Queues()
 	if err != nil {
-		logError("client.retry_all_dead_jobs.queues", err)
+		logError(c.logger, "client.retry_all_dead_jobs.queues", err)
 		return err
 	}
 
@@ -459,7 +1267,7 @@ func (c *Client) RetryAllDeadJobs() error {
 	for i := 0; i < 1000; i++ {
 		res, err := redis.Int64(script.Do(conn, args...))
 		if err != nil {
-			logError("client.retry_all_dead_jobs.do", err)
+			logError(c.logger, "client.retry_all_dead_jobs.do", err)
 			return err
 		}
 
@@ -477,13 +1285,203 @@ func (c *Client) DeleteAllDeadJobs() error {
 	defer conn.Close()
 	_, err := conn.Do("DEL", redisKeyDead(c.namespace))
 	if err != nil {
-		logError("client.delete_all_dead_jobs", err)
+		logError(c.logger, "client.delete_all_dead_jobs", err)
 		return err
 	}
 
 	return nil
 }
 
+// PruneDeadJobs deletes the dead jobs that died before olderThan, returning how many were deleted. See
+// WorkerPoolOptions.DeadJobMaxAge to prune them continuously.
+func (c *Client) PruneDeadJobs(olderThan time.Time) (int64, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+	n, err := redis.Int64(conn.Do("ZREMRANGEBYSCORE", redisKeyDead(c.namespace), "-inf", "("+strconv.FormatInt(olderThan.Unix(), 10)))
+	if err != nil {
+		logError(c.logger, "client.prune_dead_jobs", err)
+		return 0, err
+	}
+	return n, nil
+}
+
+// RetryDeadJobsByName requeues the dead jobs named jobName, returning how many were requeued. Returns ErrNotRetried
+// if jobName isn't a known job.
+func (c *Client) RetryDeadJobsByName(jobName string) (int64, error) {
+	queues, err := c.Queues()
+	if err != nil {
+		logError(c.logger, "client.retry_dead_jobs_by_name.queues", err)
+		return 0, err
+	}
+
+	known := false
+	for _, q := range queues {
+		if q.JobName == jobName {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return 0, ErrNotRetried
+	}
+
+	script := redis.NewScript(2, redisLuaRequeueDeadByNameCmd)
+	return c.processJobsInBatches("client.retry_dead_jobs_by_name.do", func(conn redis.Conn, offset int64) ([]int64, error) {
+		return redis.Int64s(script.Do(conn, redisKeyDead(c.namespace), redisKeyJobs(c.namespace, jobName), jobName, nowEpochSeconds(), offset, 1000))
+	})
+}
+
+// DeadJobFilter selects the dead jobs retried by Client.RetryDeadJobsWhere. Empty fields match all jobs.
+type DeadJobFilter struct {
+	Name        string    // Jobs with this name
+	ErrContains string    // Jobs whose last error contains this, eg, "connection refused"
+	DiedAfter   time.Time // Jobs that died at or after this
+	DiedBefore  time.Time // Jobs that died before this
+}
+
+// RetryDeadJobsWhere requeues the dead jobs that match filter, eg, those that failed as a downstream service was down,
+// returning how many were requeued. The jobs are matched and requeued in Redis, 1000 at a time, so only the matching
+// jobs are moved. Dead jobs with no known queue are skipped.
+func (c *Client) RetryDeadJobsWhere(filter DeadJobFilter) (int64, error) {
+	queues, err := c.Queues()
+	if err != nil {
+		logError(c.logger, "client.retry_dead_jobs_where.queues", err)
+		return 0, err
+	}
+
+	minDiedAt, maxDiedAt := "-inf", "+inf"
+	if !filter.DiedAfter.IsZero() {
+		minDiedAt = strconv.FormatInt(filter.DiedAfter.Unix(), 10)
+	}
+	if !filter.DiedBefore.IsZero() {
+		maxDiedAt = "(" + strconv.FormatInt(filter.DiedBefore.Unix(), 10)
+	}
+
+	keys := make([]interface{}, 0, len(queues)+1)
+	keys = append(keys, redisKeyDead(c.namespace))
+	for _, q := range queues {
+		keys = append(keys, redisKeyJobs(c.namespace, q.JobName))
+	}
+	script := redis.NewScript(len(keys), redisLuaRequeueDeadWhereCmd)
+	return c.processJobsInBatches("client.retry_dead_jobs_where.do", func(conn redis.Conn, offset int64) ([]int64, error) {
+		args := redis.Args{}.Add(keys...).Add(redisKeyJobsPrefix(c.namespace), nowEpochSeconds(), minDiedAt, maxDiedAt, filter.Name, filter.ErrContains, offset, 1000)
+		return redis.Int64s(script.Do(conn, args...))
+	})
+}
+
+// DeleteDeadJobsByName deletes the dead jobs named jobName, returning how many were deleted.
+func (c *Client) DeleteDeadJobsByName(jobName string) (int64, error) {
+	script := redis.NewScript(1, redisLuaDeleteDeadByNameCmd)
+	return c.processJobsInBatches("client.delete_dead_jobs_by_name.do", func(conn redis.Conn, offset int64) ([]int64, error) {
+		return redis.Int64s(script.Do(conn, redisKeyDead(c.namespace), jobName, offset, 1000))
+	})
+}
+
+// processJobsInBatches runs a script that scans a batch of jobs from offset, removing the ones that match, until all
+// of them have been scanned. The script returns how many jobs it removed and how many it scanned.
+func (c *Client) processJobsInBatches(logKey string, run func(conn redis.Conn, offset int64) ([]int64, error)) (int64, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	var offset, total int64
+	for {
+		res, err := run(conn, offset)
+		if err == nil && len(res) != 2 {
+			err = fmt.Errorf("need 2 elements back from redis command")
+		}
+		if err != nil {
+			logError(c.logger, logKey, err)
+			return total, err
+		}
+
+		removed, scanned := res[0], res[1]
+		total += removed
+		if scanned == 0 {
+			return total, nil
+		}
+		// The removed jobs no longer take up ranks in the zset or list.
+		offset += scanned - removed
+	}
+}
+
+// DeleteQueuedJob deletes the job with ID jobID from queueName's queue, eg, a poison message that keeps failing. It
+// returns ErrNotDeleted if the job isn't queued, eg, because a worker has already fetched it. queueName is the name of
+// the queue's jobs. Jobs queued on a Redis Stream (see BackendStreams) can't be deleted.
+func (c *Client) DeleteQueuedJob(queueName, jobID string) error {
+	n, err := c.deleteQueuedJobs("client.delete_queued_job.do", queueName, "id", jobID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotDeleted
+	}
+	return nil
+}
+
+// DeleteQueuedJobsByName deletes the jobs named jobName from queueName's queue, returning how many were deleted. Jobs
+// are queued on the queue of their own name, so unless the two differ this deletes all of the queued jobs as of when
+// each batch is scanned. Jobs queued on a Redis Stream (see BackendStreams) can't be deleted.
+func (c *Client) DeleteQueuedJobsByName(queueName, jobName string) (int64, error) {
+	return c.deleteQueuedJobs("client.delete_queued_jobs_by_name.do", queueName, "name", jobName)
+}
+
+// deleteQueuedJobs deletes the jobs on queueName's queue, including those enqueued with a priority, whose field is
+// value.
+func (c *Client) deleteQueuedJobs(logKey, queueName, field, value string) (int64, error) {
+	script := redis.NewScript(1, redisLuaDeleteQueuedCmd)
+
+	var total int64
+	for _, key := range []string{redisKeyJobsPriority(c.namespace, queueName), redisKeyJobs(c.namespace, queueName)} {
+		n, err := c.processJobsInBatches(logKey, func(conn redis.Conn, offset int64) ([]int64, error) {
+			return redis.Int64s(script.Do(conn, key, field, value, offset, 1000))
+		})
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// FlushQueue deletes all of the jobs waiting on queueName's queue at once, including those enqueued with a priority,
+// returning how many were deleted, eg, when a buggy producer has flooded it. queueName is the name of the queue's jobs.
+// Jobs queued on a Redis Stream (see BackendStreams) aren't deleted.
+func (c *Client) FlushQueue(queueName string) (int64, error) {
+	return c.flushQueue(queueName, false)
+}
+
+// FlushQueueAndInProgress deletes the jobs on queueName's queue as per FlushQueue, along with the worker pools'
+// in-progress lists of the queue's jobs. The jobs that are running carry on, but they're no longer requeued by the
+// reaper if their worker pool dies.
+func (c *Client) FlushQueueAndInProgress(queueName string) (int64, error) {
+	return c.flushQueue(queueName, true)
+}
+
+func (c *Client) flushQueue(queueName string, inProgress bool) (int64, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	keys := []interface{}{redisKeyJobs(c.namespace, queueName), redisKeyJobsPriority(c.namespace, queueName)}
+	if inProgress {
+		poolIDs, err := redis.Strings(conn.Do("SMEMBERS", redisKeyWorkerPools(c.namespace)))
+		if err != nil {
+			logError(c.logger, "client.flush_queue.smembers", err)
+			return 0, err
+		}
+		for _, poolID := range poolIDs {
+			keys = append(keys, redisKeyJobsInProgress(c.namespace, poolID, queueName))
+		}
+	}
+
+	script := redis.NewScript(len(keys), redisLuaFlushQueueCmd)
+	n, err := redis.Int64(script.Do(conn, keys...))
+	if err != nil {
+		logError(c.logger, "client.flush_queue.do", err)
+		return 0, err
+	}
+	return n, nil
+}
+
 // DeleteScheduledJob deletes a job in the scheduled queue.
 func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
 	ok, jobBytes, err := c.deleteZsetJob(redisKeyScheduled(c.namespace), scheduledFor, jobID)
@@ -495,22 +1493,25 @@ func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
 	if len(jobBytes) > 0 {
 		job, err := newJob(jobBytes, nil, nil)
 		if err != nil {
-			logError("client.delete_scheduled_job.new_job", err)
+			logError(c.logger, "client.delete_scheduled_job.new_job", err)
 			return err
 		}
 
 		if job.Unique {
-			uniqueKey, err := redisKeyUniqueJob(c.namespace, job.Name, job.Args)
-			if err != nil {
-				logError("client.delete_scheduled_job.redis_key_unique_job", err)
-				return err
+			uniqueKey := job.UniqueKey
+			if uniqueKey == "" {
+				uniqueKey, err = redisKeyUniqueJob(c.namespace, job.Name, job.Args)
+				if err != nil {
+					logError(c.logger, "client.delete_scheduled_job.redis_key_unique_job", err)
+					return err
+				}
 			}
 			conn := c.pool.Get()
 			defer conn.Close()
 
 			_, err = conn.Do("DEL", uniqueKey)
 			if err != nil {
-				logError("worker.delete_unique_job.del", err)
+				logError(c.logger, "worker.delete_unique_job.del", err)
 				return err
 			}
 		}
@@ -522,6 +1523,102 @@ func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
 	return nil
 }
 
+// RunScheduledJobNow moves a job from the scheduled queue onto the normal work queue, so that it runs as soon as a
+// worker is free instead of at scheduledFor.
+func (c *Client) RunScheduledJobNow(scheduledFor int64, jobID string) error {
+	queues, err := c.Queues()
+	if err != nil {
+		logError(c.logger, "client.run_scheduled_job_now.queues", err)
+		return err
+	}
+
+	script := redis.NewScript(len(queues)+1, redisLuaRunScheduledNowCmd)
+
+	args := make([]interface{}, 0, len(queues)+1+4)
+	args = append(args, redisKeyScheduled(c.namespace)) // KEY[1]
+	for _, q := range queues {
+		args = append(args, redisKeyJobs(c.namespace, q.JobName)) // KEY[2, 3, ...]
+	}
+	args = append(args, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
+	args = append(args, nowEpochSeconds())               // ARGV[2]
+	args = append(args, scheduledFor)                    // ARGV[3]
+	args = append(args, jobID)                           // ARGV[4]
+
+	conn := c.pool.Get()
+	defer conn.Close()
+	cnt, err := redis.Int64(script.Do(conn, args...))
+	if err != nil {
+		logError(c.logger, "client.run_scheduled_job_now.do", err)
+		return err
+	}
+
+	if cnt == 0 {
+		return ErrNotEnqueued
+	}
+	return nil
+}
+
+// RescheduleJob moves the scheduled job with ID jobID to run at newTime instead, to the second, eg, when a user changes
+// a reminder. The job is moved in place, so it's never missing from the scheduled queue nor scheduled twice, and a
+// unique job's lock is extended to cover newTime. It returns ErrJobNotFound if the job isn't scheduled, eg, because
+// it has already run.
+func (c *Client) RescheduleJob(jobID string, newTime time.Time) error {
+	const batchSize = 1000
+	key := redisKeyScheduled(c.namespace)
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	var jobBytes []byte
+	for offset := 0; jobBytes == nil; offset += batchSize {
+		values, err := redis.ByteSlices(conn.Do("ZRANGE", key, offset, offset+batchSize-1))
+		if err != nil {
+			logError(c.logger, "client.reschedule_job.zrange", err)
+			return err
+		}
+		for _, b := range values {
+			var ref struct {
+				ID string `json:"id"`
+			}
+			if err := json.Unmarshal(b, &ref); err == nil && ref.ID == jobID {
+				jobBytes = b
+				break
+			}
+		}
+		if jobBytes == nil && len(values) < batchSize {
+			return ErrJobNotFound
+		}
+	}
+
+	job, err := newJob(jobBytes, nil, nil)
+	if err != nil {
+		logError(c.logger, "client.reschedule_job.new_job", err)
+		return err
+	}
+	uniqueKey, unique := key, "0"
+	if job.Unique {
+		unique = "1"
+		uniqueKey = job.UniqueKey
+		if uniqueKey == "" {
+			if uniqueKey, err = redisKeyUniqueJob(c.namespace, job.Name, job.Args); err != nil {
+				logError(c.logger, "client.reschedule_job.redis_key_unique_job", err)
+				return err
+			}
+		}
+	}
+
+	script := redis.NewScript(3, redisLuaRescheduleCmd)
+	n, err := redis.Int64(script.Do(conn, key, uniqueKey, redisKeyJobsUniqueTTL(c.namespace, job.Name), jobBytes, newTime.Unix(), nowEpochSeconds(), unique))
+	if err != nil {
+		logError(c.logger, "client.reschedule_job.do", err)
+		return err
+	}
+	if n == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
 // DeleteRetryJob deletes a job in the retry queue.
 func (c *Client) DeleteRetryJob(retryAt int64, jobID string) error {
 	ok, _, err := c.deleteZsetJob(redisKeyRetry(c.namespace), retryAt, jobID)
@@ -546,6 +1643,10 @@ func (c *Client) deleteZsetJob(zsetKey string, zscore int64, jobID string) (bool
 	conn := c.pool.Get()
 	defer conn.Close()
 	values, err := redis.Values(script.Do(conn, args...))
+	if err != nil {
+		logError(c.logger, "client.delete_zset_job.do", err)
+		return false, nil, err
+	}
 	if len(values) != 2 {
 		return false, nil, fmt.Errorf("need 2 elements back from redis command")
 	}
@@ -553,7 +1654,7 @@ func (c *Client) deleteZsetJob(zsetKey string, zscore int64, jobID string) (bool
 	cnt, err := redis.Int64(values[0], err)
 	jobBytes, err := redis.Bytes(values[1], err)
 	if err != nil {
-		logError("client.delete_zset_job.do", err)
+		logError(c.logger, "client.delete_zset_job.do", err)
 		return false, nil, err
 	}
 
@@ -566,42 +1667,88 @@ type jobScore struct {
 	job      *Job
 }
 
-func (c *Client) getZsetPage(key string, page uint) ([]jobScore, int64, error) {
+func (c *Client) getZsetPage(key string, page uint, filter JobFilter) ([]jobScore, int64, error) {
 	conn := c.pool.Get()
 	defer conn.Close()
 
 	if page == 0 {
 		page = 1
 	}
+	if filter != (JobFilter{}) {
+		return c.getFilteredZsetPage(conn, key, page, filter)
+	}
 
 	values, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, "-inf", "+inf", "WITHSCORES", "LIMIT", (page-1)*20, 20))
 	if err != nil {
-		logError("client.get_zset_page.values", err)
+		logError(c.logger, "client.get_zset_page.values", err)
 		return nil, 0, err
 	}
 
 	var jobsWithScores []jobScore
 
 	if err := redis.ScanSlice(values, &jobsWithScores); err != nil {
-		logError("client.get_zset_page.scan_slice", err)
+		logError(c.logger, "client.get_zset_page.scan_slice", err)
 		return nil, 0, err
 	}
 
 	for i, jws := range jobsWithScores {
-		job, err := newJob(jws.JobBytes, nil, nil)
+		job, err := c.newJob(jws.JobBytes, "client.get_zset_page.new_job")
 		if err != nil {
-			logError("client.get_zset_page.new_job", err)
 			return nil, 0, err
 		}
 
-		jobsWithScores[i].job = job
+		jobsWithScores[i].job = c.redacted(job)
 	}
 
 	count, err := redis.Int64(conn.Do("ZCARD", key))
 	if err != nil {
-		logError("client.get_zset_page.int64", err)
+		logError(c.logger, "client.get_zset_page.int64", err)
 		return nil, 0, err
 	}
 
 	return jobsWithScores, count, nil
 }
+
+// getFilteredZsetPage is like getZsetPage, but has to go through the whole zset to page through and count the jobs
+// that match filter.
+func (c *Client) getFilteredZsetPage(conn redis.Conn, key string, page uint, filter JobFilter) ([]jobScore, int64, error) {
+	const batchSize = 1000
+	start := int64(page-1) * 20
+
+	var jobsWithScores []jobScore
+	var count int64
+	for offset := 0; ; offset += batchSize {
+		values, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, "-inf", "+inf", "WITHSCORES", "LIMIT", offset, batchSize))
+		if err != nil {
+			logError(c.logger, "client.get_filtered_zset_page.values", err)
+			return nil, 0, err
+		}
+
+		var batch []jobScore
+		if err := redis.ScanSlice(values, &batch); err != nil {
+			logError(c.logger, "client.get_filtered_zset_page.scan_slice", err)
+			return nil, 0, err
+		}
+
+		for _, jws := range batch {
+			job, err := c.newJob(jws.JobBytes, "client.get_filtered_zset_page.new_job")
+			if err != nil {
+				return nil, 0, err
+			}
+			job = c.redacted(job)
+			if !filter.match(job) {
+				continue
+			}
+
+			if count >= start && count < start+20 {
+				jws.job = job
+				jobsWithScores = append(jobsWithScores, jws)
+			}
+			count++
+		}
+
+		if len(batch) < batchSize {
+			return jobsWithScores, count, nil
+		}
+	}
+}
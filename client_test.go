@@ -2,6 +2,7 @@ package work
 
 import (
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -63,6 +64,86 @@ func TestClientWorkerPoolHeartbeats(t *testing.T) {
 	assert.Equal(t, 0, len(hbs))
 }
 
+func TestClientStalePools(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.Start()
+	defer wp.Stop()
+	time.Sleep(20 * time.Millisecond)
+
+	// A pool that died a minute ago, and hasn't been reaped yet.
+	conn := pool.Get()
+	_, err := conn.Do("SADD", redisKeyWorkerPools(ns), "dead")
+	assert.NoError(t, err)
+	_, err = conn.Do("HMSET", redisKeyHeartbeat(ns, "dead"), "heartbeat_at", nowEpochSeconds()-60, "host", "web51")
+	assert.NoError(t, err)
+	conn.Close()
+
+	client := NewClient(ns, pool)
+	hbs, err := client.WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	assert.Len(t, hbs, 2)
+
+	stale, err := client.StalePools()
+	assert.NoError(t, err)
+	if assert.Len(t, stale, 1) {
+		assert.Equal(t, "dead", stale[0].WorkerPoolID)
+		assert.True(t, stale[0].Stale)
+	}
+
+	hbs, err = client.HideStalePools(true).WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	if assert.Len(t, hbs, 1) {
+		assert.Equal(t, wp.workerPoolID, hbs[0].WorkerPoolID)
+		assert.False(t, hbs[0].Stale)
+	}
+
+	// With a threshold of over a minute, the dead pool isn't stale yet.
+	stale, err = client.SetStalePoolThreshold(2 * time.Minute).StalePools()
+	assert.NoError(t, err)
+	assert.Len(t, stale, 0)
+}
+
+func TestClientFlagStale(t *testing.T) {
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	client := NewClient("work", newTestPool(":0"))
+	heartbeat := &WorkerPoolHeartbeat{HeartbeatAt: 1425263400}
+	client.flagStale(heartbeat)
+	assert.False(t, heartbeat.Stale)
+
+	heartbeat.HeartbeatAt = 1425263399
+	client.flagStale(heartbeat)
+	assert.True(t, heartbeat.Stale)
+
+	client.SetStalePoolThreshold(time.Minute).flagStale(heartbeat)
+	assert.False(t, heartbeat.Stale)
+
+	// Pools in the set without a heartbeat at all are stale.
+	heartbeat = &WorkerPoolHeartbeat{}
+	client.flagStale(heartbeat)
+	assert.True(t, heartbeat.Stale)
+}
+
+func TestParseHeartbeatMetadata(t *testing.T) {
+	heartbeat, err := parseHeartbeat("abcd", []string{"host", "worker-7d9f8", "version", "a1b2c3d", "labels", `{"region":"eu-west-1"}`})
+	assert.NoError(t, err)
+	assert.Equal(t, "worker-7d9f8", heartbeat.Host)
+	assert.Equal(t, "a1b2c3d", heartbeat.Version)
+	assert.Equal(t, map[string]string{"region": "eu-west-1"}, heartbeat.Labels)
+
+	// Pools that don't set any leave them out.
+	heartbeat, err = parseHeartbeat("abcd", []string{"host", "web51", "version", "", "labels", ""})
+	assert.NoError(t, err)
+	assert.Equal(t, "", heartbeat.Version)
+	assert.Nil(t, heartbeat.Labels)
+}
+
 func TestClientWorkerObservations(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -166,6 +247,14 @@ func TestClientQueues(t *testing.T) {
 	setNowEpochSecondsMock(1425263609)
 	enqueuer.Enqueue("wat", nil)
 
+	// A job in progress in some pool.
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("SADD", redisKeyWorkerPools(ns), "1")
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsInProgress(ns, "1", "foo"), "{}")
+	assert.NoError(t, err)
+
 	setNowEpochSecondsMock(1425263709)
 	client := NewClient(ns, pool)
 	queues, err := client.Queues()
@@ -175,12 +264,74 @@ func TestClientQueues(t *testing.T) {
 	assert.Equal(t, "foo", queues[0].JobName)
 	assert.EqualValues(t, 2, queues[0].Count)
 	assert.EqualValues(t, 300, queues[0].Latency)
+	assert.EqualValues(t, 300, queues[0].LatencySeconds)
+	assert.EqualValues(t, 1425263409, queues[0].OldestEnqueuedAt)
+	assert.EqualValues(t, 1, queues[0].InProgress)
 	assert.Equal(t, "wat", queues[1].JobName)
 	assert.EqualValues(t, 1, queues[1].Count)
 	assert.EqualValues(t, 100, queues[1].Latency)
 	assert.Equal(t, "zaz", queues[2].JobName)
 	assert.EqualValues(t, 0, queues[2].Count)
 	assert.EqualValues(t, 0, queues[2].Latency)
+	assert.EqualValues(t, 0, queues[2].OldestEnqueuedAt)
+	assert.EqualValues(t, 0, queues[2].InProgress)
+}
+
+func TestNamespaces(t *testing.T) {
+	pool := newTestPool(":6379")
+	for _, ns := range []string{"work", "other:"} {
+		cleanKeyspace(ns, pool)
+		wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+		wp.Job("wat", func(job *Job) error { return nil })
+		wp.Start()
+		wp.Stop()
+	}
+
+	namespaces, err := Namespaces(pool)
+	assert.NoError(t, err)
+	assert.Contains(t, namespaces, "work")
+	assert.Contains(t, namespaces, "other")
+}
+
+func TestClientPauseQueue(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	assert.NoError(t, client.PauseQueue("wat"))
+
+	var processed int64
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		atomic.AddInt64(&processed, 1)
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+	assert.EqualValues(t, 0, atomic.LoadInt64(&processed))
+
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(queues)) {
+		assert.True(t, queues[0].Paused)
+		assert.EqualValues(t, 1, queues[0].Count)
+	}
+
+	assert.NoError(t, client.UnpauseQueue("wat"))
+	wp.Drain()
+	assert.EqualValues(t, 1, atomic.LoadInt64(&processed))
+
+	queues, err = client.Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(queues)) {
+		assert.False(t, queues[0].Paused)
+	}
 }
 
 func TestClientScheduledJobs(t *testing.T) {
@@ -231,6 +382,214 @@ func TestClientScheduledJobs(t *testing.T) {
 	}
 }
 
+func TestClientQueuedJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 25; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+	_, err := enqueuer.EnqueueWithPriority("wat", PriorityHigh, Q{"i": -1})
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	jobs, count, err := client.QueuedJobs("wat", 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 26, count)
+	if assert.Len(t, jobs, 20) {
+		assert.EqualValues(t, -1, jobs[0].ArgInt64("i"))
+		assert.EqualValues(t, 0, jobs[1].ArgInt64("i"))
+		assert.EqualValues(t, 18, jobs[19].ArgInt64("i"))
+	}
+
+	jobs, count, err = client.QueuedJobs("wat", 2)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 26, count)
+	if assert.Len(t, jobs, 6) {
+		assert.EqualValues(t, 19, jobs[0].ArgInt64("i"))
+		assert.EqualValues(t, 24, jobs[5].ArgInt64("i"))
+	}
+
+	jobs, _, err = client.QueuedJobs("wat", 3)
+	assert.NoError(t, err)
+	assert.Empty(t, jobs)
+
+	jobs, count, err = client.QueuedJobs("nope", 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+	assert.Empty(t, jobs)
+}
+
+func TestClientRedactsArgs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	RedactArgs("client_redact_test", "password")
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("client_redact_test", Q{"user": "bob", "password": "hunter2"})
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	jobs, _, err := client.QueuedJobs("client_redact_test", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, Q{"user": "bob", "password": RedactedArg}, Q(jobs[0].Args))
+	}
+	var each []*Job
+	assert.NoError(t, client.EachQueuedJob("client_redact_test", func(job *Job) error {
+		each = append(each, job)
+		return nil
+	}))
+	if assert.Len(t, each, 1) {
+		assert.Equal(t, RedactedArg, each[0].Args["password"])
+	}
+
+	jobs, _, err = client.Unredacted().QueuedJobs("client_redact_test", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, "hunter2", jobs[0].Args["password"])
+	}
+
+	// The stored job is left alone.
+	j := jobOnQueue(pool, redisKeyJobs(ns, "client_redact_test"))
+	assert.Equal(t, "hunter2", j.ArgString("password"))
+}
+
+func TestClientUnknownCodec(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	// A job encoded with a codec that isn't registered in this process, alongside one that's fine.
+	unknown := []byte(`{"name":"wat","id":"1","t":1,"args":{"account_id":"a"},"codec":"nope","payload":"AAAA"}`)
+	known, err := (&Job{Name: "wat", ID: "2", EnqueuedAt: 1, Args: Q{"a": 1}}).serialize()
+	assert.NoError(t, err)
+	conn := pool.Get()
+	defer conn.Close()
+	for _, key := range []string{redisKeyDead(ns), redisKeyScheduled(ns)} {
+		_, err = conn.Do("ZADD", key, 10, unknown, 20, known)
+		assert.NoError(t, err)
+	}
+	_, err = conn.Do("SADD", redisKeyKnownJobs(ns), "wat")
+	assert.NoError(t, err)
+
+	// The job is listed without its encoded args, rather than failing the whole page.
+	client := NewClient(ns, pool)
+	deadJobs, count, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	if assert.Len(t, deadJobs, 2) {
+		assert.Equal(t, "1", deadJobs[0].ID)
+		assert.Equal(t, map[string]interface{}{"account_id": "a"}, deadJobs[0].Args)
+		assert.Equal(t, "2", deadJobs[1].ID)
+	}
+
+	deadJobs, _, err = client.FilterDeadJobs(1, JobFilter{Name: "wat"})
+	assert.NoError(t, err)
+	assert.Len(t, deadJobs, 2)
+
+	deadJob, err := client.DeadJob(10, "1")
+	assert.NoError(t, err)
+	if assert.NotNil(t, deadJob) {
+		assert.Equal(t, "wat", deadJob.Name)
+	}
+
+	scheduledJobs, _, err := client.ScheduledJobs(1)
+	assert.NoError(t, err)
+	assert.Len(t, scheduledJobs, 2)
+
+	assert.NoError(t, client.RetryDeadJob(10, "1"))
+	rawJSON, err := redis.Bytes(conn.Do("LINDEX", redisKeyJobs(ns, "wat"), 0))
+	assert.NoError(t, err)
+	assert.Contains(t, string(rawJSON), `"payload":"AAAA"`)
+}
+
+func TestClientDeleteQueuedJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job1, err := enqueuer.Enqueue("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", Q{"a": 2})
+	assert.NoError(t, err)
+	job3, err := enqueuer.EnqueueWithPriority("wat", PriorityHigh, Q{"a": 3})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	assert.NoError(t, client.DeleteQueuedJob("wat", job1.ID))
+	assert.NoError(t, client.DeleteQueuedJob("wat", job3.ID))
+	assert.Equal(t, ErrNotDeleted, client.DeleteQueuedJob("wat", job1.ID))
+	assert.Equal(t, ErrNotDeleted, client.DeleteQueuedJob("foo", job1.ID))
+
+	jobs, _, err := client.QueuedJobs("wat", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.EqualValues(t, 2, jobs[0].ArgInt64("a"))
+	}
+
+	for i := 0; i < 1500; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+	n, err := client.DeleteQueuedJobsByName("wat", "wat")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1501, n)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+
+	n, err = client.DeleteQueuedJobsByName("nope", "nope")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, n)
+}
+
+func TestClientFlushQueue(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+	_, err := enqueuer.EnqueueWithPriority("wat", PriorityHigh, nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+
+	conn := pool.Get()
+	_, err = conn.Do("SADD", redisKeyWorkerPools(ns), "1")
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsInProgress(ns, "1", "wat"), "{}", "{}")
+	assert.NoError(t, err)
+	conn.Close()
+
+	client := NewClient(ns, pool)
+	n, err := client.FlushQueue("wat")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, n)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobsInProgress(ns, "1", "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+
+	n, err = client.FlushQueueAndInProgress("wat")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, n)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", "wat")))
+
+	n, err = client.FlushQueue("wat")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, n)
+}
+
 func TestClientRetryJobs(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -325,6 +684,72 @@ func TestClientDeadJobs(t *testing.T) {
 	assert.EqualValues(t, 0, count)
 }
 
+func TestClientFilterDeadJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 30; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"account": map[string]interface{}{"id": i % 3}})
+		assert.NoError(t, err)
+	}
+	_, err := enqueuer.Enqueue("foo", Q{"account": map[string]interface{}{"id": 1}})
+	assert.NoError(t, err)
+
+	wp := NewWorkerPool(TestContext{}, 10, ns, pool)
+	for _, name := range []string{"wat", "foo"} {
+		wp.JobWithOptions(name, JobOptions{MaxFails: 1}, func(job *Job) error {
+			return fmt.Errorf("ohno")
+		})
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	client := NewClient(ns, pool)
+	jobs, count, err := client.FilterDeadJobs(1, JobFilter{Name: "wat"})
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 20)
+	assert.EqualValues(t, 30, count)
+
+	jobs, count, err = client.FilterDeadJobs(2, JobFilter{Name: "wat"})
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 10)
+	assert.EqualValues(t, 30, count)
+
+	jobs, count, err = client.FilterDeadJobs(1, JobFilter{ArgPath: "account.id", ArgValue: "1"})
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 11)
+	assert.EqualValues(t, 11, count)
+
+	jobs, count, err = client.FilterDeadJobs(1, JobFilter{Name: "foo", ArgsContains: `"id":1`})
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, "foo", jobs[0].Name)
+	}
+	assert.EqualValues(t, 1, count)
+}
+
+func TestJobFilter(t *testing.T) {
+	job := &Job{Name: "wat", Args: map[string]interface{}{
+		"account": map[string]interface{}{"id": float64(42), "name": "Acme"},
+		"user":    "bob",
+	}}
+
+	assert.True(t, JobFilter{}.match(job))
+	assert.True(t, JobFilter{Name: "wat"}.match(job))
+	assert.False(t, JobFilter{Name: "foo"}.match(job))
+	assert.True(t, JobFilter{ArgsContains: "Acme"}.match(job))
+	assert.False(t, JobFilter{ArgsContains: "acme"}.match(job))
+	assert.True(t, JobFilter{ArgPath: "account.id", ArgValue: "42"}.match(job))
+	assert.True(t, JobFilter{ArgPath: "user", ArgValue: "bob"}.match(job))
+	assert.False(t, JobFilter{ArgPath: "account.id", ArgValue: "4"}.match(job))
+	assert.False(t, JobFilter{ArgPath: "user.id", ArgValue: "bob"}.match(job))
+	assert.False(t, JobFilter{ArgPath: "account.missing", ArgValue: ""}.match(job))
+	assert.False(t, JobFilter{Name: "wat", ArgPath: "user", ArgValue: "alice"}.match(job))
+}
+
 func TestClientDeleteDeadJob(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "testwork"
@@ -410,6 +835,65 @@ func TestClientRetryDeadJob(t *testing.T) {
 	assert.EqualValues(t, 0, job1.FailedAt)
 }
 
+func TestClientRetryDeleteDeadJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	j1 := insertDeadJob(ns, pool, "wat1", 12345, 12347)
+	j2 := insertDeadJob(ns, pool, "wat2", 12345, 12347)
+	j3 := insertDeadJob(ns, pool, "wat3", 12345, 12349)
+	insertDeadJob(ns, pool, "wat4", 12345, 12350)
+
+	client := NewClient(ns, pool)
+	retried, err := client.RetryDeadJobs([]DeadJobRef{{12347, j1.ID}, {12347, j2.ID}, {12347, "nope"}})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, retried)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat1")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat2")))
+
+	deleted, err := client.DeleteDeadJobs([]DeadJobRef{{12349, j3.ID}, {12347, j1.ID}})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, deleted)
+
+	jobs, count, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, "wat4", jobs[0].Name)
+	}
+}
+
+func TestClientRetryDeleteDeadJobsByName(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	for i := int64(0); i < 1500; i++ {
+		insertDeadJob(ns, pool, []string{"wat", "foo", "bar"}[i%3], 12345, 12347+i)
+	}
+
+	client := NewClient(ns, pool)
+	retried, err := client.RetryDeadJobsByName("wat")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 500, retried)
+	assert.EqualValues(t, 500, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	_, err = client.RetryDeadJobsByName("nope")
+	assert.Equal(t, ErrNotRetried, err)
+
+	deleted, err := client.DeleteDeadJobsByName("foo")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 500, deleted)
+
+	_, count, err := client.FilterDeadJobs(1, JobFilter{Name: "bar"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 500, count)
+	_, count, err = client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 500, count)
+}
+
 func TestClientRetryDeadJobWithArgs(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "testwork"
@@ -607,6 +1091,37 @@ func TestClientRetryAllDeadJobsBig(t *testing.T) {
 	assert.Equal(t, "unknown job when requeueing", job.LastErr)
 }
 
+func TestClientDeadJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	_, err := client.DeadJob(3, "bob")
+	assert.Equal(t, ErrJobNotFound, err)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{MaxFails: 1}, func(job *Job) error {
+		panic("dayam")
+	})
+	enqueued, err := NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	jobs, _, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(jobs)) {
+		job, err := client.DeadJob(jobs[0].DiedAt, enqueued.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "dayam", job.LastErr)
+		assert.EqualValues(t, 1, job.Fails)
+		assert.Equal(t, enqueued.EnqueuedAt, job.FirstEnqueuedAt)
+		assert.Contains(t, job.Backtrace, "goroutine")
+	}
+}
+
 func TestClientDeleteScheduledJob(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "testwork"
@@ -649,6 +1164,76 @@ func TestClientDeleteScheduledUniqueJob(t *testing.T) {
 	assert.NotNil(t, j) // Nil? We didn't clear the unique job signature.
 }
 
+func TestClientRunScheduledJobNow(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	err := client.RunScheduledJobNow(3, "bob")
+	assert.Equal(t, ErrNotEnqueued, err)
+
+	enq := NewEnqueuer(ns, pool)
+	j, err := enq.EnqueueIn("foo", 3600, Q{"a": 1})
+	assert.NoError(t, err)
+
+	err = client.RunScheduledJobNow(j.RunAt, j.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+
+	job := jobOnQueue(pool, redisKeyJobs(ns, "foo"))
+	assert.Equal(t, j.ID, job.ID)
+	assert.EqualValues(t, 1, job.ArgInt64("a"))
+
+	// It's not scheduled anymore.
+	err = client.RunScheduledJobNow(j.RunAt, j.ID)
+	assert.Equal(t, ErrNotEnqueued, err)
+}
+
+func TestClientRescheduleJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	client := NewClient(ns, pool)
+	err := client.RescheduleJob("bob", time.Unix(1425263509, 0))
+	assert.Equal(t, ErrJobNotFound, err)
+
+	enq := NewEnqueuer(ns, pool)
+	j, err := enq.EnqueueIn("foo", 3600, Q{"a": 1})
+	assert.NoError(t, err)
+	_, err = enq.EnqueueIn("foo", 60, nil)
+	assert.NoError(t, err)
+
+	err = client.RescheduleJob(j.ID, time.Unix(1425263409+7200, 0))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+	jobs, _, err := client.ScheduledJobs(1)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 2) {
+		assert.Equal(t, j.ID, jobs[1].ID)
+		assert.EqualValues(t, 1425263409+7200, jobs[1].RunAt)
+		assert.EqualValues(t, 1, jobs[1].ArgInt64("a"))
+	}
+
+	// A unique job's lock lasts until a day past its new time.
+	u, err := enq.EnqueueUniqueIn("bar", 60, nil)
+	assert.NoError(t, err)
+	err = client.RescheduleJob(u.ID, time.Unix(1425263409+3*86400, 0))
+	assert.NoError(t, err)
+	uniqueKey, err := redisKeyUniqueJob(ns, "bar", nil)
+	assert.NoError(t, err)
+	conn := pool.Get()
+	defer conn.Close()
+	ttl, err := redis.Int64(conn.Do("TTL", uniqueKey))
+	assert.NoError(t, err)
+	assert.True(t, ttl > 3*86400, "ttl %d", ttl)
+}
+
 func TestClientDeleteRetryJob(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "testwork"
@@ -724,3 +1309,125 @@ func getQueuedJob(ns string, pool *redis.Pool, name string) *Job {
 	}
 	return job
 }
+
+func TestClientPruneDeadJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	insertDeadJob(ns, pool, "wat", 12345, 12347)
+	insertDeadJob(ns, pool, "wat", 12345, 12349)
+	insertDeadJob(ns, pool, "wat", 12345, 12350)
+
+	client := NewClient(ns, pool)
+	n, err := client.PruneDeadJobs(time.Unix(12350, 0))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, n)
+
+	jobs, count, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	if assert.Len(t, jobs, 1) {
+		assert.EqualValues(t, 12350, jobs[0].DiedAt)
+	}
+}
+
+func TestClientRetryDeadJobsWhere(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	for i := int64(0); i < 1500; i++ {
+		job := &Job{
+			Name:     []string{"wat", "foo"}[i%2],
+			ID:       makeIdentifier(),
+			Fails:    3,
+			LastErr:  []string{"dial tcp: connection refused", "invalid input", "invalid input"}[i%3],
+			FailedAt: 10000 + i,
+		}
+		rawJSON, err := job.serialize()
+		assert.NoError(t, err)
+		_, err = conn.Do("ZADD", redisKeyDead(ns), job.FailedAt, rawJSON)
+		assert.NoError(t, err)
+		_, err = conn.Do("SADD", redisKeyKnownJobs(ns), job.Name)
+		assert.NoError(t, err)
+	}
+
+	client := NewClient(ns, pool)
+	retried, err := client.RetryDeadJobsWhere(DeadJobFilter{
+		Name:        "wat",
+		ErrContains: "connection refused",
+		DiedAfter:   time.Unix(10000, 0),
+		DiedBefore:  time.Unix(10600, 0),
+	})
+	assert.NoError(t, err)
+	// Of the first 600 jobs, the even ones are wat, and the multiples of 3 refused the connection.
+	assert.EqualValues(t, 100, retried)
+	assert.EqualValues(t, 100, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1400, zsetSize(pool, redisKeyDead(ns)))
+
+	retried, err = client.RetryDeadJobsWhere(DeadJobFilter{ErrContains: "invalid"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1000, retried)
+	assert.EqualValues(t, 500, listSize(pool, redisKeyJobs(ns, "foo")))
+	assert.EqualValues(t, 400, zsetSize(pool, redisKeyDead(ns)))
+}
+
+func TestClientRequeueingWakesWorkers(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	addDead := func(ids ...string) {
+		conn := pool.Get()
+		defer conn.Close()
+		for i, id := range ids {
+			rawJSON, err := (&Job{Name: "wat", ID: id, Fails: 3, LastErr: "oops", FailedAt: int64(10000 + i)}).serialize()
+			assert.NoError(t, err)
+			_, err = conn.Do("ZADD", redisKeyDead(ns), 10000+i, rawJSON)
+			assert.NoError(t, err)
+			_, err = conn.Do("SADD", redisKeyKnownJobs(ns), "wat")
+			assert.NoError(t, err)
+		}
+	}
+	client := NewClient(ns, pool)
+
+	for name, requeue := range map[string]func() error{
+		"RetryDeadJob": func() error {
+			addDead("a")
+			return client.RetryDeadJob(10000, "a")
+		},
+		"RetryAllDeadJobs": func() error {
+			addDead("a", "b")
+			return client.RetryAllDeadJobs()
+		},
+		"RetryDeadJobsByName": func() error {
+			addDead("a", "b")
+			_, err := client.RetryDeadJobsByName("wat")
+			return err
+		},
+		"RetryDeadJobsWhere": func() error {
+			addDead("a", "b")
+			_, err := client.RetryDeadJobsWhere(DeadJobFilter{Name: "wat"})
+			return err
+		},
+		"RunScheduledJobNow": func() error {
+			job, err := NewEnqueuer(ns, pool).EnqueueIn("wat", 3600, nil)
+			assert.NoError(t, err)
+			cleanKeyspace(ns+":jobs:wat:notify", pool)
+			return client.RunScheduledJobNow(job.RunAt, job.ID)
+		},
+	} {
+		cleanKeyspace(ns, pool)
+		psc := redis.PubSubConn{Conn: pool.Get()}
+		assert.NoError(t, psc.Subscribe(redisChannelJobsWake(ns, "wat")))
+		psc.Receive() // the subscription
+
+		assert.NoError(t, requeue(), name)
+		assert.True(t, listSize(pool, redisKeyJobsNotify(ns, "wat")) > 0, name)
+		msg, ok := psc.ReceiveWithTimeout(time.Second).(redis.Message)
+		assert.True(t, ok, name)
+		assert.Equal(t, redisChannelJobsWake(ns, "wat"), msg.Channel, name)
+		psc.Close()
+	}
+}
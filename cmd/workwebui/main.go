@@ -1,14 +1,17 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
+	work "github.com/teamwork/work/v2"
 	"github.com/teamwork/work/v2/webui"
 )
 
@@ -16,6 +19,18 @@ var (
 	redisHostPort = flag.String("redis", ":6379", "redis hostport")
 	redisDatabase = flag.String("database", "0", "redis database")
 	webHostPort   = flag.String("listen", ":5040", "hostport to listen for HTTP JSON API")
+	basicAuth     = flag.String("basic-auth", os.Getenv("WORKWEBUI_BASIC_AUTH"), "username:password to require with HTTP basic auth (default $WORKWEBUI_BASIC_AUTH)")
+	basePath      = flag.String("base-path", "/", "URL path to serve the UI and API under, eg, when proxied")
+	namespaces    = flag.String("namespaces", "", "comma separated namespaces to list in the overview (default: scan redis)")
+	readOnly      = flag.Bool("read-only", false, "disable retrying and deleting dead jobs")
+	maxCount      = flag.Int64("max-queue-count", 0, "report queues holding more jobs than this at /<namespace>/alerts")
+	maxLatency    = flag.Duration("max-queue-latency", 0, "report queues whose oldest job has waited longer than this at /<namespace>/alerts")
+	readTimeout   = flag.Duration("read-timeout", 0, "HTTP read timeout (default: none)")
+	writeTimeout  = flag.Duration("write-timeout", 0, "HTTP write timeout (default: none)")
+	tlsCert       = flag.String("tls-cert", "", "certificate file to serve HTTPS with")
+	tlsKey        = flag.String("tls-key", "", "key file to serve HTTPS with")
+	sampleDepths  = flag.String("sample-queue-depths", "", "comma separated namespaces to record queue depths of every minute, for /<namespace>/queues/history")
+	shutdownWait  = flag.Duration("shutdown-timeout", 10*time.Second, "how long to wait for requests in progress when quitting")
 )
 
 func main() {
@@ -34,15 +49,60 @@ func main() {
 
 	pool := newPool(*redisHostPort, database)
 
-	server := webui.NewServer(pool, *webHostPort)
-	server.Start()
+	opts := []webui.Option{webui.WithThresholds(map[string]webui.Threshold{
+		webui.DefaultThreshold: {MaxCount: *maxCount, MaxLatency: *maxLatency},
+	})}
+	if *basePath != "/" {
+		opts = append(opts, webui.WithBasePath(*basePath))
+	}
+	if *namespaces != "" {
+		opts = append(opts, webui.WithNamespaces(strings.Split(*namespaces, ",")...))
+	}
+	if *readOnly {
+		opts = append(opts, webui.WithReadOnly())
+	}
+	if *basicAuth != "" {
+		username, password, ok := strings.Cut(*basicAuth, ":")
+		if !ok {
+			fmt.Println("Error: -basic-auth must be username:password")
+			return
+		}
+		opts = append(opts, webui.WithBasicAuth(username, password))
+	}
+
+	if *readTimeout != 0 || *writeTimeout != 0 {
+		opts = append(opts, webui.WithTimeouts(*readTimeout, *writeTimeout))
+	}
+
+	server := webui.NewServer(pool, *webHostPort, opts...)
+	if *tlsCert != "" || *tlsKey != "" {
+		server.StartTLS(*tlsCert, *tlsKey)
+	} else {
+		server.Start()
+	}
+
+	var samplers []*work.QueueDepthSampler
+	if *sampleDepths != "" {
+		for _, ns := range strings.Split(*sampleDepths, ",") {
+			sampler := work.NewQueueDepthSampler(ns, pool)
+			sampler.Start()
+			samplers = append(samplers, sampler)
+		}
+	}
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, os.Kill)
 
 	<-c
 
-	server.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownWait)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		fmt.Println("Error shutting down:", err)
+	}
+	for _, sampler := range samplers {
+		sampler.Stop()
+	}
 
 	fmt.Println("\nQuitting...")
 }
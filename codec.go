@@ -0,0 +1,180 @@
+package work
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// partitionArgCheckPeriod is how often, in seconds, an enqueuer that encodes args reads which arg a job type is
+// partitioned by.
+const partitionArgCheckPeriod = 60
+
+// compressionGzip flags a job whose payload is gzipped.
+const compressionGzip = "gzip"
+
+// Codec encodes and decodes the arguments of jobs, eg, to make them smaller, or quicker to encode, than JSON. The rest
+// of a job is always stored as JSON, as the Lua scripts that move jobs around read it, with the encoded arguments in
+// a base64 payload field. Base64 makes the encoded arguments a third bigger, so a codec only saves space if it encodes
+// them in less than three quarters of the size of their JSON. Set the codec that jobs are enqueued with using Enqueuer.SetCodec, and register it with RegisterCodec (or
+// WithCodec) in every process that reads jobs, ie, worker pools, clients and the web UI.
+//
+// The argument that a job type with a PartitionKey is partitioned by is kept as JSON alongside the encoded arguments,
+// as jobs are partitioned by Redis. Enqueuers learn which job types are partitioned from the worker pools that run
+// them, so jobs enqueued before any of those pools has started aren't partitioned.
+type Codec interface {
+	// Name identifies the codec in the jobs it encodes, so it must be unique, and mustn't change while there are jobs
+	// encoded with it.
+	Name() string
+
+	// Marshal encodes a job's arguments, which are a map[string]interface{}.
+	Marshal(v interface{}) ([]byte, error)
+
+	// Unmarshal decodes a job's arguments into v, which is a *map[string]interface{}.
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// JSONCodec is the default Codec, which stores arguments as JSON within the job.
+var JSONCodec Codec = jsonCodec{}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string                               { return "json" }
+func (jsonCodec) Marshal(v interface{}) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error { return json.Unmarshal(data, v) }
+
+var (
+	codecsMtx sync.RWMutex
+	codecs    = map[string]Codec{JSONCodec.Name(): JSONCodec}
+)
+
+// RegisterCodec lets jobs whose arguments are encoded with c be decoded in this process. It's typically called from an
+// init function. Registering a codec with the same name as another replaces it.
+func RegisterCodec(c Codec) {
+	codecsMtx.Lock()
+	defer codecsMtx.Unlock()
+	codecs[c.Name()] = c
+}
+
+func lookupCodec(name string) (Codec, error) {
+	codecsMtx.RLock()
+	defer codecsMtx.RUnlock()
+	c, ok := codecs[name]
+	if !ok {
+		return nil, fmt.Errorf("work: unknown codec %q; register it with RegisterCodec", name)
+	}
+	return c, nil
+}
+
+// SetCodec makes e encode the arguments of the jobs it enqueues with c, and registers c. Jobs keep their codec when
+// they're retried, and the next steps of a chain use the codec of the first. Like Use, SetCodec must be called before
+// the enqueuer is used.
+// Example: e.SetCodec(msgpack.Codec), with the github.com/teamwork/work/v2/msgpack module
+func (e *Enqueuer) SetCodec(c Codec) *Enqueuer {
+	RegisterCodec(c)
+	e.codec = c
+	return e
+}
+
+// SetCompressionThreshold makes e gzip the arguments of the jobs it enqueues whose encoded arguments are bigger than
+// threshold bytes, to save Redis memory. Arguments that don't shrink by more than the base64 encoding of the compressed
+// payload adds are left uncompressed. Worker pools and clients decompress them transparently. 0, the default,
+// turns compression off. As with codecs, the arg a job type with a PartitionKey is partitioned by is left uncompressed.
+// Like Use, SetCompressionThreshold must be called before the enqueuer is used.
+// Example: e.SetCompressionThreshold(16 << 10)
+func (e *Enqueuer) SetCompressionThreshold(threshold int) *Enqueuer {
+	e.compressAbove = threshold
+	return e
+}
+
+// encodedJob is how a job whose arguments are encoded with a Codec other than JSON, or are compressed, is stored. As
+// the rest of the job is JSON, Payload is base64 encoded, which makes it a third bigger than the encoded arguments.
+type encodedJob struct {
+	*Job
+	Args        map[string]interface{} `json:"args,omitempty"` // shadows Job.Args, which are in Payload
+	Codec       string                 `json:"codec,omitempty"`
+	Compression string                 `json:"compression,omitempty"`
+	Payload     []byte                 `json:"payload,omitempty"`
+}
+
+// payloadSize returns how many bytes payload takes up in a serialized job: as is if it's inline JSON arguments, and
+// base64 encoded otherwise.
+func payloadSize(payload []byte, inline bool) int {
+	if inline {
+		return len(payload)
+	}
+	return base64.StdEncoding.EncodedLen(len(payload))
+}
+
+func gzipPayload(payload []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(payload); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzipPayload(payload []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
+
+// jobPartitionArg is the arg a job type is partitioned by, as read from Redis at checkedAt.
+type jobPartitionArg struct {
+	name      string
+	checkedAt int64
+}
+
+// encodesArgs returns whether e stores the args of jobs other than as JSON, ie, encoded with a codec or compressed.
+func (e *Enqueuer) encodesArgs() bool {
+	return e.codec != nil && e.codec != JSONCodec || e.compressAbove > 0
+}
+
+// partitionArg returns the arg jobName is partitioned by, which worker pools record when they start, if e encodes args.
+// It's read from Redis if it hasn't been for partitionArgCheckPeriod. If it can't be read, the last value read is used.
+func (e *Enqueuer) partitionArg(conn redis.Conn, jobName string) string {
+	if !e.encodesArgs() {
+		return ""
+	}
+	now := time.Now().Unix()
+
+	e.mtx.RLock()
+	arg, ok := e.partitionArgs[jobName]
+	e.mtx.RUnlock()
+
+	if ok && now < arg.checkedAt+partitionArgCheckPeriod {
+		return arg.name
+	}
+
+	name, err := redis.String(conn.Do("GET", redisKeyJobsPartition(e.Namespace, jobName)))
+	if err != nil && err != redis.ErrNil {
+		return arg.name
+	}
+
+	e.mtx.Lock()
+	e.partitionArgs[jobName] = jobPartitionArg{name: name, checkedAt: now}
+	e.mtx.Unlock()
+
+	return name
+}
+
+// serialize serializes job, keeping the arg its job type is partitioned by as JSON if e encodes args.
+func (e *Enqueuer) serialize(conn redis.Conn, job *Job) ([]byte, error) {
+	job.partitionArg = e.partitionArg(conn, job.Name)
+	return job.serialize()
+}
@@ -0,0 +1,195 @@
+package work
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+// reversedCodec stores args as backwards JSON, so tests can tell it apart from JSONCodec.
+type reversedCodec struct{}
+
+func (reversedCodec) Name() string { return "reversed" }
+
+func (reversedCodec) Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	return reverseBytes(data), err
+}
+
+func (reversedCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(reverseBytes(append([]byte(nil), data...)), v)
+}
+
+func reverseBytes(b []byte) []byte {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return b
+}
+
+func TestCodec(t *testing.T) {
+	RegisterCodec(reversedCodec{})
+
+	job := &Job{Name: "wat", ID: "1", Args: Q{"a": 1}, Chain: []ChainStep{{Name: "next"}}, codec: reversedCodec{}}
+	rawJSON, err := job.serialize()
+	assert.NoError(t, err)
+
+	var stored map[string]interface{}
+	assert.NoError(t, json.Unmarshal(rawJSON, &stored))
+	assert.Equal(t, "reversed", stored["codec"])
+	assert.NotContains(t, stored, "args")
+
+	decoded, err := newJob(rawJSON, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "wat", decoded.Name)
+	assert.EqualValues(t, 1, decoded.ArgInt64("a"))
+	assert.Equal(t, reversedCodec{}, decoded.codec)
+
+	next := nextChainJob(decoded)
+	assert.Equal(t, reversedCodec{}, next.codec)
+
+	// JSON jobs are stored as they always have been.
+	rawJSON, err = (&Job{Name: "wat", ID: "1", Args: Q{"a": 1}}).serialize()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"wat","id":"1","t":0,"args":{"a":1},"pool_id":""}`, string(rawJSON))
+	decoded, err = newJob(rawJSON, nil, nil)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, decoded.ArgInt64("a"))
+	assert.Nil(t, decoded.codec)
+
+	_, err = newJob([]byte(`{"name":"wat","codec":"nope","payload":""}`), nil, nil)
+	assert.EqualError(t, err, `work: unknown codec "nope"; register it with RegisterCodec`)
+}
+
+func TestEnqueuerSetCodec(t *testing.T) {
+	pool := newTestPool(":0")
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, "work", pool, WithCodec(reversedCodec{}))
+	var got int64
+	wp.Job("wat", func(job *Job) error {
+		got = job.ArgInt64("a")
+		return nil
+	})
+
+	enqueuer := NewEnqueuer("work", pool).SetCodec(reversedCodec{}).RunInline(wp)
+	_, err := enqueuer.Enqueue("wat", Q{"a": 3})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, got)
+}
+
+func TestCompression(t *testing.T) {
+	big := strings.Repeat("a", 1000)
+	job := &Job{Name: "wat", ID: "1", Args: Q{"big": big}, compressAbove: 100}
+	rawJSON, err := job.serialize()
+	assert.NoError(t, err)
+	assert.True(t, len(rawJSON) < 500)
+
+	var stored map[string]interface{}
+	assert.NoError(t, json.Unmarshal(rawJSON, &stored))
+	assert.Equal(t, "json", stored["codec"])
+	assert.Equal(t, "gzip", stored["compression"])
+
+	decoded, err := newJob(rawJSON, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, big, decoded.ArgString("big"))
+	assert.Equal(t, 1, decoded.compressAbove)
+
+	// Small args are left alone.
+	rawJSON, err = (&Job{Name: "wat", ID: "1", Args: Q{"a": 1}, compressAbove: 100}).serialize()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"wat","id":"1","t":0,"args":{"a":1},"pool_id":""}`, string(rawJSON))
+
+	// As are args that gzip doesn't shrink by more than base64 encoding the result adds, such as random tokens.
+	noise := make([]byte, 1500)
+	rand.New(rand.NewSource(1)).Read(noise)
+	token := base64.StdEncoding.EncodeToString(noise)
+	rawJSON, err = (&Job{Name: "wat", ID: "1", Args: Q{"token": token}, compressAbove: 100}).serialize()
+	assert.NoError(t, err)
+	assert.NotContains(t, string(rawJSON), "compression")
+	assert.Contains(t, string(rawJSON), token)
+
+	RegisterCodec(reversedCodec{})
+	job = &Job{Name: "wat", ID: "1", Args: Q{"big": big}, codec: reversedCodec{}, compressAbove: 100}
+	rawJSON, err = job.serialize()
+	assert.NoError(t, err)
+	decoded, err = newJob(rawJSON, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, big, decoded.ArgString("big"))
+
+	_, err = newJob([]byte(`{"name":"wat","codec":"json","compression":"lz4","payload":""}`), nil, nil)
+	assert.EqualError(t, err, `work: unknown compression "lz4"`)
+}
+
+func TestCodecPartitionKey(t *testing.T) {
+	RegisterCodec(reversedCodec{})
+
+	// The partition arg is kept as JSON alongside the payload, and the job keeps it when it's serialized again.
+	job := &Job{Name: "wat", ID: "1", Args: Q{"account_id": "a", "n": 1}, codec: reversedCodec{}, partitionArg: "account_id"}
+	rawJSON, err := job.serialize()
+	assert.NoError(t, err)
+	var stored map[string]interface{}
+	assert.NoError(t, json.Unmarshal(rawJSON, &stored))
+	assert.Equal(t, map[string]interface{}{"account_id": "a"}, stored["args"])
+	decoded, err := newJob(rawJSON, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"account_id": "a", "n": float64(1)}, decoded.Args)
+	assert.Equal(t, "account_id", decoded.partitionArg)
+
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var locked string
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{PartitionKey: "account_id"}, func(job *Job) error {
+		conn := pool.Get()
+		defer conn.Close()
+		locked, _ = redis.String(conn.Do("GET", redisKeyJobsPartitionLock(ns, "wat", job.ArgString("account_id"))))
+		return nil
+	})
+	wp.Start()
+	wp.Stop()
+
+	// The enqueuer learns the partition arg from Redis, where the pool recorded it.
+	enqueuer := NewEnqueuer(ns, pool).SetCodec(reversedCodec{})
+	job, err = enqueuer.Enqueue("wat", Q{"account_id": "a"})
+	assert.NoError(t, err)
+	conn := pool.Get()
+	rawJSON, err = redis.Bytes(conn.Do("LINDEX", redisKeyJobs(ns, "wat"), 0))
+	conn.Close()
+	assert.NoError(t, err)
+	assert.Contains(t, string(rawJSON), `"args":{"account_id":"a"}`)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.Equal(t, job.ID, locked)
+}
+
+func TestCompressionPartitionKey(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("SET", redisKeyJobsPartition(ns, "wat"), "account_id")
+	assert.NoError(t, err)
+
+	big := strings.Repeat("a", 1000)
+	enqueuer := NewEnqueuer(ns, pool).SetCompressionThreshold(100)
+	_, err = enqueuer.Enqueue("wat", Q{"account_id": "a", "big": big})
+	assert.NoError(t, err)
+
+	rawJSON, err := redis.Bytes(conn.Do("LINDEX", redisKeyJobs(ns, "wat"), 0))
+	assert.NoError(t, err)
+	assert.Contains(t, string(rawJSON), `"compression":"gzip"`)
+	assert.Contains(t, string(rawJSON), `"args":{"account_id":"a"}`)
+	job, err := newJob(rawJSON, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, big, job.ArgString("big"))
+}
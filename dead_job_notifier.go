@@ -0,0 +1,74 @@
+package work
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const deadJobWebhookTimeout = 10 * time.Second
+
+// DeadJobNotification is the JSON payload sent when a job is moved to the dead queue. See
+// WorkerPoolOptions.DeadJobWebhookURL and WorkerPoolOptions.DeadJobChannel.
+type DeadJobNotification struct {
+	Namespace string `json:"namespace"`
+	Job       *Job   `json:"job"` // LastErr, Fails and FailedAt say why and when the job died
+}
+
+type deadJobNotifier struct {
+	namespace  string
+	pool       Pool
+	webhookURL string
+	channel    string
+	httpClient *http.Client
+	logger     Logger
+}
+
+func newDeadJobNotifier(namespace string, pool Pool, webhookURL, channel string, logger Logger) *deadJobNotifier {
+	return &deadJobNotifier{
+		namespace:  namespace,
+		pool:       pool,
+		webhookURL: webhookURL,
+		channel:    channel,
+		httpClient: &http.Client{Timeout: deadJobWebhookTimeout},
+		logger:     logger,
+	}
+}
+
+// notify is an OnJobDead hook.
+func (n *deadJobNotifier) notify(job *Job, _ error) {
+	payload, err := json.Marshal(DeadJobNotification{Namespace: n.namespace, Job: job.redacted()})
+	if err != nil {
+		logError(n.logger, "dead_job_notifier.serialize", err)
+		return
+	}
+
+	if n.channel != "" {
+		conn := n.pool.Get()
+		_, err := conn.Do("PUBLISH", n.channel, payload)
+		conn.Close()
+		if err != nil {
+			logError(n.logger, "dead_job_notifier.publish", err)
+		}
+	}
+
+	if n.webhookURL != "" {
+		// Don't hold up the worker while waiting on a slow webhook.
+		go n.post(payload)
+	}
+}
+
+func (n *deadJobNotifier) post(payload []byte) {
+	resp, err := n.httpClient.Post(n.webhookURL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		logError(n.logger, "dead_job_notifier.post", err)
+		return
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		logError(n.logger, "dead_job_notifier.post", fmt.Errorf("webhook responded with %s", resp.Status))
+	}
+}
@@ -0,0 +1,81 @@
+package work
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadJobNotifierWebhook(t *testing.T) {
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies <- b
+	}))
+	defer srv.Close()
+
+	n := newDeadJobNotifier("work", nil, srv.URL, "", nil)
+	job := &Job{Name: "wat", ID: "1", Fails: 3, LastErr: "sorry kid"}
+	n.notify(job, nil)
+
+	select {
+	case b := <-bodies:
+		var notification DeadJobNotification
+		assert.NoError(t, json.Unmarshal(b, &notification))
+		assert.Equal(t, "work", notification.Namespace)
+		assert.Equal(t, "wat", notification.Job.Name)
+		assert.Equal(t, "1", notification.Job.ID)
+		assert.EqualValues(t, 3, notification.Job.Fails)
+		assert.Equal(t, "sorry kid", notification.Job.LastErr)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook wasn't called")
+	}
+}
+
+func TestDeadJobNotifierChannel(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	psc := redis.PubSubConn{Conn: pool.Get()}
+	defer psc.Close()
+	assert.NoError(t, psc.Subscribe("work-dead"))
+	_, ok := psc.Receive().(redis.Subscription)
+	assert.True(t, ok)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{DeadJobChannel: "work-dead"})
+	wp.JobWithOptions("broken", JobOptions{MaxFails: 1}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+	wp.JobWithOptions("ok", JobOptions{MaxFails: 1}, func(job *Job) error {
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("ok", nil)
+	assert.NoError(t, err)
+	dead, err := enqueuer.Enqueue("broken", nil)
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	msg, ok := psc.Receive().(redis.Message)
+	if assert.True(t, ok) {
+		var notification DeadJobNotification
+		assert.NoError(t, json.Unmarshal(msg.Data, &notification))
+		assert.Equal(t, ns, notification.Namespace)
+		assert.Equal(t, dead.ID, notification.Job.ID)
+		assert.Equal(t, "sorry kid", notification.Job.LastErr)
+	}
+}
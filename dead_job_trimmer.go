@@ -0,0 +1,184 @@
+package work
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	deadJobTrimPeriod   = time.Minute
+	deadJobArchiveBatch = 1000
+)
+
+// DeadJobArchiver is called with the dead jobs that a worker pool's retention, see WorkerPoolOptions.DeadJobMaxCount
+// and DeadJobMaxAge, is about to delete, eg, to keep them in S3, a file, or behind an HTTP endpoint. It's called with
+// batches of up to 1000 jobs, oldest first, and the jobs are only deleted once it returns nil; if it returns an error,
+// it's logged and the jobs are kept until the next trim. Jobs may be archived more than once, eg, if several pools
+// trim at the same time, so archives should tolerate duplicates, say, by the job's ID and DiedAt. Jobs that can't be
+// decoded, eg, as their codec isn't registered in the pool's process, hold up the trim until they can be.
+type DeadJobArchiver func(jobs []*DeadJob) error
+
+// ArchiveDeadJobsTo returns a DeadJobArchiver that writes each dead job to w as a line of JSON, with its arguments
+// redacted as per RedactArgs. w is written to from a single goroutine.
+// Example: work.WithDeadJobArchiver(work.ArchiveDeadJobsTo(file))
+func ArchiveDeadJobsTo(w io.Writer) DeadJobArchiver {
+	enc := json.NewEncoder(w)
+	return func(jobs []*DeadJob) error {
+		for _, job := range jobs {
+			if err := enc.Encode(&DeadJob{DiedAt: job.DiedAt, Job: job.redacted()}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// deadJobTrimmer enforces a worker pool's dead job retention, removing the dead jobs that are too old or too many.
+type deadJobTrimmer struct {
+	namespace string
+	pool      Pool
+	maxCount  int64           // 0 means no limit
+	maxAge    time.Duration   // 0 means no limit
+	archive   DeadJobArchiver // nil means the trimmed jobs aren't archived
+	period    time.Duration
+	logger    Logger
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+func newDeadJobTrimmer(namespace string, pool Pool, maxCount int64, maxAge time.Duration, logger Logger) *deadJobTrimmer {
+	return &deadJobTrimmer{
+		namespace:        namespace,
+		pool:             pool,
+		maxCount:         maxCount,
+		maxAge:           maxAge,
+		period:           deadJobTrimPeriod,
+		logger:           logger,
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}
+}
+
+func (t *deadJobTrimmer) start() {
+	go t.loop()
+}
+
+func (t *deadJobTrimmer) stop() {
+	t.stopChan <- struct{}{}
+	<-t.doneStoppingChan
+}
+
+func (t *deadJobTrimmer) loop() {
+	ticker := time.NewTicker(t.period)
+	defer ticker.Stop()
+
+	t.trim()
+	for {
+		select {
+		case <-t.stopChan:
+			t.doneStoppingChan <- struct{}{}
+			return
+		case <-ticker.C:
+			t.trim()
+		}
+	}
+}
+
+// trim removes the dead jobs that died more than maxAge ago, and then the oldest of those left over maxCount.
+func (t *deadJobTrimmer) trim() {
+	conn := t.pool.Get()
+	defer conn.Close()
+
+	if t.archive != nil {
+		for {
+			n, err := t.archiveBatch(conn)
+			if err != nil || n < deadJobArchiveBatch {
+				return
+			}
+		}
+	}
+
+	key := redisKeyDead(t.namespace)
+	if t.maxAge > 0 {
+		diedBefore := nowEpochSeconds() - int64(t.maxAge/time.Second)
+		if _, err := conn.Do("ZREMRANGEBYSCORE", key, "-inf", "("+strconv.FormatInt(diedBefore, 10)); err != nil {
+			logError(t.logger, "dead_job_trimmer.trim.max_age", err)
+		}
+	}
+	if t.maxCount > 0 {
+		if _, err := conn.Do("ZREMRANGEBYRANK", key, 0, -t.maxCount-1); err != nil {
+			logError(t.logger, "dead_job_trimmer.trim.max_count", err)
+		}
+	}
+}
+
+// archiveBatch passes up to deadJobArchiveBatch of the dead jobs that should be trimmed to the archiver, and then
+// removes them, returning how many there were.
+func (t *deadJobTrimmer) archiveBatch(conn redis.Conn) (int, error) {
+	key := redisKeyDead(t.namespace)
+
+	var values []interface{}
+	var err error
+	if t.maxAge > 0 {
+		diedBefore := nowEpochSeconds() - int64(t.maxAge/time.Second)
+		values, err = redis.Values(conn.Do("ZRANGEBYSCORE", key, "-inf", "("+strconv.FormatInt(diedBefore, 10), "WITHSCORES", "LIMIT", 0, deadJobArchiveBatch))
+		if err != nil {
+			logError(t.logger, "dead_job_trimmer.archive.max_age", err)
+			return 0, err
+		}
+	}
+	if len(values) == 0 && t.maxCount > 0 {
+		count, err := redis.Int64(conn.Do("ZCARD", key))
+		if err != nil {
+			logError(t.logger, "dead_job_trimmer.archive.zcard", err)
+			return 0, err
+		}
+		if over := count - t.maxCount; over > 0 {
+			if over > deadJobArchiveBatch {
+				over = deadJobArchiveBatch
+			}
+			values, err = redis.Values(conn.Do("ZRANGE", key, 0, over-1, "WITHSCORES"))
+			if err != nil {
+				logError(t.logger, "dead_job_trimmer.archive.max_count", err)
+				return 0, err
+			}
+		}
+	}
+
+	var batch []jobScore
+	if err := redis.ScanSlice(values, &batch); err != nil {
+		logError(t.logger, "dead_job_trimmer.archive.scan_slice", err)
+		return 0, err
+	}
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	jobs := make([]*DeadJob, 0, len(batch))
+	args := make([]interface{}, 0, len(batch)+1)
+	args = append(args, key)
+	for _, jws := range batch {
+		args = append(args, jws.JobBytes)
+		job, err := newJob(jws.JobBytes, nil, nil)
+		if err != nil {
+			logError(t.logger, "dead_job_trimmer.archive.new_job", err)
+			return 0, err
+		}
+		jobs = append(jobs, &DeadJob{DiedAt: jws.Score, Job: job})
+	}
+
+	if err := t.archive(jobs); err != nil {
+		logError(t.logger, "dead_job_trimmer.archive", err)
+		return 0, err
+	}
+	if _, err := conn.Do("ZREM", args...); err != nil {
+		logError(t.logger, "dead_job_trimmer.archive.zrem", err)
+		return 0, err
+	}
+	return len(batch), nil
+}
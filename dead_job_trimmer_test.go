@@ -0,0 +1,85 @@
+package work
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadJobTrimmer(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := nowEpochSeconds()
+	insertDeadJob(ns, pool, "wat", now-7200, now-7200)
+	insertDeadJob(ns, pool, "wat", now-300, now-300)
+	insertDeadJob(ns, pool, "wat", now-200, now-200)
+	insertDeadJob(ns, pool, "wat", now-100, now-100)
+
+	trimmer := newDeadJobTrimmer(ns, pool, 0, time.Hour, nil)
+	trimmer.trim()
+	assert.EqualValues(t, 3, zsetSize(pool, redisKeyDead(ns)))
+
+	trimmer = newDeadJobTrimmer(ns, pool, 2, 0, nil)
+	trimmer.trim()
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyDead(ns)))
+
+	jobs, _, err := NewClient(ns, pool).DeadJobs(1)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 2) {
+		assert.EqualValues(t, now-100, jobs[0].DiedAt)
+		assert.EqualValues(t, now-200, jobs[1].DiedAt)
+	}
+}
+
+func TestWorkerPoolDeadJobRetention(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	insertDeadJob(ns, pool, "wat", 1, 1)
+	insertDeadJob(ns, pool, "wat", 2, 2)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WithDeadJobRetention(1, 0))
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.Start()
+	wp.Stop()
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+}
+
+func TestDeadJobTrimmerArchive(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := nowEpochSeconds()
+	old := insertDeadJob(ns, pool, "wat", now-7200, now-7200)
+	insertDeadJob(ns, pool, "wat", now-300, now-300)
+	insertDeadJob(ns, pool, "wat", now-200, now-200)
+	insertDeadJob(ns, pool, "wat", now-100, now-100)
+
+	trimmer := newDeadJobTrimmer(ns, pool, 2, time.Hour, nil)
+	trimmer.archive = func(jobs []*DeadJob) error { return fmt.Errorf("bucket unavailable") }
+	trimmer.trim()
+	assert.EqualValues(t, 4, zsetSize(pool, redisKeyDead(ns)))
+
+	var buf bytes.Buffer
+	trimmer.archive = ArchiveDeadJobsTo(&buf)
+	trimmer.trim()
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyDead(ns)))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if assert.Len(t, lines, 2) {
+		var archived DeadJob
+		assert.NoError(t, json.Unmarshal([]byte(lines[0]), &archived))
+		assert.Equal(t, old.ID, archived.ID)
+		assert.EqualValues(t, now-7200, archived.DiedAt)
+	}
+}
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -14,26 +15,41 @@ const (
 	reapPeriod        = 10 * time.Minute
 	reapJitterSecs    = 30
 	requeueKeysPerJob = 4
+	maxReaperEvents   = 1000 // the number of ReaperEvents kept in Redis
 )
 
+// ReaperEvent records a worker pool cleaning up after a dead worker pool, ie, one that's stopped sending heartbeats
+// without stopping cleanly. See WorkerPool.OnReap and Client.ReaperEvents.
+type ReaperEvent struct {
+	At           int64    `json:"at"`
+	ReaperPoolID string   `json:"reaper_pool_id"`          // The worker pool that did the cleaning up
+	DeadPoolID   string   `json:"dead_pool_id"`            // The dead worker pool
+	RequeuedJobs []string `json:"requeued_jobs,omitempty"` // IDs of the jobs the dead pool had in progress, which were requeued
+	ResetLocks   []string `json:"reset_locks,omitempty"`   // Names of the job types the dead pool was holding concurrency locks on
+}
+
 type deadPoolReaper struct {
-	namespace   string
-	pool        *redis.Pool
-	deadTime    time.Duration
-	reapPeriod  time.Duration
-	curJobTypes []string
+	namespace    string
+	pool         Pool
+	deadTime     time.Duration
+	reapPeriod   time.Duration
+	curJobTypes  []string
+	logger       Logger
+	workerPoolID string               // the pool the reaper belongs to, for ReaperEvents
+	onReap       []func(*ReaperEvent) // see WorkerPool.OnReap
 
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
 }
 
-func newDeadPoolReaper(namespace string, pool *redis.Pool, curJobTypes []string) *deadPoolReaper {
+func newDeadPoolReaper(namespace string, pool Pool, curJobTypes []string, logger Logger) *deadPoolReaper {
 	return &deadPoolReaper{
 		namespace:        namespace,
 		pool:             pool,
 		deadTime:         deadTime,
 		reapPeriod:       reapPeriod,
 		curJobTypes:      curJobTypes,
+		logger:           logger,
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
 	}
@@ -64,7 +80,7 @@ func (r *deadPoolReaper) loop() {
 
 			// Reap
 			if err := r.reap(); err != nil {
-				logError("dead_pool_reaper.reap", err)
+				logError(r.logger, "dead_pool_reaper.reap", err)
 			}
 		}
 	}
@@ -84,10 +100,14 @@ func (r *deadPoolReaper) reap() error {
 
 	// Cleanup all dead pools
 	for deadPoolID, jobTypes := range deadPoolIDs {
+		event := &ReaperEvent{At: nowEpochSeconds(), ReaperPoolID: r.workerPoolID, DeadPoolID: deadPoolID}
 		lockJobTypes := jobTypes
 		// if we found jobs from the heartbeat, requeue them and remove the heartbeat
 		if len(jobTypes) > 0 {
-			r.requeueInProgressJobs(deadPoolID, jobTypes)
+			event.RequeuedJobs, err = r.requeueInProgressJobs(deadPoolID, jobTypes)
+			if err != nil {
+				logError(r.logger, "dead_pool_reaper.requeue_in_progress_jobs", err)
+			}
 			if _, err = conn.Do("DEL", redisKeyHeartbeat(r.namespace, deadPoolID)); err != nil {
 				return err
 			}
@@ -96,38 +116,71 @@ func (r *deadPoolReaper) reap() error {
 			lockJobTypes = r.curJobTypes
 		}
 		// Cleanup any stale lock info
-		if err = r.cleanStaleLockInfo(deadPoolID, lockJobTypes); err != nil {
+		if event.ResetLocks, err = r.cleanStaleLockInfo(deadPoolID, lockJobTypes); err != nil {
 			return err
 		}
 		// Remove dead pool from worker pools set
 		if _, err = conn.Do("SREM", workerPoolsKey, deadPoolID); err != nil {
 			return err
 		}
+
+		r.recordEvent(conn, event)
 	}
 
 	return nil
 }
 
-func (r *deadPoolReaper) cleanStaleLockInfo(poolID string, jobTypes []string) error {
+// recordEvent adds event to the ReaperEvents kept in Redis and calls the OnReap hooks with it.
+func (r *deadPoolReaper) recordEvent(conn redis.Conn, event *ReaperEvent) {
+	rawJSON, err := json.Marshal(event)
+	if err != nil {
+		logError(r.logger, "dead_pool_reaper.record_event.marshal", err)
+	} else {
+		conn.Send("LPUSH", redisKeyReaperEvents(r.namespace), rawJSON)
+		conn.Send("LTRIM", redisKeyReaperEvents(r.namespace), 0, maxReaperEvents-1)
+		if err := conn.Flush(); err != nil {
+			logError(r.logger, "dead_pool_reaper.record_event", err)
+		}
+		conn.Receive()
+		conn.Receive()
+	}
+
+	for _, fn := range r.onReap {
+		fn(event)
+	}
+}
+
+// cleanStaleLockInfo releases the concurrency locks held by the dead pool with poolID, returning the names of the job
+// types it held any on.
+func (r *deadPoolReaper) cleanStaleLockInfo(poolID string, jobTypes []string) ([]string, error) {
 	numKeys := len(jobTypes) * 2
 	redisReapLocksScript := redis.NewScript(numKeys, redisLuaReapStaleLocks)
 	var scriptArgs = make([]interface{}, 0, numKeys+1) // +1 for argv[1]
 
+	jobTypesByLock := make(map[string]string, len(jobTypes))
 	for _, jobType := range jobTypes {
-		scriptArgs = append(scriptArgs, redisKeyJobsLock(r.namespace, jobType), redisKeyJobsLockInfo(r.namespace, jobType))
+		lockKey := redisKeyJobsLock(r.namespace, jobType)
+		jobTypesByLock[lockKey] = jobType
+		scriptArgs = append(scriptArgs, lockKey, redisKeyJobsLockInfo(r.namespace, jobType))
 	}
 	scriptArgs = append(scriptArgs, poolID) // ARGV[1]
 
 	conn := r.pool.Get()
 	defer conn.Close()
-	if _, err := redisReapLocksScript.Do(conn, scriptArgs...); err != nil {
-		return err
+	locks, err := redis.Strings(redisReapLocksScript.Do(conn, scriptArgs...))
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	var reset []string
+	for _, lockKey := range locks {
+		reset = append(reset, jobTypesByLock[lockKey])
+	}
+	return reset, nil
 }
 
-func (r *deadPoolReaper) requeueInProgressJobs(poolID string, jobTypes []string) error {
+// requeueInProgressJobs moves the jobs in progress in the pool with poolID back to their queues, returning their IDs.
+func (r *deadPoolReaper) requeueInProgressJobs(poolID string, jobTypes []string) ([]string, error) {
 	numKeys := len(jobTypes) * requeueKeysPerJob
 	redisRequeueScript := redis.NewScript(numKeys, redisLuaReenqueueJob)
 	var scriptArgs = make([]interface{}, 0, numKeys+1)
@@ -142,16 +195,23 @@ func (r *deadPoolReaper) requeueInProgressJobs(poolID string, jobTypes []string)
 	defer conn.Close()
 
 	// Keep moving jobs until all queues are empty
+	var requeued []string
 	for {
 		values, err := redis.Values(redisRequeueScript.Do(conn, scriptArgs...))
 		if err == redis.ErrNil {
-			return nil
+			return requeued, nil
 		} else if err != nil {
-			return err
+			return requeued, err
 		}
 
 		if len(values) != 3 {
-			return fmt.Errorf("need 3 elements back")
+			return requeued, fmt.Errorf("need 3 elements back")
+		}
+
+		if rawJSON, ok := values[0].([]byte); ok {
+			if job, err := newJob(rawJSON, nil, nil); err == nil {
+				requeued = append(requeued, job.ID)
+			}
 		}
 	}
 }
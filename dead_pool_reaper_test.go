@@ -48,7 +48,7 @@ func TestDeadPoolReaper(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Test getting dead pool
-	reaper := newDeadPoolReaper(ns, pool, []string{})
+	reaper := newDeadPoolReaper(ns, pool, []string{}, nil)
 	deadPools, err := reaper.findDeadPools()
 	assert.NoError(t, err)
 	assert.Equal(t, map[string][]string{"2": {"type1", "type2"}, "3": {"type1", "type2"}}, deadPools)
@@ -127,7 +127,7 @@ func TestDeadPoolReaperNoHeartbeat(t *testing.T) {
 	assert.EqualValues(t, 3, numPools)
 
 	// Test getting dead pool ids
-	reaper := newDeadPoolReaper(ns, pool, []string{"type1"})
+	reaper := newDeadPoolReaper(ns, pool, []string{"type1"}, nil)
 	deadPools, err := reaper.findDeadPools()
 	assert.NoError(t, err)
 	assert.Equal(t, map[string][]string{"1": {}, "2": {}, "3": {}}, deadPools)
@@ -210,7 +210,7 @@ func TestDeadPoolReaperNoJobTypes(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Test getting dead pool
-	reaper := newDeadPoolReaper(ns, pool, []string{})
+	reaper := newDeadPoolReaper(ns, pool, []string{}, nil)
 	deadPools, err := reaper.findDeadPools()
 	assert.NoError(t, err)
 	assert.Equal(t, map[string][]string{"2": {"type1", "type2"}}, deadPools)
@@ -271,7 +271,7 @@ func TestDeadPoolReaperWithWorkerPools(t *testing.T) {
 	_, err = conn.Do("LPUSH", redisKeyJobsInProgress(ns, stalePoolID, job1), `{"sleep": 10}`)
 	assert.NoError(t, err)
 	jobTypes := map[string]*jobType{"job1": nil}
-	staleHeart := newWorkerPoolHeartbeater(ns, pool, stalePoolID, jobTypes, 1, []string{"id1"})
+	staleHeart := newWorkerPoolHeartbeater(ns, pool, stalePoolID, jobTypes, 1, []string{"id1"}, nil)
 	staleHeart.start()
 
 	// should have 1 stale job and empty job queue
@@ -280,7 +280,7 @@ func TestDeadPoolReaperWithWorkerPools(t *testing.T) {
 
 	// setup a worker pool and start the reaper, which should restart the stale job above
 	wp := setupTestWorkerPool(pool, ns, job1, 1, JobOptions{Priority: 1})
-	wp.deadPoolReaper = newDeadPoolReaper(wp.namespace, wp.pool, []string{"job1"})
+	wp.deadPoolReaper = newDeadPoolReaper(wp.namespace, wp.pool, []string{"job1"}, nil)
 	wp.deadPoolReaper.deadTime = expectedDeadTime
 	wp.deadPoolReaper.start()
 
@@ -324,18 +324,20 @@ func TestDeadPoolReaperCleanStaleLocks(t *testing.T) {
 	err = conn.Flush()
 	assert.NoError(t, err)
 
-	reaper := newDeadPoolReaper(ns, pool, jobNames)
+	reaper := newDeadPoolReaper(ns, pool, jobNames, nil)
 	// clean lock info for workerPoolID1
-	reaper.cleanStaleLockInfo(workerPoolID1, jobNames)
+	reset, err := reaper.cleanStaleLockInfo(workerPoolID1, jobNames)
 	assert.NoError(t, err)
+	assert.Equal(t, []string{job1}, reset)
 	assert.EqualValues(t, 2, getInt64(pool, lock1))   // job1 lock should be decr by 1
 	assert.EqualValues(t, 1, getInt64(pool, lock2))   // job2 lock is unchanged
 	v, _ := conn.Do("HGET", lockInfo1, workerPoolID1) // workerPoolID1 removed from job1's lock info
 	assert.Nil(t, v)
 
 	// now clean lock info for workerPoolID2
-	reaper.cleanStaleLockInfo(workerPoolID2, jobNames)
+	reset, err = reaper.cleanStaleLockInfo(workerPoolID2, jobNames)
 	assert.NoError(t, err)
+	assert.Equal(t, []string{job1, job2}, reset)
 	assert.EqualValues(t, 0, getInt64(pool, lock1))
 	// lock should be able to go below 0 as
 	// - reaper may requeue jobs from an alive worker
@@ -348,3 +350,51 @@ func TestDeadPoolReaperCleanStaleLocks(t *testing.T) {
 	v, err = conn.Do("HGET", lockInfo2, workerPoolID2)
 	assert.Nil(t, v)
 }
+
+func TestDeadPoolReaperEvents(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	// Worker pool 2 died while running a job, and holding a lock on type1 besides the job's own
+	_, err := conn.Do("SADD", redisKeyWorkerPools(ns), "2")
+	assert.NoError(t, err)
+	_, err = conn.Do("HMSET", redisKeyHeartbeat(ns, "2"),
+		"heartbeat_at", time.Now().Add(-1*time.Hour).Unix(),
+		"job_names", "type1,type2",
+	)
+	assert.NoError(t, err)
+	job := &Job{Name: "type1", ID: "job1", EnqueuedAt: 1}
+	rawJSON, err := job.serialize()
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsInProgress(ns, "2", "type1"), rawJSON)
+	assert.NoError(t, err)
+	_, err = conn.Do("INCRBY", redisKeyJobsLock(ns, "type1"), 2)
+	assert.NoError(t, err)
+	_, err = conn.Do("HINCRBY", redisKeyJobsLockInfo(ns, "type1"), "2", 2)
+	assert.NoError(t, err)
+
+	var hooked []*ReaperEvent
+	reaper := newDeadPoolReaper(ns, pool, []string{"type1", "type2"}, nil)
+	reaper.workerPoolID = "1"
+	reaper.onReap = []func(*ReaperEvent){func(event *ReaperEvent) { hooked = append(hooked, event) }}
+	err = reaper.reap()
+	assert.NoError(t, err)
+
+	if assert.Len(t, hooked, 1) {
+		assert.Equal(t, "1", hooked[0].ReaperPoolID)
+		assert.Equal(t, "2", hooked[0].DeadPoolID)
+		assert.Equal(t, []string{"job1"}, hooked[0].RequeuedJobs)
+		assert.Equal(t, []string{"type1"}, hooked[0].ResetLocks)
+		assert.True(t, hooked[0].At > 0)
+	}
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "type1")))
+
+	client := NewClient(ns, pool)
+	events, err := client.ReaperEvents()
+	assert.NoError(t, err)
+	assert.Equal(t, hooked, events)
+}
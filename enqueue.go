@@ -1,28 +1,69 @@
 package work
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
 
+// defaultUniqueTTL is how long a unique job's lock is held for unless the job type sets JobOptions.UniqueTTL.
+const defaultUniqueTTL = 24 * time.Hour
+
+// defaultIdempotencyTTL is how long a job's idempotency key is kept unless the job type sets
+// JobOptions.IdempotencyTTL.
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// maxJobNotifications is the most notifications kept for each job type, ie, the most workers waiting with FetchBlocking
+// that a burst of enqueued jobs wakes at once. The rest wake when their wait times out.
+const maxJobNotifications = 100
+
 // Enqueuer can enqueue jobs.
 type Enqueuer struct {
 	Namespace string // eg, "myapp-work"
-	Pool      *redis.Pool
+	Pool      Pool
 
 	queuePrefix           string // eg, "myapp-work:jobs:"
 	knownJobs             map[string]int64
+	shards                map[string]*jobShards
+	partitionArgs         map[string]jobPartitionArg
 	enqueueUniqueScript   *redis.Script
 	enqueueUniqueInScript *redis.Script
+	middleware            []EnqueueMiddleware
+	backend               Backend // the namespace's, as of backendCheckedAt
+	backendCheckedAt      int64
+	inline                *WorkerPool // set by RunInline
+	codec                 Codec       // set by SetCodec
+	compressAbove         int         // set by SetCompressionThreshold
+	logger                Logger      // set by SetLogger
 	mtx                   sync.RWMutex
 }
 
+// JobEnqueuer is implemented by Enqueuer and by memstore.Store, so code that enqueues jobs can take either, and be unit
+// tested without Redis.
+type JobEnqueuer interface {
+	Enqueue(jobName string, args map[string]interface{}) (*Job, error)
+	EnqueueContext(ctx context.Context, jobName string, args map[string]interface{}) (*Job, error)
+	EnqueueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error)
+	EnqueueAt(jobName string, at time.Time, args map[string]interface{}) (*ScheduledJob, error)
+	EnqueueUnique(jobName string, args map[string]interface{}) (*Job, error)
+	EnqueueUniqueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error)
+}
+
+// EnqueueMiddleware is called for each job before it's enqueued, and can change the job, eg, to add arguments. It must
+// call next to enqueue the job, and return its error. Returning without calling next drops the job, in which case the
+// Enqueue methods return a nil job.
+type EnqueueMiddleware func(job *Job, next func() error) error
+
 // NewEnqueuer creates a new enqueuer with the specified Redis namespace and Redis pool.
-func NewEnqueuer(namespace string, pool *redis.Pool) *Enqueuer {
+func NewEnqueuer(namespace string, pool Pool) *Enqueuer {
 	if pool == nil {
-		panic("NewEnqueuer needs a non-nil *redis.Pool")
+		panic("NewEnqueuer needs a non-nil Pool")
 	}
 
 	return &Enqueuer{
@@ -30,64 +71,470 @@ func NewEnqueuer(namespace string, pool *redis.Pool) *Enqueuer {
 		Pool:                  pool,
 		queuePrefix:           redisKeyJobsPrefix(namespace),
 		knownJobs:             make(map[string]int64),
-		enqueueUniqueScript:   redis.NewScript(2, redisLuaEnqueueUnique),
-		enqueueUniqueInScript: redis.NewScript(2, redisLuaEnqueueUniqueIn),
+		shards:                make(map[string]*jobShards),
+		partitionArgs:         make(map[string]jobPartitionArg),
+		enqueueUniqueScript:   redis.NewScript(4, redisLuaEnqueueUnique),
+		enqueueUniqueInScript: redis.NewScript(3, redisLuaEnqueueUniqueIn),
 	}
 }
 
+// SetLogger sets the Logger that errors which can't be returned, such as failing to clean up after EnqueueUniqueWithin,
+// are logged to.
+func (e *Enqueuer) SetLogger(logger Logger) *Enqueuer {
+	e.logger = logger
+	return e
+}
+
+// Use adds middleware that's run for every job enqueued by e, in the order it's added. Use must be called before the
+// enqueuer is used. Note that unique jobs are deduplicated on the arguments they have before the middleware runs.
+// Example: e.Use(func(job *work.Job, next func() error) error { job.Args["tenant_id"] = tenantID; return next() })
+func (e *Enqueuer) Use(mw EnqueueMiddleware) *Enqueuer {
+	e.middleware = append(e.middleware, mw)
+	return e
+}
+
+// runMiddleware runs job through the middleware, calling enqueue at the end of the chain. It returns whether enqueue
+// was called.
+func (e *Enqueuer) runMiddleware(job *Job, enqueue func() error) (bool, error) {
+	job.codec = e.codec
+	job.compressAbove = e.compressAbove
+	if job.Args == nil && len(e.middleware) > 0 {
+		job.Args = make(map[string]interface{})
+	}
+
+	i := 0
+	called := false
+	var next func() error
+	next = func() error {
+		if i < len(e.middleware) {
+			mw := e.middleware[i]
+			i++
+			return mw(job, next)
+		}
+		called = true
+		return enqueue()
+	}
+	err := next()
+	return called, err
+}
+
 // Enqueue will enqueue the specified job name and arguments. The args param can be nil if no args ar needed.
 // Example: e.Enqueue("send_email", work.Q{"addr": "test@example.com"})
 func (e *Enqueuer) Enqueue(jobName string, args map[string]interface{}) (*Job, error) {
+	return e.EnqueueContext(context.Background(), jobName, args)
+}
+
+// EnqueueContext enqueues a job as per Enqueue, making ctx available to enqueue middleware through Job.Context, eg,
+// so that tracing middleware can link the job to the caller's span. If ctx is done before the job's been pushed to
+// Redis, eg, as Redis is slow, its error is returned.
+func (e *Enqueuer) EnqueueContext(ctx context.Context, jobName string, args map[string]interface{}) (*Job, error) {
 	job := &Job{
 		Name:       jobName,
 		ID:         makeIdentifier(),
 		EnqueuedAt: nowEpochSeconds(),
 		Args:       args,
+		ctx:        ctx,
 	}
 
-	rawJSON, err := job.serialize()
+	if ok, err := e.enqueueJob(job); !ok || err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// EnqueueTyped enqueues a job as per Enqueue, with payload as its arguments. payload is typically a struct, which is
+// encoded to JSON, so its fields are named as per their json tags. Handlers decode it with Args.
+// Example: e.EnqueueTyped("sync", SyncArgs{AccountID: 4, Full: true})
+func (e *Enqueuer) EnqueueTyped(jobName string, payload interface{}) (*Job, error) {
+	return e.EnqueueTypedContext(context.Background(), jobName, payload)
+}
+
+// EnqueueTypedContext enqueues a job as per EnqueueTyped, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueTypedContext(ctx context.Context, jobName string, payload interface{}) (*Job, error) {
+	args, err := argsFromStruct(payload)
 	if err != nil {
 		return nil, err
 	}
+	return e.EnqueueContext(ctx, jobName, args)
+}
+
+// enqueueJob pushes job onto its queue, returning false if it was dropped by middleware.
+func (e *Enqueuer) enqueueJob(job *Job) (bool, error) {
+	if e.inline != nil {
+		return e.runMiddleware(job, func() error { return e.runInline(job) })
+	}
 
-	conn := e.Pool.Get()
+	conn := getConn(job.Context(), e.Pool)
 	defer conn.Close()
 
-	if _, err := conn.Do("LPUSH", e.queuePrefix+jobName, rawJSON); err != nil {
+	ok, err := e.runMiddleware(job, func() error {
+		rawJSON, err := e.serialize(conn, job)
+		if err != nil {
+			return err
+		}
+		if e.useStreams(conn) {
+			conn.Send("XADD", redisKeyJobsStream(e.Namespace, job.Name), "*", "job", rawJSON)
+		} else {
+			conn.Send("LPUSH", e.queueKey(conn, job.Name, job.Args), rawJSON)
+		}
+		return notifyJob(conn, e.Namespace, job.Name)
+	})
+	if !ok || err != nil {
+		return false, err
+	}
+
+	return true, e.addToKnownJobs(conn, job.Name)
+}
+
+// notifyJob wakes a worker waiting for jobs named jobName with FetchBlocking, and the idle workers of pools with
+// FetchPubSub. It also returns the error of any commands sent on conn beforehand, such as the LPUSH of the job.
+func notifyJob(conn redis.Conn, namespace, jobName string) error {
+	conn.Send("LPUSH", redisKeyJobsNotify(namespace, jobName), 1)
+	conn.Send("LTRIM", redisKeyJobsNotify(namespace, jobName), 0, maxJobNotifications-1)
+	_, err := conn.Do("PUBLISH", redisChannelJobsWake(namespace, jobName), 1)
+	return err
+}
+
+// enqueueBatchSize is the max number of jobs pushed by a single LPUSH in EnqueueBatch.
+const enqueueBatchSize = 1000
+
+// EnqueueBatch enqueues a job with the specified name for each of the arguments in argsList, in a single round trip
+// to Redis. The jobs are processed in the same order as argsList. Middleware added with Use sees each job in turn,
+// but calling next only adds the job to the batch, which is sent once every job has been through the middleware.
+// Example: e.EnqueueBatch("send_email", []work.Q{{"addr": "a@example.com"}, {"addr": "b@example.com"}})
+func (e *Enqueuer) EnqueueBatch(jobName string, argsList []Q) ([]*Job, error) {
+	return e.EnqueueBatchContext(context.Background(), jobName, argsList)
+}
+
+// EnqueueBatchContext enqueues a batch of jobs as per EnqueueBatch, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueBatchContext(ctx context.Context, jobName string, argsList []Q) ([]*Job, error) {
+	if len(argsList) == 0 {
+		return nil, nil
+	}
+
+	var conn redis.Conn
+	var partitionArg string
+	if e.inline == nil {
+		conn = getConn(ctx, e.Pool)
+		defer conn.Close()
+		partitionArg = e.partitionArg(conn, jobName)
+	}
+
+	jobs := make([]*Job, 0, len(argsList))
+	var rawJSONs [][]byte
+	for _, args := range argsList {
+		job := &Job{
+			Name:       jobName,
+			ID:         makeIdentifier(),
+			EnqueuedAt: nowEpochSeconds(),
+			Args:       args,
+			ctx:        ctx,
+		}
+		_, err := e.runMiddleware(job, func() error {
+			job.partitionArg = partitionArg
+			rawJSON, err := job.serialize()
+			if err != nil {
+				return err
+			}
+			jobs = append(jobs, job)
+			if e.inline != nil {
+				return e.runInline(job)
+			}
+
+			rawJSONs = append(rawJSONs, rawJSON)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(jobs) == 0 || e.inline != nil {
+		return jobs, nil
+	}
+
+	if e.useStreams(conn) {
+		for _, rawJSON := range rawJSONs {
+			if err := conn.Send("XADD", redisKeyJobsStream(e.Namespace, jobName), "*", "job", rawJSON); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		// The jobs are pushed onto each queue they go to, which is more than one if the job type's sharded, in order.
+		queues := make([]string, len(rawJSONs))
+		for i := range rawJSONs {
+			queues[i] = e.queueKey(conn, jobName, jobs[i].Args)
+		}
+		cmds := make(map[string][]interface{})
+		var order []string
+		for i, rawJSON := range rawJSONs {
+			queue := queues[i]
+			if _, ok := cmds[queue]; !ok {
+				order = append(order, queue)
+			}
+			if len(cmds[queue]) == 0 {
+				cmds[queue] = []interface{}{queue}
+			}
+			cmds[queue] = append(cmds[queue], rawJSON)
+			if len(cmds[queue]) > enqueueBatchSize {
+				if err := conn.Send("LPUSH", cmds[queue]...); err != nil {
+					return nil, err
+				}
+				cmds[queue] = nil
+			}
+		}
+		for _, queue := range order {
+			if cmd := cmds[queue]; len(cmd) > 0 {
+				if err := conn.Send("LPUSH", cmd...); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if err := notifyJob(conn, e.Namespace, jobName); err != nil {
 		return nil, err
 	}
 
 	if err := e.addToKnownJobs(conn, jobName); err != nil {
-		return job, err
+		return jobs, err
+	}
+
+	return jobs, nil
+}
+
+// EnqueueChain enqueues the first step of chain. The following steps are enqueued by the workers as each step
+// succeeds. The returned job is the first step.
+// Example: e.EnqueueChain(work.Chain("resize", work.Q{"image_id": 4}).Then("upload", nil))
+func (e *Enqueuer) EnqueueChain(chain *JobChain) (*Job, error) {
+	return e.EnqueueChainContext(context.Background(), chain)
+}
+
+// EnqueueChainContext enqueues the first step of chain as per EnqueueChain, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueChainContext(ctx context.Context, chain *JobChain) (*Job, error) {
+	if chain == nil || len(chain.steps) == 0 {
+		return nil, fmt.Errorf("empty job chain")
+	}
+
+	first := chain.steps[0]
+	job := &Job{
+		Name:       first.Name,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       first.Args,
+		Chain:      append([]ChainStep(nil), chain.steps[1:]...),
+		ctx:        ctx,
+	}
+
+	if ok, err := e.enqueueJob(job); !ok || err != nil {
+		return nil, err
 	}
 
 	return job, nil
 }
 
-// EnqueueIn enqueues a job in the scheduled job queue for execution in secondsFromNow seconds.
-func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+// EnqueueAndWait enqueues a job as per Enqueue and then waits for it to finish, returning its result. A job that fails
+// is retried as usual, and the wait only ends once it succeeds or fails for the last time, in which case the
+// JobResult's Err is set. If ctx is done first, its error is returned along with the enqueued job.
+// Example: res, job, err := e.EnqueueAndWait(ctx, "render_pdf", work.Q{"doc_id": 4})
+func (e *Enqueuer) EnqueueAndWait(ctx context.Context, jobName string, args map[string]interface{}) (*JobResult, *Job, error) {
+	job := &Job{
+		Name:        jobName,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  nowEpochSeconds(),
+		Args:        args,
+		StoreResult: true,
+		ctx:         ctx,
+	}
+
+	if e.inline != nil {
+		var runErr error
+		if ok, err := e.runMiddleware(job, func() error { runErr = e.runInline(job); return nil }); !ok || err != nil {
+			return nil, nil, err
+		}
+		res := &JobResult{JobID: job.ID, Result: job.result, FinishedAt: nowEpochSeconds()}
+		if runErr != nil {
+			res.Err = runErr.Error()
+		}
+		return res, job, nil
+	}
+
+	if ok, err := e.enqueueJob(job); !ok || err != nil {
+		return nil, nil, err
+	}
+
+	res, err := waitForJobResult(ctx, e.Pool, e.Namespace, job.ID)
+	return res, job, err
+}
+
+// EnqueueWithPriority enqueues a job as per Enqueue, but ahead of (or behind) other jobs with the same name that have a
+// lower (or higher) priority. Jobs with the same priority are processed in the order they were enqueued, and jobs
+// enqueued with Enqueue have PriorityNormal. Note that jobs which fail are retried with normal priority.
+// Example: e.EnqueueWithPriority("send_email", work.PriorityHigh, work.Q{"addr": "test@example.com"})
+func (e *Enqueuer) EnqueueWithPriority(jobName string, priority JobPriority, args map[string]interface{}) (*Job, error) {
+	return e.EnqueueWithPriorityContext(context.Background(), jobName, priority, args)
+}
+
+// EnqueueWithPriorityContext enqueues a job as per EnqueueWithPriority, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueWithPriorityContext(ctx context.Context, jobName string, priority JobPriority, args map[string]interface{}) (*Job, error) {
+	if priority == PriorityNormal || e.inline != nil {
+		return e.EnqueueContext(ctx, jobName, args)
+	}
+	if priority < -maxJobPriority || priority > maxJobPriority {
+		return nil, fmt.Errorf("work: priority must be between %d and %d", -maxJobPriority, maxJobPriority)
+	}
+
 	job := &Job{
 		Name:       jobName,
 		ID:         makeIdentifier(),
 		EnqueuedAt: nowEpochSeconds(),
 		Args:       args,
+		Priority:   priority,
+		ctx:        ctx,
 	}
 
-	rawJSON, err := job.serialize()
-	if err != nil {
+	conn := getConn(ctx, e.Pool)
+	defer conn.Close()
+
+	ok, err := e.runMiddleware(job, func() error {
+		rawJSON, err := e.serialize(conn, job)
+		if err != nil {
+			return err
+		}
+		conn.Send("ZADD", redisKeyJobsPriority(e.Namespace, jobName), priorityScore(priority, job.EnqueuedAt), rawJSON)
+		return notifyJob(conn, e.Namespace, jobName)
+	})
+	if !ok || err != nil {
 		return nil, err
 	}
 
-	conn := e.Pool.Get()
-	defer conn.Close()
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return job, err
+	}
 
+	return job, nil
+}
+
+// EnqueueWithExpiry enqueues a job as per Enqueue, but it's discarded instead of run if it's fetched more than expiresIn
+// from now, overriding the job type's JobOptions.ExpiresIn. expiresIn is rounded up to a second.
+// Example: e.EnqueueWithExpiry("send_typing_notification", 5*time.Second, work.Q{"user_id": 4})
+func (e *Enqueuer) EnqueueWithExpiry(jobName string, expiresIn time.Duration, args map[string]interface{}) (*Job, error) {
+	return e.EnqueueWithExpiryContext(context.Background(), jobName, expiresIn, args)
+}
+
+// EnqueueWithExpiryContext enqueues a job as per EnqueueWithExpiry, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueWithExpiryContext(ctx context.Context, jobName string, expiresIn time.Duration, args map[string]interface{}) (*Job, error) {
+	if expiresIn <= 0 {
+		return nil, fmt.Errorf("work: expiresIn must be positive")
+	}
+
+	now := nowEpochSeconds()
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: now,
+		Args:       args,
+		ExpiresAt:  now + int64((expiresIn+time.Second-1)/time.Second),
+		ctx:        ctx,
+	}
+
+	if ok, err := e.enqueueJob(job); !ok || err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// EnqueueWithIdempotencyKey enqueues a job as per Enqueue, but the job is only run if no other job of the same name with
+// the same key is running or has succeeded, eg, if the caller retries after a timeout, or the job is requeued by the
+// reaper after it finished. Workers check and claim key atomically before running the job, and keep it for the job
+// type's JobOptions.IdempotencyTTL once it succeeds. If it fails for good, the key is released.
+// Example: e.EnqueueWithIdempotencyKey("charge_card", "order-1234", work.Q{"order_id": 1234})
+func (e *Enqueuer) EnqueueWithIdempotencyKey(jobName, key string, args map[string]interface{}) (*Job, error) {
+	return e.EnqueueWithIdempotencyKeyContext(context.Background(), jobName, key, args)
+}
+
+// EnqueueWithIdempotencyKeyContext enqueues a job as per EnqueueWithIdempotencyKey, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueWithIdempotencyKeyContext(ctx context.Context, jobName, key string, args map[string]interface{}) (*Job, error) {
+	if key == "" {
+		return nil, fmt.Errorf("work: idempotency key must not be empty")
+	}
+
+	job := &Job{
+		Name:           jobName,
+		ID:             makeIdentifier(),
+		EnqueuedAt:     nowEpochSeconds(),
+		Args:           args,
+		IdempotencyKey: key,
+		ctx:            ctx,
+	}
+
+	if ok, err := e.enqueueJob(job); !ok || err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// priorityScore orders jobs on a priority zset by descending priority and then by the time they were enqueued. Jobs
+// above normal priority have a negative score, which the fetch script relies on.
+func priorityScore(priority JobPriority, enqueuedAt int64) int64 {
+	return -int64(priority)*1e10 + enqueuedAt
+}
+
+// EnqueueIn enqueues a job in the scheduled job queue for execution in secondsFromNow seconds.
+func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.EnqueueInContext(context.Background(), jobName, secondsFromNow, args)
+}
+
+// EnqueueInContext enqueues a job as per EnqueueIn, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueInContext(ctx context.Context, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.enqueueScheduled(ctx, jobName, nowEpochSeconds()+secondsFromNow, args)
+}
+
+// EnqueueAt enqueues a job in the scheduled job queue for execution at the given time, to the second. A time in the
+// past runs the job as soon as the scheduler next checks for due jobs.
+// Example: e.EnqueueAt("send_digest", time.Date(2024, 1, 2, 9, 0, 0, 0, customerTZ), work.Q{"customer_id": 4})
+func (e *Enqueuer) EnqueueAt(jobName string, at time.Time, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.EnqueueAtContext(context.Background(), jobName, at, args)
+}
+
+// EnqueueAtContext enqueues a job as per EnqueueAt, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueAtContext(ctx context.Context, jobName string, at time.Time, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.enqueueScheduled(ctx, jobName, at.Unix(), args)
+}
+
+func (e *Enqueuer) enqueueScheduled(ctx context.Context, jobName string, runAt int64, args map[string]interface{}) (*ScheduledJob, error) {
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+		ctx:        ctx,
+	}
 	scheduledJob := &ScheduledJob{
-		RunAt: nowEpochSeconds() + secondsFromNow,
+		RunAt: runAt,
 		Job:   job,
 	}
 
-	_, err = conn.Do("ZADD", redisKeyScheduled(e.Namespace), scheduledJob.RunAt, rawJSON)
-	if err != nil {
+	if e.inline != nil {
+		if ok, err := e.runMiddleware(job, func() error { return e.runInline(job) }); !ok || err != nil {
+			return nil, err
+		}
+		return scheduledJob, nil
+	}
+
+	conn := getConn(ctx, e.Pool)
+	defer conn.Close()
+
+	ok, err := e.runMiddleware(job, func() error {
+		rawJSON, err := e.serialize(conn, job)
+		if err != nil {
+			return err
+		}
+		_, err = conn.Do("ZADD", redisKeyScheduled(e.Namespace), scheduledJob.RunAt, rawJSON)
+		return err
+	})
+	if !ok || err != nil {
 		return nil, err
 	}
 
@@ -98,6 +545,96 @@ func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[stri
 	return scheduledJob, nil
 }
 
+// EnqueueEvery has worker pools enqueue a job with the specified name and arguments every interval, to the second,
+// until it's cancelled with CancelRecurring. Runs are aligned to multiples of interval since the Unix epoch, so a job
+// enqueued every 15 minutes runs on the hour and at 15, 30 and 45 past. The job is stored in Redis, so unlike
+// WorkerPool.PeriodicallyEnqueue it doesn't have to be registered by every worker pool. Calling EnqueueEvery again
+// with the same name and arguments changes the interval, rather than adding another recurring job.
+// Example: e.EnqueueEvery("sync_customer", 15*time.Minute, work.Q{"customer_id": 4})
+func (e *Enqueuer) EnqueueEvery(jobName string, interval time.Duration, args map[string]interface{}) (*RecurringJob, error) {
+	return e.EnqueueEveryContext(context.Background(), jobName, interval, args)
+}
+
+// EnqueueEveryContext stores a recurring job as per EnqueueEvery, returning ctx's error if it's done before the job's
+// been stored.
+func (e *Enqueuer) EnqueueEveryContext(ctx context.Context, jobName string, interval time.Duration, args map[string]interface{}) (*RecurringJob, error) {
+	if interval < time.Second {
+		return nil, fmt.Errorf("work: interval must be at least a second")
+	}
+
+	id, err := makeRecurringID(jobName, args)
+	if err != nil {
+		return nil, err
+	}
+	rj := &RecurringJob{
+		ID:       id,
+		Name:     jobName,
+		Args:     args,
+		Interval: int64(interval / time.Second),
+	}
+	rawJSON, err := json.Marshal(rj)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := getConn(ctx, e.Pool)
+	defer conn.Close()
+
+	if _, err := conn.Do("HSET", redisKeyRecurring(e.Namespace), rj.ID, rawJSON); err != nil {
+		return nil, err
+	}
+
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return rj, err
+	}
+
+	return rj, nil
+}
+
+// CancelRecurring stops a job enqueued with EnqueueEvery from being enqueued again. Runs the worker pools have already
+// scheduled, which are at most a few minutes away, still go ahead. It returns ErrNotDeleted if the job wasn't
+// recurring.
+func (e *Enqueuer) CancelRecurring(job *RecurringJob) error {
+	return e.CancelRecurringContext(context.Background(), job)
+}
+
+// CancelRecurringContext cancels a recurring job as per CancelRecurring, returning ctx's error if it's done first.
+func (e *Enqueuer) CancelRecurringContext(ctx context.Context, job *RecurringJob) error {
+	conn := getConn(ctx, e.Pool)
+	defer conn.Close()
+
+	n, err := redis.Int(conn.Do("HDEL", redisKeyRecurring(e.Namespace), job.ID))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotDeleted
+	}
+	return nil
+}
+
+// makeRecurringID returns the ID of the recurring job with jobName and args, so they can only recur once.
+func makeRecurringID(jobName string, args map[string]interface{}) (string, error) {
+	rawArgs, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("recurring:%s:%s", jobName, rawArgs), nil
+}
+
+// CancelScheduled deletes a job enqueued with EnqueueIn, EnqueueAt or one of the EnqueueUniqueIn methods before it
+// runs, releasing its uniqueness lock if it has one. It returns ErrNotDeleted if the job is no longer scheduled, eg,
+// because it's already been moved to its queue to run.
+// Example: job, _ := e.EnqueueIn("send_reminder", 3600, work.Q{"task_id": 4}); ...; e.CancelScheduled(job)
+func (e *Enqueuer) CancelScheduled(job *ScheduledJob) error {
+	return e.CancelScheduledContext(context.Background(), job)
+}
+
+// CancelScheduledContext deletes a scheduled job as per CancelScheduled, returning ctx's error if it's done first.
+func (e *Enqueuer) CancelScheduledContext(ctx context.Context, job *ScheduledJob) error {
+	return NewClient(e.Namespace, e.Pool).WithContext(ctx).DeleteScheduledJob(job.RunAt, job.ID)
+}
+
 // EnqueueUnique enqueues a job unless a job is already enqueued with the same name and arguments.
 // The already-enqueued job can be in the normal work queue or in the scheduled job queue.
 // Once a worker begins processing a job, another job with the same name and arguments can be enqueued again.
@@ -105,12 +642,22 @@ func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[stri
 // In order to add robustness to the system, jobs are only unique for 24 hours after they're enqueued. This is mostly relevant for scheduled jobs.
 // EnqueueUnique returns the job if it was enqueued and nil if it wasn't
 func (e *Enqueuer) EnqueueUnique(jobName string, args map[string]interface{}) (*Job, error) {
-	return e.EnqueueUniqueByKey(jobName, args, nil)
+	return e.EnqueueUniqueContext(context.Background(), jobName, args)
+}
+
+// EnqueueUniqueContext enqueues a unique job as per EnqueueUnique, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueContext(ctx context.Context, jobName string, args map[string]interface{}) (*Job, error) {
+	return e.enqueueUniqueByKey(ctx, jobName, args, nil)
 }
 
 // EnqueueUniqueIn enqueues a unique job in the scheduled job queue for execution in secondsFromNow seconds. See EnqueueUnique for the semantics of unique jobs.
 func (e *Enqueuer) EnqueueUniqueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
-	return e.EnqueueUniqueInByKey(jobName, secondsFromNow, args, nil)
+	return e.EnqueueUniqueInContext(context.Background(), jobName, secondsFromNow, args)
+}
+
+// EnqueueUniqueInContext enqueues a unique job as per EnqueueUniqueIn, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueInContext(ctx context.Context, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.enqueueUniqueInByKey(ctx, jobName, secondsFromNow, args, nil)
 }
 
 // EnqueueUniqueByKey enqueues a job unless a job is already enqueued with the same name and key, updating arguments.
@@ -120,7 +667,16 @@ func (e *Enqueuer) EnqueueUniqueIn(jobName string, secondsFromNow int64, args ma
 // In order to add robustness to the system, jobs are only unique for 24 hours after they're enqueued. This is mostly relevant for scheduled jobs.
 // EnqueueUniqueByKey returns the job if it was enqueued and nil if it wasn't
 func (e *Enqueuer) EnqueueUniqueByKey(jobName string, args map[string]interface{}, keyMap map[string]interface{}) (*Job, error) {
-	enqueue, job, err := e.uniqueJobHelper(jobName, args, keyMap)
+	return e.EnqueueUniqueByKeyContext(context.Background(), jobName, args, keyMap)
+}
+
+// EnqueueUniqueByKeyContext enqueues a unique job as per EnqueueUniqueByKey, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueByKeyContext(ctx context.Context, jobName string, args map[string]interface{}, keyMap map[string]interface{}) (*Job, error) {
+	return e.enqueueUniqueByKey(ctx, jobName, args, keyMap)
+}
+
+func (e *Enqueuer) enqueueUniqueByKey(ctx context.Context, jobName string, args map[string]interface{}, keyMap map[string]interface{}) (*Job, error) {
+	enqueue, job, err := e.uniqueJobHelper(ctx, jobName, args, keyMap)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +692,17 @@ func (e *Enqueuer) EnqueueUniqueByKey(jobName string, args map[string]interface{
 // EnqueueUniqueInByKey enqueues a job in the scheduled job queue that is unique on specified key for execution in secondsFromNow seconds. See EnqueueUnique for the semantics of unique jobs.
 // Subsequent calls with same key will update arguments
 func (e *Enqueuer) EnqueueUniqueInByKey(jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (*ScheduledJob, error) {
-	enqueue, job, err := e.uniqueJobHelper(jobName, args, keyMap)
+	return e.EnqueueUniqueInByKeyContext(context.Background(), jobName, secondsFromNow, args, keyMap)
+}
+
+// EnqueueUniqueInByKeyContext enqueues a unique scheduled job as per EnqueueUniqueInByKey, with ctx as per
+// EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueInByKeyContext(ctx context.Context, jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (*ScheduledJob, error) {
+	return e.enqueueUniqueInByKey(ctx, jobName, secondsFromNow, args, keyMap)
+}
+
+func (e *Enqueuer) enqueueUniqueInByKey(ctx context.Context, jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (*ScheduledJob, error) {
+	enqueue, job, err := e.uniqueJobHelper(ctx, jobName, args, keyMap)
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +719,48 @@ func (e *Enqueuer) EnqueueUniqueInByKey(jobName string, secondsFromNow int64, ar
 	return nil, err
 }
 
+// EnqueueUniqueWithin enqueues a job unless a job with the same name and arguments was enqueued with
+// EnqueueUniqueWithin in the last window, whether or not that job has run since, eg, to debounce a storm of webhooks.
+// Unlike EnqueueUnique, the job's arguments can't be updated while it's waiting to run.
+// EnqueueUniqueWithin returns the job if it was enqueued and nil if it wasn't
+// Example: e.EnqueueUniqueWithin("sync_account", work.Q{"account_id": 4}, time.Minute)
+func (e *Enqueuer) EnqueueUniqueWithin(jobName string, args map[string]interface{}, window time.Duration) (*Job, error) {
+	return e.EnqueueUniqueWithinContext(context.Background(), jobName, args, window)
+}
+
+// EnqueueUniqueWithinContext enqueues a job as per EnqueueUniqueWithin, with ctx as per EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueWithinContext(ctx context.Context, jobName string, args map[string]interface{}, window time.Duration) (*Job, error) {
+	if window < time.Millisecond {
+		return nil, fmt.Errorf("work: window must be at least a millisecond")
+	}
+	if e.inline != nil {
+		return e.EnqueueContext(ctx, jobName, args)
+	}
+
+	key, err := redisKeyUniqueWithin(e.Namespace, jobName, args)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := getConn(ctx, e.Pool)
+	defer conn.Close()
+
+	if _, err := redis.String(conn.Do("SET", key, "1", "PX", window.Milliseconds(), "NX")); err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	job, err := e.EnqueueContext(ctx, jobName, args)
+	if job == nil || err != nil {
+		// Don't hold up the next enqueue for a job that wasn't enqueued.
+		if _, delErr := conn.Do("DEL", key); delErr != nil {
+			logError(e.logger, "enqueue_unique_within.del", delErr)
+		}
+	}
+	return job, err
+}
+
 func (e *Enqueuer) addToKnownJobs(conn redis.Conn, jobName string) error {
 	needSadd := true
 	now := time.Now().Unix()
@@ -181,7 +789,7 @@ func (e *Enqueuer) addToKnownJobs(conn redis.Conn, jobName string) error {
 
 type enqueueFnType func(*int64) (string, error)
 
-func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{}, keyMap map[string]interface{}) (enqueueFnType, *Job, error) {
+func (e *Enqueuer) uniqueJobHelper(ctx context.Context, jobName string, args map[string]interface{}, keyMap map[string]interface{}) (enqueueFnType, *Job, error) {
 	useDefaultKeys := false
 	if keyMap == nil {
 		useDefaultKeys = true
@@ -200,46 +808,118 @@ func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{},
 		Args:       args,
 		Unique:     true,
 		UniqueKey:  uniqueKey,
-	}
-
-	rawJSON, err := job.serialize()
-	if err != nil {
-		return nil, nil, err
+		ctx:        ctx,
 	}
 
 	enqueueFn := func(runAt *int64) (string, error) {
-		conn := e.Pool.Get()
+		if e.inline != nil {
+			_, err := e.runMiddleware(job, func() error { return e.runInline(job) })
+			return "ok", err
+		}
+
+		conn := getConn(ctx, e.Pool)
 		defer conn.Close()
 
 		if err := e.addToKnownJobs(conn, jobName); err != nil {
 			return "", err
 		}
 
+		var res string
+		_, err := e.runMiddleware(job, func() error {
+			rawJSON, err := e.serialize(conn, job)
+			if err != nil {
+				return err
+			}
+			res, err = e.enqueueUnique(conn, job, uniqueKey, rawJSON, useDefaultKeys, runAt)
+			return err
+		})
+		return res, err
+	}
+
+	return enqueueFn, job, nil
+}
+
+func (e *Enqueuer) enqueueUnique(conn redis.Conn, job *Job, uniqueKey string, rawJSON []byte, useDefaultKeys bool, runAt *int64) (string, error) {
+	var updated interface{}
+	if useDefaultKeys {
+		// keying on arguments so arguments can't be updated
+		// we'll just get them off the original job so to save space, make this "1"
+		updated = "1"
+	} else {
+		// we'll use this for updated arguments since the job on the queue
+		// doesn't get updated
+		updated = rawJSON
+	}
+
+	if runAt != nil { // Scheduled job so different job queue with additional args
 		scriptArgs := []interface{}{}
-		script := e.enqueueUniqueScript
-
-		scriptArgs = append(scriptArgs, e.queuePrefix+jobName) // KEY[1]
-		scriptArgs = append(scriptArgs, uniqueKey)             // KEY[2]
-		scriptArgs = append(scriptArgs, rawJSON)               // ARGV[1]
-		if useDefaultKeys {
-			// keying on arguments so arguments can't be updated
-			// we'll just get them off the original job so to save space, make this "1"
-			scriptArgs = append(scriptArgs, "1") // ARGV[2]
-		} else {
-			// we'll use this for updated arguments since the job on the queue
-			// doesn't get updated
-			scriptArgs = append(scriptArgs, rawJSON) // ARGV[2]
-		}
+		scriptArgs = append(scriptArgs, redisKeyScheduled(e.Namespace))               // KEY[1]
+		scriptArgs = append(scriptArgs, uniqueKey)                                    // KEY[2]
+		scriptArgs = append(scriptArgs, redisKeyJobsUniqueTTL(e.Namespace, job.Name)) // KEY[3]
+		scriptArgs = append(scriptArgs, rawJSON)                                      // ARGV[1]
+		scriptArgs = append(scriptArgs, updated)                                      // ARGV[2]
+		scriptArgs = append(scriptArgs, *runAt)                                       // ARGV[3]
+		scriptArgs = append(scriptArgs, nowEpochSeconds())                            // ARGV[4]
+		return redis.String(e.doScript(conn, e.enqueueUniqueInScript, scriptArgs...))
+	}
 
-		if runAt != nil { // Scheduled job so different job queue with additional arg
-			scriptArgs[0] = redisKeyScheduled(e.Namespace) // KEY[1]
-			scriptArgs = append(scriptArgs, *runAt)        // ARGV[3]
+	scriptArgs := []interface{}{}
+	scriptArgs = append(scriptArgs, e.queueKey(conn, job.Name, job.Args))         // KEY[1]
+	scriptArgs = append(scriptArgs, uniqueKey)                                    // KEY[2]
+	scriptArgs = append(scriptArgs, redisKeyJobsUniqueTTL(e.Namespace, job.Name)) // KEY[3]
+	scriptArgs = append(scriptArgs, redisKeyJobsNotify(e.Namespace, job.Name))    // KEY[4]
+	scriptArgs = append(scriptArgs, rawJSON)                                      // ARGV[1]
+	scriptArgs = append(scriptArgs, updated)                                      // ARGV[2]
+	scriptArgs = append(scriptArgs, redisChannelJobsWake(e.Namespace, job.Name))  // ARGV[3]
+	return redis.String(e.doScript(conn, e.enqueueUniqueScript, scriptArgs...))
+}
 
-			script = e.enqueueUniqueInScript
-		}
+// doScript runs script on conn. redigo loads scripts on demand when the server replies NOSCRIPT, but not if a Doer
+// wraps the reply in another error, so in that case the enqueuer's scripts are loaded again and script is retried.
+func (e *Enqueuer) doScript(conn redis.Conn, script *redis.Script, keysAndArgs ...interface{}) (interface{}, error) {
+	reply, err := script.Do(conn, keysAndArgs...)
+	if !isNoScriptError(err) {
+		return reply, err
+	}
+	if err := e.loadScripts(conn); err != nil {
+		return nil, err
+	}
+	return script.Do(conn, keysAndArgs...)
+}
 
-		return redis.String(script.Do(conn, scriptArgs...))
+// loadScripts loads the enqueuer's scripts into the server conn is talking to, and forgets what it's cached about the
+// namespace, ie, the job types it's recorded, how their queues are sharded and the backend.
+func (e *Enqueuer) loadScripts(conn redis.Conn) error {
+	for _, script := range []*redis.Script{e.enqueueUniqueScript, e.enqueueUniqueInScript} {
+		if err := script.Load(conn); err != nil {
+			return err
+		}
 	}
 
-	return enqueueFn, job, nil
+	e.mtx.Lock()
+	e.knownJobs = make(map[string]int64)
+	e.shards = make(map[string]*jobShards)
+	e.backendCheckedAt = 0
+	e.mtx.Unlock()
+	return nil
+}
+
+// isNoScriptError reports whether err, or an error it wraps, was replied by a server that doesn't have a script.
+func isNoScriptError(err error) bool {
+	var e redis.Error
+	return errors.As(err, &e) && strings.HasPrefix(string(e), "NOSCRIPT ")
+}
+
+// Ping checks that e can reach Redis, eg, for a health check endpoint.
+func (e *Enqueuer) Ping() error {
+	return e.PingContext(context.Background())
+}
+
+// PingContext checks that e can reach Redis as per Ping, giving up once ctx is done.
+func (e *Enqueuer) PingContext(ctx context.Context) error {
+	conn := getConn(ctx, e.Pool)
+	defer conn.Close()
+
+	_, err := conn.Do("PING")
+	return err
 }
@@ -1,11 +1,15 @@
 package work
 
 import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/gomodule/redigo/redis"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,6 +55,102 @@ func TestEnqueue(t *testing.T) {
 	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
 }
 
+func TestEnqueueTyped(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	type syncArgs struct {
+		AccountID int64 `json:"account_id"`
+	}
+	job, err := enqueuer.EnqueueTyped("sync", syncArgs{AccountID: 4})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, job.ArgInt64("account_id"))
+
+	j := jobOnQueue(pool, redisKeyJobs(ns, "sync"))
+	args, err := Args[syncArgs](j)
+	assert.NoError(t, err)
+	assert.Equal(t, syncArgs{AccountID: 4}, args)
+
+	_, err = enqueuer.EnqueueTyped("sync", "wat")
+	assert.Error(t, err)
+}
+
+func TestEnqueueMiddleware(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var order []string
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueuer.Use(func(job *Job, next func() error) error {
+		order = append(order, "tenant")
+		job.Args["tenant_id"] = 7
+		return next()
+	}).Use(func(job *Job, next func() error) error {
+		order = append(order, "drop")
+		if job.ArgBool("drop") {
+			return nil
+		}
+		return next()
+	})
+
+	job, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.Equal(t, []string{"tenant", "drop"}, order)
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.EqualValues(t, 7, j.ArgInt64("tenant_id"))
+
+	job, err = enqueuer.Enqueue("wat", Q{"drop": true})
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	scheduled, err := enqueuer.EnqueueIn("wat", 300, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, scheduled)
+	_, j = jobOnZset(pool, redisKeyScheduled(ns))
+	assert.EqualValues(t, 7, j.ArgInt64("tenant_id"))
+
+	job, err = enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	j = jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.EqualValues(t, 7, j.ArgInt64("tenant_id"))
+
+	jobs, err := enqueuer.EnqueueBatch("wat", []Q{{"a": 1}, {"drop": true}, {"a": 2}})
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// The context passed to EnqueueContext is available to middleware.
+	type ctxKey struct{}
+	var gotCtx interface{}
+	enqueuer.Use(func(job *Job, next func() error) error {
+		gotCtx = job.Context().Value(ctxKey{})
+		return next()
+	})
+	ctx := context.WithValue(context.Background(), ctxKey{}, "trace")
+	job, err = enqueuer.EnqueueContext(ctx, "wat", nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.Equal(t, "trace", gotCtx)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, gotCtx)
+
+	// Errors from enqueueing are returned through the middleware.
+	boom := fmt.Errorf("boom")
+	enqueuer.Use(func(job *Job, next func() error) error {
+		return boom
+	})
+	job, err = enqueuer.Enqueue("wat", nil)
+	assert.Equal(t, boom, err)
+	assert.Nil(t, job)
+}
+
 func TestEnqueueIn(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -98,6 +198,152 @@ func TestEnqueueIn(t *testing.T) {
 	assert.NoError(t, j.ArgError())
 }
 
+func TestEnqueueAt(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	at := time.Now().Add(time.Hour).Truncate(time.Second)
+	job, err := enqueuer.EnqueueAt("wat", at, Q{"a": 1})
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.Equal(t, at.Unix(), job.RunAt)
+		assert.True(t, at.Equal(job.RunAtTime()))
+	}
+
+	score, j := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.Equal(t, at.Unix(), score)
+	assert.Equal(t, job.ID, j.ID)
+
+	// The absolute run time comes back from the client too.
+	jobs, _, err := NewClient(ns, pool).ScheduledJobs(1)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.True(t, at.Equal(jobs[0].RunAtTime()))
+	}
+}
+
+func TestEnqueueWithPriority(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	job, err := enqueuer.EnqueueWithPriority("wat", PriorityHigh, Q{"a": 1})
+	assert.Nil(t, err)
+	if assert.NotNil(t, job) {
+		assert.Equal(t, PriorityHigh, job.Priority)
+	}
+
+	// Make sure "wat" is in the known jobs
+	assert.EqualValues(t, []string{"wat"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	// Prioritized jobs go on the priority zset, normal ones on the queue
+	_, err = enqueuer.EnqueueWithPriority("wat", PriorityNormal, Q{"a": 2})
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyJobsPriority(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	score, j := jobOnZset(pool, redisKeyJobsPriority(ns, "wat"))
+	assert.True(t, score < 0)
+	assert.Equal(t, job.ID, j.ID)
+	assert.Equal(t, PriorityHigh, j.Priority)
+
+	_, err = enqueuer.EnqueueWithPriority("wat", 1001, nil)
+	assert.Error(t, err)
+}
+
+func TestEnqueueWithExpiry(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	job, err := enqueuer.EnqueueWithExpiry("wat", 1500*time.Millisecond, Q{"a": 1})
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.Equal(t, job.EnqueuedAt+2, job.ExpiresAt)
+	}
+
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.Equal(t, job.ID, j.ID)
+	assert.Equal(t, job.ExpiresAt, j.ExpiresAt)
+
+	_, err = enqueuer.EnqueueWithExpiry("wat", 0, nil)
+	assert.Error(t, err)
+}
+
+func TestEnqueueBatch(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	argsList := make([]Q, 0, 2500)
+	for i := 0; i < 2500; i++ {
+		argsList = append(argsList, Q{"i": i})
+	}
+	jobs, err := enqueuer.EnqueueBatch("wat", argsList)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2500)
+	assert.Equal(t, "wat", jobs[0].Name)
+	assert.EqualValues(t, 0, jobs[0].ArgInt64("i"))
+
+	assert.EqualValues(t, 2500, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Jobs are dequeued in the order they were passed in.
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.Equal(t, jobs[0].ID, j.ID)
+	assert.EqualValues(t, 0, j.ArgInt64("i"))
+
+	// Make sure "wat" is in the known jobs
+	assert.EqualValues(t, []string{"wat"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	jobs, err = enqueuer.EnqueueBatch("wat", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, jobs)
+}
+
+func TestEnqueueAndWait(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("double", JobOptions{MaxFails: 1}, func(job *Job) error {
+		return job.SetResult(job.ArgInt64("n") * 2)
+	})
+	wp.JobWithOptions("broken", JobOptions{MaxFails: 1, SkipDead: true}, func(job *Job) error {
+		return fmt.Errorf("broken")
+	})
+	wp.Start()
+	defer wp.Stop()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, job, err := enqueuer.EnqueueAndWait(ctx, "double", Q{"n": 21})
+	assert.NoError(t, err)
+	assert.Equal(t, job.ID, res.JobID)
+	var n int64
+	assert.NoError(t, res.Unmarshal(&n))
+	assert.EqualValues(t, 42, n)
+
+	res, _, err = enqueuer.EnqueueAndWait(ctx, "broken", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "broken", res.Err)
+
+	// Nothing processes "nobody", so the wait is cut short by the context.
+	shortCtx, shortCancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer shortCancel()
+	res, job, err = enqueuer.EnqueueAndWait(shortCtx, "nobody", nil)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Nil(t, res)
+	assert.NotNil(t, job)
+}
+
 func TestEnqueueUnique(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -174,6 +420,102 @@ func TestEnqueueUnique(t *testing.T) {
 	assert.NotNil(t, job)
 }
 
+func TestEnqueueUniqueAfterScriptFlush(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+	assert.NoError(t, enqueuer.Ping())
+
+	job, err := enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	_, err = pool.Get().Do("SCRIPT", "FLUSH")
+	assert.NoError(t, err)
+
+	job, err = enqueuer.EnqueueUnique("wat", Q{"a": 2})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+// noScriptDoer replies to EVALSHA like a server that doesn't have the script until it's loaded, wrapping the NOSCRIPT
+// error as some Redis clients do.
+type noScriptDoer struct {
+	fakeDoer
+	loaded map[string]bool
+}
+
+func (d *noScriptDoer) Do(ctx context.Context, args ...interface{}) (interface{}, error) {
+	switch args[0] {
+	case "SCRIPT":
+		hash := sha1.Sum([]byte(args[2].(string)))
+		d.loaded[hex.EncodeToString(hash[:])] = true
+	case "EVALSHA":
+		if !d.loaded[args[1].(string)] {
+			d.cmds = append(d.cmds, args)
+			return nil, fmt.Errorf("evalsha: %w", redis.Error("NOSCRIPT No matching script. Please use EVAL."))
+		}
+	}
+	return d.fakeDoer.Do(ctx, args...)
+}
+
+func TestEnqueueUniqueReloadsScripts(t *testing.T) {
+	doer := &noScriptDoer{
+		fakeDoer: fakeDoer{replies: map[string]interface{}{"EVALSHA": "ok", "HMGET": []interface{}{nil, nil}}},
+		loaded:   map[string]bool{},
+	}
+	enqueuer := NewEnqueuer("work", NewDoerPool(doer))
+	assert.NoError(t, enqueuer.Ping())
+
+	job, err := enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// The known jobs are forgotten along with the scripts, so wat is recorded again.
+	job, err = enqueuer.EnqueueUnique("wat", Q{"a": 2})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	var cmds []interface{}
+	for _, cmd := range doer.cmds {
+		cmds = append(cmds, cmd[0])
+	}
+	assert.Equal(t, []interface{}{"PING", "SADD", "HMGET", "EVALSHA", "SCRIPT", "SCRIPT", "EVALSHA", "SADD", "HMGET", "EVALSHA"}, cmds)
+}
+
+func TestEnqueueUniqueWithin(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	job, err := enqueuer.EnqueueUniqueWithin("wat", Q{"a": 1}, 100*time.Millisecond)
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// Still a duplicate once the first job has been dequeued.
+	_, err = pool.Get().Do("DEL", redisKeyJobs(ns, "wat"))
+	assert.NoError(t, err)
+	job, err = enqueuer.EnqueueUniqueWithin("wat", Q{"a": 1}, 100*time.Millisecond)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	job, err = enqueuer.EnqueueUniqueWithin("wat", Q{"a": 2}, 100*time.Millisecond)
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	time.Sleep(150 * time.Millisecond)
+	job, err = enqueuer.EnqueueUniqueWithin("wat", Q{"a": 1}, 100*time.Millisecond)
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	_, err = enqueuer.EnqueueUniqueWithin("wat", Q{"a": 1}, 0)
+	assert.Error(t, err)
+}
+
 func TestEnqueueUniqueIn(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -231,6 +573,72 @@ func TestEnqueueUniqueIn(t *testing.T) {
 	assert.NotNil(t, job)
 }
 
+func TestEnqueueCancelScheduled(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	job, err := enqueuer.EnqueueIn("wat", 300, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NoError(t, enqueuer.CancelScheduled(job))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.Equal(t, ErrNotDeleted, enqueuer.CancelScheduled(job))
+
+	// Cancelling a job that's unique by key releases its lock.
+	job, err = enqueuer.EnqueueUniqueInByKey("wat", 300, Q{"a": 1}, Q{"installation_id": 7})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.NoError(t, enqueuer.CancelScheduled(job))
+
+	job, err = enqueuer.EnqueueUniqueInByKey("wat", 300, Q{"a": 2}, Q{"installation_id": 7})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+}
+
+func TestEnqueueUniqueTTL(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	// Without a worker pool to say otherwise, locks are held for a day.
+	job, err := enqueuer.EnqueueUniqueByKey("wat", Q{"a": 1, "b": "cool"}, Q{"installation_id": 7})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.True(t, keyTTL(pool, job.UniqueKey) > 3600)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("taw", JobOptions{UniqueTTL: time.Minute}, func(job *Job) error {
+		return nil
+	})
+	wp.Start()
+	wp.Stop()
+
+	job, err = enqueuer.EnqueueUniqueByKey("taw", Q{"a": 1, "b": "cool"}, Q{"installation_id": 7})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	ttl := keyTTL(pool, job.UniqueKey)
+	assert.True(t, ttl > 0 && ttl <= 60)
+
+	scheduled, err := enqueuer.EnqueueUniqueInByKey("taw", 300, Q{"a": 2}, Q{"installation_id": 8})
+	assert.NoError(t, err)
+	assert.NotNil(t, scheduled)
+	ttl = keyTTL(pool, scheduled.UniqueKey)
+	assert.True(t, ttl > 300 && ttl <= 360) // Held until a minute after the job is due
+}
+
+func keyTTL(pool *redis.Pool, key string) int64 {
+	conn := pool.Get()
+	defer conn.Close()
+
+	ttl, err := redis.Int64(conn.Do("TTL", key))
+	if err != nil {
+		panic("could not get TTL: " + err.Error())
+	}
+	return ttl
+}
+
 func TestEnqueueUniqueByKey(t *testing.T) {
 	var arg3 string
 	var arg4 string
@@ -0,0 +1,139 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// EnqueueRouter picks the namespace that an EnqueuerSet enqueues a job in from the job's name and arguments.
+type EnqueueRouter func(jobName string, args map[string]interface{}) (namespace string)
+
+// EnqueuerSet enqueues jobs in several namespaces, eg, one per product or shard, picking each job's namespace with an
+// EnqueueRouter. It implements JobEnqueuer, so code that enqueues jobs doesn't need to know where they go.
+type EnqueuerSet struct {
+	route      EnqueueRouter
+	enqueuers  map[string]*Enqueuer
+	middleware []EnqueueMiddleware
+}
+
+var _ JobEnqueuer = (*EnqueuerSet)(nil)
+
+// NewEnqueuerSet creates an EnqueuerSet that routes jobs with route. Add an enqueuer for each namespace route returns.
+// Example: set := work.NewEnqueuerSet(func(jobName string, args map[string]interface{}) string { return "app-" + args["product"].(string) })
+func NewEnqueuerSet(route EnqueueRouter) *EnqueuerSet {
+	if route == nil {
+		panic("NewEnqueuerSet needs a non-nil EnqueueRouter")
+	}
+
+	return &EnqueuerSet{
+		route:     route,
+		enqueuers: make(map[string]*Enqueuer),
+	}
+}
+
+// ShardByArg returns an EnqueueRouter that spreads jobs over namespaces by a hash of their argName argument, so that
+// jobs with the same value, eg, for the same customer, always go to the same namespace. Jobs without the argument go to
+// the first namespace.
+// Example: work.NewEnqueuerSet(work.ShardByArg("customer_id", "app-0", "app-1", "app-2"))
+func ShardByArg(argName string, namespaces ...string) EnqueueRouter {
+	if len(namespaces) == 0 {
+		panic("ShardByArg needs at least one namespace")
+	}
+
+	return func(jobName string, args map[string]interface{}) string {
+		v, ok := args[argName]
+		if !ok {
+			return namespaces[0]
+		}
+		return namespaces[shardIndex(v, len(namespaces))]
+	}
+}
+
+// Add creates the enqueuer for namespace, using pool, and returns it so that it can be configured, eg, with SetCodec.
+// Add must be called before the set is used.
+func (s *EnqueuerSet) Add(namespace string, pool Pool) *Enqueuer {
+	e := NewEnqueuer(namespace, pool)
+	for _, mw := range s.middleware {
+		e.Use(mw)
+	}
+	s.enqueuers[namespace] = e
+	return e
+}
+
+// Use adds middleware to the enqueuer of every namespace, including those added later. See Enqueuer.Use.
+func (s *EnqueuerSet) Use(mw EnqueueMiddleware) *EnqueuerSet {
+	s.middleware = append(s.middleware, mw)
+	for _, e := range s.enqueuers {
+		e.Use(mw)
+	}
+	return s
+}
+
+// Enqueuer returns the enqueuer for namespace, or nil if it hasn't been added.
+func (s *EnqueuerSet) Enqueuer(namespace string) *Enqueuer {
+	return s.enqueuers[namespace]
+}
+
+// Route returns the enqueuer that a job with the specified name and arguments is enqueued by. It returns an error if
+// the namespace the job's routed to hasn't been added.
+func (s *EnqueuerSet) Route(jobName string, args map[string]interface{}) (*Enqueuer, error) {
+	namespace := s.route(jobName, args)
+	e, ok := s.enqueuers[namespace]
+	if !ok {
+		return nil, fmt.Errorf("work: job %s routed to namespace %q, which has no enqueuer", jobName, namespace)
+	}
+	return e, nil
+}
+
+// Enqueue enqueues a job in the namespace it's routed to, see Enqueuer.Enqueue.
+func (s *EnqueuerSet) Enqueue(jobName string, args map[string]interface{}) (*Job, error) {
+	return s.EnqueueContext(context.Background(), jobName, args)
+}
+
+// EnqueueContext enqueues a job in the namespace it's routed to, see Enqueuer.EnqueueContext.
+func (s *EnqueuerSet) EnqueueContext(ctx context.Context, jobName string, args map[string]interface{}) (*Job, error) {
+	e, err := s.Route(jobName, args)
+	if err != nil {
+		return nil, err
+	}
+	return e.EnqueueContext(ctx, jobName, args)
+}
+
+// EnqueueIn enqueues a job in the namespace it's routed to, see Enqueuer.EnqueueIn.
+func (s *EnqueuerSet) EnqueueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	e, err := s.Route(jobName, args)
+	if err != nil {
+		return nil, err
+	}
+	return e.EnqueueIn(jobName, secondsFromNow, args)
+}
+
+// EnqueueAt enqueues a job in the namespace it's routed to, see Enqueuer.EnqueueAt.
+func (s *EnqueuerSet) EnqueueAt(jobName string, at time.Time, args map[string]interface{}) (*ScheduledJob, error) {
+	e, err := s.Route(jobName, args)
+	if err != nil {
+		return nil, err
+	}
+	return e.EnqueueAt(jobName, at, args)
+}
+
+// EnqueueUnique enqueues a unique job in the namespace it's routed to, see Enqueuer.EnqueueUnique. Jobs are only
+// unique within a namespace, so the router should send jobs with the same name and arguments to the same one.
+func (s *EnqueuerSet) EnqueueUnique(jobName string, args map[string]interface{}) (*Job, error) {
+	e, err := s.Route(jobName, args)
+	if err != nil {
+		return nil, err
+	}
+	return e.EnqueueUnique(jobName, args)
+}
+
+// EnqueueUniqueIn enqueues a unique job in the namespace it's routed to, see Enqueuer.EnqueueUniqueIn and
+// EnqueueUnique.
+func (s *EnqueuerSet) EnqueueUniqueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	e, err := s.Route(jobName, args)
+	if err != nil {
+		return nil, err
+	}
+	return e.EnqueueUniqueIn(jobName, secondsFromNow, args)
+}
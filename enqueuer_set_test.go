@@ -0,0 +1,55 @@
+package work
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnqueuerSet(t *testing.T) {
+	a, b := &fakeDoer{}, &fakeDoer{}
+	set := NewEnqueuerSet(func(jobName string, args map[string]interface{}) string {
+		return "app-" + args["product"].(string)
+	})
+	set.Add("app-a", NewDoerPool(a))
+	set.Add("app-b", NewDoerPool(b))
+
+	var enqueued []string
+	set.Use(func(job *Job, next func() error) error {
+		enqueued = append(enqueued, job.ArgString("product"))
+		return next()
+	})
+
+	job, err := set.Enqueue("wat", Q{"product": "a"})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	job, err = set.Enqueue("wat", Q{"product": "b"})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.Equal(t, []string{"a", "b"}, enqueued)
+
+	assert.Contains(t, a.cmds, []interface{}{"SADD", redisKeyKnownJobs("app-a"), "wat"})
+	assert.NotContains(t, a.cmds, []interface{}{"SADD", redisKeyKnownJobs("app-b"), "wat"})
+	assert.Contains(t, b.cmds, []interface{}{"SADD", redisKeyKnownJobs("app-b"), "wat"})
+
+	job, err = set.Enqueue("wat", Q{"product": "c"})
+	assert.Error(t, err)
+	assert.Nil(t, job)
+
+	assert.Equal(t, "app-a", set.Enqueuer("app-a").Namespace)
+	assert.Nil(t, set.Enqueuer("app-c"))
+}
+
+func TestShardByArg(t *testing.T) {
+	route := ShardByArg("customer_id", "app-0", "app-1", "app-2")
+
+	shards := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		ns := route("wat", Q{"customer_id": i})
+		assert.Equal(t, ns, route("other", Q{"customer_id": i, "a": 1}))
+		shards[ns] = true
+	}
+	assert.Len(t, shards, 3)
+
+	assert.Equal(t, "app-0", route("wat", nil))
+}
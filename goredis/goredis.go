@@ -0,0 +1,109 @@
+// Package goredis runs work on top of a go-redis client, for services that use go-redis rather than redigo. It's a
+// module of its own so that the work package doesn't depend on go-redis.
+//
+//	rdb := redis.NewClient(&redis.Options{Addr: ":6379", Protocol: 2})
+//	pool := goredis.NewPool(rdb)
+//	enqueuer := work.NewEnqueuer("my_app_namespace", pool)
+//
+// The client must use RESP2, as work reads replies the way Redis sends them over RESP2, so set Protocol to 2 in its
+// options; go-redis defaults to RESP3. The pool can't subscribe to channels, so worker pools using it can't use
+// work.FetchPubSub; see work.NewDoerPool.
+package goredis
+
+import (
+	"context"
+	"errors"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/redis/go-redis/v9"
+	work "github.com/teamwork/work/v2"
+)
+
+// NewPool returns a work.Pool whose connections run their commands with c. It panics if c is a *redis.Client,
+// *redis.ClusterClient or *redis.Ring that isn't set to use RESP2.
+func NewPool(c redis.UniversalClient) work.Pool {
+	if p := protocol(c); p != 2 {
+		panic("goredis: the client must use RESP2, so set Protocol to 2 in its options")
+	}
+	return work.NewDoerPool(NewDoer(c))
+}
+
+// NewDoer returns a work.Doer that runs commands with c, for use with work.NewDoerPool. Unlike NewPool, it doesn't
+// check that c uses RESP2.
+func NewDoer(c redis.UniversalClient) work.Doer {
+	return doer{c: c}
+}
+
+// protocol returns the RESP version c is set to use, or 2 if it can't tell.
+func protocol(c redis.UniversalClient) int {
+	var p int
+	switch c := c.(type) {
+	case *redis.Client:
+		p = c.Options().Protocol
+	case *redis.ClusterClient:
+		p = c.Options().Protocol
+	case *redis.Ring:
+		p = c.Options().Protocol
+	default:
+		return 2
+	}
+	if p < 2 {
+		// go-redis' default.
+		return 3
+	}
+	return p
+}
+
+type doer struct {
+	c redis.UniversalClient
+}
+
+func (d doer) Do(ctx context.Context, args ...interface{}) (interface{}, error) {
+	return reply(d.c.Do(ctx, args...).Result())
+}
+
+func (d doer) Pipeline(ctx context.Context, cmds [][]interface{}, tx bool) ([]interface{}, error) {
+	var pipe redis.Pipeliner
+	if tx {
+		pipe = d.c.TxPipeline()
+	} else {
+		pipe = d.c.Pipeline()
+	}
+	res := make([]*redis.Cmd, len(cmds))
+	for i, cmd := range cmds {
+		res[i] = pipe.Do(ctx, cmd...)
+	}
+	// Exec returns the first error any of the commands replied with, which is returned in that command's place.
+	if _, err := pipe.Exec(ctx); err != nil && !isReplyError(err) {
+		return nil, err
+	}
+
+	replies := make([]interface{}, len(res))
+	for i, r := range res {
+		v, err := reply(r.Result())
+		if err != nil {
+			replies[i] = err
+		} else {
+			replies[i] = v
+		}
+	}
+	return replies, nil
+}
+
+// reply converts a go-redis reply to what a work.Doer returns: nil replies are returned as (nil, nil) and errors
+// replied by the server as a redigo redis.Error.
+func reply(v interface{}, err error) (interface{}, error) {
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if isReplyError(err) {
+		return nil, redigo.Error(err.Error())
+	}
+	return v, err
+}
+
+// isReplyError returns whether err was replied by the Redis server, rather than, eg, a network error.
+func isReplyError(err error) bool {
+	var rerr redis.Error
+	return errors.As(err, &rerr)
+}
@@ -0,0 +1,104 @@
+package goredis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	work "github.com/teamwork/work/v2"
+)
+
+const ns = "work_goredis"
+
+func newTestClient(t *testing.T) *redis.Client {
+	rdb := redis.NewClient(&redis.Options{Addr: ":6379", Protocol: 2})
+	t.Cleanup(func() { rdb.Close() })
+	keys, err := rdb.Keys(context.Background(), ns+":*").Result()
+	assert.NoError(t, err)
+	if len(keys) > 0 {
+		assert.NoError(t, rdb.Del(context.Background(), keys...).Err())
+	}
+	return rdb
+}
+
+func TestDoer(t *testing.T) {
+	rdb := newTestClient(t)
+	conn := NewPool(rdb).Get()
+	defer conn.Close()
+
+	_, err := redigo.String(conn.Do("GET", ns+":missing"))
+	assert.Equal(t, redigo.ErrNil, err)
+
+	_, err = conn.Do("SET", ns+":str", "a")
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", ns+":str", "b")
+	assert.IsType(t, redigo.Error(""), err)
+	assert.Contains(t, err.Error(), "WRONGTYPE")
+
+	// Pipelined commands get their own replies, with errors in place of the commands that failed.
+	conn.Send("RPUSH", ns+":list", "a", "b")
+	conn.Send("LPUSH", ns+":str", "b")
+	conn.Send("LRANGE", ns+":list", 0, -1)
+	assert.NoError(t, conn.Flush())
+	n, err := redigo.Int64(conn.Receive())
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, n)
+	_, err = conn.Receive()
+	assert.IsType(t, redigo.Error(""), err)
+	vals, err := redigo.Strings(conn.Receive())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, vals)
+
+	conn.Send("MULTI")
+	conn.Send("INCR", ns+":n")
+	conn.Send("HGET", ns+":hash", "missing")
+	replies, err := redigo.Values(conn.Do("EXEC"))
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(1), nil}, replies)
+}
+
+func TestPool(t *testing.T) {
+	rdb := newTestClient(t)
+	pool := NewPool(rdb)
+
+	done := make(chan string, 1)
+	wp := work.NewWorkerPoolWithOptions(struct{}{}, 1, ns, pool, work.WithFetchStrategy(work.FetchBlocking))
+	wp.Job("send_email", func(job *work.Job) error {
+		done <- job.ArgString("address")
+		return nil
+	})
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	job, err := enqueuer.Enqueue("send_email", work.Q{"address": "a@b.com"})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	queues, err := work.NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	if assert.Len(t, queues, 1) {
+		assert.Equal(t, "send_email", queues[0].JobName)
+		assert.EqualValues(t, 1, queues[0].Count)
+	}
+
+	wp.Start()
+	defer wp.Stop()
+	select {
+	case address := <-done:
+		assert.Equal(t, "a@b.com", address)
+	case <-time.After(5 * time.Second):
+		t.Fatal("job wasn't run")
+	}
+}
+
+func TestNewPoolProtocol(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{Addr: ":6379"})
+	defer rdb.Close()
+	assert.Panics(t, func() { NewPool(rdb) })
+
+	rdb = redis.NewClient(&redis.Options{Addr: ":6379", Protocol: 3})
+	defer rdb.Close()
+	assert.Panics(t, func() { NewPool(rdb) })
+}
@@ -1,12 +1,12 @@
 package work
 
 import (
+	"encoding/json"
 	"os"
 	"sort"
 	"strings"
+	"sync"
 	"time"
-
-	"github.com/gomodule/redigo/redis"
 )
 
 const (
@@ -16,26 +16,32 @@ const (
 type workerPoolHeartbeater struct {
 	workerPoolID string
 	namespace    string // eg, "myapp-work"
-	pool         *redis.Pool
+	pool         Pool
 	beatPeriod   time.Duration
+	mu           sync.Mutex // guards concurrency, workerIDs and periodicJobs, which can change while the pool runs
 	concurrency  uint
 	jobNames     string
 	startedAt    int64
 	pid          int
 	hostname     string
+	version      string
+	labels       string // JSON encoded map[string]string
 	workerIDs    string
+	periodicJobs string // JSON encoded []*PeriodicJob, guarded by mu
+	logger       Logger
 
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
 }
 
-func newWorkerPoolHeartbeater(namespace string, pool *redis.Pool, workerPoolID string, jobTypes map[string]*jobType, concurrency uint, workerIDs []string) *workerPoolHeartbeater {
+func newWorkerPoolHeartbeater(namespace string, pool Pool, workerPoolID string, jobTypes map[string]*jobType, concurrency uint, workerIDs []string, logger Logger) *workerPoolHeartbeater {
 	h := &workerPoolHeartbeater{
 		workerPoolID:     workerPoolID,
 		namespace:        namespace,
 		pool:             pool,
 		beatPeriod:       beatPeriod,
 		concurrency:      concurrency,
+		logger:           logger,
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
 	}
@@ -53,7 +59,7 @@ func newWorkerPoolHeartbeater(namespace string, pool *redis.Pool, workerPoolID s
 	h.pid = os.Getpid()
 	host, err := os.Hostname()
 	if err != nil {
-		logError("heartbeat.hostname", err)
+		logError(h.logger, "heartbeat.hostname", err)
 		host = "hostname_errored"
 	}
 	h.hostname = host
@@ -61,6 +67,50 @@ func newWorkerPoolHeartbeater(namespace string, pool *redis.Pool, workerPoolID s
 	return h
 }
 
+// setMetadata sets the version and labels sent with the heartbeat, and replaces the host's name with hostname if it's
+// set. It must be called before start.
+func (h *workerPoolHeartbeater) setMetadata(version, hostname string, labels map[string]string) {
+	h.version = version
+	if hostname != "" {
+		h.hostname = hostname
+	}
+	h.labels = ""
+	if len(labels) > 0 {
+		rawJSON, err := json.Marshal(labels)
+		if err != nil {
+			logError(h.logger, "heartbeat.labels", err)
+			return
+		}
+		h.labels = string(rawJSON)
+	}
+}
+
+// update changes the pool's concurrency and workers for the next heartbeat.
+func (h *workerPoolHeartbeater) update(concurrency uint, workerIDs []string) {
+	sort.Strings(workerIDs)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.concurrency = concurrency
+	h.workerIDs = strings.Join(workerIDs, ",")
+}
+
+// setPeriodicJobs changes the pool's periodic jobs for the next heartbeat.
+func (h *workerPoolHeartbeater) setPeriodicJobs(pjs []*periodicJob) {
+	periodicJobs := make([]*PeriodicJob, 0, len(pjs))
+	for _, pj := range pjs {
+		periodicJobs = append(periodicJobs, &PeriodicJob{Name: pj.jobName, Spec: pj.spec, Location: pj.location(), Args: pj.opts.Args})
+	}
+	rawJSON, err := json.Marshal(periodicJobs)
+	if err != nil {
+		logError(h.logger, "heartbeat.periodic_jobs", err)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.periodicJobs = string(rawJSON)
+}
+
 func (h *workerPoolHeartbeater) start() {
 	go h.loop()
 }
@@ -93,19 +143,26 @@ func (h *workerPoolHeartbeater) heartbeat() {
 	workerPoolsKey := redisKeyWorkerPools(h.namespace)
 	heartbeatKey := redisKeyHeartbeat(h.namespace, h.workerPoolID)
 
+	h.mu.Lock()
+	concurrency, workerIDs, periodicJobs := h.concurrency, h.workerIDs, h.periodicJobs
+	h.mu.Unlock()
+
 	conn.Send("SADD", workerPoolsKey, h.workerPoolID)
 	conn.Send("HMSET", heartbeatKey,
 		"heartbeat_at", nowEpochSeconds(),
 		"started_at", h.startedAt,
 		"job_names", h.jobNames,
-		"concurrency", h.concurrency,
-		"worker_ids", h.workerIDs,
+		"concurrency", concurrency,
+		"worker_ids", workerIDs,
+		"periodic_jobs", periodicJobs,
 		"host", h.hostname,
 		"pid", h.pid,
+		"version", h.version,
+		"labels", h.labels,
 	)
 
 	if err := conn.Flush(); err != nil {
-		logError("heartbeat", err)
+		logError(h.logger, "heartbeat", err)
 	}
 }
 
@@ -120,6 +177,6 @@ func (h *workerPoolHeartbeater) removeHeartbeat() {
 	conn.Send("DEL", heartbeatKey)
 
 	if err := conn.Flush(); err != nil {
-		logError("remove_heartbeat", err)
+		logError(h.logger, "remove_heartbeat", err)
 	}
 }
@@ -21,7 +21,7 @@ func TestHeartbeater(t *testing.T) {
 		"bar": nil,
 	}
 
-	heart := newWorkerPoolHeartbeater(ns, pool, "abcd", jobTypes, 10, []string{"ccc", "bbb"})
+	heart := newWorkerPoolHeartbeater(ns, pool, "abcd", jobTypes, 10, []string{"ccc", "bbb"}, nil)
 	heart.start()
 
 	time.Sleep(20 * time.Millisecond)
@@ -37,12 +37,38 @@ func TestHeartbeater(t *testing.T) {
 
 	assert.True(t, h["pid"] != "")
 	assert.True(t, h["host"] != "")
+	assert.Equal(t, "", h["version"])
+	assert.Equal(t, "", h["labels"])
 
 	heart.stop()
 
 	assert.False(t, redisInSet(pool, redisKeyWorkerPools(ns), "abcd"))
 }
 
+func TestHeartbeaterMetadata(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool,
+		WorkerPoolOptions{Version: "a1b2c3d", Labels: map[string]string{"region": "eu-west-1"}},
+		WithHostname("worker-7d9f8"),
+		WithLabels(map[string]string{"app": "billing"}),
+	)
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.Start()
+	defer wp.Stop()
+	time.Sleep(20 * time.Millisecond)
+
+	hbs, err := NewClient(ns, pool).WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	if assert.Len(t, hbs, 1) {
+		assert.Equal(t, "a1b2c3d", hbs[0].Version)
+		assert.Equal(t, "worker-7d9f8", hbs[0].Host)
+		assert.Equal(t, map[string]string{"region": "eu-west-1", "app": "billing"}, hbs[0].Labels)
+	}
+}
+
 func redisInSet(pool *redis.Pool, key, member string) bool {
 	conn := pool.Get()
 	defer conn.Close()
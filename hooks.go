@@ -0,0 +1,86 @@
+package work
+
+// JobEventHandler is called when a job reaches a point in its lifecycle. err is the error the job failed with, and is
+// nil for OnJobStart, OnJobSuccess and OnJobExpired.
+type JobEventHandler func(job *Job, err error)
+
+type lifecycleHooks struct {
+	onStart   []JobEventHandler
+	onSuccess []JobEventHandler
+	onFailure []JobEventHandler
+	onRetry   []JobEventHandler
+	onDead    []JobEventHandler
+	onPanic   []JobEventHandler
+	onExpired []JobEventHandler
+	onReap    []func(*ReaperEvent)
+
+	onDeadLettered []JobEventHandler
+}
+
+func runHooks(hooks []JobEventHandler, job *Job, err error) {
+	for _, h := range hooks {
+		h(job, err)
+	}
+}
+
+// OnJobStart registers fn to be called before each job is run.
+//
+// Hooks are called synchronously by the worker processing the job, so they should be quick. Register them before
+// calling Start.
+func (wp *WorkerPool) OnJobStart(fn JobEventHandler) *WorkerPool {
+	wp.hooks.onStart = append(wp.hooks.onStart, fn)
+	return wp
+}
+
+// OnJobSuccess registers fn to be called after each job that succeeds.
+func (wp *WorkerPool) OnJobSuccess(fn JobEventHandler) *WorkerPool {
+	wp.hooks.onSuccess = append(wp.hooks.onSuccess, fn)
+	return wp
+}
+
+// OnJobFailure registers fn to be called after each job that fails, whether or not it will be retried.
+func (wp *WorkerPool) OnJobFailure(fn JobEventHandler) *WorkerPool {
+	wp.hooks.onFailure = append(wp.hooks.onFailure, fn)
+	return wp
+}
+
+// OnJobRetry registers fn to be called after a failed job is put in the retry queue.
+func (wp *WorkerPool) OnJobRetry(fn JobEventHandler) *WorkerPool {
+	wp.hooks.onRetry = append(wp.hooks.onRetry, fn)
+	return wp
+}
+
+// OnJobDead registers fn to be called after a failed job that won't be retried is moved to the dead queue. It isn't
+// called for jobs with JobOptions.SkipDead, or for jobs enqueued on their JobOptions.DeadLetterQueue instead.
+func (wp *WorkerPool) OnJobDead(fn JobEventHandler) *WorkerPool {
+	wp.hooks.onDead = append(wp.hooks.onDead, fn)
+	return wp
+}
+
+// OnJobDeadLettered registers fn to be called after a failed job that won't be retried is enqueued on its job type's
+// JobOptions.DeadLetterQueue, in place of the OnJobDead hooks.
+func (wp *WorkerPool) OnJobDeadLettered(fn JobEventHandler) *WorkerPool {
+	wp.hooks.onDeadLettered = append(wp.hooks.onDeadLettered, fn)
+	return wp
+}
+
+// OnPanic registers fn to be called when a job's handler or middleware panics. err holds the recovered value. The job
+// then fails as usual, so the OnJobFailure hooks are called too.
+func (wp *WorkerPool) OnPanic(fn JobEventHandler) *WorkerPool {
+	wp.hooks.onPanic = append(wp.hooks.onPanic, fn)
+	return wp
+}
+
+// OnJobExpired registers fn to be called after a job is discarded without being run because it expired, as per
+// JobOptions.ExpiresIn and Enqueuer.EnqueueWithExpiry.
+func (wp *WorkerPool) OnJobExpired(fn JobEventHandler) *WorkerPool {
+	wp.hooks.onExpired = append(wp.hooks.onExpired, fn)
+	return wp
+}
+
+// OnReap registers fn to be called after this pool's dead pool reaper cleans up after a dead worker pool. It's called
+// from the reaper's goroutine.
+func (wp *WorkerPool) OnReap(fn func(*ReaperEvent)) *WorkerPool {
+	wp.hooks.onReap = append(wp.hooks.onReap, fn)
+	return wp
+}
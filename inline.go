@@ -0,0 +1,46 @@
+package work
+
+import "fmt"
+
+// RunInline makes e run jobs as soon as they're enqueued, in the goroutine that enqueues them, with the handlers and
+// middleware registered on wp, rather than adding them to Redis, eg, for local development, or for the CI of services
+// that enqueue jobs. wp doesn't need to be started. Scheduled jobs run straight away, unique jobs aren't deduplicated,
+// and jobs that fail aren't retried: the Enqueue methods return the error they failed with instead. The steps of a
+// chain run one after the other. Recurring jobs added with EnqueueEvery are still stored in Redis. Passing nil turns
+// inline mode off. Like Use, RunInline must be called before the enqueuer is used.
+// Example: if os.Getenv("WORK_INLINE") != "" { enqueuer.RunInline(wp) }
+func (e *Enqueuer) RunInline(wp *WorkerPool) *Enqueuer {
+	e.inline = wp
+	return e
+}
+
+// runInline runs job, and the rest of its chain if it succeeds, with the handlers of the inline worker pool. Each job is
+// encoded to JSON and back first, as it would be by Redis, so handlers see the same args as they would from a worker.
+func (e *Enqueuer) runInline(job *Job) error {
+	ctx := job.Context()
+	for job != nil {
+		rawJSON, err := job.serialize()
+		if err != nil {
+			return err
+		}
+		inlineJob, err := newJob(rawJSON, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		jt := e.inline.jobTypes[job.Name]
+		if jt == nil {
+			return fmt.Errorf("work: no handler for job %q", job.Name)
+		}
+		inlineJob.PoolID = e.inline.workerPoolID
+		inlineJob.maxFails = jt.MaxFails
+		err = runJobWithTimeout(ctx, jt.Timeout, jobTimeoutGracePeriod, inlineJob, e.inline.contextType, e.inline.middleware, jt)
+		if err != nil {
+			return err
+		}
+		job.result = inlineJob.result
+
+		job = nextChainJob(inlineJob)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnqueuerRunInline(t *testing.T) {
+	// Nothing should touch Redis, so there doesn't need to be one.
+	pool := newTestPool(":0")
+	ns := "work"
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	enqueuer := NewEnqueuer(ns, pool).RunInline(wp)
+
+	var ran []string
+	wp.Middleware(func(job *Job, next NextMiddlewareFunc) error {
+		ran = append(ran, "mw:"+job.Name)
+		return next()
+	})
+	wp.Job("send_email", func(job *Job) error {
+		ran = append(ran, fmt.Sprintf("send_email:%v", job.Args["n"]))
+		return nil
+	})
+	wp.Job("render", func(job *Job) error {
+		return job.SetResult(Q{"n": job.ArgInt64("n") * 2})
+	})
+	wp.Job("fail", func(job *Job) error {
+		return fmt.Errorf("nope")
+	})
+	wp.JobWithOptions("check_ctx", JobOptions{}, func(ctx context.Context, job *Job) error {
+		if ctx.Value(testCtxKey{}) != "yes" {
+			return fmt.Errorf("missing ctx value")
+		}
+		return nil
+	})
+
+	job, err := enqueuer.Enqueue("send_email", Q{"n": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	_, err = enqueuer.EnqueueIn("send_email", 3600, Q{"n": 2})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUnique("send_email", Q{"n": 3})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUnique("send_email", Q{"n": 3})
+	assert.NoError(t, err)
+	jobs, err := enqueuer.EnqueueBatch("send_email", []Q{{"n": 4}, {"n": 5}})
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, []string{
+		"mw:send_email", "send_email:1",
+		"mw:send_email", "send_email:2",
+		"mw:send_email", "send_email:3",
+		"mw:send_email", "send_email:3",
+		"mw:send_email", "send_email:4",
+		"mw:send_email", "send_email:5",
+	}, ran)
+
+	ran = nil
+	_, err = enqueuer.EnqueueChain(Chain("render", Q{"n": 3}).Then("send_email", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"mw:render", "mw:send_email", "send_email:6"}, ran)
+
+	res, _, err := enqueuer.EnqueueAndWait(context.Background(), "render", Q{"n": 4})
+	assert.NoError(t, err)
+	var out struct{ N int }
+	assert.NoError(t, res.Unmarshal(&out))
+	assert.Equal(t, 8, out.N)
+
+	res, _, err = enqueuer.EnqueueAndWait(context.Background(), "fail", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "nope", res.Err)
+
+	job, err = enqueuer.Enqueue("fail", nil)
+	assert.EqualError(t, err, "nope")
+	assert.Nil(t, job)
+	_, err = enqueuer.Enqueue("unknown", nil)
+	assert.EqualError(t, err, `work: no handler for job "unknown"`)
+
+	ctx := context.WithValue(context.Background(), testCtxKey{}, "yes")
+	_, err = enqueuer.EnqueueContext(ctx, "check_ctx", nil)
+	assert.NoError(t, err)
+}
+
+type testCtxKey struct{}
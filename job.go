@@ -1,10 +1,14 @@
 package work
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"reflect"
+	"sync/atomic"
+	"time"
 )
 
 // Job represents a job.
@@ -17,39 +21,171 @@ type Job struct {
 	Unique     bool                   `json:"unique,omitempty"`
 	UniqueKey  string                 `json:"unique_key,omitempty"`
 	PoolID     string                 `json:"pool_id"`
+	Priority   JobPriority            `json:"priority,omitempty"`
+
+	// StoreResult is set for jobs whose JobResult should be stored even if they don't set a result.
+	StoreResult bool `json:"store_result,omitempty"`
+
+	// Chain holds the steps left to run after this job succeeds. See Enqueuer.EnqueueChain.
+	Chain []ChainStep `json:"chain,omitempty"`
+
+	// RunLock is set on the runs of periodic jobs with PeriodicOptions.SkipIfRunning. A run holds the lock it names
+	// while it runs, and is skipped if the previous run still holds it.
+	RunLock string `json:"run_lock,omitempty"`
+
+	// ExpiresAt is when, in epoch seconds, the job stops being worth running, and is discarded if it's fetched after.
+	// It's set by Enqueuer.EnqueueWithExpiry, and overrides the job type's JobOptions.ExpiresIn.
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+
+	// IdempotencyKey is set by Enqueuer.EnqueueWithIdempotencyKey. Only one job of the same name with the same key is
+	// run successfully, however many times it's enqueued or requeued, within the job type's JobOptions.IdempotencyTTL.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 
 	// Inputs when retrying
 	Fails    int64  `json:"fails,omitempty"` // number of times this job has failed
 	LastErr  string `json:"err,omitempty"`
 	FailedAt int64  `json:"failed_at,omitempty"`
 
-	rawJSON      []byte
-	dequeuedFrom []byte
-	inProgQueue  []byte
-	argError     error
-	observer     *observer
-	aliveChecker func(*Job) (bool, error)
-	killed       bool
+	// FirstEnqueuedAt is when the job was first enqueued, as EnqueuedAt is reset each time the job is retried.
+	FirstEnqueuedAt int64 `json:"first_t,omitempty"`
+	// Backtrace is the stack trace of the panic the job last failed with, if it did.
+	Backtrace string `json:"backtrace,omitempty"`
+
+	// DeadLetter is set on the jobs enqueued to a JobOptions.DeadLetterQueue, describing the job that failed.
+	DeadLetter *DeadLetter `json:"dead_letter,omitempty"`
+
+	rawJSON       []byte
+	dequeuedFrom  []byte
+	inProgQueue   []byte
+	partition     string // the partition the job holds, if its job type is partitioned
+	streamID      string // the job's stream entry, if it was read from its job type's stream (see BackendStreams)
+	argError      error
+	observer      *observer
+	aliveChecker  func(*Job) (bool, error)
+	killed        int32 // accessed atomically, as it's also checked by the worker while the handler runs
+	result        []byte
+	ctx           context.Context
+	codec         Codec  // what Args are encoded with when the job is serialized, if not JSON
+	compressAbove int    // the size of encoded Args above which they're gzipped when the job is serialized, if not 0
+	partitionArg  string // the arg kept as JSON when Args are encoded, so that the job's partition can be claimed
+	maxFails      uint   // the MaxFails of the job's type, set when it's run
+}
+
+// DeadLetter describes a job that exhausted its retries, for the job it was enqueued as on its job type's
+// JobOptions.DeadLetterQueue.
+type DeadLetter struct {
+	Namespace       string `json:"namespace"`
+	Name            string `json:"name"`
+	ID              string `json:"id"`
+	Fails           int64  `json:"fails"`
+	LastErr         string `json:"err"`
+	FailedAt        int64  `json:"failed_at"`
+	FirstEnqueuedAt int64  `json:"first_t"`
 }
 
 // Q is a shortcut to easily specify arguments for jobs when enqueueing them.
 // Example: e.Enqueue("send_email", work.Q{"addr": "test@example.com", "track": true})
 type Q map[string]interface{}
 
+// JobPriority is the priority of a single job relative to other jobs with the same name. See Enqueuer.EnqueueWithPriority.
+type JobPriority int
+
+// Job priorities for use with Enqueuer.EnqueueWithPriority. Any value from -1000 to 1000 can be used.
+const (
+	PriorityLow      JobPriority = -10
+	PriorityNormal   JobPriority = 0
+	PriorityHigh     JobPriority = 10
+	PriorityCritical JobPriority = 100
+)
+
+const maxJobPriority = 1000
+
 func newJob(rawJSON, dequeuedFrom, inProgQueue []byte) (*Job, error) {
 	var job Job
-	err := json.Unmarshal(rawJSON, &job)
+	encoded := encodedJob{Job: &job}
+	err := json.Unmarshal(rawJSON, &encoded)
 	if err != nil {
 		return nil, err
 	}
+	job.Args = encoded.Args
+	if encoded.Codec != "" {
+		// The only arg kept as JSON alongside the payload is the one the job type is partitioned by.
+		for k := range encoded.Args {
+			job.partitionArg = k
+		}
+		codec, err := lookupCodec(encoded.Codec)
+		if err != nil {
+			return nil, err
+		}
+		payload := encoded.Payload
+		switch encoded.Compression {
+		case "":
+		case compressionGzip:
+			if payload, err = gunzipPayload(payload); err != nil {
+				return nil, fmt.Errorf("work: decompressing the args of job %q: %w", job.Name, err)
+			}
+			// Keep compressing the job's args, eg, when it's retried.
+			job.compressAbove = 1
+		default:
+			return nil, fmt.Errorf("work: unknown compression %q", encoded.Compression)
+		}
+		if err := codec.Unmarshal(payload, &job.Args); err != nil {
+			return nil, fmt.Errorf("work: decoding the args of job %q with codec %q: %w", job.Name, encoded.Codec, err)
+		}
+		job.codec = codec
+	}
 	job.rawJSON = rawJSON
 	job.dequeuedFrom = dequeuedFrom
 	job.inProgQueue = inProgQueue
 	return &job, nil
 }
 
+// newUndecodedJob returns the job in rawJSON without decoding its args, for jobs whose args can't be decoded, eg, as
+// their codec isn't registered in this process. Only the args kept as JSON alongside the encoded ones are set.
+func newUndecodedJob(rawJSON []byte) (*Job, error) {
+	var job Job
+	encoded := encodedJob{Job: &job}
+	if err := json.Unmarshal(rawJSON, &encoded); err != nil {
+		return nil, err
+	}
+	job.Args = encoded.Args
+	job.rawJSON = rawJSON
+	return &job, nil
+}
+
 func (j *Job) serialize() ([]byte, error) {
-	return json.Marshal(j)
+	codec := j.codec
+	if codec == nil {
+		codec = JSONCodec
+	}
+	if codec == JSONCodec && j.compressAbove == 0 {
+		return json.Marshal(j)
+	}
+
+	payload, err := codec.Marshal(j.Args)
+	if err != nil {
+		return nil, fmt.Errorf("work: encoding the args of job %q with codec %q: %w", j.Name, codec.Name(), err)
+	}
+	var compression string
+	if j.compressAbove > 0 && len(payload) > j.compressAbove {
+		compressed, err := gzipPayload(payload)
+		if err != nil {
+			return nil, fmt.Errorf("work: compressing the args of job %q: %w", j.Name, err)
+		}
+		// The payload is base64 encoded in the job's JSON, so only compress args that shrink by more than that adds.
+		if payloadSize(compressed, false) < payloadSize(payload, codec == JSONCodec) {
+			payload, compression = compressed, compressionGzip
+		}
+	}
+	if compression == "" && codec == JSONCodec {
+		return json.Marshal(j)
+	}
+	// The fetch script reads the job's partition from its args, so that arg is kept as JSON too.
+	var plain map[string]interface{}
+	if v, ok := j.Args[j.partitionArg]; ok && j.partitionArg != "" {
+		plain = map[string]interface{}{j.partitionArg: v}
+	}
+	return json.Marshal(&encodedJob{Job: j, Args: plain, Codec: codec.Name(), Compression: compression, Payload: payload})
 }
 
 // setArg sets a single named argument on the job.
@@ -64,6 +200,95 @@ func (j *Job) failed(err error) {
 	j.Fails++
 	j.LastErr = err.Error()
 	j.FailedAt = nowEpochSeconds()
+	if j.FirstEnqueuedAt == 0 {
+		j.FirstEnqueuedAt = j.EnqueuedAt
+	}
+
+	j.Backtrace = ""
+	var panicErr *panicError
+	if errors.As(err, &panicErr) {
+		j.Backtrace = string(panicErr.stack)
+	}
+}
+
+// clone returns a copy of the job for a handler to run on in the background, so that it doesn't share the job with the
+// worker. Args are copied too, as middleware may set them.
+func (j *Job) clone() *Job {
+	c := &Job{
+		Name:            j.Name,
+		ID:              j.ID,
+		EnqueuedAt:      j.EnqueuedAt,
+		Unique:          j.Unique,
+		UniqueKey:       j.UniqueKey,
+		PoolID:          j.PoolID,
+		Priority:        j.Priority,
+		StoreResult:     j.StoreResult,
+		Chain:           j.Chain,
+		RunLock:         j.RunLock,
+		ExpiresAt:       j.ExpiresAt,
+		IdempotencyKey:  j.IdempotencyKey,
+		Fails:           j.Fails,
+		LastErr:         j.LastErr,
+		FailedAt:        j.FailedAt,
+		FirstEnqueuedAt: j.FirstEnqueuedAt,
+		Backtrace:       j.Backtrace,
+		DeadLetter:      j.DeadLetter,
+		rawJSON:         j.rawJSON,
+		dequeuedFrom:    j.dequeuedFrom,
+		inProgQueue:     j.inProgQueue,
+		partition:       j.partition,
+		streamID:        j.streamID,
+		argError:        j.argError,
+		observer:        j.observer,
+		aliveChecker:    j.aliveChecker,
+		killed:          atomic.LoadInt32(&j.killed),
+		result:          j.result,
+		ctx:             j.ctx,
+		codec:           j.codec,
+		compressAbove:   j.compressAbove,
+		partitionArg:    j.partitionArg,
+		maxFails:        j.maxFails,
+	}
+	if j.Args != nil {
+		c.Args = make(map[string]interface{}, len(j.Args))
+		for k, v := range j.Args {
+			c.Args[k] = v
+		}
+	}
+	return c
+}
+
+// expired reports whether, at now in epoch seconds, the job is past its ExpiresAt or, if it has none, has waited for
+// longer than expiresIn, if it's non-zero, since it was first enqueued or, if it was scheduled, was due.
+func (j *Job) expired(expiresIn time.Duration, now int64) bool {
+	if j.ExpiresAt != 0 {
+		return now > j.ExpiresAt
+	}
+	if expiresIn <= 0 {
+		return false
+	}
+	enqueuedAt := j.FirstEnqueuedAt
+	if enqueuedAt == 0 {
+		enqueuedAt = j.EnqueuedAt
+	}
+	return now > enqueuedAt+int64(expiresIn/time.Second)
+}
+
+// Attempt returns which run of the job this is, starting at 1 and going up each time it's retried.
+func (j *Job) Attempt() int64 {
+	return j.Fails + 1
+}
+
+// MaxFails returns the JobOptions.MaxFails of the job's type, ie, how many attempts it gets, or 0 if it isn't being run
+// by a worker pool.
+func (j *Job) MaxFails() uint {
+	return j.maxFails
+}
+
+// IsLastAttempt reports whether the job won't be retried if this attempt fails, eg, so that its handler can notify a
+// human instead. It's false if the job isn't being run by a worker pool.
+func (j *Job) IsLastAttempt() bool {
+	return j.maxFails > 0 && j.Attempt() >= int64(j.maxFails)
 }
 
 // Checkin will update the status of the executing job to the specified messages. This message is visible within the web UI. This is useful for indicating some sort of progress on very long running jobs. For instance, on a job that has to process a million records over the course of an hour, the job could call Checkin with the current job number every 10k jobs.
@@ -73,19 +298,73 @@ func (j *Job) Checkin(msg string) {
 	}
 }
 
+// SetProgress reports that the job has done done of total units of work, eg, rows of an import. Progress is visible
+// within the web UI and through Client.WorkerObservations, eg, to show end users how far through an export is. It's
+// cheap enough to call for every unit, as only the latest progress is written to Redis, once a second.
+func (j *Job) SetProgress(done, total int64) {
+	if j.observer != nil {
+		j.observer.observeProgress(j.Name, j.ID, done, total)
+	}
+}
+
+// Context returns the job's context. In middleware and handlers it's the context that's passed to handlers which take
+// one, and is cancelled if the job is cancelled or times out. In enqueue middleware it's the context passed to
+// Enqueuer.EnqueueContext. It's never nil.
+func (j *Job) Context() context.Context {
+	if j.ctx == nil {
+		return context.Background()
+	}
+	return j.ctx
+}
+
+// SetContext replaces the job's context, so that middleware can pass values such as a tracing span on to later
+// middleware and the handler. ctx should be derived from the job's current context so that cancellation still works.
+func (j *Job) SetContext(ctx context.Context) {
+	if ctx == nil {
+		panic("work: nil context")
+	}
+	j.ctx = ctx
+}
+
+// SetResult stores result, encoded as JSON, as the job's result once it finishes successfully. It can then be read
+// with Client.JobResult or by the caller of Enqueuer.EnqueueAndWait. Results expire after the job's
+// JobOptions.ResultTTL.
+func (j *Job) SetResult(result interface{}) error {
+	rawJSON, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	j.result = rawJSON
+	return nil
+}
+
 // Alive returns whether the job has been flagged to be stopped. See Client.KillJob
 func (j *Job) Alive() (bool, error) {
-	if j.killed {
+	if atomic.LoadInt32(&j.killed) == 1 {
 		return false, nil
 	}
 
 	if j.aliveChecker != nil {
 		alive, err := j.aliveChecker(j)
-		j.killed = !alive
+		if !alive {
+			atomic.StoreInt32(&j.killed, 1)
+		}
 		return alive, err
 	}
 
-	return !j.killed, nil
+	return true, nil
+}
+
+// Cancelled returns whether the job has been cancelled with Client.CancelJob. Handlers that don't take a context
+// can check this periodically and return early. Errors checking with Redis are treated as not cancelled.
+func (j *Job) Cancelled() bool {
+	alive, err := j.Alive()
+	return err == nil && !alive
+}
+
+// wasCancelled returns whether the job has been seen to be cancelled, without checking with Redis.
+func (j *Job) wasCancelled() bool {
+	return atomic.LoadInt32(&j.killed) == 1
 }
 
 // ArgString returns j.Args[key] typed to a string. If the key is missing or of the wrong type, it sets an argument error
@@ -98,7 +377,7 @@ func (j *Job) ArgString(key string) string {
 		if ok {
 			return typedV
 		}
-		j.argError = typecastError("string", key, v)
+		j.argError = typecastError("string", key, v, j.redactedArg(key, v))
 	} else {
 		j.argError = missingKeyError("string", key)
 	}
@@ -126,7 +405,7 @@ func (j *Job) ArgInt64(key string) int64 {
 				return vInt64
 			}
 		}
-		j.argError = typecastError("int64", key, v)
+		j.argError = typecastError("int64", key, v, j.redactedArg(key, v))
 	} else {
 		j.argError = missingKeyError("int64", key)
 	}
@@ -147,7 +426,7 @@ func (j *Job) ArgFloat64(key string) float64 {
 		} else if isFloatKind(rVal) {
 			return rVal.Float()
 		}
-		j.argError = typecastError("float64", key, v)
+		j.argError = typecastError("float64", key, v, j.redactedArg(key, v))
 	} else {
 		j.argError = missingKeyError("float64", key)
 	}
@@ -164,7 +443,7 @@ func (j *Job) ArgBool(key string) bool {
 		if ok {
 			return typedV
 		}
-		j.argError = typecastError("bool", key, v)
+		j.argError = typecastError("bool", key, v, j.redactedArg(key, v))
 	} else {
 		j.argError = missingKeyError("bool", key)
 	}
@@ -176,6 +455,35 @@ func (j *Job) ArgError() error {
 	return j.argError
 }
 
+// Args decodes the job's arguments into a T, typically the struct the job was enqueued with by Enqueuer.EnqueueTyped,
+// as an alternative to the Arg accessors. Arguments that T doesn't have a field for are ignored.
+// Example: args, err := work.Args[SyncArgs](job)
+func Args[T any](job *Job) (T, error) {
+	var args T
+	rawJSON, err := json.Marshal(job.Args)
+	if err != nil {
+		return args, fmt.Errorf("work: encoding the args of job %q: %w", job.Name, err)
+	}
+	if err := json.Unmarshal(rawJSON, &args); err != nil {
+		return args, fmt.Errorf("work: decoding the args of job %q into %T: %w", job.Name, args, err)
+	}
+	return args, nil
+}
+
+// argsFromStruct returns payload as job arguments, by encoding it to JSON and back. payload must encode to a JSON
+// object, so it's typically a struct or a map.
+func argsFromStruct(payload interface{}) (map[string]interface{}, error) {
+	rawJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	var args map[string]interface{}
+	if err := json.Unmarshal(rawJSON, &args); err != nil {
+		return nil, fmt.Errorf("work: %T doesn't encode to a JSON object, so can't be used as job arguments", payload)
+	}
+	return args, nil
+}
+
 func isIntKind(v reflect.Value) bool {
 	k := v.Kind()
 	return k == reflect.Int || k == reflect.Int8 || k == reflect.Int16 || k == reflect.Int32 || k == reflect.Int64
@@ -195,7 +503,8 @@ func missingKeyError(jsonType, key string) error {
 	return fmt.Errorf("looking for a %s in job.Arg[%s] but key wasn't found", jsonType, key)
 }
 
-func typecastError(jsonType, key string, v interface{}) error {
+// typecastError is the error for an argument v of the wrong type. shown is what's shown of v, as it may be redacted.
+func typecastError(jsonType, key string, v, shown interface{}) error {
 	actualType := reflect.TypeOf(v)
-	return fmt.Errorf("looking for a %s in job.Arg[%s] but value wasn't right type: %v(%v)", jsonType, key, actualType, v)
+	return fmt.Errorf("looking for a %s in job.Arg[%s] but value wasn't right type: %v(%v)", jsonType, key, actualType, shown)
 }
@@ -1,8 +1,10 @@
 package work
 
 import (
+	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -254,3 +256,132 @@ func TestJobArgumentExtractionBadFloat(t *testing.T) {
 		j.argError = nil
 	}
 }
+
+func TestJobCancelled(t *testing.T) {
+	checks := 0
+	killed := false
+	j := &Job{aliveChecker: func(*Job) (bool, error) {
+		checks++
+		return !killed, nil
+	}}
+
+	assert.False(t, j.Cancelled())
+	killed = true
+	assert.True(t, j.Cancelled())
+
+	// Once seen, cancellation sticks without checking again.
+	killed = false
+	assert.True(t, j.Cancelled())
+	assert.Equal(t, 2, checks)
+
+	j = &Job{aliveChecker: func(*Job) (bool, error) {
+		return false, fmt.Errorf("redis down")
+	}}
+	assert.False(t, j.Cancelled())
+}
+
+func TestJobFailed(t *testing.T) {
+	j := &Job{EnqueuedAt: 1425263409}
+	j.failed(&panicError{value: "dayam", stack: []byte("goroutine 1 [running]")})
+	assert.EqualValues(t, 1, j.Fails)
+	assert.Equal(t, "dayam", j.LastErr)
+	assert.EqualValues(t, 1425263409, j.FirstEnqueuedAt)
+	assert.Equal(t, "goroutine 1 [running]", j.Backtrace)
+
+	// Retried jobs are enqueued again, but keep their first enqueue time, and lose the backtrace of an earlier panic.
+	j.EnqueuedAt = 1425263509
+	j.failed(fmt.Errorf("sorry kid"))
+	assert.EqualValues(t, 2, j.Fails)
+	assert.Equal(t, "sorry kid", j.LastErr)
+	assert.EqualValues(t, 1425263409, j.FirstEnqueuedAt)
+	assert.Equal(t, "", j.Backtrace)
+}
+
+func TestJobClone(t *testing.T) {
+	j := &Job{
+		Name:           "wat",
+		ID:             "1",
+		EnqueuedAt:     1425263409,
+		Args:           Q{"a": 1},
+		IdempotencyKey: "k",
+		Fails:          2,
+		rawJSON:        []byte(`{"name":"wat"}`),
+		partition:      "p",
+		killed:         1,
+		result:         []byte("3"),
+		maxFails:       4,
+	}
+	c := j.clone()
+	assert.Equal(t, j, c)
+
+	// Args set on the copy, eg, by middleware, don't touch the original.
+	c.setArg("b", 2)
+	assert.Equal(t, map[string]interface{}{"a": 1}, j.Args)
+}
+
+func TestJobExpired(t *testing.T) {
+	j := &Job{EnqueuedAt: 1425263409}
+	assert.False(t, j.expired(0, 1425263509))
+	assert.False(t, j.expired(time.Minute, 1425263469))
+	assert.True(t, j.expired(time.Minute, 1425263470))
+
+	// Retries count from when the job was first enqueued.
+	j = &Job{EnqueuedAt: 1425263509, FirstEnqueuedAt: 1425263409}
+	assert.True(t, j.expired(time.Minute, 1425263510))
+
+	// A job's own ExpiresAt overrides its job type's ExpiresIn.
+	j = &Job{EnqueuedAt: 1425263409, ExpiresAt: 1425263419}
+	assert.False(t, j.expired(0, 1425263419))
+	assert.True(t, j.expired(0, 1425263420))
+	assert.False(t, (&Job{EnqueuedAt: 1425263409, ExpiresAt: 1425263509}).expired(time.Second, 1425263419))
+}
+
+func TestJobAttempts(t *testing.T) {
+	pool := newTestPool(":0")
+	wp := NewWorkerPool(TestContext{}, 1, "work", pool)
+	var attempts []string
+	wp.JobWithOptions("wat", JobOptions{MaxFails: 2}, func(job *Job) error {
+		attempts = append(attempts, fmt.Sprintf("%d/%d last:%v", job.Attempt(), job.MaxFails(), job.IsLastAttempt()))
+		return nil
+	})
+
+	enqueuer := NewEnqueuer("work", pool).RunInline(wp)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1/2 last:false"}, attempts)
+
+	// Retried jobs have failed before.
+	j := &Job{Fails: 1, maxFails: 2}
+	assert.EqualValues(t, 2, j.Attempt())
+	assert.True(t, j.IsLastAttempt())
+
+	// Jobs that aren't being run don't know how many attempts they get.
+	j = &Job{Fails: 5}
+	assert.EqualValues(t, 0, j.MaxFails())
+	assert.False(t, j.IsLastAttempt())
+}
+
+func TestJobArgsTyped(t *testing.T) {
+	type syncArgs struct {
+		AccountID int64    `json:"account_id"`
+		Full      bool     `json:"full"`
+		Tags      []string `json:"tags"`
+	}
+
+	args, err := argsFromStruct(syncArgs{AccountID: 4, Full: true, Tags: []string{"a"}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"account_id": 4.0, "full": true, "tags": []interface{}{"a"}}, args)
+
+	job := &Job{Name: "sync", Args: args}
+	got, err := Args[syncArgs](job)
+	assert.NoError(t, err)
+	assert.Equal(t, syncArgs{AccountID: 4, Full: true, Tags: []string{"a"}}, got)
+
+	job = &Job{Name: "sync", Args: Q{"account_id": "four"}}
+	_, err = Args[syncArgs](job)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `work: decoding the args of job "sync" into work.syncArgs`)
+
+	_, err = argsFromStruct([]int{1})
+	assert.EqualError(t, err, "work: []int doesn't encode to a JSON object, so can't be used as job arguments")
+}
@@ -1,7 +1,39 @@
 package work
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
-func logError(key string, err error) {
-	fmt.Printf("ERROR: %s - %s\n", key, err.Error())
+// Logger receives errors that can't be returned to the caller, such as Redis errors in a worker pool's background
+// goroutines and panics in handlers. msg is a short key identifying where the error happened, eg, "worker.fetch",
+// and args are alternating keys and values, always including "error". A *slog.Logger satisfies Logger.
+//
+// By default errors are printed to stdout. See WorkerPoolOptions.Logger, Client.SetLogger and Enqueuer.SetLogger.
+type Logger interface {
+	Error(msg string, args ...interface{})
+}
+
+type stdoutLogger struct{}
+
+func (stdoutLogger) Error(msg string, args ...interface{}) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ERROR: %s", msg)
+	for i := 0; i+1 < len(args); i += 2 {
+		if args[i] == "error" {
+			fmt.Fprintf(&b, " - %v", args[i+1])
+		} else {
+			fmt.Fprintf(&b, " %v=%v", args[i], args[i+1])
+		}
+	}
+	fmt.Println(b.String())
+}
+
+var defaultLogger Logger = stdoutLogger{}
+
+func logError(logger Logger, key string, err error) {
+	if logger == nil {
+		logger = defaultLogger
+	}
+	logger.Error(key, "error", err)
 }
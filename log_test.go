@@ -0,0 +1,41 @@
+package work
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testLogger struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (l *testLogger) Error(msg string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, fmt.Sprintf("%s %v", msg, args))
+}
+
+func (l *testLogger) messages() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.entries...)
+}
+
+func TestLogError(t *testing.T) {
+	logger := &testLogger{}
+	logError(logger, "worker.fetch", fmt.Errorf("oops"))
+	assert.Equal(t, []string{"worker.fetch [error oops]"}, logger.messages())
+
+	fallback := &testLogger{}
+	oldDefault := defaultLogger
+	defaultLogger = fallback
+	defer func() { defaultLogger = oldDefault }()
+
+	logError(nil, "heartbeat", fmt.Errorf("down"))
+	assert.Equal(t, []string{"heartbeat [error down]"}, fallback.messages())
+	assert.Len(t, logger.messages(), 1)
+}
@@ -0,0 +1,288 @@
+// Package memstore enqueues and runs jobs in memory, for unit tests of job handlers and of the code that enqueues them,
+// without Redis. A Store implements work.JobEnqueuer, and only runs jobs when the test asks it to:
+//
+//	store := memstore.New()
+//	store.Job("send_email", sendEmail)
+//	signUp(store, "a@example.com") // takes a work.JobEnqueuer, which is a *work.Enqueuer in production
+//	assert.Len(t, store.Queued("send_email"), 1)
+//	assert.NoError(t, store.Drain())
+//
+// Args are encoded to JSON and back when a job is enqueued, as they are with Redis, so handlers see numbers as
+// float64s, etc. Job options such as retries, concurrency controls and priorities aren't emulated.
+package memstore
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	work "github.com/teamwork/work/v2"
+)
+
+var _ work.JobEnqueuer = (*Store)(nil)
+
+// Store holds jobs in memory, along with the handlers to run them.
+type Store struct {
+	mtx       sync.Mutex
+	handlers  map[string]func(context.Context, *work.Job) error
+	queued    []*work.Job
+	scheduled []*work.ScheduledJob
+	processed []*work.Job
+	failed    []*work.Job
+}
+
+// New returns an empty Store.
+func New() *Store {
+	return &Store{handlers: make(map[string]func(context.Context, *work.Job) error)}
+}
+
+// Job registers fn as the handler of jobs named name. As with WorkerPool.Job, fn can be a func(*work.Job) error or a
+// func(context.Context, *work.Job) error. Handlers with a custom context type aren't supported.
+func (s *Store) Job(name string, fn interface{}) *Store {
+	var handler func(context.Context, *work.Job) error
+	switch fn := fn.(type) {
+	case func(*work.Job) error:
+		handler = func(_ context.Context, job *work.Job) error { return fn(job) }
+	case func(context.Context, *work.Job) error:
+		handler = fn
+	default:
+		panic(fmt.Sprintf("memstore: the handler of %q must be a func(*work.Job) error or a func(context.Context, *work.Job) error", name))
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.handlers[name] = handler
+	return s
+}
+
+// Enqueue adds a job to the end of the queue.
+func (s *Store) Enqueue(jobName string, args map[string]interface{}) (*work.Job, error) {
+	return s.EnqueueContext(context.Background(), jobName, args)
+}
+
+// EnqueueContext adds a job to the end of the queue. ctx is passed to the job's handler, if it takes one.
+func (s *Store) EnqueueContext(ctx context.Context, jobName string, args map[string]interface{}) (*work.Job, error) {
+	job, err := newJob(jobName, args)
+	if err != nil {
+		return nil, err
+	}
+	job.SetContext(ctx)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.queued = append(s.queued, job)
+	return job, nil
+}
+
+// EnqueueIn schedules a job to be run in secondsFromNow seconds. It's added to the queue by EnqueueScheduled.
+func (s *Store) EnqueueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*work.ScheduledJob, error) {
+	return s.EnqueueAt(jobName, time.Now().Add(time.Duration(secondsFromNow)*time.Second), args)
+}
+
+// EnqueueAt schedules a job to be run at the given time. It's added to the queue by EnqueueScheduled.
+func (s *Store) EnqueueAt(jobName string, at time.Time, args map[string]interface{}) (*work.ScheduledJob, error) {
+	job, err := newJob(jobName, args)
+	if err != nil {
+		return nil, err
+	}
+	scheduledJob := &work.ScheduledJob{RunAt: at.Unix(), Job: job}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.scheduled = append(s.scheduled, scheduledJob)
+	return scheduledJob, nil
+}
+
+// EnqueueUnique adds a job to the end of the queue, unless a job with the same name and args is already queued or
+// scheduled, in which case it returns nil.
+func (s *Store) EnqueueUnique(jobName string, args map[string]interface{}) (*work.Job, error) {
+	job, err := newJob(jobName, args)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.isEnqueued(job) {
+		return nil, nil
+	}
+	s.queued = append(s.queued, job)
+	return job, nil
+}
+
+// EnqueueUniqueIn schedules a job as per EnqueueIn, unless a job with the same name and args is already queued or
+// scheduled, in which case it returns nil.
+func (s *Store) EnqueueUniqueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*work.ScheduledJob, error) {
+	job, err := newJob(jobName, args)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.isEnqueued(job) {
+		return nil, nil
+	}
+	scheduledJob := &work.ScheduledJob{RunAt: time.Now().Unix() + secondsFromNow, Job: job}
+	s.scheduled = append(s.scheduled, scheduledJob)
+	return scheduledJob, nil
+}
+
+// isEnqueued returns whether a job with the same name and args as job is queued or scheduled. s.mtx must be held.
+func (s *Store) isEnqueued(job *work.Job) bool {
+	key := uniqueKey(job)
+	for _, j := range s.queued {
+		if uniqueKey(j) == key {
+			return true
+		}
+	}
+	for _, j := range s.scheduled {
+		if uniqueKey(j.Job) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Queued returns the jobs named jobName that are waiting to be run, in the order they'll be run, or all of them if
+// jobName is empty.
+func (s *Store) Queued(jobName string) []*work.Job {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return filter(s.queued, jobName)
+}
+
+// Scheduled returns the scheduled jobs named jobName, or all of them if jobName is empty, soonest first.
+func (s *Store) Scheduled(jobName string) []*work.ScheduledJob {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	var jobs []*work.ScheduledJob
+	for _, job := range s.scheduled {
+		if jobName == "" || job.Name == jobName {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].RunAt < jobs[j].RunAt })
+	return jobs
+}
+
+// Processed returns the jobs named jobName that have been run and succeeded, or all of them if jobName is empty.
+func (s *Store) Processed(jobName string) []*work.Job {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return filter(s.processed, jobName)
+}
+
+// Failed returns the jobs named jobName that have been run and failed, or all of them if jobName is empty. Their
+// LastErr holds the error they failed with.
+func (s *Store) Failed(jobName string) []*work.Job {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return filter(s.failed, jobName)
+}
+
+// EnqueueScheduled adds the scheduled jobs to the end of the queue, soonest first, as if they were due.
+func (s *Store) EnqueueScheduled() {
+	jobs := s.Scheduled("")
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	for _, job := range jobs {
+		s.queued = append(s.queued, job.Job)
+	}
+	s.scheduled = nil
+}
+
+// RunNext runs the job at the head of the queue, returning false if the queue's empty. The error is the one the job
+// failed with, if it did.
+func (s *Store) RunNext() (bool, error) {
+	s.mtx.Lock()
+	if len(s.queued) == 0 {
+		s.mtx.Unlock()
+		return false, nil
+	}
+	job := s.queued[0]
+	s.queued = s.queued[1:]
+	handler := s.handlers[job.Name]
+	s.mtx.Unlock()
+
+	err := run(handler, job)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if err != nil {
+		job.Fails++
+		job.LastErr = err.Error()
+		job.FailedAt = time.Now().Unix()
+		s.failed = append(s.failed, job)
+	} else {
+		s.processed = append(s.processed, job)
+	}
+	return true, err
+}
+
+// Drain runs the queued jobs, including those enqueued by the jobs it runs, until the queue is empty. It returns the
+// first error a job failed with, if any did.
+func (s *Store) Drain() error {
+	var firstErr error
+	for {
+		ran, err := s.RunNext()
+		if !ran {
+			return firstErr
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+}
+
+// run runs job with handler, turning a panic into an error.
+func run(handler func(context.Context, *work.Job) error, job *work.Job) (err error) {
+	if handler == nil {
+		return fmt.Errorf("memstore: no handler for job %q", job.Name)
+	}
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = fmt.Errorf("%v", panicErr)
+		}
+	}()
+	return handler(job.Context(), job)
+}
+
+// newJob makes a job as it would be read from Redis, with its args encoded to JSON and back.
+func newJob(jobName string, args map[string]interface{}) (*work.Job, error) {
+	id := make([]byte, 12)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	rawJSON, err := json.Marshal(&work.Job{Name: jobName, ID: hex.EncodeToString(id), EnqueuedAt: time.Now().Unix(), Args: args})
+	if err != nil {
+		return nil, err
+	}
+	var job work.Job
+	if err := json.Unmarshal(rawJSON, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// uniqueKey identifies jobs with the same name and args.
+func uniqueKey(job *work.Job) string {
+	rawArgs, _ := json.Marshal(job.Args) // they've been encoded already
+	return job.Name + ":" + string(rawArgs)
+}
+
+func filter(jobs []*work.Job, jobName string) []*work.Job {
+	var filtered []*work.Job
+	for _, job := range jobs {
+		if jobName == "" || job.Name == jobName {
+			filtered = append(filtered, job)
+		}
+	}
+	return filtered
+}
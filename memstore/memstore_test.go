@@ -0,0 +1,88 @@
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	work "github.com/teamwork/work/v2"
+)
+
+func TestStore(t *testing.T) {
+	store := New()
+	var emails []string
+	store.Job("send_email", func(job *work.Job) error {
+		emails = append(emails, job.ArgString("addr"))
+		return job.ArgError()
+	})
+	store.Job("sign_up", func(ctx context.Context, job *work.Job) error {
+		_, err := store.Enqueue("send_email", work.Q{"addr": job.ArgString("addr")})
+		return err
+	})
+
+	var enqueuer work.JobEnqueuer = store
+	_, err := enqueuer.Enqueue("sign_up", work.Q{"addr": "a@example.com"})
+	assert.NoError(t, err)
+	job, err := enqueuer.EnqueueUnique("send_email", work.Q{"addr": "b@example.com"})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	job, err = enqueuer.EnqueueUnique("send_email", work.Q{"addr": "b@example.com"})
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+	_, err = enqueuer.EnqueueIn("send_email", 60, work.Q{"addr": "c@example.com"})
+	assert.NoError(t, err)
+
+	assert.Len(t, store.Queued(""), 2)
+	assert.Len(t, store.Queued("send_email"), 1)
+	assert.Len(t, store.Scheduled("send_email"), 1)
+
+	assert.NoError(t, store.Drain())
+	assert.Equal(t, []string{"b@example.com", "a@example.com"}, emails)
+	assert.Len(t, store.Processed("send_email"), 2)
+	assert.Len(t, store.Processed(""), 3)
+	assert.Empty(t, store.Queued(""))
+
+	store.EnqueueScheduled()
+	assert.Empty(t, store.Scheduled(""))
+	ran, err := store.RunNext()
+	assert.True(t, ran)
+	assert.NoError(t, err)
+	assert.Equal(t, "c@example.com", emails[2])
+
+	ran, err = store.RunNext()
+	assert.False(t, ran)
+	assert.NoError(t, err)
+}
+
+func TestStoreFailures(t *testing.T) {
+	store := New()
+	store.Job("charge", func(job *work.Job) error {
+		if job.ArgInt64("amount") < 0 {
+			panic("negative amount")
+		}
+		return fmt.Errorf("declined")
+	})
+
+	_, err := store.Enqueue("charge", work.Q{"amount": 5})
+	assert.NoError(t, err)
+	_, err = store.Enqueue("charge", work.Q{"amount": -5})
+	assert.NoError(t, err)
+	_, err = store.Enqueue("refund", nil)
+	assert.NoError(t, err)
+
+	assert.EqualError(t, store.Drain(), "declined")
+	failed := store.Failed("")
+	if assert.Len(t, failed, 3) {
+		assert.Equal(t, "declined", failed[0].LastErr)
+		assert.Equal(t, "negative amount", failed[1].LastErr)
+		assert.Equal(t, `memstore: no handler for job "refund"`, failed[2].LastErr)
+		assert.EqualValues(t, 1, failed[0].Fails)
+	}
+}
+
+func TestStoreJobValidation(t *testing.T) {
+	assert.Panics(t, func() {
+		New().Job("wat", func(job *work.Job) {})
+	})
+}
@@ -0,0 +1,316 @@
+// Package metrics collects metrics about jobs and queues and serves them in the Prometheus text exposition format, so
+// that they can be scraped by Prometheus without pulling in its client library.
+//
+// Job metrics are collected by a worker pool middleware and hooks, and queue metrics by polling a work.Client:
+//
+//	m := metrics.New()
+//	m.Instrument(pool)
+//	stop := m.Poll(work.NewClient("my_app_namespace", redisPool), 15*time.Second)
+//	defer stop()
+//	http.Handle("/metrics", m)
+//
+// To serve them with the Prometheus client library instead, register a collector for them from the
+// github.com/teamwork/work/v2/prometheus module.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	work "github.com/teamwork/work/v2"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the job duration histogram buckets.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 300}
+
+// Metrics holds the metrics collected for a worker pool and its queues. It implements http.Handler to serve them.
+type Metrics struct {
+	buckets []float64
+
+	mtx          sync.Mutex
+	processed    map[string]float64
+	failed       map[string]float64
+	retried      map[string]float64
+	dead         map[string]float64
+	deadLettered map[string]float64
+	durations    map[string]*histogram
+	queues       []*work.Queue
+	polled       bool
+}
+
+// New returns a Metrics that uses DefaultBuckets for the job duration histogram.
+func New() *Metrics {
+	return NewWithBuckets(DefaultBuckets)
+}
+
+// NewWithBuckets returns a Metrics that uses buckets, in seconds and in increasing order, for the job duration
+// histogram.
+func NewWithBuckets(buckets []float64) *Metrics {
+	if !sort.Float64sAreSorted(buckets) {
+		panic("metrics: buckets must be in increasing order")
+	}
+	return &Metrics{
+		buckets:      append([]float64(nil), buckets...),
+		processed:    make(map[string]float64),
+		failed:       make(map[string]float64),
+		retried:      make(map[string]float64),
+		dead:         make(map[string]float64),
+		deadLettered: make(map[string]float64),
+		durations:    make(map[string]*histogram),
+	}
+}
+
+// Instrument adds m.Middleware to pool, and hooks that count the jobs it retries, sends to the dead queue and enqueues
+// on a dead letter queue. Call it before adding any other middleware so that it times the whole job.
+func (m *Metrics) Instrument(pool *work.WorkerPool) {
+	pool.Middleware(m.Middleware)
+	pool.OnJobRetry(func(job *work.Job, err error) { m.count(m.retried, job.Name) })
+	pool.OnJobDead(func(job *work.Job, err error) { m.count(m.dead, job.Name) })
+	pool.OnJobDeadLettered(func(job *work.Job, err error) { m.count(m.deadLettered, job.Name) })
+}
+
+// Middleware is a worker pool middleware that counts the jobs processed and failed, and times them. Instrument adds it
+// along with the hooks that count retried and dead jobs.
+func (m *Metrics) Middleware(job *work.Job, next work.NextMiddlewareFunc) error {
+	start := time.Now()
+	err := next()
+	m.observeJob(job.Name, time.Since(start), err)
+	return err
+}
+
+func (m *Metrics) observeJob(jobName string, duration time.Duration, err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.processed[jobName]++
+	if err != nil {
+		m.failed[jobName]++
+	}
+	h, ok := m.durations[jobName]
+	if !ok {
+		h = newHistogram(m.buckets)
+		m.durations[jobName] = h
+	}
+	h.observe(duration.Seconds())
+}
+
+func (m *Metrics) count(counts map[string]float64, jobName string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	counts[jobName]++
+}
+
+// Poll collects queue depth and latency from client every interval until the returned function is called.
+func (m *Metrics) Poll(client *work.Client, interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	var once sync.Once
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			m.poll(client)
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+func (m *Metrics) poll(client *work.Client) {
+	queues, err := client.Queues()
+	if err != nil {
+		return
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.queues = queues
+	m.polled = true
+}
+
+// Snapshot is a copy of the metrics collected so far, eg, to export them with another library.
+type Snapshot struct {
+	// The numbers of jobs processed, failed, retried, moved to the dead queue and enqueued on a dead letter queue, by
+	// job name.
+	Processed, Failed, Retried, Dead, DeadLettered map[string]float64
+
+	// Durations holds how long jobs took to run, by job name.
+	Durations map[string]Histogram
+
+	// Queues holds the queues, or is nil if the client hasn't been polled yet.
+	Queues []*work.Queue
+}
+
+// Histogram is a copy of a job duration histogram.
+type Histogram struct {
+	Buckets map[float64]uint64 // the number of runs that took up to each upper bound, in seconds
+	Count   uint64
+	Sum     float64 // in seconds
+}
+
+// Snapshot returns a copy of the metrics collected so far.
+func (m *Metrics) Snapshot() *Snapshot {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	s := &Snapshot{
+		Processed:    copyCounts(m.processed),
+		Failed:       copyCounts(m.failed),
+		Retried:      copyCounts(m.retried),
+		Dead:         copyCounts(m.dead),
+		DeadLettered: copyCounts(m.deadLettered),
+		Durations:    make(map[string]Histogram, len(m.durations)),
+	}
+	for jobName, h := range m.durations {
+		s.Durations[jobName] = h.snapshot()
+	}
+	if m.polled {
+		s.Queues = append([]*work.Queue{}, m.queues...)
+	}
+	return s
+}
+
+func copyCounts(counts map[string]float64) map[string]float64 {
+	c := make(map[string]float64, len(counts))
+	for k, v := range counts {
+		c[k] = v
+	}
+	return c
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (m *Metrics) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(rw)
+}
+
+// WriteTo writes the metrics to w in the Prometheus text exposition format.
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	var b strings.Builder
+	writeCounter(&b, "work_jobs_processed_total", "Number of jobs processed.", m.processed)
+	writeCounter(&b, "work_jobs_failed_total", "Number of jobs that returned an error or panicked.", m.failed)
+	writeCounter(&b, "work_jobs_retried_total", "Number of failed jobs put in the retry queue.", m.retried)
+	writeCounter(&b, "work_jobs_dead_total", "Number of failed jobs moved to the dead queue.", m.dead)
+	writeCounter(&b, "work_jobs_dead_lettered_total", "Number of failed jobs enqueued on a dead letter queue.", m.deadLettered)
+
+	b.WriteString("# HELP work_job_duration_seconds How long jobs took to run.\n")
+	b.WriteString("# TYPE work_job_duration_seconds histogram\n")
+	jobNames := make([]string, 0, len(m.durations))
+	for jobName := range m.durations {
+		jobNames = append(jobNames, jobName)
+	}
+	sort.Strings(jobNames)
+	for _, jobName := range jobNames {
+		m.durations[jobName].write(&b, "work_job_duration_seconds", jobName)
+	}
+
+	if m.polled {
+		depths := make(map[string]float64, len(m.queues))
+		latencies := make(map[string]float64, len(m.queues))
+		for _, q := range m.queues {
+			depths[q.JobName] = float64(q.Count)
+			latencies[q.JobName] = float64(q.Latency)
+		}
+		writeGauge(&b, "work_queue_depth", "Number of jobs waiting in the queue.", depths)
+		writeGauge(&b, "work_queue_latency_seconds", "How long the next job in the queue has been waiting.", latencies)
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func writeCounter(b *strings.Builder, name, help string, values map[string]float64) {
+	writeMetric(b, name, help, "counter", values)
+}
+
+func writeGauge(b *strings.Builder, name, help string, values map[string]float64) {
+	writeMetric(b, name, help, "gauge", values)
+}
+
+func writeMetric(b *strings.Builder, name, help, typ string, values map[string]float64) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+	for _, jobName := range sortedKeys(values) {
+		fmt.Fprintf(b, "%s{job=\"%s\"} %s\n", name, escapeLabel(jobName), formatFloat(values[jobName]))
+	}
+}
+
+type histogram struct {
+	upperBounds []float64
+	counts      []uint64 // per bucket, not cumulative
+	count       uint64
+	sum         float64
+}
+
+func newHistogram(upperBounds []float64) *histogram {
+	return &histogram{
+		upperBounds: upperBounds,
+		counts:      make([]uint64, len(upperBounds)),
+	}
+}
+
+func (h *histogram) observe(v float64) {
+	i := sort.SearchFloat64s(h.upperBounds, v)
+	if i < len(h.counts) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += v
+}
+
+func (h *histogram) snapshot() Histogram {
+	buckets := make(map[float64]uint64, len(h.upperBounds))
+	var cumulative uint64
+	for i, bound := range h.upperBounds {
+		cumulative += h.counts[i]
+		buckets[bound] = cumulative
+	}
+	return Histogram{Buckets: buckets, Count: h.count, Sum: h.sum}
+}
+
+func (h *histogram) write(b *strings.Builder, name, jobName string) {
+	label := escapeLabel(jobName)
+	var cumulative uint64
+	for i, bound := range h.upperBounds {
+		cumulative += h.counts[i]
+		fmt.Fprintf(b, "%s_bucket{job=\"%s\",le=\"%s\"} %d\n", name, label, formatFloat(bound), cumulative)
+	}
+	fmt.Fprintf(b, "%s_bucket{job=\"%s\",le=\"+Inf\"} %d\n", name, label, h.count)
+	fmt.Fprintf(b, "%s_sum{job=\"%s\"} %s\n", name, label, formatFloat(h.sum))
+	fmt.Fprintf(b, "%s_count{job=\"%s\"} %d\n", name, label, h.count)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(v string) string {
+	return labelEscaper.Replace(v)
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	work "github.com/teamwork/work/v2"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	m := NewWithBuckets([]float64{0.1, 1})
+
+	err := m.Middleware(&work.Job{Name: "send_email"}, func() error { return nil })
+	assert.NoError(t, err)
+	err = m.Middleware(&work.Job{Name: "send_email"}, func() error { return fmt.Errorf("sorry kid") })
+	assert.EqualError(t, err, "sorry kid")
+	m.observeJob(`say "hi"`, 500*time.Millisecond, nil)
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+
+	assert.True(t, strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain; version=0.0.4"))
+	assert.Contains(t, body, "# TYPE work_jobs_processed_total counter\n")
+	assert.Contains(t, body, `work_jobs_processed_total{job="send_email"} 2`+"\n")
+	assert.Contains(t, body, `work_jobs_failed_total{job="send_email"} 1`+"\n")
+	assert.Contains(t, body, "# TYPE work_job_duration_seconds histogram\n")
+	assert.Contains(t, body, `work_job_duration_seconds_bucket{job="send_email",le="0.1"} 2`+"\n")
+	assert.Contains(t, body, `work_job_duration_seconds_count{job="send_email"} 2`+"\n")
+	assert.Contains(t, body, `work_job_duration_seconds_bucket{job="say \"hi\"",le="0.1"} 0`+"\n")
+	assert.Contains(t, body, `work_job_duration_seconds_bucket{job="say \"hi\"",le="1"} 1`+"\n")
+	assert.Contains(t, body, `work_job_duration_seconds_bucket{job="say \"hi\"",le="+Inf"} 1`+"\n")
+	assert.Contains(t, body, `work_job_duration_seconds_sum{job="say \"hi\""} 0.5`+"\n")
+
+	// Queue metrics are only written once the client has been polled.
+	assert.NotContains(t, body, "work_queue_depth")
+}
+
+func TestMetricsQueues(t *testing.T) {
+	m := New()
+	m.queues = []*work.Queue{{JobName: "b", Count: 3, Latency: 20}, {JobName: "a", Count: 0}}
+	m.polled = true
+
+	var b strings.Builder
+	_, err := m.WriteTo(&b)
+	assert.NoError(t, err)
+	body := b.String()
+
+	assert.Contains(t, body, "work_queue_depth{job=\"a\"} 0\nwork_queue_depth{job=\"b\"} 3\n")
+	assert.Contains(t, body, `work_queue_latency_seconds{job="b"} 20`+"\n")
+}
+
+func TestNewWithBucketsUnsorted(t *testing.T) {
+	assert.Panics(t, func() { NewWithBuckets([]float64{1, 0.5}) })
+}
+
+func TestMetricsInstrument(t *testing.T) {
+	pool := &redis.Pool{
+		MaxActive: 3,
+		MaxIdle:   3,
+		Dial:      func() (redis.Conn, error) { return redis.Dial("tcp", ":6379") },
+		Wait:      true,
+	}
+	ns := "work_metrics"
+	conn := pool.Get()
+	keys, err := redis.Strings(conn.Do("KEYS", ns+"*"))
+	assert.NoError(t, err)
+	for _, k := range keys {
+		conn.Do("DEL", k)
+	}
+	conn.Close()
+
+	m := New()
+	wp := work.NewWorkerPool(struct{}{}, 2, ns, pool)
+	m.Instrument(wp)
+	fail := func(job *work.Job) error { return fmt.Errorf("nope") }
+	wp.JobWithOptions("flaky", work.JobOptions{MaxFails: 2}, fail)
+	wp.JobWithOptions("broken", work.JobOptions{MaxFails: 1}, fail)
+	wp.JobWithOptions("charge", work.JobOptions{MaxFails: 1, DeadLetterQueue: "repair_charge"}, fail)
+	enqueuer := work.NewEnqueuer(ns, pool)
+	for _, name := range []string{"flaky", "broken", "broken", "charge"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	s := m.Snapshot()
+	assert.Equal(t, map[string]float64{"flaky": 1, "broken": 2, "charge": 1}, s.Failed)
+	assert.Equal(t, map[string]float64{"flaky": 1}, s.Retried)
+	assert.Equal(t, map[string]float64{"broken": 2}, s.Dead)
+	assert.Equal(t, map[string]float64{"charge": 1}, s.DeadLettered)
+	assert.EqualValues(t, 2, s.Durations["broken"].Count)
+	assert.EqualValues(t, 2, s.Durations["broken"].Buckets[DefaultBuckets[len(DefaultBuckets)-1]])
+	assert.Nil(t, s.Queues)
+
+	var b strings.Builder
+	_, err = m.WriteTo(&b)
+	assert.NoError(t, err)
+	assert.Contains(t, b.String(), `work_jobs_retried_total{job="flaky"} 1`+"\n")
+	assert.Contains(t, b.String(), `work_jobs_dead_total{job="broken"} 2`+"\n")
+	assert.Contains(t, b.String(), `work_jobs_dead_lettered_total{job="charge"} 1`+"\n")
+}
@@ -0,0 +1,71 @@
+package msgpack
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	work "github.com/teamwork/work/v2"
+)
+
+func TestCodec(t *testing.T) {
+	args := map[string]interface{}{
+		"id":     4,
+		"big":    uint64(1 << 63),
+		"huge":   int64(1<<60 + 1),
+		"ratio":  0.5,
+		"name":   "bob",
+		"ok":     true,
+		"tags":   []interface{}{"a", "b"},
+		"nested": map[string]interface{}{"n": -3},
+		"none":   nil,
+	}
+	data, err := Codec.Marshal(args)
+	assert.NoError(t, err)
+
+	var decoded map[string]interface{}
+	assert.NoError(t, Codec.Unmarshal(data, &decoded))
+	assert.Equal(t, map[string]interface{}{
+		"id":     int64(4),
+		"big":    uint64(1 << 63),
+		"huge":   uint64(1<<60 + 1),
+		"ratio":  0.5,
+		"name":   "bob",
+		"ok":     true,
+		"tags":   []interface{}{"a", "b"},
+		"nested": map[string]interface{}{"n": int64(-3)},
+		"none":   nil,
+	}, decoded)
+
+	job := &work.Job{Args: decoded}
+	assert.EqualValues(t, 4, job.ArgInt64("id"))
+	assert.EqualValues(t, 1<<60+1, job.ArgInt64("huge"))
+	assert.EqualValues(t, 0.5, job.ArgFloat64("ratio"))
+	assert.NoError(t, job.ArgError())
+}
+
+// BenchmarkSize reports how many bytes the args of a job take up in Redis once they're encoded with Codec and base64
+// encoded, against how many they take up as JSON.
+func BenchmarkSize(b *testing.B) {
+	for _, bm := range []struct {
+		name string
+		args map[string]interface{}
+	}{
+		{"numbers", map[string]interface{}{"account_id": 1234567, "user_id": 7654321, "amount": 19.99, "quantity": 3, "retries": 0}},
+		{"strings", map[string]interface{}{"address": "someone@example.com", "subject": "Your invoice", "body": strings.Repeat("Thanks for your order. ", 20)}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			var jsonSize, msgpackSize int
+			for i := 0; i < b.N; i++ {
+				jsonData, _ := work.JSONCodec.Marshal(bm.args)
+				data, err := Codec.Marshal(bm.args)
+				if err != nil {
+					b.Fatal(err)
+				}
+				jsonSize, msgpackSize = len(jsonData), (len(data)+2)/3*4
+			}
+			b.ReportMetric(float64(jsonSize), "json-bytes")
+			b.ReportMetric(float64(msgpackSize), "msgpack-bytes")
+		})
+	}
+}
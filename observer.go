@@ -4,15 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
-
-	"github.com/gomodule/redigo/redis"
 )
 
 // An observer observes a single worker. Each worker has its own observer.
 type observer struct {
 	namespace string
 	workerID  string
-	pool      *redis.Pool
+	pool      Pool
+	logger    Logger
 
 	// nil: worker isn't doing anything that we know of
 	// not nil: the last started observation that we received on the channel.
@@ -39,6 +38,7 @@ const (
 	observationKindStarted observationKind = iota
 	observationKindDone
 	observationKindCheckin
+	observationKindProgress
 )
 
 type observation struct {
@@ -58,15 +58,19 @@ type observation struct {
 	// If this is a checkin, set these.
 	checkin   string
 	checkinAt int64
+
+	// If this is a progress report, set these.
+	progressDone, progressTotal int64
 }
 
 const observerBufferSize = 1024
 
-func newObserver(namespace string, pool *redis.Pool, workerID string) *observer {
+func newObserver(namespace string, pool Pool, workerID string, logger Logger) *observer {
 	return &observer{
 		namespace:        namespace,
 		workerID:         workerID,
 		pool:             pool,
+		logger:           logger,
 		observationsChan: make(chan *observation, observerBufferSize),
 
 		stopChan:         make(chan struct{}),
@@ -120,6 +124,16 @@ func (o *observer) observeCheckin(jobName, jobID, checkin string) {
 	}
 }
 
+func (o *observer) observeProgress(jobName, jobID string, done, total int64) {
+	o.observationsChan <- &observation{
+		kind:          observationKindProgress,
+		jobName:       jobName,
+		jobID:         jobID,
+		progressDone:  done,
+		progressTotal: total,
+	}
+}
+
 func (o *observer) loop() {
 	// Every tick we'll update redis if necessary
 	// We don't update it on every job because the only purpose of this data is for humans to inspect the system,
@@ -139,7 +153,7 @@ func (o *observer) loop() {
 					o.process(obv)
 				default:
 					if err := o.writeStatus(o.currentStartedObservation); err != nil {
-						logError("observer.write", err)
+						logError(o.logger, "observer.write", err)
 					}
 					o.doneDrainingChan <- struct{}{}
 					break DRAIN_LOOP
@@ -148,7 +162,7 @@ func (o *observer) loop() {
 		case <-ticker:
 			if o.lastWrittenVersion != o.version {
 				if err := o.writeStatus(o.currentStartedObservation); err != nil {
-					logError("observer.write", err)
+					logError(o.logger, "observer.write", err)
 				}
 				o.lastWrittenVersion = o.version
 			}
@@ -168,7 +182,14 @@ func (o *observer) process(obv *observation) {
 			o.currentStartedObservation.checkin = obv.checkin
 			o.currentStartedObservation.checkinAt = obv.checkinAt
 		} else {
-			logError("observer.checkin_mismatch", fmt.Errorf("got checkin but mismatch on job ID or no job"))
+			logError(o.logger, "observer.checkin_mismatch", fmt.Errorf("got checkin but mismatch on job ID or no job"))
+		}
+	} else if obv.kind == observationKindProgress {
+		if (o.currentStartedObservation != nil) && (obv.jobID == o.currentStartedObservation.jobID) {
+			o.currentStartedObservation.progressDone = obv.progressDone
+			o.currentStartedObservation.progressTotal = obv.progressTotal
+		} else {
+			logError(o.logger, "observer.progress_mismatch", fmt.Errorf("got progress but mismatch on job ID or no job"))
 		}
 	}
 	o.version++
@@ -176,7 +197,7 @@ func (o *observer) process(obv *observation) {
 	// If this is the version observation we got, just go ahead and write it.
 	if o.version == 1 {
 		if err := o.writeStatus(o.currentStartedObservation); err != nil {
-			logError("observer.first_write", err)
+			logError(o.logger, "observer.first_write", err)
 		}
 		o.lastWrittenVersion = o.version
 	}
@@ -200,19 +221,22 @@ func (o *observer) writeStatus(obv *observation) error {
 		// args -> json.Encode(obv.arguments)
 		// checkin -> obv.checkin
 		// checkin_at -> obv.checkinAt
+		// progress_done -> obv.progressDone
+		// progress_total -> obv.progressTotal
 
 		var argsJSON []byte
 		if len(obv.arguments) == 0 {
 			argsJSON = []byte("")
 		} else {
 			var err error
-			argsJSON, err = json.Marshal(obv.arguments)
+			arguments, _ := redactedArgs(obv.jobName, obv.arguments)
+			argsJSON, err = json.Marshal(arguments)
 			if err != nil {
 				return err
 			}
 		}
 
-		args := make([]interface{}, 0, 13)
+		args := make([]interface{}, 0, 17)
 		args = append(args,
 			key,
 			"job_name", obv.jobName,
@@ -228,6 +252,13 @@ func (o *observer) writeStatus(obv *observation) error {
 			)
 		}
 
+		if obv.progressTotal > 0 {
+			args = append(args,
+				"progress_done", obv.progressDone,
+				"progress_total", obv.progressTotal,
+			)
+		}
+
 		conn.Send("HMSET", args...)
 		conn.Send("EXPIRE", key, 60*60*24)
 		if err := conn.Flush(); err != nil {
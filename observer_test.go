@@ -16,7 +16,7 @@ func TestObserverStarted(t *testing.T) {
 	setNowEpochSecondsMock(tMock)
 	defer resetNowEpochSecondsMock()
 
-	observer := newObserver(ns, pool, "abcd")
+	observer := newObserver(ns, pool, "abcd", nil)
 	observer.start()
 	observer.observeStarted("foo", "bar", Q{"a": 1, "b": "wat"})
 	//observer.observeDone("foo", "bar", nil)
@@ -38,7 +38,7 @@ func TestObserverStartedDone(t *testing.T) {
 	setNowEpochSecondsMock(tMock)
 	defer resetNowEpochSecondsMock()
 
-	observer := newObserver(ns, pool, "abcd")
+	observer := newObserver(ns, pool, "abcd", nil)
 	observer.start()
 	observer.observeStarted("foo", "bar", Q{"a": 1, "b": "wat"})
 	observer.observeDone("foo", "bar", nil)
@@ -53,7 +53,7 @@ func TestObserverCheckin(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
 
-	observer := newObserver(ns, pool, "abcd")
+	observer := newObserver(ns, pool, "abcd", nil)
 	observer.start()
 
 	tMock := int64(1425263401)
@@ -80,7 +80,7 @@ func TestObserverCheckinFromJob(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
 
-	observer := newObserver(ns, pool, "abcd")
+	observer := newObserver(ns, pool, "abcd", nil)
 	observer.start()
 
 	tMock := int64(1425263401)
@@ -105,6 +105,31 @@ func TestObserverCheckinFromJob(t *testing.T) {
 	assert.Equal(t, fmt.Sprint(tMockCheckin), h["checkin_at"])
 }
 
+func TestObserverProgressFromJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	observer := newObserver(ns, pool, "abcd", nil)
+	observer.start()
+	observer.observeStarted("foo", "barbar", nil)
+
+	j := &Job{Name: "foo", ID: "barbar", observer: observer}
+	j.SetProgress(10, 400)
+	j.SetProgress(100, 400)
+
+	observer.drain()
+	observer.stop()
+
+	h := readHash(pool, redisKeyWorkerObservation(ns, "abcd"))
+	assert.Equal(t, "100", h["progress_done"])
+	assert.Equal(t, "400", h["progress_total"])
+
+	ob := &WorkerObservation{ProgressDone: 100, ProgressTotal: 400}
+	assert.Equal(t, 25.0, ob.PercentDone())
+	assert.Equal(t, -1.0, (&WorkerObservation{}).PercentDone())
+}
+
 func readHash(pool *redis.Pool, key string) map[string]string {
 	m := make(map[string]string)
 
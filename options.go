@@ -0,0 +1,213 @@
+package work
+
+import "time"
+
+// Option configures a WorkerPool created with NewWorkerPoolWithOptions.
+type Option interface {
+	apply(wp *WorkerPool)
+}
+
+type optionFunc func(wp *WorkerPool)
+
+func (fn optionFunc) apply(wp *WorkerPool) {
+	fn(wp)
+}
+
+// FetchStrategy is how workers fetch jobs from Redis.
+type FetchStrategy int
+
+const (
+	// FetchPolling runs the fetch script, and if there's no job to run, sleeps for the pool's sleep backoffs before
+	// trying again.
+	FetchPolling FetchStrategy = iota
+
+	// FetchBlocking runs the fetch script, and if there's no job to run, blocks with BRPOP until a job is enqueued, so
+	// jobs are picked up almost straight away and idle workers make fewer requests to Redis. A worker that's waiting
+	// holds a connection from the pool, so it needs one for each worker on top of the usual ones. Jobs that are put back
+	// on their queue other than by being enqueued or requeued from the scheduled or retry queues, eg, by the reaper, may
+	// wait for the longest sleep backoff, rounded up to a second, before they're picked up.
+	FetchBlocking
+
+	// FetchPubSub runs the fetch script, and if there's no job to run, sleeps for the pool's sleep backoffs like
+	// FetchPolling. The pool also subscribes to a channel for each of its job types, which enqueuers publish to, and
+	// wakes its idle workers as soon as a job is enqueued. Jobs are picked up almost straight away, with only one
+	// connection per pool held for the subscription, so idle workers can back off for much longer, eg, with
+	// ExponentialSleepBackoffs. If the subscription's lost, workers keep polling until it's restored. It needs a Pool
+	// whose connections can subscribe, so it can't be used with NewDoerPool.
+	FetchPubSub
+)
+
+func (o WorkerPoolOptions) apply(wp *WorkerPool) {
+	if o.SleepBackoffs != nil {
+		wp.sleepBackoffs = o.SleepBackoffs
+	}
+	if o.MetricsSink != nil {
+		wp.metricsSink = o.MetricsSink
+	}
+	if o.Logger != nil {
+		wp.logger = o.Logger
+	}
+	if o.StopTimeout != 0 {
+		wp.stopTimeout = o.StopTimeout
+	}
+	if o.HeartbeatPeriod != 0 {
+		wp.heartbeatPeriod = o.HeartbeatPeriod
+	}
+	if o.ReapPeriod != 0 {
+		wp.reapPeriod = o.ReapPeriod
+	}
+	if o.RequeuePeriod != 0 {
+		wp.requeuePeriod = o.RequeuePeriod
+	}
+	if o.FetchStrategy != FetchPolling {
+		wp.fetchStrategy = o.FetchStrategy
+	}
+	if o.Backend != BackendLists {
+		wp.backend = o.Backend
+	}
+	if o.DeadJobWebhookURL != "" {
+		wp.deadJobWebhookURL = o.DeadJobWebhookURL
+	}
+	if o.DeadJobChannel != "" {
+		wp.deadJobChannel = o.DeadJobChannel
+	}
+	if o.DeadJobMaxCount != 0 {
+		wp.deadJobMaxCount = o.DeadJobMaxCount
+	}
+	if o.DeadJobMaxAge != 0 {
+		wp.deadJobMaxAge = o.DeadJobMaxAge
+	}
+	if o.DeadJobArchiver != nil {
+		wp.deadJobArchiver = o.DeadJobArchiver
+	}
+	if o.Version != "" {
+		wp.version = o.Version
+	}
+	if o.Hostname != "" {
+		wp.hostname = o.Hostname
+	}
+	if o.Labels != nil {
+		WithLabels(o.Labels).apply(wp)
+	}
+}
+
+// WithLogger logs the pool's errors to logger instead of stdout, as per WorkerPoolOptions.Logger.
+func WithLogger(logger Logger) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.logger = logger })
+}
+
+// WithSleepBackoffs sets how long, in milliseconds, idle workers sleep between fetches, as per
+// WorkerPoolOptions.SleepBackoffs.
+func WithSleepBackoffs(backoffs ...int64) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.sleepBackoffs = backoffs })
+}
+
+// ExponentialSleepBackoffs returns sleep backoffs, for WithSleepBackoffs or WorkerPoolOptions.SleepBackoffs, that start
+// at first and double with each fetch that finds no job, up to limit. Idle workers then make few requests to Redis,
+// and as soon as one of a pool's workers finds a job, the pool wakes its other idle workers to fetch straight away.
+// Example: work.WithSleepBackoffs(work.ExponentialSleepBackoffs(10*time.Millisecond, 30*time.Second)...)
+func ExponentialSleepBackoffs(first, limit time.Duration) []int64 {
+	if first < time.Millisecond {
+		first = time.Millisecond
+	}
+
+	backoffs := []int64{0}
+	for d := first; d < limit; d *= 2 {
+		backoffs = append(backoffs, d.Milliseconds())
+	}
+	return append(backoffs, limit.Milliseconds())
+}
+
+// WithMetricsSink sends metrics about each job run to sink, as per WorkerPoolOptions.MetricsSink.
+func WithMetricsSink(sink MetricsSink) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.metricsSink = sink })
+}
+
+// WithStopTimeout bounds how long Stop waits for the jobs in progress, as per WorkerPoolOptions.StopTimeout.
+func WithStopTimeout(timeout time.Duration) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.stopTimeout = timeout })
+}
+
+// WithHeartbeatPeriod sets how often the pool sends its heartbeat, as per WorkerPoolOptions.HeartbeatPeriod.
+func WithHeartbeatPeriod(period time.Duration) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.heartbeatPeriod = period })
+}
+
+// WithReapPeriod sets how often the pool looks for dead worker pools, as per WorkerPoolOptions.ReapPeriod.
+func WithReapPeriod(period time.Duration) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.reapPeriod = period })
+}
+
+// WithRequeuePeriod sets how often the pool moves due scheduled and retry jobs onto their queues, as per
+// WorkerPoolOptions.RequeuePeriod.
+func WithRequeuePeriod(period time.Duration) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.requeuePeriod = period })
+}
+
+// WithFetchStrategy sets how the pool's workers fetch jobs, as per WorkerPoolOptions.FetchStrategy.
+func WithFetchStrategy(strategy FetchStrategy) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.fetchStrategy = strategy })
+}
+
+// WithBackend sets what the pool's jobs are queued on, as per WorkerPoolOptions.Backend.
+func WithBackend(backend Backend) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.backend = backend })
+}
+
+// WithDeadJobNotifications POSTs a DeadJobNotification to webhookURL and/or publishes it to the Redis channel whenever a
+// job is moved to the dead queue, as per WorkerPoolOptions.DeadJobWebhookURL and DeadJobChannel.
+func WithDeadJobNotifications(webhookURL, channel string) Option {
+	return optionFunc(func(wp *WorkerPool) {
+		wp.deadJobWebhookURL = webhookURL
+		wp.deadJobChannel = channel
+	})
+}
+
+// WithDeadJobRetention limits how many dead jobs are kept, and for how long, as per WorkerPoolOptions.DeadJobMaxCount
+// and DeadJobMaxAge.
+func WithDeadJobRetention(maxCount int64, maxAge time.Duration) Option {
+	return optionFunc(func(wp *WorkerPool) {
+		wp.deadJobMaxCount = maxCount
+		wp.deadJobMaxAge = maxAge
+	})
+}
+
+// WithDeadJobArchiver passes the dead jobs trimmed as per WithDeadJobRetention to archive before they're deleted, as per
+// WorkerPoolOptions.DeadJobArchiver.
+func WithDeadJobArchiver(archive DeadJobArchiver) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.deadJobArchiver = archive })
+}
+
+// WithVersion sends version, eg, the git SHA of the build, with the pool's heartbeat, as per WorkerPoolOptions.Version.
+func WithVersion(version string) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.version = version })
+}
+
+// WithHostname sends hostname, eg, a Kubernetes pod name, with the pool's heartbeat instead of the host's name, as per
+// WorkerPoolOptions.Hostname.
+func WithHostname(hostname string) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.hostname = hostname })
+}
+
+// WithLabels adds labels, eg, {"region": "eu-west-1"}, to those sent with the pool's heartbeat, as per
+// WorkerPoolOptions.Labels.
+func WithLabels(labels map[string]string) Option {
+	return optionFunc(func(wp *WorkerPool) {
+		if wp.labels == nil {
+			wp.labels = make(map[string]string, len(labels))
+		}
+		for k, v := range labels {
+			wp.labels[k] = v
+		}
+	})
+}
+
+// WithCodec lets the pool's workers run jobs enqueued with c, as per RegisterCodec.
+func WithCodec(c Codec) Option {
+	return optionFunc(func(wp *WorkerPool) { RegisterCodec(c) })
+}
+
+// WithMiddleware appends fn to the pool's middleware chain, as per WorkerPool.Middleware.
+func WithMiddleware(fn interface{}) Option {
+	return optionFunc(func(wp *WorkerPool) { wp.Middleware(fn) })
+}
@@ -0,0 +1,53 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPoolOptions(t *testing.T) {
+	pool := newTestPool(":6379")
+	logger := &testLogger{}
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 2, "work", pool,
+		WorkerPoolOptions{SleepBackoffs: []int64{1, 2}, ReapPeriod: time.Hour},
+		WithLogger(logger),
+		WithReapPeriod(time.Minute),
+		WithRequeuePeriod(10*time.Millisecond),
+		WithStopTimeout(time.Second),
+		WithMiddleware(func(job *Job, next NextMiddlewareFunc) error { return next() }),
+		WorkerPoolOptions{HeartbeatPeriod: 2 * time.Second}, // leaves the options before it alone
+	)
+
+	assert.Equal(t, []int64{1, 2}, wp.sleepBackoffs)
+	assert.Equal(t, logger, wp.logger)
+	assert.Equal(t, time.Minute, wp.reapPeriod)
+	assert.Equal(t, 10*time.Millisecond, wp.requeuePeriod)
+	assert.Equal(t, 2*time.Second, wp.heartbeatPeriod)
+	assert.Equal(t, time.Second, wp.stopTimeout)
+	assert.Equal(t, FetchPolling, wp.fetchStrategy)
+	assert.Len(t, wp.middleware, 1)
+	if assert.Len(t, wp.workers, 2) {
+		assert.Equal(t, []int64{1, 2}, wp.workers[0].sleepBackoffs)
+		assert.Equal(t, logger, wp.workers[0].logger)
+		assert.Len(t, wp.workers[0].middleware, 1)
+	}
+}
+
+func TestExponentialSleepBackoffs(t *testing.T) {
+	assert.Equal(t, []int64{0, 10, 20, 40, 80, 100}, ExponentialSleepBackoffs(10*time.Millisecond, 100*time.Millisecond))
+	assert.Equal(t, []int64{0, 1, 2, 4}, ExponentialSleepBackoffs(0, 4*time.Millisecond))
+}
+
+func TestHeartbeatMetadataOptions(t *testing.T) {
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, "work", newTestPool(":0"),
+		WithLabels(map[string]string{"app": "billing", "region": "us-east-1"}),
+		WorkerPoolOptions{Version: "a1b2c3d", Hostname: "worker-7d9f8", Labels: map[string]string{"region": "eu-west-1"}},
+	)
+
+	assert.Equal(t, "a1b2c3d", wp.version)
+	assert.Equal(t, "worker-7d9f8", wp.hostname)
+	assert.Equal(t, map[string]string{"app": "billing", "region": "eu-west-1"}, wp.labels)
+}
@@ -0,0 +1,146 @@
+// Package otel traces jobs with OpenTelemetry. It's a module of its own so that the work package doesn't depend on the
+// OpenTelemetry SDK.
+//
+// The enqueue middleware starts a producer span for each job and stores its trace context in the job's arguments, and
+// the worker pool middleware starts a consumer span for each run of the job, as a child of, and linked to, that span:
+//
+//	enqueuer.Use(otel.EnqueueMiddleware())
+//	pool.Middleware(otel.Middleware())
+//
+// Both use the global tracer provider and propagator unless they're given others with WithTracerProvider and
+// WithPropagator.
+package otel
+
+import (
+	"fmt"
+
+	work "github.com/teamwork/work/v2"
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ArgKey is the job argument the trace context is stored in.
+const ArgKey = "trace_context"
+
+// TracerName is the name of the tracer the spans are started with.
+const TracerName = "github.com/teamwork/work/v2/otel"
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option configures the middleware.
+type Option func(c *config)
+
+// WithTracerProvider sets the tracer provider spans are started with, instead of the global one.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) { c.tracerProvider = tp }
+}
+
+// WithPropagator sets the propagator the trace context is stored in job arguments with, instead of the global one.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(c *config) { c.propagator = p }
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.tracerProvider == nil {
+		c.tracerProvider = otelapi.GetTracerProvider()
+	}
+	if c.propagator == nil {
+		c.propagator = otelapi.GetTextMapPropagator()
+	}
+	return c
+}
+
+// EnqueueMiddleware returns an enqueue middleware that starts a producer span for each job, as a child of any span in
+// the context passed to Enqueuer.EnqueueContext, and stores its trace context in the job's ArgKey argument.
+func EnqueueMiddleware(opts ...Option) work.EnqueueMiddleware {
+	c := newConfig(opts)
+	tracer := c.tracerProvider.Tracer(TracerName)
+	return func(job *work.Job, next func() error) error {
+		ctx, span := tracer.Start(job.Context(), "enqueue "+job.Name,
+			trace.WithSpanKind(trace.SpanKindProducer),
+			trace.WithAttributes(attributes(job, "publish")...))
+		defer span.End()
+
+		carrier := propagation.MapCarrier{}
+		c.propagator.Inject(ctx, carrier)
+		if len(carrier) > 0 {
+			job.Args[ArgKey] = map[string]string(carrier)
+		}
+
+		err := next()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return err
+	}
+}
+
+// Middleware returns a worker pool middleware that starts a consumer span for each run of a job. The span is a child of
+// the job's producer span, if it has one, and is linked to it. The handler gets the span through Job.Context. A run that
+// returns an error or panics sets the span's status to error; panics are passed on, so the job fails as usual.
+func Middleware(opts ...Option) func(job *work.Job, next work.NextMiddlewareFunc) error {
+	c := newConfig(opts)
+	tracer := c.tracerProvider.Tracer(TracerName)
+	return func(job *work.Job, next work.NextMiddlewareFunc) (err error) {
+		parent := c.propagator.Extract(job.Context(), carrier(job))
+		startOpts := []trace.SpanStartOption{
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(append(attributes(job, "process"), attribute.Int64("work.job.attempt", job.Attempt()))...),
+		}
+		if link := trace.LinkFromContext(parent); link.SpanContext.IsValid() {
+			startOpts = append(startOpts, trace.WithLinks(link))
+		}
+		ctx, span := tracer.Start(parent, "process "+job.Name, startOpts...)
+		defer span.End()
+		job.SetContext(ctx)
+
+		defer func() {
+			if v := recover(); v != nil {
+				perr := fmt.Errorf("panic: %v", v)
+				span.RecordError(perr, trace.WithStackTrace(true))
+				span.SetStatus(codes.Error, perr.Error())
+				panic(v)
+			}
+		}()
+
+		err = next()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return err
+	}
+}
+
+// carrier returns the trace context stored in the job's arguments. Jobs are always decoded before they're run, even
+// those run inline by Enqueuer.RunInline, so it's a map[string]interface{} by then.
+func carrier(job *work.Job) propagation.MapCarrier {
+	m, _ := job.Args[ArgKey].(map[string]interface{})
+	c := make(propagation.MapCarrier, len(m))
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			c[k] = s
+		}
+	}
+	return c
+}
+
+func attributes(job *work.Job, operation string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("messaging.system", "work"),
+		attribute.String("messaging.operation", operation),
+		attribute.String("messaging.destination.name", job.Name),
+		attribute.String("messaging.message.id", job.ID),
+	}
+}
@@ -0,0 +1,129 @@
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	work "github.com/teamwork/work/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestOptions() ([]Option, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	return []Option{WithTracerProvider(tp), WithPropagator(propagation.TraceContext{})}, exporter
+}
+
+// roundTrip returns the job as a worker pool would fetch it from Redis.
+func roundTrip(t *testing.T, job *work.Job) *work.Job {
+	b, err := json.Marshal(job)
+	assert.NoError(t, err)
+	var fetched work.Job
+	assert.NoError(t, json.Unmarshal(b, &fetched))
+	return &fetched
+}
+
+func TestMiddleware(t *testing.T) {
+	opts, exporter := newTestOptions()
+	enqueueMW := EnqueueMiddleware(opts...)
+	mw := Middleware(opts...)
+
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)).Tracer("test")
+	ctx, request := tracer.Start(context.Background(), "request")
+	job := &work.Job{Name: "send_email", ID: "1", Args: map[string]interface{}{"address": "a@b.com"}}
+	job.SetContext(ctx)
+	assert.NoError(t, enqueueMW(job, func() error { return nil }))
+	request.End()
+	assert.Contains(t, job.Args[ArgKey], "traceparent")
+
+	var handlerSpan trace.SpanContext
+	fetched := roundTrip(t, job)
+	fetched.Fails = 1
+	err := mw(fetched, func() error {
+		handlerSpan = trace.SpanContextFromContext(fetched.Context())
+		return fmt.Errorf("bounced")
+	})
+	assert.EqualError(t, err, "bounced")
+
+	spans := exporter.GetSpans()
+	if !assert.Len(t, spans, 3) {
+		return
+	}
+	enqueue, process := spans[0], spans[2]
+	assert.Equal(t, "enqueue send_email", enqueue.Name)
+	assert.Equal(t, trace.SpanKindProducer, enqueue.SpanKind)
+	assert.Equal(t, request.SpanContext().SpanID(), enqueue.Parent.SpanID())
+
+	assert.Equal(t, "process send_email", process.Name)
+	assert.Equal(t, trace.SpanKindConsumer, process.SpanKind)
+	assert.Equal(t, enqueue.SpanContext.TraceID(), process.SpanContext.TraceID())
+	assert.Equal(t, enqueue.SpanContext.SpanID(), process.Parent.SpanID())
+	if assert.Len(t, process.Links, 1) {
+		assert.Equal(t, enqueue.SpanContext.SpanID(), process.Links[0].SpanContext.SpanID())
+	}
+	assert.Equal(t, process.SpanContext.SpanID(), handlerSpan.SpanID())
+	assert.Equal(t, codes.Error, process.Status.Code)
+	assert.Equal(t, "bounced", process.Status.Description)
+	assert.Contains(t, process.Attributes, attribute.Int64("work.job.attempt", 2))
+	assert.Contains(t, process.Attributes, attribute.String("messaging.message.id", "1"))
+}
+
+func TestMiddlewareWithoutTraceContext(t *testing.T) {
+	opts, exporter := newTestOptions()
+	mw := Middleware(opts...)
+
+	job := &work.Job{Name: "send_email", ID: "1", Args: map[string]interface{}{}}
+	assert.NoError(t, mw(job, func() error { return nil }))
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 1) {
+		assert.False(t, spans[0].Parent.IsValid())
+		assert.Empty(t, spans[0].Links)
+		assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	}
+}
+
+func TestMiddlewarePanic(t *testing.T) {
+	opts, exporter := newTestOptions()
+	mw := Middleware(opts...)
+
+	job := &work.Job{Name: "send_email", ID: "1"}
+	assert.PanicsWithValue(t, "boom", func() {
+		mw(job, func() error { panic("boom") })
+	})
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+		assert.Equal(t, "panic: boom", spans[0].Status.Description)
+	}
+}
+
+func TestMiddlewareRunInline(t *testing.T) {
+	opts, exporter := newTestOptions()
+	redisPool := &redis.Pool{Dial: func() (redis.Conn, error) { return redis.Dial("tcp", ":6379") }}
+	wp := work.NewWorkerPool(struct{}{}, 1, "work_otel", redisPool).Middleware(Middleware(opts...))
+	var handlerSpan trace.SpanContext
+	wp.Job("send_email", func(job *work.Job) error {
+		handlerSpan = trace.SpanContextFromContext(job.Context())
+		return nil
+	})
+	enqueuer := work.NewEnqueuer("work_otel", redisPool).Use(EnqueueMiddleware(opts...)).RunInline(wp)
+
+	_, err := enqueuer.Enqueue("send_email", work.Q{"address": "a@b.com"})
+	assert.NoError(t, err)
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 2) {
+		process, enqueue := spans[0], spans[1]
+		assert.Equal(t, "enqueue send_email", enqueue.Name)
+		assert.Equal(t, enqueue.SpanContext.SpanID(), process.Parent.SpanID())
+		assert.Equal(t, process.SpanContext.SpanID(), handlerSpan.SpanID())
+	}
+}
@@ -1,8 +1,10 @@
 package work
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
@@ -16,9 +18,11 @@ const (
 
 type periodicEnqueuer struct {
 	namespace             string
-	pool                  *redis.Pool
+	pool                  Pool
+	mu                    sync.Mutex // guards periodicJobs, which can change while the enqueuer is running
 	periodicJobs          []*periodicJob
 	scheduledPeriodicJobs []*scheduledPeriodicJob
+	logger                Logger
 	stopChan              chan struct{}
 	doneStoppingChan      chan struct{}
 }
@@ -27,6 +31,39 @@ type periodicJob struct {
 	jobName  string
 	spec     string
 	schedule cron.Schedule
+	opts     PeriodicOptions
+	rawArgs  string // opts.Args, JSON encoded, or "" if there are none
+	id       string // the prefix of the IDs of the job's runs
+}
+
+func newPeriodicJob(jobName, spec string, schedule cron.Schedule, opts PeriodicOptions) (*periodicJob, error) {
+	pj := &periodicJob{jobName: jobName, spec: spec, schedule: schedule, opts: opts}
+	var err error
+	if pj.rawArgs, err = encodePeriodicArgs(opts.Args); err != nil {
+		return nil, err
+	}
+	pj.id = makePeriodicID(jobName, spec, pj.location(), pj.rawArgs)
+	return pj, nil
+}
+
+// encodePeriodicArgs returns args JSON encoded, or "" if there are none.
+func encodePeriodicArgs(args map[string]interface{}) (string, error) {
+	if len(args) == 0 {
+		return "", nil
+	}
+	rawArgs, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	return string(rawArgs), nil
+}
+
+// location returns the name of the time zone pj's spec is in, or "" if it's the local time zone.
+func (pj *periodicJob) location() string {
+	if pj.opts.Location == nil {
+		return ""
+	}
+	return pj.opts.Location.String()
 }
 
 type scheduledPeriodicJob struct {
@@ -35,11 +72,12 @@ type scheduledPeriodicJob struct {
 	*periodicJob
 }
 
-func newPeriodicEnqueuer(namespace string, pool *redis.Pool, periodicJobs []*periodicJob) *periodicEnqueuer {
+func newPeriodicEnqueuer(namespace string, pool Pool, periodicJobs []*periodicJob, logger Logger) *periodicEnqueuer {
 	return &periodicEnqueuer{
 		namespace:        namespace,
 		pool:             pool,
-		periodicJobs:     periodicJobs,
+		periodicJobs:     append([]*periodicJob(nil), periodicJobs...),
+		logger:           logger,
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
 	}
@@ -62,7 +100,7 @@ func (pe *periodicEnqueuer) loop() {
 	if pe.shouldEnqueue() {
 		err := pe.enqueue()
 		if err != nil {
-			logError("periodic_enqueuer.loop.enqueue", err)
+			logError(pe.logger, "periodic_enqueuer.loop.enqueue", err)
 		}
 	}
 
@@ -76,33 +114,108 @@ func (pe *periodicEnqueuer) loop() {
 			if pe.shouldEnqueue() {
 				err := pe.enqueue()
 				if err != nil {
-					logError("periodic_enqueuer.loop.enqueue", err)
+					logError(pe.logger, "periodic_enqueuer.loop.enqueue", err)
 				}
 			}
 		}
 	}
 }
 
+// add starts enqueueing pj, scheduling its runs before the horizon straight away rather than on the next loop.
+func (pe *periodicEnqueuer) add(pj *periodicJob) error {
+	pe.mu.Lock()
+	pe.periodicJobs = append(pe.periodicJobs, pj)
+	pe.mu.Unlock()
+
+	conn := pe.pool.Get()
+	defer conn.Close()
+
+	return pe.schedule(conn, pj, nowEpochSeconds())
+}
+
+// remove stops enqueueing the periodic jobs that match, and unschedules the runs already scheduled for them.
+func (pe *periodicEnqueuer) remove(match func(*periodicJob) bool) error {
+	pe.mu.Lock()
+	var removed []*periodicJob
+	periodicJobs := pe.periodicJobs[:0:0]
+	for _, pj := range pe.periodicJobs {
+		if match(pj) {
+			removed = append(removed, pj)
+		} else {
+			periodicJobs = append(periodicJobs, pj)
+		}
+	}
+	pe.periodicJobs = periodicJobs
+	pe.mu.Unlock()
+
+	conn := pe.pool.Get()
+	defer conn.Close()
+
+	now := nowEpochSeconds()
+	for _, pj := range removed {
+		if err := pe.unschedule(conn, pj, now); err != nil {
+			return err
+		}
+		if _, err := conn.Do("HDEL", redisKeyPeriodicJobRuns(pe.namespace), pj.id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (pe *periodicEnqueuer) enqueue() error {
 	now := nowEpochSeconds()
-	nowTime := time.Unix(now, 0)
-	horizon := nowTime.Add(periodicEnqueuerHorizon)
 
 	conn := pe.pool.Get()
 	defer conn.Close()
 
-	for _, pj := range pe.periodicJobs {
-		for t := pj.schedule.Next(nowTime); t.Before(horizon); t = pj.schedule.Next(t) {
-			epoch := t.Unix()
-			id := makeUniquePeriodicID(pj.jobName, pj.spec, epoch)
+	pe.mu.Lock()
+	periodicJobs := pe.periodicJobs
+	pe.mu.Unlock()
 
-			job := &Job{
-				Name: pj.jobName,
-				ID:   id,
+	for _, pj := range periodicJobs {
+		if err := pe.schedule(conn, pj, now); err != nil {
+			return err
+		}
+	}
+
+	nowTime := time.Unix(now, 0)
+	horizon := nowTime.Add(periodicEnqueuerHorizon)
+
+	if err := pe.enqueueRecurring(conn, now, horizon.Unix()); err != nil {
+		return err
+	}
 
-				// This is technically wrong, but this lets the bytes be identical for the same periodic job instance. If we don't do this, we'd need to use a different approach -- probably giving each periodic job its own history of the past 100 periodic jobs, and only scheduling a job if it's not in the history.
+	_, err := conn.Do("SET", redisKeyLastPeriodicEnqueue(pe.namespace), now)
+
+	return err
+}
+
+// enqueueRecurring schedules the runs of the jobs enqueued with Enqueuer.EnqueueEvery that are due after now and
+// before horizon. As with cron jobs, each run's ID and bytes are the same whichever worker pool schedules it, so it's
+// only scheduled once.
+func (pe *periodicEnqueuer) enqueueRecurring(conn redis.Conn, now, horizon int64) error {
+	values, err := redis.ByteSlices(conn.Do("HVALS", redisKeyRecurring(pe.namespace)))
+	if err != nil {
+		return err
+	}
+
+	for _, rawJSON := range values {
+		var rj RecurringJob
+		if err := json.Unmarshal(rawJSON, &rj); err != nil {
+			logError(pe.logger, "periodic_enqueuer.enqueue_recurring.unmarshal", err)
+			continue
+		}
+		if rj.Interval <= 0 {
+			continue
+		}
+
+		for epoch := (now/rj.Interval + 1) * rj.Interval; epoch < horizon; epoch += rj.Interval {
+			job := &Job{
+				Name:       rj.Name,
+				ID:         fmt.Sprintf("%s:%d", rj.ID, epoch),
 				EnqueuedAt: epoch,
-				Args:       nil,
+				Args:       rj.Args,
 			}
 
 			rawJSON, err := job.serialize()
@@ -110,18 +223,87 @@ func (pe *periodicEnqueuer) enqueue() error {
 				return err
 			}
 
-			_, err = conn.Do("ZADD", redisKeyScheduled(pe.namespace), epoch, rawJSON)
-			if err != nil {
+			if _, err := conn.Do("ZADD", redisKeyScheduled(pe.namespace), epoch, rawJSON); err != nil {
 				return err
 			}
 		}
 	}
 
-	_, err := conn.Do("SET", redisKeyLastPeriodicEnqueue(pe.namespace), now)
+	return nil
+}
+
+// periodicJobRuns is stored for each periodic job, so Client.PeriodicJobs can tell if its runs are being scheduled.
+type periodicJobRuns struct {
+	LastEnqueuedAt int64 `json:"last_enqueued_at"`
+	NextDueAt      int64 `json:"next_due_at"`
+}
+
+// schedule adds pj's runs between now and the horizon to the scheduled job queue, and records when it did so and when
+// the next run is due.
+func (pe *periodicEnqueuer) schedule(conn redis.Conn, pj *periodicJob, now int64) error {
+	runs := pj.runs(now)
+	for _, run := range runs {
+		rawJSON, err := run.serialize()
+		if err != nil {
+			return err
+		}
+		if _, err := conn.Do("ZADD", redisKeyScheduled(pe.namespace), run.RunAt, rawJSON); err != nil {
+			return err
+		}
+	}
 
+	pjr := periodicJobRuns{LastEnqueuedAt: now}
+	if len(runs) > 0 {
+		pjr.NextDueAt = runs[0].RunAt
+	} else if next := pj.schedule.Next(time.Unix(now, 0)); !next.IsZero() {
+		pjr.NextDueAt = next.Unix()
+	}
+	rawJSON, err := json.Marshal(pjr)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", redisKeyPeriodicJobRuns(pe.namespace), pj.id, rawJSON)
 	return err
 }
 
+// unschedule removes pj's runs between now and the horizon from the scheduled job queue.
+func (pe *periodicEnqueuer) unschedule(conn redis.Conn, pj *periodicJob, now int64) error {
+	for _, run := range pj.runs(now) {
+		rawJSON, err := run.serialize()
+		if err != nil {
+			return err
+		}
+		if _, err := conn.Do("ZREM", redisKeyScheduled(pe.namespace), rawJSON); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runs returns pj's runs between now and the horizon.
+func (pj *periodicJob) runs(now int64) []*ScheduledJob {
+	nowTime := time.Unix(now, 0)
+	horizon := nowTime.Add(periodicEnqueuerHorizon)
+
+	var runs []*ScheduledJob
+	for t := pj.schedule.Next(nowTime); t.Before(horizon); t = pj.schedule.Next(t) {
+		epoch := t.Unix()
+		job := &Job{
+			Name: pj.jobName,
+			ID:   fmt.Sprintf("%s:%d", pj.id, epoch),
+
+			// This is technically wrong, but this lets the bytes be identical for the same periodic job instance. If we don't do this, we'd need to use a different approach -- probably giving each periodic job its own history of the past 100 periodic jobs, and only scheduling a job if it's not in the history.
+			EnqueuedAt: epoch,
+			Args:       pj.opts.Args,
+		}
+		if pj.opts.SkipIfRunning {
+			job.RunLock = pj.id
+		}
+		runs = append(runs, &ScheduledJob{RunAt: epoch, Job: job})
+	}
+	return runs
+}
+
 func (pe *periodicEnqueuer) shouldEnqueue() bool {
 	conn := pe.pool.Get()
 	defer conn.Close()
@@ -130,13 +312,23 @@ func (pe *periodicEnqueuer) shouldEnqueue() bool {
 	if err == redis.ErrNil {
 		return true
 	} else if err != nil {
-		logError("periodic_enqueuer.should_enqueue", err)
+		logError(pe.logger, "periodic_enqueuer.should_enqueue", err)
 		return true
 	}
 
 	return lastEnqueue < (nowEpochSeconds() - int64(periodicEnqueuerSleep/time.Minute))
 }
 
-func makeUniquePeriodicID(name, spec string, epoch int64) string {
-	return fmt.Sprintf("periodic:%s:%s:%d", name, spec, epoch)
+// makePeriodicID returns the prefix of the IDs of a periodic job's runs, to which the time of the run is added. The time
+// zone and args are left out if it's the local time zone and there are none, so the IDs of existing periodic jobs don't
+// change.
+func makePeriodicID(name, spec, location, rawArgs string) string {
+	id := fmt.Sprintf("periodic:%s:%s", name, spec)
+	if location != "" {
+		id += ":" + location
+	}
+	if rawArgs != "" {
+		id += ":" + rawArgs
+	}
+	return id
 }
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -22,7 +23,7 @@ func TestPeriodicEnqueuer(t *testing.T) {
 	setNowEpochSecondsMock(1468359453)
 	defer resetNowEpochSecondsMock()
 
-	pe := newPeriodicEnqueuer(ns, pool, pjs)
+	pe := newPeriodicEnqueuer(ns, pool, pjs, nil)
 	err := pe.enqueue()
 	assert.NoError(t, err)
 
@@ -97,12 +98,104 @@ func TestPeriodicEnqueuer(t *testing.T) {
 	assert.True(t, pe.shouldEnqueue())
 }
 
+func TestPeriodicEnqueuerRecurring(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1468359453)
+	defer resetNowEpochSecondsMock()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	rj, err := enqueuer.EnqueueEvery("sync", time.Minute, Q{"customer_id": 4})
+	assert.NoError(t, err)
+	assert.Equal(t, `recurring:sync:{"customer_id":4}`, rj.ID)
+	assert.EqualValues(t, 60, rj.Interval)
+
+	// Enqueueing it again changes it rather than adding another one.
+	_, err = enqueuer.EnqueueEvery("sync", time.Minute, Q{"customer_id": 4})
+	assert.NoError(t, err)
+	c := NewClient(ns, pool)
+	recurring, err := c.RecurringJobs()
+	assert.NoError(t, err)
+	assert.Len(t, recurring, 1)
+
+	pe := newPeriodicEnqueuer(ns, pool, nil, nil)
+	assert.NoError(t, pe.enqueue())
+	assert.NoError(t, pe.enqueue())
+
+	scheduledJobs, count, err := c.ScheduledJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, count)
+	for i, runAt := range []int64{1468359480, 1468359540, 1468359600, 1468359660} {
+		assert.Equal(t, runAt, scheduledJobs[i].RunAt)
+		assert.Equal(t, fmt.Sprintf(`recurring:sync:{"customer_id":4}:%d`, runAt), scheduledJobs[i].ID)
+		assert.EqualValues(t, 4, scheduledJobs[i].ArgInt64("customer_id"))
+	}
+
+	assert.NoError(t, enqueuer.CancelRecurring(rj))
+	assert.Equal(t, ErrNotDeleted, enqueuer.CancelRecurring(rj))
+
+	setNowEpochSecondsMock(1468359453 + 600)
+	assert.NoError(t, pe.enqueue())
+	_, count, err = c.ScheduledJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, count)
+
+	_, err = enqueuer.EnqueueEvery("sync", time.Millisecond, nil)
+	assert.Error(t, err)
+}
+
+func TestPeriodicJobLocation(t *testing.T) {
+	wp := NewWorkerPool(TestContext{}, 1, "work", newTestPool(":6379"))
+	plusOne := time.FixedZone("UTC+1", 3600)
+	wp.PeriodicallyEnqueueWithOptions("0 0 2 * * *", "backup", PeriodicOptions{Location: plusOne})
+	wp.PeriodicallyEnqueue("2 * * * *", "cleanup")
+
+	// 02:00 in UTC+1 is 01:00 UTC, a minute after now.
+	now := time.Date(2016, 7, 13, 0, 59, 0, 0, time.UTC).Unix()
+	runs := wp.periodicJobs[0].runs(now)
+	if assert.Len(t, runs, 1) {
+		assert.Equal(t, now+60, runs[0].RunAt)
+		assert.Equal(t, fmt.Sprintf("periodic:backup:0 0 2 * * *:UTC+1:%d", now+60), runs[0].ID)
+	}
+
+	// A 5 field spec starts with the minutes.
+	runs = wp.periodicJobs[1].runs(time.Date(2016, 7, 13, 0, 1, 0, 0, time.Local).Unix())
+	if assert.Len(t, runs, 1) {
+		assert.Equal(t, time.Date(2016, 7, 13, 0, 2, 0, 0, time.Local).Unix(), runs[0].RunAt)
+		assert.Equal(t, fmt.Sprintf("periodic:cleanup:2 * * * *:%d", runs[0].RunAt), runs[0].ID)
+	}
+}
+
+func TestPeriodicJobArgs(t *testing.T) {
+	wp := NewWorkerPool(TestContext{}, 1, "work", newTestPool(":6379"))
+	wp.PeriodicallyEnqueueWithArgs("0 * * * * *", "report", Q{"tenant_id": 4})
+	wp.PeriodicallyEnqueueWithArgs("0 * * * * *", "report", Q{"tenant_id": 5})
+
+	now := time.Date(2016, 7, 13, 0, 59, 30, 0, time.UTC).Unix()
+	runs := wp.periodicJobs[0].runs(now)
+	if assert.Len(t, runs, 4) {
+		assert.Equal(t, fmt.Sprintf(`periodic:report:0 * * * * *:{"tenant_id":4}:%d`, now+30), runs[0].ID)
+		assert.Equal(t, 4, runs[0].Args["tenant_id"])
+	}
+
+	wp.RemovePeriodicJobWithArgs("0 * * * * *", "report", Q{"tenant_id": 4})
+	if assert.Len(t, wp.periodicJobs, 1) {
+		assert.Equal(t, `{"tenant_id":5}`, wp.periodicJobs[0].rawArgs)
+	}
+
+	wp.PeriodicallyEnqueueWithArgs("0 * * * * *", "report", Q{"tenant_id": 6})
+	wp.RemovePeriodicJob("0 * * * * *", "report")
+	assert.Len(t, wp.periodicJobs, 0)
+}
+
 func TestPeriodicEnqueuerSpawn(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
 	cleanKeyspace(ns, pool)
 
-	pe := newPeriodicEnqueuer(ns, pool, nil)
+	pe := newPeriodicEnqueuer(ns, pool, nil, nil)
 	pe.start()
 	pe.stop()
 }
@@ -115,6 +208,9 @@ func appendPeriodicJob(pjs []*periodicJob, spec, jobName string) []*periodicJob
 		panic(err)
 	}
 
-	pj := &periodicJob{jobName: jobName, spec: spec, schedule: sched}
+	pj, err := newPeriodicJob(jobName, spec, sched, PeriodicOptions{})
+	if err != nil {
+		panic(err)
+	}
 	return append(pjs, pj)
 }
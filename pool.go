@@ -0,0 +1,353 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// Pool is a source of Redis connections. A redigo *redis.Pool satisfies it directly. Other Redis clients can be used by
+// wrapping them in a Doer and passing it to NewDoerPool, as the goredis module does for a go-redis UniversalClient.
+type Pool interface {
+	Get() redis.Conn
+}
+
+// Doer runs Redis commands using a client other than redigo. Implementations must:
+//   - return nil replies as (nil, nil) rather than an error (eg, translate go-redis' redis.Nil).
+//   - return errors replied by the Redis server as a redis.Error, so that scripts can be loaded on demand.
+type Doer interface {
+	// Do runs a single command, eg, Do(ctx, "LPUSH", "work:jobs:send_email", rawJSON).
+	Do(ctx context.Context, args ...interface{}) (interface{}, error)
+
+	// Pipeline runs cmds in a single round trip, wrapped in MULTI/EXEC if tx is true. It returns one reply per
+	// command; a command that failed has its error in place of its reply.
+	Pipeline(ctx context.Context, cmds [][]interface{}, tx bool) ([]interface{}, error)
+}
+
+// NewDoerPool returns a Pool whose connections run their commands with doer. The connection pooling itself is left
+// to the client behind doer.
+//
+// The goredis module provides a Doer for go-redis clients, eg, work.NewDoerPool(goredis.NewDoer(rdb)).
+//
+// The connections only run commands that get a reply, so they can't subscribe to channels: worker pools using one
+// can't use FetchPubSub, and NewWorkerPoolWithOptions panics if they try. FetchBlocking works, as BRPOP is run by doer.
+func NewDoerPool(doer Doer) Pool {
+	if doer == nil {
+		panic("NewDoerPool needs a non-nil Doer")
+	}
+	return &doerPool{doer: doer}
+}
+
+type doerPool struct {
+	doer Doer
+}
+
+func (p *doerPool) Get() redis.Conn {
+	return &doerConn{doer: p.doer}
+}
+
+var errDoerConnClosed = fmt.Errorf("work: connection closed")
+
+// doerConn implements redis.Conn on top of a Doer. Commands passed to Send are buffered until Flush or Do, and
+// commands sent between MULTI and EXEC are run together as a transaction when EXEC is flushed.
+type doerConn struct {
+	doer    Doer
+	pending []doerCmd
+	replies []interface{}
+	tx      [][]interface{}
+	inTx    bool
+	closed  bool
+}
+
+// doerCmd is a command waiting to be flushed. Exactly one of args, tx or status is set: status is the canned reply
+// for MULTI and queued commands, tx holds a transaction's commands in place of EXEC.
+type doerCmd struct {
+	args   []interface{}
+	tx     [][]interface{}
+	status string
+}
+
+func (c *doerConn) Close() error {
+	c.closed = true
+	c.pending = nil
+	c.replies = nil
+	c.tx = nil
+	c.inTx = false
+	return nil
+}
+
+func (c *doerConn) Err() error {
+	if c.closed {
+		return errDoerConnClosed
+	}
+	return nil
+}
+
+func (c *doerConn) Send(commandName string, args ...interface{}) error {
+	if c.closed {
+		return errDoerConnClosed
+	}
+
+	cmd := append([]interface{}{commandName}, args...)
+	switch strings.ToUpper(commandName) {
+	case "MULTI":
+		c.inTx = true
+		c.tx = [][]interface{}{}
+		c.pending = append(c.pending, doerCmd{status: "OK"})
+	case "EXEC":
+		c.pending = append(c.pending, doerCmd{tx: c.tx})
+		c.inTx = false
+		c.tx = nil
+	case "DISCARD":
+		c.pending = append(c.pending, doerCmd{status: "OK"})
+		c.inTx = false
+		c.tx = nil
+	default:
+		if c.inTx {
+			c.tx = append(c.tx, cmd)
+			c.pending = append(c.pending, doerCmd{status: "QUEUED"})
+		} else {
+			c.pending = append(c.pending, doerCmd{args: cmd})
+		}
+	}
+
+	return nil
+}
+
+func (c *doerConn) Flush() error {
+	return c.flush(context.Background())
+}
+
+func (c *doerConn) flush(ctx context.Context) error {
+	if c.closed {
+		return errDoerConnClosed
+	}
+	if len(c.pending) == 0 {
+		return nil
+	}
+
+	pending := c.pending
+	c.pending = nil
+
+	// Plain commands are pipelined together, but any collected before a transaction are run before it, so that
+	// commands run in the order they were sent.
+	var batch [][]interface{}
+	var batchIdx []int
+	replies := make([]interface{}, len(pending))
+	runBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		res, err := c.doer.Pipeline(ctx, batch, false)
+		if err != nil {
+			return err
+		}
+		for i, r := range res {
+			replies[batchIdx[i]] = normalizeDoerReply(r)
+		}
+		batch, batchIdx = nil, nil
+		return nil
+	}
+	for i, cmd := range pending {
+		switch {
+		case cmd.status != "":
+			replies[i] = cmd.status
+		case cmd.tx != nil:
+			if err := runBatch(); err != nil {
+				return err
+			}
+			res, err := c.doer.Pipeline(ctx, cmd.tx, true)
+			if err != nil {
+				return err
+			}
+			replies[i] = normalizeDoerReply(res)
+		default:
+			batch = append(batch, cmd.args)
+			batchIdx = append(batchIdx, i)
+		}
+	}
+	if err := runBatch(); err != nil {
+		return err
+	}
+
+	c.replies = append(c.replies, replies...)
+	return nil
+}
+
+func (c *doerConn) Receive() (interface{}, error) {
+	return c.ReceiveContext(context.Background())
+}
+
+// ReceiveContext implements redis.ConnWithContext, passing ctx to the Doer if the reply hasn't been flushed yet.
+func (c *doerConn) ReceiveContext(ctx context.Context) (interface{}, error) {
+	if c.closed {
+		return nil, errDoerConnClosed
+	}
+	if len(c.replies) == 0 {
+		if err := c.flush(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if len(c.replies) == 0 {
+		return nil, fmt.Errorf("work: no pending replies")
+	}
+
+	reply := c.replies[0]
+	c.replies = c.replies[1:]
+	if err, ok := reply.(redis.Error); ok {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (c *doerConn) Do(commandName string, args ...interface{}) (interface{}, error) {
+	return c.DoContext(context.Background(), commandName, args...)
+}
+
+// DoContext implements redis.ConnWithContext, passing ctx to the Doer.
+func (c *doerConn) DoContext(ctx context.Context, commandName string, args ...interface{}) (interface{}, error) {
+	if c.closed {
+		return nil, errDoerConnClosed
+	}
+
+	if len(c.pending) == 0 && len(c.replies) == 0 && !c.inTx && commandName != "" {
+		reply, err := c.doer.Do(ctx, append([]interface{}{commandName}, args...)...)
+		if err != nil {
+			return nil, err
+		}
+		return normalizeDoerReply(reply), nil
+	}
+
+	// Like redigo, flush anything that was sent and return the reply to the last command, along with the first
+	// error that any of the pending commands replied with.
+	if commandName != "" {
+		if err := c.Send(commandName, args...); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.flush(ctx); err != nil {
+		return nil, err
+	}
+
+	var reply interface{}
+	var replyErr error
+	for len(c.replies) > 0 {
+		reply = c.replies[0]
+		c.replies = c.replies[1:]
+		if e, ok := reply.(redis.Error); ok && replyErr == nil {
+			replyErr = e
+		}
+	}
+	if commandName == "" {
+		return nil, replyErr
+	}
+	return reply, replyErr
+}
+
+// normalizeDoerReply converts replies into the types redigo uses, so that the redigo reply helpers work with them:
+// strings become []byte and errors become redis.Error.
+func normalizeDoerReply(reply interface{}) interface{} {
+	switch v := reply.(type) {
+	case string:
+		return []byte(v)
+	case int:
+		return int64(v)
+	case redis.Error:
+		return v
+	case error:
+		return redis.Error(v.Error())
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i := range v {
+			res[i] = normalizeDoerReply(v[i])
+		}
+		return res
+	}
+	return reply
+}
+
+// contextPool gets connections from Pool whose commands give up once ctx is done, see getConn.
+type contextPool struct {
+	Pool
+	ctx context.Context
+}
+
+func (p *contextPool) Get() redis.Conn {
+	return getConn(p.ctx, p.Pool)
+}
+
+// getConn gets a connection from pool whose commands give up once ctx is done, returning its error. A redigo
+// *redis.Pool waits for a connection as per ctx, and connections that support redis.ConnWithContext, as redigo's and
+// NewDoerPool's do, run their commands with it. Other connections only check ctx before each command.
+func getConn(ctx context.Context, pool Pool) redis.Conn {
+	if ctx.Done() == nil {
+		return pool.Get()
+	}
+
+	var conn redis.Conn
+	if p, ok := pool.(interface {
+		GetContext(ctx context.Context) (redis.Conn, error)
+	}); ok {
+		var err error
+		if conn, err = p.GetContext(ctx); err != nil {
+			return errorConn{err}
+		}
+	} else {
+		conn = pool.Get()
+	}
+	return &contextConn{Conn: conn, ctx: ctx}
+}
+
+// contextConn runs its commands with ctx.
+type contextConn struct {
+	redis.Conn
+	ctx context.Context
+}
+
+func (c *contextConn) Do(commandName string, args ...interface{}) (interface{}, error) {
+	if conn, ok := c.Conn.(redis.ConnWithContext); ok {
+		return conn.DoContext(c.ctx, commandName, args...)
+	}
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.Conn.Do(commandName, args...)
+}
+
+func (c *contextConn) Send(commandName string, args ...interface{}) error {
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	return c.Conn.Send(commandName, args...)
+}
+
+func (c *contextConn) Flush() error {
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	return c.Conn.Flush()
+}
+
+func (c *contextConn) Receive() (interface{}, error) {
+	if conn, ok := c.Conn.(redis.ConnWithContext); ok {
+		return conn.ReceiveContext(c.ctx)
+	}
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.Conn.Receive()
+}
+
+// errorConn is a connection that couldn't be got, whose commands fail with err.
+type errorConn struct {
+	err error
+}
+
+func (c errorConn) Close() error                                   { return nil }
+func (c errorConn) Err() error                                     { return c.err }
+func (c errorConn) Do(string, ...interface{}) (interface{}, error) { return nil, c.err }
+func (c errorConn) Send(string, ...interface{}) error              { return c.err }
+func (c errorConn) Flush() error                                   { return c.err }
+func (c errorConn) Receive() (interface{}, error)                  { return nil, c.err }
@@ -0,0 +1,218 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeDoer struct {
+	cmds      [][]interface{}
+	pipelines int
+	txs       int
+	replies   map[string]interface{}
+}
+
+func (d *fakeDoer) reply(cmd []interface{}) interface{} {
+	d.cmds = append(d.cmds, cmd)
+	if r, ok := d.replies[cmd[0].(string)]; ok {
+		return r
+	}
+	return "OK"
+}
+
+func (d *fakeDoer) Do(ctx context.Context, args ...interface{}) (interface{}, error) {
+	r := d.reply(args)
+	if err, ok := r.(error); ok {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (d *fakeDoer) Pipeline(ctx context.Context, cmds [][]interface{}, tx bool) ([]interface{}, error) {
+	d.pipelines++
+	if tx {
+		d.txs++
+	}
+	replies := make([]interface{}, 0, len(cmds))
+	for _, cmd := range cmds {
+		replies = append(replies, d.reply(cmd))
+	}
+	return replies, nil
+}
+
+func TestDoerPoolDo(t *testing.T) {
+	doer := &fakeDoer{replies: map[string]interface{}{
+		"GET":    "12",
+		"LRANGE": []interface{}{"a", "b"},
+		"HGET":   nil,
+		"EVAL":   redis.Error("ERR boom"),
+	}}
+	conn := NewDoerPool(doer).Get()
+	defer conn.Close()
+
+	n, err := redis.Int64(conn.Do("GET", "foo"))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 12, n)
+
+	vals, err := redis.Strings(conn.Do("LRANGE", "foo", 0, -1))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, vals)
+
+	_, err = redis.String(conn.Do("HGET", "foo", "bar"))
+	assert.Equal(t, redis.ErrNil, err)
+
+	_, err = conn.Do("EVAL", "return 1", 0)
+	assert.Equal(t, redis.Error("ERR boom"), err)
+
+	assert.Equal(t, 0, doer.pipelines)
+}
+
+func TestDoerPoolPipeline(t *testing.T) {
+	doer := &fakeDoer{replies: map[string]interface{}{
+		"LLEN": int64(3),
+		"GET":  fmt.Errorf("WRONGTYPE"),
+	}}
+	conn := NewDoerPool(doer).Get()
+	defer conn.Close()
+
+	assert.NoError(t, conn.Send("LLEN", "a"))
+	assert.NoError(t, conn.Send("GET", "b"))
+	assert.NoError(t, conn.Flush())
+	assert.Equal(t, 1, doer.pipelines)
+
+	n, err := redis.Int64(conn.Receive())
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, n)
+
+	_, err = conn.Receive()
+	assert.Equal(t, redis.Error("WRONGTYPE"), err)
+}
+
+func TestDoerPoolTransaction(t *testing.T) {
+	doer := &fakeDoer{replies: map[string]interface{}{
+		"DECR": int64(0),
+	}}
+	conn := NewDoerPool(doer).Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	conn.Send("LREM", "inprog", 1, "job")
+	conn.Send("DECR", "lock")
+	vals, err := redis.Values(conn.Do("EXEC"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, doer.txs)
+	assert.Equal(t, []interface{}{[]byte("OK"), int64(0)}, vals)
+	assert.Equal(t, [][]interface{}{{"LREM", "inprog", 1, "job"}, {"DECR", "lock"}}, doer.cmds)
+}
+
+func TestDoerPoolTransactionOrder(t *testing.T) {
+	doer := &fakeDoer{replies: map[string]interface{}{
+		"INCR": int64(1),
+	}}
+	conn := NewDoerPool(doer).Get()
+	defer conn.Close()
+
+	conn.Send("SET", "a", 1)
+	conn.Send("MULTI")
+	conn.Send("INCR", "a")
+	conn.Send("EXEC")
+	conn.Send("DEL", "a")
+	assert.NoError(t, conn.Flush())
+	assert.Equal(t, 3, doer.pipelines)
+	assert.Equal(t, [][]interface{}{{"SET", "a", 1}, {"INCR", "a"}, {"DEL", "a"}}, doer.cmds)
+
+	for _, want := range []interface{}{[]byte("OK"), "OK", "QUEUED", []interface{}{int64(1)}, []byte("OK")} {
+		reply, err := conn.Receive()
+		assert.NoError(t, err)
+		assert.Equal(t, want, reply)
+	}
+}
+
+// stalledDoer waits for each command's context to be done, like a Redis server that's stopped replying.
+type stalledDoer struct{}
+
+func (stalledDoer) Do(ctx context.Context, args ...interface{}) (interface{}, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (stalledDoer) Pipeline(ctx context.Context, cmds [][]interface{}, tx bool) ([]interface{}, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestDoerPoolPubSub(t *testing.T) {
+	pool := NewDoerPool(&fakeDoer{})
+	assert.PanicsWithValue(t, "work: FetchPubSub can't be used with a NewDoerPool pool, as it can't subscribe to channels", func() {
+		NewWorkerPoolWithOptions(TestContext{}, 1, "work", pool, WithFetchStrategy(FetchPubSub))
+	})
+	assert.NotPanics(t, func() {
+		NewWorkerPoolWithOptions(TestContext{}, 1, "work", pool, WithFetchStrategy(FetchBlocking))
+	})
+}
+
+func TestContextDeadlines(t *testing.T) {
+	pool := NewDoerPool(stalledDoer{})
+	enqueuer := NewEnqueuer("work", pool)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	job, err := enqueuer.EnqueueContext(ctx, "wat", Q{"a": 1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, job)
+
+	job, err = enqueuer.EnqueueWithPriorityContext(ctx, "wat", PriorityHigh, Q{"a": 1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, job)
+
+	scheduledJob, err := enqueuer.EnqueueInContext(ctx, "wat", 60, Q{"a": 1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, scheduledJob)
+
+	job, err = enqueuer.EnqueueUniqueContext(ctx, "wat", Q{"a": 1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, job)
+
+	jobs, err := enqueuer.EnqueueBatchContext(ctx, "wat", []Q{{"a": 1}, {"a": 2}})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, jobs)
+
+	job, err = enqueuer.EnqueueWithExpiryContext(ctx, "wat", time.Minute, Q{"a": 1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, job)
+
+	job, err = enqueuer.EnqueueWithIdempotencyKeyContext(ctx, "wat", "key", Q{"a": 1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, job)
+
+	job, err = enqueuer.EnqueueChainContext(ctx, Chain("wat", Q{"a": 1}).Then("taw", nil))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, job)
+
+	job, err = enqueuer.EnqueueUniqueByKeyContext(ctx, "wat", Q{"a": 1}, Q{"key": 1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, job)
+
+	scheduledJob, err = enqueuer.EnqueueUniqueInByKeyContext(ctx, "wat", 60, Q{"a": 1}, Q{"key": 1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, scheduledJob)
+
+	job, err = enqueuer.EnqueueUniqueWithinContext(ctx, "wat", Q{"a": 1}, time.Minute)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, job)
+
+	_, err = enqueuer.EnqueueEveryContext(ctx, "wat", time.Minute, Q{"a": 1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorIs(t, enqueuer.CancelRecurringContext(ctx, &RecurringJob{ID: "recurring:wat:{}"}), context.DeadlineExceeded)
+	assert.ErrorIs(t, enqueuer.CancelScheduledContext(ctx, &ScheduledJob{RunAt: 1, Job: &Job{ID: "1"}}), context.DeadlineExceeded)
+
+	assert.ErrorIs(t, enqueuer.PingContext(ctx), context.DeadlineExceeded)
+
+	_, err = NewClient("work", pool).WithContext(ctx).Queues()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -19,9 +19,13 @@ type sampleItem struct {
 	redisJobsLock           string
 	redisJobsLockInfo       string
 	redisJobsMaxConcurrency string
+	redisJobsPriority       string
+	redisJobsRateLimit      string
+	redisJobsLeases         string
+	redisJobsPartition      string
 }
 
-func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJobsPaused, redisJobsLock, redisJobsLockInfo, redisJobsMaxConcurrency string) {
+func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJobsPaused, redisJobsLock, redisJobsLockInfo, redisJobsMaxConcurrency, redisJobsPriority, redisJobsRateLimit, redisJobsLeases, redisJobsPartition string) {
 	sample := sampleItem{
 		priority:                priority,
 		redisJobs:               redisJobs,
@@ -30,6 +34,10 @@ func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJo
 		redisJobsLock:           redisJobsLock,
 		redisJobsLockInfo:       redisJobsLockInfo,
 		redisJobsMaxConcurrency: redisJobsMaxConcurrency,
+		redisJobsPriority:       redisJobsPriority,
+		redisJobsRateLimit:      redisJobsRateLimit,
+		redisJobsLeases:         redisJobsLeases,
+		redisJobsPartition:      redisJobsPartition,
 	}
 	s.samples = append(s.samples, sample)
 	s.sum += priority
@@ -0,0 +1,76 @@
+// Package prometheus exports the metrics collected by the metrics package with the Prometheus client library, so that
+// they're served by promhttp.Handler along with the rest of a service's metrics. It's a module of its own so that the
+// work package doesn't depend on the client library.
+//
+//	m := metrics.New()
+//	m.Instrument(pool)
+//	stop := m.Poll(work.NewClient("my_app_namespace", redisPool), 15*time.Second)
+//	defer stop()
+//	prometheus.MustRegister(workprometheus.NewCollector(m))
+//	http.Handle("/metrics", promhttp.Handler())
+package prometheus
+
+import (
+	"github.com/teamwork/work/v2/metrics"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	processedDesc    = newDesc("work_jobs_processed_total", "Number of jobs processed.")
+	failedDesc       = newDesc("work_jobs_failed_total", "Number of jobs that returned an error or panicked.")
+	retriedDesc      = newDesc("work_jobs_retried_total", "Number of failed jobs put in the retry queue.")
+	deadDesc         = newDesc("work_jobs_dead_total", "Number of failed jobs moved to the dead queue.")
+	deadLetteredDesc = newDesc("work_jobs_dead_lettered_total", "Number of failed jobs enqueued on a dead letter queue.")
+	durationDesc     = newDesc("work_job_duration_seconds", "How long jobs took to run.")
+	queueDepthDesc   = newDesc("work_queue_depth", "Number of jobs waiting in the queue.")
+	queueLatencyDesc = newDesc("work_queue_latency_seconds", "How long the next job in the queue has been waiting.")
+)
+
+func newDesc(name, help string) *prom.Desc {
+	return prom.NewDesc(name, help, []string{"job"}, nil)
+}
+
+// Collector is a prometheus.Collector for the metrics collected by a metrics.Metrics. The metrics have the same names
+// and labels as those metrics.Metrics serves itself.
+type Collector struct {
+	metrics *metrics.Metrics
+}
+
+// NewCollector returns a Collector for m.
+func NewCollector(m *metrics.Metrics) *Collector {
+	return &Collector{metrics: m}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prom.Desc) {
+	for _, desc := range []*prom.Desc{
+		processedDesc, failedDesc, retriedDesc, deadDesc, deadLetteredDesc, durationDesc, queueDepthDesc, queueLatencyDesc,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prom.Metric) {
+	s := c.metrics.Snapshot()
+
+	collectCounts(ch, processedDesc, s.Processed)
+	collectCounts(ch, failedDesc, s.Failed)
+	collectCounts(ch, retriedDesc, s.Retried)
+	collectCounts(ch, deadDesc, s.Dead)
+	collectCounts(ch, deadLetteredDesc, s.DeadLettered)
+	for jobName, h := range s.Durations {
+		ch <- prom.MustNewConstHistogram(durationDesc, h.Count, h.Sum, h.Buckets, jobName)
+	}
+	for _, q := range s.Queues {
+		ch <- prom.MustNewConstMetric(queueDepthDesc, prom.GaugeValue, float64(q.Count), q.JobName)
+		ch <- prom.MustNewConstMetric(queueLatencyDesc, prom.GaugeValue, float64(q.Latency), q.JobName)
+	}
+}
+
+func collectCounts(ch chan<- prom.Metric, desc *prom.Desc, counts map[string]float64) {
+	for jobName, n := range counts {
+		ch <- prom.MustNewConstMetric(desc, prom.CounterValue, n, jobName)
+	}
+}
@@ -0,0 +1,41 @@
+package prometheus
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	work "github.com/teamwork/work/v2"
+	"github.com/teamwork/work/v2/metrics"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func TestCollector(t *testing.T) {
+	m := metrics.NewWithBuckets([]float64{0.1, 1})
+	assert.NoError(t, m.Middleware(&work.Job{Name: "send_email"}, func() error { return nil }))
+	assert.Error(t, m.Middleware(&work.Job{Name: "send_email"}, func() error {
+		time.Sleep(150 * time.Millisecond)
+		return fmt.Errorf("bounced")
+	}))
+
+	reg := prom.NewPedanticRegistry()
+	assert.NoError(t, reg.Register(NewCollector(m)))
+
+	rec := httptest.NewRecorder()
+	promhttp.HandlerFor(reg, promhttp.HandlerOpts{}).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, body, "# TYPE work_jobs_processed_total counter\n")
+	assert.Contains(t, body, `work_jobs_processed_total{job="send_email"} 2`+"\n")
+	assert.Contains(t, body, `work_jobs_failed_total{job="send_email"} 1`+"\n")
+	assert.Contains(t, body, "# TYPE work_job_duration_seconds histogram\n")
+	assert.Contains(t, body, `work_job_duration_seconds_bucket{job="send_email",le="0.1"} 1`+"\n")
+	assert.Contains(t, body, `work_job_duration_seconds_bucket{job="send_email",le="1"} 2`+"\n")
+	assert.Contains(t, body, `work_job_duration_seconds_count{job="send_email"} 2`+"\n")
+	assert.NotContains(t, body, "work_queue_depth")
+}
@@ -0,0 +1,19 @@
+module github.com/teamwork/work/v2/protobuf
+
+go 1.18
+
+require (
+	github.com/stretchr/testify v1.8.4
+	github.com/teamwork/work/v2 v2.0.0
+	google.golang.org/protobuf v1.33.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/gomodule/redigo v1.9.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/robfig/cron/v3 v3.0.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/teamwork/work/v2 => ../
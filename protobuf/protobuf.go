@@ -0,0 +1,69 @@
+// Package protobuf provides a work.Codec that stores the arguments of jobs as a protobuf google.protobuf.Struct. It's a
+// module of its own so that the work package doesn't depend on protobuf.
+//
+//	enqueuer.SetCodec(protobuf.Codec)
+//	pool := work.NewWorkerPoolWithOptions(Context{}, 10, "my_app_namespace", redisPool, work.WithCodec(protobuf.Codec))
+//
+// It's for services that read jobs with protobuf, eg, in other languages: a Struct holds the same values as JSON, so
+// numbers are float64s, and it doesn't make jobs smaller. The encoded arguments are base64 encoded in the job's JSON,
+// which makes them a third bigger, so typical arguments take up more space than they do as JSON, as BenchmarkSize
+// measures. To save Redis memory, compress big arguments with Enqueuer.SetCompressionThreshold instead.
+package protobuf
+
+import (
+	"encoding/json"
+	"fmt"
+
+	work "github.com/teamwork/work/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Codec encodes the arguments of jobs as a google.protobuf.Struct. Arguments of types a Struct can't hold directly,
+// such as structs or typed slices, are stored as they're encoded to JSON. It's registered when the package is imported,
+// so processes that only read jobs, such as the web UI, just need to import it.
+var Codec work.Codec = codec{}
+
+func init() {
+	work.RegisterCodec(Codec)
+}
+
+type codec struct{}
+
+func (codec) Name() string { return "protobuf" }
+
+func (codec) Marshal(v interface{}) ([]byte, error) {
+	args, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("protobuf: can't encode %T, only map[string]interface{}", v)
+	}
+	s, err := structpb.NewStruct(args)
+	if err != nil {
+		// Convert the args to the types a Struct holds, as JSON would.
+		data, jsonErr := json.Marshal(args)
+		if jsonErr != nil {
+			return nil, jsonErr
+		}
+		args = nil
+		if jsonErr := json.Unmarshal(data, &args); jsonErr != nil {
+			return nil, jsonErr
+		}
+		if s, err = structpb.NewStruct(args); err != nil {
+			return nil, err
+		}
+	}
+	return proto.Marshal(s)
+}
+
+func (codec) Unmarshal(data []byte, v interface{}) error {
+	args, ok := v.(*map[string]interface{})
+	if !ok {
+		return fmt.Errorf("protobuf: can't decode into %T, only *map[string]interface{}", v)
+	}
+	var s structpb.Struct
+	if err := proto.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*args = s.AsMap()
+	return nil
+}
@@ -0,0 +1,142 @@
+package work
+
+import (
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const queueDepthSamplePeriod = 30 * time.Second
+
+// QueueDepthSampler records how many jobs are waiting on each of a namespace's queues every minute, keeping the
+// samples for StatsRetention, for graphing backlogs and capacity planning. Read them with Client.QueueDepthHistory.
+// Only one is needed per namespace, eg, alongside the web UI, but running more is harmless.
+type QueueDepthSampler struct {
+	namespace string
+	client    *Client
+	period    time.Duration
+	logger    Logger
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+// QueueDepths is how many jobs were waiting on each queue, by job name, in a minute, as returned by
+// Client.QueueDepthHistory.
+type QueueDepths struct {
+	Minute int64            `json:"minute"` // Start of the minute, in epoch seconds
+	Depths map[string]int64 `json:"depths"` // Empty if the minute wasn't sampled
+}
+
+// NewQueueDepthSampler creates a QueueDepthSampler for namespace. Call Start to start sampling.
+func NewQueueDepthSampler(namespace string, pool Pool) *QueueDepthSampler {
+	return &QueueDepthSampler{
+		namespace:        namespace,
+		client:           NewClient(namespace, pool),
+		period:           queueDepthSamplePeriod,
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}
+}
+
+// SetLogger sets the Logger that errors are logged to.
+func (s *QueueDepthSampler) SetLogger(logger Logger) *QueueDepthSampler {
+	s.logger = logger
+	s.client.SetLogger(logger)
+	return s
+}
+
+// Start samples the queue depths in the background until Stop is called.
+func (s *QueueDepthSampler) Start() {
+	go s.loop()
+}
+
+// Stop stops sampling.
+func (s *QueueDepthSampler) Stop() {
+	s.stopChan <- struct{}{}
+	<-s.doneStoppingChan
+}
+
+func (s *QueueDepthSampler) loop() {
+	ticker := time.NewTicker(s.period)
+	defer ticker.Stop()
+
+	s.sample()
+	for {
+		select {
+		case <-s.stopChan:
+			s.doneStoppingChan <- struct{}{}
+			return
+		case <-ticker.C:
+			s.sample()
+		}
+	}
+}
+
+// sample records the current queue depths in the current minute's hash, replacing any earlier sample in the minute.
+func (s *QueueDepthSampler) sample() {
+	queues, err := s.client.Queues()
+	if err != nil {
+		logError(s.logger, "queue_depth_sampler.queues", err)
+		return
+	}
+	if len(queues) == 0 {
+		return
+	}
+
+	key := redisKeyQueueDepths(s.namespace, nowEpochSeconds()/60*60)
+	args := redis.Args{key}
+	for _, q := range queues {
+		args = args.Add(q.JobName, q.Count)
+	}
+
+	conn := s.client.pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	conn.Send("HMSET", args...)
+	conn.Send("EXPIRE", key, int64(StatsRetention/time.Second))
+	if _, err := conn.Do("EXEC"); err != nil {
+		logError(s.logger, "queue_depth_sampler.exec", err)
+	}
+}
+
+// QueueDepthHistory returns how many jobs were waiting on each queue in each minute of the last d, oldest first, up to
+// StatsRetention, as recorded by a QueueDepthSampler.
+func (c *Client) QueueDepthHistory(d time.Duration) ([]*QueueDepths, error) {
+	if d > StatsRetention {
+		d = StatsRetention
+	}
+	now := nowEpochSeconds() / 60 * 60
+	minutes := int64(d / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	history := make([]*QueueDepths, 0, minutes)
+	for minute := now - (minutes-1)*60; minute <= now; minute += 60 {
+		history = append(history, &QueueDepths{Minute: minute})
+		if err := conn.Send("HGETALL", redisKeyQueueDepths(c.namespace, minute)); err != nil {
+			logError(c.logger, "client.queue_depth_history.send", err)
+			return nil, err
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		logError(c.logger, "client.queue_depth_history.flush", err)
+		return nil, err
+	}
+
+	for _, h := range history {
+		depths, err := redis.Int64Map(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.queue_depth_history.receive", err)
+			return nil, err
+		}
+		h.Depths = depths
+	}
+
+	return history, nil
+}
@@ -0,0 +1,40 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueDepthSampler(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1467760821)
+	defer resetNowEpochSecondsMock()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, name := range []string{"wat", "wat", "foo"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err)
+	}
+	sampler := NewQueueDepthSampler(ns, pool)
+	sampler.sample()
+
+	setNowEpochSecondsMock(1467760821 + 120)
+	_, err := enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+	sampler.Start()
+	sampler.Stop()
+
+	history, err := NewClient(ns, pool).QueueDepthHistory(4 * time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, []*QueueDepths{
+		{Minute: 1467760740, Depths: map[string]int64{}},
+		{Minute: 1467760800, Depths: map[string]int64{"wat": 2, "foo": 1}},
+		{Minute: 1467760860, Depths: map[string]int64{}},
+		{Minute: 1467760920, Depths: map[string]int64{"wat": 2, "foo": 2}},
+	}, history)
+}
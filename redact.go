@@ -0,0 +1,94 @@
+package work
+
+import "sync"
+
+// RedactedArg is shown in place of the value of a redacted argument.
+const RedactedArg = "[REDACTED]"
+
+var (
+	redactionsMtx sync.RWMutex
+	redactions    = make(map[string]map[string]bool) // job name, or "" for all jobs -> argument names
+)
+
+// RedactArgs hides the values of the arguments named fields, eg, "password" or "token", of jobs named jobName, or of
+// every job if jobName is empty, wherever jobs are shown rather than run: the jobs returned by the Client (and so the
+// web UI), the args of WorkerObservations, and dead job notifications. Arguments are matched by name at any depth, so
+// nested objects are redacted too. The jobs stored in Redis, and those passed to handlers and hooks, keep their
+// arguments. It's typically called from an init function, in every process that shows jobs.
+// Example: work.RedactArgs("charge_card", "card_number", "cvv")
+func RedactArgs(jobName string, fields ...string) {
+	redactionsMtx.Lock()
+	defer redactionsMtx.Unlock()
+	if redactions[jobName] == nil {
+		redactions[jobName] = make(map[string]bool)
+	}
+	for _, field := range fields {
+		redactions[jobName][field] = true
+	}
+}
+
+// redactedArgs returns a copy of args with the values of the arguments redacted for jobs named jobName replaced by
+// RedactedArg. It returns false, and args itself, if no arguments are redacted for jobName.
+func redactedArgs(jobName string, args map[string]interface{}) (map[string]interface{}, bool) {
+	redactionsMtx.RLock()
+	defer redactionsMtx.RUnlock()
+	forJob, forAll := redactions[jobName], redactions[""]
+	if args == nil || (len(forJob) == 0 && len(forAll) == 0) {
+		return args, false
+	}
+	redacted := redactValue(args, func(field string) bool { return forJob[field] || forAll[field] })
+	return redacted.(map[string]interface{}), true
+}
+
+// redactValue returns a copy of v with the values of the object fields that shouldRedact redacted.
+func redactValue(v interface{}, shouldRedact func(field string) bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for field, value := range v {
+			if shouldRedact(field) {
+				redacted[field] = RedactedArg
+			} else {
+				redacted[field] = redactValue(value, shouldRedact)
+			}
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, value := range v {
+			redacted[i] = redactValue(value, shouldRedact)
+		}
+		return redacted
+	default:
+		return v
+	}
+}
+
+// redacted returns a copy of j with its arguments redacted as per RedactArgs, or j itself if there's nothing to redact.
+func (j *Job) redacted() *Job {
+	args, ok := redactedArgs(j.Name, j.Args)
+	if !ok {
+		return j
+	}
+	cp := *j
+	cp.Args = args
+	return &cp
+}
+
+// RedactedArgs returns j's arguments with those hidden by RedactArgs redacted, eg, to report them to an error tracker
+// from a middleware. They're a copy if anything was redacted, so mustn't be modified.
+func (j *Job) RedactedArgs() map[string]interface{} {
+	args, _ := redactedArgs(j.Name, j.Args)
+	return args
+}
+
+// redactedArg returns RedactedArg if j's argument named key is redacted, or its value v if not, eg, for error
+// messages, which are logged and stored with the job.
+func (j *Job) redactedArg(key string, v interface{}) interface{} {
+	redactionsMtx.RLock()
+	defer redactionsMtx.RUnlock()
+	if redactions[j.Name][key] || redactions[""][key] {
+		return RedactedArg
+	}
+	return v
+}
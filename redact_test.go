@@ -0,0 +1,32 @@
+package work
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactArgs(t *testing.T) {
+	RedactArgs("redact_test_login", "password", "token")
+
+	job := &Job{Name: "redact_test_login", Args: Q{
+		"user":     "bob",
+		"password": "hunter2",
+		"session":  map[string]interface{}{"token": "abc", "ttl": 60.0},
+		"devices":  []interface{}{map[string]interface{}{"token": "def"}},
+	}}
+	redacted := job.redacted()
+	assert.Equal(t, map[string]interface{}{
+		"user":     "bob",
+		"password": RedactedArg,
+		"session":  map[string]interface{}{"token": RedactedArg, "ttl": 60.0},
+		"devices":  []interface{}{map[string]interface{}{"token": RedactedArg}},
+	}, redacted.Args)
+	assert.Equal(t, "hunter2", job.Args["password"])
+
+	other := &Job{Name: "redact_test_other", Args: Q{"password": "hunter2"}}
+	assert.True(t, other == other.redacted())
+
+	job.ArgInt64("password")
+	assert.EqualError(t, job.ArgError(), "looking for a int64 in job.Arg[password] but value wasn't right type: string([REDACTED])")
+}
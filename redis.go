@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 )
 
 func redisNamespacePrefix(namespace string) string {
@@ -72,11 +73,91 @@ func redisKeyJobsConcurrency(namespace, jobName string) string {
 	return redisKeyJobs(namespace, jobName) + ":max_concurrency"
 }
 
+func redisKeyJobsUniqueTTL(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":unique_ttl"
+}
+
+func redisKeyJobsPriority(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":priority"
+}
+
+func redisKeyJobsRateLimit(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":rate_limit"
+}
+
+func redisKeyJobsLeases(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":leases"
+}
+
+func redisKeyJobsPartition(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":partition"
+}
+
+// redisKeyJobsShards returns the key of the hash that describes how a job type's queue is sharded, as written by the
+// worker pools, see JobOptions.Shards.
+func redisKeyJobsShards(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":shards"
+}
+
+// redisKeyJobsShard returns the key of one of a sharded job type's queues. Shard 0 is the job type's usual queue, which
+// retried, scheduled and reaped jobs are pushed onto.
+func redisKeyJobsShard(namespace, jobName string, shard int) string {
+	if shard == 0 {
+		return redisKeyJobs(namespace, jobName)
+	}
+	return redisKeyJobs(namespace, jobName) + ":shard:" + strconv.Itoa(shard)
+}
+
+// redisKeyJobsStream returns the key of the stream jobs named jobName are added to with BackendStreams.
+func redisKeyJobsStream(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":stream"
+}
+
+// redisKeyBackend returns the key of the namespace's Backend, as written by the worker pools.
+func redisKeyBackend(namespace string) string {
+	return redisNamespacePrefix(namespace) + "backend"
+}
+
+// redisKeyJobsNotify returns the key of the list that's pushed to whenever a job is enqueued, to wake the workers
+// waiting for jobs with FetchBlocking.
+func redisKeyJobsNotify(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":notify"
+}
+
+// redisChannelJobsWake returns the channel that's published to whenever a job is enqueued, to wake the idle workers of
+// pools with FetchPubSub. The scripts that requeue jobs build the same channel from the job's queue.
+func redisChannelJobsWake(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":wake"
+}
+
+// redisKeyJobsPartitionLock returns the key holding the ID of the job running in a job type's partition. The fetch
+// script builds the same key from redisKeyJobsPartition.
+func redisKeyJobsPartitionLock(namespace, jobName, partition string) string {
+	return redisKeyJobsPartition(namespace, jobName) + ":lock:" + partition
+}
+
+// redisKeyJobsPartitionWaiting returns the key of the list of jobs waiting for a job type's partition to be free, in
+// the order they were fetched. The fetch script builds the same key from redisKeyJobsPartition.
+func redisKeyJobsPartitionWaiting(namespace, jobName, partition string) string {
+	return redisKeyJobsPartition(namespace, jobName) + ":waiting:" + partition
+}
+
 func redisKeyUniqueJob(namespace, jobName string, args map[string]interface{}) (string, error) {
+	return redisKeyJobArgs(namespace, "unique:", jobName, args)
+}
+
+// redisKeyUniqueWithin returns the key marking that a job with jobName and args was enqueued by
+// Enqueuer.EnqueueUniqueWithin, which expires at the end of its window.
+func redisKeyUniqueWithin(namespace, jobName string, args map[string]interface{}) (string, error) {
+	return redisKeyJobArgs(namespace, "unique_within:", jobName, args)
+}
+
+// redisKeyJobArgs returns "<namespace>:<prefix><jobName>:<args as JSON>".
+func redisKeyJobArgs(namespace, prefix, jobName string, args map[string]interface{}) (string, error) {
 	var buf bytes.Buffer
 
 	buf.WriteString(redisNamespacePrefix(namespace))
-	buf.WriteString("unique:")
+	buf.WriteString(prefix)
 	buf.WriteString(jobName)
 	buf.WriteRune(':')
 
@@ -94,6 +175,61 @@ func redisKeyKilledJob(namespace, jobID string) string {
 	return redisNamespacePrefix(namespace) + jobID + ":killed"
 }
 
+func redisKeyJobResult(namespace, jobID string) string {
+	return redisNamespacePrefix(namespace) + "result:" + jobID
+}
+
+// redisKeyStats returns the key of the hash counting the jobs processed in the minute starting at minute, eg,
+// "<namespace>:stats:1467760800".
+func redisKeyStats(namespace string, minute int64) string {
+	return redisNamespacePrefix(namespace) + "stats:" + strconv.FormatInt(minute, 10)
+}
+
+// redisKeyStatsTotals returns the key of the hash counting all the jobs processed, eg, "<namespace>:stats".
+func redisKeyStatsTotals(namespace string) string {
+	return redisNamespacePrefix(namespace) + "stats"
+}
+
+// redisKeyQueueDepths returns the key of the hash of how many jobs were waiting on each queue in the minute starting at
+// minute, eg, "<namespace>:queue_depths:1467760800".
+func redisKeyQueueDepths(namespace string, minute int64) string {
+	return redisNamespacePrefix(namespace) + "queue_depths:" + strconv.FormatInt(minute, 10)
+}
+
+// redisKeyJobStats returns the key of the hash counting the jobs named jobName processed, eg,
+// "<namespace>:job_stats:send_email".
+func redisKeyJobStats(namespace, jobName string) string {
+	return redisNamespacePrefix(namespace) + "job_stats:" + jobName
+}
+
+// redisKeyIdempotency returns the key that records whether the job of jobName with the idempotency key key is running
+// or has run. See Job.IdempotencyKey.
+func redisKeyIdempotency(namespace, jobName, key string) string {
+	return redisNamespacePrefix(namespace) + "idempotency:" + jobName + ":" + key
+}
+
+// redisKeyRunLock returns the key of the lock held by the job running for a periodic job with
+// PeriodicOptions.SkipIfRunning, identified by runLock. See Job.RunLock.
+func redisKeyRunLock(namespace, runLock string) string {
+	return redisNamespacePrefix(namespace) + "run_lock:" + runLock
+}
+
+// redisKeyPeriodicJobRuns returns the key of the hash of when each periodic job was last scheduled and is next due, by
+// the prefix of its runs' IDs.
+func redisKeyPeriodicJobRuns(namespace string) string {
+	return redisNamespacePrefix(namespace) + "periodic_job_runs"
+}
+
+// redisKeyReaperEvents returns the key of the list of the latest ReaperEvents, newest first.
+func redisKeyReaperEvents(namespace string) string {
+	return redisNamespacePrefix(namespace) + "reaper_events"
+}
+
+// redisKeyRecurring returns the key of the hash of jobs enqueued with Enqueuer.EnqueueEvery, by RecurringJob.ID.
+func redisKeyRecurring(namespace string) string {
+	return redisNamespacePrefix(namespace) + "recurring"
+}
+
 func redisKeyLastPeriodicEnqueue(namespace string) string {
 	return redisNamespacePrefix(namespace) + "last_periodic_enqueue"
 }
@@ -102,42 +238,145 @@ func redisKeyLastPeriodicEnqueue(namespace string) string {
 //
 // KEYS[1] = the 1st job queue we want to try, eg, "work:jobs:emails"
 // KEYS[2] = the 1st job queue's in prog queue, eg, "work:jobs:emails:97c84119d13cb54119a38743:inprogress"
-// KEYS[3] = the 2nd job queue...
-// KEYS[4] = the 2nd job queue's in prog queue...
+// KEYS[3] = the 1st job queue's paused key
+// KEYS[4] = the 1st job queue's lock
+// KEYS[5] = the 1st job queue's lock info hash
+// KEYS[6] = the 1st job queue's max concurrency key
+// KEYS[7] = the 1st job queue's priority zset, eg, "work:jobs:emails:priority"
+// KEYS[8] = the 1st job queue's rate limit hash, eg, "work:jobs:emails:rate_limit"
+// KEYS[9] = the 1st job queue's concurrency leases zset, eg, "work:jobs:emails:leases"
+// KEYS[10] = the 1st job queue's partition key, eg, "work:jobs:emails:partition"
+// KEYS[11] = the 2nd job queue...
 // ...
-// KEYS[N] = the last job queue...
-// KEYS[N+1] = the last job queue's in prog queue...
 // ARGV[1] = job queue's workerPoolID
+// ARGV[2] = current time in epoch milliseconds
+// ARGV[3] = workerID, which holds the concurrency lease while it runs the job
+// ARGV[4] = epoch milliseconds at which a new concurrency lease expires
+// ARGV[5] = milliseconds a partition lock is held for if the job holding it is never finished
+// ARGV[6...] = the job queues whose jobs are run at most once, see JobOptions.AtMostOnce
+//
+// Jobs in the priority zset with a priority above normal are fetched before any in the job queue, and those below
+// normal after it.
+//
+// If the job type is partitioned, the partition key holds the name of the job arg to partition by. A job whose
+// partition is busy is moved to the partition's waiting list instead of being returned, and the next job is tried.
+// The job that's returned is followed by its partition, if it has one.
+//
+// A job from a queue that's run at most once is acked before it's returned: it's taken off the in prog queue in the
+// same call that pops it, so if the worker dies while running it, it's lost rather than requeued by the reaper. Its
+// lock is still held, and released by the worker as usual.
+//
+// Every queue the worker fetches from is tried in the one call, in the order given, so a poll is a single round trip
+// however many job types the pool has.
 var redisLuaFetchJob = fmt.Sprintf(`
-local function acquireLock(lockKey, lockInfoKey, workerPoolID)
+local function acquireLock(lockKey, lockInfoKey, workerPoolID, leaseKey, maxConcurrency, workerID, leaseExpiresAt)
   redis.call('incr', lockKey)
   redis.call('hincrby', lockInfoKey, workerPoolID, 1)
+  if maxConcurrency and maxConcurrency > 0 then
+    redis.call('zadd', leaseKey, leaseExpiresAt, workerID)
+  end
 end
 
-local function haveJobs(jobQueue)
-  return redis.call('llen', jobQueue) > 0
+local function haveJobs(jobQueue, priorityQueue)
+  return redis.call('llen', jobQueue) > 0 or redis.call('zcard', priorityQueue) > 0
+end
+
+-- pops the most urgent job off the priority zset onto the in prog queue. Scores below 0 are above normal priority.
+local function popPriority(priorityQueue, inProgQueue, aboveNormalOnly)
+  local jobs = redis.call('zrange', priorityQueue, 0, 0, 'WITHSCORES')
+  if #jobs == 0 or (aboveNormalOnly and tonumber(jobs[2]) >= 0) then
+    return nil
+  end
+  redis.call('zrem', priorityQueue, jobs[1])
+  redis.call('lpush', inProgQueue, jobs[1])
+  return jobs[1]
+end
+
+local function popJob(jobQueue, inProgQueue, priorityQueue)
+  return popPriority(priorityQueue, inProgQueue, true) or redis.call('rpoplpush', jobQueue, inProgQueue) or popPriority(priorityQueue, inProgQueue, false)
+end
+
+-- claims job's partition for it, returning the job to run and its partition. If the partition is busy the job waits
+-- its turn and nil is returned. If other jobs are already waiting, the oldest of them runs instead.
+local function claimPartition(job, partitionKey, partitionArg, inProgQueue, lockTTL)
+  local decoded = cjson.decode(job)
+  if type(decoded['args']) ~= 'table' then
+    return job, nil
+  end
+  local value = decoded['args'][partitionArg]
+  local kind = type(value)
+  if kind ~= 'string' and kind ~= 'number' and kind ~= 'boolean' then
+    return job, nil
+  end
+  local partition = tostring(value)
+  local lockKey = partitionKey .. ':lock:' .. partition
+  local waitingKey = partitionKey .. ':waiting:' .. partition
+  local owner = redis.call('get', lockKey)
+  if owner == decoded['id'] then
+    -- requeued after the worker running it died
+    return job, partition
+  end
+  if owner or redis.call('llen', waitingKey) > 0 then
+    redis.call('lrem', inProgQueue, 1, job)
+    redis.call('lpush', waitingKey, job)
+    if owner then
+      return nil, nil
+    end
+    job = redis.call('rpop', waitingKey)
+    decoded = cjson.decode(job)
+    redis.call('lpush', inProgQueue, job)
+  end
+  redis.call('set', lockKey, decoded['id'], 'PX', lockTTL)
+  return job, partition
 end
 
 local function isPaused(pauseKey)
   return redis.call('get', pauseKey)
 end
 
-local function canRun(lockKey, maxConcurrency)
-  local activeJobs = tonumber(redis.call('get', lockKey))
-  if (not maxConcurrency or maxConcurrency == 0) or (not activeJobs or activeJobs < maxConcurrency) then
-    -- default case: maxConcurrency not defined or set to 0 means no cap on concurrent jobs OR
-    -- maxConcurrency set, but lock does not yet exist OR
-    -- maxConcurrency set, lock is set, but not yet at max concurrency
+local function canRun(leaseKey, maxConcurrency, nowMs)
+  if not maxConcurrency or maxConcurrency == 0 then
+    -- default case: maxConcurrency not defined or set to 0 means no cap on concurrent jobs
     return true
-  else
-    -- we are at max capacity for running jobs
-    return false
+  end
+  -- reclaim slots whose lease wasn't renewed, eg, because the worker holding it died
+  redis.call('zremrangebyscore', leaseKey, '-inf', nowMs)
+  -- otherwise we can run unless we are at max capacity for running jobs
+  return redis.call('zcard', leaseKey) < maxConcurrency
+end
+
+-- token bucket refilled at max_per_second tokens per second, holding at most one second's worth of tokens
+local function rateLimitTokens(rateLimitKey, nowMs)
+  local bucket = redis.call('hmget', rateLimitKey, 'max_per_second', 'tokens', 'ts')
+  local maxPerSecond = tonumber(bucket[1])
+  if not maxPerSecond or maxPerSecond <= 0 then
+    return nil
+  end
+  local tokens = tonumber(bucket[2]) or maxPerSecond
+  local ts = tonumber(bucket[3]) or nowMs
+  if nowMs > ts then
+    tokens = math.min(math.max(maxPerSecond, 1), tokens + (nowMs - ts) * maxPerSecond / 1000)
+  end
+  return tokens
+end
+
+local function takeRateLimitToken(rateLimitKey, tokens, nowMs)
+  if tokens then
+    redis.call('hmset', rateLimitKey, 'tokens', tokens - 1, 'ts', nowMs)
   end
 end
 
-local res, jobQueue, inProgQueue, pauseKey, lockKey, maxConcurrency, workerPoolID, concurrencyKey, lockInfoKey
+local res, jobQueue, inProgQueue, pauseKey, lockKey, maxConcurrency, workerPoolID, concurrencyKey, lockInfoKey, priorityQueue, rateLimitKey, tokens, leaseKey, partitionKey, partitionArg, partition
 local keylen = #KEYS
 workerPoolID = ARGV[1]
+local nowMs = tonumber(ARGV[2])
+local workerID = ARGV[3]
+local leaseExpiresAt = tonumber(ARGV[4])
+local partitionLockTTL = tonumber(ARGV[5])
+local atMostOnce = {}
+for i=6,#ARGV do
+  atMostOnce[ARGV[i]] = true
+end
 
 for i=1,keylen,%d do
   jobQueue = KEYS[i]
@@ -146,16 +385,101 @@ for i=1,keylen,%d do
   lockKey = KEYS[i+3]
   lockInfoKey = KEYS[i+4]
   concurrencyKey = KEYS[i+5]
+  priorityQueue = KEYS[i+6]
+  rateLimitKey = KEYS[i+7]
+  leaseKey = KEYS[i+8]
+  partitionKey = KEYS[i+9]
+
+  -- most queues are empty most of the time, so check that before reading their limits
+  if haveJobs(jobQueue, priorityQueue) and not isPaused(pauseKey) then
+    maxConcurrency = tonumber(redis.call('get', concurrencyKey))
+    tokens = rateLimitTokens(rateLimitKey, nowMs)
+
+    if canRun(leaseKey, maxConcurrency, nowMs) and (not tokens or tokens >= 1) then
+      partitionArg = redis.call('get', partitionKey)
+      for attempt=1,%d do
+        res = popJob(jobQueue, inProgQueue, priorityQueue)
+        if not res then
+          break
+        end
+        partition = nil
+        if partitionArg then
+          res, partition = claimPartition(res, partitionKey, partitionArg, inProgQueue, partitionLockTTL)
+        end
+        if res then
+          if atMostOnce[jobQueue] then
+            redis.call('lrem', inProgQueue, 1, res)
+          end
+          acquireLock(lockKey, lockInfoKey, workerPoolID, leaseKey, maxConcurrency, workerID, leaseExpiresAt)
+          takeRateLimitToken(rateLimitKey, tokens, nowMs)
+          return {res, jobQueue, inProgQueue, partition}
+        end
+      end
+    end
+  end
+end
+return nil`, fetchKeysPerJobType, maxPartitionWaits)
 
-  maxConcurrency = tonumber(redis.call('get', concurrencyKey))
+// Used when a job in a partition is finished, to free the partition and move the next job waiting for it to the head of
+// the job queue. Nothing is done if the partition has been taken by another job since.
+//
+// KEYS[1] = the partition's lock
+// KEYS[2] = the partition's waiting list
+// KEYS[3] = the job queue
+// ARGV[1] = the ID of the job that held the partition
+var redisLuaReleasePartition = `
+local owner = redis.call('get', KEYS[1])
+if owner and owner ~= ARGV[1] then
+  return 0
+end
+redis.call('del', KEYS[1])
+local job = redis.call('rpop', KEYS[2])
+if job then
+  redis.call('rpush', KEYS[3], job)
+end
+return 1
+`
 
-  if haveJobs(jobQueue) and not isPaused(pauseKey) and canRun(lockKey, maxConcurrency) then
-    acquireLock(lockKey, lockInfoKey, workerPoolID)
-    res = redis.call('rpoplpush', jobQueue, inProgQueue)
-    return {res, jobQueue, inProgQueue}
-  end
+// Used to release a periodic job's run lock, unless it's been taken by another run since.
+//
+// KEYS[1] = the run lock
+// ARGV[1] = the ID of the job that held it
+var redisLuaReleaseRunLock = `
+if redis.call('get', KEYS[1]) == ARGV[1] then
+  return redis.call('del', KEYS[1])
+end
+return 0
+`
+
+// Used to claim a job's idempotency key before it's run. The key holds the ID of the job running with it, or "done"
+// once one has succeeded. A job that's requeued by the reaper still holds the key, so it can run again.
+//
+// KEYS[1] = the idempotency key
+// ARGV[1] = the job's ID
+// ARGV[2] = milliseconds the key is held for
+var redisLuaClaimIdempotencyKey = `
+local holder = redis.call('get', KEYS[1])
+if holder == 'done' then
+  return 'done'
+end
+if holder and holder ~= ARGV[1] then
+  return 'running'
+end
+redis.call('set', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 'ok'
+`
+
+// Used to release the idempotency key of a job that failed and won't be retried, so that the job can be enqueued again,
+// unless the key has been claimed by another job since, or marked as done.
+//
+// KEYS[1] = the idempotency key
+// ARGV[1] = the ID of the job that claimed it
+var redisLuaReleaseIdempotencyKey = `
+if redis.call('get', KEYS[1]) == ARGV[1] then
+  return redis.call('del', KEYS[1])
 end
-return nil`, fetchKeysPerJobType)
+return 0
+`
 
 // Used by the reaper to re-enqueue jobs that were in progress
 //
@@ -200,11 +524,15 @@ return nil`, requeueKeysPerJob)
 // KEYS[N] = the last job's lock
 // KEYS[N+1] = the last job's lock info haash
 // ARGV[1] = the dead worker pool id
+//
+// Returns the locks that the dead worker pool held.
 var redisLuaReapStaleLocks = `
 local keylen = #KEYS
 local lock, lockInfo, deadLockCount
 local deadPoolID = ARGV[1]
 
+local reset = {}
+
 for i=1,keylen,2 do
   lock = KEYS[i]
   lockInfo = KEYS[i+1]
@@ -213,17 +541,71 @@ for i=1,keylen,2 do
   if deadLockCount then
     redis.call('decrby', lock, deadLockCount)
     redis.call('hdel', lockInfo, deadPoolID)
+    if tonumber(deadLockCount) ~= 0 then
+      table.insert(reset, lock)
+    end
   end
 end
-return nil
+return reset
 `
 
+// Used to fetch a job with BackendStreams, after any on the job queues
+//
+// KEYS[1] = the 1st job type's stream
+// KEYS[2] = the 1st job type's paused key
+// KEYS[3] = the 1st job type's lock
+// KEYS[4] = the 1st job type's lock info hash
+// KEYS[5] = the 2nd job type's stream...
+// ...
+// ARGV[1] = the workerPoolID, which is also the consumer the entry is delivered to
+// ARGV[2] = milliseconds an entry must be pending for before it's claimed from a worker pool that's presumed dead
+//
+// Returns the job, its stream and its entry ID.
+var redisLuaFetchStreamJob = fmt.Sprintf(`
+local function take(stream, lockKey, lockInfoKey, entry)
+  local fields = entry[2]
+  if type(fields) ~= 'table' then
+    -- deleted while pending
+    redis.call('xack', stream, '%[1]s', entry[1])
+    return nil
+  end
+  for i=1,#fields,2 do
+    if fields[i] == 'job' then
+      redis.call('incr', lockKey)
+      redis.call('hincrby', lockInfoKey, ARGV[1], 1)
+      return {fields[i+1], stream, entry[1]}
+    end
+  end
+  return nil
+end
+
+local res
+for i=1,#KEYS,4 do
+  if not redis.call('get', KEYS[i+1]) then
+    local claimed = redis.call('xautoclaim', KEYS[i], '%[1]s', ARGV[1], ARGV[2], '0-0', 'COUNT', 1)
+    if #claimed[2] > 0 then
+      res = take(KEYS[i], KEYS[i+2], KEYS[i+3], claimed[2][1])
+      if res then
+        return res
+      end
+    end
+    local read = redis.call('xreadgroup', 'GROUP', '%[1]s', ARGV[1], 'COUNT', 1, 'STREAMS', KEYS[i], '>')
+    if read then
+      res = take(KEYS[i], KEYS[i+2], KEYS[i+3], read[1][2][1])
+      if res then
+        return res
+      end
+    end
+  end
+end
+return nil`, streamGroup)
+
 // KEYS[1] = zset of jobs (retry or scheduled), eg work:retry
 // KEYS[2] = zset of dead, eg work:dead. If we don't know the jobName of a job, we'll put it in dead.
 // KEYS[3...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
 // ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
-var redisLuaZremLpushCmd = `
+var redisLuaZremLpushCmd = fmt.Sprintf(`
 local res, j, queue
 res = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, 1)
 if #res > 0 then
@@ -234,6 +616,9 @@ if #res > 0 then
     if v == queue then
       j['t'] = tonumber(ARGV[2])
       redis.call('lpush', queue, cjson.encode(j))
+      redis.call('lpush', queue .. ':notify', 1)
+      redis.call('ltrim', queue .. ':notify', 0, %d)
+      redis.call('publish', queue .. ':wake', 1)
       return 'ok'
     end
   end
@@ -243,7 +628,18 @@ if #res > 0 then
   return 'dead' -- put on dead queue
 end
 return nil
-`
+`, maxJobNotifications-1)
+
+// redisLuaWakeQueue defines wake, which wakes the workers waiting for jobs on a queue after a script pushes jobs onto
+// it, as Enqueuer.Enqueue does: those with FetchBlocking through the queue's notify list, and with FetchPubSub
+// through its wake channel.
+var redisLuaWakeQueue = fmt.Sprintf(`
+local function wake(queue)
+  redis.call('lpush', queue .. ':notify', 1)
+  redis.call('ltrim', queue .. ':notify', 0, %d)
+  redis.call('publish', queue .. ':wake', 1)
+end
+`, maxJobNotifications-1)
 
 // KEYS[1] = zset of (dead|scheduled|retry), eg, work:dead
 // ARGV[1] = died at. The z rank of the job.
@@ -275,7 +671,7 @@ return {deletedCount, jobBytes}
 // ARGV[3] = died at. The z rank of the job.
 // ARGV[4] = job ID to requeue
 // Returns: number of jobs requeued (typically 1 or 0)
-var redisLuaRequeueSingleDeadCmd = `
+var redisLuaRequeueSingleDeadCmd = redisLuaWakeQueue + `
 local jobs, i, j, queue, found, requeuedCount
 jobs = redis.call('zrangebyscore', KEYS[1], ARGV[3], ARGV[3])
 local jobCount = #jobs
@@ -293,6 +689,7 @@ for i=1,jobCount do
         j['failed_at'] = nil
         j['err'] = nil
         redis.call('lpush', queue, cjson.encode(j))
+        wake(queue)
         requeuedCount = requeuedCount + 1
         found = true
         break
@@ -308,13 +705,64 @@ end
 return requeuedCount
 `
 
+// KEYS[1] = zset of scheduled jobs, eg work:scheduled
+// KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
+// ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = scheduled for. The z rank of the job.
+// ARGV[4] = job ID to enqueue
+// Returns: number of jobs enqueued (typically 1 or 0). Jobs of unknown names are left scheduled.
+var redisLuaRunScheduledNowCmd = redisLuaWakeQueue + `
+local jobs, i, j, queue, enqueuedCount
+jobs = redis.call('zrangebyscore', KEYS[1], ARGV[3], ARGV[3])
+local jobCount = #jobs
+enqueuedCount = 0
+for i=1,jobCount do
+  j = cjson.decode(jobs[i])
+  if j['id'] == ARGV[4] then
+    queue = ARGV[1] .. j['name']
+    for _,v in pairs(KEYS) do
+      if v == queue then
+        redis.call('zrem', KEYS[1], jobs[i])
+        j['t'] = tonumber(ARGV[2])
+        redis.call('lpush', queue, cjson.encode(j))
+        wake(queue)
+        enqueuedCount = enqueuedCount + 1
+        break
+      end
+    end
+  end
+end
+return enqueuedCount
+`
+
+// KEYS[1] = zset of scheduled jobs, eg work:scheduled
+// KEYS[2] = the job's unique key, if it's unique
+// KEYS[3] = the job's lock TTL in seconds, as written by the worker pool (defaults to a day)
+// ARGV[1] = job
+// ARGV[2] = epoch seconds to run the job at instead
+// ARGV[3] = current epoch seconds
+// ARGV[4] = "1" if the job is unique. Its lock is held for the TTL past the time the job is to be run at.
+// Returns: number of jobs rescheduled (1 or 0)
+var redisLuaRescheduleCmd = `
+if not redis.call('zscore', KEYS[1], ARGV[1]) then
+  return 0
+end
+redis.call('zadd', KEYS[1], ARGV[2], ARGV[1])
+if ARGV[4] == '1' and redis.call('exists', KEYS[2]) == 1 then
+  local ttl = (tonumber(redis.call('get', KEYS[3])) or 86400) + math.max(0, tonumber(ARGV[2]) - tonumber(ARGV[3]))
+  redis.call('expire', KEYS[2], ttl)
+end
+return 1
+`
+
 // KEYS[1] = zset of dead jobs, eg work:dead
 // KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
 // ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
 // ARGV[3] = max number of jobs to requeue
 // Returns: number of jobs requeued
-var redisLuaRequeueAllDeadCmd = `
+var redisLuaRequeueAllDeadCmd = redisLuaWakeQueue + `
 local jobs, i, j, queue, found, requeuedCount
 jobs = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, ARGV[3])
 local jobCount = #jobs
@@ -331,6 +779,7 @@ for i=1,jobCount do
       j['failed_at'] = nil
       j['err'] = nil
       redis.call('lpush', queue, cjson.encode(j))
+      wake(queue)
       requeuedCount = requeuedCount + 1
       found = true
       break
@@ -345,31 +794,182 @@ end
 return requeuedCount
 `
 
-// KEYS[1] = job queue to push onto
+// KEYS[1] = zset of dead jobs, eg work:dead
+// KEYS[2] = the job's queue, eg "work:jobs:send_email"
+// ARGV[1] = job name
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = rank to start scanning the dead jobs at
+// ARGV[4] = max number of dead jobs to scan
+// Returns:
+// - number of jobs requeued
+// - number of dead jobs scanned
+var redisLuaRequeueDeadByNameCmd = redisLuaWakeQueue + `
+local jobs, i, j, requeuedCount
+jobs = redis.call('zrange', KEYS[1], ARGV[3], ARGV[3] + ARGV[4] - 1)
+local jobCount = #jobs
+requeuedCount = 0
+for i=1,jobCount do
+  j = cjson.decode(jobs[i])
+  if j['name'] == ARGV[1] then
+    redis.call('zrem', KEYS[1], jobs[i])
+    j['t'] = tonumber(ARGV[2])
+    j['fails'] = nil
+    j['failed_at'] = nil
+    j['err'] = nil
+    redis.call('lpush', KEYS[2], cjson.encode(j))
+    wake(KEYS[2])
+    requeuedCount = requeuedCount + 1
+  end
+end
+return {requeuedCount, jobCount}
+`
+
+// KEYS[1] = zset of dead jobs, eg work:dead
+// KEYS[2, 3, ...] = the queues of the known jobs, eg "work:jobs:send_email"
+// ARGV[1] = jobs prefix, eg, "work:jobs:"
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = min died at, eg, "-inf" or "1425263409"
+// ARGV[4] = max died at, eg, "+inf" or "(1425263409"
+// ARGV[5] = job name, or "" for any
+// ARGV[6] = substring of the job's error, or "" for any
+// ARGV[7] = rank to start scanning the dead jobs in the died at range at
+// ARGV[8] = max number of dead jobs to scan
+// Returns:
+// - number of jobs requeued
+// - number of dead jobs scanned
+var redisLuaRequeueDeadWhereCmd = redisLuaWakeQueue + `
+local jobs, i, j, queue, requeuedCount
+local known = {}
+for i=2,#KEYS do
+  known[KEYS[i]] = true
+end
+jobs = redis.call('zrangebyscore', KEYS[1], ARGV[3], ARGV[4], 'LIMIT', ARGV[7], ARGV[8])
+local jobCount = #jobs
+requeuedCount = 0
+for i=1,jobCount do
+  j = cjson.decode(jobs[i])
+  queue = ARGV[1] .. j['name']
+  if known[queue] and (ARGV[5] == '' or j['name'] == ARGV[5]) and (ARGV[6] == '' or (type(j['err']) == 'string' and string.find(j['err'], ARGV[6], 1, true))) then
+    redis.call('zrem', KEYS[1], jobs[i])
+    j['t'] = tonumber(ARGV[2])
+    j['fails'] = nil
+    j['failed_at'] = nil
+    j['err'] = nil
+    redis.call('lpush', queue, cjson.encode(j))
+    wake(queue)
+    requeuedCount = requeuedCount + 1
+  end
+end
+return {requeuedCount, jobCount}
+`
+
+// KEYS[1] = zset of dead jobs, eg work:dead
+// ARGV[1] = job name
+// ARGV[2] = rank to start scanning the dead jobs at
+// ARGV[3] = max number of dead jobs to scan
+// Returns:
+// - number of jobs deleted
+// - number of dead jobs scanned
+var redisLuaDeleteDeadByNameCmd = `
+local jobs, i, j, deletedCount
+jobs = redis.call('zrange', KEYS[1], ARGV[2], ARGV[2] + ARGV[3] - 1)
+local jobCount = #jobs
+deletedCount = 0
+for i=1,jobCount do
+  j = cjson.decode(jobs[i])
+  if j['name'] == ARGV[1] then
+    redis.call('zrem', KEYS[1], jobs[i])
+    deletedCount = deletedCount + 1
+  end
+end
+return {deletedCount, jobCount}
+`
+
+// KEYS[1] = job queue, eg, work:jobs:send_email, or its priority zset
+// ARGV[1] = job field to match, eg, "id" or "name"
+// ARGV[2] = value of the field
+// ARGV[3] = index to start scanning the queue at, from its head
+// ARGV[4] = max number of jobs to scan
+// Returns:
+// - number of jobs deleted
+// - number of jobs scanned
+var redisLuaDeleteQueuedCmd = `
+local jobs, i, j, deletedCount
+local keyType = redis.call('type', KEYS[1])['ok']
+if keyType == 'list' then
+  jobs = redis.call('lrange', KEYS[1], ARGV[3], ARGV[3] + ARGV[4] - 1)
+elseif keyType == 'zset' then
+  jobs = redis.call('zrange', KEYS[1], ARGV[3], ARGV[3] + ARGV[4] - 1)
+else
+  return {0, 0}
+end
+local jobCount = #jobs
+deletedCount = 0
+for i=1,jobCount do
+  j = cjson.decode(jobs[i])
+  if j[ARGV[1]] == ARGV[2] then
+    if keyType == 'list' then
+      redis.call('lrem', KEYS[1], 1, jobs[i])
+    else
+      redis.call('zrem', KEYS[1], jobs[i])
+    end
+    deletedCount = deletedCount + 1
+  end
+end
+return {deletedCount, jobCount}
+`
+
+// KEYS[1...] = job queue, eg, work:jobs:send_email, its priority zset and any of its in-progress lists
+// Returns: number of jobs deleted
+var redisLuaFlushQueueCmd = `
+local count = 0
+for i=1,#KEYS do
+  local keyType = redis.call('type', KEYS[i])['ok']
+  if keyType == 'list' then
+    count = count + redis.call('llen', KEYS[i])
+  elseif keyType == 'zset' then
+    count = count + redis.call('zcard', KEYS[i])
+  end
+end
+redis.call('del', unpack(KEYS))
+return count
+`
+
+// KEYS[1] = job queue to push onto, which is one of the job type's shards if it's sharded
 // KEYS[2] = Unique job's key. Test for existence and set if we push.
+// KEYS[3] = Unique job's lock TTL in seconds, as written by the worker pool (defaults to a day)
+// KEYS[4] = job type's notify list
 // ARGV[1] = job
 // ARGV[2] = updated job or just a 1 if arguments don't update
-var redisLuaEnqueueUnique = `
-if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', '86400') then
+// ARGV[3] = job type's wake channel
+var redisLuaEnqueueUnique = fmt.Sprintf(`
+local ttl = tonumber(redis.call('get', KEYS[3])) or 86400
+if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', ttl) then
   redis.call('lpush', KEYS[1], ARGV[1])
+  redis.call('lpush', KEYS[4], 1)
+  redis.call('ltrim', KEYS[4], 0, %d)
+  redis.call('publish', ARGV[3], 1)
   return 'ok'
 else
-  redis.call('set', KEYS[2], ARGV[2], 'EX', '86400')
+  redis.call('set', KEYS[2], ARGV[2], 'EX', ttl)
 end
 return 'dup'
-`
+`, maxJobNotifications-1)
 
 // KEYS[1] = scheduled job queue
 // KEYS[2] = Unique job's key. Test for existence and set if we push.
+// KEYS[3] = Unique job's lock TTL in seconds, as written by the worker pool (defaults to a day)
 // ARGV[1] = job
 // ARGV[2] = updated job or just a 1 if arguments don't update
 // ARGV[3] = epoch seconds for job to be run at
+// ARGV[4] = current epoch seconds. The lock is held for the TTL past the time the job is to be run at.
 var redisLuaEnqueueUniqueIn = `
-if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', '86400') then
+local ttl = (tonumber(redis.call('get', KEYS[3])) or 86400) + math.max(0, tonumber(ARGV[3]) - tonumber(ARGV[4]))
+if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', ttl) then
   redis.call('zadd', KEYS[1], ARGV[3], ARGV[1])
   return 'ok'
 else
-  redis.call('set', KEYS[2], ARGV[2], 'EX', '86400')
+  redis.call('set', KEYS[2], ARGV[2], 'EX', ttl)
 end
 return 'dup'
 `
@@ -7,9 +7,13 @@ import (
 	"github.com/gomodule/redigo/redis"
 )
 
+const requeuePeriod = 1 * time.Second
+
 type requeuer struct {
 	namespace string
-	pool      *redis.Pool
+	pool      Pool
+	logger    Logger
+	period    time.Duration
 
 	redisRequeueScript *redis.Script
 	redisRequeueArgs   []interface{}
@@ -21,7 +25,7 @@ type requeuer struct {
 	doneDrainingChan chan struct{}
 }
 
-func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames []string) *requeuer {
+func newRequeuer(namespace string, pool Pool, requeueKey string, jobNames []string, logger Logger) *requeuer {
 	args := make([]interface{}, 0, len(jobNames)+2+2)
 	args = append(args, requeueKey)              // KEY[1]
 	args = append(args, redisKeyDead(namespace)) // KEY[2]
@@ -34,6 +38,8 @@ func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames
 	return &requeuer{
 		namespace: namespace,
 		pool:      pool,
+		logger:    logger,
+		period:    requeuePeriod,
 
 		redisRequeueScript: redis.NewScript(len(jobNames)+2, redisLuaZremLpushCmd),
 		redisRequeueArgs:   args,
@@ -65,7 +71,7 @@ func (r *requeuer) loop() {
 	// If we have 100 processes all running requeuers,
 	// there's probably too much hitting redis.
 	// So later on we'l have to implement exponential backoff
-	ticker := time.Tick(1000 * time.Millisecond)
+	ticker := time.Tick(r.period)
 
 	for {
 		select {
@@ -93,14 +99,14 @@ func (r *requeuer) process() bool {
 	if err == redis.ErrNil {
 		return false
 	} else if err != nil {
-		logError("requeuer.process", err)
+		logError(r.logger, "requeuer.process", err)
 		return false
 	}
 
 	if res == "" {
 		return false
 	} else if res == "dead" {
-		logError("requeuer.process.dead", fmt.Errorf("no job name"))
+		logError(r.logger, "requeuer.process.dead", fmt.Errorf("no job name"))
 		return true
 	} else if res == "ok" {
 		return true
@@ -0,0 +1,67 @@
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	defaultResultTTL   = 24 * time.Hour
+	resultPollInterval = 100 * time.Millisecond
+)
+
+// JobResult is the outcome of a job that set a result with Job.SetResult or was enqueued with EnqueueAndWait. It's
+// stored once the job succeeds, or once it has failed for the last time.
+type JobResult struct {
+	JobID      string          `json:"job_id"`
+	Result     json.RawMessage `json:"result,omitempty"` // JSON encoded value passed to Job.SetResult, if any
+	Err        string          `json:"err,omitempty"`    // set if the job failed
+	FinishedAt int64           `json:"finished_at"`
+}
+
+// Unmarshal decodes the result set by the job into v.
+func (r *JobResult) Unmarshal(v interface{}) error {
+	if len(r.Result) == 0 {
+		return json.Unmarshal([]byte("null"), v)
+	}
+	return json.Unmarshal(r.Result, v)
+}
+
+func getJobResult(conn redis.Conn, namespace, jobID string) (*JobResult, error) {
+	rawJSON, err := redis.Bytes(conn.Do("GET", redisKeyJobResult(namespace, jobID)))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var res JobResult
+	if err := json.Unmarshal(rawJSON, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// waitForJobResult polls for the result of jobID until it's stored or ctx is done.
+func waitForJobResult(ctx context.Context, pool Pool, namespace, jobID string) (*JobResult, error) {
+	ticker := time.NewTicker(resultPollInterval)
+	defer ticker.Stop()
+
+	for {
+		conn := getConn(ctx, pool)
+		res, err := getJobResult(conn, namespace, jobID)
+		conn.Close()
+		if err != nil || res != nil {
+			return res, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,14 +1,57 @@
 package work
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"runtime/debug"
+	"time"
 )
 
+// ErrJobTimeout is the error a job fails with when it runs for longer than its JobOptions.Timeout.
+var ErrJobTimeout = fmt.Errorf("job timed out")
+
+// ErrNoRetry can be returned by handlers, or wrapped in the error they return, to fail the job without retrying it. The
+// job goes straight to the dead queue unless its job type sets SkipDead.
+// Example: return fmt.Errorf("invalid address %q: %w", addr, work.ErrNoRetry)
+var ErrNoRetry = fmt.Errorf("job should not be retried")
+
+// RetryIn returns an error that fails the job with err and, if it's retried, retries it after d instead of after its
+// job type's backoff, eg, as per a Retry-After header from an API. errors.Is and errors.As see through it to err.
+// Example: return work.RetryIn(time.Duration(retryAfter)*time.Second, err)
+func RetryIn(d time.Duration, err error) error {
+	if err == nil {
+		err = fmt.Errorf("retry in %v", d)
+	}
+	return &retryInError{delay: d, err: err}
+}
+
+// retryInError is the error returned by RetryIn.
+type retryInError struct {
+	delay time.Duration
+	err   error
+}
+
+func (e *retryInError) Error() string { return e.err.Error() }
+func (e *retryInError) Unwrap() error { return e.err }
+
+// panicError is the error a job fails with when its handler or a middleware panics.
+type panicError struct {
+	value interface{}
+	stack []byte
+}
+
+func (e *panicError) Error() string {
+	// value turns out to be interface{}, of actual type "runtime.errorCString"
+	// Luckily, it sprints nicely via fmt.
+	return fmt.Sprintf("%v", e.value)
+}
+
 // returns an error if the job fails, or there's a panic, or we couldn't reflect correctly.
 // if we return an error, it signals we want the job to be retried.
-func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt *jobType) (returnCtx reflect.Value, returnError error) {
+func runJob(ctx context.Context, job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt *jobType) (returnCtx reflect.Value, returnError error) {
 	returnCtx = reflect.New(ctxType)
+	job.ctx = ctx
 	currentMiddleware := 0
 	maxMiddleware := len(middleware)
 
@@ -30,6 +73,9 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 		if jt.IsGeneric {
 			return jt.GenericHandler(job)
 		}
+		if jt.IsGenericContext {
+			return jt.GenericContextHandler(job.Context(), job)
+		}
 		res := jt.DynamicHandler.Call([]reflect.Value{returnCtx, reflect.ValueOf(job)})
 		x := res[0].Interface()
 		if x == nil {
@@ -40,11 +86,7 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 
 	defer func() {
 		if panicErr := recover(); panicErr != nil {
-			// err turns out to be interface{}, of actual type "runtime.errorCString"
-			// Luckily, the err sprints nicely via fmt.
-			errorishError := fmt.Errorf("%v", panicErr)
-			logError("runJob.panic", errorishError)
-			returnError = errorishError
+			returnError = &panicError{value: panicErr, stack: debug.Stack()}
 		}
 	}()
 
@@ -52,3 +94,48 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 
 	return
 }
+
+// errJobAbandoned is returned by runJobWithTimeout if the handler didn't return within the grace period after its
+// timeout, and is still running, on its own copy of the job.
+var errJobAbandoned = fmt.Errorf("%w: handler abandoned", ErrJobTimeout)
+
+// runJobWithTimeout runs the job as per runJob, but cancels the context passed to handlers once timeout has elapsed. The
+// handler then has the grace period to return, after which the job fails with ErrJobTimeout whatever it returned. If it
+// doesn't return by then, it's abandoned and errJobAbandoned is returned; the handler keeps running in the background,
+// so job must be a copy that the worker doesn't touch. A timeout of 0 means no timeout.
+func runJobWithTimeout(ctx context.Context, timeout, grace time.Duration, job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt *jobType) error {
+	if timeout <= 0 {
+		_, err := runJob(ctx, job, ctxType, middleware, jt)
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := runJob(ctx, job, ctxType, middleware, jt)
+		done <- err
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		// ctx is also done if the worker's context was cancelled, eg, because the job was cancelled, in which case the
+		// handler still has until the grace period after its deadline.
+		timer := time.NewTimer(time.Until(deadline) + grace)
+		defer timer.Stop()
+		select {
+		case err = <-done:
+		case <-timer.C:
+			return errJobAbandoned
+		}
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		return ErrJobTimeout
+	}
+	return err
+}
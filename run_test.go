@@ -1,9 +1,12 @@
 package work
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -48,7 +51,7 @@ func TestRunBasicMiddleware(t *testing.T) {
 		Args: map[string]interface{}{"a": "foo"},
 	}
 
-	v, err := runJob(job, tstCtxType, middleware, jt)
+	v, err := runJob(context.Background(), job, tstCtxType, middleware, jt)
 	assert.NoError(t, err)
 	c := v.Interface().(*tstCtx)
 	assert.Equal(t, "mw1mw2mw3h1foo", c.String())
@@ -77,7 +80,7 @@ func TestRunHandlerError(t *testing.T) {
 		Name: "foo",
 	}
 
-	v, err := runJob(job, tstCtxType, middleware, jt)
+	v, err := runJob(context.Background(), job, tstCtxType, middleware, jt)
 	assert.Error(t, err)
 	assert.Equal(t, "h1_err", err.Error())
 
@@ -108,7 +111,7 @@ func TestRunMwError(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(context.Background(), job, tstCtxType, middleware, jt)
 	assert.Error(t, err)
 	assert.Equal(t, "mw1_err", err.Error())
 }
@@ -137,7 +140,7 @@ func TestRunHandlerPanic(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(context.Background(), job, tstCtxType, middleware, jt)
 	assert.Error(t, err)
 	assert.Equal(t, "dayam", err.Error())
 }
@@ -165,7 +168,115 @@ func TestRunMiddlewarePanic(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(context.Background(), job, tstCtxType, middleware, jt)
 	assert.Error(t, err)
 	assert.Equal(t, "dayam", err.Error())
 }
+
+func TestRunContextHandler(t *testing.T) {
+	type ctxKey struct{}
+
+	h1 := func(ctx context.Context, j *Job) error {
+		if ctx.Value(ctxKey{}) != "bar" {
+			return fmt.Errorf("missing context value")
+		}
+		return ctx.Err()
+	}
+
+	jt := &jobType{
+		Name:                  "foo",
+		IsGenericContext:      true,
+		GenericContextHandler: h1,
+	}
+
+	job := &Job{
+		Name: "foo",
+	}
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "bar"))
+	_, err := runJob(ctx, job, tstCtxType, nil, jt)
+	assert.NoError(t, err)
+
+	cancel()
+	_, err = runJob(ctx, job, tstCtxType, nil, jt)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestRunMiddlewareSetContext(t *testing.T) {
+	type ctxKey struct{}
+
+	mw := func(job *Job, next NextMiddlewareFunc) error {
+		job.SetContext(context.WithValue(job.Context(), ctxKey{}, "span"))
+		return next()
+	}
+	var got interface{}
+	jt := &jobType{
+		Name:             "foo",
+		IsGenericContext: true,
+		GenericContextHandler: func(ctx context.Context, j *Job) error {
+			got = ctx.Value(ctxKey{})
+			return ctx.Err()
+		},
+	}
+	middleware := []*middlewareHandler{{IsGeneric: true, GenericMiddlewareHandler: mw}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &Job{Name: "foo"}
+	_, err := runJob(ctx, job, tstCtxType, middleware, jt)
+	assert.NoError(t, err)
+	assert.Equal(t, "span", got)
+
+	// The handler still sees cancellation of the original context.
+	cancel()
+	_, err = runJob(ctx, job, tstCtxType, middleware, jt)
+	assert.Equal(t, context.Canceled, err)
+
+	assert.Panics(t, func() { job.SetContext(nil) })
+}
+
+func TestRunJobTimeout(t *testing.T) {
+	h1 := func(ctx context.Context, j *Job) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	h2 := func(j *Job) error {
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	}
+	h3 := func(ctx context.Context, j *Job) error {
+		return nil
+	}
+	release := make(chan struct{})
+	h4 := func(j *Job) error {
+		<-release
+		return nil
+	}
+
+	job := &Job{
+		Name: "foo",
+	}
+
+	jt := &jobType{Name: "foo", IsGenericContext: true, GenericContextHandler: h1}
+	err := runJobWithTimeout(context.Background(), 10*time.Millisecond, time.Second, job, tstCtxType, nil, jt)
+	assert.Equal(t, ErrJobTimeout, err)
+
+	// Handlers which ignore the context are waited for during the grace period, but still time out.
+	jt = &jobType{Name: "foo", IsGeneric: true, GenericHandler: h2}
+	start := time.Now()
+	err = runJobWithTimeout(context.Background(), 10*time.Millisecond, time.Second, job, tstCtxType, nil, jt)
+	assert.Equal(t, ErrJobTimeout, err)
+	assert.True(t, time.Since(start) >= 100*time.Millisecond)
+
+	// Handlers which overrun the grace period too are abandoned.
+	jt = &jobType{Name: "foo", IsGeneric: true, GenericHandler: h4}
+	start = time.Now()
+	err = runJobWithTimeout(context.Background(), 10*time.Millisecond, 10*time.Millisecond, job.clone(), tstCtxType, nil, jt)
+	assert.Equal(t, errJobAbandoned, err)
+	assert.True(t, errors.Is(err, ErrJobTimeout))
+	assert.True(t, time.Since(start) < time.Second)
+	close(release)
+
+	jt = &jobType{Name: "foo", IsGenericContext: true, GenericContextHandler: h3}
+	err = runJobWithTimeout(context.Background(), 10*time.Millisecond, time.Second, job, tstCtxType, nil, jt)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,188 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const defaultSentinelRoleCheckInterval = time.Second
+
+// SentinelOptions can be passed to NewSentinelPool.
+type SentinelOptions struct {
+	DialOptions         []redis.DialOption // Options used when dialing the master, eg, redis.DialPassword or redis.DialDatabase
+	SentinelDialOptions []redis.DialOption // Options used when dialing the sentinels
+	MaxActive           int                // Max number of connections to the master (default is 0, meaning no limit)
+	MaxIdle             int                // Max number of idle connections to the master
+	IdleTimeout         time.Duration      // Close connections after remaining idle for this long (default is 0, meaning never)
+	RoleCheckInterval   time.Duration      // Connections idle for longer than this are checked to still be talking to the master before reuse (default 1s)
+}
+
+// NewSentinelPool creates a pool of connections to the current master of masterName, as reported by the Redis
+// Sentinels at sentinelAddrs. Connections are checked before reuse and dropped once their server stops being the
+// master, so after a Sentinel driven failover new connections are made to the new master and worker pools,
+// enqueuers and clients using the pool carry on without a restart.
+func NewSentinelPool(masterName string, sentinelAddrs []string, opts SentinelOptions) *redis.Pool {
+	if len(sentinelAddrs) == 0 {
+		panic("NewSentinelPool needs at least one sentinel address")
+	}
+
+	s := &sentinel{
+		masterName:  masterName,
+		addrs:       append([]string(nil), sentinelAddrs...),
+		dialOptions: opts.SentinelDialOptions,
+	}
+
+	roleCheckInterval := opts.RoleCheckInterval
+	if roleCheckInterval == 0 {
+		roleCheckInterval = defaultSentinelRoleCheckInterval
+	}
+
+	return &redis.Pool{
+		MaxActive:   opts.MaxActive,
+		MaxIdle:     opts.MaxIdle,
+		IdleTimeout: opts.IdleTimeout,
+		Wait:        opts.MaxActive > 0,
+		Dial: func() (redis.Conn, error) {
+			addr, err := s.masterAddr()
+			if err != nil {
+				return nil, err
+			}
+
+			c, err := redis.Dial("tcp", addr, opts.DialOptions...)
+			if err != nil {
+				return nil, err
+			}
+
+			if !isMaster(c) {
+				c.Close()
+				return nil, fmt.Errorf("work: %s (%s) is not a master", masterName, addr)
+			}
+
+			return &sentinelConn{Conn: c}, nil
+		},
+		TestOnBorrow: func(c redis.Conn, t time.Time) error {
+			if time.Since(t) < roleCheckInterval {
+				return nil
+			}
+			if !isMaster(c) {
+				return fmt.Errorf("work: %s is no longer the master", masterName)
+			}
+			return nil
+		},
+	}
+}
+
+// sentinel looks up the master's address from a list of sentinels.
+type sentinel struct {
+	masterName  string
+	dialOptions []redis.DialOption
+
+	mtx   sync.Mutex
+	addrs []string
+}
+
+func (s *sentinel) masterAddr() (string, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var lastErr error
+	for i, addr := range s.addrs {
+		masterAddr, err := s.queryMasterAddr(addr)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		// Try the sentinel that answered first next time.
+		s.addrs[0], s.addrs[i] = s.addrs[i], s.addrs[0]
+		return masterAddr, nil
+	}
+
+	return "", fmt.Errorf("work: no sentinel could find master %s: %v", s.masterName, lastErr)
+}
+
+func (s *sentinel) queryMasterAddr(sentinelAddr string) (string, error) {
+	c, err := redis.Dial("tcp", sentinelAddr, s.dialOptions...)
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+
+	res, err := redis.Strings(c.Do("SENTINEL", "get-master-addr-by-name", s.masterName))
+	if err != nil {
+		return "", err
+	}
+	if len(res) != 2 {
+		return "", fmt.Errorf("need 2 elements back")
+	}
+
+	return net.JoinHostPort(res[0], res[1]), nil
+}
+
+func isMaster(c redis.Conn) bool {
+	role, err := redis.Values(c.Do("ROLE"))
+	if err != nil || len(role) == 0 {
+		return false
+	}
+	r, err := redis.String(role[0], nil)
+	return err == nil && r == "master"
+}
+
+// sentinelConn marks itself as broken once its server replies with an error that means it's no longer the master,
+// so that the pool discards it rather than handing it out again.
+type sentinelConn struct {
+	redis.Conn
+	err error
+}
+
+func (c *sentinelConn) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+	return c.Conn.Err()
+}
+
+func (c *sentinelConn) Do(commandName string, args ...interface{}) (interface{}, error) {
+	reply, err := c.Conn.Do(commandName, args...)
+	c.checkError(err)
+	return reply, err
+}
+
+func (c *sentinelConn) Receive() (interface{}, error) {
+	reply, err := c.Conn.Receive()
+	c.checkError(err)
+	return reply, err
+}
+
+func (c *sentinelConn) DoContext(ctx context.Context, commandName string, args ...interface{}) (interface{}, error) {
+	reply, err := redis.DoContext(c.Conn, ctx, commandName, args...)
+	c.checkError(err)
+	return reply, err
+}
+
+func (c *sentinelConn) ReceiveContext(ctx context.Context) (interface{}, error) {
+	reply, err := redis.ReceiveContext(c.Conn, ctx)
+	c.checkError(err)
+	return reply, err
+}
+
+func (c *sentinelConn) checkError(err error) {
+	if isFailoverError(err) {
+		c.err = err
+	}
+}
+
+// isFailoverError reports whether err was replied by a server that has been demoted or can't reach its master.
+func isFailoverError(err error) bool {
+	e, ok := err.(redis.Error)
+	if !ok {
+		return false
+	}
+	return strings.HasPrefix(string(e), "READONLY ") || strings.HasPrefix(string(e), "MASTERDOWN ")
+}
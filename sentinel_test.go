@@ -0,0 +1,36 @@
+package work
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSentinelConnFailoverErrors(t *testing.T) {
+	var cases = []struct {
+		err      error
+		failover bool
+	}{
+		{nil, false},
+		{fmt.Errorf("READONLY You can't write against a read only replica."), false},
+		{redis.Error("ERR unknown command"), false},
+		{redis.Error("READONLY You can't write against a read only replica."), true},
+		{redis.Error("MASTERDOWN Link with MASTER is down and replica-serve-stale-data is set to 'no'."), true},
+	}
+
+	for i, testCase := range cases {
+		c := &sentinelConn{}
+		c.checkError(testCase.err)
+		if testCase.failover != (c.err != nil) {
+			t.Errorf("idx %d: failover should be %v", i, testCase.failover)
+		}
+	}
+}
+
+func TestSentinelPoolNoSentinels(t *testing.T) {
+	assert.Panics(t, func() {
+		NewSentinelPool("mymaster", nil, SentinelOptions{})
+	})
+}
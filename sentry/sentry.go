@@ -0,0 +1,80 @@
+// Package sentry reports the panics and errors of jobs to Sentry, or any error tracker like it, without pulling in its
+// SDK. The middleware hands each failure to a capture function, which sends it on. The
+// github.com/teamwork/work/v2/sentry/sentrygo module provides one that uses Sentry's Go SDK, along with a middleware
+// that uses it:
+//
+//	pool.Middleware(sentry.Middleware(sentrygo.Capture(nil))) // or sentrygo.Middleware(nil)
+package sentry
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strconv"
+
+	work "github.com/teamwork/work/v2"
+)
+
+// Event describes a job that panicked or returned an error.
+type Event struct {
+	Err     error                  // The error the job returned or, if it panicked, one describing the panic
+	Panic   bool                   // Whether the job panicked
+	Stack   []byte                 // Where the job panicked, if it did
+	JobName string                 // The job's name
+	JobID   string                 // The job's ID
+	Args    map[string]interface{} // The job's arguments, redacted as per work.RedactArgs
+	Attempt int64                  // Which run of the job this was, starting at 1
+}
+
+// Tags returns the tags to index the event by, ie, the job's name and attempt.
+func (e *Event) Tags() map[string]string {
+	return map[string]string{
+		"job_name": e.JobName,
+		"attempt":  strconv.FormatInt(e.Attempt, 10),
+	}
+}
+
+// Context returns the details of the job, to attach to the event.
+func (e *Event) Context() map[string]interface{} {
+	c := map[string]interface{}{
+		"name":    e.JobName,
+		"id":      e.JobID,
+		"args":    e.Args,
+		"attempt": e.Attempt,
+	}
+	if e.Panic {
+		c["stack"] = string(e.Stack)
+	}
+	return c
+}
+
+// Middleware returns a worker pool middleware that calls capture with an Event for each job that panics or returns an
+// error. Panics are passed on, so the job fails as usual. Add it before any other middleware so that it sees their
+// panics and errors too.
+func Middleware(capture func(e *Event)) func(job *work.Job, next work.NextMiddlewareFunc) error {
+	return func(job *work.Job, next work.NextMiddlewareFunc) (err error) {
+		defer func() {
+			if v := recover(); v != nil {
+				capture(newEvent(job, fmt.Errorf("panic: %v", v), debug.Stack()))
+				panic(v)
+			}
+		}()
+
+		err = next()
+		if err != nil {
+			capture(newEvent(job, err, nil))
+		}
+		return err
+	}
+}
+
+func newEvent(job *work.Job, err error, stack []byte) *Event {
+	return &Event{
+		Err:     err,
+		Panic:   stack != nil,
+		Stack:   stack,
+		JobName: job.Name,
+		JobID:   job.ID,
+		Args:    job.RedactedArgs(),
+		Attempt: job.Attempt(),
+	}
+}
@@ -0,0 +1,46 @@
+package sentry
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	work "github.com/teamwork/work/v2"
+)
+
+func TestMiddleware(t *testing.T) {
+	work.RedactArgs("charge_card", "card_number")
+
+	var events []*Event
+	mw := Middleware(func(e *Event) { events = append(events, e) })
+	job := &work.Job{Name: "charge_card", ID: "1", Fails: 2, Args: map[string]interface{}{"card_number": "4242", "amount": 10}}
+
+	assert.NoError(t, mw(job, func() error { return nil }))
+	assert.Empty(t, events)
+
+	err := mw(job, func() error { return fmt.Errorf("declined") })
+	assert.EqualError(t, err, "declined")
+	if assert.Len(t, events, 1) {
+		e := events[0]
+		assert.EqualError(t, e.Err, "declined")
+		assert.False(t, e.Panic)
+		assert.Equal(t, "charge_card", e.JobName)
+		assert.Equal(t, "1", e.JobID)
+		assert.EqualValues(t, 3, e.Attempt)
+		assert.Equal(t, map[string]interface{}{"card_number": work.RedactedArg, "amount": 10}, e.Args)
+		assert.Equal(t, map[string]string{"job_name": "charge_card", "attempt": "3"}, e.Tags())
+		assert.NotContains(t, e.Context(), "stack")
+	}
+	assert.Equal(t, "4242", job.Args["card_number"])
+
+	events = nil
+	assert.PanicsWithValue(t, "boom", func() {
+		mw(job, func() error { panic("boom") })
+	})
+	if assert.Len(t, events, 1) {
+		assert.EqualError(t, events[0].Err, "panic: boom")
+		assert.True(t, events[0].Panic)
+		assert.Contains(t, string(events[0].Stack), "sentry_test.go")
+		assert.Contains(t, events[0].Context(), "stack")
+	}
+}
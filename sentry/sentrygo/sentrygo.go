@@ -0,0 +1,43 @@
+// Package sentrygo reports the panics and errors of jobs to Sentry with its Go SDK. It's a module of its own so that
+// the work package doesn't depend on the SDK:
+//
+//	pool.Middleware(sentrygo.Middleware(nil))
+//
+// Each event is tagged with the job's name and attempt, and has the job's ID, arguments (redacted as per
+// work.RedactArgs) and, for panics, stack in its "job" context.
+package sentrygo
+
+import (
+	sentrysdk "github.com/getsentry/sentry-go"
+	work "github.com/teamwork/work/v2"
+	"github.com/teamwork/work/v2/sentry"
+)
+
+// Middleware returns a worker pool middleware that reports each job that panics or returns an error to Sentry, with
+// a clone of hub, or of the current hub if hub is nil. Panics are passed on, so the job fails as usual. Add it before
+// any other middleware so that it sees their panics and errors too.
+func Middleware(hub *sentrysdk.Hub) func(job *work.Job, next work.NextMiddlewareFunc) error {
+	return sentry.Middleware(Capture(hub))
+}
+
+// Capture returns a capture function for sentry.Middleware that sends each event to Sentry with a clone of hub, or of
+// the current hub if hub is nil. Panics are reported as fatal, and errors as errors.
+func Capture(hub *sentrysdk.Hub) func(e *sentry.Event) {
+	return func(e *sentry.Event) {
+		h := hub
+		if h == nil {
+			h = sentrysdk.CurrentHub()
+		}
+		h = h.Clone()
+		h.ConfigureScope(func(scope *sentrysdk.Scope) {
+			scope.SetTags(e.Tags())
+			scope.SetContext("job", e.Context())
+			if e.Panic {
+				scope.SetLevel(sentrysdk.LevelFatal)
+			} else {
+				scope.SetLevel(sentrysdk.LevelError)
+			}
+		})
+		h.CaptureException(e.Err)
+	}
+}
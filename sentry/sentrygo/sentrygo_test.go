@@ -0,0 +1,63 @@
+package sentrygo
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	sentrysdk "github.com/getsentry/sentry-go"
+	"github.com/stretchr/testify/assert"
+	work "github.com/teamwork/work/v2"
+)
+
+// recordingTransport keeps the events sent to it, instead of sending them to Sentry.
+type recordingTransport struct {
+	mtx    sync.Mutex
+	events []*sentrysdk.Event
+}
+
+func (t *recordingTransport) Configure(options sentrysdk.ClientOptions) {}
+func (t *recordingTransport) Flush(timeout time.Duration) bool          { return true }
+
+func (t *recordingTransport) SendEvent(event *sentrysdk.Event) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.events = append(t.events, event)
+}
+
+func TestMiddleware(t *testing.T) {
+	work.RedactArgs("charge_card", "card_number")
+
+	transport := &recordingTransport{}
+	client, err := sentrysdk.NewClient(sentrysdk.ClientOptions{Dsn: "https://key@sentry.example.com/1", Transport: transport})
+	assert.NoError(t, err)
+	mw := Middleware(sentrysdk.NewHub(client, sentrysdk.NewScope()))
+	job := &work.Job{Name: "charge_card", ID: "1", Fails: 2, Args: map[string]interface{}{"card_number": "4242", "amount": 10}}
+
+	assert.NoError(t, mw(job, func() error { return nil }))
+	assert.Empty(t, transport.events)
+
+	assert.EqualError(t, mw(job, func() error { return fmt.Errorf("declined") }), "declined")
+	if assert.Len(t, transport.events, 1) {
+		e := transport.events[0]
+		assert.Equal(t, sentrysdk.LevelError, e.Level)
+		if assert.Len(t, e.Exception, 1) {
+			assert.Equal(t, "declined", e.Exception[0].Value)
+		}
+		assert.Equal(t, "charge_card", e.Tags["job_name"])
+		assert.Equal(t, "3", e.Tags["attempt"])
+		assert.Equal(t, "1", e.Contexts["job"]["id"])
+		assert.Equal(t, map[string]interface{}{"card_number": work.RedactedArg, "amount": 10}, e.Contexts["job"]["args"])
+	}
+
+	assert.PanicsWithValue(t, "boom", func() {
+		mw(job, func() error { panic("boom") })
+	})
+	if assert.Len(t, transport.events, 2) {
+		e := transport.events[1]
+		assert.Equal(t, sentrysdk.LevelFatal, e.Level)
+		assert.Equal(t, "panic: boom", e.Exception[0].Value)
+		assert.Contains(t, e.Contexts["job"]["stack"], "sentrygo_test.go")
+	}
+}
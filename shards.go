@@ -0,0 +1,77 @@
+package work
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// shardsCheckPeriod is how often, in seconds, an enqueuer reads how a job type's queue is sharded.
+const shardsCheckPeriod = 60
+
+// jobShards is how a job type's queue is sharded, as written by the worker pools, see JobOptions.Shards.
+type jobShards struct {
+	count     int    // 0 or 1 means the queue isn't sharded
+	key       string // the arg jobs are sharded by, or "" to shard them round-robin
+	checkedAt int64
+	next      uint32 // the next shard to push onto round-robin
+}
+
+// shardIndex returns the shard of count that jobs with v as their shard key go to.
+func shardIndex(v interface{}, count int) int {
+	h := fnv.New32a()
+	fmt.Fprint(h, v)
+	return int(h.Sum32() % uint32(count))
+}
+
+// queueKey returns the queue that a job with the specified name and arguments is pushed onto, which is one of its job
+// type's shards if it's sharded.
+func (e *Enqueuer) queueKey(conn redis.Conn, jobName string, args map[string]interface{}) string {
+	shards := e.jobShards(conn, jobName)
+	if shards.count <= 1 {
+		return e.queuePrefix + jobName
+	}
+
+	if v, ok := args[shards.key]; ok && shards.key != "" {
+		return redisKeyJobsShard(e.Namespace, jobName, shardIndex(v, shards.count))
+	}
+	next := atomic.AddUint32(&shards.next, 1)
+	return redisKeyJobsShard(e.Namespace, jobName, int(next%uint32(shards.count)))
+}
+
+// jobShards returns how jobName's queue is sharded, reading it from Redis if it hasn't been for shardsCheckPeriod. If
+// it can't be read, the queue is assumed to be sharded as it was last time.
+func (e *Enqueuer) jobShards(conn redis.Conn, jobName string) *jobShards {
+	now := time.Now().Unix()
+
+	e.mtx.RLock()
+	shards, ok := e.shards[jobName]
+	e.mtx.RUnlock()
+
+	if ok && now < shards.checkedAt+shardsCheckPeriod {
+		return shards
+	}
+
+	var count int
+	var key string
+	values, err := redis.Values(conn.Do("HMGET", redisKeyJobsShards(e.Namespace, jobName), "count", "key"))
+	if err == nil {
+		_, err = redis.Scan(values, &count, &key)
+	}
+	if err != nil {
+		if ok {
+			return shards
+		}
+		return &jobShards{}
+	}
+
+	shards = &jobShards{count: count, key: key, checkedAt: now}
+	e.mtx.Lock()
+	e.shards[jobName] = shards
+	e.mtx.Unlock()
+
+	return shards
+}
@@ -0,0 +1,162 @@
+package work
+
+import (
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pushedTo returns the queues that jobs were pushed onto with LPUSH, in order.
+func pushedTo(doer *fakeDoer) []string {
+	var queues []string
+	for _, cmd := range doer.cmds {
+		if cmd[0] == "LPUSH" && cmd[1] != redisKeyJobsNotify("work", "wat") {
+			queues = append(queues, cmd[1].(string))
+		}
+	}
+	return queues
+}
+
+func TestEnqueueSharded(t *testing.T) {
+	doer := &fakeDoer{replies: map[string]interface{}{"HMGET": []interface{}{"3", ""}}}
+	enqueuer := NewEnqueuer("work", NewDoerPool(doer))
+
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"a": i})
+		assert.NoError(t, err)
+	}
+	assert.ElementsMatch(t, []string{
+		redisKeyJobs("work", "wat"),
+		redisKeyJobsShard("work", "wat", 1),
+		redisKeyJobsShard("work", "wat", 2),
+	}, pushedTo(doer))
+}
+
+func TestEnqueueShardedByKey(t *testing.T) {
+	doer := &fakeDoer{replies: map[string]interface{}{"HMGET": []interface{}{"3", "account_id"}}}
+	enqueuer := NewEnqueuer("work", NewDoerPool(doer))
+
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"account_id": 4, "a": i})
+		assert.NoError(t, err)
+	}
+	_, err := enqueuer.EnqueueBatch("wat", []Q{{"account_id": 4}, {"account_id": 4}})
+	assert.NoError(t, err)
+
+	queues := pushedTo(doer)
+	assert.Len(t, queues, 4)
+	for _, queue := range queues {
+		assert.Equal(t, redisKeyJobsShard("work", "wat", shardIndex(4, 3)), queue)
+	}
+}
+
+func TestEnqueueUnsharded(t *testing.T) {
+	doer := &fakeDoer{replies: map[string]interface{}{"HMGET": []interface{}{nil, nil}}}
+	enqueuer := NewEnqueuer("work", NewDoerPool(doer))
+
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{redisKeyJobs("work", "wat"), redisKeyJobs("work", "wat")}, pushedTo(doer))
+
+	// How the queue's sharded is only read once a minute.
+	var hmgets int
+	for _, cmd := range doer.cmds {
+		if cmd[0] == "HMGET" {
+			hmgets++
+		}
+	}
+	assert.Equal(t, 1, hmgets)
+}
+
+func TestWorkerFetchesFromShards(t *testing.T) {
+	jobTypes := map[string]*jobType{
+		"wat":  {Name: "wat", JobOptions: JobOptions{Priority: 4, Shards: 3}},
+		"taw":  {Name: "taw", JobOptions: JobOptions{Priority: 1}},
+		"slow": {Name: "slow", JobOptions: JobOptions{Priority: 1, Shards: 2}},
+	}
+	w := newWorker("work", "1", NewDoerPool(&fakeDoer{}), reflect.TypeOf(TestContext{}), nil, jobTypes, nil, nil)
+
+	queues := map[string]uint{}
+	for _, s := range w.sampler.samples {
+		queues[s.redisJobs] = s.priority
+	}
+	assert.Equal(t, map[string]uint{
+		redisKeyJobs("work", "wat"):          1,
+		redisKeyJobsShard("work", "wat", 1):  1,
+		redisKeyJobsShard("work", "wat", 2):  1,
+		redisKeyJobs("work", "taw"):          1,
+		redisKeyJobs("work", "slow"):         1,
+		redisKeyJobsShard("work", "slow", 1): 1,
+	}, queues)
+}
+
+func TestWorkerPoolShards(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var processed int64
+	wp := NewWorkerPool(TestContext{}, 3, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{Shards: 4, ShardKey: "account_id"}, func(job *Job) error {
+		atomic.AddInt64(&processed, 1)
+		return nil
+	})
+	wp.Start()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 20; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"account_id": i})
+		assert.NoError(t, err)
+	}
+
+	wp.Drain()
+	wp.Stop()
+	time.Sleep(10 * time.Millisecond)
+
+	for shard := 0; shard < 4; shard++ {
+		assert.EqualValues(t, 0, listSize(pool, redisKeyJobsShard(ns, "wat", shard)))
+	}
+	assert.EqualValues(t, 20, atomic.LoadInt64(&processed))
+}
+
+func TestClientQueuesSharded(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("SADD", redisKeyKnownJobs(ns), "wat")
+	assert.NoError(t, err)
+	_, err = conn.Do("HMSET", redisKeyJobsShards(ns, "wat"), "count", 3, "key", "")
+	assert.NoError(t, err)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 6; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobsShard(ns, "wat", 2)))
+
+	queues, err := NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	if assert.Len(t, queues, 1) {
+		assert.EqualValues(t, 6, queues[0].Count)
+	}
+}
+
+func TestShardsValidation(t *testing.T) {
+	wp := NewWorkerPool(TestContext{}, 1, "work", newTestPool(":0"))
+	handler := func(job *Job) error { return nil }
+	assert.PanicsWithValue(t, "work: JobOptions.StrictFIFO can't be used with Shards", func() {
+		wp.JobWithOptions("wat", JobOptions{StrictFIFO: true, Shards: 2}, handler)
+	})
+	assert.NotPanics(t, func() {
+		wp.JobWithOptions("wat", JobOptions{StrictFIFO: true, Shards: 1}, handler)
+	})
+}
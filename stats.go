@@ -0,0 +1,183 @@
+package work
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// StatsRetention is how long the per-minute counts of processed and failed jobs are kept in Redis.
+const StatsRetention = 7 * 24 * time.Hour
+
+// Throughput counts the jobs processed in a minute.
+type Throughput struct {
+	Minute    int64 `json:"minute"` // Start of the minute, in epoch seconds
+	Processed int64 `json:"processed"`
+	Failed    int64 `json:"failed"`
+}
+
+// Stats totals up a namespace's jobs and workers, as returned by Client.Stats.
+type Stats struct {
+	Processed   int64 `json:"processed"` // Jobs run, including those that failed, since the namespace was first used
+	Failed      int64 `json:"failed"`    // Jobs that failed, whether or not they were retried
+	Retrying    int64 `json:"retrying"`  // Jobs waiting to be retried
+	Dead        int64 `json:"dead"`
+	Scheduled   int64 `json:"scheduled"`
+	BusyWorkers int64 `json:"busy_workers"`
+	WorkerPools int64 `json:"worker_pools"` // Worker pools that have registered, including any that died and haven't been reaped
+}
+
+// JobTypeStats counts the jobs of a type processed since the namespace was first used, as returned by
+// Client.JobTypeStats.
+type JobTypeStats struct {
+	JobName         string `json:"job_name"`
+	Processed       int64  `json:"processed"` // Including those that failed
+	Failed          int64  `json:"failed"`
+	TotalDurationMs int64  `json:"total_duration_ms"` // How long all the processed jobs took to run, in milliseconds
+}
+
+// MeanDuration returns how long the jobs took to run on average, or 0 if none have been processed.
+func (s *JobTypeStats) MeanDuration() time.Duration {
+	if s.Processed == 0 {
+		return 0
+	}
+	return time.Duration(s.TotalDurationMs/s.Processed) * time.Millisecond
+}
+
+func terminateAndRecordStats(w *worker, jobName string, duration time.Duration, runErr error, fate terminateOp) terminateOp {
+	key := redisKeyStats(w.namespace, nowEpochSeconds()/60*60)
+	totalsKey := redisKeyStatsTotals(w.namespace)
+	jobKey := redisKeyJobStats(w.namespace, jobName)
+	return func(conn redis.Conn) {
+		fate(conn)
+		conn.Send("HINCRBY", key, "processed", 1)
+		conn.Send("HINCRBY", totalsKey, "processed", 1)
+		conn.Send("HINCRBY", jobKey, "processed", 1)
+		conn.Send("HINCRBY", jobKey, "duration_ms", duration.Milliseconds())
+		if runErr != nil {
+			conn.Send("HINCRBY", key, "failed", 1)
+			conn.Send("HINCRBY", totalsKey, "failed", 1)
+			conn.Send("HINCRBY", jobKey, "failed", 1)
+		}
+		conn.Send("EXPIRE", key, int64(StatsRetention/time.Second))
+	}
+}
+
+// JobTypeStats returns how many jobs of each known type have been processed and failed, and how long they took, sorted
+// by job name, eg, to spot which job type is degrading. Compare two calls a while apart for recent rates.
+func (c *Client) JobTypeStats() ([]*JobTypeStats, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	jobNames, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownJobs(c.namespace)))
+	if err != nil {
+		logError(c.logger, "client.job_type_stats.smembers", err)
+		return nil, err
+	}
+	sort.Strings(jobNames)
+
+	for _, jobName := range jobNames {
+		conn.Send("HMGET", redisKeyJobStats(c.namespace, jobName), "processed", "failed", "duration_ms")
+	}
+	if err := conn.Flush(); err != nil {
+		logError(c.logger, "client.job_type_stats.flush", err)
+		return nil, err
+	}
+
+	stats := make([]*JobTypeStats, 0, len(jobNames))
+	for _, jobName := range jobNames {
+		counts, err := redis.Int64s(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.job_type_stats.receive", err)
+			return nil, err
+		}
+		stats = append(stats, &JobTypeStats{JobName: jobName, Processed: counts[0], Failed: counts[1], TotalDurationMs: counts[2]})
+	}
+
+	return stats, nil
+}
+
+// Stats returns the namespace's totals in one call, eg, for a dashboard.
+func (c *Client) Stats() (*Stats, error) {
+	observations, err := c.WorkerObservations()
+	if err != nil {
+		logError(c.logger, "client.stats.worker_observations", err)
+		return nil, err
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	conn.Send("HMGET", redisKeyStatsTotals(c.namespace), "processed", "failed")
+	conn.Send("ZCARD", redisKeyRetry(c.namespace))
+	conn.Send("ZCARD", redisKeyDead(c.namespace))
+	conn.Send("ZCARD", redisKeyScheduled(c.namespace))
+	conn.Send("SCARD", redisKeyWorkerPools(c.namespace))
+	if err := conn.Flush(); err != nil {
+		logError(c.logger, "client.stats.flush", err)
+		return nil, err
+	}
+
+	var stats Stats
+	totals, err := redis.Int64s(conn.Receive())
+	if err != nil {
+		logError(c.logger, "client.stats.receive", err)
+		return nil, err
+	}
+	stats.Processed, stats.Failed = totals[0], totals[1]
+	for _, count := range []*int64{&stats.Retrying, &stats.Dead, &stats.Scheduled, &stats.WorkerPools} {
+		if *count, err = redis.Int64(conn.Receive()); err != nil {
+			logError(c.logger, "client.stats.receive", err)
+			return nil, err
+		}
+	}
+
+	for _, ob := range observations {
+		if ob.IsBusy {
+			stats.BusyWorkers++
+		}
+	}
+
+	return &stats, nil
+}
+
+// ThroughputHistory returns the number of jobs processed and failed in each minute of the last d, oldest first, up to
+// StatsRetention. The current minute is included, so it's likely to be incomplete.
+func (c *Client) ThroughputHistory(d time.Duration) ([]*Throughput, error) {
+	if d > StatsRetention {
+		d = StatsRetention
+	}
+	now := nowEpochSeconds() / 60 * 60
+	minutes := int64(d / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	history := make([]*Throughput, 0, minutes)
+	for minute := now - (minutes-1)*60; minute <= now; minute += 60 {
+		history = append(history, &Throughput{Minute: minute})
+		if err := conn.Send("HMGET", redisKeyStats(c.namespace, minute), "processed", "failed"); err != nil {
+			logError(c.logger, "client.throughput_history.send", err)
+			return nil, err
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		logError(c.logger, "client.throughput_history.flush", err)
+		return nil, err
+	}
+
+	for _, t := range history {
+		counts, err := redis.Int64s(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.throughput_history.receive", err)
+			return nil, err
+		}
+		t.Processed, t.Failed = counts[0], counts[1]
+	}
+
+	return history, nil
+}
@@ -0,0 +1,124 @@
+package work
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThroughputHistory(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1467760821)
+	defer resetNowEpochSecondsMock()
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("ok", JobOptions{MaxFails: 1}, func(job *Job) error {
+		return nil
+	})
+	wp.JobWithOptions("broken", JobOptions{MaxFails: 1, SkipDead: true}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, name := range []string{"ok", "ok", "broken"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	setNowEpochSecondsMock(1467760821 + 120)
+	_, err := enqueuer.Enqueue("ok", nil)
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	client := NewClient(ns, pool)
+	history, err := client.ThroughputHistory(5 * time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Throughput{
+		{Minute: 1467760680},
+		{Minute: 1467760740},
+		{Minute: 1467760800, Processed: 3, Failed: 1},
+		{Minute: 1467760860},
+		{Minute: 1467760920, Processed: 1},
+	}, history)
+
+	history, err = client.ThroughputHistory(30 * 24 * time.Hour)
+	assert.NoError(t, err)
+	assert.Len(t, history, int(StatsRetention/time.Minute))
+}
+
+func TestClientStats(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("ok", JobOptions{MaxFails: 1}, func(job *Job) error {
+		return nil
+	})
+	wp.JobWithOptions("broken", JobOptions{MaxFails: 1}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, name := range []string{"ok", "ok", "broken"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err)
+	}
+	_, err := enqueuer.EnqueueIn("ok", 3600, nil)
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	stats, err := NewClient(ns, pool).Stats()
+	assert.NoError(t, err)
+	assert.Equal(t, &Stats{Processed: 3, Failed: 1, Dead: 1, Scheduled: 1}, stats)
+}
+
+func TestClientJobTypeStats(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("ok", JobOptions{MaxFails: 1}, func(job *Job) error {
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+	wp.JobWithOptions("broken", JobOptions{MaxFails: 1, SkipDead: true}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+	wp.Job("idle", func(job *Job) error { return nil })
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, name := range []string{"ok", "ok", "broken"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	stats, err := NewClient(ns, pool).JobTypeStats()
+	assert.NoError(t, err)
+	if assert.Len(t, stats, 3) {
+		assert.Equal(t, "broken", stats[0].JobName)
+		assert.EqualValues(t, 1, stats[0].Processed)
+		assert.EqualValues(t, 1, stats[0].Failed)
+		assert.Equal(t, &JobTypeStats{JobName: "idle"}, stats[1])
+		assert.Equal(t, "ok", stats[2].JobName)
+		assert.EqualValues(t, 2, stats[2].Processed)
+		assert.EqualValues(t, 0, stats[2].Failed)
+		assert.True(t, stats[2].MeanDuration() >= 10*time.Millisecond)
+	}
+}
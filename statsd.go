@@ -0,0 +1,80 @@
+package work
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetricsSink receives metrics about the jobs run by a worker pool. Tags are "key:value" strings, eg, "job:send_email".
+// See WorkerPoolOptions.MetricsSink.
+//
+// The worker pool reports, for each job it runs:
+//   - jobs.processed: a count of 1, tagged with the job name.
+//   - jobs.duration: how long the job took, tagged with the job name and status (ok or failed).
+//   - jobs.failed, jobs.retried and jobs.dead: a count of 1 when the job fails, is scheduled to be retried or is sent
+//     to the dead queue, tagged with the job name.
+//   - jobs.dead_lettered: a count of 1, tagged with the job name, when the job is enqueued on its
+//     JobOptions.DeadLetterQueue instead of being sent to the dead queue.
+type MetricsSink interface {
+	Count(name string, value int64, tags []string)
+	Timing(name string, d time.Duration, tags []string)
+}
+
+// StatsDSink is a MetricsSink that sends metrics over UDP to a StatsD server, with tags in the DogStatsD format used
+// by the Datadog agent.
+type StatsDSink struct {
+	prefix string
+	conn   net.Conn
+}
+
+// NewStatsDSink returns a StatsDSink that sends metrics to the StatsD server at addr, eg, "127.0.0.1:8125", with their
+// names prefixed by prefix, eg, "myapp.work.".
+func NewStatsDSink(addr, prefix string) (*StatsDSink, error) {
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return &StatsDSink{prefix: prefix, conn: conn}, nil
+}
+
+// Count sends a counter.
+func (s *StatsDSink) Count(name string, value int64, tags []string) {
+	s.send(name, strconv.FormatInt(value, 10), "c", tags)
+}
+
+// Timing sends a timer, in milliseconds.
+func (s *StatsDSink) Timing(name string, d time.Duration, tags []string) {
+	s.send(name, strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', -1, 64), "ms", tags)
+}
+
+// Close closes the connection to the StatsD server.
+func (s *StatsDSink) Close() error {
+	return s.conn.Close()
+}
+
+func (s *StatsDSink) send(name, value, typ string, tags []string) {
+	var b strings.Builder
+	b.WriteString(statsdSanitizer.Replace(s.prefix + name))
+	b.WriteByte(':')
+	b.WriteString(value)
+	b.WriteByte('|')
+	b.WriteString(typ)
+	for i, tag := range tags {
+		if i == 0 {
+			b.WriteString("|#")
+		} else {
+			b.WriteByte(',')
+		}
+		b.WriteString(statsdTagSanitizer.Replace(tag))
+	}
+
+	// Metrics are best effort, so a StatsD server that's down mustn't hold up or fail jobs.
+	s.conn.Write([]byte(b.String()))
+}
+
+var (
+	statsdSanitizer    = strings.NewReplacer(":", "_", "|", "_", "@", "_", "#", "_", ",", "_", "\n", "_")
+	statsdTagSanitizer = strings.NewReplacer("|", "_", "@", "_", "#", "_", ",", "_", "\n", "_")
+)
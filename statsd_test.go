@@ -0,0 +1,43 @@
+package work
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsDSink(t *testing.T) {
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer server.Close()
+
+	sink, err := NewStatsDSink(server.LocalAddr().String(), "myapp.work.")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer sink.Close()
+
+	read := func() string {
+		buf := make([]byte, 1024)
+		server.SetReadDeadline(time.Now().Add(time.Second))
+		n, _, err := server.ReadFrom(buf)
+		assert.NoError(t, err)
+		return string(buf[:n])
+	}
+
+	sink.Count("jobs.processed", 1, []string{"job:send_email"})
+	assert.Equal(t, "myapp.work.jobs.processed:1|c|#job:send_email", read())
+
+	sink.Timing("jobs.duration", 1500*time.Microsecond, []string{"job:send_email", "status:ok"})
+	assert.Equal(t, "myapp.work.jobs.duration:1.5|ms|#job:send_email,status:ok", read())
+
+	sink.Count("jobs.failed", 2, []string{"job:a|b,c"})
+	assert.Equal(t, "myapp.work.jobs.failed:2|c|#job:a_b_c", read())
+
+	sink.Count("jobs.dead", 1, nil)
+	assert.Equal(t, "myapp.work.jobs.dead:1|c", read())
+}
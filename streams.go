@@ -0,0 +1,141 @@
+package work
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// Backend is the kind of Redis data structure jobs are queued on.
+type Backend int
+
+const (
+	// BackendLists queues jobs on lists, moving each job to its worker pool's in-progress list while it runs. If a
+	// worker pool dies, the reaper moves its in-progress jobs back onto their queues.
+	BackendLists Backend = iota
+
+	// BackendStreams queues jobs enqueued with Enqueue, EnqueueContext and EnqueueBatch on Redis Streams (Redis 6.2 or
+	// later), read by the worker pools as a consumer group. An entry stays pending while its job runs and is deleted
+	// once the job is done, so if a worker pool dies its jobs are claimed and run by another pool once they've been
+	// pending for a minute, without waiting for the reaper. Other jobs, eg, unique, scheduled and retried ones, are
+	// still queued on lists, which the worker pools fetch from first. Job types can't have concurrency controls, ie,
+	// MaxConcurrency, MaxPerSecond, StrictFIFO or PartitionKey.
+	BackendStreams
+)
+
+const (
+	// streamGroup is the consumer group the worker pools read streams as.
+	streamGroup = "work"
+
+	// How long a stream entry is pending for before it's presumed abandoned by a dead worker pool and claimed by
+	// another. Workers keep claiming the entries of the jobs they're running to stop that from happening.
+	streamClaimIdle        = time.Minute
+	streamClaimRenewPeriod = 10 * time.Second
+
+	// How long an Enqueuer uses the namespace's Backend for before it checks it again, in seconds.
+	backendCheckPeriod = 300
+
+	streamKeysPerJobType = 4
+)
+
+func (b Backend) String() string {
+	if b == BackendStreams {
+		return "streams"
+	}
+	return "lists"
+}
+
+// useStreams returns whether e should add jobs to streams rather than lists, as per the namespace's Backend.
+func (e *Enqueuer) useStreams(conn redis.Conn) bool {
+	now := time.Now().Unix()
+
+	e.mtx.RLock()
+	backend, checkedAt := e.backend, e.backendCheckedAt
+	e.mtx.RUnlock()
+
+	if now < checkedAt+backendCheckPeriod {
+		return backend == BackendStreams
+	}
+
+	value, err := redis.String(conn.Do("GET", redisKeyBackend(e.Namespace)))
+	if err != nil && err != redis.ErrNil {
+		return backend == BackendStreams
+	}
+	backend = BackendLists
+	if value == BackendStreams.String() {
+		backend = BackendStreams
+	}
+
+	e.mtx.Lock()
+	e.backend, e.backendCheckedAt = backend, now
+	e.mtx.Unlock()
+
+	return backend == BackendStreams
+}
+
+// writeBackendToRedis records the pool's Backend as the namespace's, for the enqueuers, and creates the consumer
+// groups the pool reads its job types' streams as.
+func (wp *WorkerPool) writeBackendToRedis() {
+	conn := wp.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("SET", redisKeyBackend(wp.namespace), wp.backend.String()); err != nil {
+		logError(wp.logger, "worker_pool.write_backend", err)
+	}
+	if wp.backend != BackendStreams {
+		return
+	}
+	for jobName := range wp.jobTypes {
+		_, err := conn.Do("XGROUP", "CREATE", redisKeyJobsStream(wp.namespace, jobName), streamGroup, "0", "MKSTREAM")
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			logError(wp.logger, "worker_pool.write_backend.xgroup", err)
+		}
+	}
+}
+
+// fetchStreamJob reads a job from the streams of the worker's job types, claiming one that's been pending too long
+// first. It returns nil if there aren't any.
+func (w *worker) fetchStreamJob() (*Job, error) {
+	scriptArgs := make([]interface{}, 0, len(w.streamKeys)+2)
+	scriptArgs = append(scriptArgs, w.streamKeys...)                // KEYS[1-4 * N]
+	scriptArgs = append(scriptArgs, w.poolID)                       // ARGV[1]
+	scriptArgs = append(scriptArgs, streamClaimIdle.Milliseconds()) // ARGV[2]
+
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	values, err := redis.Strings(w.redisFetchStreamScript.Do(conn, scriptArgs...))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	job, err := newJob([]byte(values[0]), []byte(values[1]), nil)
+	if err != nil {
+		return nil, err
+	}
+	job.streamID = values[2]
+	return job, nil
+}
+
+// renewStreamClaim keeps resetting how long job's stream entry has been pending for until done is closed, so other
+// worker pools don't claim it while it's running.
+func (w *worker) renewStreamClaim(job *Job, done <-chan struct{}) {
+	ticker := time.NewTicker(streamClaimRenewPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			conn := w.pool.Get()
+			_, err := conn.Do("XCLAIM", string(job.dequeuedFrom), streamGroup, w.poolID, 0, job.streamID, "JUSTID")
+			if err != nil {
+				logError(w.logger, "worker.renew_stream_claim", err)
+			}
+			conn.Close()
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package work
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPoolStreamsBackend(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 2, ns, pool, WithBackend(BackendStreams))
+	var ran int32
+	wp.Job("wat", func(job *Job) error {
+		atomic.AddInt32(&ran, 1)
+		return nil
+	})
+	wp.Start()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueBatch("wat", []Q{nil, nil})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&ran) == 3 }, 2*time.Second, 10*time.Millisecond)
+	wp.Stop()
+
+	conn := pool.Get()
+	defer conn.Close()
+	n, err := redis.Int(conn.Do("XLEN", redisKeyJobsStream(ns, "wat")))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n) // entries are deleted once their jobs are done
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+}
+
+func TestWorkerStreamsBackendClaimsAbandonedJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WithBackend(BackendStreams))
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.writeBackendToRedis()
+
+	// A job delivered to a worker pool that died 2 minutes ago
+	conn := pool.Get()
+	defer conn.Close()
+	stream := redisKeyJobsStream(ns, "wat")
+	job := &Job{Name: "wat", ID: "1", EnqueuedAt: 1}
+	rawJSON, err := job.serialize()
+	assert.NoError(t, err)
+	id, err := redis.String(conn.Do("XADD", stream, "*", "job", rawJSON))
+	assert.NoError(t, err)
+	_, err = conn.Do("XREADGROUP", "GROUP", streamGroup, "dead", "COUNT", 1, "STREAMS", stream, ">")
+	assert.NoError(t, err)
+
+	w := wp.workers[0]
+	fetched, err := w.fetchStreamJob()
+	assert.NoError(t, err)
+	assert.Nil(t, fetched) // it's not been pending long enough
+
+	_, err = conn.Do("XCLAIM", stream, streamGroup, "dead", 0, id, "IDLE", (2 * time.Minute).Milliseconds())
+	assert.NoError(t, err)
+	fetched, err = w.fetchStreamJob()
+	assert.NoError(t, err)
+	if assert.NotNil(t, fetched) {
+		assert.Equal(t, "1", fetched.ID)
+		assert.Equal(t, id, fetched.streamID)
+	}
+}
+
+func TestWorkerPoolStreamsBackendValidations(t *testing.T) {
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, "work", newTestPool(":6379"), WithBackend(BackendStreams))
+	assert.Panics(t, func() {
+		wp.JobWithOptions("wat", JobOptions{MaxConcurrency: 1}, func(job *Job) error { return nil })
+	})
+	assert.NotPanics(t, func() {
+		wp.JobWithOptions("wat", JobOptions{Priority: 10}, func(job *Job) error { return nil })
+	})
+}
@@ -0,0 +1,136 @@
+package work
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// wakeResubscribeDelay is how long the wake subscriber waits to subscribe again after losing its connection.
+const wakeResubscribeDelay = time.Second
+
+// wakeSubscriber subscribes to the wake channels of a pool's job types, which are published to whenever a job is
+// enqueued, and calls wake with the job's name so that the pool's idle workers fetch it straight away. See FetchPubSub.
+type wakeSubscriber struct {
+	pool     Pool
+	channels map[string]string // job names by channel
+	wake     func(jobName string)
+	logger   Logger
+
+	mtx     sync.Mutex
+	psc     *redis.PubSubConn // the current subscription, if any
+	stopped bool
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+func newWakeSubscriber(namespace string, pool Pool, jobNames []string, wake func(jobName string), logger Logger) *wakeSubscriber {
+	channels := make(map[string]string, len(jobNames))
+	for _, jobName := range jobNames {
+		channels[redisChannelJobsWake(namespace, jobName)] = jobName
+	}
+
+	return &wakeSubscriber{
+		pool:     pool,
+		channels: channels,
+		wake:     wake,
+		logger:   logger,
+
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}
+}
+
+func (s *wakeSubscriber) start() {
+	go s.loop()
+}
+
+func (s *wakeSubscriber) stop() {
+	s.mtx.Lock()
+	s.stopped = true
+	psc := s.psc
+	s.mtx.Unlock()
+
+	close(s.stopChan)
+	if psc != nil {
+		psc.Unsubscribe()
+	}
+	<-s.doneStoppingChan
+}
+
+func (s *wakeSubscriber) loop() {
+	defer close(s.doneStoppingChan)
+
+	if len(s.channels) == 0 {
+		<-s.stopChan
+		return
+	}
+
+	for {
+		err := s.subscribe()
+		if s.isStopped() {
+			return
+		}
+		logError(s.logger, "wake_subscriber.subscribe", err)
+
+		// The workers carry on polling in the meantime.
+		select {
+		case <-s.stopChan:
+			return
+		case <-time.After(wakeResubscribeDelay):
+		}
+	}
+}
+
+// subscribe subscribes to the wake channels and calls wake for each message until it's unsubscribed by stop, or the
+// connection fails.
+func (s *wakeSubscriber) subscribe() error {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	channels := make([]interface{}, 0, len(s.channels))
+	for channel := range s.channels {
+		channels = append(channels, channel)
+	}
+	psc := &redis.PubSubConn{Conn: conn}
+	if err := psc.Subscribe(channels...); err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	if s.stopped {
+		s.mtx.Unlock()
+		return nil
+	}
+	s.psc = psc
+	s.mtx.Unlock()
+
+	defer func() {
+		s.mtx.Lock()
+		s.psc = nil
+		s.mtx.Unlock()
+	}()
+
+	for {
+		switch v := psc.Receive().(type) {
+		case redis.Message:
+			if jobName, ok := s.channels[v.Channel]; ok {
+				s.wake(jobName)
+			}
+		case redis.Subscription:
+			if v.Kind == "unsubscribe" && v.Count == 0 {
+				return nil
+			}
+		case error:
+			return v
+		}
+	}
+}
+
+func (s *wakeSubscriber) isStopped() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.stopped
+}
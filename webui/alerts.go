@@ -0,0 +1,74 @@
+package webui
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gocraft/web"
+	work "github.com/teamwork/work/v2"
+)
+
+// DefaultThreshold is the key of the Threshold that applies to queues without one of their own. See WithThresholds.
+const DefaultThreshold = "*"
+
+// Threshold is the most a queue should hold before it's reported by the alerts endpoint. Zero fields aren't checked.
+type Threshold struct {
+	MaxCount   int64         // Most jobs waiting in the queue
+	MaxLatency time.Duration // Longest the oldest job in the queue should have waited
+}
+
+// Alert is a queue in breach of its Threshold.
+type Alert struct {
+	JobName  string   `json:"job_name"`
+	Count    int64    `json:"count"`
+	Latency  int64    `json:"latency"`
+	Breached []string `json:"breached"` // "count" and/or "latency"
+}
+
+// WithThresholds sets the queue thresholds, keyed by job name, checked by the /:namespace/alerts endpoint. The
+// DefaultThreshold key applies to all other queues. Queues in breach are also highlighted in the UI.
+func WithThresholds(thresholds map[string]Threshold) Option {
+	return func(s *Server) {
+		s.thresholds = thresholds
+	}
+}
+
+// breaches returns which of q's thresholds it's over, if any.
+func (c *context) breaches(q *work.Queue) []string {
+	t, ok := c.thresholds[q.JobName]
+	if !ok {
+		t = c.thresholds[DefaultThreshold]
+	}
+
+	var breached []string
+	if t.MaxCount > 0 && q.Count > t.MaxCount {
+		breached = append(breached, "count")
+	}
+	if t.MaxLatency > 0 && time.Duration(q.Latency)*time.Second > t.MaxLatency {
+		breached = append(breached, "latency")
+	}
+	return breached
+}
+
+// alerts lists the queues in breach of their thresholds. It responds with 503 Service Unavailable if there are any, so
+// that it can be used as a health check by external monitors.
+func (c *context) alerts(rw web.ResponseWriter, r *web.Request) {
+	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	queues, err := nsclient.Queues()
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	alerts := []*Alert{}
+	for _, q := range queues {
+		if breached := c.breaches(q); len(breached) > 0 {
+			alerts = append(alerts, &Alert{JobName: q.JobName, Count: q.Count, Latency: q.Latency, Breached: breached})
+		}
+	}
+
+	if len(alerts) > 0 {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}
+	render(rw, alerts, nil)
+}
@@ -0,0 +1,83 @@
+package webui
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+
+	"github.com/gocraft/web"
+)
+
+// WithAuth wraps all of the server's endpoints, including the UI but not /healthz and /readyz, with the auth
+// middleware, eg, to check a session or an OAuth proxy's headers. The middleware should respond with an error itself
+// rather than call the handler it's given if the request isn't allowed.
+func WithAuth(auth func(http.Handler) http.Handler) Option {
+	return func(s *Server) {
+		s.auth = auth
+	}
+}
+
+// WithBasicAuth requires HTTP basic authentication with the username and password for all of the server's endpoints
+// but /healthz and /readyz.
+func WithBasicAuth(username, password string) Option {
+	return WithAuth(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			u, p, ok := r.BasicAuth()
+			if !ok ||
+				subtle.ConstantTimeCompare([]byte(u), []byte(username)) != 1 ||
+				subtle.ConstantTimeCompare([]byte(p), []byte(password)) != 1 {
+				rw.Header().Set("WWW-Authenticate", `Basic realm="workwebui"`)
+				http.Error(rw, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(rw, r)
+		})
+	})
+}
+
+// WithEnqueue enables the endpoint that enqueues jobs without WithAuth or WithBasicAuth, for handlers returned by
+// NewHandler that are mounted behind the app's own auth.
+func WithEnqueue() Option {
+	return func(s *Server) {
+		s.enqueueEnabled = true
+	}
+}
+
+// rejectCrossSite guards the endpoints that change state against cross-site request forgery, as browsers send basic
+// auth credentials and cookies with other sites' form posts too. The requests must be JSON, which other sites can't
+// send without the browser asking the server first, and must come from the server's own origin if the browser says
+// where they came from.
+func rejectCrossSite(rw web.ResponseWriter, r *web.Request, next web.NextMiddlewareFunc) {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		next(rw, r)
+		return
+	}
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+		rw.WriteHeader(http.StatusUnsupportedMediaType)
+		fmt.Fprint(rw, `{"error": "requests must be application/json"}`)
+		return
+	}
+	if !sameOrigin(r.Request) {
+		rw.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(rw, `{"error": "cross-origin requests aren't allowed"}`)
+		return
+	}
+	next(rw, r)
+}
+
+// sameOrigin reports whether r was made from a page served by this server, as per its Origin header, which must match
+// its Host, or X-Forwarded-Host behind a proxy, or else its Sec-Fetch-Site header. Requests with neither, eg, from curl,
+// are allowed.
+func sameOrigin(r *http.Request) bool {
+	if origin := r.Header.Get("Origin"); origin != "" {
+		u, err := url.Parse(origin)
+		if err != nil || u.Host == "" {
+			return false
+		}
+		return u.Host == r.Host || u.Host == r.Header.Get("X-Forwarded-Host")
+	}
+	site := r.Header.Get("Sec-Fetch-Site")
+	return site == "" || site == "same-origin" || site == "none"
+}
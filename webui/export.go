@@ -0,0 +1,90 @@
+package webui
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gocraft/web"
+	work "github.com/teamwork/work/v2"
+)
+
+// deadJobsCSVHeader is the header row of the CSV export of dead jobs.
+var deadJobsCSVHeader = []string{"died_at", "id", "name", "args", "fails", "err", "enqueued_at", "first_enqueued_at"}
+
+func deadJobCSVRecord(job *work.DeadJob) ([]string, error) {
+	args, err := json.Marshal(job.Args)
+	if err != nil {
+		return nil, err
+	}
+	return []string{
+		strconv.FormatInt(job.DiedAt, 10),
+		job.ID,
+		job.Name,
+		string(args),
+		strconv.FormatInt(job.Fails, 10),
+		job.LastErr,
+		strconv.FormatInt(job.EnqueuedAt, 10),
+		strconv.FormatInt(job.FirstEnqueuedAt, 10),
+	}, nil
+}
+
+// exportDeadJobs streams all the dead jobs, optionally filtered as per the dead_jobs endpoint, as a JSON array or, with
+// format=csv, as CSV. Errors after the response has started can only be reported by cutting it short.
+func (c *context) exportDeadJobs(rw web.ResponseWriter, r *web.Request) {
+	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	if err := r.ParseForm(); err != nil {
+		renderError(rw, err)
+		return
+	}
+	filter := parseFilter(r)
+	filename := r.PathParams["namespace"] + "-dead-jobs"
+
+	switch format := r.Form.Get("format"); format {
+	case "", "json":
+		rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+".json"))
+
+		enc := json.NewEncoder(rw)
+		sep := "["
+		err := nsclient.EachDeadJob(filter, func(job *work.DeadJob) error {
+			fmt.Fprint(rw, sep)
+			sep = ","
+			return enc.Encode(job)
+		})
+		if err != nil {
+			if sep == "[" {
+				renderError(rw, err)
+			}
+			return
+		}
+		if sep == "[" {
+			fmt.Fprint(rw, sep)
+		}
+		fmt.Fprintln(rw, "]")
+	case "csv":
+		rw.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+".csv"))
+
+		w := csv.NewWriter(rw)
+		if err := w.Write(deadJobsCSVHeader); err != nil {
+			return
+		}
+		err := nsclient.EachDeadJob(filter, func(job *work.DeadJob) error {
+			record, err := deadJobCSVRecord(job)
+			if err != nil {
+				return err
+			}
+			return w.Write(record)
+		})
+		if err != nil {
+			return
+		}
+		w.Flush()
+	default:
+		rw.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(rw, `{"error": %q}`, "unknown format "+format)
+	}
+}
@@ -0,0 +1,40 @@
+package webui
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// withHealthChecks serves /healthz and /readyz ahead of next, so that they're not behind the base path or auth, for
+// use as Kubernetes liveness and readiness probes. /healthz responds once the server is up, and /readyz once Redis
+// responds to a PING as well.
+func (s *Server) withHealthChecks(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			s.healthz(rw, r)
+		case "/readyz":
+			s.readyz(rw, r)
+		default:
+			next.ServeHTTP(rw, r)
+		}
+	})
+}
+
+func (s *Server) healthz(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	fmt.Fprint(rw, `{"status": "ok"}`)
+}
+
+func (s *Server) readyz(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("PING"); err != nil {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintf(rw, `{"error": %q}`, err.Error())
+		return
+	}
+	fmt.Fprint(rw, `{"status": "ok"}`)
+}
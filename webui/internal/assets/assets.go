@@ -1,115 +1,14 @@
+// Package assets embeds the web UI's frontend, built into build/ with `yarn build`.
 package assets
 
 import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"strings"
+	"embed"
 )
 
-func bindata_read(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
+//go:embed build
+var build embed.FS
 
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	return buf.Bytes(), nil
-}
-
-var _index_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\xcf\xbd\x6e\xc3\x20\x10\x07\xf0\xdd\x4f\x71\xbd\xb9\x2e\xea\x0e\x5e\xda\xce\xcd\x90\x25\x23\x81\x53\x7c\x09\x06\x04\x27\xac\xbc\x7d\x64\xe3\x4c\xf7\xa9\x9f\xf4\xd7\x1f\xbf\xff\x3f\xe7\xcb\xe9\x0f\x66\x59\xc2\x34\xe8\xad\x40\xb0\xf1\x66\x90\x22\x4e\x03\x80\x9e\xc9\xfa\xad\x01\xd0\x0b\x89\x85\x68\x17\x32\xd8\x98\xd6\x9c\x8a\x20\xb8\x14\x85\xa2\x18\x5c\xd9\xcb\x6c\x3c\x35\x76\x34\xee\xc3\x27\x70\x64\x61\x1b\xc6\xea\x6c\x20\xf3\x8d\xa0\x76\x53\xbd\x51\x7d\x4d\xfe\x79\xe8\x9e\x1b\xb0\x37\x68\x73\xc6\x49\x2b\xcf\xed\x38\x54\x57\x38\x0b\xd4\xe2\x0c\xaa\x35\x95\xc7\xd7\xbd\x6e\x1f\x7d\xdf\xc1\xee\x68\xd5\x73\xbc\x02\x00\x00\xff\xff\xab\xa9\x3a\x23\xd8\x00\x00\x00")
-
-func index_html() ([]byte, error) {
-	return bindata_read(
-		_index_html,
-		"index.html",
-	)
-}
-
-var _work_js = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x0b\x77\xdb\x36\xb6\x28\x8e\x7f\x15\x89\xa7\x7f\x16\xa8\x60\x5a\x72\xd2\xb4\xa5\x82\x6a\x52\xc7\x9d\x66\x26\x6e\x3c\x79\xb4\x73\x8e\xa2\xe3\x4b\x93\x90\xc4\x9a\x06\x34\x20\xe4\xc4\x23\xe9\xbb\xff\x17\x36\x00\x12\xa4\x28\xc7\xe9\xcc\x3d\xbf\x39\x77\xcd\x6a\x57\x2c\xe2\xfd\xd8\xd8\xd8\x6f\xf4\xe7\x6b\x9e\xaa\x5c\x70\xc4\xf0\xc6\xfd\xee\x29\x24\xf0\x26\x9f\x23\x3e\x15\x33\x2c\x99\x5a\x4b\xde\xd3\xbf\x23\xf6\x71\x25\xa4\x2a\xc7\xb7\x89\xec\x49\xaa\x93\xe8\x26\x8f\x05\x29\xe2\xfe\x88\xd8\xcc\x78\xb3\xdb\x8d\x6d\x25\xa6\x2b\xa5\x49\x51\x20\xe9\xea\x12\x49\xea\xdf\x0a\x13\x19\x15\xb4\x3f\xac\xd3\x76\xba\x6d\x4e\x37\xbb\xb1\x8a\x6e\x28\x23\x2a\x4a\x29\x27\x2a\xca\x68\x3d\x54\xc2\x89\xc0\x1b\x15\x09\xfd\x13\x6f\xb7\xaf\xae\x7e\x63\xa9\x8a\x32\x36\xcf\x39\xbb\x90\x62\xc5\xa4\xba\x83\x62\x9b\x54\xf0\x79\xbe\x58\xcb\xe4\xaa\x60\x30\x48\xbe\xbe\x61\xf6\x6b\x48\x16\x4c\xc5\x62\x87\x77\x44\x45\x9c\xfa\x4b\x61\x06\xc1\xc2\x90\x45\x97\x97\xac\x3c\x17\xd9\xba\x60\x93\xaa\x04\xde\xb8\x09\xea\x4e\x93\x75\xa1\x76\x71\x47\x66\xb5\x0e\x2a\xca\x10\x27\x41\x12\x10\x8e\x09\xd7\xdd\x09\x7f\x3a\xaa\xaa\x62\x67\xb2\x92\x42\x09\x75\xb7\x62\xd1\x32\x29\x5f\x7d\xe0\x6e\x4e\x66\x2d\x75\x05\xdd\xc6\x8a\x06\x01\x51\x48\x45\x25\x7d\x7c\x82\x77\x68\xea\x37\x49\x38\xde\x30\xb7\xa8\x94\xa3\xc7\x8f\x31\xc2\x3b\xd2\x2e\x12\xac\x4b\xd6\x2b\x95\xcc\x53\x15\x8c\x1b\xe5\x1f\x7d\xaa\xb4\x5e\xa3\xe6\x34\xf4\xbe\x10\x49\x0a\x92\x90\x1c\x00\xa8\x6f\x97\x32\x1d\xe7\x73\x74\x2b\xf2\xac\x37\xa4\x94\x2a\x9c\x52\xce\x3e\xf4\xce\xa4\x14\x12\x05\xe7\x39\xcf\xe7\x39\xcb\x7a\xec\x63\xca\x56\x00\x80\x22\x4d\xd7\x52\xb2\x6c\xdc\xd3\x1d\xaa\x25\xeb\x71\xc1\x8f\x6e\x5c\xc1\x8c\xdd\xf6\x18\xbf\xcd\xa5\xe0\x37\x8c\xab\xde\x5c\x48\x28\x34\x5f\x17\x45\x8f\xe9\x56\x7b\x37\xac\x2c\x93\x05\xeb\x25\x3c\xeb\x25\x59\x96\xeb\x66\x93\xa2\xb7\x64\xc5\x6a\xbe\x2e\x7a\x1f\x12\xc9\x73\xbe\x28\xa3\x00\x8f\x59\x51\x32\x18\x65\x49\xa7\xde\x04\x66\x64\x4d\x87\x63\x7f\xa4\x2a\x92\x6c\x55\x24\x29\x43\xc7\xff\xbf\xf2\x78\x41\xf6\x77\xbc\x9c\xae\x07\x83\xd9\x0e\x63\x92\x46\x3c\xb9\x61\x34\x78\xc1\x6f\x13\x99\x27\x5c\xf5\x7e\xc9\x45\x91\xe8\xe2\xc1\x4e\x2d\xa5\xf8\xd0\x4b\xa3\xb9\x4c\x6e\x58\xf9\x56\x5c\x88\x15\x1d\x91\x74\xb7\xf3\x76\x40\x3c\x68\xf5\x39\x1a\x61\x22\x29\x47\x4f\xbe\xc5\x8d\x35\x16\xd8\x74\x62\xd7\x38\x95\x2c\x51\xec\x48\xb2\x24\x55\x47\x69\x91\x94\x65\x2f\x15\xeb\x22\xeb\x71\xa1\x7a\xf3\x9c\x67\xb0\x7e\xaf\x75\x76\x4f\x18\x18\xec\xbd\x98\xf7\xee\xc4\xba\x97\x48\xd6\x5b\x97\x39\x5f\xf4\xca\x54\xe6\x2b\xd5\x53\xc9\xa2\x24\xbd\x9b\xe4\x9a\xf5\xca\xb5\xd4\xdb\x93\x28\x5b\x35\x2f\x7b\x57\x4c\x17\x2d\x44\x92\xb1\xac\x77\xc5\xe6\x42\xb2\xde\x7e\xef\x7a\xdd\xf5\x0c\x0a\x8a\xf4\xfa\x8a\xe8\x54\xdc\xac\x04\x67\x5c\xe1\x68\xbd\xca\x12\xc5\xa4\xb7\x16\x12\x79\x05\x88\x88\xf2\xf2\x97\xa4\xc8\xb3\xb3\x82\xe9\xed\x27\xc5\x67\x42\x2a\xde\x7c\xce\x42\x57\x58\x51\x68\xc4\xe0\xb0\xe1\xba\x28\x28\x65\xdb\xed\x3a\x4a\x5a\xc3\x41\x0c\xef\xaa\x3a\xd2\xab\xa3\xeb\x6f\xb7\xcf\xa4\x4c\xee\xa2\xbc\x84\xbf\x88\x61\x8d\x62\xd8\x2d\x93\x77\x48\x78\xf5\x8a\x06\x56\xc8\xd0\x66\x47\xe0\xd4\x57\x05\x12\x87\xa5\x14\x65\x91\xc6\x14\x84\xd3\x02\x29\x87\x8e\x34\xc2\x28\x09\xd3\x88\x64\x55\x02\x64\xf0\x28\x5d\xe6\x45\x26\x19\x37\xf5\x04\xcd\xbd\x34\xc2\xf1\x58\x44\x05\xe3\x0b\xb5\x0c\x43\x97\xf1\x5a\xac\x15\x2b\xa9\xc0\x24\x63\x05\x53\xac\x57\x57\xd8\xb9\x85\xa8\x87\x94\x9b\x31\x1b\xd4\x39\x9d\x39\xdc\xa7\x57\xe8\xd4\xd6\x8a\xe6\x42\x9e\x25\xe9\x12\x31\xe2\x63\xdb\x7c\x8e\x3a\xd7\x11\xe7\x73\x64\x66\x17\x19\x10\x82\x01\xfd\x28\xc5\x0d\x80\x9b\x2d\xe8\xe6\xf3\xe9\x92\x30\xc0\xb1\x08\x43\x1e\xad\xd6\xe5\x52\xaf\xb8\x3e\xfc\x3d\xfb\xa9\xd7\x14\xef\x34\x82\xae\xe6\x94\x7a\xfb\xa7\xf7\x72\xc2\x68\x8e\x18\x8e\x59\x18\xf6\xf7\x77\x12\x31\x3a\x65\x33\x4c\xd8\x4e\xdf\x5a\x92\xa8\x28\xa1\x09\x51\xd1\x15\x4d\xc7\x06\xc1\xc0\x79\x5d\x53\x1e\x71\x54\x62\x92\x51\x8b\xef\x93\xb2\xcc\x17\x7c\xbb\x6d\xdc\xc6\x42\x22\xb3\xc1\xa3\xb1\x7a\x9a\xc8\xc5\x5a\x4f\xa1\xb4\x9b\x34\x56\x83\x81\x5b\xeb\x2a\x6f\xaa\x66\x63\x57\x4d\xf4\x72\xde\xe3\xf8\x41\x17\x8a\xbe\x4e\xf5\xe8\xf5\x7d\x0e\x77\xfe\xae\xba\xc1\x3e\x71\x38\x94\x77\x3d\xea\x6b\x5c\xd9\xe5\xbf\x48\xd4\x92\xaa\x68\x95\xc8\xd2\xfd\x5e\x30\xf5\x97\x35\x93\x77\x6f\x94\xcc\xf9\xe2\x97\xa4\x58\xc3\xfe\xd8\x5c\xdd\xe0\xea\x9e\xfc\x24\xcb\xda\xb9\x6f\x05\xe4\x19\x94\x57\xe1\xc3\xc7\x1a\x1f\x22\x7f\x21\x5b\xb7\xf8\x0e\x09\x4c\xee\x6b\xb0\x3d\x61\xd3\x72\x81\x98\x6e\x59\x44\xab\x44\x2d\x35\x6e\x27\x39\x15\x51\xc9\x12\x99\x2e\x49\x4a\x85\x5e\xd8\xa5\x83\xf9\x04\x6d\x5c\xb1\x58\x12\x53\x28\xce\x07\xe8\x68\x44\x29\xcd\xa3\x9c\x67\xec\xe3\xab\x39\x0a\x26\x01\x9e\x04\x93\x20\x0e\xc2\x00\x0f\xd4\x20\xa0\xc1\x80\x13\xdd\x50\x9c\x1a\xaa\xe4\xfe\x65\x69\x92\x10\x06\x18\x60\x9c\x82\xf2\x7a\x9c\x92\x72\x37\xce\x9c\xf2\x83\xe3\x14\x6e\x9c\xb2\xba\xe6\x34\x6a\x7e\xcd\x16\x67\x1f\x57\x28\x40\xd3\x49\x38\xc3\x01\x8c\x72\x9a\x1c\xfd\xfd\xd9\xd1\x7f\x0d\x8f\xbe\x9b\x0d\x50\x38\xc1\x01\xde\x83\x12\xd3\x41\x30\x09\xf4\x7d\x3f\x51\x31\xdf\x61\x33\xb1\xdc\x4c\xec\x3e\x68\xb8\x77\x5a\x76\x26\x7a\x27\x6e\x12\x95\x2e\x1b\x83\xd4\x63\x34\x43\x44\xfe\x18\x71\x80\xb1\x9b\xb2\x0c\x43\x39\x1d\xcd\x76\xa4\x4d\xee\x69\x44\x6a\x5a\x3c\xfe\x6f\xb4\x54\x6a\x55\x4e\x62\x3c\x79\x7f\xfc\xfe\x78\xfa\xdf\xef\x8f\x67\x5f\x1d\x57\x4d\x18\xe4\xaf\x26\x2c\x66\x51\xb9\xbe\x2a\x61\x0a\x48\x4d\x87\x33\x7b\x38\x35\x12\x29\x1a\xe0\xbf\xdf\x99\xc6\x26\x84\x6b\x0a\x4e\xe8\x7f\x74\xf1\x0a\x2c\xfe\x23\xc0\xe3\xa3\x51\x9f\xd2\x22\x0c\x91\xd0\xa7\xa3\xea\xa5\xc0\x44\x35\x12\x86\xa4\xc0\xe6\x2e\x4d\xfc\x26\x26\x81\x1b\x2e\x34\x94\x68\xa4\xde\xa8\x97\xec\x37\x94\x60\x4c\x02\xd8\xb0\x30\x44\x8a\x06\xc7\x01\x26\x35\x7c\x28\x07\x1f\x16\x42\xc5\x6e\x47\x74\x97\xde\x81\x6f\xe1\xf4\xea\x92\x0c\x4c\x17\x01\xa5\x1a\x05\x89\x79\x8f\x39\xae\x82\x8d\xdd\xd2\x5f\x25\x25\x03\x70\xe5\x94\xd5\xb0\xab\xb1\x7a\xb5\xe3\x80\xbc\x96\xa4\xa0\x48\x6d\xb7\x41\x80\x07\xdc\xed\x88\x08\xc3\x60\x12\xf4\x29\x15\x61\x88\x8a\x81\xbe\xb1\xa4\xf9\x25\x31\x29\x3a\x90\x98\xc3\x16\x4f\x46\x78\xbc\x37\x3a\x01\xab\x3e\x9d\xb2\x28\x27\x82\x04\xc1\x6c\x86\x2d\xc7\xb3\x59\xde\xc8\xb8\x3f\xdc\x8d\x65\xa4\x64\xc2\xcb\xb9\x90\x37\x0e\x01\x71\xf4\x68\x88\x91\x20\x12\x6e\x52\x91\x26\x45\xa9\x71\x6a\x4d\x67\xd8\xc4\x4f\x11\x2b\xdd\xcc\x8c\x22\x41\x8d\xc2\x02\xb2\xb9\xd5\x27\x46\x0f\x45\xe3\x32\x7b\xe9\xfb\x14\x8e\xbb\x06\x18\x6d\xdf\x1c\x44\x51\x77\x63\x11\x4e\x87\x63\xfe\x94\x8d\xf9\x60\x80\xd5\x94\xcf\xbc\xab\x84\xcf\x6a\xe6\xe5\x37\x91\x73\x14\xf4\x02\xfc\xa9\xeb\xa0\x46\xc2\x63\x7d\xc5\x89\x4f\xcd\xb5\x93\xb6\x62\x35\x99\x3d\x8d\xbe\x1a\x4c\xfe\xfb\x8b\xcd\x0e\xe1\xed\xf4\xfd\xec\xfd\xfb\xd9\xf1\x82\x04\xef\xdf\x7f\x11\x06\x6d\x22\xab\xbe\x2e\x83\x80\x68\xfa\x83\x48\xfd\x4f\x61\xb7\x87\x24\x74\x48\x72\x7a\x1c\x3b\xc4\x70\xf9\xc5\xac\x46\x11\x97\x5f\xcc\xbe\xc2\xdb\xf7\x5f\xbd\xff\x6a\xab\xff\x47\xdb\xf7\x78\xfb\xfe\xbd\xfe\xfb\xfe\x3d\x3e\x5e\x8c\x0b\x9a\x47\xec\x23\xd3\xd4\xc0\x18\x17\xe6\x94\xd9\x53\x25\x0d\xed\xc0\xa2\xb2\xc8\x53\x86\x12\x62\xb3\x31\x26\x6a\x40\x45\x57\x06\x26\xc5\x74\x34\x9b\x20\x35\xa0\x01\x9a\xfe\xf7\xb1\x46\x50\xc4\xd2\x20\x3a\x07\xe3\x38\xf8\xea\x2b\x7d\x10\x8b\xe9\xd0\x95\x8b\xbe\xaa\x0b\x05\xe5\xaa\x48\x54\x00\xe5\xf6\x8a\x4d\x3a\xcb\xa1\xaa\x1c\x14\x9b\xc4\x41\x1c\xe0\x46\x1a\xd6\xf7\xd0\xfb\xf7\xcd\x82\xfa\x5b\xa7\x56\x45\x35\x66\x80\x74\x8d\xf4\xa5\x1b\xf3\x70\x86\x49\x42\xf3\xa8\x48\x4a\xf5\x42\x4f\xb2\xba\x60\xfa\x94\xb2\x9a\xae\xdc\x5b\x2c\x97\xb7\xbf\x5a\x75\x8e\x41\x42\x8a\x49\x1e\x33\x22\xd9\x82\x7d\x5c\xbd\x11\x6b\x99\x6a\x94\xb4\x4a\x64\x72\xf3\xb3\xe6\xa2\x62\x4e\x94\xb8\x66\xbc\x8c\xe5\xae\x41\x47\xd7\x54\xf4\x94\xcd\xb6\x5b\xa4\xff\x00\xf6\xc5\x44\xff\x6c\x90\xd4\xfa\xbe\x09\x8e\x03\x18\x74\xba\x4c\xe4\x33\x85\x86\x86\xae\x0b\x8e\x83\x01\x33\x68\xc0\xbb\x8c\xfc\xd1\xc0\x3d\x5b\x8f\x87\x24\x94\x47\x66\x44\xe3\x56\x93\x6e\x6a\x47\x23\xdd\xb6\x18\xd0\xe0\x78\x12\x60\x62\xf6\x32\x99\x26\x55\xf6\xcc\x66\x7f\x61\xb9\xa5\x9c\xaa\x8e\x4b\xef\xbf\x83\x81\x20\x41\xae\xef\xb8\x0a\xe9\xe6\xd8\xbb\xab\xa0\x6e\x4a\xf3\xe9\x70\x46\xca\x0a\xe7\xa3\xd4\xad\xb0\xae\x56\x02\xc6\x36\x03\x4d\xdd\x40\xd3\x7a\xa0\x8d\xf6\x4a\x58\x8e\xd2\x52\x89\x1b\xc9\x6e\x92\x5c\xb3\xd3\x17\xee\xae\x28\xfd\x8d\x91\xe6\x03\xee\xf8\x32\xce\xed\x0e\x8f\x70\x74\x93\xac\x1a\x84\x9a\x3b\xff\x61\x98\xb1\x54\x64\xec\xdd\xeb\x17\x15\xd7\xa7\xb9\x2a\xbc\x6b\x30\x1b\x55\x05\xbd\x1d\xde\xca\x37\xa8\x77\xbd\xa3\x8a\xaa\xed\x76\xb3\xab\x48\x63\x6f\x03\xcd\x06\x11\x49\x35\x82\x08\x02\x92\xd3\x21\x49\x35\xde\x28\x1d\xde\xc8\xdc\x8f\xb9\xfb\xb1\xa2\x43\x72\x45\x1d\xb7\x34\x5e\x3d\xbd\x1a\x0f\x06\x2b\xcd\xab\x98\x1d\x44\x25\x15\xd3\xd5\x0c\x6f\xb7\xf6\x14\x97\x78\x4e\x9b\xcc\x82\x8a\xe0\x64\xe2\x89\xfd\x31\xcd\x07\x83\x59\x6c\x3f\x88\x5e\xe3\x3e\x9d\x6f\xb7\xf2\xfb\xe1\x76\xbb\x46\x18\xf5\x47\xd8\xa5\x86\x21\x4a\x06\x94\x71\xbb\x40\x68\x8e\x8d\x04\xa3\xa7\xfb\x47\xa6\xbb\x74\x2a\x67\x7a\x36\x72\x40\x47\x75\x26\x36\x99\x70\xf7\x2d\x69\x1a\xad\xc4\x0a\xe1\xb1\x3c\xa2\x23\x22\x27\xe9\x54\x1e\x8d\x66\x03\xba\x8c\x93\x01\x5d\xee\xaa\x4a\x16\x21\x94\x38\xd1\x68\x23\x18\x7b\x19\xb8\xce\xc0\x5e\x46\x0c\xc9\xf5\xf1\xd1\x0b\x93\xd1\xd2\xa3\x34\x46\x98\xcc\xa9\x9a\x66\xb3\x7b\x66\x4a\xe9\x1c\x20\x52\xe2\x8d\x19\x1a\x0d\x82\x6a\x03\x6f\xa8\xa8\x28\x9d\x12\x93\x85\x26\xc4\x01\xa8\x6e\x88\xdb\x16\x4c\x2e\xe9\xd1\x88\xdc\xd2\xe1\xf8\xf6\xe9\xc2\xed\xd5\xed\x60\x80\xdd\x52\x2c\xa6\xb7\x33\xbc\xb9\xa4\xb7\xe3\x2b\xc9\x92\xeb\xdd\xa5\x3f\x84\x15\xbd\x19\x5c\x1e\x8d\x76\x66\x21\xea\xe5\xa9\xd6\xbd\x06\xcc\x39\x8e\x93\x03\x19\xe3\x56\xed\x52\x97\x2c\x2b\x4a\xf4\x29\xf5\x7a\x4c\xea\x5b\xef\xfd\xf1\x40\x5f\x73\xc7\x01\x06\x3e\xd2\x70\x90\x39\x70\x93\x35\x1f\x79\xd2\xc5\x47\x1a\x5a\x0c\x70\xc0\xef\xbb\x7a\x5b\x02\x4f\x16\x6f\x2c\x6d\x11\xb3\xdd\x6e\x9f\xd9\xd3\x14\x8d\x6e\xa4\x7c\x26\xd9\xd9\xdf\xd6\x49\x01\x9c\x5c\xa2\x98\x9f\x60\x06\xf5\xd2\x16\xad\x12\x80\xfc\xf7\xb9\x37\x49\x03\x37\xa8\x9a\x16\x7b\x73\x77\x73\x25\x8a\x30\x0c\x4a\xf8\xd1\xce\x88\x72\xc5\x64\xa2\x84\x9c\x74\x20\x12\x47\x6c\x7a\xb2\xd9\xc6\x54\xef\xe9\x8e\x45\xa9\xe0\xa5\x92\xeb\x54\x09\x49\x29\xad\xd2\xfb\xee\x77\xcd\x52\x4f\xdc\xd8\xe2\xaa\x43\x52\xfc\xeb\x73\xf6\xfa\x82\xd2\x50\x94\x53\xa1\x99\x81\xd4\xf0\xce\x25\x45\x02\xa5\x98\x70\xf4\x04\x03\x84\xa1\xd1\x13\x0d\x60\xa8\xb9\x6d\x1d\x0b\x5a\xa0\x0e\x20\x24\x0c\xb8\xbd\x16\x08\x78\x74\x6a\x37\x8d\xfa\xfd\x30\x0c\x0d\x64\x68\x2a\xab\x5a\x84\xe1\x6c\xe2\x7f\xc4\xc1\x71\x40\xd4\x7e\xe5\x51\x67\xe5\x91\x5f\x79\x34\x8b\xd7\xd1\xc5\xab\x0b\xc2\xf7\xab\x9f\x74\x56\x3f\xf1\xab\x9f\xcc\x62\x3d\x3d\xcd\xba\xed\xb1\x36\x13\x34\x24\x65\xcd\xfa\x61\x10\x17\xd9\x33\xef\x71\xdb\x15\x8f\xb3\xdd\xea\x69\x58\xde\xca\x49\x14\x34\x7f\x63\xd9\x2c\x60\x7a\xe0\x1b\x4e\x96\x2e\xa2\xff\x92\x04\x96\x30\x56\xe4\x9a\xdd\xc5\x7c\xd7\x64\x68\x2d\xfb\x3d\x35\xb2\xdc\xde\xf3\x44\xb1\x99\xc6\xcb\x7b\x40\xa3\x84\xe1\xc0\xad\x66\x41\x73\xae\xf3\xfd\x43\x5c\x21\x0b\x86\x00\x93\xe4\x73\xa4\x28\xa5\xdc\x52\x05\x7d\x27\x7e\xa9\x45\xfc\x93\x60\xcd\x0d\x0b\x93\x05\xb1\x44\x0a\x88\x0c\xd1\xa7\xb4\x16\x51\xf3\x56\x19\x8e\x1d\x95\xd1\x1f\xe9\xd2\xfe\xf1\x04\xc6\x6e\x48\x72\xc7\xea\x18\x9c\x19\x98\xe9\x41\x3e\x0c\x2a\x43\x0a\x87\x61\x86\x38\xee\x2a\xde\x92\xd8\x29\x6c\xc0\xaf\x3a\xab\xd7\xec\xae\x44\x4a\x53\xb2\x7e\x02\xaf\xf8\xfe\xc2\x42\x88\xa6\xd2\x2a\x9a\xb6\xb0\xd2\xdb\x6a\xf5\x45\x8d\x62\x90\x9a\x8a\x19\x31\xe7\xae\x3a\x79\xcd\x41\xe8\x91\xaa\xba\x5d\x5e\xb5\xab\xda\xed\x2a\xd2\x68\xb9\xd5\x6c\x7f\xb4\x1b\x77\xe1\xe3\x4e\x2d\x13\xd3\x33\xd3\xbb\xa4\xff\x6a\x64\xe7\x60\x11\xd2\xdc\x87\xce\x30\xd0\x08\xc9\xe6\xa7\x4e\xd4\x00\x09\x49\xfa\x47\x18\xce\x35\xd5\x0e\x20\x69\xe1\xe6\x93\x6c\xa2\x7f\xf7\x10\x07\x50\x6c\xaa\x6a\x65\x63\xad\x1d\x7a\x1a\x0c\xf8\xe0\xcb\xef\x7b\xe5\xb2\xd2\x57\x2c\x93\x5b\xd6\x4b\x7a\xc1\x97\x03\x35\xf8\x32\xe8\xad\xa4\x58\x7d\x69\xae\x4a\x41\x78\x94\x69\x5e\x39\x09\x3a\xb4\x33\xc5\x0e\xbb\xfc\xab\xae\xfc\xa4\xce\xcf\xba\xf2\xd3\x9d\x93\x03\x70\x34\xc4\xa4\xa0\x48\xdf\xbf\x12\x13\x03\x2e\x48\x46\xe5\x32\x59\x31\x8c\x36\x45\x5e\x2a\xc6\x63\x19\xe9\x46\xa2\xbc\x7c\xcd\xfe\xb6\xce\x25\xcb\x88\x66\x7f\x3a\x92\xed\xd5\xdf\x91\xb3\x10\x9d\x89\x3f\x24\xe9\x75\x67\xc6\x8f\x42\x7e\x48\x64\xb6\x9f\xb7\xf3\x86\x29\x38\x7b\x35\x7f\x7b\xa7\x87\x3a\x35\x05\x89\x8c\x0c\x22\x9b\xe1\x1a\xfe\x5b\x25\x0b\x22\x23\x73\xe0\x66\xfa\xd6\x38\xd0\x9a\x29\x41\x64\xc4\x8c\xcc\x7d\xa6\xaf\x96\xce\xb2\x79\x3d\xa0\x44\x9f\x85\x57\x73\x8c\x72\x3c\xfb\x3c\xc2\xa5\xe2\x78\x28\xdb\x6e\x2b\xd4\xc2\xac\xf6\x4b\xc3\x91\xee\xcd\xc2\x52\xe3\x4e\xee\xa5\x09\xd7\xd0\x74\xc5\x7a\x1a\xf7\xb1\xac\xf7\x21\x57\x4b\x60\x70\x7a\x42\xf6\x6a\xd4\x54\x9d\x7f\x3b\x5c\x86\x77\x06\x0a\x6c\x73\x0b\xa6\xbc\x7b\xd7\x50\x07\x65\x4d\x01\x1c\xba\xa0\x6b\x34\x53\x97\x58\xd9\xbc\x17\xe5\x59\xa5\xa7\xf6\x54\x55\x1e\x48\x2a\x79\x07\x0a\xd6\xc6\x94\x6a\xf4\x69\xae\x55\x3d\x7d\x83\xde\x51\x90\x5c\xa5\x01\x20\x61\x36\xfd\x7a\x46\x83\x8c\x05\x24\xf8\xda\xbb\x12\x9a\xb3\x00\x46\x0a\x31\xac\x39\xfb\xaa\xd1\x9a\x6e\xd9\xec\xac\xe8\x68\x34\xb4\xb2\xa3\xe0\x32\x18\xd8\x9b\x64\x2e\xc5\xcd\xe9\x32\x91\xa7\x22\x63\x88\xe3\x19\xe5\x80\xcd\x87\xa3\x93\x47\x8f\xbf\x7e\xf2\xcd\xb7\xdf\x69\x1e\xf3\x9e\x5e\xd5\x41\xce\x50\x69\x7e\x1d\x5b\x71\x54\x80\x9b\xd3\x15\x74\xe3\xf4\xed\x7a\xb2\x19\x9b\x2f\x96\xf9\x6f\xd7\xc5\x0d\x17\xab\xbf\xc9\x52\x05\x9a\xbb\xca\x95\xae\x58\xa9\xa1\xfc\x3e\xc4\x94\xcd\x28\xdb\x61\xd2\x5d\xbb\x5e\x29\xb8\x15\x1a\xeb\x8e\x36\x3b\x22\x70\x3d\xb0\x5d\x0a\x4c\x7a\x35\xf0\xfe\x68\xb7\x43\x78\xd2\xa8\x13\x37\x31\x73\xc5\x9b\x92\x9c\xa4\x54\x83\x36\x29\xe9\x68\x5c\xee\x93\x87\xa5\x26\x0f\xb9\x3b\x52\x35\x4d\x52\xce\x70\xb5\x43\x6b\x43\x22\x16\x8e\x08\x5c\xeb\xfb\x30\x9d\xae\x35\x11\xb8\x9e\x01\x18\x48\xbc\xc9\xa9\xbe\x74\xab\x4a\x19\x1d\x8e\xb3\xa7\xb9\xeb\x27\x1b\x0c\x70\xe2\x1a\xc8\xa7\xd9\xcc\xb4\xa1\x7f\xe9\x66\xe0\x2f\xde\xed\x2a\xdc\xf8\x7b\xf8\x8d\x2e\x9b\x09\x7b\xb8\x1a\xda\x5a\x19\xa9\x65\xa2\x5e\x43\x91\x92\x0a\xd2\xf8\xfe\x31\x29\x4a\x46\x05\x5c\xf1\x8d\x8c\xb7\x72\x0d\xe9\xc3\x56\xfa\xcf\x1a\x61\x08\x4b\xa0\x36\x6b\x2c\xf3\xc6\x29\x73\x70\xb7\xcc\xcb\x5d\xb3\xe4\x33\xbb\xee\x5d\x94\x30\xdb\x11\x4f\x77\xfd\xcf\xe7\xc3\x7c\xc1\xa7\x86\x1d\x8d\x07\x10\xeb\xe5\xbc\x54\x09\x4f\x35\x11\xaa\x70\x37\x02\x3c\x35\x28\x4f\x6f\x6a\x2f\xe9\x19\x3b\x80\xa4\xec\x25\xd5\x46\x04\xfb\x3a\x68\x63\xc6\x51\x37\xf7\x9a\xcd\x99\x64\x3c\x75\x6d\xea\xc5\xe9\x2d\x93\x92\x7f\xa9\x71\x29\xe3\xbd\x9c\xe7\x2a\x4f\x8a\xbc\x64\x59\xef\xa8\x57\xae\x57\x4c\x22\xdc\x28\x61\xf0\x6d\x85\x58\xfb\x6a\xbb\x75\xa4\x5c\xdf\xd1\xd1\xca\xe7\xcf\xea\xd4\x09\x8b\xd5\x9e\xc8\xae\x41\x2c\xfa\x2d\x18\xf9\x02\x55\xdd\xab\xf1\x46\x0f\xad\xc7\x3e\xae\x24\x2b\x4b\xdd\xdc\xcd\xba\x54\x3d\x96\xab\x25\x93\xfa\x5a\x70\x57\x41\xbd\x3c\x04\xe8\x8f\x60\xe0\x7a\xc0\x63\x56\x63\xef\x16\x13\xae\x34\x0d\x57\x65\x82\x25\x92\x63\x28\x63\x2b\xbe\x67\x0d\x73\xa4\x11\xf9\x20\x73\xe5\x4c\x93\x9a\x86\x4b\xc3\xdd\x0e\x13\x15\x86\x0e\xef\x94\x4c\x5d\xb8\xa6\x5f\xcd\x27\x9d\xa9\xb0\x36\xb1\x86\x23\x18\xc5\xe5\x25\x55\x78\xf7\xb9\xda\x05\x2b\x77\xf4\x35\x0b\x35\x2f\xd0\xc4\x5c\x70\x21\x38\x5a\x16\xae\x05\xab\x66\x51\x53\x3e\x1b\x8b\xa8\x9e\x2b\xf5\x3f\xb6\xdb\xfe\x88\x88\xc8\x9f\x2f\xed\x0f\x49\x00\x63\x08\x72\x6e\xd4\x31\x91\x5b\x1b\xaa\x8f\xf3\x21\x83\x2f\xa1\x91\x33\x11\x35\x5a\xf2\xa8\x67\xee\xd1\xcf\x3c\x0c\x19\xf2\x77\x87\x63\x22\x20\x8d\x08\x4c\x94\xc6\xd6\xc0\x10\x8f\x34\x16\x06\x7e\x78\x6d\x68\xbe\x8c\x0a\xb4\xd6\x2c\xd2\x01\xbb\x39\xcf\x58\x40\x9f\x0b\xa2\x30\x29\xcc\x2f\xa4\xea\x8d\xa8\x2c\xd6\x16\xcd\x0d\x53\x18\x47\xc9\x6a\x55\xdc\x99\x2a\x15\x6e\xc7\x15\xf3\x90\x20\x45\x18\x26\x39\x52\x64\xba\xd1\x8c\x5f\x20\x19\xcf\x98\x0c\x88\xd9\xb2\x3d\xce\x5a\x83\xbc\xe6\xfe\xd0\x88\x3d\xfa\x4a\xb7\x6a\x4c\x42\x22\x55\x56\x64\x4d\xe9\xb8\x24\x0b\xb9\xce\x58\x22\x50\xf9\x8d\x06\x86\x2c\x51\xec\x6d\x7e\xc3\x62\xcd\x2d\xbe\x78\xf3\xca\x52\x14\x58\x63\xb9\x46\x82\x15\xc2\x0d\xc9\xe8\x3b\x5c\x4b\xb3\x8e\xac\x2c\xab\x4a\x09\xde\x06\x24\xe8\x05\x18\xef\x76\x33\xbd\xd8\xa8\x1e\x40\x6d\x05\x34\x9e\xc3\x40\xf5\x69\x2d\xe9\x46\x95\x71\x56\x15\xe2\xeb\x9b\x2b\x26\x7d\xd2\xd6\x57\x81\x7d\x9e\xc1\xc2\x58\x45\x17\xef\xde\xfc\x44\x03\xfd\x6f\x40\x54\xf4\xfa\xec\xe2\xe5\xb3\xd3\x33\x1a\xd8\x1f\x3a\xed\xe2\xd5\x05\x0d\x2e\x5e\x5d\x04\x9f\xdd\x76\x92\x65\x67\xb7\x8c\xab\x97\xc0\x0f\x30\xb9\x67\x5b\x50\x31\x64\xed\x92\x93\xfd\x24\x80\xe1\xfe\x48\x1f\xe9\x44\xa9\x24\x5d\x42\x2e\x0a\x04\x0f\x06\xba\x31\xbd\x0c\x92\xdd\x88\x5b\xf6\xc0\x3e\x3b\x0a\x4f\x3a\x53\xbd\x9e\x33\x76\xa0\xe7\x72\xbd\x82\x0b\xef\xa7\xbc\x54\xc2\x97\x10\x39\x48\xfc\x90\xf3\x4c\x7c\x88\x78\x72\x9b\x2f\x12\x25\x64\xb4\x2e\x99\x7c\xb6\x60\x5c\x59\x40\x34\xd6\x11\xac\xd6\x61\x3f\xe3\x99\xd4\x14\xfd\x49\x14\xe0\x30\x3c\x94\xfb\x38\x1a\x06\x78\xbb\x6d\x67\x9f\x8b\xab\xbc\x60\xbd\x37\xc9\x3c\x91\xb9\x29\xd0\x6f\x14\x38\x5d\x4a\x71\xc3\xba\x72\x7e\x85\x91\x96\xbd\x8b\xa5\xe0\x2c\xc0\x9a\xee\xb1\x83\x5f\x9a\xc9\x85\x61\xa0\x19\xb9\x37\x9a\xd9\xd5\x18\xaa\x99\xdb\x58\x8e\x3f\x8a\x5f\x73\xb5\x14\x6b\xf5\x9a\x15\x22\xc9\xde\x95\x39\x5f\xfc\xa4\xf9\xe7\x3d\x2a\x03\x26\x70\x78\x91\xea\xe1\xfd\x98\x4b\x36\x17\x1f\x83\x46\x3f\x17\x62\x05\xbc\xf7\x2b\xae\x5b\x4f\x97\x09\x5f\xb0\x7f\xa0\x8f\xb7\x32\xcf\x18\x57\xa6\x8f\xbc\x3c\xfb\xa8\x64\xc2\x99\x58\x57\xfd\x00\x04\x74\x11\x3f\x35\x0f\x66\x84\x01\x76\xe3\xee\xed\xed\x54\xe6\xaf\xde\x3c\x54\xbb\xfc\x8f\x1b\xef\xfd\x9b\xba\xfa\x37\x75\xf5\x6f\xea\xea\x5f\x99\xba\xe2\xe8\x1b\x4c\x56\x54\xa0\x39\x26\x57\xff\x3a\xb4\x96\x91\x41\xbe\x59\x8a\x0f\x7b\xf4\x56\x8d\x5d\x46\x20\x8a\x62\x94\x02\xb5\xa5\x84\x4a\x8a\x8b\x64\xc1\xb6\xdb\xf3\x44\x2d\xa3\xe4\xaa\x44\x1e\x19\xb6\x4a\x16\xec\x88\xe1\xa7\x74\xb4\xdb\x91\x4f\x10\x74\xf9\x1c\x0d\xdb\xad\x36\xd4\xf2\xb5\xcd\xcf\x74\x46\x8c\x72\xa9\x55\x1c\x74\x4b\xca\x36\x52\x8f\x60\xc2\x8c\x5d\xc6\x41\x4a\xb0\xc8\x03\x33\x3a\x45\x00\xad\xfd\x9c\xdc\xb0\x78\x55\x95\x4e\x52\x95\xdf\xb2\x1d\x39\x58\xbf\x5c\x25\x3c\x00\xbd\x2e\x51\x18\xe3\x18\xfa\xaf\x57\x13\x29\xfc\x19\x43\xb8\xa7\x9f\x24\x20\x1b\xc1\x4f\x8b\x3c\xbd\x8e\xbd\x39\xfe\xb6\xbe\x59\xbd\x15\x08\xbc\x26\x3a\xbb\x07\x4b\x8c\x7f\x70\x11\xb2\xbc\xd4\x27\x28\x7b\xd8\x32\x04\x51\x14\x60\xfc\x69\x1a\x7c\x5d\x04\x64\xd3\xd5\xdd\x2a\x59\xe4\x1c\x84\xfd\x3b\x02\x12\x77\x03\x3d\xd5\x56\x07\xe0\xdc\xb2\x2f\xcb\x00\x28\x4c\x59\x5e\xf8\x60\x08\xb5\x4e\xc5\x9a\xab\x63\x1f\x34\x98\x04\x18\xbb\x97\x4e\xbf\xf2\xe9\x74\x0d\x4c\xf7\x51\xea\xc4\x36\x79\x6f\x99\x7a\x30\xf7\x16\x33\x9b\xea\x15\x69\x0b\xbc\x3d\xae\xe0\xea\xb3\x15\x12\x5e\x0a\x9c\xbc\x44\x63\xcd\xca\xaa\x46\x13\x39\xa8\xa4\xd3\x99\x46\xac\x69\xa2\x90\x51\xea\xd4\x82\x5c\xe0\x84\x8c\xf0\xcc\xc3\x2c\x78\xcc\xbb\x71\x8e\x4f\x74\x18\x9a\x20\x09\x43\x94\xea\x3e\xfb\x39\x36\xb7\x40\xae\x59\x0a\x9d\x5e\x3c\x65\x61\x98\x8e\x71\x4a\xfb\x23\xa2\x4c\x27\xd0\x5e\x31\x18\x10\x49\x04\x88\xf7\x72\x9d\x99\xf8\xe3\xed\x35\xfa\x2e\xf1\xb8\xf8\x9e\xea\x86\xc2\xd0\x4c\x8e\x23\xcd\x91\x19\x85\xd8\x90\x24\xba\x3e\x34\x02\xdd\x38\x73\x98\xb1\x44\xb8\x45\x21\xb5\x56\x4b\xa7\x08\xbc\x49\xb6\x5b\xa4\x26\xae\x65\x85\x71\x8c\x8a\x29\x9b\x51\x41\x50\x42\x07\x83\x9c\x52\x2a\x71\x18\x72\x90\xc2\x91\x02\x63\x27\x54\x77\xc6\x51\xa4\xa0\xd3\xd9\xd8\xe0\x3c\x2a\x2b\x3c\xe7\xca\x5b\x8b\x57\x18\xe4\x70\xcc\x3a\x24\xba\x7a\x5c\x0a\x9c\xc0\x9a\x92\x56\x81\xb8\xf1\xa5\xd0\xff\xa9\x28\xa1\x02\xec\x2b\x3e\x25\xa7\x73\xa6\x8d\x27\xe0\x6f\x03\xc6\x8d\xa4\x30\x97\x58\x02\xdf\x05\x26\x39\xe5\xe8\x11\xdc\x6d\x11\x47\xb9\xbe\xdd\xe0\x5a\x5b\x1b\x45\x51\x89\x09\x47\xdf\x3c\xc6\xfa\x9e\xe3\xe8\xe4\xc4\x5c\x74\x5f\xeb\x8b\xee\x5f\xdf\x58\xe0\xea\xff\x21\xe3\x8c\x25\x4d\x11\x46\x9b\x2c\x2f\x57\x45\x02\x4a\x87\x38\x00\x7f\x11\x79\x2a\xb8\x62\x1f\x55\x40\x6e\xf2\x8f\x39\x2f\xe3\xa9\x95\xb2\x67\x51\x82\x51\x20\xa1\x4c\x80\x67\xa4\x42\x79\xf1\xc6\x24\xc6\xa5\x55\x7c\xf9\x18\xca\x69\x63\x3b\x33\xa1\x5e\x19\x97\x46\xf7\xd5\xc0\x91\x89\x4c\x6e\xca\xce\x4a\xa9\xc3\xbb\x9d\x15\x1b\x57\x47\x5c\xee\x23\xc4\x05\x53\xcf\x3d\xff\xa0\xfd\xdb\x61\xd3\x6c\x22\x89\x92\xe6\x7d\xb4\xdb\x11\x70\x00\xb2\xeb\xf4\xc9\x25\x80\x2e\x4f\xbd\x1a\x1d\x5d\xda\xda\xde\xbd\x63\x52\x74\x5f\x8d\xd1\x74\xaa\xb2\xad\x8d\xf9\x44\xff\xf5\xdb\x68\x5e\xa3\xc6\x63\xd2\x50\x56\xfb\x32\x32\x43\x3d\x7a\x44\x11\xe1\xb4\xd6\xa5\x13\x41\x95\x19\x52\xe9\x8c\xfa\x93\x9b\x92\xe4\x54\x45\xf5\x76\x90\xd4\x15\x92\xfa\xd4\x6b\x6a\xcc\x0e\x30\x0f\x43\x54\xd2\x3c\x92\x2c\x5b\xa7\xec\x75\xbe\x58\x2a\x5f\xa7\x2f\x49\xe2\x29\x31\x2b\x3c\xa7\x2c\x67\x20\xa6\xc9\x8c\x94\x4e\xd9\xb3\xd6\x60\x98\x93\x42\x63\x90\x4d\x05\x5d\xdc\x41\x4c\x61\x80\x2a\xce\x89\x5d\xd3\xd4\xfc\xb8\xb0\x00\xe5\x60\x4e\xec\x34\x5e\xb5\x6d\xce\xa3\x14\x6b\xfc\x9c\x55\xae\x5d\x54\x55\xc6\x7c\x0a\x3b\x3c\xb2\xd4\x78\x44\x3d\x0c\x8f\x28\xb2\xd4\x78\x24\x9b\x2e\x67\x54\x4d\x97\x46\xdf\xe4\xd9\x6a\xd4\x26\x20\xb2\x61\x02\x72\x85\x24\xb6\x36\x19\x37\xd4\xb3\xd7\x5c\xe8\xbe\xe5\xc3\xfa\x96\x64\xa1\xfb\xbe\x99\x2e\x66\x94\xb5\xa0\x40\x4e\x17\x33\xb2\x42\x40\x28\x2d\x76\x24\xf3\xe8\xaf\x9b\x0a\xd5\xb5\xeb\x90\x0c\xef\x48\x89\x9d\x55\x22\x2d\x35\x47\x65\xfe\x26\xfb\x3e\x6b\x25\xde\x6e\xa5\xb5\xe8\x2b\x77\x3b\x3c\xd6\x17\xcc\xf2\x77\x08\x29\x82\x3f\xfc\x21\x35\x47\xe6\xcd\xfa\xaa\x4c\x65\x7e\xc5\xe4\x71\x30\x60\x2d\xab\x77\xb8\x19\x08\x27\xd2\xa8\x0c\x0b\x2a\x07\xc1\x71\x61\xc5\x76\x65\x40\x12\x48\x60\xb7\x8c\x1b\xbb\xec\x80\xe4\x90\x52\xba\x46\x83\xca\xa9\x85\x6e\xf6\x8f\x36\x02\x55\xaf\x9a\xca\x19\x6d\xd8\x19\x28\x7c\xdf\xc1\x86\x53\x55\xb9\x80\xeb\x16\x98\x6e\x61\x53\x0f\x03\x4e\x2a\x80\xb6\x1b\x87\x49\xc9\x67\x3b\xc2\x76\x35\x9a\xfb\x35\x2f\x8a\x73\xa0\x04\x7d\xd5\xb7\x2e\x59\xcc\x80\xc9\x31\xcd\xd0\x61\xab\xce\x6b\x96\xb2\xfc\x96\xed\x61\x39\x5b\x7e\x30\xf0\xca\x3f\xcf\xb3\x77\xe0\x71\xda\x8d\x18\xc6\xb6\xbb\x7d\x0a\xa3\x46\x42\x0a\xb1\x69\x02\xc6\x38\x3b\xc2\xa7\xf9\xac\xc3\xdd\x07\x9a\xf2\xd4\x89\xba\x45\x63\x0b\x8f\x7d\x4b\x13\xa5\x27\xa6\xa0\xbb\xbc\x50\x4c\x76\xf6\xd6\xa7\x94\x99\xbe\x5a\x86\xee\xdd\xd0\x90\x94\xea\x35\x60\x3e\x66\xe4\xc9\x16\x10\x0c\x68\x2c\x13\x9e\x15\xcc\x6e\xa2\x59\x07\x07\x23\x6b\xde\x0d\x25\x87\x00\xc4\x42\xc5\x0b\x23\xa0\x02\xe1\xe8\x41\xa8\x00\x5c\x6b\x5b\x9a\xca\xd9\x04\x59\x30\xd1\xb3\x6f\x66\x45\x35\xd4\x10\x86\xe3\xcd\xae\xb9\x75\x9d\xd0\xe1\x55\x0f\x43\x64\x21\x6b\xaf\xe1\x6a\x76\xc8\x82\x05\xc6\x0f\x04\x23\x33\x95\xbd\x9e\x0c\x5b\xc9\x14\x4c\x1d\x3d\x74\x4a\xed\x4e\xdf\xf1\x9b\x4e\x88\xcf\xbd\xb9\x20\x4c\xdc\xac\x8c\xad\xaf\x86\xbb\x64\xd6\x76\xc0\xd2\x04\x91\x19\x94\x1e\xd1\xb4\x98\x59\x10\x19\xb7\x46\x6a\x37\xb1\x98\x51\x7d\x51\x1a\xc8\xd2\x88\x4b\x02\x65\x5c\x58\x5a\x1b\x68\xd9\x1c\x68\x5b\xb0\x17\xcd\xa3\xc4\x18\x3c\xa1\x4d\x7d\x8c\x75\x62\xdb\x2a\xc9\x3b\xf7\x3a\x7b\x5f\x7f\xf3\x29\x93\x9f\xfb\x8c\xb6\x64\x6d\xb4\x75\xc8\xe8\xab\xa2\xdd\x87\xe0\x1b\x6a\x89\x77\x7b\xff\x89\xca\x68\x0b\x6c\xb3\xc4\x41\xdb\xac\xfd\x9c\x85\xe8\x4c\x04\xdb\xac\xae\x0c\x67\x9b\xb5\x9f\x57\x32\x05\x04\xe8\x4b\x96\xdc\xb2\x9f\x84\xe8\xaa\x9f\x97\xcf\x40\xcc\xb2\x9f\xb5\xc3\xb8\x32\x37\xf2\xe7\x53\xdb\x9a\x1a\xdb\xae\x66\x8f\xce\xde\x74\x3f\xcb\x9a\x99\x5a\x4b\x2e\x6b\x67\xda\x55\x52\x5f\xa4\x2e\xfd\x93\x9b\xd8\xd6\x8e\x7d\x9e\xc3\x6e\xbb\x69\xeb\xf2\x0d\xe6\xa8\x63\xc7\x33\x3e\x3d\xd1\x1c\xa5\xc0\x70\xef\x3a\xde\xff\x53\x8c\xdd\xa1\x71\x7d\xfb\xb5\x8d\xac\x60\xf8\xbc\x93\xc7\x9a\xd1\xeb\xe6\x55\xee\xd1\x26\x38\xb6\x70\xf4\xc4\xca\x3c\x41\xe8\xf9\xcf\x32\x80\xd6\xc7\x96\xb2\x48\xdf\xc5\x6b\x29\x19\x57\xce\xc4\x1a\xbc\x28\x17\x4c\xbd\x2b\x81\xa9\x99\xe7\xf2\xc6\xa4\x17\xd4\x88\xb9\xaa\x82\x25\xad\xfc\xed\xaa\xb4\xb5\xae\x2c\x48\x46\xc1\x50\xf4\xa5\xe1\xc7\x3d\xff\x17\xfb\xe3\x4a\x5f\xc1\x4b\xfd\xcf\x8d\xfe\x67\xd1\x61\x39\xb3\x0a\xc3\x55\x64\x20\x08\x3c\x9f\x2f\x5e\x5d\x4c\x6e\x2a\xc5\xcf\x4a\x37\x8f\xe3\xb9\x97\x34\x37\x49\x47\xa3\x1d\xb9\xec\xb8\x46\x17\x08\x8f\xe7\x94\x91\x79\xa3\xd1\x77\x6f\x7e\x9a\xdc\x78\xd2\x98\x9b\x4a\x13\xad\x06\x23\x4c\xa6\xd0\xe8\x0c\xc7\x8d\x5a\x56\xc1\x0b\xc4\xa2\x9a\x51\xd3\x31\x59\x76\xda\x86\x55\x66\xb7\x73\x7d\xc9\x93\xdb\x2e\x38\xb8\xea\xba\xcf\x5d\x1e\xbd\x7a\xd0\x85\xde\x69\x58\xbf\xbc\xa7\xe1\x25\x5d\x3e\xa8\xe1\x8f\x2d\x43\x60\x34\x24\x22\x2a\x84\x58\x3d\x2b\xef\x78\x8a\xd1\x95\x93\xba\xb4\x45\xfa\x68\x48\x92\xda\x8a\xfa\x6a\xaa\x66\x84\x75\x58\x9a\xf7\x8c\x2e\x88\x4d\x34\xe1\x11\x73\x84\x9b\xe8\x00\x6f\x78\x18\x76\x98\xca\x73\xd4\xd9\x98\x42\x7d\x50\xc9\xe2\x1d\x8e\xbd\xdf\x3b\xf2\xa1\xa9\x0b\x00\x23\xef\x74\xdf\xea\x19\xa3\x39\x61\x78\xbb\x5d\x1d\x2e\xb0\x82\x02\x68\x45\x19\xf9\xe8\x0f\xc2\xe8\xbb\x56\x60\x43\x0a\x3f\xac\xd0\x1a\xae\xd3\x36\xd0\x39\xc9\x0b\x1a\x12\xe9\xf9\x41\x63\x34\xc7\x44\xec\xa7\x32\x3c\x16\x94\x52\x6e\x47\xd5\xb4\xb1\xc7\x68\x6e\xad\xa8\xad\xe2\x14\x44\x31\xae\xc7\x0a\x5c\xf1\x8e\xb5\xcf\xd3\x25\x78\x18\xec\x1d\x08\x58\xb6\x02\xe2\x52\xec\x97\xa8\x80\x1f\x4a\x95\x55\xa9\xca\xf1\x6b\xae\xb1\x5a\xb3\x23\x33\xdb\x84\xb6\x0f\x2b\xc9\xbc\x24\xc0\x18\xd6\x7b\x3d\x09\x43\xf8\x9b\x85\xe1\x1a\x25\x47\x19\xde\xed\x34\xb5\x73\xdd\x05\xe4\x1f\xd0\x4b\xc4\x88\x5d\x56\xbc\x23\x67\xf7\x15\x72\x6b\x81\x77\xe4\xb4\x03\xe9\xac\xd1\xd1\x08\xef\xc8\xab\xce\x2c\x9d\x73\xd1\x91\x03\x72\x71\x99\xf0\x4c\xdc\x20\x5c\xb9\x45\xa0\x47\x4f\xb0\xf3\x97\x3c\x21\xd9\x76\xfb\x04\xef\xc8\x79\xc7\xd8\x3a\x36\x7b\x47\x5e\x76\x86\x32\xf8\x9d\x0e\x27\x17\xc8\x71\xab\x00\x3d\x4d\x97\x1a\x6c\xaf\x36\x67\x01\xbb\xd9\xbf\x12\x62\x45\x0c\x63\xf8\x03\x04\xe5\x89\x6f\xed\x67\x7c\x47\xc0\x97\x1d\x42\x24\xbd\x15\xf1\x07\x63\xa4\x7e\x5d\x11\x3e\x67\x9a\xd0\x59\x3b\xc2\xe6\xd4\x23\x64\x5e\x59\x09\xcd\x9f\xd9\x5d\x7c\x41\xea\xd9\xc7\xfe\x52\xd8\xf4\x9f\x24\x9b\xc7\xe7\xa4\x39\xec\xf8\xe5\x6e\xe7\x5d\xd5\xe5\x67\x1b\xd8\xa4\x09\x7f\x57\xb2\xe7\xaf\xce\x69\x1f\x79\xa2\x84\x0a\xc5\x18\x5b\x87\xed\xb6\x6f\x8d\x1e\x32\x91\xc2\x8a\xee\xa7\x34\xf9\xfe\xcf\x24\x66\x88\x8a\x16\x20\x26\x6a\x5e\xa4\x54\x35\xee\x5a\xe3\x57\x23\x6b\xcb\x1c\xd5\x75\x45\x9b\xd4\xd6\xe6\xb5\xa3\x9c\x8c\x6c\xd8\xa7\xd1\x37\x86\x3a\x79\x3c\x04\x31\xb4\x26\x56\x34\xb5\x71\xf2\xc4\x10\xe8\xd5\xf2\x84\x61\x1f\x20\xf4\x3e\x73\x11\x8c\x1a\xa4\x49\x1d\x16\x43\xa3\x82\x6b\x76\x57\x43\x9f\xd8\x83\xbe\x9a\xd8\xb4\xcb\xea\x50\x6e\x1d\xca\xc1\x52\x9c\xed\x7c\x1b\xdd\x01\x7c\x9d\xda\x79\x10\xee\xc1\x90\xa3\x6a\x82\x86\xa4\x88\x24\x4b\x32\x60\x5a\x30\x52\x38\x36\x8b\xb2\x23\x96\x1e\xd1\xbb\xb6\xee\x5e\xbe\x3d\x82\xcb\xae\xa7\x92\x77\x9b\xca\x34\xc9\xda\xef\x18\xf4\xbb\xdd\x6e\x76\xd6\x8e\x5b\xe1\x8d\xe6\xe5\x9c\x88\xa8\x84\xb3\x0d\x2e\x71\x5d\xdb\xd7\x29\xa6\x54\xce\x82\x28\x35\x45\x21\xd2\x10\x69\xc3\xc3\xfe\xda\xfb\xf7\x12\x6c\xe0\x41\x5b\x1c\xbd\x20\xdb\x2d\x43\x25\x72\x5e\x38\x78\x37\x86\x2a\x6d\x3b\x32\x6c\xc7\x42\x82\x95\x6d\x20\x20\xca\xb9\xb6\xef\x23\x46\x86\xd6\x08\x57\x88\xa5\x97\xc2\xed\x75\x5f\xab\xcb\x0a\xa2\x20\xdc\x9d\xd7\x22\x54\xec\xb0\x2f\xeb\x1e\x11\x71\x5d\xdd\x5b\xa3\xd9\x5b\xcb\x84\xa8\x0a\xe4\x67\xaf\x56\x61\x28\xda\x71\x85\x6e\xcd\x5d\x5c\x44\x65\x72\xcb\x2c\x5c\x09\x3d\x68\x65\xa4\x84\x62\x47\x80\x00\x6a\x22\x76\xc3\x5f\x34\x4e\x76\xc7\x65\x95\x35\x68\x1b\x0f\x16\x5a\xd0\x56\x59\x92\x21\xe6\x34\xe4\x3b\x6b\xcb\xd7\x44\x26\xff\x40\x1f\xb6\xa9\xce\x6e\x16\x82\xb6\x98\xaf\x56\xe5\x85\xa6\xe9\x3e\xd7\xa5\x8b\x08\x13\x45\x8f\xa4\xc6\x8d\x1b\x29\x4c\x5c\x34\xc0\xb2\x15\x0d\xb0\xfc\x97\x8c\x06\xb8\x36\xd1\x00\xed\x2c\x66\x24\xa3\xc3\x71\xf9\x59\xd1\x00\xd7\xd3\xcc\x46\x03\x2c\x3f\x1d\x0d\xb0\x6c\x45\x03\x2c\xf7\x1c\x21\xd8\xbd\x71\xeb\x3c\x25\x6c\x13\xf4\xa7\xa3\x19\x78\x8f\x4a\xca\xa6\x8f\x40\xa3\xda\xaf\xd5\xa9\xfa\x53\x75\x2a\xdb\xae\x94\x48\x9c\x7f\xa4\x40\xd2\xd1\x1e\x53\x5e\x71\x59\x32\x2a\x21\xf0\x45\x79\xc0\x5d\x27\x38\xfe\xea\x3f\x7a\xa6\xc8\xbb\xd7\x2f\x69\x30\x70\x15\x5e\x0b\xa1\x06\x6c\x10\xf4\xbe\x3a\x0e\x76\x18\xbb\xf6\xa6\xc5\xcc\xb9\xd0\xbc\xe7\x81\x53\x3e\xea\x0e\xbd\xc4\x4e\x59\x79\xdd\xd1\x79\xb2\x5a\xe5\x7c\xa1\xfb\xcb\x12\x95\xc4\xc9\x6a\x55\xe4\xe6\xfc\x1c\xff\x56\x0a\x3e\x4e\x97\x89\x2c\x99\xa2\x6b\x35\x3f\xfa\x76\x7c\x95\x94\xec\xc9\x63\x12\x0c\xf4\x6c\xd1\x9a\xb3\x32\x4d\x56\x0c\x75\x38\xf3\xff\xe9\xcd\xab\x9f\xad\xa4\x23\x9f\xdf\x69\x24\x80\xb1\x9d\x41\x87\x7f\x56\x85\xbf\xa7\x5e\x14\x1d\x47\x53\x1e\x70\x30\x69\xae\xa2\xaa\x63\x14\x29\xc2\x2a\x3d\x85\xd2\x64\x61\xf0\x87\x1b\x96\xe5\x49\x2f\x18\xe8\xcf\x41\xb0\x09\x06\x62\x10\xec\x82\x58\x78\xce\x51\x60\xb8\x49\x9b\xea\xf0\x7d\x0e\xcc\xc4\xac\x9b\x02\x42\x60\x36\xd4\x51\xa5\x3a\xa6\x9b\x1d\x91\x74\x38\x96\x4f\x61\x7c\x56\xe3\x2c\x9d\xc6\xb9\x00\xd9\xe2\x54\xce\xa6\xc3\xd9\x38\x30\x52\xbd\xba\x69\x08\x5d\x35\x2d\x66\xb4\x3f\xc4\x3b\xdd\xa4\x69\x89\xed\x35\x93\x50\x36\x95\x1d\x0d\x24\x10\x6d\x46\x4c\xf5\xdf\xd9\x76\x8b\x78\x18\xf6\x13\xa0\x8a\xa7\x27\x33\xca\x63\x8d\xba\xe1\x67\x80\x82\x41\x02\x0b\x81\xe1\x80\xa3\x60\xc0\x07\x01\x0e\x30\x51\x36\xae\x1f\x88\x34\x55\x07\x0a\x6b\x62\xad\xb6\xb5\x1d\xeb\xb0\xb6\x63\x53\x3e\x23\x92\x5e\x4d\x45\x94\x67\x33\xeb\x48\xa5\xaf\xa8\x79\x39\x18\x54\x4b\x57\xd0\xe1\xb8\x78\x2a\xa3\x55\x22\x6b\x55\x7d\x31\x18\x60\x9b\x34\x2d\x66\x48\x54\x3f\xcd\x92\x8f\x8b\xa7\xe2\x60\x05\x33\x91\xb5\x57\x09\x04\xb5\x80\xac\x5c\xa7\x89\x89\x77\x34\xec\x6e\x28\xe9\x6e\x62\x6c\x67\x42\x37\x79\x16\xeb\x5f\x44\x4f\x25\x1e\x11\x28\x14\x27\xbb\xdd\xbe\x45\x6c\xbd\x4a\xd3\x19\xf1\xa0\x64\x7f\x6f\x0b\xd8\x5b\x08\x13\xa6\x8f\xda\xa4\x98\x0e\x67\x03\xf3\x3b\xd6\xbf\x49\x4e\x8b\xe9\x68\x46\x52\x5a\x4c\x4f\x66\xa4\xa4\xc5\xf4\xd1\x8c\xac\xe9\x26\x2d\xcb\x38\x27\x00\xe6\x71\x4a\xaa\xb3\x1d\x97\xbb\xb1\x98\x26\xb3\x89\xfe\xa7\xb1\x32\x38\x76\x31\x1d\xa7\x89\x99\x4c\x62\xa7\x30\x5d\xcf\x6a\xe7\x6b\xbe\x67\x80\x6b\x26\x72\x83\x58\x94\xf3\x92\x49\xf5\x82\x2b\x63\x41\xd3\xe7\x9e\x5d\xab\xb3\xf0\x15\xeb\x22\xe3\x5f\xda\x90\xa9\x49\xaf\x54\x77\x05\xeb\xa9\x44\x2e\x98\x8a\x7a\x6f\x97\x79\xd9\x5b\x49\x71\x95\x5c\x15\x77\xbd\x1b\x96\xf0\xd2\x04\x47\xd5\xb7\x11\x98\xf3\x55\x77\xd3\x97\x75\x67\x5f\xf6\x40\x7d\xcb\x14\x93\xbd\xbc\xec\xe5\xfc\x36\x29\xf2\xcc\x05\x48\x15\xf4\x76\x7a\x5b\x07\x04\x02\x4d\xaa\x12\xab\xc0\x1a\xbb\xeb\x46\x9e\x29\x2c\x26\x22\xe2\xec\xa3\x7a\x93\x5f\x15\x39\x5f\x4c\xb8\xcd\x32\xac\x1e\x52\xa4\x91\xad\xd7\x2a\x59\xad\x18\xcf\x40\x83\xa7\x29\xe9\xbd\x0a\x3c\x9a\xe7\xb2\x34\x2a\x3e\x4c\x6e\xcd\xda\x2a\x2f\xd4\xcc\x95\x50\x4a\xdc\xb4\xc6\xd1\x6e\xd7\xdc\xa6\x9e\xf6\xb7\x32\x1f\xae\x2b\x6d\xb7\xfd\xfa\x23\x32\x11\x63\xf7\x57\x7e\xfa\x06\x96\xfa\xa5\x48\x32\x26\x67\xef\xf9\x7b\xde\x7b\x61\x56\xca\x5b\xd9\x7a\x21\xbf\x74\x0d\x7e\xd9\x43\x5f\x0a\x20\x23\xca\xaa\x93\x2f\x71\x6f\x2e\xd6\x3c\x8b\xde\xf3\xde\xf9\xba\x04\x17\xe0\x2f\x95\x58\x7d\x49\x7a\x5f\x9a\x69\x7d\x49\x7a\x42\xba\x60\xcc\xef\x79\xcf\xc4\x03\x8c\x8f\x8f\x17\xb9\x5a\xae\xaf\xa2\x54\xdc\x1c\x7f\x60\x57\xab\x24\xbd\x3e\x4a\x05\x57\x32\xbf\x3a\x06\x58\x38\x82\xc0\xb7\xf2\x3f\x4c\x57\x89\xc2\xfa\xd2\xb2\x4e\xe3\x4d\x18\x1b\x04\xbd\x60\xb0\x3f\xf1\xf1\xde\x4e\x48\x3f\xec\x51\xd2\xf2\x79\xd6\x67\x80\x71\xf5\xb3\xc8\x58\xed\x0d\xeb\xa7\x5a\xe2\xd9\x6c\x88\x0d\x60\xa5\xe8\x6d\x2d\xaf\xc1\x63\xf5\x3d\x1d\x86\xe1\x2d\xb8\xc7\xa6\xba\xc7\x11\x6e\xc5\x59\x32\x95\xba\x39\x65\x14\xc0\xc4\x6b\x2f\x69\xf0\x4e\x91\x25\x04\x63\xa5\x81\x62\x1f\xd5\x71\x5a\x96\x01\x29\xf5\x45\x66\xf2\x30\x31\x87\x8f\xa8\x56\xa4\xd5\xfb\x3b\x2a\x72\x7e\xfd\xe9\x7e\x5c\xba\x64\x05\x35\x63\x2b\x97\x8c\xa9\x4f\x0e\xa0\x34\xf8\xa0\x19\x0b\x62\x5f\x1a\x0c\x61\xb5\x4b\xa6\x9e\x29\xbd\xeb\x6b\xc5\x10\x27\x6a\xca\x41\xfb\x5b\xb5\xb5\xf6\x70\x8b\x89\x28\x0d\x84\x56\x1d\x34\x10\x8c\x98\xca\xd2\x7a\x17\x14\xd4\xcb\x42\x26\xa7\x8a\xb8\xe5\x51\x0b\x9a\xfc\x4b\xcb\x92\x16\x3b\x68\xad\xcc\xf9\xa2\x60\x4a\x58\x75\x48\x49\x2f\x07\x83\x31\xa7\x8b\xed\x16\x2d\x68\x8e\x14\xc6\x44\xd0\x2c\xba\xca\x79\x66\xcc\xe6\x38\x29\x09\x78\xc9\xee\xa7\x0e\xad\xe8\x8f\x45\x15\xb2\xed\x24\x0b\xdf\xbd\x7e\x79\x28\xdd\x6e\x97\x59\xc0\xfb\xca\x49\x76\x2b\xae\x3f\x51\xee\x87\x42\x5c\x1d\xa4\x4b\x27\x88\xd3\x54\x73\x14\x82\xae\x1a\x13\x51\x7a\x6e\xde\x82\x25\x88\x63\xc2\xa3\xa5\x64\xf3\x30\xec\xe8\x1a\x99\x3c\xbc\xc3\x31\xe2\xb0\x64\x44\xd0\xb9\xdf\x64\x47\x83\xbb\x0a\x04\x45\x43\x28\x6f\x84\xc4\xf6\x5f\xd8\x27\x88\xf9\x56\x96\x61\xa8\x22\xb8\xca\x20\x01\x7e\xe9\xa4\x6a\xa1\x8d\xb3\x8c\xfb\xb2\xfb\x3e\x16\x88\x51\x65\x77\x45\x22\x1f\x09\x64\x8e\xc5\x32\xfb\x2e\x29\x9f\x04\x41\x2c\x74\x57\xe0\xe0\x1f\x01\xd4\xbf\xd1\x50\x8f\xfd\x0f\x5d\xe0\x2d\xfb\xa8\xe8\x47\x40\x2b\x35\xab\x53\xb4\x8f\x9c\x2e\xa5\xb1\x07\x92\x98\x24\x10\xb8\x2e\x2f\x32\x9d\x50\x8e\x93\xa9\x9a\x69\xe8\xf5\xf1\x8a\x4e\xc3\xc4\xc5\x44\x99\xb0\x26\x0a\x2b\x08\xe4\xc7\xac\x71\x39\x14\xfe\x8c\xe6\xfe\x5d\x0c\xe3\x04\xab\x2b\x58\x2a\x08\x1c\x63\x62\x91\x78\x87\x2e\x80\xbc\x80\x08\x4c\x3e\x3d\x5f\x3e\xae\x08\xa5\x31\xf3\x2e\xb6\x31\x6e\xe1\x47\xff\xd2\x1b\x37\xc7\x7b\x68\x85\x38\xf6\x27\xb2\x6a\x05\x18\x85\xa9\x40\x00\x5e\xb7\xbd\x55\x44\x4a\x88\xa0\x92\x0a\x7e\xcb\xa4\x7a\x2b\x9e\x5d\x95\xa2\x58\x2b\xf6\x4e\x16\x65\x18\xca\x31\x3a\x90\xb7\xdd\x16\x10\x34\x90\xde\x21\x81\x4d\x94\x53\x31\xa0\xc1\x7b\xfe\x19\x3c\xd0\xef\xe6\x7a\x64\xcd\xf5\x38\x03\x5c\x7d\x45\xeb\xb3\x8a\xa6\x62\x46\x36\xfa\x8c\xc6\x35\x2e\xde\x61\x92\x53\x06\x47\x6c\x6c\xfe\xd0\x0e\x3c\x81\x12\x4c\xf2\xee\xe3\x99\x1b\xab\xe0\x2b\x4d\x63\x76\x04\xd1\x1d\x1f\x0c\x23\x50\x2f\x31\x84\xb2\x65\x07\x5c\x3d\xc0\x19\x65\xbf\xb6\x11\x80\x84\xa1\xdb\xf3\xfa\x57\x94\x14\x45\x18\x3a\xd9\x71\xa2\xc4\xd5\x0e\x93\x9b\x0e\xc6\xaf\x8a\x45\xd1\xf3\x6f\x8e\x86\xdc\x43\x5f\x1a\x55\xe8\xf2\xda\x8a\x9b\x9b\x50\x49\xbe\xc3\x99\xed\xee\xa7\xb7\xe7\x2f\x5f\xfc\xa8\x49\x1c\x27\xa5\x56\xf2\x6e\x23\xa8\x30\xb6\x25\x5c\x3d\x77\xa3\x5c\xb2\x24\xab\x83\x50\x08\x50\x62\xed\x20\xc0\xab\xd8\x55\x9c\x24\x9f\xf9\x93\xf7\x84\x4a\xae\x95\xbf\x41\x80\x66\x56\xb0\x54\x09\x69\x62\x43\x2d\x8c\x3e\xec\x92\x0e\xc9\xad\xa6\xfe\xef\x28\x47\x4f\x86\xb8\x2b\x46\x49\xe5\x3b\x56\x8b\xe5\x2b\xea\xef\xf9\xd9\x0f\xef\xfe\x18\x86\xf6\xcf\x1e\x71\xe8\x46\xb0\x4f\x06\xbe\x5d\xb2\x9e\x4f\x68\x79\x41\x5c\xd6\x25\xcb\x7a\x39\xef\x25\x20\x1d\xba\x92\xe2\x43\xc9\xa4\x2f\x18\x0a\xf0\xd8\x46\x82\x24\xca\x90\x01\xb4\x36\x4a\x74\x3e\x67\x26\x63\x62\xff\x82\x82\xbd\xbe\x67\xb7\x5b\xff\xd6\xa5\x4b\xa4\x21\xc8\xa3\xe9\x20\xbf\xfe\xa4\x81\xde\x87\xa0\x2e\xa3\x89\x5d\x54\x7f\x50\x47\x44\x3b\xe1\xab\xe1\xaf\xea\xdb\x05\x2e\xb4\x2e\x63\xf0\x42\xaf\x7d\x42\x87\xe3\xe4\xa9\x8b\x18\x35\x4e\x1c\xd3\x95\x53\x3e\x4d\x34\x4f\x75\x35\xcd\x81\x49\x4d\x81\x3d\x3d\x3a\x22\x85\x21\xe3\x53\xac\xe9\x07\x0d\x18\x48\x5a\x32\xc8\xb0\xe8\xe6\x48\xeb\x56\x8b\xbd\x56\x35\x8b\x96\x54\x06\xf9\xa6\xc9\x7a\x40\x25\x1d\x8e\xcb\xa7\x69\x93\xed\x2c\x07\x03\x6c\x93\xa6\xe5\x0c\xe1\xb1\x7d\x45\xe0\x6a\x9a\xea\x71\x69\xd6\x12\x26\xfe\x71\x5f\x3c\x5f\xcb\x4d\x7c\x85\x74\x2d\x93\x9e\xaa\x19\xe5\x84\x39\xdd\xf7\x0f\x42\x14\x2c\xe1\x0d\xf1\xd1\xee\x93\xcf\x9d\x34\x65\x49\x95\xa1\xbd\x06\x22\x50\xfe\x3e\xf8\x31\x96\x3a\x0c\x5a\x15\xef\x65\x8f\x67\xae\x85\x73\xfe\x44\x54\x65\xa4\xea\xc4\xbc\x9a\x06\xb1\xa6\x3c\x79\x94\x60\xd0\xc3\xdf\xd6\xc6\xc8\xb7\xce\x14\xf9\xd6\x1a\x22\xb7\xdc\x31\x38\xde\x9c\x85\xe1\x59\x55\x41\xd3\x16\x93\x0c\x9d\x11\x8e\x63\xdb\x6c\x69\x9a\xf5\x95\xde\xf0\x9c\xce\x44\xf7\x1d\x8b\x49\x86\xd6\x10\xd0\x86\xd4\x26\xc6\x6c\x87\x75\x03\x46\xaf\xdf\xa4\x43\x1a\x53\xe3\x44\x1a\xf6\x64\xbb\xad\x64\x8c\x02\x52\x6d\x58\x6b\x94\xea\xce\xbd\xae\x81\x8a\xe1\x13\x85\x38\x8e\x95\xa5\xb9\xf4\x3f\xb7\x74\x6d\x1e\xbd\xd8\x78\x36\xef\x62\x87\x1b\x03\x30\xef\xff\x6c\xd8\x44\x21\x56\x57\x6f\x86\x67\x42\x85\xee\xf1\x96\x30\x4d\xc9\xc8\xa8\x60\xc9\xad\x79\x1c\xa2\x24\x39\x95\x91\x51\x1d\xd8\x84\x92\xca\x88\x71\xc5\xa4\xf9\x1e\x5f\xa3\x84\xdc\x6a\xc2\xa6\x6d\x62\xc1\x1a\x0e\xd5\xa5\xc7\x4d\xed\x6a\x96\xe1\x06\x93\x0f\x28\x27\xb7\xcd\x95\x36\x0b\xa4\x1a\x0b\x04\xd4\xd8\x47\x54\x12\x3d\x1d\x7f\x82\xf3\xfa\x42\x79\x70\x94\xc3\x30\xf4\xbf\x6a\x6e\xe9\xf2\x32\xcf\x2e\x2f\xb7\x5b\x05\x76\x05\xe6\x8b\x9e\x0e\x06\xb8\x41\xbd\x78\xe1\x01\x0e\x04\x61\x7a\x35\xd5\xa3\x9a\xe9\x89\x1e\x5a\x95\x1e\xf3\x27\x71\x65\x76\xe9\x7e\xe8\xab\x78\x5a\xd1\xf4\x59\x42\x78\x7c\x46\xbb\xe0\xb1\x92\xb5\x49\xba\x42\xcd\xad\x3e\xc3\xfe\x36\xeb\x7d\xb7\x0a\x42\x13\xdd\x57\x56\x62\x3d\xe8\x30\x09\xc3\xfc\x69\x3a\x1e\x0c\x72\x9c\x50\x39\xcd\x67\x9a\x27\xe6\x28\x69\x6c\xc4\xd2\xb8\x65\xb9\xd3\xe7\xc7\x57\x5f\xd5\xa9\x44\xb9\x9e\x38\x1d\x82\xb2\xc9\xf6\xe4\x04\xaf\x0d\xef\xe9\xa7\x62\x3c\x18\x70\xac\x40\xa6\x88\x6a\x11\xaf\x47\x4e\xde\x78\x9a\x40\x60\xd5\xc3\x10\x59\x24\xfa\x6a\xaa\x66\x44\xa0\x57\x78\xbb\x45\x17\x61\x88\x2e\x10\x26\x17\xc6\x1a\x94\x9c\x87\x21\x3a\x47\x98\x9c\x9b\x6f\xec\x4d\x64\x81\x2a\xf2\x54\xd2\xbe\xae\x4f\x0a\x3a\x47\x4a\x73\x80\x55\x9c\xb3\x69\xa1\xb1\xab\xa6\x2d\x2f\xf4\x1c\x3c\xab\x01\x74\xa5\xe9\x6d\x3f\xe5\x1d\xd7\x37\xb1\xee\x90\x76\x65\x20\x08\xe0\xed\xe1\xf7\x1b\xa4\x7c\x82\xf9\x12\xb5\x30\x08\xde\xdc\xfa\xc8\x0b\x50\x03\x20\x04\x1c\x4b\xc4\x1b\x88\x43\x9f\x25\x8b\x3a\xc4\xa4\x8e\x1a\x2f\x70\x2c\xc3\xd0\x56\x93\x7a\x1b\x1d\x91\x65\x86\xe7\x45\x74\xac\xbb\xf2\xba\xe1\xa8\xcb\xa2\x4e\xdf\xf5\x02\x5a\xba\xd5\xd4\xe8\x5d\x15\x80\x4b\xc3\x1c\x26\x1f\xe9\x5d\x24\xd7\xfc\x4c\x63\x8f\x9f\x84\xb8\x2e\xc9\x07\x93\x72\x0a\x08\xc6\x24\x5d\x9b\xa4\xca\xca\xb3\x24\x67\x0e\x60\x4e\xe9\x88\xbc\xea\x0a\xe3\x4b\x2e\x5c\x91\xf3\xca\x07\xcf\x98\x78\x54\x06\xa1\x9c\x40\x50\xf0\x58\x36\x0c\x3c\x74\x2f\x39\x5f\xc0\x21\x88\x17\xce\xd8\xe3\x72\x67\x2c\x7b\xab\xd7\x97\xd0\x77\x10\xec\xf5\x3b\x6c\x0c\x08\xbe\x31\x36\xbe\xe8\x9b\x91\xb1\x57\xfc\xe6\xc4\xb8\xb1\x7d\xf3\x08\x93\xf5\xbf\xbe\x9f\xda\xef\x0c\x07\xd1\x21\x67\x60\x91\x5a\x32\x63\x06\xfd\x39\x41\x26\x1a\x21\x56\x24\x2a\xec\x25\xb6\x6f\xe0\xab\xa2\x43\x9b\x55\x5b\xfa\xaa\xc8\xfd\x84\xfb\x77\x8f\xa8\x70\xfc\x72\xc3\x55\xc9\xfa\x27\xc9\xca\x6b\xa9\xbe\x02\xaa\x73\xa5\x49\x27\x53\x8e\x0a\xc2\x6c\x39\x2a\xe1\xe1\xa2\x2b\x70\x88\xac\x41\xe4\xff\xa5\x3d\xaf\x17\xcd\x73\x2f\x12\x86\xe0\xab\xe3\x91\x08\xfc\x3d\x1d\x56\x71\x03\x3e\x45\xfe\xc1\xb8\xe0\x29\x44\x3d\xb8\xfa\x91\x98\xee\x47\xc0\x4c\x64\x94\xab\xb5\x52\xa2\xed\xbd\x61\x69\xc7\x3e\x62\xd1\x0d\x53\xc9\x9f\xd9\xdd\x76\xcb\xa2\xa4\x50\xf6\x57\xaa\x64\x61\x7f\x96\xcb\x7c\xae\x93\xdb\x0f\x82\xfd\x13\x68\xd8\x91\xa3\x61\x87\x7b\x2f\x7b\x59\x6d\x6b\xc7\x59\x99\x30\x54\x43\x21\x8e\xd9\xce\x70\x0c\x36\xc4\x03\x8f\xb8\x0b\xf2\xf0\x08\x9c\x5f\x23\xee\xc2\x3c\x0c\x31\x59\x19\x8b\xfc\xb9\x46\x43\x27\x18\x93\x2b\xc8\x5f\x61\xb2\xa4\x1c\x9d\x3c\xd2\xec\xb5\xf1\x96\x5d\xfc\xeb\x83\x22\xb9\xa4\xd9\x9e\x6b\xe9\xcb\x9c\x5f\xef\x79\x94\xde\x44\x57\x0d\x8f\xd2\xb4\xcb\xa3\x72\x19\x5d\xed\x7c\x5f\x53\x25\xe2\xca\x6b\xcf\x8f\xa4\x3a\xb7\x32\x1a\x32\x77\x46\xfb\x73\xf0\x11\x98\x61\x62\x42\x33\x80\xf2\x24\x9e\xfb\x26\xfd\xb7\xec\xb4\x8a\x2a\x50\xd5\x17\xbc\xb8\x33\x08\xe7\x15\x37\x0e\x1b\xf3\xe8\x4a\x88\xc2\x37\xfa\x77\xf1\x15\x4c\x17\xc4\x28\xbf\xaa\x26\x1e\xe2\x64\xba\xdf\x4b\x7f\x44\x80\xab\x8f\x37\xbb\xdd\x8e\x58\x9f\x24\xd3\x8b\xb7\xc7\x9a\x6e\xae\x3d\x3c\xed\x38\xac\xdb\x4d\x23\x0d\x31\x4c\xfa\xac\x7e\x0b\x0f\x1c\x50\x58\xe6\xfb\x62\x39\x77\x1c\xeb\xb4\x39\x56\xdb\xed\x95\xf5\xdb\xeb\x1b\xa3\x59\x69\x9e\x74\xf3\xa3\x24\xc0\x54\xb7\x5b\xc4\xa2\x95\x69\xd3\x4e\x14\x55\xaa\xe6\xbc\x19\x55\x01\xc2\x4d\xe0\xdd\xfd\xae\xa7\xd6\xe7\x14\x1e\xf2\x13\x60\xb8\xdf\xda\x1f\x78\xfa\xc8\xdb\x48\x30\xe2\xdf\x5b\x44\x13\x36\x94\x4c\x03\x25\x02\x12\xb4\x9a\xa8\x52\xa0\x81\x80\x04\x7b\xd5\x83\x99\x3e\xa1\x5d\x4b\x93\xcf\xd1\xda\x28\x2a\x14\xae\xc2\x55\x24\x1d\xa1\x37\x52\x23\xc7\xc8\x68\x8e\x14\x59\xe3\x71\x6a\x44\x7e\xeb\xa8\xb6\x00\x45\x19\x26\x9a\x3b\x34\xe6\xe2\x32\x0c\xfb\x09\x92\x18\x87\xe1\xba\xba\xea\x50\x46\x40\xc4\xc9\xc3\x10\xa5\x51\x15\xfa\x62\xe2\xfd\x1e\xd0\xa0\x17\x0c\x78\xec\x25\x51\x6e\x84\xa1\xa9\x91\xff\xd2\x85\xbe\x78\xed\x07\x91\xb8\x8e\xea\xd2\x3d\x74\x53\xbc\x15\x3c\xc4\x83\x43\xcd\x78\x5a\x6f\xce\xcb\x07\x06\x0a\x78\xd4\x0a\x14\x00\x16\x9a\xc8\x46\x0a\x30\xa8\xce\x73\xa6\x82\x40\x00\xf0\x3e\xe0\xd0\x86\xdd\x3f\xd1\xa8\x52\xee\x3b\xaa\xb3\x2c\x97\x2c\x55\x26\x1c\x7c\x9e\x96\xf1\xe6\x9e\x17\x0f\xe3\x7d\xc9\x64\x83\x11\xaf\xb9\x0e\x08\xdb\xab\xe1\x1d\x2c\x36\xa9\xf9\xd6\x70\x2d\x0c\x51\xdb\x32\x25\xa9\x48\x6a\x47\x7a\x48\xea\x48\x8a\x4a\xc2\x3d\x36\x2f\xca\x80\xa0\x5b\x09\xef\x45\x90\xca\x59\xc9\x30\x83\x1a\xec\x65\xad\x5a\xd6\xdf\x95\x69\x88\xa5\x4d\xaa\xbb\x59\xe9\x55\xcb\x34\x7d\xfe\xda\xb8\x36\xc3\xc3\x40\xc8\x95\x23\xc9\xd1\xc8\x98\xbe\x7a\xaf\x69\x7c\xf5\xc5\x31\x44\x20\x1c\xe8\x96\xc7\xcd\xce\x53\xcd\x21\x40\xcf\x85\xf7\xb6\xdf\x98\x7b\x16\xad\x05\x01\x41\x68\x6c\x05\xa2\xdb\xad\x30\x3f\x9c\x65\xaa\xb3\x17\xb5\x81\xf9\xc1\xaa\x0e\xd0\xa0\x3f\xc2\x43\xe1\x7e\xcd\x03\x70\x6a\x2c\xbe\xa7\xc3\xb1\x38\x3a\x72\xac\x99\xbe\x5d\x48\x41\x25\x0c\x69\xbb\x0d\x02\x78\xdb\x87\x16\x9d\xf3\xe2\x44\x93\x15\x45\x1d\x50\xed\x38\xc0\xd8\xbc\x6a\xe2\x2c\xa4\x82\x01\xdf\x35\xee\x12\xdd\x6e\x5c\x54\x17\x87\x14\x37\xf5\x97\x12\xd5\x6f\x1f\xf3\x9b\x65\x28\xdc\x2d\x62\xa6\x5f\x7d\x5a\x38\x89\xd7\x51\x4a\x9c\x2b\xb9\xfe\xe8\x42\x7e\xb9\x41\xb5\xee\x48\x65\x9f\x45\xb6\x1b\x30\x2e\x11\x36\x2f\x98\x75\x58\x53\x55\xb2\xf7\x02\x61\xa4\xfb\xe2\x18\x0c\xf2\x81\x98\xaf\x42\xb8\x3f\x32\xb6\xd3\x26\x84\xbb\xe6\x7d\x1e\x7d\xdb\x3a\x92\xdf\x3e\xae\xc9\x97\xdf\xe5\x45\xf6\xaf\x4f\xb0\xe8\x85\xf8\xf6\xe4\x9f\xe3\xe2\x66\x59\xc6\x27\x6d\x33\x72\xcf\x43\xa2\xd2\x25\xe9\xfd\xf0\x0c\x28\x4f\x86\xc7\x0b\x12\x0c\x02\xb0\xe0\x96\xe6\xf5\x0c\x92\x75\x8d\xa5\x75\x85\x76\x08\xc6\x1e\xec\x3a\xc7\x29\x43\xa0\x31\x56\xf5\xb8\xe0\x79\x93\xfa\xf1\x46\xef\x8d\xca\x71\x47\x10\x3c\x7d\xe3\x48\x5a\x62\xd2\x91\x57\x84\x21\x2a\xe8\xda\xdd\x89\x5d\xcb\x3a\x71\x4f\x24\x1a\x84\x02\x82\x39\xf8\x45\x0b\xe4\xde\x5e\x68\xbc\x9b\x84\x31\x61\x9a\xbc\x6e\x04\xa0\xb5\x7a\x16\xfb\x2a\x65\xf3\x65\x45\x7e\xe0\x81\x92\xb4\xfd\x40\x89\xc6\x35\xc8\xd7\x3d\xe8\xf5\xd1\x7c\x2b\x18\xed\x17\x93\x60\x12\x0c\x8a\x38\x08\x76\xb5\x25\xb8\x2b\xd3\xe1\x6a\xb2\x7f\x26\x33\xc4\xbb\x85\x2a\xbb\xa6\x57\x4a\xc7\x32\xf1\xa6\x04\xaa\x4b\x21\xd0\xf4\x52\x63\x24\xd3\xfb\x0a\xa2\x54\x27\xf7\xb8\xa7\xdd\x2e\xcf\x39\x06\x4f\x89\x40\x03\xe0\x0d\xd3\x59\x1d\x28\xbe\x39\x62\xc4\xee\x1a\x86\x18\x23\xc6\x69\xa6\xb3\x82\x83\xf7\x56\x1d\xcf\x71\xa6\xb3\x5a\x9d\xdf\x5d\x13\x5c\x6b\xee\xa9\x09\x84\x57\x67\xcd\xfd\x0d\x6b\x60\xa6\xbd\xa7\x23\x85\x7d\x3a\x52\x7d\x3f\x9a\xa8\xa3\x51\x0c\x0e\xd5\xa3\xb1\x7c\xaa\xc0\xe6\x4f\x98\x97\xba\xea\x73\x26\x67\x56\x88\xc0\x5b\x5a\x0e\xab\x8f\xe5\x64\xea\x8f\xab\x32\x2d\x16\xd8\x33\xf3\x71\x67\xa3\xb0\x67\x03\x1e\x76\xf1\x1e\x23\xc2\xa8\x9a\x17\x31\x76\x05\xb8\xe1\x55\xf4\xa9\xdb\xe5\x7f\x33\xea\xd6\x28\xbb\xf8\x1d\x28\x5b\x7a\xee\x42\xff\x63\x48\xb6\x7a\xe2\xb5\xb3\x4f\x36\x41\xd2\xc4\x14\xa5\xde\x73\xb0\x61\x88\x86\xd6\xd0\xcd\x90\x63\x91\x12\x2f\xc5\x07\x26\x4f\x93\x92\x21\x5c\x91\x3c\xb2\x99\x8e\x27\xc8\x7b\xd5\xc6\xab\x5d\x63\x53\x59\xbd\x14\x57\xf7\x46\xa5\x79\x00\x97\x79\x8f\xe0\xf8\x0d\x01\x61\x15\x7b\xe5\x83\xc0\x61\xe4\xb4\x1d\x7c\xa1\xb6\xb2\x77\x8f\xdc\x76\xa3\xe2\x64\x0f\x15\x73\xef\x11\x1e\x52\x50\x43\x42\x4b\xeb\xd3\x7c\x34\xc2\x13\x19\xcb\x41\x70\x1c\x90\xdc\xe6\xf1\x9a\xb6\x9e\xf0\xea\x7d\xc5\x98\x37\x30\xb5\xf2\xde\xf2\x2d\x06\xf9\xef\x45\xe4\xf9\xff\x6d\x44\x5e\xf8\x88\x3c\xff\xc7\x11\x79\xfe\x40\x44\x9e\x1a\x9f\xac\x07\x21\x70\x5b\xf6\x33\x10\x77\xa3\xc6\x83\x10\x76\xa3\xc6\xff\x00\xa2\xf6\xf6\xf7\x10\xae\xd6\x43\xf2\x71\xf4\x1e\xaa\xcd\xff\xa1\x47\x40\x94\xf7\x96\x9c\xa1\xdb\x19\xc6\xa0\x2e\xfa\x0c\xc2\xfd\xb3\x1d\x37\x2b\x97\x42\x4d\x06\x3a\x37\xb0\xdf\xf7\xaa\xfc\xe6\x2f\x6b\xa1\x92\xb3\x8f\x29\x63\x19\xcb\xc0\x64\x25\xee\x0f\xc9\x5f\xde\xbd\x7a\xfb\xec\xf2\xec\xaf\xa7\x67\x67\xcf\xcf\x9e\x5f\x9e\xbd\x7e\x6d\x1e\x6e\x2e\xe8\xe6\x0d\x4b\xd7\x32\x57\x77\xae\xec\xae\x93\x00\x0f\xfe\xf0\x07\x1b\x56\x1d\x42\x40\x8d\xfd\x81\xee\xd1\x81\xd6\x58\xa8\x34\x51\x97\xdf\x28\x21\x93\x05\x03\x4b\x21\xf7\x6e\x79\x67\x09\x6b\x08\xf7\x42\xb1\x1b\x94\xe8\x85\x8f\xbb\x8b\x95\x4c\x55\x65\x48\xcb\x3e\x4c\x1f\xb4\xca\xea\x28\x9f\xa3\x62\xca\xc0\xf1\xc9\xbd\x21\x04\xee\x12\x2e\x2d\x0c\x87\x75\x30\xf2\x56\x27\x16\x33\xdb\x5a\xc6\x0a\x88\xed\x76\x8d\xdd\xda\x97\x6c\x78\x3e\x9d\xea\x40\xc3\x0b\x6f\xf4\xf7\x8f\x15\x4c\x6c\x61\xd9\x2c\x4c\xc2\x5c\x01\x55\x6b\x90\xac\xaa\xee\x3e\x4f\xe7\xd4\x18\xa9\x83\x76\xb0\x51\xab\x1f\xc3\xd2\x70\x0f\xa6\x45\x6d\xb8\xff\x4e\xc3\xcc\xff\xac\xa3\xf3\x21\x8d\xd9\xbf\x03\xa8\xff\x3b\x80\xfa\xff\x85\x00\xea\xb7\xff\x8e\x87\xde\x1d\x0f\xfd\xf1\x13\x4c\x56\x94\xa3\xaf\x9f\x60\x72\x45\x85\xd3\x8f\x3d\x39\xc1\xe4\x86\x0a\xb4\x04\xd3\x4c\xf4\xe4\x11\x26\x97\x54\xa0\x05\x26\xb7\xfa\xf3\x31\x26\x77\x54\xa0\x5b\x4c\x3e\xea\xcf\xaf\x31\xf9\x40\x05\xfa\x88\xc9\xb5\xfe\x7c\x82\xc9\x99\x09\xb5\x7e\x4a\x05\x3a\xc3\xe4\x15\xe5\xe8\x5b\x4c\x2e\x28\xd8\x85\x9c\xff\xeb\x04\x5e\x3f\x18\x13\xbd\x12\xea\x1f\x08\x9f\x9d\xe5\xb7\x8d\xf8\xd9\xa7\x75\x41\xc1\x55\x92\x73\x26\x9d\xe6\xe9\x26\x91\x8b\x9c\xbf\x15\xab\xf8\xd1\x90\x98\x8f\x1f\xc0\x3c\x33\x7e\x32\xdc\xdd\x13\xd4\x7b\xc9\x12\x18\x1b\x98\x8f\x1c\x2e\x35\x72\x81\xbf\x17\x22\x95\xc9\x5c\x1d\x7f\x10\xf2\x3a\x00\xdf\xe0\x43\x55\x6c\xa3\xf7\x14\x39\x3c\x3d\x29\x3e\xdc\x33\xe6\x9b\x24\xe7\x07\xd7\xa5\x38\xcf\x46\xc3\x1d\xf1\x83\xa9\x5a\x71\xee\x3d\x23\x49\xca\x3c\x63\xf7\x35\x79\x72\xcf\x70\x78\x72\xfb\xa9\xf5\x6b\xc5\x41\x47\x43\x72\x51\xf3\x09\x75\x57\x3c\xb9\x25\x8d\xaf\x8b\xbc\x28\xca\x66\xd2\x1b\x95\xa4\xd7\x0c\xc2\x7a\xde\x17\xea\xfd\xde\xf1\x5c\x47\x2f\x73\x7e\x4d\x36\x4a\xc4\xc1\xf1\x4a\x8a\x94\x95\x25\x2b\x83\x1d\x09\x2e\xaa\x8f\xfb\xf6\xf6\xf3\x3a\xf8\xdb\x9a\xad\x4d\xeb\x7f\x31\xbf\xfe\x79\x4d\x4b\xa6\xe4\xdd\xe5\x6f\xe2\x0a\x9a\x7f\xad\xbf\x7a\x7f\xd2\x5f\xff\xbc\x2e\xca\x74\xc9\x34\x99\x90\x55\xdd\xbc\x71\x29\xff\xec\xae\x32\x96\xd4\xbd\x3c\x67\x49\xd5\x01\x36\x7a\xd8\x99\x43\x28\xc9\x2a\x7f\xf7\xfa\xe5\x3d\xaf\x38\xec\xc5\x2f\xa9\x42\x8a\x1d\x8d\xf0\x80\xdd\x1b\x12\xff\xdc\x0f\x89\x5f\xa9\x42\xea\x40\xf5\xf6\x65\xd4\x46\x9c\x64\x34\x24\xf3\xc8\xa0\x39\x7c\xf0\x09\x82\xeb\xc8\x84\xa9\x26\x1b\x1b\x9b\x21\xbe\x86\x18\x29\x96\x39\x38\x0c\xd0\xb6\xa2\x91\x00\xc0\xb3\xd9\x95\x55\x6d\x7c\xfe\xf0\x6a\x35\xa0\x7b\xf5\x1f\x8e\x8d\xaf\x5c\x3a\xd9\x5c\xad\xcb\xbb\x5f\x85\xbc\x66\xf2\xdd\xeb\x97\xf1\x79\x64\xf6\x03\x05\xc7\x3a\xe3\xf2\x03\xe4\x94\x01\x26\xe6\xd7\x85\x10\x45\xab\x9c\xc9\xb8\x5c\x09\x51\x94\x01\x44\x5e\x3d\x0c\x44\xed\x69\xd8\xe3\xf4\xbb\xe6\x70\x59\xcf\x61\x2d\x0b\x7f\x44\xb6\xd5\xcf\x1c\x8b\x77\xfe\x7e\xd7\x78\xee\x0e\x8e\xc7\x6b\xf9\x33\xc7\xd4\x3a\xb0\xbf\x6b\x5c\x1f\x0e\x8e\xab\xd5\xfa\x67\x8e\xad\x3e\xe1\xbf\x6b\x58\x37\xf5\xb0\xe6\x4c\xa5\xcb\x16\x54\xd5\xad\x63\x02\xeb\xd7\xca\x37\x6b\xea\x4a\xb9\x42\xcf\x8a\x36\x74\x9a\x72\x49\x51\x5c\xfa\x2d\x1a\xcb\xdd\xbd\x2e\x75\xa2\xdf\xa6\x49\xd9\x6f\xd4\x96\x6c\xb5\xfa\xa9\xe5\x03\xe3\x0e\x67\x34\x40\x36\xa0\xe8\x0d\x02\xd2\xbe\xb8\x30\xc6\xa4\xe2\x10\x17\xcc\xd9\x0f\xfc\x70\xf7\x22\x43\x41\xb2\x5a\x05\xf8\x77\x7a\x53\xec\xc9\xa6\x8e\x46\x84\xd3\x3a\xcc\x0b\x58\x2b\xd8\x30\x2d\xff\x11\x0c\xd8\x20\x18\xf7\x6e\xf3\x32\x57\xbd\xa5\x52\xab\xf8\xf8\x78\x9e\xa4\xec\x4a\x88\xeb\xc8\xfa\x5e\xe7\xe2\x58\xea\x3a\xc7\x99\x48\xcb\x63\xa8\x78\x94\xb1\x54\x64\x4c\x46\x4b\x75\x53\x4c\x72\x17\x64\x85\x06\x03\x46\x04\x1d\x8e\xc5\x53\x35\x16\x83\x01\xe6\x03\x1a\x84\x89\x5c\x94\xd3\x99\xce\xdb\xf7\x77\xab\x05\x62\x62\x30\x9a\x61\x2b\x79\x50\xd4\x70\x64\x7c\x10\x34\x83\xcb\xb8\xb0\x32\x42\x3e\x3c\x32\x4d\x1d\x95\xa6\x7c\x40\x38\x1a\xa2\xee\x89\x1c\x43\x54\x2b\x66\x8c\xea\x78\x9a\xa3\x26\xe3\x28\x23\xbe\x79\x0f\x55\xe6\x53\xb2\x79\x49\x6f\xcd\xef\x35\x04\x80\x96\x94\x6f\xb7\xe7\x2d\x4e\xfb\x9f\xd4\x56\x82\xf0\xa6\x65\x58\xf8\x4f\x6a\x39\x6d\xb8\x5e\x12\x49\x37\x3b\x52\x18\x77\xb5\xc4\x44\xe4\xb7\x4a\xd2\x3e\x35\x61\xed\x41\x0f\x53\x29\x31\x54\x04\x4e\xc2\x28\x31\xbf\x30\xf1\x72\xe0\x95\x7c\x54\xd0\x20\x18\x28\x13\xf2\x50\xe1\x37\x2e\xd6\xbd\xc0\x61\xd8\x7f\xdb\xd2\xde\x20\x50\xdb\xc8\xa9\x98\x51\x05\x56\xa1\x86\xb1\xdd\x3b\x0b\x27\x7a\x50\x23\x4a\x69\x8e\x65\x1d\x80\x9f\x57\xb6\x30\xa3\xa7\x79\x7d\x96\x52\x2b\xd4\x85\x97\x45\x86\xe3\xf2\x69\x6e\x5c\xac\xa6\xa5\x2f\xca\x2d\x07\x27\xb3\xb1\xd7\x58\x0a\xce\x5e\x61\xe8\xd9\xc3\x89\x55\x59\xaf\x40\x4e\x5b\x39\x75\x74\xfe\xa9\x98\xb9\x59\xe4\x9e\x6d\xeb\xe6\x8b\x2f\x8c\x84\x21\xfe\x40\xc0\xe3\x93\x41\x24\xe0\x82\x48\x36\x8f\x13\x30\x32\x29\x63\x49\x2e\xc5\x07\xce\x64\xfc\x5b\x94\x1a\x5d\xc1\xae\xe1\x6b\x5f\xc9\x36\xdb\x2e\x78\xac\x92\x8b\xc0\xa0\x5d\x5f\x94\xd2\x0f\x0d\x07\xfb\xca\xd3\x32\xa0\x41\x1c\xd0\x61\x40\x82\x58\xff\x38\x09\xaa\x67\xc0\xbf\x08\x06\x28\x08\x06\xbe\xa5\xc1\x94\xc6\x33\x3f\x58\x53\xfb\x71\xf1\x4e\x2f\xeb\x7c\x8e\xde\x39\x99\xa4\xb5\xcf\x79\x17\xad\xc4\xaa\x76\xda\x90\x91\x64\xe5\xba\x50\x94\x11\xa9\x21\xe4\x42\xb2\x79\xfe\x91\x2a\x62\x9e\xd7\xa7\x9c\xc8\x0a\xa2\x05\xfc\x5e\x73\x45\x87\x44\x5a\x9e\x77\x63\xaa\x9b\x95\x34\x95\x63\x05\xa3\x8c\xb9\xb3\x0d\x8d\x05\x81\x6a\xf1\x70\xd7\xf2\x10\x62\xae\x77\x13\xc7\xc7\x1b\x80\x4d\x30\x63\x30\xbf\xdd\x30\xaa\x4f\x33\x92\xd1\xf0\x7b\x37\xc7\x30\x7c\xe7\x42\xce\xee\x79\x36\x13\x59\x05\xff\xb1\xdb\x35\x6e\x38\x78\xd2\x22\x0c\x83\x2b\xe3\x8e\x07\x9f\xdb\x2d\x62\xc6\x0d\xa5\x7a\xb2\x67\xec\x05\xb0\xc0\x09\xed\x0f\x0d\xb8\x97\x1f\x72\x95\x2e\x51\x81\x37\x69\x52\x32\xa7\x2c\x8b\xe1\xc3\x06\x07\x8a\xa1\x34\x58\x36\x8d\x21\xdd\x02\x4f\x6c\xeb\xd6\xe0\x62\x1a\xe9\x7d\x80\xea\xbd\x6b\xf3\xe7\xcc\xfc\x39\x85\x66\x76\xfa\x5c\x24\xf5\xc3\x42\x12\x02\x20\x51\x4a\xd5\x24\x88\x82\xc1\x15\x62\x64\x88\x63\x85\x09\x8c\x37\xa1\x43\xa2\x68\x9d\x1f\xab\x41\x10\x07\xe4\x99\x7d\x83\xc5\x1c\x4c\x86\xab\xf7\x32\x72\x3a\x1c\xe7\x75\x44\x9c\x7c\x30\xc0\x9b\x82\xb2\x69\x6e\x34\xe2\x29\x55\x83\x2b\x54\x90\x1c\x8f\x93\x01\x5d\xa1\x82\xa4\xb0\xb6\x55\xfc\x55\xb7\x3e\xdb\x6d\xfd\xa0\xe6\xc4\xec\x61\x8c\x52\x7a\x11\x86\x6c\x7a\x31\xdb\x6e\xd9\x34\xf8\xc3\x1f\xdc\x3b\x3b\xc1\x8c\xa4\x5d\xef\xf4\xa4\x93\x34\x36\x92\x84\xae\x4c\x18\x33\xa3\xa9\xb5\x05\xc7\xf0\xa2\x52\x1f\x15\x94\x41\xd0\x18\x84\x71\x94\x09\xce\xc6\xb8\xa0\x45\x04\x0c\x13\xa9\x86\x3f\x18\x60\xd2\x98\x00\x6c\xa5\xf7\xd6\x47\x11\x86\x88\x6b\xc4\xc9\x88\x40\xc1\xa3\x51\x40\x82\xa9\xc9\xb5\xe1\x55\x66\xa0\xc0\x9c\xd8\x2a\xbd\x0f\xb9\x5a\xf6\xae\xd9\x5d\xd9\xdb\x04\x03\x3f\x16\x07\x73\xce\x9d\xa4\x17\x60\x88\x76\xc5\x49\x10\xd4\x76\x02\x49\xd3\xe1\x4c\x3d\x0c\xbf\xd8\xb0\xc5\xba\x8b\xc9\xda\x06\xaf\x8d\x55\x23\xfc\xab\xef\x04\x06\xed\x9a\xe3\x64\x57\xcb\x1d\x27\xa2\xdc\x59\x6a\x78\xd4\xdd\xb4\xe2\x95\xdb\x83\x0a\xd6\x92\xd5\x29\x1d\x33\xfa\xe9\x36\xf7\x40\x6d\xb2\x40\x8c\x08\xc2\xc9\x5d\xd7\xbb\xf4\x38\xb6\x53\x0b\x43\x54\x5a\x4b\x66\x2a\x07\xa8\x0f\xfd\x82\x17\x20\x2c\x2c\x28\xbb\xf5\xec\x83\x20\x06\x5c\x09\x4b\x50\xe1\xcb\x9f\x48\xf0\x45\x78\xac\x17\xdc\xd8\x19\x32\xba\x8f\xfc\x21\x02\x0b\x31\x0f\xe3\xe9\x1b\x40\x4f\x72\x6e\x6f\x01\x66\x8d\x9b\xed\x5d\xc0\x22\xf3\x63\x87\x89\x70\x28\xa6\xe1\x9c\xe6\x62\x07\x56\x58\x26\x08\xc6\x66\x22\x1c\x6e\x60\x3d\x44\x7e\x60\x78\x44\xd1\x0c\x29\x52\x40\x75\xfb\x5c\x0b\xdb\x6e\x35\xe2\x0a\x02\x72\x03\xbe\xd4\xc8\xca\x98\x2f\x29\x47\xa3\x47\x46\x4a\x3a\xfa\x16\x83\x1f\xfb\xe8\x31\x26\x1f\xef\x7b\xe6\xca\xbe\x2d\x35\x17\x92\x7c\xa0\x1f\x27\xf5\x27\x0a\x80\x22\x75\x8c\x7d\x80\xe3\x27\xc3\xd1\xf0\x11\xb9\xee\x2c\xa5\x37\xd9\x16\x79\x4c\xce\x3a\x8b\x58\xd0\x35\x85\xbe\x26\xa7\x9d\x85\x56\x42\xaa\xc4\xb5\xf4\x84\xbc\xea\x2c\x34\x97\xc9\xc2\x1b\xd3\x37\xe4\xe2\x01\x33\x74\xf8\x84\x9c\xd3\x4d\x5e\xc2\x13\x1b\x2c\xdb\xe7\xb2\xfa\xa3\x1d\x61\x1c\xf8\xde\x1f\x85\x4c\xd9\xfe\x33\x2a\x55\xfe\x6b\x2f\x00\x65\x67\x81\x37\xf6\x31\x8a\x46\xe6\x6e\x2c\x3d\xfb\x98\xbc\x04\x5e\xa1\xa2\xd6\x21\xf0\x98\xff\xe6\x9e\x6d\xa2\xa5\x12\xdd\x0f\x3d\xd5\xa9\xba\xa9\x91\x41\x18\x0a\x14\x7c\xfb\x75\x80\x1b\xd4\x66\xd4\x1a\xa8\x91\x5e\x6b\x78\x0d\x5c\xc7\x01\x6e\x0e\x68\x5e\x2f\x4a\x43\x94\xde\xd5\xac\xb7\x80\xae\xe5\xc0\xab\xaf\x9b\x4e\x3c\x5d\x8e\xd7\x0d\xdc\x27\x2f\xa9\xf7\xe6\x19\x04\xe5\x48\xc6\x2f\x1b\xcf\xa4\x15\xe4\x12\xbd\xf4\x87\x87\xc9\xcb\x28\x2f\x2f\xd6\x92\xb5\x96\xd5\x9a\x40\xbd\xa6\xf9\x5e\x93\xaf\x1b\x4d\xe6\xe4\x12\xbd\x6e\x36\xf9\x3a\x5a\xf3\x12\x34\x27\x97\x79\x09\xb1\xe5\xf7\x1a\x27\xaf\xad\x9c\xeb\x50\x1c\xc4\xa6\xcc\xd9\x84\x08\xf8\x8d\x6e\x2c\x15\x09\x78\x6d\x47\xde\xd0\x4f\xd8\x4f\x91\xb7\x14\x44\x7d\xfd\x21\x20\xa5\xfe\x90\x5c\x5e\x96\xac\x70\xbf\x20\x52\x0a\x28\xdc\x7f\xa2\xc7\xef\x8f\x07\xc7\x0b\xf2\x8e\x4e\x67\xe4\x67\xba\x39\x75\x12\xbb\xcd\x4d\xb2\x8a\xdb\x3c\x6f\x75\x37\xb3\xa6\x79\x8f\xf0\x62\x16\x58\xcc\x0c\x51\x56\x41\xd9\xb3\x23\xd6\x29\xfc\x41\xcd\x69\x3c\x56\x7b\xc2\x43\x0b\x6d\x6c\xb6\xac\xb0\x99\xa5\x09\xbb\xac\x49\xec\xd3\x68\xc3\xd8\x56\x6a\x5c\x14\x3f\xbb\x0e\xf0\x8e\x28\x01\x97\x4a\x87\xb3\x40\x73\x4e\xca\x8c\xa8\xfb\xc2\x21\x6a\x07\xfe\x43\x5d\x43\xd1\x37\xd0\x76\x2b\x50\x30\x7a\xfc\x28\xc0\x84\xed\x76\xa4\x02\x89\x58\x12\x0d\x84\xf5\x77\x41\x2a\x18\x02\x08\xaa\x73\x72\xf2\xa3\x45\x67\x55\xe8\x71\xe7\xe7\x90\x92\xb4\x10\xbc\xf3\xad\xb8\xfa\xfa\xbd\x04\x6f\x4c\x03\x60\xd8\xdd\xc1\xe0\x4d\xa3\x6f\xac\x84\xba\xeb\xc9\xe7\x14\xeb\x98\x2d\x1e\x93\x58\x98\x5f\x24\xa1\x15\x77\xd3\xc1\x30\x4a\x8f\x61\x34\xb7\xa4\x66\x68\x00\x56\x9b\x0c\x17\xd0\x8b\x1d\x39\x60\x32\x98\x9a\x97\xd7\x2a\x7e\x33\xed\xe4\x37\x53\x88\x0a\x34\x4d\x67\x5e\x74\xa1\x69\x3a\xf3\xac\xf4\xf2\x49\x3e\x4d\x67\xb1\x4e\xc5\xd6\x3f\xef\x30\x3b\x9a\x62\xd1\xcd\x8e\xa6\x78\x93\x5b\x36\x34\xad\x7d\xf0\x6d\xf8\x0f\x60\x47\xf3\x0e\x76\xd4\x6b\x2c\xdf\x1d\x62\x22\x6b\x3a\x42\xc2\x91\x2d\x2c\x0d\x21\x1c\xf5\x90\x54\x4f\x02\xfe\x98\xa4\x20\xfe\xde\x07\xd8\xd4\x0b\xde\xe5\x7b\xb7\x00\x12\x63\x1a\x46\x9b\x2f\xb6\xc5\x25\xb9\x65\xb2\xcc\x05\x8f\x83\xd1\x93\xe8\x24\x1a\x06\xe4\xf2\xf2\xcd\xd9\xe9\xeb\xb3\xb7\x97\x2f\x7e\x7e\x7b\xf6\xfa\xe7\x67\x2f\xdf\x5c\x3e\x7f\x75\xf9\xf3\xab\xb7\x97\xef\xde\x9c\x5d\xbe\x7a\x7d\xf9\x9f\xaf\xde\x5d\xfe\xfa\xe2\xe5\xcb\xcb\x1f\xce\x2e\x7f\x7c\xf1\xfa\xec\x79\xbc\x31\x68\xce\x80\xc2\x2b\xc3\xf7\x12\x63\x3b\x1a\x5f\xee\x76\xe4\x85\x43\x57\x73\xc9\xd8\xdf\x19\xaa\xec\x25\x7e\xde\x61\xf2\x9c\xbe\x08\xc3\x9f\xb7\xdb\x17\x5e\x18\x9d\xe7\x0e\x10\x26\xd5\xaf\xf8\xf9\x03\x3d\x8f\x46\x8f\x4d\x70\xf8\x93\x6f\x6d\x70\xf8\xaf\x3b\x03\xf4\xb4\x95\xeb\x40\x78\x25\x24\xc7\x9b\xdc\xf0\x71\x12\xf5\x47\x24\x38\x4d\x8a\x22\xe7\x8b\xde\x85\xd3\x69\xf4\x20\xec\xa2\xa6\x0f\xca\x9e\x11\x2b\x16\x77\xbd\xbc\x04\xdb\x03\x1b\x5f\x9e\x65\xbd\xab\x3b\x90\x87\xfd\x1f\xbd\x87\x47\x7a\xf5\xcb\xff\xd3\x5b\x25\xe9\x75\xb2\x60\x51\xef\x5d\xc9\xea\xf6\xa2\x74\xc9\xd2\xeb\xea\x13\xe1\x9e\x12\xc6\x06\x44\x2d\xd9\x4d\xd4\x7b\xcd\x92\xac\x77\x23\x24\xeb\x25\xb5\x50\xf0\x2a\xba\x61\xc7\xeb\x92\x1d\x41\xe5\xa3\xba\x17\xdf\x44\xc4\x53\x55\xb3\x1d\xf3\xf4\x2d\xd4\x59\xc7\x6f\xe0\x11\xcd\x98\x11\xcd\xb2\xc6\x26\x28\x45\xcc\x88\x61\x37\x63\x46\x0c\xed\x10\x33\x62\xb8\x51\xfd\x03\x48\xa4\x98\x91\x84\xeb\x7a\x50\xff\xd5\x3c\x56\xc4\x12\x7f\x31\x23\xce\xd2\x05\x92\xb9\xc8\x58\xd5\x10\xa4\x80\xe7\xa6\xfb\xab\xa7\x1c\x2b\x02\x6f\x36\xe9\x46\x3e\x26\xa9\x8a\x6b\x07\x16\xde\x5a\x1c\x2a\x08\x8f\xea\x2f\x4e\xf8\xa7\xcc\x8f\xea\x8d\x0f\x2c\x54\x5b\x58\xbe\x78\xf6\xe6\xcd\xe5\xdb\x9f\x5e\xbc\xe9\x84\xe8\xe0\xe1\xd2\xde\x83\x51\x9e\x2e\x2f\x5f\x9f\x3d\x3b\x7d\x7b\xf9\xfc\xec\x97\xb7\xaf\x5e\xbd\x7c\x73\xf9\xc7\x97\xaf\x7e\x78\xf6\xf2\xf2\xa7\x57\xaf\xfe\x7c\x79\xd9\xe9\x75\x7f\x7f\x15\xb3\x18\xcf\x4f\xcf\xc0\x2e\xeb\x81\x65\x91\xf0\xac\xb5\x34\xf9\x22\x0a\x16\x81\x00\x16\xc2\x9a\x0b\xa4\x91\xb3\x5b\x23\x8e\x1e\x7f\xf3\x6f\x41\xf7\xff\x3a\x41\x77\x65\x3e\xcc\x42\x85\xf5\xd5\xd7\x65\x26\xf6\x92\xb7\x2f\x4d\x4d\x94\x9c\x54\x42\x9a\x30\x44\x81\x00\xeb\xef\xe9\x70\xb6\xdd\x06\xaf\xdc\x6f\x7d\xb5\x06\xdc\x7c\x41\x30\xf5\x9f\xdd\x6f\xcf\x6b\xbe\x96\xd9\xa8\x3a\x1c\x94\x15\x4b\x55\xf6\x58\x46\xb2\xe5\xc4\x63\xb1\x3d\xe4\x5d\x03\x9b\x30\xda\x1f\xc6\x48\x51\xb0\x54\x9c\x30\xaa\x74\x19\x1b\xe7\xea\x97\xa4\x58\xb3\xed\x16\x92\x8c\xa0\xa2\x23\xe3\xd5\x2d\x93\x10\x24\x2d\xf3\x33\x63\xc4\x68\xdb\x86\xde\x69\xb6\xbf\xc6\x84\xd1\x20\x4b\x54\x72\x14\x18\xd9\x53\xa0\xb7\xc2\x7c\x60\xc2\x8c\xc8\xcd\x7b\xb0\xb5\x21\x9a\xdb\x17\xda\x39\xe1\x5c\xb5\x1c\xee\x22\xab\xd8\xc7\x56\xf8\x02\xbb\x1e\xbf\x75\xad\xc7\x6f\x7c\xca\x66\x86\xf8\xf7\xd5\x07\x5e\x48\xb0\xd1\x2c\x52\xe2\xdd\x6a\xe5\xe6\xb5\xa7\x1a\x30\x91\x58\xdd\xab\x04\xbf\xf2\xe8\x72\x99\x94\xa7\xc9\x7a\xb1\x54\x00\xf7\xb4\x3f\x22\x3a\x35\xf5\x92\x40\x71\x60\x48\x9c\x87\xbc\xf1\x4e\x1e\x61\x63\x7f\x5a\x19\x4d\x97\x1e\xf6\x69\x37\xce\x48\xd7\x20\x86\x0d\x41\x39\x80\xae\x2d\xf6\x9a\xc1\x39\x85\x72\xce\x83\x5c\x67\x49\x2f\xdd\x1e\xe5\x76\xb2\x11\xf7\x76\xb4\xa3\x27\xed\x1b\x6d\xae\x4d\x8f\x7f\xe5\x95\x28\x93\x69\x02\xf4\x17\xee\x28\xac\x5f\xf4\x46\x10\x4e\xff\xca\xfd\x20\xc2\xf9\x1c\x1d\x8d\x9e\x72\x20\xf2\xbf\x7b\x12\x10\x86\x49\xff\xcf\x1c\x42\x1c\xaa\x88\x7d\x54\x32\x49\x15\xbc\xe0\x51\x9a\x22\xdf\x40\x11\x28\x41\x15\x78\x3c\x80\x43\x3d\x5c\x6b\x75\x68\x27\xe3\x29\x63\x45\x41\x36\x0c\x4e\x42\xf9\x54\xce\x48\x4e\x15\x49\xa9\x1c\xff\x71\x0f\x58\x34\x29\xac\xbb\xf8\x2e\x20\x29\x26\x7f\xe4\x9a\x28\x4e\xec\x1e\x26\xd1\x6a\x99\x94\x2c\x7b\xcd\x16\x79\xa9\x24\xa0\x97\x9f\x35\x66\xd1\x13\xb0\x61\x9d\x0a\xdd\x6b\x89\xcb\x76\xbb\x05\x0e\xc3\x0c\x41\xe0\x74\x0d\x40\xe3\x42\x6f\x15\x50\xc6\x49\x24\x5b\xed\x4d\x50\x86\xf6\x53\xa1\x1e\xd1\xf5\x70\x5c\xd0\xfe\x68\x5c\x98\xc5\xf8\x36\x20\x92\xe8\xcb\x68\x3f\xc8\x2b\xde\xfc\xa0\xd7\x1b\x66\x34\x1a\x0e\x61\xd5\x20\x85\x2a\xf2\x17\xf3\xd7\x5b\xb9\x29\x9f\x45\x19\x5b\x69\xf6\x9a\xa7\x39\x2b\x5b\x7a\x82\xbf\x72\xdb\xce\x28\xc0\xe4\xaf\xfc\x3e\x88\x66\x98\xac\x51\x3b\x34\xd8\xad\x79\x5f\x56\x8f\x5c\x2f\x54\x1d\x65\xa4\xcd\x7c\x23\x85\x6b\x47\x66\x35\x1b\xff\xb2\xb7\x49\x0a\x87\xe1\x2f\x7c\xaa\x66\x94\x52\xb9\xdd\x22\xf8\x6d\x47\x77\x02\xaf\xbf\x98\x5c\xa9\xbb\x1b\xe2\x1d\x0f\xc3\xc6\x78\xae\x6a\xed\x8c\xb2\xac\xd3\x76\x1b\xac\xf9\x35\x17\x1f\xf8\x11\xac\x48\x40\x98\x63\xce\xde\x42\xe4\x06\xca\x04\x12\x58\x1f\x82\x9c\xdf\x8a\x6b\xf6\xc7\x75\x22\x33\x96\x69\x02\xf7\x2a\x49\xaf\x9f\xf1\xec\x54\x9f\xd4\x1f\x73\x59\x2a\xfb\xc6\x07\xe1\xee\x71\x21\x8d\x00\x5b\xcd\x35\x91\xd1\xb2\xe3\x5d\x72\x05\x33\x7a\x34\x0c\x2a\x0e\x7e\xa2\xe2\x3d\x41\x6e\x33\x41\x19\xdf\xa5\x75\xb9\xb4\x38\x04\x22\x34\x32\x1c\xdb\x54\x64\xbe\xf6\x2a\x4d\x59\xe5\x9a\xa1\x70\x3c\x65\x44\xcd\x3a\xe4\xd0\x7b\x9d\xb3\x2a\x5e\x9d\xce\x8f\x59\x18\x2a\xe7\x7a\xc6\xda\xa2\x59\xf3\x0a\x5e\xfd\xa8\xce\x65\x96\x97\x2b\xbd\x64\xee\x59\x9e\x12\x82\x9e\x55\xc9\x2f\x2c\x21\x0c\xa7\xab\xd9\x33\xaf\x55\x25\xe6\xf1\x00\x5e\xdd\xc2\x7d\x4d\xa9\x6b\x40\x49\x16\x70\x72\xde\x28\xb1\x5a\xb1\x0c\x61\x70\x5d\xb1\x1b\xaf\x51\x80\x98\xca\x99\x0d\x1b\xc0\xc3\xb0\x86\x88\x71\xd7\xc8\x9c\xbe\x6b\x7f\x70\x2e\x27\x2f\x2f\x34\x27\xa8\xcb\x2b\x84\x21\xe2\x4e\x2d\xec\x8a\x24\x2b\x98\xbe\x56\x58\x33\x7a\x59\x7d\x01\xe9\x15\x82\xb7\x2e\x5c\xe6\x6d\x3b\xd3\x8f\xab\x7e\xd7\xf1\x3c\xd1\xcf\x22\x63\xf6\xe5\x01\x0f\x8a\xac\xf9\xff\x9f\x20\x6e\x59\xf3\xf5\x16\x9d\xcb\xa9\xd4\x27\x8c\x39\x15\x98\x23\x32\x6c\x24\x0b\x77\x15\x9b\xaf\xd3\x64\xa5\xd6\x92\x55\x89\xcf\xc5\xfa\xca\x46\xb8\xe8\x4a\x6b\x17\x3f\x17\xeb\x92\x3d\x17\x1f\xf8\x7e\x4a\x67\xd1\x73\x71\xdb\x91\xd2\x59\xf4\xdd\xaa\xfd\x5d\x15\x43\x92\xf6\x65\x94\xe5\x65\x72\x55\xb0\x0c\x83\x42\xd1\x0a\x0b\x24\xed\xa3\xc0\x04\x53\x72\x14\x4b\xce\x57\x6b\xe5\x3e\x4a\x08\x5e\xeb\xbe\x14\xfb\xa8\x12\xc9\x12\x43\xcf\x68\x62\xa7\x2f\x8d\x32\xb1\xc7\x2a\xda\x84\xd1\xfe\xa8\x72\xb2\x9c\x18\x7d\x1b\xef\x94\x18\x1b\x64\x7a\xf2\x68\x14\x10\x45\x5c\x5a\x33\x4e\xd7\xc7\x1a\x4b\x55\xd0\x6e\x9f\xe8\xf8\x33\xf7\x5f\xe7\xb0\xe1\x2e\xfe\xcc\xa7\xc5\x6c\x9c\x80\x19\x42\xd2\xbc\x36\xab\x96\xc0\xb0\x80\x2e\x91\x24\x49\x6d\x32\x2d\xeb\x3e\x3f\x58\xef\x24\xa4\x04\x5d\x22\x25\x48\x43\xc5\x72\xed\x3d\x49\x2e\xc6\x4a\xd8\xf7\x2d\xc3\x10\xb1\xc9\x0d\x52\xe4\x12\xc7\xfa\xcf\x2d\x26\x4a\x98\x6b\xf4\xeb\x00\x50\xa6\x25\x26\x3c\x4a\x05\xf9\xed\x9e\xb9\x27\xa7\xa7\x85\x98\x55\xa2\x4c\xfd\x31\xae\x39\xa4\xe9\x6c\xdc\x37\x69\x36\x48\xba\x7b\xd8\xb4\xdf\xf1\x90\x81\x01\x6f\xd6\x78\xe3\x60\x67\xce\x8b\x45\xc9\x82\x9a\xc6\xf2\x39\xfa\x9a\x52\x2a\x22\x95\x2c\xb6\xdb\x27\xee\x67\x15\x5f\xd3\x3c\xaf\xc2\x20\x6c\xb6\x19\xdf\x58\xd3\xd3\xa0\xc0\xc7\x9c\x8a\x8e\x90\x5d\xa7\x76\x3a\x5f\x83\x7e\xac\x6a\x97\xf9\xed\xfa\xa7\x56\x63\xf9\x47\xbe\xe8\xe1\x55\x83\x34\x4d\xc4\xcc\xc4\xbc\xa9\x0b\x5c\xe8\x02\x99\xd8\x30\x90\x3e\x82\xc7\xd1\x87\x65\x5e\x30\xc4\xc2\xf0\xeb\xbe\xeb\xaa\xf2\x82\x6e\x57\x3f\xaf\x9e\xb0\xa9\x5e\xeb\x99\xce\xc6\x6c\x8c\x2b\xbd\x1a\x61\x54\xf7\x31\x36\xda\x5d\xe1\xa0\x6d\xf8\x94\x1d\x1d\x8d\xb1\x42\x42\x93\x71\x41\x6a\x4e\x59\x16\x10\xee\x8a\x0e\xc7\xec\x69\x55\x9c\x0d\x06\x55\xd9\xab\xf5\x95\x3e\x81\x41\x03\xc4\x5f\xba\x81\x20\x45\x35\x52\xe3\x91\x43\xb0\xf0\x2a\xde\xe2\x10\xc1\x35\x55\x33\x00\x65\xde\x85\xab\x97\x9d\xc9\xfa\x1e\xe4\x5d\x08\xbc\x51\xbc\x4a\x6e\x02\xfe\xeb\xca\x69\xef\x61\xe3\x0b\xc3\x73\xc4\xa2\x4b\x13\xf3\x49\xb7\x49\x5e\x36\x2e\xc4\xdf\x1c\xc0\x3f\xbc\xc9\xea\x41\x45\xbf\xd9\xb1\xa2\x6a\x72\x81\xac\xc2\x96\x9c\x23\xdb\x51\xdd\xd3\x1b\xb7\xc2\x2c\x0c\x79\x18\xee\x2d\x70\x9b\xca\x04\x55\x6f\xe7\x56\xb4\x4b\xfe\x8f\x6e\xc0\xdb\x43\x1b\xb0\x3f\xfe\x37\xad\x95\xb7\xd2\xdc\xba\xad\x9f\x74\x5b\x9a\xa6\x79\xed\x25\xbe\x6b\x18\xec\x68\xec\x8c\x59\x5c\xe3\x5d\xca\x49\x41\x05\x49\xe8\x90\xe4\x54\x8e\xf3\x71\x4e\x2f\x50\x8e\x71\x32\x18\x8c\x73\x3a\x1c\xd7\x56\x56\xc5\x38\x1d\xa7\xf4\x02\xa5\x18\xe7\xf6\x51\xa7\xf1\xf0\x69\x72\x94\x8f\xb1\xa4\x17\xe0\x74\x7a\x74\xe4\x92\xf3\xa3\x64\x8c\x0b\x7a\x01\x51\x50\x5c\xb2\xce\x37\xef\xde\x51\x30\x81\x81\x3f\x51\x52\x28\x26\x79\xa2\x18\x76\x97\x8e\x6d\xce\x54\xdf\x19\x26\xcd\xbe\xf8\x60\x38\x4f\xe0\x47\xa8\x24\x52\x9f\x6e\xbd\xf9\x7d\x6b\x52\x61\x44\x0d\x28\xa1\xdc\x6b\x76\xbb\x4d\x74\x3e\x1e\x63\x69\xb0\x80\xbe\x8e\xe8\x05\xb2\x47\x9b\xeb\x46\x34\x56\xdf\x6b\x44\x74\x36\xe2\x5e\x54\xc2\x44\xd0\x0b\x24\x4c\x23\x46\xcc\x54\xc5\xd0\x15\x83\x01\x7e\x03\x26\x62\x1e\x7e\xa8\xb0\x0e\x6f\x61\x9d\x37\x88\xb7\xb0\x8e\xf2\x36\xf0\xe7\x5a\xb3\x5c\x8a\x30\xbc\xe3\xfe\xfb\xa5\xa8\x14\xe6\x95\x97\x53\x13\xeb\x3e\xc8\xbd\x48\xf5\xee\x87\x15\xea\x4f\x1a\x05\xe3\x20\xe7\x9c\xc9\xb7\xec\xa3\x0a\x30\x29\x45\xdd\xe1\x0b\xc3\xeb\xae\x45\x74\x39\xb7\x4c\x80\x2e\xe5\x10\x7c\x3b\x1d\x28\x31\x80\x31\xaa\xb3\xe0\xed\x4e\x9d\x4e\x44\x35\x51\x22\xe9\x73\x84\x21\x70\x93\x9d\xb9\x87\x53\xc3\x10\x18\x36\x4a\xe5\x94\xcd\x00\xb3\x5a\x93\x28\x71\xc4\x0c\x43\x05\x01\x25\x68\xa2\x0b\x8a\x23\xc3\x1b\x4d\x8b\x23\x35\x83\x98\x12\xe3\x03\xc3\xaa\x42\x03\x30\x32\x7a\xaa\x26\xa3\x23\x65\x1f\x46\xc5\xa4\x5d\xb4\x9e\xfa\xf3\x6a\xad\x6b\xd7\x37\x5d\x5a\x0a\xa1\x26\xee\x87\xb1\xf6\x89\xdd\xe7\xf4\x67\x84\x3d\x9e\xe2\x99\xc7\x82\x2d\xf3\xb2\x75\xac\x9d\x1d\xa7\x77\x8e\x9d\x2d\x27\x4f\x54\x6e\xdf\xf3\xa4\x9c\xb0\x2a\xe8\x5c\x45\x6b\xbf\xe0\x8a\xc9\x79\x92\xb2\xa6\x24\x80\xe1\x3d\x3e\x53\x6a\x0c\x86\x14\x3c\x33\x86\x27\xf6\x3d\x3a\x0a\x21\x81\x03\xd3\x31\xc4\x4e\x80\x1c\x1b\xbf\x8f\x8a\xd8\x95\xe3\xc0\x40\xf8\xba\xe7\xbc\x7c\xde\x0a\x1a\x48\xad\x26\x90\xef\x85\x13\x9c\xec\x27\xc5\xfd\x11\x84\x63\x30\x4d\x82\xc0\x0d\x4f\x3e\x70\x5f\x5f\xfa\x56\xae\x59\xdc\x4c\xfa\x31\x29\x4a\xbb\x5a\x5d\x8c\x0f\x3d\x54\xba\xde\x8a\xff\x6c\xa0\x3e\x68\x09\x46\x74\x21\x44\xd1\x32\x5c\x6c\x65\x36\xac\x18\xcd\x46\x68\xe6\x4f\x12\xd7\x60\x65\xa2\x08\xee\xa9\xba\x44\xd5\x57\xdd\xff\xdf\x00\xb7\x37\x5c\x7c\x97\xb9\x91\xf4\x9c\x9c\x80\xc6\x37\xca\x98\xdb\x5e\x84\xc9\x68\xf8\x7d\xe7\x20\x6d\x30\x47\x6f\x78\x6d\x33\xc4\x1f\x8d\xbd\x63\x55\x82\x4e\x67\x04\xdc\xba\xf5\x07\xcb\xe8\x7f\x12\xe6\x58\x35\xfa\xb7\xba\xda\xdf\xeb\x05\xb2\xb3\x79\xe6\x3d\xb9\xb1\x3f\xa1\x5f\x3f\xb3\xfc\x5f\x0d\x3b\xe7\xcc\x10\x2d\x0f\xa6\xc4\xea\xcf\xec\x4e\xb3\x36\xa6\x11\x78\x0d\xfd\x4a\xd4\xc1\xec\xa2\x6b\x76\x77\xaa\x49\xde\xb1\x57\xde\x30\x58\xb6\xdb\x93\x93\xef\x9c\x46\x59\x17\xf4\xcb\x5d\x48\x56\x96\x96\x6b\x52\x62\xd5\x66\xce\x94\x58\xfd\x50\xac\xe5\xc3\xc4\xaf\xbf\xf8\xe4\x2a\x18\x04\xab\x24\x2f\x48\x97\xf1\x1c\x08\x88\x35\xba\x60\x13\x16\xc1\x03\x33\x4d\xca\xf4\xcf\x87\x96\x02\xb4\x0f\xe6\x99\xf1\x33\x9e\x55\x33\xfc\x05\x29\xdc\x31\x2d\x9b\xfb\xe8\x04\xa6\xff\x61\x99\xa7\x4b\xcb\x8d\x9d\x09\xda\x1f\x92\x0f\xa2\xae\xa5\x51\xdb\x0b\xe0\xfb\xe2\x6a\x0e\x0a\xc6\x46\x18\xa5\xf4\x83\x08\xc3\x33\x61\x6a\xb3\xd6\x2a\x00\x97\xe1\x2d\xc4\x1f\x2b\x39\xc7\xa9\x8b\x44\xdf\x31\x76\xc3\x50\xf6\x97\x22\x0c\xcd\xce\x4f\x10\xa3\x2f\x90\x41\xb8\x1a\x55\x1a\xb6\xaa\x71\x4b\xd4\x49\x0d\xe4\x0d\xa9\xa7\x02\xc4\xb0\x86\x06\x1c\xef\x2f\xdd\x45\x52\x2a\x16\xf8\x43\xee\x58\x31\xf0\xb3\x57\x75\x98\x60\x55\xc5\x0e\x56\x2e\xa0\x30\xd6\xbf\x6d\x81\x30\x74\x05\xdc\x8b\x55\xcb\x44\x86\xe1\xe8\xa9\xf9\xd5\x8c\xc1\xd0\x33\x89\xe6\x15\x33\xd8\x0c\x97\x61\xf4\x0f\x10\x2d\xf2\x74\x99\x48\x0d\xa4\x55\x91\x5d\xd7\x80\x0f\x80\xc1\x47\xbb\x41\x66\xdf\x3e\xb1\x4b\x3f\x38\xe2\x9b\x7e\xc1\x11\xc3\x78\x73\x21\x3a\xd5\x89\x17\x22\x92\xac\x54\x42\x32\x4d\x0c\x48\x51\x14\xcc\x44\x8f\x30\xc6\x28\xdf\x3d\x0e\xdc\x8b\x78\x7f\xe2\xc8\xe3\xe7\xf0\xf8\x60\x4d\xbf\x98\xb5\xea\x20\x8d\x88\xf6\x7f\xd1\x63\x3b\x17\x93\x97\xfa\x7f\x87\xc0\xe2\x97\x82\x4e\xd9\x2c\x3e\x17\x94\xd5\x45\xff\xcb\xd0\x1f\xe7\xc2\x09\xf3\xcf\x05\x51\xf4\xa5\xd0\xcb\xfc\x52\xd0\x73\xcb\x9d\xeb\xd9\x12\x85\x6b\x4a\x42\xf9\xdc\xd9\x0f\x48\x4d\xd9\xcc\x1f\xc1\x9f\x1a\x92\x48\x08\x57\x51\xe5\x7d\x51\xc1\xf7\x9b\xea\xa5\x05\x53\x7e\xfc\x46\xc0\x9b\xfa\x5e\xc4\x0b\x48\xdf\xcd\x73\x9e\x14\xc5\xdd\xe6\x0d\x00\xe9\x7f\x35\x9e\x22\x63\xac\xf5\x4e\x3c\x17\x19\x33\x44\x7c\xfd\xbb\xa9\x6e\x72\xc6\xf9\x95\xa0\x46\x4d\xfa\xfd\xb7\x62\x6a\xd6\x72\x16\x37\xc4\x34\x1e\xc5\xa2\x58\x0b\x47\xb9\x70\xbc\x2c\x2a\x65\x6a\x29\xbf\xed\xd6\xbe\x0b\xce\xa2\x54\x48\xc9\xca\x95\xe0\x59\xce\x17\xef\x4a\x67\x1a\x04\x2f\xde\x1e\xcc\xc5\xe4\x11\xb0\xf9\x7a\xe8\x6f\xef\x56\x1a\xc5\xd5\xd2\x87\xd8\xdb\x3a\xce\xea\xf0\x13\x3e\xa1\x0a\x96\xb5\x7d\x14\xb4\x9f\x47\xf7\xc9\x55\x4f\x6b\xa8\x68\x20\x78\x30\x70\xe6\x08\x20\x51\x77\xc5\x2a\xb1\xc4\x76\x8b\xf8\xc1\x47\x11\xb3\xfc\x36\xd0\x5c\x59\xe3\x75\x34\x45\x02\x1b\x3b\x45\xe7\x75\x59\x7c\x72\x78\xb2\xad\xcf\xc3\xf0\x56\x1f\x9d\x0f\x4b\xc6\x0a\x40\x6b\x60\xaf\x5d\x75\x96\xdf\xac\x4c\x47\xd5\x83\xfc\x3f\xb2\x44\x93\xee\x28\x30\x62\xa9\xc8\xd4\x24\xc1\xa3\x68\x18\x60\x4c\x3c\x19\x8a\xf0\x61\x03\x36\x77\xec\xb4\xb4\xb4\x06\x0e\x1c\x86\x9e\xcc\xae\x09\x2a\x61\x88\x02\x50\xe3\x5f\x89\x8f\x00\x0d\xdb\x6d\x20\x93\x2c\x07\x2d\xad\x0f\xd5\xd2\xeb\x0a\xba\x9d\x98\x7a\x2c\x0b\x62\x4b\xdf\x12\x4e\xeb\x78\x04\x1e\x09\xf9\x9c\x95\xa9\xcc\x57\xf0\xc2\x55\x83\x08\xad\xc3\x35\xc0\xab\x80\x41\x30\x00\x55\x06\xc4\x1b\xe9\xd2\x66\x74\x2d\xb2\xee\xeb\x40\x4e\xc9\x2a\x3e\xe3\x50\x7c\x09\x45\x36\x5e\x00\x0e\xee\x45\xb1\x68\x87\xdf\x20\x10\x82\x7b\xcf\x9c\x12\xfa\xaf\xe9\x16\xbc\x23\x25\x6b\x9a\x0a\x9a\x79\x19\xf0\xf1\x09\x1c\x70\x2a\xdc\x2c\x98\xfa\xe5\x40\xc4\x05\x01\x6d\xfd\xb2\xe7\x3e\x6d\x1a\xdc\x91\x52\x5f\xcb\x32\x49\xaf\x73\xbe\xf0\xab\xb3\xe8\x12\xf6\x03\xf2\x98\x55\x4b\xda\x27\x4d\xf4\xfa\xfa\x2a\xb0\x82\x19\x62\xaf\x51\x03\xc2\x6f\x37\xdb\x80\xdd\xf7\x43\xe1\x33\x17\x82\xcd\x7b\x14\xb5\x12\xbd\xf8\x55\xc7\x7e\x54\x6b\x6b\xf7\xca\x29\x80\x08\x4c\x0d\x99\x9d\x1f\xd7\x71\x6f\x90\xb0\x00\xc6\x22\x0b\x61\x93\x40\xc9\x35\x0b\xe2\x60\xae\x09\xf3\x20\x66\x86\x6b\xc2\x04\x31\x2a\x70\x9f\x82\xe5\x3a\x04\x3f\x31\x4d\x32\xdc\x54\x13\xe4\xac\xf5\x6a\x04\xa3\xcf\x6a\x82\x16\xfd\x24\xec\x1b\x45\x86\xea\x74\x96\x84\x34\x30\xa9\x01\xf9\x8b\xe6\xf2\x7f\x02\x81\x9f\xa7\xf1\x86\x25\xf8\xa0\x53\xaf\x51\x43\xf1\x50\xba\xc5\x49\x18\x3a\x85\x77\xcc\x2b\x0b\xa9\x5a\x07\x5c\x23\x37\xb8\xb1\x4d\x4f\xd4\x33\x52\xf5\xf0\x72\xc6\x10\xde\xbc\x13\x61\x88\xde\x09\xa0\x1b\xd3\x25\xa0\x06\x14\x08\xbe\xb2\xc0\xec\xc6\x3a\x67\x98\xfc\x2c\xe8\x3b\x73\xb1\xf9\x0f\x1d\xc1\xa8\xec\x51\x35\x01\xf8\x6c\x55\x73\x99\x94\x0c\xfd\x6c\x22\x21\xd2\x5c\xff\xd4\x8b\x01\xbb\x4e\xbe\x40\x29\x6b\x0a\x9a\x56\xd5\x82\xea\xc1\xff\x28\xd2\x75\x09\x4d\x4e\x90\x1e\x2a\x79\x27\xa8\xd2\x83\xe0\xe4\x9d\x88\x12\xf5\xa9\xf1\x6a\x46\xd2\x52\xd2\x06\x3d\x66\x0d\xd3\x82\x2b\xb7\x9c\xba\x94\x79\x2b\x2f\x17\xdc\x5b\xb1\xed\xb6\x66\x03\x1a\xdf\x40\xaa\xfb\x6b\x6a\xe6\xe8\x29\x14\x5b\xbb\x00\x5a\x9a\x56\x05\x1f\x09\xde\x34\xcb\xbf\xf0\x15\x21\xdd\xbb\xd8\x6a\x60\xc1\x3e\x93\xeb\xb9\xf4\xd0\x6e\x9b\xb3\xaf\x4d\x2f\x17\x4c\x9d\x8b\x2c\x9f\xe7\x4c\x02\x05\x35\xd9\x4f\xd2\x14\x52\xbf\x8f\x18\x7d\x26\x34\x2d\x13\x86\xfd\x3e\xb8\xe3\xd5\xba\x34\x56\xa3\x9e\x4b\x0f\x52\xef\x3e\x77\xc8\x1f\xf7\xa8\x08\x8d\xe1\x3b\x22\x33\xb2\xb8\xfb\xa1\x8b\xc8\x8b\xc6\xae\x69\x0f\x88\xa7\xd8\xe4\x80\x3e\xf8\x17\x9f\x79\x91\xb5\x16\xaf\x81\x7c\xd0\x79\x78\x8c\xb1\xa2\xd5\x6f\xf7\x56\xf6\xb0\x4f\xd1\x49\xa8\x22\x36\x9f\xb3\x54\xbd\x4d\x16\xd5\x11\x35\x6a\x77\xaf\xb6\x26\xc7\xab\x06\xc8\x3d\x15\x1d\x25\xfe\xc8\x04\x63\x4f\x16\x93\x93\xf8\x91\xa7\x15\x62\xcd\x07\x48\x34\x9e\x04\xdb\x33\x90\xc4\xf0\xa4\xf8\x31\xbf\x62\x12\x87\xe1\x89\x66\xa7\x58\x83\x23\x3f\x83\xba\x9a\x4d\x83\x0c\xa3\xc3\xff\xf6\x5b\x5f\x19\x72\xda\xa0\x04\xaa\xb5\x68\x60\xdf\x9e\x32\xf5\x81\x00\x53\x75\x2b\x64\x04\x24\xa2\x65\xde\xea\xf0\x4f\x8c\x48\xaa\xc6\x63\x67\x1c\xe2\x64\x2e\x24\xa1\xc5\xc4\x13\xb5\xc6\xce\xf3\xb7\x5f\x6c\xb7\xfd\xc4\x48\x5e\xf5\x77\x61\x4c\x92\x29\xa5\x89\xf9\x55\xab\x52\x72\x6a\x33\xc7\xb9\x91\xe2\xe6\x94\xd2\x4a\x27\x7b\xc6\x50\x81\x09\x0c\x5e\xa7\xcb\x66\xba\x1a\xe7\x34\x8f\x4a\xf3\xd0\xfa\xae\x5a\x8a\x7c\x8e\xdc\x08\xfb\x94\x4a\xfb\x13\x73\x5a\x10\x49\x13\xbb\xf5\xce\xae\xc2\x4a\xa1\xed\x18\x52\x33\x86\x14\xc6\xa0\x0b\x0d\x89\xab\x66\x42\xbc\xdb\x4c\x69\x33\x25\x2d\x08\xaf\x32\x53\x9a\x56\xa3\xd1\xab\x60\xdd\x89\x53\x37\xeb\xee\xe6\x13\xdd\xca\xa1\xe6\x13\xdd\x7f\x57\xf3\x86\xa9\xfa\xf6\xbb\x00\xef\x76\x9e\x58\x5a\x4f\xd8\xec\xe7\x77\xc3\xa0\xe2\x09\x1f\xe9\x9b\x51\x43\xa2\xb7\xd5\xbc\x66\xad\x9c\xa9\x05\x38\x07\x36\x82\xaa\xbd\xaa\x6e\x77\xc4\x28\x40\x16\x6e\x68\x0c\x6b\x75\x23\x1b\x8f\x2b\x25\x9e\xaa\x95\x78\xca\x57\xe2\xa9\xb1\xe5\x7d\x35\x04\xd8\xbf\x76\x77\xa8\x22\xfa\x70\x99\x65\x72\x87\x53\x01\xfe\x34\x50\x04\x87\xb1\xaf\xdc\x02\x8c\xed\x23\x19\xb6\xba\x66\xb5\x6d\x43\x1e\xca\x85\x21\xd6\x67\x76\x57\xd5\xae\x3a\x75\x80\xac\x0b\xb9\xa5\xf5\x19\x69\x4f\x85\xf8\x7f\x6d\x25\xc2\xf0\x71\xdf\x65\xff\x2f\x5b\x94\xf3\x26\xd7\x51\xeb\xd9\x32\x61\xdf\x30\xd9\xb0\x08\xd4\x53\x4a\xc8\xd2\x59\xd4\x58\x70\x06\xd4\x62\x75\x25\x6a\xcc\x2b\x3c\xcb\x2b\xfc\x02\xa8\x04\x71\x5a\x81\xaf\xc1\x4c\x0d\xc8\x75\x91\xc5\x5e\xf0\xb9\xb0\xef\x30\x8c\x3b\xfa\x24\x8a\x9e\x21\x8e\xad\xda\x57\x39\x1d\xcd\x70\xcc\x9f\xfa\xa5\xbd\x08\x77\x80\x3d\x5d\xc6\x94\xcf\xc8\x5f\x05\xd2\x2c\xd3\xea\x25\xbb\x65\xc5\x5b\xe0\x55\x08\xf3\x6f\x61\xa0\x92\xfc\x04\x9f\x50\x7a\x09\x4b\xf5\xab\xa0\xfd\xbe\x77\xa5\xbe\x6e\xd3\xa3\x7c\x72\x5d\x45\xd8\xe5\x44\x91\x37\xac\xe1\x63\x81\x5b\xb7\xdf\x6f\x9d\xf5\xad\xc6\xe7\x21\x0d\xbc\xa9\xe9\x98\x5f\x45\xf5\xc8\x19\xac\x10\xbc\x81\x71\x86\x2a\x87\x28\xca\xb7\x5b\x67\x80\x5a\xdb\x65\x18\x00\xb7\x57\x15\xc7\xc0\x3e\x1b\xf7\xe7\xbf\x8b\x86\x34\x5c\xd0\xbf\x0b\x2b\x01\x17\x8d\x65\xa4\x8c\x88\x86\x9a\x42\x11\xe1\xc1\x12\x68\x2d\x84\x7d\x30\x9f\x6e\xfc\x9a\x31\x23\x5e\xbd\x58\x91\xba\x56\xcc\x49\xb5\x79\xf1\x74\xb6\x03\x51\xcb\x17\xe8\x9c\x81\x6a\xd3\x0a\x59\x9a\xdb\xe9\x4c\x93\x1a\x1a\x13\x93\xe4\x8f\xc6\xa4\xb4\x41\xc6\x38\xf5\x57\x53\x0e\xc3\xbf\x57\xe2\x28\x9f\xcb\x7a\xcb\x5a\xef\xa2\xb9\x6d\x9b\xb6\xb8\xf1\x19\x3c\xe3\xe2\x25\x10\x3e\x0d\x7e\x65\x57\xd7\xb9\x0a\x06\x6c\x46\x83\x0f\xf6\xb7\xd2\x19\xe7\xe2\xef\x26\xf5\x46\xff\x80\xa4\x9b\xd2\xa4\x9c\xbf\xb1\x09\xaf\xcc\xb7\x08\x06\x7b\x2d\x7b\xba\x5e\x87\xe5\xfe\x08\xb4\xa1\x1d\x1d\x7c\xc0\x61\xfc\xb3\x9f\x6c\x43\x6d\x13\x4e\x21\xb9\x36\x53\xe4\x18\xae\xe0\x0e\xbe\x1d\xb2\x7f\x10\x8d\x86\x29\xd7\xac\xbe\x15\x54\x05\x9e\x8a\xd9\xa7\x1e\x1f\xf8\xb4\xda\x17\x02\x4c\x96\xa6\x5f\x88\x19\xfd\x93\x18\x0c\xc8\x7f\x89\x29\x7c\xcd\xe8\x66\x87\xeb\x2f\x4f\x0f\xca\x9c\x63\xc1\x18\xe4\x68\x8d\x07\xeb\x69\xe3\x7b\x9f\x77\x7b\xd1\xd8\x4e\x68\x6a\xcc\x3c\xf5\xb6\x18\x73\x83\x93\x1f\x81\xa6\xca\x09\xba\x20\x49\x50\x36\xe0\x91\xa7\x3b\x75\x5a\x4d\xf6\x14\x48\xb2\xef\x2b\xdb\xf6\x0d\xb8\xb5\x70\x22\xe6\xf3\x92\xa9\x58\x1d\xb1\xdd\x18\x8e\x84\x51\xb8\xbb\x3e\x38\x44\x10\x78\x63\x10\x36\xde\x68\x34\xea\x25\x38\xdb\xab\x9d\x4e\xf7\x8c\x7c\x9c\x81\xcf\x0e\xc6\xcf\x7d\x79\xe3\xf3\xdf\x2d\x6f\xec\xa9\x30\x44\x9e\xd0\x31\x0c\x41\xd0\x68\xa5\x4e\xc6\x7c\xb4\x21\x79\xd4\xdf\xd2\x71\xa3\xf6\x81\xf5\xb3\xcc\x84\x05\xf5\x90\xe8\xb3\x1a\x5b\x15\xd2\x58\xe9\x50\xca\xf5\x2f\xd9\xa7\xf4\x8c\x23\xbc\x67\xcc\xc7\x29\x97\x0e\xb8\x4a\xc7\x32\xbe\x51\x89\x04\xa5\x36\x0f\xc3\xe7\x7a\xd6\x13\x4e\x37\xa0\x3c\xd0\x97\x4b\xa3\x14\x61\x3c\xf3\x13\xcf\x78\xb6\x73\xd1\x9a\x17\x4c\x55\x5c\xe8\x04\x71\xda\x91\xac\x8f\x17\xdd\x24\x3c\x5d\x0a\x09\x62\x4d\x1e\xd5\x1f\xc4\xfc\x7c\x65\xf6\xd5\xe5\x98\x4f\x32\xd7\xbc\xb4\xad\x52\xfd\x36\xa9\x55\x05\xef\x6b\x87\xe3\xca\x56\x4b\xca\x30\x3c\xe5\x48\x4a\xa2\x67\x06\x7a\x1c\x29\x01\x8f\xfa\xc2\x0e\x25\xed\xac\x88\x00\x0d\x64\x2d\xed\xb0\x96\x7c\x15\xe2\xa3\x5c\x5a\xa1\x87\xb7\x17\xff\xf9\xb9\xdc\xe0\xdf\x3e\xb7\xc2\x8f\x9f\x5b\xe1\xef\x0d\x72\xc4\x69\xf1\xec\xe6\xa7\x56\x61\x62\xb4\x69\x43\x4a\xa9\x91\x4d\xdb\x64\x1c\x86\x23\xcb\x0d\x21\x46\x47\x8f\x70\xcc\xa8\x22\x8f\x4e\x9e\x6a\x96\x1e\x72\x34\x9b\xea\xbd\xd3\xf8\xeb\xe7\x8e\xee\xaf\x9f\x5b\xe1\x97\xcf\xad\xf0\xe7\xcf\xad\xf0\xc7\xcf\xad\xf0\x03\xac\xf0\xf0\xfb\x95\x11\x0d\x3a\xbe\x61\x2e\xa7\x2b\x39\x23\xe6\x8f\xb9\x22\x57\xf2\xe8\xc8\xab\xf8\x17\x7b\x72\x57\x72\x30\x70\xe5\xaa\xfa\xb5\xb1\xb7\xaf\x83\xf8\x2f\x1f\xff\x7f\x01\x72\xc0\x1b\x19\x5f\xc9\x2a\xc4\x51\xad\x83\x61\x4d\x03\x5f\xb8\x20\xfc\x47\x1e\x9b\x86\xbe\xe7\x7c\xec\x22\x84\x34\x4c\x81\x45\x18\x8a\xe8\xb2\xc9\x8b\x9f\xb3\x1b\x91\xff\x9d\x65\xef\xf8\x4d\x52\x5e\xb3\x0c\x6e\x82\x53\x1b\xd5\xc7\x7b\x01\xe8\x60\xc5\xf3\xbd\x6a\x63\x67\x99\x6a\x9f\x45\xb5\x8e\x17\x05\x18\x53\x78\x8f\x03\x08\xab\x3d\xf1\x35\x60\x9f\x33\x3a\x75\xb8\xfc\xfe\xa0\x68\x81\x89\x47\x16\x7e\xe1\xaf\xfd\x89\x33\xc6\xac\x43\xb8\x98\x15\xf6\xea\xc3\x32\x7b\xaa\x2a\xa5\xeb\x7f\x61\x64\x14\xe8\x07\x86\x96\x12\x9c\x29\x18\xba\x92\x4d\x01\xa2\x52\x8e\x82\x35\x6d\x9b\xfd\x2d\x85\xe5\x62\x9f\x68\x56\xf5\x2f\x50\x0d\xe2\x14\xff\x05\x9a\x6a\x9a\xcb\x73\x75\xc0\xbe\x1b\xbc\xce\xbb\x87\x3a\xee\x8e\x13\x6e\x9e\xdb\xf0\x0a\xd7\x0c\x9b\xbe\x37\x5b\x99\xa8\x76\xca\x2e\xec\x26\xea\x3f\xd2\xf0\xe6\xc3\x6f\x03\x02\x12\xb0\xed\x36\x78\x67\x7c\x25\x02\x52\x54\x57\xe4\x47\x6e\x1e\x0a\xf1\xed\x3b\x85\x72\x4c\x26\x2c\xdd\xbe\x40\xbd\x86\xd7\x4a\x90\x03\xc1\x65\x0e\xed\x33\x93\x8b\xe6\x3e\x6f\xb7\xe7\x9c\xdc\x48\x5a\x1f\xa3\xfd\xd5\x5d\xd6\x79\x20\x3f\xf7\x54\x3f\x87\x56\x1a\x98\x05\x33\xed\x27\xdf\x05\xd8\x95\x91\x14\xf6\xe6\x46\xe2\x31\x7f\xf8\x18\xa9\x24\x7b\x10\x63\x47\x09\x9e\x3b\xc0\x0a\xb8\x12\x63\x33\x66\xd5\x80\x88\x42\x35\x62\xdd\xa9\xa4\xb2\x90\xba\x66\x77\xce\x32\xaa\x1a\xbc\x11\xa7\xaa\x8a\x05\x30\xb9\x86\x6c\xb2\xf6\x52\x20\xbd\xb2\xb1\xf1\x80\x49\xae\x0b\x82\x7d\x09\x1d\x9a\x8f\x1b\x3b\x29\xfb\xc6\x45\x1d\x7b\x04\x62\x09\xd3\x46\x19\x88\x3a\x60\x1f\x25\x65\xa0\x1a\xf5\x52\x24\x9b\x37\xfa\x30\x6b\xe6\x20\x38\x59\x50\x6e\x72\x2a\xf1\xa3\x1b\x41\x91\x94\xea\x0c\x12\x4d\x43\x40\xbf\xfa\x09\x9a\x26\xb4\xdf\x75\x07\xec\xe3\x2a\x37\x46\xa1\x6f\xf3\x1b\xe6\xda\xaa\xa4\x4a\x2d\xef\x9b\x44\xb5\x23\x2d\xd5\x62\x46\x8f\xfe\xa2\x94\x8a\x09\x12\x10\xce\x44\xef\x88\xde\x07\x13\x30\x8d\xb0\x8e\x29\x61\xcd\xf6\x01\x3f\x68\xe4\xc4\xc2\xdb\x21\xff\x50\x7b\xf6\x93\x54\xe3\xc4\xfa\x4b\xe0\x18\x89\xc6\x92\x88\xbd\xe9\x8a\xc6\x8a\xd8\x24\x6f\xd1\x0c\xef\x2a\xda\x2b\xc2\x89\x68\xed\x12\xb1\x71\x16\xa8\xc5\x2e\x44\xb4\x76\x96\x35\xbf\xbd\x7c\x03\x1d\xac\xf9\x4d\x44\x03\x54\x98\xff\xa5\xd7\xc2\xc2\x23\x73\xbf\x88\x35\x6d\xa2\xcc\xfc\x25\x02\x80\xc6\x84\xd7\xf0\xec\x2e\xf3\xc6\x66\x49\x47\x23\x16\x6e\x99\x13\x43\x2a\x39\x32\x69\x5f\x0e\x5f\x4c\x90\xf4\x23\xde\x84\x61\x71\x4f\xa0\xa0\x89\xdd\xed\x13\x92\x10\x85\x63\xfb\x35\x84\x2f\x22\xcd\x06\x17\x44\x36\x17\xd3\x85\x09\x89\xf7\x74\x03\xd0\xb9\x6d\xe4\xeb\x87\x36\xd2\x36\xa4\x2a\xaa\x28\x64\x45\x18\x3a\x11\x46\x9d\x0b\x92\x25\x3d\xc7\x83\x2d\x6a\xbc\xf6\x68\x18\x58\x41\x48\x31\x29\x62\x57\x97\x04\x81\x1e\xd1\x1e\xb4\x78\x3e\x22\xa9\xda\x23\xb2\x94\x9b\xd2\x68\x48\x84\x9e\x93\x6a\x75\x4c\xd4\x7e\x93\x1e\xc1\x53\xaa\x96\xd8\xa7\x6a\xf0\x89\x8d\x63\xf3\xd9\x4d\xae\x0f\x36\xf9\x8d\x3d\xb3\xd0\xa6\x3d\xa0\xe6\xb5\x5e\xf9\xb9\x9d\x64\xed\x4e\x98\xeb\xe4\xbb\x6a\xdc\x6c\xbf\x05\x8f\xe3\x9e\x1f\x1c\xe6\x63\x7f\x98\xad\x6d\x74\x01\x51\xb6\xdb\xe9\xac\x73\x88\x1e\xaa\xd9\x34\x44\x8a\x71\x4b\xc4\x48\x6c\xcb\x55\xdc\x22\x18\x76\xd3\x72\x23\x66\x2d\x53\x8e\x9d\xbf\x06\x2b\x75\xe0\x39\x26\xcf\x18\x68\xef\xd5\x9b\x5a\x05\xea\x28\x84\xae\x77\x69\xee\x0f\xd2\xd0\xa6\x26\xee\x2f\x6d\x04\xd4\x79\xf9\xdc\xba\x82\x6d\xb7\x7d\x15\xd9\x88\x23\xa5\xd1\x50\x55\x9a\x7c\x3d\x72\xa7\xcd\xcf\x39\xbc\xac\xc3\xf0\x78\x21\xe9\x4a\x35\x9e\x71\xaa\xb4\x94\x82\x9f\x8a\x9b\x9b\x5c\x41\x3b\xaf\x85\x50\xc6\x01\x12\x93\xcb\x07\xd6\x79\xc7\x6f\xc4\x9a\xbb\x6a\xde\x52\xb9\x31\x79\x9a\x5d\x58\xb1\x0e\xbc\xb6\x90\x61\xb8\x90\x0d\xf5\xda\xcd\xa1\xb2\x97\x32\x0c\x2f\x9b\x65\x17\xde\x3e\x6e\xae\x92\xd2\xc6\x5b\x63\xa4\x09\x5d\xf1\x90\xc0\x85\x63\x00\x45\x5f\x34\xe6\x57\x6a\xed\x12\x5f\xe6\x2e\x65\x99\x94\x7e\x64\xb7\xfe\x88\xe4\xe5\x0b\xfb\x7e\xce\xdf\xc1\xdc\xd9\x77\x86\xb4\x94\x98\x0b\x5d\x09\x57\xd8\xc4\x8a\xab\xec\x27\x55\x31\x32\xbf\xe0\x16\x04\x86\xc0\xa4\x63\x62\x1f\xfa\x6b\x8e\x75\xbb\x6d\xa7\x7c\xdf\x3e\x2c\x60\x16\xd0\x3e\x40\x7b\x85\x3c\x5d\x72\x8b\x62\xac\x2e\x6b\x70\x5e\xf5\xae\xb8\xb1\xa3\x18\x8c\xe7\x58\xe3\x2e\x5c\x28\x10\x5f\x63\x23\x89\xee\x53\xca\x27\xce\x9d\x82\x51\xee\x17\x35\x56\x0b\xbc\xbb\x76\xec\x44\xbc\x94\xf5\x35\x69\x62\xf4\x95\x4e\xba\xcd\x26\x97\x0a\x09\x62\x7d\x83\xc0\xaf\x4d\x2f\x96\x93\x32\xb9\x25\x46\xb6\x14\xb1\x3b\x80\xe3\x2a\xa5\x5a\x5d\x4f\x65\xbe\x8f\xfb\xad\x93\x9d\xde\x56\x73\xef\x1f\x50\x7d\xdb\x08\x54\xba\xaa\x6f\x0b\xf7\x51\xf9\xf1\x15\x1c\xd7\x64\xe4\x73\xfe\xac\x8d\x29\x8c\x3e\x91\x7e\xaa\x07\xa8\x3c\xaa\x7e\xb7\x41\x96\xb7\xf6\xd3\x41\xb0\x81\x2e\x0b\xc4\x30\xd9\x16\x88\xf2\xa8\xf1\xfd\x10\x20\xdf\x61\xd2\xee\x8e\x0e\x49\xab\xa1\x89\xde\xd3\x7a\xe8\xa8\xf1\x49\x55\x8b\x90\x6a\x55\xa6\xfd\x61\xcd\xa7\x25\xb4\x3f\x84\xe7\xeb\xcc\x54\x52\xda\x1f\xd9\x98\x97\x34\xb7\xfa\xed\x92\xe6\xad\x01\x41\xbc\x82\xef\x0b\x93\xbd\xa6\xed\xf1\x8e\xe1\x30\xad\xb7\xdb\xf5\xf7\xa5\x71\xf2\x6a\xcd\xa7\xc4\x24\xdd\x6e\x51\x0a\xfa\x5e\x6f\xe0\x8e\x97\xd1\x99\x76\x44\x34\x87\xb3\x5a\xa9\x5c\x4c\x2a\x34\x0a\xc0\x65\xcf\x41\x0e\x74\x17\x84\x79\x9c\x20\x46\xef\x14\xca\x89\x20\x8c\xc0\x1b\x7d\xfd\x21\x8e\x51\xe9\x27\x9a\x63\x91\x4c\x0c\xcf\xc9\x48\x89\xe3\x8f\x1c\xe9\xbf\xba\xf8\xc8\xb4\x07\xfb\x92\x41\x57\xcd\x5d\x82\x77\x99\xdc\x22\x45\x6e\x4f\xc3\x10\x95\x94\x47\xfe\x16\xbb\x51\x97\x1d\x79\x74\x3a\xc3\xc4\xaa\xe3\x72\x8c\x41\x73\xaf\x67\xea\x2b\x16\x41\xcd\xe7\x57\x9a\x78\xf6\x15\x5b\xfa\xe8\x24\xae\x4a\xc1\xb2\x6c\xb7\xed\xa1\x6e\xb7\xa8\x09\xf0\x86\x9c\x37\x0b\xec\x2f\xbc\x4f\x53\x7c\x68\xe1\x28\x7f\x04\x75\x74\x3a\x6a\x82\x74\x34\xb3\x1d\x42\x19\x8e\x59\xe5\x54\x0f\x86\xc2\x8e\xfd\x9d\x32\xf3\xb2\xbc\xab\x34\xae\x7f\x9a\xba\xdd\x8f\x59\x83\x02\x7f\x14\x90\x02\x93\xc2\x62\x02\x5f\x32\x7f\xad\xea\x00\xd1\x35\xd3\x6b\x66\xa1\xaa\x90\xed\x09\x61\x3e\x93\x4b\x54\x97\x9d\x09\x35\x7e\xbe\x89\x1f\x7c\xf4\x9a\x1d\x8e\x14\x6a\x03\xc6\x72\xca\xbb\x5a\x23\xb2\x0e\x89\x27\x8d\xe8\x59\xda\x57\x89\x15\xe2\x78\x7c\xab\xaf\xec\x4d\x0b\xdd\x14\xc4\xc7\x87\xb1\xa8\xd0\x46\x2c\x49\x05\xe6\xe6\x3e\x34\x30\xaa\x7f\x6b\xd8\x71\xc1\x24\x2c\x1e\x67\x1f\x6d\xc0\x4a\x4c\x18\xe2\xa4\xc0\x9f\x88\x88\xfa\xff\xdd\x54\x86\xbf\x7f\x2a\x9d\xc1\x5f\xe1\x7e\x39\x34\x0f\x51\xcf\xc3\x9a\xfd\xbb\x57\x0e\x0f\xcf\x43\x36\xe7\xd1\xa0\x58\x62\x71\x68\x57\x86\x0f\x98\x8a\xc4\x3b\xa7\xf5\xdc\x24\x99\x58\xa9\xd3\x22\x29\x4b\xe7\xc6\x1a\x17\xa4\x32\x0b\x6e\x66\xd4\x34\x61\x5b\xd2\x4b\x04\x05\x51\x31\x91\xf4\xa0\xa4\xd2\x93\xfc\x91\x84\xca\x09\x48\x8c\x05\x8e\xcf\xf9\xb8\xc1\x47\x70\xa4\x48\x82\x89\x39\x4a\x44\xfe\x83\x12\x58\xf1\x59\x12\xd8\x04\xa2\x7a\x02\x5d\xfb\x90\xa9\xd7\x94\x94\x6c\x0c\x51\x63\x9b\xd2\xb8\x5d\x18\xde\x44\xd3\x1b\x1e\x3b\x34\xb6\xa6\x43\x5f\x7f\x6b\xfd\x31\x52\xb3\x7c\x26\xfc\xef\xaa\xa4\x39\xb1\x0d\xb4\x25\x14\xc0\x20\xc3\xdb\x10\xe7\x7e\x88\x7d\x58\xcb\xd4\xdd\x10\x2e\xf6\x66\x63\xfd\x3d\xd9\x41\x7f\x58\x29\x01\x3d\x29\xc2\xfd\x81\x6c\x81\xe6\xdc\x17\x18\x6d\xe9\xa8\x33\xc8\xba\x1e\x9a\xad\xf9\x6b\x5e\x14\x80\xd5\x20\x8e\xa8\x9d\x17\xe9\x2a\x80\x30\x29\xc0\x5a\x0c\x8a\x84\x61\x12\x75\xe0\x0e\x24\xdd\xda\xd8\xc7\xe2\xec\xd5\x00\xf1\xdb\x5b\x64\xa8\x5b\xc4\x8f\xc0\xa5\x90\x82\x48\x92\x6b\x7a\xf1\x53\x43\x7e\x9e\x67\x6e\xc4\xcc\xbf\xfd\x1e\xe3\x1d\x31\x5d\x1c\x86\x0e\x62\x69\x94\x9c\x2a\x5f\x36\x6b\x37\x56\xb5\x04\x52\xb9\x1d\x61\x8b\x7e\x72\x31\xf3\x5b\xa5\x75\xfd\x06\x1c\x95\x8e\x34\x46\x25\xb5\x61\xa0\x46\x5f\x7f\x67\x81\x6a\xad\x29\x05\x1b\x59\x3d\xd3\x00\x66\x0c\x4a\x32\x0d\x2d\x8a\x64\xb8\xeb\xde\xcb\x9b\xdb\xf2\x9a\xa5\x2c\xbf\x05\x1b\xfd\x72\xbb\x4d\x0d\x5d\xb1\xa6\x94\x66\xdb\x2d\xd2\xed\x9b\x8d\xb8\xa7\x16\x2a\x75\x4f\xb6\x60\x9f\xd2\xf5\xa1\x6d\xcd\x5d\x21\x62\xe9\xab\xf5\x1e\x51\x69\x5e\x37\x01\x43\x2d\x6f\xa3\x27\x96\x1c\x6f\x24\x92\x9c\x94\xa4\xc0\xf1\x9a\xf4\x51\xda\xa7\xb4\xdc\x6e\xd7\xe6\x75\x86\x5a\xb2\x6e\xd6\xae\xdd\x5c\x18\x36\x3e\x5b\xc4\x8d\xd3\x07\xdc\xbf\x74\xcf\xf3\xcc\xd1\x42\x29\x1c\xb5\xc6\x36\x9a\x15\x6c\x1d\x6c\xa0\x9a\x1a\xa0\x46\xac\x94\x60\x4e\x4b\x2f\xd8\x5e\xfa\xbb\x46\x3d\x77\x8f\x4e\x00\x68\xae\x7c\xd0\x24\x57\xd4\x88\x94\xc6\xf3\x2e\xbf\x99\x55\x54\x2e\xc5\x5a\x63\x48\x3b\x37\xd3\xe2\xe4\x40\x3a\x9a\x93\x82\x64\x38\xee\x5f\xd5\x98\x65\xbb\xf5\xbf\x3a\x63\x70\x6f\xb7\xa8\x7f\xca\x51\x4a\xe6\x78\xbb\xd5\xbf\xd6\xa4\xa8\x83\xbd\xcc\x27\xa8\x63\x64\x2d\xa0\x33\xfd\x87\x61\x67\x32\x2a\x61\x58\x5d\xc7\xbe\x6b\xdb\xc0\x81\xa1\xb1\x19\x38\xee\x52\x55\xfd\xd3\xb7\x5c\xdf\x7d\x25\x26\x12\x69\x34\xa2\x8f\x8d\xc1\x1a\x35\x9e\x28\x48\x75\xa6\x69\x46\xe6\xbe\x58\xea\x4c\xed\xc5\xc2\x6e\x3d\xa7\x61\xcc\x10\x18\x3d\x95\x61\xc8\xa6\xa7\xb2\xe3\x39\x8d\x6e\x46\x38\x6e\x79\x2f\x9c\x36\xa8\x75\x08\x0d\xed\x13\xe9\xdd\xd1\x83\xac\xd7\xa5\x89\x6d\x8c\x37\x8a\xba\xdf\x63\x5f\x28\x3e\xd6\x48\xf7\xc4\x19\x63\x1a\x8c\x36\x1a\x06\x9a\xb2\xf0\x80\x16\x13\xab\xe0\x7b\xfc\x0d\x04\x72\x71\x6f\x27\x0c\xf8\xb8\xc9\xcb\x78\x8f\x5f\x98\x50\xd6\xf0\x27\xba\x34\x32\xee\xd7\x6c\x4e\x29\x2d\x26\x90\xa8\x57\xc6\x97\x7a\x19\x09\x9d\xbd\x6d\x29\x3d\xe7\x13\xfb\x7b\xb3\x8b\xcd\xaf\x71\x25\xb6\xb0\x0e\x3d\x6a\x5a\xcc\x62\xfd\x0f\x65\x3b\x4d\x77\xd4\xbd\x40\x38\x0f\x27\x59\x6f\x45\x54\x1a\x3d\xfe\x16\xe2\x81\x9a\xd5\xb0\xf3\xfa\x0e\xa2\xce\xb8\xc9\x78\xd6\xc0\x76\xe9\x6b\xdb\x9d\x3e\xad\x2e\xfc\x7b\x5e\x1c\xd9\x33\xde\x72\x4f\x7e\x38\xd6\xe6\xd3\x2f\x92\xa8\xbd\x17\x49\x14\x09\x7c\xb3\xf7\x0b\xd5\x7a\x38\xd7\x45\x7c\x67\xd5\x5b\xc5\x9e\x9a\xc7\xf1\xfc\x62\x82\x9a\x2a\x22\xa2\x1a\xca\x20\x1c\xab\xa6\x06\xad\x91\x4b\xf8\x9e\x7a\x89\x7b\xea\xa7\x6f\xbd\x03\x62\x69\x74\xdf\x4f\xaa\xb6\x1b\xae\x46\x33\xc6\x0a\xca\x11\x41\x2b\x8d\xcf\xb8\xd3\xf2\x56\xd6\x2f\x34\x1b\x19\xfa\x79\xb2\x72\xcd\xa8\x31\xae\xd0\xf4\x35\xbb\x9b\xc0\x6b\x0d\xc6\xff\xdd\xbc\x02\x6d\x3e\x8d\xd6\x08\x4c\x63\x55\xbb\x33\x8f\x3b\x2e\xf6\x44\xf6\x95\xfa\x8f\xb0\x4a\xfb\x59\xa9\xa5\x2c\x47\xec\x29\x0b\x5b\x0f\x34\xdb\x8e\x35\x71\x3c\x71\xe4\x93\xde\x9c\xda\x6b\x63\x82\xf4\xf4\xad\x52\xeb\x29\x9f\xf8\x98\x8a\x9e\x10\x8e\x63\x81\xe3\xfd\x44\x0f\x50\x73\xa4\xfc\xf7\xd3\xed\x51\xf1\xfa\x68\x22\x59\x7a\x82\x7d\x09\x7d\xba\x27\xb3\x73\x0d\x6c\xb7\x4f\x1c\x76\x98\x20\x45\x4b\x20\xed\x3a\x28\x53\x78\x6a\xda\xa9\x8a\xe1\xe9\x6d\xa4\xa8\x93\xe6\x35\x73\x3c\xa5\x4e\x67\xb7\x7d\x6a\x34\xfc\xa0\x78\x01\x33\xe8\xbb\x15\xd3\x2b\x04\xcd\xd9\x17\x5f\x34\xc4\x80\xf6\xef\xd4\xc0\x3d\x7c\xd9\x2e\x40\x29\x4a\xf3\xfb\x86\x7a\x4f\x5d\x4f\x41\x74\xcf\xaa\x7c\xe3\xaf\xca\xfa\x9f\xba\x2a\xd9\x3d\xdd\x7e\xe7\x77\x9b\xdd\xdf\xad\x51\xef\xdb\x27\x95\xba\x47\x01\xba\xe0\xfb\xcb\x7a\x8a\xa8\x7b\xc6\x55\xd9\xf3\x6f\xb7\xea\x90\xad\xba\x91\x75\xf9\x29\xcd\xc2\x4d\x0d\x12\x94\x6e\x26\xe9\x39\xcf\x3f\x7f\xa9\x9b\xba\xb0\xc3\xeb\xbe\xf2\x9f\x07\xda\x9b\xe1\x68\xe8\x2f\x7d\x7a\x78\x18\x44\xfe\x8e\x3d\xbf\xf2\x1e\xec\xd8\xd3\x08\xab\xda\x0a\xbd\x4e\xab\xfd\x9a\x4a\x85\x82\x60\xa0\xba\x87\xc3\x9b\x5d\x82\x11\x50\x5b\x59\xac\xaa\x5b\xbb\x0e\xac\xa1\xda\x4f\x9d\xdd\xc9\xb8\x42\x67\xf6\x64\x9e\x49\xbb\x14\xaa\xf5\xa8\xca\x81\xa1\x10\xf7\xba\x61\x73\x75\xb8\x3e\xaa\x87\x87\xcf\xdd\x51\x75\xea\x52\x5e\x57\xe7\x26\x48\x47\xef\x63\x3d\x38\x7d\x18\x1f\xb8\x16\xe6\x09\xb7\xaa\x2a\xd7\x07\xea\xbe\x71\xc0\xb4\x6d\xd4\xa2\xc6\x28\x4c\x4b\xd7\xde\x20\xe6\x0f\x1d\xc4\x2e\x9f\xa3\x57\x12\x29\xbc\xdd\x9e\x29\x54\xf9\xee\xf7\xcc\xba\x1e\x5a\x48\xc3\x8a\x37\x26\x63\x29\x95\x6e\xaf\x95\x65\x7d\x74\xad\x94\xd6\xee\xf7\x04\xb6\xa4\xf2\x62\xdb\x03\x3d\xde\x01\x7a\x8d\xf0\xa1\xfd\x4a\x54\x68\xee\x10\x4d\x14\x12\x81\x3b\xe1\x8c\x57\x70\xc6\x2b\x38\xe3\x87\xe1\x8c\xdb\x07\xc4\xe4\x84\x7b\x10\x67\x4f\x69\x1b\xe2\xf4\xb1\x8b\xeb\xfb\x24\xae\x62\x82\x78\xb0\x51\x37\xb8\xee\x2a\xe8\x41\x82\x39\xf5\x72\x92\x75\x95\xbb\xee\x68\x70\xde\x2c\x68\x77\x95\x9b\x5d\xe5\xb8\x7b\xc5\x6a\x84\x03\xfb\x69\xb6\x90\x1f\xd8\xc2\x1b\x1f\x3b\x75\x6d\x95\xe8\xd8\xaa\xca\xed\x80\x51\x88\x62\x04\x23\x32\xa2\x4d\x0d\x5c\x7a\xb7\x74\x7b\x9d\xbb\x25\xaa\xdd\x12\xd5\x6e\x89\xc3\xbb\x55\xf5\xe0\x54\x89\x9a\x0e\xe3\x31\xfc\x75\x9d\x8a\xc6\x3e\xea\x01\x88\xf6\x3e\x4a\x62\x6a\xc4\xa5\xc9\xd7\xa3\x6b\x6d\xe3\x43\x7a\x5a\xb7\x6a\x7f\xd8\xaf\xed\x8a\x66\xad\xa2\xd7\x9f\xd5\xd1\xbc\xaa\x6d\xf7\x5c\x98\x3d\x17\xf8\xe0\xd2\xaf\x5c\x95\x7a\xdb\x95\xa6\x3b\x3a\xb7\x7d\x81\x04\x29\x4c\x44\xf9\xfa\x35\x1c\x33\x45\x1b\xdc\x80\x16\x64\x4e\x0b\x3a\x24\x2b\x13\xd3\xcf\x6e\x5a\x16\x86\xf3\xa7\x79\x15\x26\x6e\x30\xc0\x9b\xcc\x50\x98\xdf\xcf\x27\x68\x45\x33\x92\x19\x55\x51\xbc\xa2\x59\x45\x0c\xeb\xf6\x17\x74\x89\x04\xc9\x48\x3e\x9d\xcf\x48\x8a\x3d\x69\xc8\xa2\xd2\xbe\x67\x61\x88\x32\xba\x72\x8e\x6d\x2c\x0c\xb3\x8a\xe8\x5c\xf8\x44\xa7\xd2\x4d\x61\x52\xd0\x04\x2d\x48\x41\xe6\x95\x6b\xd5\x7a\x52\xd2\x45\xbc\xae\x88\xe8\x05\x59\xd3\x05\xc9\xe8\x4a\xaf\xa3\xe6\x13\xf3\x56\x88\x20\x6e\x5a\xf2\xa5\x33\xd6\xa9\x76\xdc\x9a\x28\xca\xe8\x15\x12\x6e\xfc\x18\x04\xa1\x09\xca\xf6\xba\xcf\xbc\xee\x33\xb2\xa6\x59\x65\xa9\x5a\xee\x74\xbb\x19\x95\xd0\xe7\x5e\xfb\x2b\x7a\x83\x32\x22\xc8\xdc\xef\xa3\xe6\x7d\x57\xfe\x02\x64\x91\xe1\x56\xdd\x98\x57\x00\x45\xf3\x78\x65\xee\x42\x3d\xb2\xd5\xde\xc8\x56\xde\xc8\x56\x64\xad\x17\xba\xa6\xed\xb3\x2a\xa4\x76\x97\xd5\x08\x12\xc6\xae\xc4\x33\x4d\xbe\x44\x00\x41\xa4\x74\xea\xdc\x33\x85\x52\x3c\xee\x90\x1a\xac\xad\x4c\xb3\x8e\x28\x8e\x52\xba\x36\x3c\x2b\xcc\x12\x72\x47\x41\xad\x5d\xce\xa8\x85\xc3\x39\xcd\xc9\x8a\xe6\x74\x48\x2c\x43\x74\x49\x53\xfb\xc0\xa7\x03\xc9\x79\x18\xf6\x2f\xcd\x63\x9f\xab\xc1\xc0\x2b\x80\x37\x73\x0b\x9a\xab\x09\x5a\xd0\x39\x99\x5b\xd0\x5c\xd0\x79\x03\x34\x6f\xe9\x12\x15\x64\x4e\x2e\xed\x25\x5c\xfa\xd0\x79\x8b\x37\xf3\xed\x16\xcd\xe9\xc2\x03\xcb\x79\x05\x96\xb7\x4d\xb0\x84\x25\xcf\x69\x82\x6e\x49\x4e\x56\xd5\xea\x67\x93\x35\xbd\x8d\xab\x03\x41\x6f\x49\x46\x6f\xc9\x9c\x82\x7b\xb3\x19\x7c\x0d\x90\xd0\xc6\xda\x1b\xc2\xdc\x02\xe4\x81\x69\x3a\xe6\xef\x92\x5e\xa1\xc2\x9b\x84\x06\x1f\x3d\x94\xcb\xbd\xa1\x5c\x7a\x43\xb9\x24\x19\xbd\xac\xc3\x34\x02\x88\xce\xa9\x84\x51\x7c\xba\xc7\x1b\x90\x15\xae\x5a\xbd\xd6\x40\x7b\xe9\x2f\xcf\xff\x9f\xbd\x3f\x6f\x6e\xdb\xf6\x16\xc6\xf1\xb7\x22\x71\x32\xbc\xc4\x35\xac\x4a\x76\x9a\x85\x0a\xaa\x49\xbc\x34\x69\xb3\xda\x49\xd3\xd4\xf5\xe3\xa1\x25\xc8\x62\x4d\x01\x2a\x08\x79\x89\xc4\xf7\xfe\x1b\x1c\x2c\x04\x17\x39\x4e\x3f\xfd\xdd\xef\x33\xcf\xdc\x7f\x24\x10\xfb\x7a\x70\xce\xc1\x59\xa6\xb5\x4d\x7b\x06\x9b\x76\x11\x9f\xe9\x4d\xfb\xbd\x7d\x85\x1a\xef\xdc\xb4\x99\xde\xb4\xcb\xa2\x2e\x25\x46\xb1\xc0\x89\x82\x7e\x8d\xfb\x29\x71\x5d\x4f\xc2\x30\x29\x6f\x16\xb7\xe0\x89\xf1\x93\x96\x90\xa4\x76\xcb\x20\xe3\xae\xe1\xae\x3a\xb5\xc7\x06\x73\xef\x25\x2d\xf7\x1e\x8d\xcd\x69\x82\x76\xe0\x40\xe4\x44\xd8\xbd\x9e\x6b\x0d\xb5\xdc\x60\x08\x4b\xf8\x1a\xf4\x09\x21\x20\x9c\x3d\xf2\x3a\x1c\xe7\x36\xac\x23\xd1\x0a\x04\x15\xec\x5c\x22\x2c\x48\x16\xe5\xd8\x2b\x31\xaa\x0f\x28\x36\x11\x78\x8c\xf4\x83\x95\xc2\x97\x73\x9c\xe8\x2f\x83\x23\x52\x22\x4a\x95\x38\x10\x85\x30\xc7\x44\x6a\xb9\x88\x9c\xb8\x36\x0b\xbf\xb1\x48\x28\x5c\xb4\xde\x64\x3b\x6a\x3a\xc6\x89\xde\x22\xd5\x76\x51\x1c\x8d\x15\x96\x9f\x6c\x28\x85\xf0\xd8\xf6\x5a\xa8\x5e\x8f\xfd\xd2\x63\x77\x35\xa6\x11\x2d\xb1\x01\xa3\x0b\x98\x9b\xf9\xb7\xa8\x95\x31\x8c\x6b\xe6\x3d\x87\xaf\xc7\x0a\x47\x03\x6d\x73\x98\x5a\x51\x9d\x5a\xbd\xc1\xee\x9a\x29\xe1\xcf\x94\x50\xa5\x5c\x15\x85\x50\x04\xc7\xe6\x71\x55\x6b\x6d\x0e\xe4\x1a\x06\x52\x72\x73\x35\x72\xf7\xf4\x1b\x1d\xd6\x28\x9c\x13\x0c\x4e\xcc\x99\xbe\x73\x08\x85\x50\xf4\xcd\x5d\x3d\xbd\xa3\xae\x66\xc7\x2f\xbf\x6b\x05\x1e\xda\x01\x85\xa1\xd8\xc4\x1f\xd0\xb6\x32\xfc\x98\x6a\xe6\x2a\x33\x00\x72\x57\xa3\x36\xad\x6e\x95\x01\xf0\x9f\x2c\xf5\xf4\x9f\x2d\x75\xd1\x86\xaf\x27\x2d\xf8\xba\xf3\x17\x9e\x90\x20\xd8\x4a\xec\x63\xab\x08\xc3\x47\x76\x02\x47\xd1\xc6\x2d\xac\x68\x69\xdb\xeb\xfc\x8e\x9e\xd6\xbb\x8a\x53\xe3\x62\xe7\x9d\x88\x12\x87\xb7\x5e\x38\xd8\xab\x92\x0e\xa4\x97\x74\x56\x49\xca\xc3\x10\x28\x97\xc4\xfa\xa8\x54\x4b\x83\x9c\xcb\x74\xd8\xc5\xb0\x65\x76\xb4\x7f\xf4\x41\x3c\x76\x72\x0c\x0a\x9d\xd8\x09\xf0\xb8\x6a\xf6\x66\x0c\x66\x6f\xd6\xeb\xc0\xbd\x58\x95\x86\x3d\xcc\x66\xf6\x2c\x32\x38\xc9\x20\x9c\x79\xec\xf0\xa4\xaa\xb0\x51\x17\xd8\x1c\x1a\x23\x13\xc4\x3d\x2a\xbc\x11\x96\x23\x06\x64\xdc\x7b\x01\xe4\xb7\x56\x74\xa8\xaa\x04\xa6\xcd\xe7\x18\xe6\xec\x05\x50\xf3\xe8\xa1\xbf\xaa\x4f\x4e\x83\x9d\x27\x6d\x6e\xa3\xb4\x11\x18\x2d\x80\x51\xea\xec\xa9\xdd\xaf\x28\x83\xee\x00\xe1\xa5\x36\xa1\xc8\x2a\x2f\x89\x87\xbc\xd4\x21\x34\x8f\x3a\xcc\xf8\xbe\xf5\xd4\x92\x2b\xed\x63\x3d\x2b\xc0\xa1\xaa\x0a\x3a\x18\x0b\x13\xb8\xf6\x06\x4e\x1c\xbf\xd5\xf6\xa6\xaf\xca\xc2\xac\x54\xf8\xb8\xa5\x12\x6a\xf9\x0a\xef\x5e\xcf\xcd\xee\x1b\x69\xcd\xb7\x5a\x6c\x23\x02\xf8\x6c\xe6\xd5\x0d\xc5\x2e\x18\x86\xa6\xb8\x7d\x64\x57\x13\x33\x77\x31\xa5\x49\x8c\x1a\x03\x17\x79\xd0\x55\xbb\x9f\x51\x9d\x87\x67\x1c\x63\x8c\x04\x76\xe1\x6e\xe0\x64\x1b\xac\xbd\x96\x15\x75\xa6\x48\xf4\x5a\xc3\x33\x40\x55\xb6\xa4\x21\xbc\xaf\x51\x63\xb7\xbb\x4a\x3e\x91\x7b\x81\xa1\xa5\xf1\x12\xea\x29\xb7\x30\xb5\x06\x06\x7d\xba\x57\x43\x95\xaa\x2b\x8f\x11\x85\x5b\xfc\xda\x42\x4d\x2a\xc6\x73\xa5\x77\x36\x77\x15\xc1\x6d\x60\xdf\xd0\x9c\x56\x2e\x6b\x51\x0f\xc1\x79\xc7\x46\x06\x6f\x95\x84\xd5\x8f\xe9\xd4\x3c\x5c\x1f\x53\xb0\x52\x6b\x14\xff\xf1\x82\xd0\xde\x32\xa7\xc7\xb7\x6c\x7c\x3c\x9e\xd1\xc9\x12\xa6\xe0\xdc\x65\xdf\xa7\x0b\x91\x72\x91\xca\xf4\x2b\x3d\x5e\x9e\x4b\x41\x29\x9e\x11\xed\xd1\xe3\x25\xcf\xa5\xdd\x3b\xf3\x5a\x1c\xf4\x45\x51\x20\x3d\xaa\x20\xdf\xcb\xdb\x89\x30\xb6\xae\xd5\xe6\x3e\x83\x03\x92\x53\x59\x8b\xbf\x22\xac\x27\xc5\xed\x47\xbe\x97\x25\xe9\xfc\x2d\xbd\x31\x19\x92\xf3\x8c\x5a\xa9\x93\x21\x25\x97\x0a\x3b\xc9\x70\x55\x38\x89\xd6\xb5\xdb\x8a\x8d\x19\x8c\xec\x6e\xa1\xd1\xcf\x5b\x42\x7b\x4d\x71\x30\x7c\x43\x3c\x3b\x91\xd5\xa4\x6b\x42\x7b\x4d\x61\x29\x7c\xe9\x24\x71\x2a\xf1\x56\xe4\xec\x9c\x5e\xa4\xec\x33\x17\x97\x6d\xce\xa1\xc1\x91\x6f\x43\x26\xbe\x1e\xf3\x93\x03\x4a\x7a\x03\x0d\x5b\x36\x50\x3f\x2e\xcf\x18\x1c\xa8\x1f\x8d\x5c\x8c\x30\x02\x0f\x38\xab\x89\xd3\xe0\x03\x23\x25\x63\xea\x3e\xd0\x92\x32\x07\x70\xe9\x46\x99\x0a\xd4\xa0\x57\x03\x5f\x17\x0e\x5f\x17\xad\x76\x30\x85\x81\x87\x23\xdd\x55\xb2\x83\x33\x02\xbb\x1a\xdf\x46\x00\x06\xaf\xf5\x03\x90\x24\x1a\x18\x77\xfb\x38\x43\xf0\xd2\xa6\x72\xb7\x82\x4b\xbd\xca\x59\x69\xa6\x08\x59\x4e\xf2\xc0\x60\x72\x99\x1d\x30\xab\x0f\x58\x34\x44\x8c\x4a\xf1\x18\x64\xaf\x10\x90\x61\x17\xc8\x39\x45\x2e\xaf\x16\xa1\x8d\x68\x49\x62\xae\x03\x8b\xb7\x08\x3d\x8f\x58\xe8\x09\x04\x8f\x0f\x19\x08\x1e\xd6\x27\x30\x31\x22\x53\x4d\x30\x5f\x8e\xc7\x01\x0f\x0b\x06\xcc\xb7\x9d\x39\xa7\x4f\xe7\x6e\x4e\x0f\x20\x8c\x2c\x2c\x8d\xa3\x9b\x48\xd6\xd4\x92\xdc\x74\x0b\x10\xd8\x16\xe4\xd2\xbe\xaa\xea\xd9\x57\x17\xf8\xd0\x40\x23\xcb\x88\x51\x2d\x66\x55\xe1\x1b\x0b\xa6\x41\x51\xae\x29\xb4\x64\xe4\x93\x8c\x7b\x73\xfd\xa3\x9a\x84\x02\x67\x91\x5a\xec\xa5\xd5\x63\x10\x24\xeb\x19\xc7\xba\xf8\xa0\x72\xb9\x46\xa5\x48\x87\x0d\x99\xe5\x0e\xc3\x83\xde\x0c\xc0\x03\x0d\xc3\x0b\xf0\xa7\x56\x99\xe5\x1d\x7b\x3f\x92\x12\xa3\x10\x98\x81\xda\x44\x84\xdc\x96\x6a\x5e\xc1\xfe\x9e\x32\x99\x5d\x57\xed\x1e\xfb\x31\x06\xd3\x4e\xb6\x4b\x61\x78\x65\x27\xc8\xf9\xea\xdf\xab\x6f\x32\x7b\xbe\x44\x7d\x3b\x5a\x36\xb7\xa3\x98\x23\x41\xf6\x2c\x93\xe7\x61\x80\xf0\x81\x7d\x27\xa0\xa3\x1a\x98\x33\xaa\x0d\x4d\xe1\x2e\x11\x86\x7b\xc0\xe1\x8e\xf6\x88\x28\x89\xc5\x69\x94\x61\x81\x46\x7b\x50\x61\x7c\x10\x86\x53\x38\xe0\x75\xc9\x9f\xc1\x23\x84\x0d\x22\xb4\x33\x78\xf8\xf8\xe1\x93\xdd\x47\x0f\x1f\x6b\x19\x97\xee\x22\x0c\xcf\x75\x2d\x51\x43\x9f\xad\xcc\x8c\x8d\xc2\x40\x1c\xe9\x89\xde\x6b\xee\x75\x51\x99\x67\x3b\xc5\x76\x86\x1f\xd5\x1e\x01\xec\x1c\xd3\x0d\xb3\x47\x41\x62\xb6\xd6\x46\xb3\x51\xbd\x6b\x75\x13\x4f\x62\x0d\x5f\x1e\xeb\xcf\xc7\xe5\x09\xab\x35\x51\xce\xaf\xd5\xfe\xc9\xca\xb5\xca\xb4\x06\x4c\xb5\xdd\x72\xf1\x2c\x38\xce\x14\xca\x53\xc9\xa4\x05\xf3\x00\x4c\xd5\x3a\x2d\xac\x55\x40\xb5\x64\xbe\x86\x60\x15\x49\xf6\xcf\x89\xda\xd9\x1a\x57\xae\xc7\xb6\xc1\x4c\x0f\x39\x84\xa1\x3f\x6d\xd8\x9c\xef\x3c\x34\x50\xf4\x6e\x4c\xa3\x79\x99\x34\x21\xa9\x9b\xab\x6f\x4d\x55\x1d\xd4\x66\xda\xa4\xbd\xdf\x77\x42\xb2\x16\xc0\xeb\x41\x40\x7d\xe2\xdf\xbb\x13\xaf\x80\x4e\xbc\x11\xdc\x66\x1b\xc1\xed\xa0\x6f\xf9\x01\xdf\x1e\xa0\x55\x77\xaa\x8e\xab\xe5\xe2\x68\x8e\xa6\xed\x1a\xb1\x14\xd3\xfd\x2e\x07\x6b\x41\x1f\x26\xf1\x51\x80\x8a\x02\x03\xa6\x71\x98\xa4\x19\x9d\xb4\xe2\x1b\x2d\x58\x43\x0b\x92\x59\xc1\x44\x2b\xad\x3c\x2e\xa9\xc0\x0a\xd4\x78\xf4\x10\x37\x56\x82\x69\x63\xff\x0d\x5c\xdf\x61\xe6\x16\x9c\xe3\xfb\xa2\x40\xfa\x31\x10\x90\x83\x86\x3e\xbd\xac\xeb\xd3\xe3\xbb\xc8\x4b\x7d\x25\xd5\x28\x4c\x73\x4f\xed\x10\x27\x8b\x07\x60\xa5\x3c\x53\x0d\x88\x62\x28\xb3\xfa\x35\x62\x28\x30\x47\xa6\x55\xac\xb1\xbd\x76\xca\xc4\xbe\x5c\x15\xb8\x3c\xf1\x24\x23\x0b\x2d\xe0\x47\x8d\x31\x78\x87\x69\x26\x2e\x4a\xbb\xc4\x30\xd1\xa9\xc2\x64\x17\x6a\xb3\x1a\x1d\x34\x10\xf2\xc7\x63\xb0\x25\xc6\x40\x25\xcd\x4f\x50\xc0\x45\x77\x9f\x2e\x12\x61\x64\x66\xf1\x12\x84\xf6\x8d\x83\xcc\x31\xc5\x13\x6d\x27\xfb\x88\x73\x59\x45\xee\xa7\xda\xb7\x9e\x4f\x05\x2c\x74\x5e\x3f\xea\xbc\x9a\x4b\x17\x9d\x01\x39\x0b\xad\x7e\xe4\x1a\xc5\xa7\x2a\x87\x1b\xc9\x7c\x43\x86\xca\x70\x15\x75\xa1\xaa\xae\xd3\x16\x06\x2d\xba\xb2\x81\x5b\x2b\x63\x69\xb9\xe0\xbd\xf9\xd2\x0a\xb2\x9c\x95\xb2\x8f\x68\x55\xe0\x2b\x52\x3f\x2b\x75\xa5\x2e\x14\x86\x22\x92\xa8\xc0\xb7\xb5\xac\xa0\x07\xd3\x05\x2d\x52\xc8\x80\x62\x1e\x2d\x47\xc1\xce\xee\x8f\x41\x1c\xec\xec\x3e\x0a\x10\x5e\x8d\xf9\x7c\x91\x51\x49\x5b\x4f\xa5\x66\x62\xd7\x25\xdd\xcd\xeb\xbc\x7d\x92\x5a\xd6\xc1\x4c\x5c\x5e\xb9\x2d\xe7\x27\x0c\xab\xd7\x37\x30\x42\x2a\x1a\xea\x56\x82\xa1\x04\x05\x83\xe6\x5d\xe0\x70\x4f\x2a\x2d\xd6\x53\xc3\x0f\xcf\x55\xbc\x36\x8c\x22\xad\xe9\x14\xa9\x85\xd9\xcb\x93\xb3\xac\x31\x18\xc2\x30\x5a\x3a\xb1\xde\x7a\x62\x23\xb7\xe9\x2a\x6b\x4a\xb8\xc2\xf1\x5a\xaf\xa3\x0b\xb0\xb6\x59\x9e\xa0\x90\x6c\xef\x22\x7c\x56\xed\xf2\x8f\xf1\x14\x22\xc8\x24\xd2\x74\xd1\x8d\x45\xda\x2a\xec\x09\x1d\xf2\x65\x6f\x61\x81\xae\x1b\xb6\x35\x2e\x2b\x43\x3c\x20\x8b\x08\x0d\x2f\x49\x1e\x5d\xe2\x1b\x7c\x8d\x97\x98\x29\xfa\xe9\x0a\x16\xd9\x45\x21\xf0\x5e\x34\x05\x16\x07\x88\xe7\xb6\xb0\xa7\xac\xd1\xd5\xee\x12\xd5\x64\xa0\xca\xe6\x8c\xa1\xa2\x47\x86\x65\x02\x36\xa8\x55\x07\xb0\x9a\x09\x04\xae\x7e\x31\x35\x3b\x56\xcb\xc1\x53\x92\x45\x37\xd0\x2b\xb8\x72\x68\x3c\xe5\x22\xba\x76\xec\x15\x33\xfe\xeb\xd2\x8c\xec\x75\x69\x46\x16\x82\x48\xa1\x85\xd7\xbe\x0b\x15\x7b\xcd\x3d\xec\x9a\x2c\x6e\x65\xae\x2d\xff\xe6\xba\x6a\x5b\xf6\x1a\x5f\xdb\xb4\xa1\x5a\xfe\x94\x2d\x69\x91\x4e\xa3\x6b\x30\x6b\xe5\xd9\x97\x35\x23\xbe\xae\x5a\x99\x75\xb1\xba\xba\xf5\xfa\xba\xb4\x35\x2b\x9d\xcf\xbd\x6b\x97\xa1\xb8\xae\xdb\x99\xb5\x29\xd0\x0f\xcb\x55\x56\xb4\x0f\x4c\x8d\x99\xb0\x0a\xe2\xa5\x71\x8c\x3b\x17\xac\x71\x6a\x1e\xc5\xda\x6f\x64\x73\x2b\x69\xaf\x0f\xf5\x9d\xb4\x44\xce\xd2\x6e\x43\x64\xfa\x3b\x36\x01\x55\x3b\x1b\x33\xb7\x0f\x46\x73\xb0\x7f\xa9\x86\x14\xfb\x43\x4a\xa2\x25\xa6\x98\xd5\x64\x95\x2c\x2a\x1c\x35\x20\x0d\x32\x46\x96\x7e\x0c\x0c\xbc\x20\x4f\xf0\x0d\x78\x23\x85\x4d\x04\xbb\xa8\x1c\x62\x18\x46\x6e\x59\x24\xba\xc7\xc6\x5a\xaf\x1f\xba\x3d\xc6\xa3\x60\xe7\xe1\x63\x0f\x21\x7c\xea\x92\x6e\xb4\x1e\xef\xb5\x7e\xa1\xab\xe0\x58\xdf\xbb\xe3\xfe\xe7\xb7\x98\x99\xe7\x6b\xb2\x74\x66\x4c\x96\xe4\x3a\x5a\x1a\x0c\xe2\xc6\xe1\x0a\x25\x02\x6b\x88\x3e\x88\xd5\xc4\xde\x52\xa3\x86\x66\x30\x9a\x8e\x29\x25\x02\x15\x39\xe3\x41\xbb\xa7\xb1\xc5\x66\x5b\x10\x7c\x1f\x76\xd7\xa0\x64\x1f\x30\xbd\x47\x6a\x0d\x9a\x08\xa6\x87\xc7\x1c\x19\xb9\x7c\x4f\xda\xdc\x73\x1c\x5e\x53\x90\x40\x52\xdc\xae\x98\x36\x89\x60\x0c\x67\x28\xfc\x57\x0b\x5b\x15\x55\x44\xc8\x5c\x7c\x2d\xbc\xa4\xb9\x0c\x43\xb0\x96\x81\xab\x6f\x1a\xaa\xe5\x76\xeb\x7a\x2d\x95\xf0\x9a\xba\x8c\x36\xea\x01\x1d\x34\xa2\x50\x2d\x46\x94\x5a\x55\x13\x71\x7b\x55\x51\x73\x84\x1e\x6e\xfd\xa3\xee\xad\x17\xf3\x38\xce\xaa\x9e\xa9\x2a\xec\xde\x3c\x0c\xd3\xaa\x4b\xf2\xcc\xda\x8e\x2d\x2d\x7f\xa7\xd3\x48\x78\x4c\x09\x69\xef\xc5\x3c\x0c\x1f\x3a\x2b\xe0\xad\x76\xbc\x4b\xa0\xd2\x72\x08\xee\xb4\xe9\xfd\xfd\xe6\xbc\xe1\xc4\x6e\x36\x36\xee\x4b\xef\x97\x82\x09\x9e\x7f\xe6\xdd\x32\xf8\xd0\x06\x2b\x4f\x42\x95\x69\xc1\x8c\x74\x07\x38\xb1\xa8\x60\x6a\x51\x41\x63\xaf\x9c\xa1\x15\xf3\x3c\x33\x00\x20\x53\x69\x55\xb4\xcb\xe8\xab\x3c\x02\x69\x1c\x6f\xcf\xfd\x18\x27\xc4\xb3\x08\x8e\xc1\xb2\xbf\x05\x0e\x06\x3f\x32\x0b\x58\xc9\x58\xb3\xeb\x93\x92\x6e\xbf\x62\x23\xd7\xcc\x27\x23\xdd\x7e\xb1\xc9\x9a\x7b\xa6\x56\x3a\x1d\x5d\x45\x89\x7f\x4f\xa1\xf8\xac\x16\x51\x5e\xcf\xce\x25\x44\x42\x36\xd2\xf3\xb1\xdb\x40\xfe\xf3\xcb\x1d\xa6\xe1\xfd\xeb\xfb\xff\x3f\xdb\x0a\x3f\xf4\x28\x31\xb5\x9a\xe8\xbe\x76\xe3\xb5\x32\xa9\xf6\x1a\xb9\x3c\xcf\xd2\xb1\xa3\x1c\xe0\x70\x1b\x1a\x40\xbb\x1e\xf7\x08\x1e\xf0\x67\x49\x2b\x98\xbb\xd1\x2b\xcd\xd5\x49\x9f\xa7\x12\x94\x64\xf1\xc4\x7d\x1b\xca\x69\x4a\x72\xfd\xaa\x51\x7d\x60\xb1\xb9\x54\xac\xc9\x79\x4e\x72\x43\xa8\x69\x0a\x6d\x56\xfd\xfe\xc8\x4b\x52\x69\x4e\xf2\x5e\xca\x72\x2a\xe4\x0b\x3a\xe5\x42\x51\x3d\x36\xe2\x15\x73\xd9\x4c\xda\x19\xf4\x60\xce\xaf\xa8\xae\xf7\xaa\xfa\x7d\x28\xf8\xdc\x15\xb1\xcf\x12\xba\x73\x47\xb5\x7e\x57\x9c\x3d\x4d\x2b\x90\xa9\xc0\xba\xc8\xfb\x2c\x19\x03\xc7\xb6\x92\xd7\x73\xc5\x2c\x9d\xcb\x73\x4f\x3d\x08\x5c\x12\x29\xec\xd4\x3e\xdb\xba\x9d\xef\x41\x92\xf2\xb4\x59\x13\xd1\xe6\x01\xc3\x1e\x5f\x27\xb4\xec\x1f\x46\x59\x39\x8c\xc2\x1d\xc6\xda\x51\x94\x77\x1c\x45\xe1\x8e\x62\xe5\xda\x7b\x34\x08\x50\x31\x78\x14\x7a\x2a\x56\x61\x18\x69\x3a\xa2\x42\x6e\x0c\x1e\x2b\x9c\x5a\xc6\xda\xc4\x3f\xb8\x63\xf0\x4f\x02\x6b\x3d\x09\xcc\x9d\x84\xc4\x79\x0f\x41\x60\x54\x41\x5d\xc7\x2d\x80\x01\x6a\xaf\x9f\x01\xe7\x19\xc5\x7b\xbf\x1c\xfe\x58\x7a\xdf\x78\x64\x83\xba\xed\x1d\x7f\x30\xc8\x1e\xe3\x8e\x1c\xfa\xfd\x32\x17\xc8\x43\x62\x8a\xfa\xf9\x58\x15\x28\xe8\x76\x35\x08\x07\xdf\x09\xd5\x06\xb4\x8d\x88\xf2\x56\xb6\xa3\x2a\x9c\x15\xd3\x8a\xf3\x8a\xac\x04\x77\x10\x44\x6c\x24\x46\x17\x91\xc4\x99\xb7\xc2\x0c\xc1\x33\x68\x2d\x4a\x8c\x66\xd5\x48\x14\x9f\xd7\x22\x2a\x24\x4b\x56\x21\x59\x32\x0b\xf3\xb2\xea\xf0\x32\x9c\x95\x9e\x5a\x3c\x98\x97\x6d\x80\x79\x59\xeb\x4a\x67\x6e\xa5\xb3\x16\x98\x97\xb9\xf4\x22\xab\xaf\xae\x4d\x81\x6e\x38\x08\x67\x4e\xe2\x3e\xcd\x28\x58\x7f\xf3\x0f\x62\xaa\x31\x24\xbb\x3c\xda\x77\x40\xc9\x0b\xf3\xad\x57\x82\xbe\xbf\xfb\xda\x90\xc9\xaf\xc9\xc1\x80\x06\x23\x63\x03\x73\xb1\x82\xfa\x58\x79\x8d\xaa\x09\x57\xed\x7d\xd9\xb9\x2f\xae\x3d\x9d\xd0\xcd\x2f\x20\xce\x34\x8a\x35\x2a\x59\xe1\xd8\x0c\x9b\x46\x79\x70\x29\xae\x38\x89\x18\x4e\x70\x86\x29\x16\x8a\x0e\xf2\xba\xf9\x28\xde\x48\x69\xed\x00\x60\xaa\xbf\x20\x2e\x22\x9f\x0f\xb0\xf9\xbd\x06\xb3\x1a\x97\xb5\x05\xdc\xec\x02\x1b\x57\x4f\xf1\xeb\x74\x4a\xf7\x6e\xc7\x19\xcd\xef\x33\xd1\xad\x73\xfb\xb0\xe2\x2c\xaa\xdc\x8e\x14\xb1\x0d\xf6\x19\x58\xbb\x7d\x06\xcc\x9a\xc6\x22\x22\x54\x6a\xd8\x8b\x3a\xd2\x3c\x6c\xa2\xcb\xff\x59\x93\x46\xaf\x1d\x8c\xf1\xca\xf6\xe7\x48\x19\x86\xd7\x5a\x9f\xb0\x3a\xcf\xac\x3d\x3b\x83\xec\xac\x86\xf4\x58\xfe\x75\x39\x91\x5a\x19\x7c\x58\x45\xe2\x49\x7d\xcd\xf5\x43\x54\x65\xc2\xc3\x70\x19\x31\xa3\xda\x57\x67\x1e\xe3\x2a\xcb\xfd\x91\xbd\xa3\xbe\xb1\x27\x9e\x83\x3b\xbb\x23\x3a\x8d\x9b\x9a\xdb\x75\xaa\xab\xd5\x66\x72\x8b\x50\xd8\x8f\xb1\x8c\xc6\x11\x43\x75\x8e\xbf\x04\xcf\x0c\x25\xa8\x69\x6b\xd9\x4a\x37\x53\xdd\x3a\x0a\x43\x6a\xa8\x3c\x8f\x62\xf9\xab\xae\x26\xed\x3b\x68\x23\xe4\xb5\x31\xbb\xfd\xf8\x61\x80\xb0\xb6\x0c\xc5\x34\xf2\x06\xb8\x8b\xcf\x53\x16\x3a\xde\x67\x49\xab\xe8\x8c\xac\xcc\xc3\x4c\xfc\x5a\x14\x38\xa9\x7e\xa6\x95\x4f\x8b\xfd\x54\xb9\xd5\x2d\x0e\x30\x65\x94\xb9\xe7\x9e\x02\xb7\x31\xc2\x5b\x0b\xa5\x5e\xa1\x3a\xfb\xbb\x32\x75\x2f\x28\xc8\x5c\xe3\x17\x34\x4a\xcc\x7f\xaa\x36\x37\xae\xb2\xd6\x2b\x65\x12\xcf\x6b\x16\xd5\x46\xce\xfd\x3a\x54\xe1\xba\x28\x4e\x0d\x76\x7c\x50\xad\x80\x8d\x6d\x49\x00\xe5\xff\x00\x45\x8d\xb5\xeb\x4c\xdb\xb4\xc6\x35\x21\x9f\xc6\x66\xe3\xc4\x1f\x28\x16\xc4\x9f\xac\x21\x27\x4c\x9d\x53\x23\x60\x88\xb0\xd0\xcc\xf1\xa8\xd6\x14\xd7\x3d\xd6\xe2\x40\x9b\xe6\xd5\x55\x4b\x5e\x0b\x9c\x7a\x1f\xfe\x06\x3b\x6e\x6c\xb0\x52\x3a\xd0\xd9\xf1\x85\x0b\xa0\x8f\x86\x46\x95\x2d\xd8\x3f\x78\x7d\xf0\xf1\x60\x3f\xf0\x9d\x92\x11\x59\xd1\x2a\xf4\xf5\xea\x1d\x60\xf7\x1e\x9a\x46\x91\xff\x55\xd5\xe9\xa7\x35\x9d\x7e\x5a\x79\xb3\xaa\xa6\xfa\x2a\xfb\x15\xdf\xea\x95\x53\x5a\xd5\x60\x8b\x24\x49\xe0\xf5\x0a\x66\xb9\x2a\xa9\xa6\x15\x0b\x2a\xc3\xea\xf6\x51\xcb\xeb\xba\xae\x27\x85\x7a\xee\x51\xc1\x1d\xfe\xe5\x85\x25\xbf\x69\x03\xf7\xa7\x61\xf8\x63\xd7\xd9\xc8\xda\xb5\x41\x2d\x7b\x67\xb2\x4e\x8d\x49\xb8\x6c\x83\xb8\xda\xd0\x38\x41\xa3\x46\xf0\x23\xe5\x4e\xa5\x6f\xd5\x22\x67\xd6\x3c\x99\xdd\x81\xdd\x69\x1b\x33\x16\xf8\x5b\x12\x68\xd5\xdc\x77\xbd\x63\xf9\x39\x01\xb6\xfd\x18\xa0\xf6\x12\xfe\xc3\x56\xa3\xd4\xa3\xc0\xc0\x83\x6f\x8f\xae\x18\x6a\x93\x7a\xb4\x37\x4e\x98\xa9\xbf\xf2\x3e\x58\x46\x57\xde\xd2\x0c\x9d\x5c\x1d\xb0\xf1\xdf\x03\xe4\xf2\x05\x95\x87\x6a\xe7\x96\xa9\x9a\xc4\x5c\xba\xc5\x28\xdb\x99\x94\x71\x95\x46\x8c\x5d\x79\xad\x1f\x87\xcf\x15\x81\x76\x9f\xc5\x2b\xef\x89\x05\xc9\xfd\xfd\x58\x97\x45\x98\x12\x45\x5a\x77\xfb\xdf\x5c\xe3\x05\x99\x96\x7d\xf8\x9e\x05\xd7\x06\x90\xcf\x2d\xf8\x5b\x80\xef\x0e\xc3\x4a\x02\xb3\x6e\xc6\x4f\x1f\x27\xe3\x88\x23\xb4\x5e\xdb\x68\xf7\xf4\x58\x11\x50\x52\xcd\x83\xac\x76\x34\x25\x14\x0d\x65\x34\xc5\x0b\x54\xa8\x61\xa8\x91\x72\x63\xa3\xb3\xad\x90\x3a\x29\xf7\xdd\x7b\x75\xab\xd5\xcb\x36\xdf\xf6\x75\x6e\xa7\x7e\x3a\xc2\x55\xcb\xae\x8e\x25\x2d\xbf\x67\x17\x97\xeb\x37\xa9\xb5\x5c\x6d\x92\xde\xbd\xcb\xf5\xdc\xd3\x2e\x21\xd3\xd2\x9e\xb4\x9a\xed\x73\x3b\xbb\x0a\xfa\xc0\x06\xb0\x36\xa4\xac\x9d\x3e\x95\xcd\x01\x9f\xf5\x3a\x98\xd1\x64\x12\x18\x93\x3d\xe7\x7c\x72\x6b\xc2\xdd\x0c\x8c\x09\x54\x5f\x3d\x90\xa6\xe5\x17\x43\x36\x44\x9a\x91\x8b\x19\x51\x88\xd2\xd0\x6f\x75\x41\xa6\xa3\x71\x85\x4d\xa2\x99\xf5\xdd\x7e\xc5\x07\x5b\x0b\x0a\x94\x51\xf2\x82\x18\x9f\xd4\xb2\xce\xb9\x96\xd5\x9b\x1e\xa0\xc1\x63\x78\x7f\x01\xe3\xf7\x93\xdb\x43\x2e\xb4\x6d\x6a\xb5\x29\x3c\x41\x74\x68\x7c\xf0\x8c\x36\xf1\xfe\xda\xe5\x85\x56\x9b\x2e\x2f\xea\xa6\xd0\xbb\xb2\xf4\x8e\x64\x84\x0e\x6d\xc0\x4f\x05\x12\xf8\x73\x84\xf0\x03\xe2\xe0\xfe\x83\xa1\xa5\xe9\xba\x03\x9c\x39\x73\x47\xe2\x76\xe5\xdb\x9f\xb1\xb9\x12\xf2\xa0\xec\xb2\x9a\x81\xc1\xa3\x30\x09\xc3\x8f\xd1\x03\x84\x07\x3b\x4f\xc2\xc4\x5a\x99\x7b\xe0\x39\x7e\x30\x75\xa4\x61\xf8\x3c\x4a\x51\x61\xae\xcd\xed\x9d\x87\x3b\x2a\xbf\xa1\x8c\x5e\xaa\x2a\x1e\x54\x1f\x87\xab\xb8\x77\x5b\x0e\xfc\x36\xf2\x5a\xc2\x0f\x6a\x7c\xf9\xbb\x52\x9f\xc4\x09\x55\x7b\xf1\x93\xaa\x56\x05\x07\xc5\x03\xf2\xc0\x9b\xe1\xa2\x34\x1e\x2e\x54\xc6\x8c\xd0\x42\x84\xa1\xa5\xce\x1e\x98\xf5\x7e\x12\x20\x3c\x8e\x1e\xe0\xf2\xed\xfb\x41\x18\x46\xd5\xaa\x10\x02\x46\xca\xef\x11\xbc\x0c\x99\x3d\x40\xeb\xcb\x00\xbc\x70\xf1\xad\x25\xc8\xeb\x4b\xb0\xfb\x28\xcc\xc3\xf0\x55\x6d\xa8\xb0\x1c\x79\x18\xee\xab\xe1\x3d\x7a\x18\x3a\x1d\xbd\x8c\x3c\x28\x19\xee\x76\xc7\x51\xd0\xff\xa3\x5a\x03\x3b\x43\x98\x52\xab\xd9\x98\xd9\x07\x8b\xc4\x63\xc2\xf8\xac\x89\xcc\xff\xc6\x9b\x2a\x41\x5e\x35\xe0\x68\x56\x91\x11\x59\x85\x36\xce\x2a\x37\x47\x49\x53\xee\x25\x5a\xb5\x90\x0a\xc1\x85\x16\xd2\x80\xa4\x63\x99\x8c\x2f\xe3\xa4\x57\x8d\x28\xea\x64\xa5\x51\x4a\x07\xa7\xf1\x94\x98\x6a\x36\xca\x4d\x69\x4e\xb2\xbf\x76\xc3\x07\x0d\x53\x4e\x0f\xc8\xd2\xdb\x1c\x4c\xfb\xe3\xa5\x05\xdb\xbc\x39\xc4\xdd\x9b\xc3\x80\xaa\x17\x24\x53\xfb\xb0\xcd\xdc\xd9\x4c\x86\xe1\x4c\x56\x20\x18\x66\x6a\x4c\x8c\x3a\x8d\xd1\x99\x8a\xaa\x8a\x61\x70\x2d\x76\xc9\x29\xe6\x86\xb9\x72\x09\xee\xda\xe1\x85\x40\xef\xc3\xba\x45\xef\xbe\x71\x75\x26\x29\xa1\xb6\x36\x59\x54\x1f\x10\xed\x3b\x8c\x06\x8a\xc7\x3e\x7b\x0a\x53\xfc\x8b\x02\xc1\x16\x69\x04\x6b\xee\x56\x77\x43\x68\x47\xdc\x15\x99\x98\x5f\xd6\xeb\x8a\x10\x4c\xdd\x65\x86\x66\x87\x76\x9d\x5e\xdc\xae\x0d\x22\x8d\x8a\x6a\x0b\x82\x9d\x8c\x88\x0a\xfb\x20\xb3\x22\x65\xd9\xa8\x1f\x67\x75\xad\xa6\xd2\xe8\xb7\xa8\x8a\x56\x24\x43\xd4\x57\x7f\x0d\x41\x1d\x50\x01\xc8\xd6\xeb\xec\xa7\x7a\x9a\x51\x9c\x6f\xa8\x9d\x24\x24\x29\x8d\x67\xd5\x25\x71\xc1\xf4\x85\x63\x01\x38\x69\xc1\x8a\xb9\xba\x92\xd1\xeb\xc1\x70\xb0\xc2\x5d\x25\x52\xbc\x2f\xd4\x46\x00\x99\x9a\x80\xc1\x5c\x8d\xdd\x74\xb5\xd4\xaa\xf4\xc9\xa0\xca\xe5\x54\xbd\xc2\xda\xdb\x19\x6d\x6c\x25\xae\x0d\xa4\xd6\x50\x69\xd4\xdf\x99\xe7\xe0\x3e\xfb\x5b\x5d\x8d\x9e\xd6\x63\xe3\xc2\xed\x5b\xed\x76\xc2\xda\x6d\x46\x88\x92\x2b\x73\xd4\xd8\xc2\x35\x77\x45\x70\x22\x08\x83\xc3\x53\xbf\xc7\x65\xed\xd9\x57\x57\xf9\xd7\xbf\x54\x65\x62\x30\x2b\x07\xaa\x0d\x0e\xdb\xd7\x67\xe7\x97\x9f\x28\x52\xb8\xc3\x2f\xcf\xc8\xaf\xc8\xbf\x2f\x3e\x0c\xd1\x07\x92\x47\x1f\xd0\x28\x8b\x3e\xa0\x58\x44\x1f\x0c\x63\xbd\x92\x29\x0c\xbb\xd7\x11\x6a\x66\x2d\x2c\x0f\xfe\x9e\x0d\x7d\xb3\x7a\xa8\xb4\xae\x1a\x98\x4e\xa3\x17\x00\x29\x77\x1e\xee\x06\x08\x2b\x44\x0b\xb7\x2d\xe5\x00\x2b\xbc\xf2\x8f\xf5\x5a\x76\xa1\x2f\x66\x06\x3f\x18\x48\xb4\x3d\x78\xb6\x10\x43\x54\x77\x32\x38\x9c\x0b\xf2\x86\xe1\xd2\xa1\x99\xfa\x2a\xc5\x83\x55\xb5\x6f\x22\x84\xff\x20\x14\xff\x42\x24\xfe\x40\x12\x19\xfd\xe1\x1c\x9c\xe9\x75\x40\x86\xd3\x05\x37\x33\x3c\xf8\xa8\x7b\x39\xa9\x78\x61\x71\x17\x80\xef\x73\x55\x50\xb4\xe2\x4e\x6b\xb6\xd0\x58\xd1\x07\x6f\xff\xa6\x48\xd0\x8a\xfd\xd3\x9c\x8c\xa3\xd4\x58\x99\x61\xce\x5c\x3e\x5c\x96\x6a\x72\xba\xaa\x46\x8b\x14\x30\x02\x5a\x32\x38\x2f\x31\x88\xb4\x7c\x17\x4f\x2b\xf7\x2a\x95\x51\x5a\x63\x8c\xbe\xaf\xc5\xec\xc6\xef\x6a\x31\x0f\x21\xa6\x30\xde\xf5\xf3\xf5\x3a\xf5\xbc\x69\x11\x92\x5b\x0f\xfe\x24\xb5\x6f\x22\x1f\x48\x16\x31\x84\x93\x48\xb4\x4c\x4d\xf9\x28\xa3\xa7\xc3\x79\x0d\x97\xea\x6e\x12\x80\x63\x0f\xdc\x1d\x55\x72\x8a\x2f\x41\xa5\xa0\xb1\x23\x46\xce\x2d\x64\xd9\xab\x9a\xfb\xea\xb1\xcf\x5e\xaa\x1f\x2f\xae\xd7\x13\x30\x5e\x2b\x99\xe7\x04\x17\x10\x23\x14\x2f\xb5\x7f\x42\x58\x22\x6f\x63\x5b\xea\xbd\xca\x3b\x49\x9c\xb6\x81\x59\xfc\x1d\x6d\xa4\x20\xb9\x18\xb5\x5c\xe5\xc9\x5d\x78\x0e\xf8\x38\x01\x64\xf3\x86\x46\x89\xba\x48\x13\xa3\x06\xb4\x6b\xeb\x84\x77\xf6\x04\xe1\x65\x94\x68\x7a\x36\xa1\x4e\xad\xc4\xa2\x04\xb3\x24\x8f\x12\xe4\xa2\x13\x1f\x63\xb3\xc9\x9e\x5d\xc5\xaa\x73\x5c\xf3\x1c\xa5\xe6\xa7\x50\x97\x98\x59\x60\x5f\x52\xc8\xda\xb7\xd1\xf6\x12\xb5\x9d\xc9\x63\x2a\x11\x96\xb4\x97\x4c\x26\x91\x31\x1d\x91\x92\x20\x18\x26\x84\x0e\x27\x7c\x45\x63\x67\x24\xc2\xd7\x86\xb3\xaa\xce\x9e\xe2\xb3\x7e\x82\x1a\x93\xa4\x77\x36\xa1\xe7\xcb\x8b\x77\xd7\x8c\x0a\x9c\xbb\xef\x63\xbe\x14\xc0\xc6\xd0\x53\xa4\x79\x05\xc3\x71\x18\x46\x53\x15\x35\x46\x08\x8f\x49\x8e\x27\x24\xf8\x93\x75\x3a\x9d\x4e\xca\x3a\xc1\x56\x34\xf1\x9c\x2d\xa2\xad\x68\x3c\x0a\x3a\x51\x22\x3b\xc1\xd6\xb8\x37\x4d\x33\xed\xb1\x58\x68\xd3\xcf\xd1\x0f\xff\xa7\xf7\xdf\x27\x7f\xfe\xf9\xe7\x0f\xa7\x3f\xe0\x20\x40\x5b\x41\xac\xb2\x65\x29\xa3\x6f\x41\x13\x7d\x2b\x40\x41\x3c\x55\x35\x68\x31\xef\x49\xe7\xfc\xb6\x13\x6c\x4d\x21\x3e\x08\x9c\xae\x80\xc3\x2f\x27\x24\x08\x8a\x74\x8b\x4c\xb0\x37\xa1\xda\xe3\x7c\x82\x86\x09\x49\x31\x25\xe0\x11\xd2\xbd\x52\x00\xe2\xe6\x0c\x78\x2a\x8c\xad\xc4\x7c\x55\x5f\x63\x8a\xeb\x98\x30\x06\xd4\x36\x96\xfa\xff\x05\x5f\xb2\x49\x22\x6e\x63\x3e\x62\xb5\x67\x92\x6a\x86\x43\xf5\x1f\xf3\x6a\x24\x34\x91\xe1\x6b\xb0\x9b\x2d\xc5\x6d\x2c\x0a\x85\xcf\xe7\x54\x46\x0c\x4b\xe4\x1c\x3b\x2d\x88\xd4\x18\xf5\x70\x11\x86\x0b\x70\x03\x25\x68\xae\x3d\xbf\x1d\xa8\xf8\xd7\xfc\xe2\x22\x65\x17\xeb\xf5\x98\xb3\x9c\x67\x54\xe7\x8e\x16\x1e\x90\x00\xa5\x93\x7b\x97\xa4\x0e\x5d\xce\xe8\xc8\x21\x03\x7a\xdf\x97\xfb\x90\xd9\x7d\x88\xe2\x19\x38\xaa\x2f\xaa\x97\xaf\xa5\x07\x0c\xc6\x54\xd3\xd1\xae\x32\x5f\xcd\x5a\xc0\xa9\xa1\xe5\xa1\xaa\xbc\xd0\xda\x64\xff\x50\x55\x14\xac\x1b\xa6\x3b\xf5\xc9\xb9\xb3\x52\x79\x67\xa5\x93\xf2\x41\x63\xa7\xff\xdf\xd1\x60\x2b\x8a\xe6\x11\xda\x1a\xf4\xfb\xe8\x87\x9d\xfe\xba\x8f\xaa\x36\x29\x5d\x66\x85\xdf\xfe\x3c\xfa\x39\x7e\x31\xca\xe8\x68\x10\xff\x12\x77\xbf\xae\xd7\x83\xb0\xcd\x08\xc2\x68\x12\xa1\x78\x50\x33\xfd\xe8\x2a\xd2\xc6\x18\xbb\x83\xba\x7d\x46\x54\xa1\x5b\xa9\x06\x89\xf7\x76\x2b\xd5\xee\x47\xca\x43\x6c\x7d\xe2\x53\x57\x5a\x3e\x82\x37\xab\xdf\x94\x66\x1b\xda\x94\x4e\x3c\xf5\x41\x2b\xf4\x01\xfe\xda\x2d\x9f\xca\xdc\x80\xcd\xc7\xbb\xee\x8b\x30\x64\x84\x90\x3f\xc2\x50\x3e\xfb\x25\x0c\xa3\x0f\xe4\x0f\x0d\x51\x7f\x21\x7d\xab\xeb\xcb\x70\x46\x00\xdd\xbf\xa6\x3f\xdd\x1a\xd6\xd1\x93\x1f\x9d\x39\x29\x22\xb4\x87\x76\xad\xf3\x4d\x27\x47\x9c\x4b\x24\x7a\x82\xce\x93\x94\xa5\xec\xe2\xa0\x46\x4d\xd8\x62\x63\x3a\x8a\x52\x4a\xc6\x94\x08\xdc\x52\x85\xb6\x30\xa3\xd2\xdb\xd2\x70\x6b\x74\x4a\xbd\x17\xe4\x84\x6c\xec\x84\xf6\x75\x94\xac\xd7\xd9\xb3\x44\x7b\x39\xd8\xd8\x5b\x54\x4c\xe8\x7a\x1d\x9d\xd1\xd1\x95\x5a\xc4\xa9\xea\xed\x82\x92\x01\xbe\x89\xa6\x14\x2f\x28\x42\xf1\x00\x28\xb7\xdb\x68\xa0\x8d\xbf\xc5\x17\x51\x86\x10\xbe\x7b\x6a\x8b\xf2\xa9\xa2\xa8\xd8\x74\x44\x2b\xb5\x2f\x07\xb8\xdb\xf7\x3d\xba\x95\xc7\xe7\x57\xb2\xb3\x15\x45\x5f\x22\xb4\xfd\x1b\xfa\x61\xa0\x8e\x8e\x6f\x58\x4e\x23\xdc\xea\xd4\xe4\x86\xc7\xf9\x53\xee\x84\x42\x0e\xa3\x25\xd5\x38\xa2\x24\x50\xc1\x30\xa7\x0a\x7d\xa0\xe4\xef\xe8\x0a\xaf\x64\x3a\xa7\x7c\x29\xe3\x41\xff\xbf\x23\xba\xbd\x83\xb6\x65\x81\x7c\x83\x63\x1a\x41\x01\xb7\xaa\x0e\x11\x31\x1b\x7d\x4c\x91\xc5\x38\x18\x19\x53\x2c\x48\x4a\x7d\xdb\x31\x9a\xfa\xdd\xbc\x1c\x46\x6b\x3d\xd3\xa7\x8f\x39\x25\x37\xb7\x53\x90\xc1\xbc\x1f\x06\x5a\xed\xb7\x6d\xcb\xad\xcc\x66\x6a\xd9\x17\xa5\xf4\x93\xc2\x0c\x54\x05\x29\x45\x29\x25\x59\x5b\xee\xf6\xad\x95\xb5\xee\x51\xa8\xd8\x4a\xda\x0b\xdd\xd5\xd5\x98\x12\xb6\x69\x7f\x6e\xae\x45\x77\x8f\xb5\x6d\xf6\x96\x3e\x6e\xaa\xa6\x10\xa4\xa5\x0a\xa7\x01\xa2\x81\x90\xda\xf7\xc6\xc9\x5c\x86\xd5\x41\xc3\xa6\xeb\x1a\x4c\x30\x75\x20\xdb\x5a\x2d\x0a\x46\xa6\xbe\xb4\x03\xc8\xb0\x8f\xae\xe9\xd6\x56\x7c\xad\x36\xc6\x94\x12\xa9\x4e\x87\x67\x38\xfb\x4a\xed\xc9\xdb\xa8\x5f\xf1\x6c\x7c\x5b\x82\xde\x0b\x55\xe4\xcc\x33\x28\x47\xc3\x50\xed\xdf\x85\x03\x99\xeb\xf5\x82\x3e\x23\xaa\xbf\xdd\x73\x3a\x44\xf6\xdc\x41\xa1\x72\x0b\x5e\xa8\xfc\xb9\xea\xc4\x92\x92\xed\x01\xc2\xb6\x92\x8b\x48\x65\xbe\x30\xe8\xfa\x39\x30\xad\xa0\xb3\x33\x8a\xe4\x4c\xf0\xeb\x0e\x25\x73\x8a\xe7\x26\xc3\x0c\x32\xf8\xae\xea\x80\x51\xaf\x26\xcf\x48\xed\xec\x3c\x54\xb0\x6f\x02\x0c\x59\xf6\x8c\xcc\x23\x84\x9c\xc4\xca\x34\x65\x69\x3e\xd3\xba\x92\xee\x00\x8c\xa2\x6a\x82\x95\x87\xda\x04\x73\x64\x24\x10\x8a\x5b\x0b\xd9\x27\x56\x41\x52\xe8\x96\xbe\x17\xee\xac\xc8\x90\xc7\xf5\xde\xe1\xff\xa1\xde\x5d\x47\x68\x54\xcb\x2b\xe2\x6f\x75\x79\x38\x01\x1e\x77\xe9\xdb\xac\x7c\x97\x74\x76\x2b\xe9\x7a\x7d\x41\x7b\x0a\x68\x1d\xd9\xba\x22\xf4\xd3\x25\xec\xeb\x73\x20\x85\x3c\x4f\x63\x56\xb2\x84\xe8\x0d\x06\xab\xf8\x28\x40\x78\xba\xb9\x27\x6a\x83\xac\xd7\xd1\x0c\xd6\x79\x0e\x6e\xee\xd4\x2a\x1f\x10\x10\x3e\xc1\x7b\x04\x64\x04\xf0\x3b\x72\xd0\x54\x4a\x7c\x5f\x8d\xa4\x37\x12\xbf\x21\x07\xbd\xa6\x60\x02\x7e\x4d\xc0\xd6\xd1\x01\xde\xc3\x0b\x3c\x45\xf8\x88\xbc\xee\x39\xeb\x1e\xf8\x2f\xfb\x55\x6a\xe0\xe2\x63\xf2\xda\x94\x40\x3d\x5f\x09\x70\x78\x40\x40\x83\x62\xac\xaf\xed\x8f\xe4\xa0\xd7\x2e\x8f\x8b\x5f\xba\x24\x27\x77\x8b\x3f\xb9\x38\x2b\x01\x88\xdf\xba\x28\x68\xf7\x95\xfb\x2c\xc5\xc8\xf0\xbe\x8b\x74\x72\x44\xf8\xb9\x57\x95\x8d\xfb\x42\x68\x8f\xf1\x6b\xfc\xb7\xc2\x3e\x0c\x48\xd9\xa7\x53\x2a\x04\x9d\x58\x4f\x5b\xf8\x50\xbf\x29\x8f\x69\xd6\x48\xfa\xda\x6a\x5b\xe6\x73\xa9\x71\xea\xc8\x6d\xfc\x3b\x5c\xac\x39\x95\xcf\xa7\x92\xda\xc8\xdf\xd4\x85\x87\x7f\x25\x3b\xf8\x67\xd2\xc7\x40\xc0\x7f\xd0\x7b\xb6\xbc\x93\xf1\x03\xb3\xf5\xad\x03\x75\x4b\xe1\x9b\x7f\x4b\xf1\x0b\x80\x10\x9a\xfb\x0d\x6f\x31\x38\x35\x29\x63\xf3\x5f\x82\x21\x00\x13\x03\x05\x17\x35\xa7\x47\x25\x68\x10\xa4\xe1\x8c\x85\x3a\x16\x3c\x9d\x41\xec\x15\xfc\xde\x52\x32\xa0\xbb\x1a\x56\x5d\x52\x32\xf0\xa4\xac\x17\x4b\x49\xc1\xc3\x54\xb9\x67\xe3\x09\x36\x09\x65\xdc\x21\x17\xe0\x3c\x2d\x9e\x62\x3b\xe9\x20\x65\xb9\xc0\xe7\x8a\x9e\xa1\x46\x04\xae\x2e\x0d\xa8\xaf\xf1\x33\x3a\x3c\x03\xa6\x4f\xdd\xa3\x2f\xe8\xff\x66\xb7\xab\xe8\x8c\x12\x86\xd6\x6b\x85\x1f\x39\xe4\xa7\x28\xf0\x92\x6d\xac\x1d\xc0\xe8\x19\x0d\xc3\xee\x15\x45\xab\xab\x66\xf5\x65\xed\x30\x07\x8e\xf5\xa2\x52\xf0\x34\x5b\xe6\x33\xb5\x05\x5a\x44\xd5\x2a\xbd\x35\x66\x1c\x19\xf9\x79\xf8\x33\x19\x38\xfa\x4f\x10\x1a\x95\xaa\xf1\xb6\xa1\x9f\x09\x2b\xec\x43\x96\x6d\x4e\xb8\xd4\x33\x75\x3d\x59\x71\xcd\x27\x8f\x03\x84\xfd\xa1\x4e\xcc\x3e\x6d\x1b\xa9\xee\x97\xea\xc1\x24\x42\x9b\x46\xf9\x33\x91\x15\x1d\xa8\x97\x77\x09\xb6\xbd\xab\x10\xdd\xda\xde\xb4\x87\xcc\xfb\x72\x6e\x55\x25\x85\x21\x25\xf0\x5c\x3c\xb4\xd7\x54\xfb\x16\x02\x6f\xea\x1b\x37\x11\x68\x8a\xfb\xdb\xc8\xed\x47\x60\x2b\x6c\x12\x0f\xab\xc8\x4c\xed\x3a\x99\x29\x37\x28\x27\x49\xc7\x70\xcd\x65\x75\xbb\x93\xea\x0d\xe0\x3a\xee\xe3\x3a\xe3\x2d\x56\x07\xcf\xbb\x77\x8c\xff\x3f\x23\x80\x66\xce\xa3\xaf\x15\x6c\x0c\xa0\x68\x19\x84\x58\xe2\x06\xfe\xa3\xd9\x76\x15\xf1\x2e\xea\xdc\x99\xb5\x8e\x1f\x33\x9c\xea\x39\x18\x97\xaf\x58\x80\xb5\x6c\xf4\x74\xa8\xed\x98\x0e\x8d\xf2\xc3\x0e\x21\xe4\x9a\x46\x0c\x85\xe1\x8e\x15\x9d\xd7\xca\x93\x8f\xfb\x01\x02\x9e\xaa\xa2\xf2\xf2\x52\x1a\xff\x01\x8d\x72\x84\x56\x60\xf9\xd3\xf1\xee\x36\xf9\xef\xa3\xe2\xa2\xea\xbf\xcf\x1d\x8f\x28\x07\x85\x0f\xa0\x25\x4d\x83\x83\x00\x15\xd5\x6a\xcd\x64\x16\x8c\x3c\x50\x7d\x1c\x81\x2f\xeb\x1c\xc5\xb9\x7d\xdb\x7f\xc3\x4a\xcd\x9d\xb1\xb5\x18\xe7\xf4\xb4\x59\x5c\xb7\x13\x07\x76\x29\x52\x2c\x4b\x67\x8f\xda\x4c\x4a\x2c\x71\x6a\xc4\xb6\x6b\x5e\xf9\xfe\x5d\x1f\x7d\x23\x11\xa1\x38\x8b\xc6\x08\x5f\xc9\x68\xdc\x70\x28\x99\x56\x1d\x4a\xae\x8c\x9d\xa1\x98\x16\xa5\x5f\x49\xf9\x4f\xbd\x7d\x26\xd1\x18\xa7\xa8\xa8\xc3\x66\xda\xab\x46\x34\xe1\xab\x1a\x54\x2d\x4f\x1d\x30\xd1\x5e\x2d\xc6\x03\xa6\xb4\xe7\xc2\xd8\x81\x0e\xb5\xdd\x37\xc9\x2c\x29\xd2\xd5\xbe\x3c\x74\xad\xcd\x0d\x9f\x29\xeb\x84\x0d\xb5\xdc\x73\x9b\xc8\xa1\x4b\xf4\x54\x29\xaa\xc2\x80\x9d\x46\x8e\xa2\x50\xe7\x79\x52\x91\x4c\x92\x8d\xa8\xcf\xa9\x9c\xbd\xe5\xef\xb9\x90\x49\x96\xb7\x49\x0e\x51\xf2\xfe\x1b\x80\x14\x6b\xf7\xef\xaf\x98\xe4\xfb\xf4\xea\x23\xe7\x59\x13\xb4\x6b\x71\x15\x36\x81\x33\xfb\xe2\xd6\xef\x81\x05\x6e\xe7\x32\xb2\xae\x8a\x57\xf5\x5e\xbe\xb8\x35\x37\x73\x9b\xe5\x64\x8a\xf4\x48\x5b\xea\x6e\x2b\xc0\x46\xea\x4b\x6f\xa3\x02\x55\x04\xa5\x3f\xc9\xaa\xc9\xcc\xdd\x67\x89\xb8\x58\xaa\x3d\x9b\x1b\xeb\xe7\x61\xa8\x8f\x5a\x97\x10\x97\x74\xb2\x7b\x3a\xf2\x3f\xb4\xa3\x04\x03\xee\xad\xd5\xe4\xf8\x52\x60\xeb\x67\xc2\xf9\x64\x05\xe7\x03\xce\x82\x31\xad\x81\x74\x89\xab\xc6\x5d\x63\x9f\xf7\xf1\xd6\xbb\xec\xba\xdd\x17\xa2\x37\x4b\xf2\x77\xd7\xec\xbd\xe0\x0b\x2a\xe4\x2d\x30\x1e\xbb\x3f\xb7\x44\x87\x61\xf4\xab\xe8\x49\x9a\xab\x0a\x46\x2f\xc4\x09\x3d\x25\xdd\x7e\x1c\xfd\x6c\x42\xb8\x3b\xf0\xd9\x8b\xaf\x6a\x53\x92\x18\xa7\x8e\x3c\x0c\xc1\xa3\x8b\xa3\xe6\xb8\xd3\xeb\x7b\x43\xe5\x8c\x4f\x86\x62\x24\x80\xac\x31\x43\x66\xeb\x35\x57\xbd\x79\xc1\x79\x46\x13\xf6\x5b\x92\x2d\x15\xd4\xb1\xd1\x6f\x97\x73\x2a\xd2\xb1\x89\x4e\xf3\xb7\xc9\x5b\xf0\x17\x00\x89\xef\x79\x9e\xca\xf4\x8a\x56\x33\x0d\x7e\xb2\x85\xdf\x5d\x51\x91\xf1\x64\x42\x27\xb5\xda\x07\xea\x12\x18\x3d\xd7\x02\xc9\xb1\xea\x62\x2e\x3f\xe5\xd4\x4e\xc7\x88\x9e\x68\x9d\x63\xf5\xf1\x36\x99\xd3\x53\xc2\xe2\x48\x12\xde\x4b\xa4\x14\xe9\xf9\x52\xc2\xe3\x01\x8e\x44\x3d\x2a\x5f\x24\x63\x8a\xb4\x97\xad\xe7\x2e\xe1\x38\x12\xc6\x01\x88\x6a\xac\x36\xd8\x6f\xf5\xb5\x0f\x7d\xad\xd6\x18\x81\xc3\xb3\xb8\x25\x72\x8b\x59\x52\x75\xdf\x58\x7b\x83\xd5\x18\xb1\xba\xeb\xbc\x7d\xb7\x80\x6f\x25\x98\x23\x30\x24\x21\x03\x27\x74\x73\x7e\x45\xbd\x8a\x37\x37\xe5\xea\x7b\x5e\x91\xee\x86\xed\x00\x6e\x89\x58\x6d\xfd\xd1\x48\x65\xd4\xa7\x05\xc5\xac\x65\xea\x59\x6d\xea\xbb\xac\xb9\x3f\x82\x20\x6e\x76\x93\x55\xd7\x02\xb5\x64\xf1\x3d\x0b\x7d\xa9\x39\x0f\xd4\xd6\x9d\x39\x28\x9c\xd0\xf1\x25\x9d\x58\x08\xa4\xc0\x8f\x3a\xad\xb1\x11\xa1\xca\x25\x5d\xd8\xf0\x3c\x65\x2e\x98\xdc\x98\x60\x81\x25\x5e\x19\x38\xbc\xa7\xeb\xb2\x99\x4c\x2c\x0c\xc3\xc6\x41\xc3\xc6\x2a\x19\x1b\xb1\x98\xf6\xce\xae\x45\xb2\x58\x50\x01\x17\x64\x2f\xd5\x26\x82\xa0\x0c\x36\x7d\x33\xd9\xf9\x88\x6f\xca\x6e\x1a\xf6\xb9\x8f\x7f\xd7\x46\xec\x77\x66\x58\xab\x86\xac\xaa\xf5\x98\x06\xdd\xe4\x8c\x5c\x28\x76\x15\x99\xac\xd8\xef\xb0\x2b\x07\xa3\x1c\x99\x7f\x83\xa7\x0a\x9e\x65\x74\x12\x07\x50\xd3\x39\xbf\x09\xb4\x66\x31\xf8\xea\x0c\x44\x32\x49\x79\x19\x33\xaa\x75\xa0\x5a\xb1\x07\x02\x0f\xcd\x28\x75\x7a\x24\xcb\x22\x28\x0c\x01\x6e\x05\xe6\x3b\xa8\x78\x9a\xfa\x6a\xca\x99\xf2\xc3\xca\xbe\x18\xda\xe5\xe9\x6b\xf5\xf3\x20\x00\xb4\x48\x0f\xca\xfc\x93\xa0\x1f\xc4\xa5\xe5\x6a\xdb\xef\x48\x92\x45\x22\x72\x7a\x98\xf1\x44\x46\x26\x2f\x5a\xaf\xfb\x38\x62\x5d\x22\xd7\x6b\x06\x0f\xdf\x26\xa1\x0b\x16\x8d\x22\x57\x25\x1c\xb3\xd8\x25\x42\x44\x3d\x3d\x36\x67\xd7\xf4\xb5\x34\x70\xe2\x2f\xb0\x6a\xc1\xff\xd6\x75\xd5\xf3\x44\xd5\x4c\xcd\x2c\xf6\xc6\x77\x93\xda\x68\x6d\xcf\xc6\x97\x75\x99\x28\xd2\xed\xd6\x73\xf9\x20\xe4\xb3\xac\xeb\xd1\x81\xcd\x7f\x85\xf3\x04\xf9\xf2\x7c\x9e\xca\x00\x9e\x8b\x03\x60\x4c\x04\x9e\x0e\x63\x30\xe6\x19\x17\x26\x55\x61\x66\x5e\x50\xa6\xf3\xfa\xe7\x76\xc6\xc7\x49\x66\x22\xe7\x9c\xc9\x99\x09\x7b\x79\xaf\x29\xbd\x0c\xe2\x72\x9a\xb1\x0d\x56\x27\xa8\x26\x55\x58\x66\xd2\xbb\x52\x12\x0a\x16\xbb\x71\x10\x68\xf1\x86\x48\x7f\x93\x20\x40\xb8\x39\x3d\xf5\x98\xfb\x64\xa9\xd7\xec\x6f\xe9\xdf\x65\x49\x43\x07\x81\x85\x67\x57\xac\x67\xa9\x41\x27\x49\x48\x71\x5d\x7d\x0c\x78\xc6\x0d\xb3\x37\x0a\xf6\x9a\x1d\x5e\xc6\xad\xd7\x91\xdc\x22\xe0\xa7\xc2\x13\x63\xfa\x4d\x56\xde\x0e\x29\x51\x70\xd4\x61\x35\x0e\x52\x22\x1c\x49\xf2\xbb\xb4\xe6\xe7\x04\xb5\xfc\x58\xfb\x49\x24\xf2\x39\xc9\xbf\xca\x8a\x75\x70\x35\xd7\x7c\xa1\x52\x72\xd5\x98\x24\xab\xc2\xc9\xfa\x09\xd2\x1f\x8a\x67\xcc\x7a\xa8\x11\x5b\x5b\x48\x9e\x04\x0f\x82\x2d\x76\x22\x4e\x15\x52\x33\xd4\x92\x35\xfd\x21\x7b\x46\x6d\x2e\xb6\xb5\x85\x04\x91\x75\x0c\x49\x15\xa3\x27\xec\xd4\x1c\x5f\x0c\xe1\x9c\x66\x74\x2c\xe9\x44\x9b\xf7\x8c\x2a\x71\xf0\x20\x10\x86\xdc\xc6\x9b\x65\x3b\xb6\xc9\xdd\xbe\x31\x29\xa5\xfb\x00\xee\xc1\xa4\x95\xc3\x50\xfd\xa6\x7e\xbf\x61\xa8\x27\xc2\x34\x0f\xc2\x70\x76\x5e\x55\x6c\x5e\xd6\xaa\xd5\x17\x74\xb3\xa2\xb5\x59\xf7\x4a\x20\xd7\x6b\x9d\x27\xcd\x15\x31\x37\x51\xeb\x48\x54\x0c\x2a\x4a\x81\x9c\x48\xfa\xb5\xfb\x16\x4f\x7e\x6e\xbb\x40\x37\xde\x23\xbf\xd5\x6e\xb9\x2a\x58\xc1\xd7\x49\xfe\x66\x99\xc9\x74\x91\xd1\x58\x81\x89\xb9\xf9\xf0\xda\x7b\x51\xdd\x50\x0e\xec\x24\xec\x82\x0a\xbe\xcc\xb3\xdb\x63\x2a\x5f\x31\x46\xc5\xcb\x8f\x6f\x5e\x03\xb3\xe9\xe9\x20\x40\x58\x93\x0f\x12\xaf\xae\xfc\x5b\xb7\xed\x26\x76\xbb\x33\x08\xb6\xee\xba\x85\xfd\x4b\xf5\x43\xeb\x2c\x18\x64\xca\xd8\xa1\xac\x8c\xd4\xd9\xea\x10\xd4\x2a\xb9\x96\x02\x9b\xda\xb2\xc9\xd3\x9d\x00\xe1\xe7\x42\x24\xb7\xbd\x34\x87\x7f\x8d\xa2\x0d\x7e\x22\xd2\xec\x0a\xe0\x26\x3c\xdd\x0d\x40\xb2\xf7\xa4\x7f\x8a\x30\xec\x21\xf7\x86\xad\x9b\x0e\x02\xa4\xe0\xcc\x5d\x2b\xa2\x36\x9e\x37\xc9\x7f\x6c\x1c\x4e\xd7\xd5\xb9\xc5\x30\xeb\xba\xeb\xcf\xbb\x8d\x58\x79\x3b\xdc\x7d\xad\x30\x27\xea\xf9\xad\x9c\xb5\xdb\xa7\xec\xe8\x2f\xd2\x57\xb1\x95\x9e\x4e\x1a\xe8\x66\xdc\xb1\x7a\x5e\x87\x7d\x60\xf9\x40\x7a\x66\x8e\xdc\xbd\x73\x75\x11\x18\x52\x3a\x98\x49\xb9\x88\x7f\xf8\xe1\xfa\xfa\xba\x77\xbd\xdb\xe3\xe2\xe2\x87\x9d\x7e\xbf\xff\x83\xca\xa2\x6f\xa1\x79\xa2\x6e\x92\x8d\xb9\x07\x4f\x9f\x3e\xf9\xe1\x4d\x22\x67\xf0\xf3\xe6\x75\x50\xa3\xd5\xdb\x8b\x3c\xfd\xe1\x66\x26\xe7\x59\xe0\xad\x11\x65\x8d\x83\xa0\xa1\xf5\x9d\x15\x00\x95\xfe\x40\x21\xfb\xf1\x5d\x43\xd1\x8a\x2d\xc1\x94\x0b\x9a\x5e\xb0\x77\xd6\xae\x38\x91\xa3\xbb\xab\x8f\x3d\x18\x2d\x99\x93\xf6\x94\x3e\xa1\x2f\x72\xad\x3b\x0c\xbc\x3b\xfd\xf0\xa9\xa5\x8b\xf7\xb4\x0d\xd2\x5d\x60\xce\x31\x3e\xa1\x1f\xd5\xd5\x6f\xef\x2b\x05\xd0\x74\xf4\x6f\x76\xd5\x2a\x2b\x4e\x7c\x69\x75\x6b\x5c\x1e\x24\x34\x6e\xb3\x52\xf2\x9b\x75\x52\xd6\x91\x08\x94\x78\x6a\xc0\xdd\x92\xab\x9c\x00\x7a\xa7\x5d\x6e\xbd\x9b\x46\xc1\xf6\x36\x3c\x95\x83\xec\xc6\x09\x3b\x1d\x0a\x23\x63\x9e\xad\xd7\xc1\xb9\xa6\x48\x4a\x31\x3f\x15\x19\x80\x14\x43\x10\xc4\x9e\xd3\xc1\xae\x97\xc1\xc8\x94\x3f\x68\x50\xe0\x02\x85\xe1\x03\x71\x22\x4e\x47\x51\x10\x6c\x65\xa8\x27\x45\x3a\x8f\x50\x9c\x6d\x05\x8b\x9b\x00\x07\x53\x85\x3d\x06\xce\xb6\x6d\x30\xce\x73\x40\x28\x03\x84\xb9\xa6\x0e\xcb\xd1\x60\x81\x62\x75\xe7\x10\xe1\x6d\x9a\x52\xef\x0c\x80\x7a\x76\x42\x4f\x1d\xd4\x91\x9e\x23\x98\x6f\x80\x55\x63\x1d\x78\xf7\x71\x80\x29\x66\x91\x7f\x8e\x37\xc1\xe1\x46\x23\x50\x05\x98\x93\x69\x7a\x5c\xdd\x5c\x4d\x70\x76\x06\x1b\x4d\xad\xe2\xa6\x5c\x00\x15\x1f\x0d\x02\xaf\x5b\xb0\x09\xc2\xd0\xb6\xd4\x2d\x5b\x32\x29\xaa\xc4\x4e\x00\x63\xf1\xa0\x81\x28\xb7\xf0\xf6\x00\xf6\x69\xb9\x29\x02\x2b\x3d\xd9\x74\x48\xdb\x4b\x9d\x89\x50\x0b\x42\x12\xc6\xb8\xa6\x80\xb7\x6f\xe6\x16\xeb\x04\x84\x75\x7b\x21\xf8\x34\xcd\x2c\xca\x39\xe5\x4c\x6e\x4f\x93\x71\xe3\x7b\x3b\x17\xe3\x46\xdc\x52\xa4\x8d\xb8\x29\x17\xf3\x44\x36\xa2\x15\x5e\x68\xb1\xdd\x34\xcf\x53\x76\xb1\x7d\x91\xdd\x2e\x1c\xac\xea\x0e\xea\x2a\xc4\x7d\xdf\xb0\x99\x3b\x51\x4f\x61\x1e\xec\xf1\x5c\xaf\x07\x83\x4a\xc4\x88\xc6\xb4\x07\xbe\xe3\xf7\xf9\x18\xd8\x5e\x86\x86\xfa\x44\x23\x8a\x86\x92\x7c\x60\x27\xf2\xd4\x9d\x47\x4e\xfa\x43\xfe\xcc\xde\x67\x43\xae\xb0\x1c\x63\x6b\xe4\x84\x9f\x0e\x59\xdb\x09\x51\x88\xdb\x7a\x1d\x05\x92\x2f\x8e\xc7\x8a\x7a\x0c\xc0\xc9\xe8\x5f\xd4\x8f\xc2\x41\x6e\x02\x14\xc5\x2a\xfe\x90\x8f\x97\x39\xe4\x5c\xaf\xd5\xf7\x8b\x6c\x29\x74\xc1\xc8\x94\xd4\x39\x70\x30\xd5\xff\x14\x61\x93\x00\x59\x71\x70\x0e\x7f\xe0\x59\xc4\x44\x82\xdc\x42\xcf\x16\x05\x79\x5d\x95\x7f\x0f\x5e\x5e\x4d\xe5\x8c\x46\x81\x7e\x8a\x0d\x70\xb7\x8f\xc2\xd0\x54\x6a\x32\x61\x97\x68\x2b\xd6\x09\x65\x65\x19\xcf\xa9\x5f\x17\x7c\x57\xab\xd2\x51\x36\xc9\x55\xa4\x3e\xa1\x9e\x0f\xbc\x6d\x1e\x8f\x68\x24\xf0\x07\x7e\x22\x4e\xa1\xc8\x89\x38\xad\xe1\x76\x09\x6b\xfa\xb4\x86\xf5\x2f\xc1\xf3\x88\xc5\xac\xba\xdc\x98\xab\x0c\x19\x48\x34\xc3\x6d\x8a\x4c\xcc\x48\xad\x48\xba\x90\xfa\x0e\x8a\x28\x61\xc6\x5a\xf2\x81\xe6\x63\x46\xc1\x24\xbd\x0a\xb4\x0f\x79\x73\x92\x49\xf0\x4c\x97\xf9\xe9\xd9\x9f\x3f\x98\x50\x80\xb5\xbc\x97\xb3\x9f\x15\xf9\x97\x8a\x22\x93\x49\xeb\x89\x1c\xd5\x9b\xa3\x78\x95\xe6\xb1\x4a\x2a\x50\xdc\x48\x54\xf5\xd4\x22\xdf\x1e\x83\xf7\x48\x9f\x0e\x19\x57\x2e\x61\x30\x88\x29\xcb\xb9\x91\xb1\xac\xce\x0d\xf2\xec\x43\xbf\xe5\x13\x75\x22\x3c\x11\x54\x56\x77\x43\x2c\x98\x36\x98\x62\x69\x61\x03\x4b\xd2\xa9\x28\xcf\xb2\x81\x68\xf1\x91\xde\x0a\xaf\x79\x32\x09\x70\x90\xc1\x9f\x79\xa6\xcc\x08\xf3\x64\xfc\x83\xab\x74\x42\xb9\x29\x9d\x2c\x27\x29\x0f\xc0\x30\x56\xa6\x6e\x46\x91\x21\x91\xd5\xf7\x4a\xa6\xf7\x4a\x86\x45\x76\x92\xa9\xbd\x32\xac\xd5\x98\x83\x64\xb6\xeb\x03\x88\xf1\x06\x38\xa0\xfa\x9f\x22\x5c\xcb\x9f\xce\x2f\x4c\xfb\xe9\x3c\xb9\xb8\xab\x60\xfb\xa8\xea\xf5\x29\x88\xe7\x2a\x01\x19\x8d\x00\x1b\xfe\x80\x57\xc9\xb1\xe6\x1e\x60\xcb\x46\x68\xa9\x68\x42\x65\x92\x66\xb9\xab\xeb\x23\xbf\xb8\xc8\xd4\xc9\x92\x26\xd0\x32\x16\xb6\x58\xca\x20\xfe\xdb\x28\x21\x67\xe4\x8b\x09\x99\x2a\x5e\xb1\xab\x24\x4b\x55\xef\x53\x1b\x52\x95\xb0\x88\xe3\x80\xb3\xbd\x99\xba\xc2\x02\x5f\x07\x23\xd0\x84\x6c\x10\x67\xe4\x37\x5d\x55\x65\xaa\x81\x0a\x0b\xe2\x9f\x5d\x7b\x9a\xb0\x61\x55\xc2\xa6\xf8\x0f\xda\x57\x38\x55\x22\x68\x12\xc4\x1f\x5c\x23\x2f\xfe\x83\x41\xd9\x5b\x25\x23\xac\xe0\x6a\x47\x67\x98\x67\x7a\x67\x26\x38\x25\x19\xdc\x05\x89\xda\x7d\xa9\xc2\xcb\xd2\xfa\xf6\x4b\x90\x7d\xf6\x4d\x4f\x92\xd3\x61\x00\x37\xb6\x02\x22\xc9\x08\xf0\xbc\xb1\xaa\x2e\x0e\x36\x20\x03\x26\xa3\x66\x03\x8e\xc9\x78\x34\xee\x69\x14\xc2\xcc\x14\x0a\xc3\x5f\x04\xc8\xfa\xc4\x81\x45\x4d\x74\x99\x06\x14\x19\x8f\xa2\x72\x72\x34\xe5\x0c\x7c\x97\x31\x0a\x43\x40\x76\x55\x1d\x75\x9f\x75\x63\x93\x16\x04\x5b\x2a\xd9\xca\xbb\x1b\xa4\xf5\x60\x92\xc2\x13\xea\xe7\x44\x30\xcd\x62\x21\x49\x18\xba\x5c\x4e\xe9\xbe\x96\x9e\x2c\x25\xd7\xb7\x95\x8e\x88\x5e\x34\x2e\xca\x04\x99\x41\x8f\xc3\x10\x16\x26\x41\xb1\x18\x01\xc3\x3f\x51\x1d\x75\x89\xaf\x6c\x94\x53\xd1\x76\x70\x46\x6f\xed\x0c\x9e\xf8\x3e\x37\xf7\x62\xb9\x51\x74\x96\x5f\x9a\x59\xec\x5e\x36\xa4\xa3\xa5\x15\x6b\x0f\x09\x01\x44\x07\xd8\xa4\xb7\x6e\x78\xea\x98\x01\xa4\xdb\x65\xee\x03\x4b\x5b\xab\xc5\xf5\x46\xc0\x1b\xaa\xe6\xc1\xdd\x01\x72\x7d\xa8\x92\x9a\xcd\xdc\xd5\x1c\x60\x5b\xa5\xba\x91\x5b\x94\x7b\xb2\x1e\x67\x7b\x59\x3a\xbe\x04\xb2\x92\xb3\xb1\x0a\x93\xeb\x8a\x01\xdd\x25\xf3\x7d\x94\x1b\xc0\xec\xbd\xf1\xd6\xa6\x9d\x39\x48\xc2\x75\x08\xdc\x7a\x9c\x9c\xb6\x4e\xaf\x05\x15\x98\xeb\x50\x4b\x5e\x3b\x91\x6c\x23\xc4\xe0\x26\x85\x37\x52\xea\x75\x95\x2b\xcf\x1c\x68\xe0\x3a\x54\x6d\xb9\x39\x65\x0e\xf5\x66\xe5\x94\xb5\x5a\x02\xde\x34\xa1\x00\x42\xb8\x0f\x42\x8c\x19\xba\x12\x8e\x30\x05\x47\xba\x0d\x9c\x27\x51\xb8\x63\x6b\xa4\xde\x19\x27\xc9\x29\xd2\x56\xbe\x2d\x80\x01\x51\xec\x14\x48\x46\x9d\xdc\x20\x1a\x53\x60\x53\xae\xd7\x11\x25\xab\x02\x61\x7a\x92\x9e\x92\xc0\x98\xc8\xde\x08\x92\xcc\x29\x76\xe0\xa6\x76\xea\xff\x27\x60\x83\x76\x71\x73\x72\x8a\xe2\x28\x53\xb4\xe8\xc9\x29\xd2\x26\xbc\xb5\x57\x4d\x84\xca\xd9\xe4\x16\xfc\x72\x05\x7e\xc1\xa2\xac\x63\xe0\x9d\x24\xa7\x96\x6d\xd6\x3a\xdd\x63\xc3\x3e\x34\xd0\xc6\x11\x96\xa8\x3e\xd1\x9a\x49\x50\x4e\x37\xea\xb6\x4c\xf5\x7a\x3d\x0e\xc3\x71\x5b\x7c\xdb\x12\xb8\xca\xc6\xa8\xa5\x4c\x18\xca\x93\xf4\x54\x41\xef\x93\xf4\xb4\xb9\x86\x2a\xd6\x1a\x7a\x51\x53\x65\xa7\x0b\x67\x76\x9a\x14\x62\x4b\xc9\xf8\xee\x95\x86\x8b\xa4\xed\xda\xc1\x92\xc8\x91\xac\xc5\x39\x98\x2c\x55\xbf\xb4\x91\x85\xca\xd2\xc0\x25\xd2\xb8\xa8\x24\x21\x6a\x6a\x1b\x6c\xfa\x71\x0b\x9b\x7e\x0c\x03\x69\xa9\xf5\x5f\xba\x9a\xda\x77\x5b\xe4\x5f\x45\xe0\xc2\x38\x5b\xaf\x4d\xb7\xf5\xbc\xb6\xec\xc3\x71\x69\x21\x81\x36\xe6\xc2\xec\x1d\x40\x3b\x3c\xdd\xa8\x0a\x88\x35\x90\xd4\xbc\xd5\xb9\x97\x44\x51\x11\x76\x12\xf0\x60\x12\x86\x87\xd6\xa3\x2d\x8b\x14\x06\x8e\x39\x81\x90\x28\x7d\xb9\x67\xa4\x3f\xcc\x4a\x52\x35\xdb\x22\x3b\xd6\x3a\x8a\x54\x78\x71\xaa\xfe\xb6\x06\x2d\x18\x4a\x7a\x0f\x0c\x05\x90\x90\xb4\xb9\xb6\x4c\x47\x73\x73\x9b\xa7\xf6\x0a\x4f\xdb\x5e\xb6\x13\x7b\xcb\xa5\xa3\x57\x2e\xdb\x73\xed\x7d\xd6\xde\xee\xac\x7a\xcd\x7c\x95\x9e\x5b\xdf\x3a\x74\xff\xa3\x99\x58\xde\xc7\x9b\x79\xab\xc4\xed\xf1\x7a\x2e\x8f\xc1\x5f\xe7\x48\xfb\x69\xa4\xdb\x15\xde\x85\x4c\x44\xe5\x8a\x67\xf6\x8a\xf7\xf3\xc0\x15\xaf\xce\x8d\x1f\xed\x20\x8f\xa8\xdc\xe9\x2d\xe5\x45\xe3\xd2\x8f\xdb\x32\xd9\xe0\xe8\xe4\x34\x0e\x02\x2d\x0e\xe3\xa9\xdb\x55\xb6\xdf\x7f\x40\xb5\x7d\x9b\x5a\x83\x0d\xf9\x1d\x14\xdb\x77\x50\x6b\xff\x26\xa5\xf6\xaf\x50\x69\xdf\x49\xa1\xdd\x41\x9d\x7d\x8b\x7c\x11\x9b\x68\xa2\x3a\xd9\xf5\x8f\x2b\x6a\x12\x57\xdf\x55\x95\xc6\x7e\x98\x82\x27\x98\x97\x58\x0f\x40\x21\x8d\xf9\xb4\x22\x38\x51\x06\xe8\x0b\xfe\x16\x85\x93\x8d\x2a\x2c\x75\xed\x1f\x2f\xe2\xe4\xa4\x2c\x88\xb3\xd3\x16\x2a\x27\x53\x38\x7d\xb5\x64\x10\x6c\x35\x0b\xab\xc8\x53\x14\xb7\x5d\x12\x16\x20\x67\xee\x92\x68\x32\x3e\xfe\x73\x82\xc4\x2e\x64\x05\x7d\xfe\x26\x7a\xcf\x36\x62\xa3\xd6\x42\x89\xa7\x2c\x5b\x7d\x97\xa1\xe5\x93\x05\x58\x68\x2b\x75\x66\x3d\xb9\xc3\x6e\xd4\xa5\xeb\xf5\xa0\xeb\x33\x52\xc3\xf0\x69\xed\x7b\x50\xcf\x10\x3d\xe9\x56\x79\xb1\x41\x07\x64\x93\xb7\xc1\xd7\xc5\xf6\x82\xa7\x4c\x6e\x5b\x29\xdd\x4e\xe0\x32\xeb\x57\x34\x8f\xf5\x34\xab\xf6\x25\xa2\x84\x8e\x9e\xd6\xd8\xba\xbd\x89\x61\x62\x1d\x58\x49\x5d\x8f\xed\xa6\x05\xcb\xea\x43\x58\xaf\xbb\xa0\xca\xec\x51\x7a\x93\x44\x26\xdb\xd0\x49\xc1\xb9\x0c\xfc\x4e\xcc\x99\xef\xa9\xfc\x9c\x45\x46\x5a\x7a\xa7\xdf\x37\xb6\xfe\x13\x27\xe6\x7d\xc4\x79\xa9\xf9\xa3\x70\xd2\x04\x8d\xb3\x5e\x4d\x7c\x3c\x92\x38\xc1\x14\x67\xa5\x03\x9f\x6e\x24\x88\x58\xaf\x67\xaa\x6e\x6d\x42\xce\x9a\xc4\x1a\x26\xc6\x7a\x8d\x7e\x9a\x42\xac\xc2\x61\x4c\xac\x5d\x83\x71\xd6\xab\xc9\xe8\x6b\x1c\x61\x43\xcf\x48\x8a\x55\xb7\x6a\x22\xc5\x91\x67\xf3\xb0\xb5\xd7\x29\xf4\xba\x70\xbb\x6b\x9c\xf5\x5a\xa5\x89\xa3\xc4\xd7\x58\xad\x68\x09\xec\xdc\x4f\x3a\x75\xc7\x97\x4e\xdd\xa9\x48\xa7\xaa\x0d\x2a\xbd\x15\xc0\x56\x00\x56\xbb\xe2\xf3\xd4\x59\x4d\xc3\x72\x96\xe6\xad\x93\xd0\x32\x69\xd4\x9a\x58\xb9\x62\x84\x45\x03\x84\x6f\xd5\xff\xc3\x27\x08\xdf\x40\xc4\x2e\xc2\xd7\x10\x78\x88\xf0\x25\x24\x3d\x45\xf8\x40\x05\x7e\xec\x23\xbc\x07\x81\x01\xc2\xef\x20\xb0\x83\xf0\x7b\x08\xfc\x88\xf0\x1b\x28\xf5\x04\x0d\xaf\x98\xee\xfa\xce\x63\xb3\x79\x5e\x33\x52\x0a\x9e\x74\xfb\x78\x03\x36\x06\x49\xbe\x54\x40\xf9\x6d\x85\xe0\xba\x7d\x9c\xfa\xd9\xef\x46\x9c\xfd\x1c\x75\xa4\x19\xd2\x14\xb2\x18\x77\xfb\x05\x3e\x62\x64\xf5\xe6\xd3\xf1\xc7\xb3\x4f\xc7\x07\x67\xef\x8f\xde\xbd\x3f\x38\xfa\xf8\x25\x1e\xe0\x97\xcf\x8f\xcf\x5e\xbc\x7b\xf7\xfa\xe0\xf9\xdb\xb3\xdf\x9e\xbf\xfe\x74\x10\x3f\x84\xb8\xb7\x9f\xde\x1c\x1c\xbd\xda\x33\x71\x4f\x20\xee\xfd\xbb\xe3\x57\x1f\x5f\xfd\x76\x50\x4b\xdc\xd1\x25\xde\xfd\x76\x70\xf4\xfa\xdd\xf3\xfd\x83\xfd\x5a\x85\xbb\x3b\x90\x7e\xfc\xf1\xe8\xd5\xdb\x9f\x6b\x69\x8f\x1e\x1a\x21\xee\xfd\x77\x6f\x2c\xbc\xde\xe3\x6c\x9a\x5e\xb4\x28\xe9\x1c\x31\x30\x02\x66\xf2\xa5\x34\x5f\xaf\x57\x05\x68\xc3\xec\xbf\x7b\xf3\xbc\x21\x25\xab\x93\x93\xb6\x64\x95\x02\x86\xca\xf7\xdf\xbd\x79\x53\x91\x1e\xd5\x49\xf6\xde\x4b\xf5\xbd\xb7\xfa\xab\x71\xa7\xa4\xfa\x99\xf2\xe1\x93\x00\xa7\x7a\x0f\x8c\x49\xda\x93\xfc\x35\xbf\xa6\x62\x2f\xc9\x69\x04\x5a\x1f\x27\xe9\xe9\x70\x4c\x56\x15\xb1\xd1\x78\x8c\x9b\x22\xbd\x46\xd1\xc5\x93\x4b\x8d\x53\x5c\x15\x6c\xd5\x59\x6a\xe2\xac\xb1\x88\x72\x2c\x7b\x8d\xa5\x45\xb8\x26\xd2\x6a\x32\x36\xd6\x1b\x32\xfa\xf2\xcd\x5e\xc6\xca\x3a\x43\xc6\x36\x81\x68\xaf\x40\xfb\x0e\x81\x92\xed\xe2\xc7\x5e\xd9\x4d\xfb\x07\x4a\x1f\x03\x32\xb1\xa1\x64\xdb\xce\x42\x05\x1e\xfc\x44\xc6\x75\xc1\xde\xad\x71\x5d\xe6\x5b\xc7\xb4\xf7\x0e\x4e\xf9\x8f\x7d\xb5\xc4\x38\x69\xe5\xce\x8c\xab\x12\xc1\x24\x51\xa4\x3d\x6e\xe0\xcc\x6d\x79\x61\xd9\x49\x06\x05\xe8\x86\x02\xd5\x0d\x40\x28\x64\xfe\x8b\x01\x13\xa1\x28\x0a\xfc\x17\x23\xab\x02\x1f\x33\x75\x34\x3e\x32\x72\xcc\x9a\x1b\x01\xbf\x84\xf8\xc6\xba\xe3\x4f\x2e\xbe\xb2\x58\xf8\xad\x8b\x6f\x5f\x4d\xfc\xca\x65\xd8\xb4\x64\x78\xdf\x65\x69\x5b\x1b\xfc\x9c\x91\x55\x79\x8a\xe3\x55\x92\x65\xfc\xfa\x70\x99\x65\xc7\x63\x41\x29\x8b\x5f\x32\x9c\xe4\xb7\x6c\x0c\x01\xcb\x72\xb2\x1f\xef\xb3\xe4\x56\x85\xc7\xc9\x42\x2e\x05\x8d\x5f\x31\x27\xb2\xfc\x91\xad\x55\x02\xcf\xf2\xf8\xad\x96\xff\xf5\x40\x66\xbc\xef\x44\x82\xa1\x2e\x8b\x92\xe9\x20\x15\x10\x30\x72\x6a\x10\xe6\xd7\x4c\x6d\x09\xd5\xc0\x44\x24\x17\x17\xb6\x12\x45\x6b\xbc\xe5\xbf\x29\x3c\x3a\x91\x54\x65\x9d\xa5\x93\x89\xee\x76\xc6\xf9\x42\xfd\x5b\x5a\xce\x74\x69\xbe\x94\xae\x7b\xac\x52\x94\x2f\x74\xc1\x45\x96\xdc\xe6\xaf\x58\x96\x32\x88\x16\x34\x99\xbc\x63\xd9\xad\x0e\xff\xbd\x4c\x85\xee\x94\xa0\x57\x54\xe4\x26\xcc\xaf\x61\x9c\x82\x5f\x1f\x2f\x12\x16\x7f\x62\x38\x1f\xf3\x85\x4e\xcc\x69\x32\xcf\x68\x9e\xeb\xb0\x96\xae\x33\x1d\xc8\xd3\xaf\x54\x95\xcb\x65\x22\x24\x94\x52\xa5\x55\xc4\x82\x66\x5a\x1a\x5b\x0d\x53\xdf\x1d\x7d\x2c\x93\xf3\x57\x6c\x42\x6f\xe2\x3e\x4e\x25\x9d\x1f\xab\x26\x60\x2d\xc6\x63\xba\x90\x7b\xb3\x44\xe4\x54\xc6\x7d\x3c\xce\x92\x3c\x07\xc0\xd5\xc7\x33\x39\xcf\x0e\xb9\x80\x90\x5c\x1c\xfc\xbd\x4c\xaf\x62\x2b\x86\xbe\xcf\x0a\xdc\x00\xc8\xf1\xaa\x5a\x5d\xa0\x3f\xb7\xc7\xfa\x3b\xf0\x6a\x0f\x20\x18\xb8\x36\x14\xed\x17\x78\xed\x80\x10\xd1\xb6\x9a\xb3\xab\x00\x5a\xaa\x01\xf8\xd8\x30\xa0\xab\xfa\x8f\xce\x40\x9a\x2c\x0d\x37\xd7\x39\x20\xe6\x29\x01\x0d\x4b\xce\x97\x51\x5c\x5b\xaf\xbb\x5a\x52\xa1\x8a\x90\x9a\xfc\x75\xdd\x0a\xfb\x24\x01\xd2\x74\x5a\xa2\x37\x9d\xa4\xf2\x36\x0c\xbb\xe5\x47\xef\x3c\x99\xbc\x52\x34\x89\xa2\x7e\x2a\x0f\xbd\xbd\x64\xac\x40\xb0\x41\x95\xb5\x9d\xf5\xbb\x5a\x50\x80\xe2\xcb\xdd\x07\xf2\x6f\x46\x56\x37\x59\xca\x2e\xdb\x04\xb7\xb4\x08\x96\x4a\x0d\xf0\xcd\x3c\x6b\xcb\xf2\xfb\x9b\xd7\x5a\xf8\x8c\x59\xc0\x16\x14\xf8\xb0\x7e\xca\x97\x92\x1f\xe9\xfd\x1b\x7f\x61\x98\xde\x68\x25\xc7\x23\xaa\x99\x06\xf9\x91\xdd\xe7\x5f\x18\x56\x18\x0d\x15\x57\xf4\x79\xb6\x98\x25\xf1\x97\x0d\x5b\xc6\xab\x30\xf0\x3e\x82\x3b\xea\x0e\x36\x26\x05\xb5\x46\x83\xca\x67\xd0\xd2\x03\x40\x32\x62\x3d\x71\xcf\xc7\x72\xa9\x8e\xf4\xdf\xac\x07\xdf\x58\xc7\x8a\xb1\xe0\x59\x3d\xf6\xa5\xa0\xd3\x5a\xd4\x51\x33\xd7\xf1\x8c\x5f\xd7\xa2\x3e\xa6\xb2\x91\x4d\xd1\x42\x5e\xd4\x3c\x7b\x91\xe4\x3a\x62\x9e\xa9\xcf\xd7\x09\xbb\xf0\x3e\x8f\x01\xd9\xd0\xdf\x45\x81\xbf\x32\xf2\xc3\xc9\x9f\xdb\x7f\xc6\xa7\xd1\x49\xb2\xfd\xf5\x14\xfd\x70\x31\x84\xb3\xc7\xe4\xf6\x8c\xa6\x17\x33\xd9\x49\xb2\xf4\x82\xa9\x9d\xb6\x7d\x9e\xe4\x54\x01\xa8\x4e\x22\x92\xf3\x74\x0c\xc2\x40\x1d\x1b\xb9\x9d\xcf\xd2\xa9\xec\x8c\x93\x85\x2d\x38\xce\xd2\xc5\xf6\x22\x91\x33\x1d\x12\xcb\x8c\x76\xb4\x54\x12\x58\x85\x5a\xf0\x2c\xd1\x72\x0e\xcd\xb8\xed\x69\x9a\x49\x2a\xf2\x4e\x45\x8a\xc9\x7c\x09\xca\x26\x54\xa1\x03\x9d\x09\x9f\xa7\x2c\xf1\x7b\x46\x99\x82\xd2\xdb\xe7\xc9\xf8\xf2\x42\xf0\x25\x9b\x74\xa6\x69\x96\x6d\xf3\x45\x32\x4e\xe5\xad\xfe\x80\x8e\x4c\x33\xce\x27\xdb\x50\xa1\x09\xbb\x3c\x5a\xaa\x69\x9e\x66\x26\xac\xe0\x65\x19\xda\x4e\x26\x7f\x2d\x73\x69\x22\xa4\xa0\x72\x3c\xb3\x1f\xb7\x99\xc9\x78\x95\x88\x34\x61\x26\xd3\xb5\x9e\x0e\x10\x88\x02\x49\x29\x13\xe4\x22\xb5\x1a\x76\xdb\x33\x2e\xd2\xaf\x8a\x82\xc9\x5a\x12\xaf\xd4\x09\x1a\x27\x59\x07\x72\x6d\x27\x93\xab\xed\x1b\x13\xe6\x22\xbd\x48\xd9\xf6\x4d\x07\x58\x68\xde\xd4\x64\x54\x4a\x2a\xb6\xd5\x1e\x85\x4f\xd5\x85\x94\x5d\x98\x11\xcf\x13\x71\x49\xc5\x36\x65\x13\x1b\x9c\xa7\x2e\x08\xf7\x42\x87\x2b\x8c\x48\xad\xeb\x02\x50\x3e\xce\xca\x18\x39\x4b\xc7\x97\x8c\xe6\x79\x67\x91\xa4\x4c\x6e\x73\x31\xa1\xa2\xb3\x48\x18\xcf\xe9\xf6\xa0\x03\xac\x01\x55\xfb\x95\xa2\xfc\x3a\xae\x4f\xb0\xc4\x4c\x76\xf2\x59\xb2\xf0\xbb\x9a\x4b\xbe\x30\xfd\x82\xa0\x5d\x88\x5c\x8a\xf4\x92\xca\x99\xe0\xcb\x8b\x59\xd9\x8d\x6a\x74\xd9\x97\x5c\x0a\x7e\x49\xb7\x27\x49\x3e\x4b\x84\x48\x6e\xfd\x08\x3e\x9d\xe6\x54\xda\x18\x35\x88\x71\xb2\xf0\x3f\xff\xe2\x29\xb3\xdf\xf3\x54\xaa\x81\xce\x53\x57\xc0\xeb\x91\xfa\xbc\x4e\x27\x72\xd6\x91\xf4\x46\x6e\x27\x6c\x3c\xe3\x42\x87\x27\x74\xcc\x35\x05\xa6\xbf\xcb\x11\x2e\x55\xa0\x3a\x99\x65\x54\x39\x82\x25\x4b\xc7\x7c\x42\xb7\xcf\xd3\x49\xea\x3e\x84\x22\x25\xd5\x97\xcc\xb7\x17\x6a\x56\xe7\x9d\xab\xed\x44\x81\xa4\x73\x2a\xd3\x71\xe7\x6a\x7b\x96\xb0\x0b\xd5\xca\xd5\x76\x3a\xa1\xfc\x42\x24\x8b\x19\xc4\xcf\x13\x39\xa3\xf3\x44\x6f\x9d\x2b\x3a\x96\x5c\x6c\x6b\xc3\xba\x1d\xb5\xa3\x60\x1f\xdd\xea\xa0\xdb\x46\xfe\xd7\x6d\xe7\x9a\x8b\x89\xdb\x42\xd7\x22\x85\x1d\x34\xe7\x13\xda\xb9\xb1\x87\x5c\xdf\x1a\x89\x86\x7e\xf6\x4b\x43\x3d\xf3\x35\x13\x74\x6a\x82\x5e\x6c\x3e\xe3\xd7\x26\x28\x15\x54\xb3\xe1\xdb\x05\xed\xa8\x4b\x46\x9d\x68\x15\x60\x79\x0c\x29\x10\x99\x25\xec\x02\x02\xfa\x8a\xe9\xe5\x8b\x2c\x95\x51\xd0\x09\x90\x53\x33\x69\xf7\x8e\xa4\x4d\x30\x7e\x65\x8a\x56\x3b\x64\x1e\x05\x79\x22\x4f\x49\x1f\x1f\xb2\x26\x89\xa8\x52\x68\x81\xf0\x31\xeb\x6d\xa0\x50\xa3\xe7\xec\xce\xe4\x43\x63\xb1\xf2\x33\x23\xab\xb3\x71\xb2\xbc\x98\x69\x23\x84\x9a\x8e\x3b\x9b\x25\xf9\x9e\x17\xd9\x1d\xe0\x33\x01\xdb\xfa\xba\x96\xeb\xc8\x8f\xed\x0e\x0c\xc1\x9c\x72\x16\xaf\x74\x10\x52\x3e\xc9\xb4\xa6\xfe\xdc\xf2\x2e\x4e\x7b\x29\xbb\xe2\x97\xf4\xe7\x65\x22\x26\xa5\xdd\x16\x2d\x71\xfb\xf4\x71\x80\xc0\x2b\x48\x6b\x9e\xa2\xc0\xad\xf1\x75\x3b\x0a\x1c\x0b\x9c\xe1\x04\xa7\x38\x47\xab\x71\x2f\x59\x2c\xb2\xdb\xe8\x33\xc3\x8e\x15\x84\x36\x54\xf4\x9c\x69\x73\xa5\xe0\x73\x44\x0f\x76\x63\xd5\x63\x40\xd8\x3e\xb3\xf6\xae\x9a\x46\xe5\x2c\xcd\xbd\x66\xf1\x67\x20\xe2\xbd\x39\xb7\x16\xa1\x72\xf2\x99\xf5\xc6\x19\x4d\x44\x25\x71\xf8\x99\xf5\xea\xf3\xbf\x5e\x47\x2d\xb1\xa4\xdb\x57\xb5\x57\xd6\x8f\xe4\xa8\x28\xb0\x89\xf1\x17\xba\xe9\x3b\x2a\x6f\x9f\xa5\xda\x06\x69\x96\x33\x3d\xf1\x32\x15\xb8\x3e\x0c\xbf\x98\x9e\xb2\x5a\x11\x6b\x2e\x4e\xa5\x78\x9b\x74\xe8\xb5\xe1\x45\x6b\xf5\xa1\x66\x2d\xda\x18\x17\xec\xa2\x27\xe0\xb5\xec\x77\x63\xf1\xf5\x37\xa0\x50\x7f\x65\xe4\xe4\x14\xff\x0c\xe1\x17\xf0\xfb\x01\x7e\xff\x60\x44\xcb\xff\xf7\xa6\x82\xd2\xaf\x34\x5a\x2d\xb2\xe5\x45\xca\xf2\xf8\x57\x86\xe1\xfe\x50\x67\x71\x3f\xcd\x17\x6a\x67\xe8\x73\x95\xc7\x3f\x2b\x3a\xe8\x22\xcd\xa5\x86\xb6\x2a\xcb\x1b\x3e\x59\x66\x34\x8f\x5f\x34\x93\xf6\xe9\x42\x01\x62\x36\x56\x48\xe8\x07\x86\x17\x3c\xcf\xd3\xf3\x8c\x1e\xd5\xf2\xe5\xfa\xc4\x99\x23\xa5\xda\x7e\x0f\x7d\x79\xa7\x6e\xb7\x78\xda\x4c\xc8\x5f\x68\x3e\xcd\xa2\x40\xf8\x17\x33\xdc\x07\xd6\xef\x22\x37\xc6\x89\xcc\x3f\xe3\x64\xf5\x4f\x6a\xc6\x9c\xd7\x67\xa8\x3c\xfe\x8c\xe3\x0b\x2a\x5f\x83\x17\x5b\x2a\xe2\x5b\x85\xf6\x8a\xc4\xd4\x94\xc7\x37\x98\xb2\xbf\x97\x74\x49\xcd\xf7\x35\x5e\x08\x3e\xa6\x79\x0e\xdf\x60\xd2\x3e\xbe\x2c\x10\x16\x9c\xbc\x49\xe4\xac\x27\x12\x36\xe1\xf3\x08\xf5\x24\xd7\xec\x96\x68\xf7\x11\xea\xe5\x59\x3a\xa6\xd1\x0e\xc2\x19\x27\x41\xcd\x3e\x89\xe5\x15\x3f\x08\xb6\x04\xc7\x49\x99\x01\x5a\x78\xa9\xfd\x9d\xe7\x3a\x35\x6d\x0c\x64\x21\xe8\x38\x19\xcf\x28\x98\x4f\x00\x43\xb2\x55\x92\x4c\x9e\x64\x5c\xc1\x60\x35\x48\x10\x37\x2e\xcd\x2b\x1c\x0a\x3e\x87\x12\x07\x2a\xb1\x11\xdb\x6a\x4d\x82\xaa\xea\x70\x97\xae\xd7\x9e\x53\xaa\x47\x36\x68\xac\x4b\x40\x63\xaa\x0e\x55\x97\x33\xe6\xb0\x87\xc1\x17\xd2\x39\x15\x7b\xda\x9e\x06\xf8\x89\x71\xcd\xbd\x33\xb6\x65\x20\x87\xf6\xf9\x58\x1d\x09\x3d\x49\xf8\x29\x91\x45\x81\xf0\xb8\x31\x0d\xc9\x78\xbc\x9c\x2f\xb3\x44\xd2\x8f\xd7\xfc\xfd\x2c\xc9\xdd\x7e\xa7\x79\xfc\x12\xdf\x95\x7c\x7c\x99\x2e\x3e\x26\xe2\x82\x56\x1d\xb5\xcd\x23\x8a\xff\x42\x85\x57\xf4\x40\x2d\xd8\x6b\x9a\x5c\xf9\x75\x7f\xf2\x32\xec\xa7\x42\xdd\x61\x65\x62\xbd\xbe\x8f\x48\xf5\x3e\x37\xdb\x79\xc9\xc9\xea\x4c\x58\x53\x3b\xf8\x0c\x50\xc6\x8f\xce\x36\xcf\xd9\xd4\x40\x62\x17\x55\xe0\x29\x27\xc1\xa4\x72\x90\x3b\x67\x12\xfa\xae\xa6\xb9\xc3\x12\xa0\x69\xd5\xbe\xe9\xa4\xf9\xbe\xe6\xcd\xbc\x17\x00\x03\xe8\xa4\x93\xe6\x6a\x5e\x93\x0b\xe3\x25\x88\x2f\x16\x74\xd2\x39\xb3\xf5\xd9\x23\x90\x97\x51\x76\xed\x72\x1f\x4b\xc0\x0b\x4e\xb4\xa2\xbd\x3e\x9c\x7a\xea\xb4\xbd\x21\xbd\xb0\x66\x36\xaf\x59\x4f\xce\x12\x79\x04\x7b\x27\x7f\x0b\x27\x1a\x8e\xa7\x9a\x7f\x5d\xe0\x7c\x79\x7e\x9e\x59\xa0\xa1\xc5\xec\x81\x41\xa4\x6b\x4e\xe7\xf4\x58\x26\xf3\x45\xeb\x56\xec\xb9\xe4\xf5\x7a\x3f\x91\x60\x72\x2d\x42\x85\xe5\x48\xb9\x51\x1b\x80\x94\x7f\x14\xcb\xdc\x7e\x16\xc3\x1b\x16\x3d\x2f\x0d\xe5\x60\x75\x8c\x54\x76\x33\x65\x3e\xbc\x87\x47\x93\x7a\xa5\xd6\xf1\x10\x25\x90\xec\xcd\xfb\x50\xeb\xad\x55\xeb\x1b\xd5\x23\x22\x14\x07\x4b\x63\xf3\xda\xc3\x2b\xf4\xd8\x4a\xed\x37\xef\x9b\x74\x07\x08\x43\x63\xcd\x85\x25\xd5\x99\xfe\x28\x96\x14\x15\x58\x91\x01\xde\x7a\xfb\x63\xba\xb3\xeb\xb5\x72\xa3\x46\xcc\x86\xce\xeb\xe5\x7b\x01\x2b\xaa\x35\x83\xbd\x08\xd2\xed\xbb\xee\x37\x37\x61\xfb\x00\x8c\x73\xf1\xc6\x62\xa4\xf9\x7b\xeb\x76\x5c\xb6\x94\x2c\xb0\x9f\xa1\xb6\x0b\x0f\x93\x2c\xa7\x78\x42\x73\x29\x96\x0a\x97\x6f\xcc\x0a\x96\x7a\x5e\xc6\x9c\xd9\x3c\x3d\x80\xd4\xd3\x64\xac\x95\xd4\xa8\x16\x7f\x53\xb9\x4e\xe8\x69\xf9\xc4\x2f\x49\x7f\x28\x9f\x4d\xb9\x15\x3f\x92\x5b\x5b\x3a\xd3\x94\x9f\xc8\x53\x9d\x51\x1d\xff\xe7\x65\x7d\x64\xc1\xf1\xf3\x5e\xb2\xbc\x50\xd8\xca\x5e\x96\xe4\x39\xa9\xc2\x3c\xcf\xbb\x0b\x5a\x15\xac\xdc\xb1\xba\x93\xee\x73\xa8\x35\x75\x18\xbd\xee\x30\xb5\xb7\x39\xf6\xcc\x40\x21\xff\x83\x54\x92\xfc\x61\x12\x8a\xa9\xd7\x35\xa3\x8e\xab\x9a\x71\x91\x18\x8c\xfe\x57\xfa\x0b\x19\xfc\x18\x7c\x08\x26\x7d\x0e\xa3\xe7\xa8\x36\xb6\xe8\x2b\x5e\x4d\x12\x99\x58\x1b\x50\xb5\xd4\xcf\x95\x54\x18\xd1\x39\x27\x27\x4f\xf1\x60\x17\xef\x3c\xc6\xbb\x3b\xa7\x78\xc6\xc9\x2d\x53\xfb\xea\x53\x4e\xf7\xdf\xbd\x09\xc3\x00\x8c\x59\x69\x6a\x10\xf6\x70\x90\xb2\xce\x75\xca\x26\xfc\x1a\xcf\x8d\xf8\x45\xad\x84\x7d\x24\x7f\xc3\x27\x54\xe5\xb6\xdf\x61\x18\xcd\x39\xb1\x5f\x3d\x3f\x9b\xee\xcc\x05\x38\x39\xb9\x68\x74\x41\x81\xe7\x5a\xdb\x61\xd8\x9d\x1b\xa1\xcc\x33\x4e\x74\x5c\x4f\x91\x35\xc9\xf0\x82\x93\x6e\xd4\xd0\xa7\x3b\xe3\xad\x32\xb6\x67\xbc\x77\xa5\x76\x32\x67\x61\x38\xd8\xf9\x49\x1b\xa8\x78\xc5\x64\x54\x26\x44\x08\x0f\xfa\xc8\x3c\xd5\x72\x7c\xcb\xc9\x05\xc7\x37\xf5\x4e\x46\xdd\x19\x5f\xaf\xe7\x3c\x0c\x9f\x3c\x53\xbf\x83\xc1\x4f\x64\xce\x11\xbe\xe6\x44\x63\x29\xbd\xa9\xe0\xf3\xbd\x59\x22\xf6\xf8\x84\x46\xbb\x3b\x08\x5f\x72\xb2\x3a\x07\xf7\xf8\xc0\x40\x8d\x57\x0b\x05\xb6\x27\x4d\x84\x6f\xa5\x81\xf8\x24\x0e\x38\x7b\x51\x16\x08\xec\xdb\x42\x3d\x61\x4f\x47\x07\x0a\x54\x7b\x48\xe5\x09\xe8\x49\x79\xcb\xc9\x26\x20\x34\xb4\xf8\x95\xde\xbe\x17\x34\xcf\xf5\x97\x76\x95\x07\xf5\xab\xcf\xf7\x49\x2e\x69\x70\x5a\x68\x9b\xfb\xae\xe4\xfd\x7a\x5b\x6f\xcd\xef\x70\x35\x6d\x43\x9f\xad\xea\x59\xa7\xd1\xf5\x8e\xee\xf8\x3e\xbf\x66\x1d\x6f\x0c\x26\xfc\x69\xa1\x02\x6f\xf8\x32\xa7\x2a\x87\x7f\xbb\x56\x46\x72\x0c\x8f\x0b\xdf\x3b\x16\x28\xb5\x71\x34\x90\xfa\x7d\xe3\x81\x22\xff\xce\x88\xb4\x80\xc5\x77\x0f\x49\x17\xdb\x38\x26\x9d\xfc\x7d\x83\xd2\x65\xfe\xf9\xa8\x0a\x7c\x00\xde\x4a\xf6\xe0\xf7\x1d\x27\x2b\xb8\x8d\x3f\xde\x2e\x68\x1e\x5f\xf2\x1a\x19\x51\x27\xc2\x8d\x02\x99\x82\x28\x33\x8e\x68\x5c\x57\x68\x6f\x59\x80\x20\xd6\x32\xae\x97\xbc\x57\xdf\x22\xce\xad\x45\x4b\x59\xb5\xb3\xe3\x7a\xa9\x03\x36\xb9\xab\x8c\x99\xee\x46\x31\x1d\xef\xcc\x26\x5a\xaf\x84\x5a\x0a\x7b\x8f\x8f\x7e\x07\x69\x2e\x90\x66\x6b\xb4\xa7\x75\x25\xcd\x5c\x1b\xf5\xf5\x9d\x1d\xad\xab\x78\x49\x6f\xf7\xc0\x4f\x7c\xa3\x20\x0c\xcf\x59\xd0\xcc\x46\xd1\x0d\x0f\xc3\x68\x8f\xaf\xd7\x59\x97\xb4\xe5\x1d\x65\xa4\x1e\x7f\xc0\x26\x61\xb8\xa7\xca\x09\xf2\x2a\x42\x28\x8e\x96\xbc\x07\x88\x37\xe1\x58\x05\x1d\xe2\x4d\xf6\x23\x04\x0b\xda\x47\x08\x67\xe4\x2b\x08\xf5\x70\x9e\xd1\x09\xf8\x76\x06\xb1\x63\x31\xca\x7a\xea\x9e\x22\x22\x2e\x4d\x40\xff\x16\x69\x73\x28\x36\x09\xe1\x97\x51\x86\xb0\x20\x19\x8a\x0d\xb9\x1f\x51\x72\xcb\x47\xbf\x6a\xa3\x6a\x3f\x6b\x93\xd1\xa3\x48\x92\xcf\x5e\x23\x97\xbc\xe7\x81\x5c\xdb\xa2\xd4\x95\x52\xfc\x32\x92\x08\xc5\xc6\xfa\xc8\x89\xc0\xf2\xb4\x28\xf0\x7b\x43\x4d\xbc\x31\xff\xaf\xcd\xff\x91\x23\x96\x35\xcd\xe5\xec\x38\x69\x9b\xcc\xc6\x06\x64\x05\xb5\x7e\xcf\xc1\x3b\xea\x5f\x77\x50\xcb\x47\xdc\xd2\xc3\x20\x12\x7c\x44\x73\xc9\x05\x8d\x3f\x60\xa1\x43\x10\xfb\x6a\xfa\x96\xd2\x09\x9d\xc4\x7f\x14\x08\x1f\xc3\xf9\xf8\xc8\xc9\x0a\xb8\xd3\x5a\xb0\x47\x52\xfb\xaf\xb0\x78\x15\x6e\x58\xfd\xe9\xf6\x31\x9d\x27\x69\xa6\x02\x60\xfc\x47\x05\xf4\x93\xa1\x0a\x2d\x92\x3c\xbf\xe6\x02\x64\x7d\x80\xbb\x0b\x92\x3a\x34\x11\x63\xc8\x28\x69\xa6\xff\x6e\x24\xfc\x9b\x56\x96\x02\xa2\xaf\x29\xbd\x8c\xbb\xfd\xa2\x86\x1b\x44\x57\x1e\x02\x50\xb5\xde\x17\x86\x1b\x12\x7a\xb3\x24\x3f\xa4\x89\x02\x3c\x61\xd8\xed\x77\xc9\xa6\x1a\xbc\x8c\x51\x10\xe0\x20\x40\x1a\xad\x78\xa9\x66\x06\x84\x47\xef\x09\x15\xb5\xa0\x69\x15\x14\x42\xdc\x7d\xe0\x1f\x64\x84\x50\x96\x8e\x2f\x3b\x56\x31\xba\x03\xf2\xad\x8b\x65\x0b\x94\xd7\x30\x50\x1b\xa6\x49\x5d\xf3\x55\x48\xf8\xc9\xec\xb9\xb7\xe6\xff\x95\x5a\xf3\xfa\xe4\xbe\x52\xf8\x69\x64\x44\x44\xd5\x61\xe9\xb6\x22\x5b\xeb\xf5\xd3\x67\xed\x58\x98\x9e\xaf\xfd\x2a\xa4\x7d\xc9\xf1\x59\x9a\x43\xdf\x01\xd4\x1e\x2f\x17\x0b\x2e\x14\xa1\xf7\xea\x9e\x30\x98\xc8\xd1\x5e\x24\x51\x6c\xd0\x46\x70\x93\xc0\x27\xc0\xbf\x0e\xc3\x32\x5c\x15\x4b\x1a\x82\x76\x8c\x16\x55\xd5\xb6\x22\x4a\x5d\x86\x4c\xe1\x72\xa9\xd6\x2f\xd0\xaa\x0c\x48\xcb\x46\x2e\x8d\xf7\xd7\x74\x1a\x51\x1a\x09\x50\xb1\x79\xc5\x51\x42\xe6\x3a\x61\x95\x90\x73\x6a\xe4\x18\x17\x14\xa0\x6a\x37\xf2\xfb\x83\x5c\x33\xe0\xed\xb3\xd2\xa3\xf5\xba\xb4\xcf\xd6\x2d\x75\x28\x8c\x56\x85\x8b\x59\xaf\xa3\x84\xcc\x28\x0c\x20\x01\xe7\xa2\x09\x90\x1a\xce\xd3\x54\x4a\xa3\x04\x66\x67\x98\x86\x61\x1a\x51\x2c\x14\xd6\xef\xeb\xe3\x97\x96\xcb\xc0\xab\x82\xac\x6a\x09\xac\xd7\xa2\x1a\x81\xc2\x50\x1b\xcf\xd5\xe0\xa7\x54\x79\xf1\x34\x3d\x22\x30\x61\x66\x95\x08\x84\x02\x89\x4d\x09\x00\xfd\x48\x2f\xda\x1f\xe9\x29\x42\x45\x31\xac\x91\x14\x17\x14\xaf\xae\x52\x7a\xad\x79\x00\x5a\x32\xdd\xa7\x2f\x9e\x73\xb2\x7a\x9e\xc9\x38\x48\x32\xf9\x2b\xbd\x0d\xb0\x81\x92\x71\x30\x96\x22\x83\x98\x37\x54\x26\x71\x30\xa7\x32\x81\xcf\xe3\x59\x3a\x95\x71\x00\xcf\xb4\x2a\xa2\x18\x5e\x54\x09\xa2\xe8\x96\xe2\x55\x0e\x12\x38\xbf\xeb\x66\xf5\xc7\x17\xc3\xe3\xc8\x52\xca\xe4\xef\xfe\x87\x49\x59\x24\x17\xf4\xf7\x32\x68\xf3\xeb\x7e\x98\x9a\x4c\xab\xfa\x4b\xf7\xd9\xc8\xb3\xe9\xfe\xe9\x8f\x0b\xaa\xce\x4b\x3a\x4d\xcd\x02\xc4\x57\x14\x9f\x2f\xa5\xe4\xcc\x32\x5d\x54\x38\xb7\x26\xa6\xb3\x44\xd2\x49\x0b\x03\xcc\x93\xe0\xf0\xb2\xac\xd7\x11\x05\x62\xc1\x48\x4e\x80\xc0\x46\x2e\xc6\xe6\x73\xa4\xce\x89\x27\x7f\x5c\x66\x44\x85\x99\xf4\x2f\x9c\xac\xe6\x0a\xa5\x02\x7e\x5a\xbc\xaa\xb3\x9a\x15\x40\x7b\xe3\xd2\x83\x16\x2a\x01\x52\xdf\x59\x12\x40\x7f\x5d\x51\xa1\xc8\x00\xa8\x18\x78\x74\x77\x54\x0c\xe9\xdf\x57\x71\x81\xff\xae\xc2\x9d\x2f\xdf\x86\x2e\x0a\x44\x54\xaa\xd1\x67\x27\x62\xf5\x19\x65\x95\x79\xd2\xc6\x2f\x5c\x57\xf4\xae\xaf\x56\xa4\xe2\x2a\x46\x88\xad\x11\x57\x0d\xc3\x08\x01\x8b\x93\x60\xf7\xb4\x6a\xdd\x60\x54\x6a\xd1\xa4\xf4\x5a\x1d\xd5\x45\x22\x28\x93\x9f\xa1\x9c\x01\x81\xc3\x4a\xc7\x97\xa5\x39\x08\x89\x25\x01\x5b\xa1\x8d\xfe\xbb\x35\x47\xa3\x03\x05\x4b\x41\xd8\x3c\x36\x76\xfe\x29\xf1\x04\x82\x5c\x77\xad\x1f\x58\x3a\x12\xf1\x5e\x44\x91\x33\xda\x23\x21\x42\x5a\x81\xf2\x5b\xea\x21\x48\x5f\x78\xaf\x5c\x61\x4c\x35\x9c\x32\x35\x27\x00\x4b\x48\x00\x19\x32\xbd\xc4\x49\x4f\xb3\x27\x49\x86\x93\x6a\xaf\x89\xc0\xac\xbd\x6e\xd8\x76\x16\xf7\x62\x7e\xa5\x54\x6f\x48\x66\x2b\x15\xb8\x36\x15\x24\xc3\x9f\x00\x7a\x02\xa7\xe4\x24\xc1\x4c\xed\x9c\x43\x4e\xae\x58\xef\xec\xec\xf8\x60\xef\xe8\xe0\xe3\xd9\xab\xb7\x1f\x0f\x8e\xde\x3e\x7f\x7d\x7c\xb6\xff\xee\xec\xed\x3b\x2d\x68\xf8\xee\xe8\xec\xcb\xbb\x4f\x67\x9f\x5f\xbd\x7e\x7d\xf6\xe2\xe0\xec\xf0\xd5\xd1\xc1\x7e\x4f\x9b\xeb\xd6\x3c\x55\xed\x6b\xef\x2b\x27\x27\xa7\xf8\x33\x78\x22\xfc\x9d\x5b\x2d\xae\xdf\x1a\x08\xdb\x05\x95\x9d\x33\x2d\xc6\xe1\x79\x23\xfb\xcc\x81\x33\xdf\x39\x9b\xc1\xa3\xc2\x47\xbe\x78\x4d\xaf\x68\x56\x66\xf8\x9d\x17\x38\xa7\xe6\xcd\xc1\x26\x57\x80\xc2\xef\x0a\x45\x54\x79\x0e\x74\xe5\xf1\x6b\x8a\xd3\xdc\x7e\xb4\xbc\xae\xf1\x02\x4b\x91\x2c\x34\x17\x70\x02\x67\x25\x3e\xa2\x10\x67\xf0\x15\x13\xf9\x17\xc5\x96\xed\xac\x23\x8e\x69\x81\xf0\xaf\x9c\xac\x12\x96\xce\xe1\x10\x53\x36\x89\x3f\xd2\x28\x78\x6e\x23\x02\x5c\x86\x15\x9d\x83\xb0\xcb\x9b\x4a\x6a\x7c\x45\x6c\x2c\xf1\xca\x66\xf1\xcb\x69\x79\xbe\x8d\x65\x34\x25\x86\x54\xff\x99\xa6\x2e\x6c\xa7\x3e\xba\x98\x00\x7b\x1f\xd0\xad\x02\xff\xcc\xe1\xd5\x4e\xfd\xd6\xf1\xa2\x17\x1e\xd2\xd9\x66\x6e\x45\x1b\x40\xf2\x47\x5a\x65\x36\xad\xd7\x91\xf6\xe6\xdd\xf9\x95\xf7\xfc\xb9\x2a\x3f\x70\x4b\x06\x37\x41\x77\x67\x83\xf9\x28\x3f\x51\x65\x6c\xf5\x8e\x94\xe5\x2b\xf3\xe3\x7d\xe9\x53\xfd\x81\x93\x95\xe4\x8b\xe7\xe7\x5c\xa8\xcb\x57\xfd\x05\x58\x45\x78\x8b\x19\xbf\xa4\x51\xe0\x0f\x27\x50\x90\xb1\x12\x51\x29\xe2\x56\xb3\x5a\x30\x2d\x17\xd9\x2f\x5e\x46\x57\x2a\xd1\x0c\x97\x4a\x05\xb9\x5e\x71\xbf\xb0\x8e\xc2\x06\x25\x8a\x8d\xd9\x21\x67\x18\x28\x76\x06\x83\x74\x14\xc8\xda\xaa\xb8\x45\x96\xdc\xfa\x91\x1f\xb5\xf8\x8d\x4b\x33\xe2\x38\x3a\x8b\x26\x09\x82\xb1\x41\xf7\x2d\xca\x1e\x07\xa0\xda\x64\x22\x78\x0e\xa2\x9c\x60\x54\xa8\x41\xf6\xc7\x81\x47\x04\xdb\xf9\xaa\x73\x15\x2a\x99\xca\x81\x35\x98\x01\x95\x7c\x4b\xc3\x8e\x81\x8c\xe0\xcb\xe0\x0d\x65\x4b\x95\x05\x3e\xe6\x94\x2d\x4d\xe2\x42\x8d\x9c\x2f\xcc\xb0\x97\xaa\xb9\xa5\x6e\x61\x9f\x2f\xcf\x33\x6a\xc6\x34\x39\xcf\xca\x61\xed\x8b\xe4\x22\x0e\x26\x22\xb9\x70\x9f\x30\x1a\x15\x63\x87\xa1\x23\x15\xfe\x60\xa2\x01\x32\xdb\x84\x9b\x54\x9a\xf8\x9b\x54\xba\x68\x8d\x16\x40\xbc\xb9\x1e\x4c\x82\xba\x53\x75\x3c\xbf\xf2\xaa\x31\xf3\xa3\xe2\xcb\x89\xd9\x17\x7c\xa1\xe2\xf8\x42\x7f\x2e\xf5\x36\xb2\xcb\x35\x31\xdf\xde\xb2\x1d\xcc\x17\x32\x05\xa1\x49\x1d\xd0\x91\x6c\x2c\x6e\x17\x12\xa2\x6d\xd0\x24\x4c\x74\xe4\xc4\x46\x80\x00\x82\xd5\xe3\xb4\x04\x5b\x6c\x6d\x60\x59\xc2\x2d\x36\x84\x01\x2e\x09\xb8\x38\xb8\xa4\xb7\x13\x7e\xcd\x6c\x24\xb0\xb6\x20\x76\xa1\x19\xaa\x96\xc2\x83\xb8\xa5\x1e\xd1\x6b\x10\xe0\xdf\x4f\x14\xda\xab\x85\xf9\x27\x89\x4c\x5c\x52\x6c\x34\x47\x5d\x4e\x85\x20\x4f\xbc\xdc\x73\xf3\xed\xb2\x98\x69\x54\xa9\xe5\x34\x3a\x9e\x5a\xac\xaf\x56\xd7\x4d\x48\x78\xc3\xd5\x42\x41\xc2\x9c\x9b\x85\xb2\xd8\x88\x89\xe7\x4b\xaf\x22\xbd\x80\x3a\xde\xae\x20\x24\xa8\xa1\x41\xb4\x19\x1c\xb0\x8b\xe3\x60\x01\x5c\x63\x1d\xb1\xcc\x21\x62\x69\x8e\x90\x3e\xac\xee\xa4\xaa\xcf\x94\x5d\xe8\x98\x94\xe9\x1d\xf9\x5e\xf0\x0b\x3d\x95\x0b\x13\x82\xe8\xa3\x44\x52\xbb\x0f\x44\x22\xa9\xb7\x07\xb4\xc9\xb3\xd8\x59\x3c\x03\x8a\x9a\x5e\xaa\x95\xce\xe1\xdf\x45\x41\x63\xb9\x0e\x98\xc8\x0a\xe5\x1d\x1b\xaa\xb3\xba\xc7\x8e\x65\xa2\x4d\x74\xe7\x3a\xa0\x23\x97\xb9\x42\x70\xe3\x20\xd7\x01\x88\x74\xfc\xf3\x18\x94\x31\x5f\xb9\x4d\xf3\x31\x9d\x53\x7b\xd4\x65\x3a\xa7\xde\x19\xd7\x2a\xbc\xb1\xd3\xe0\x85\xa8\xe5\x78\x66\xc1\x9d\x54\x1f\x1e\xcc\x83\x44\x38\xb2\x90\xe2\x9a\x56\x1f\x7a\x69\x21\xde\x2d\x2d\x24\x98\x6d\x02\x29\xe5\x3e\xa9\x5c\xa4\x00\x98\x2b\x37\x0b\xc0\xe5\x6a\x8c\x2a\xf5\x1b\xcf\x96\x73\xb7\x16\x57\xf0\xe5\xcd\xd6\xe7\x04\x84\xf0\xe2\xe0\x5a\x07\x74\xe4\x8c\xaa\xb1\x5c\xab\xbf\xa0\xc0\x7f\xc0\x85\xfd\x0b\x27\x7d\xfc\x80\x93\x40\x4b\x64\xb8\x27\xf2\x57\xfb\xc1\x56\x14\x04\x5b\x15\x91\x0f\x4f\xcc\x83\x8a\xfa\x2b\xd1\xe6\x67\xa7\xc1\xe0\xa7\xf6\x67\x27\x2c\x05\x59\xe9\xd5\xbe\x1f\x6b\x48\x6f\x95\x2a\x6b\x48\xc7\xdd\x8b\x35\xee\x00\x79\x85\x2d\xd4\xc6\x0d\x72\xa7\xb7\x53\x1e\xb4\x6f\x73\x89\x98\xe1\x84\x5a\x01\x28\x61\xfe\x33\x10\x7c\x4a\x44\x85\xbe\x92\xe2\x7e\xdc\x1b\x9c\xd5\xc8\x1e\x37\x85\xf1\x53\x15\x51\xea\xc7\xf2\xb8\x46\x0d\x0d\x8d\xbe\x69\x37\x43\x68\x45\xe3\x55\x46\x3e\x51\xcd\xb5\xfd\xc0\x7a\x76\xe6\x4a\xed\x6d\xd2\x1f\x26\xcf\x84\x7d\xdd\x4d\xac\x1d\xc4\x94\x08\x63\x75\xa5\xdb\xa2\xb1\xb4\x5e\x77\x41\x39\x69\x95\x91\xee\xc0\x31\xce\x8b\x8c\x74\xfb\x85\x6a\x19\x5c\x74\xb6\xf9\x93\xc9\x6a\x6c\x29\xef\x61\x40\xdb\x8e\x89\x23\xaa\x7a\x0b\xfb\x7f\x09\xbc\xa6\xac\x57\x53\xd6\x01\x77\x11\x82\xc0\x8c\x4b\x3b\xdd\x55\x7d\x6c\xc3\xd5\x89\x85\x20\x6a\x59\x84\xe7\x9e\xd3\xe5\x28\xdf\x3f\x62\xb5\x54\xfd\x5a\xb2\xb7\x71\xac\x7d\x76\xb7\x29\xac\xe9\xca\x8e\x5e\xe3\xe7\x14\x4c\x77\xb8\xa2\xf5\xfd\x12\xa7\xd3\x88\x0a\x54\xad\xdf\x3e\x19\xc4\x5e\x84\x57\xb3\xa9\xd3\x77\x23\xdd\x64\x04\x7d\xa1\xb8\x24\x23\x80\x17\xa0\x29\xd3\x2c\x59\xe4\x74\x02\xee\x8e\x34\xe7\x25\xa7\xcb\x89\xe3\x5f\xb4\xbf\x53\xff\x4d\xf1\x6a\x9c\xa5\x8b\x73\x9e\x08\x7d\x4f\x36\xb9\x26\x41\x25\x83\x3a\xef\x74\x44\x7b\xd5\x52\xe6\x59\xb8\x12\x59\x14\x08\xd7\x19\x4a\x87\x14\xaf\xaa\x5c\x1a\x8f\x83\x95\x0a\xb2\x3a\xc8\xc7\x71\x70\x90\x8f\x93\x05\x0d\x30\xa8\x26\x9c\x27\x22\x0e\x3a\x01\x7e\x4d\xa7\x32\x0e\x9e\x0b\xc1\xaf\x55\x30\xc0\xea\x3e\x84\xcf\x4f\x8b\x00\x1f\xa5\x17\x33\x9b\x0c\xe1\x00\xeb\x1b\x19\x62\x60\xce\xf1\xbe\x82\x89\xe0\x25\x91\x06\xf8\x73\xca\xe2\xe0\xdd\x71\x80\x35\xb6\xe7\x2f\x3c\x7e\xbe\x58\xe4\xb5\x28\x7b\xe9\xe9\xff\xd7\x5c\xa1\x77\x6f\xf8\xd7\xf7\x22\x65\xb0\x3d\x7f\xa5\xb7\x71\xf0\x89\xa5\x13\xca\x64\x3a\x55\x98\x51\x81\xc7\x82\xac\x9e\xc4\xc1\x8b\x64\x7c\xa9\xc5\x93\xf1\xd3\x38\xf8\x98\x9c\x07\x78\xb0\x13\x07\x7b\x19\x4d\x44\x80\x07\xbb\x71\x60\x98\x43\x83\x47\x71\x00\x3c\xb9\x00\x0f\x1e\xeb\xf6\x05\xcf\x02\x3c\x78\x12\x07\xcf\x33\x15\xfb\x34\x0e\xde\xeb\xdb\x7d\xa7\x1f\x07\x7b\xc9\x22\xd7\x3d\xd9\x79\x5c\x4e\xda\xee\x0e\x4c\xd7\xee\xae\xca\x7b\xa1\xf6\x2d\xde\x7d\xa8\xc3\x7a\x1a\x76\x7f\x54\x2d\x4e\x02\xbc\xfb\x28\x0e\x5e\xf2\xb9\x2a\xf3\xb8\x32\xb3\xbb\x4f\xbc\x99\xdd\x7d\x5a\x9d\xd6\x87\xfd\xca\xa4\x3e\xfc\x31\x0e\x5e\xb1\x9c\xaa\x3b\xee\xe1\xa3\x72\x7e\x07\x6a\x8c\x87\x03\x15\xd8\x8d\x83\xc3\x1d\x15\x78\x18\x07\x87\xbb\x2a\xf0\x63\x1c\x1c\x3e\x54\x81\x47\x71\x70\xf8\xa3\x0a\x3c\x8e\x83\xc3\x47\x2a\xf0\x24\x0e\x0e\x1f\xab\xc0\xd3\x38\x38\x7c\xa2\xa6\xaa\x1f\x07\x87\x4f\x55\x60\xa0\x2a\xec\xab\x10\x54\xad\xea\xde\x51\x75\x0f\x54\xe5\x0f\x1f\xc6\xc1\xdb\xe5\x5c\xcf\xc7\x40\xf5\xca\x5f\xaa\x9d\x9d\x87\x71\xa0\xb0\xbb\x16\x26\xe7\x67\x8a\x57\x97\xf4\xb6\xe1\x2c\xab\x77\x49\x6f\xad\xc8\x78\x2a\x4e\xe0\xfb\x74\xbd\x86\x7f\x60\x2b\x55\x96\xbb\xeb\xf1\x85\xa4\x39\xc0\x25\x76\xea\x1c\x9a\x8d\x22\x4a\xbe\x82\xb9\x88\xc1\x2e\xb0\xa2\xcc\xe2\xc7\x6d\xa2\x0b\x14\xa1\x12\xef\x25\xa5\x6a\x99\xc1\x70\xcb\x4a\xc7\xa6\x7b\xaa\xd4\xe9\x7a\x5d\xed\x59\x1c\x04\x05\xce\xf8\xd8\x78\x6e\xfa\xa7\x9c\x58\x41\x17\x34\x31\xb2\x71\xf0\xac\x45\x37\x73\x68\xc7\x66\x04\x6d\xd0\xa4\x65\x4e\x60\x42\xe2\x7e\x81\xcd\x10\x36\x14\xfb\xe6\x34\xb8\x39\x50\x75\x5d\xcf\xd2\xf1\xec\xbb\x3a\xf0\xdd\x6d\x14\x08\xdf\xd6\x36\xd3\xef\x54\x4b\xfe\x00\xae\x37\xa5\xc2\xc2\xdd\xfa\xa6\xfb\x8d\xe2\x95\x46\x28\x73\x5f\xf4\xf0\xa3\x1f\xa5\xb1\xbc\x49\x25\x6e\xe3\xf2\xdc\xb1\xa4\x2d\x2b\xd4\xbc\x09\x7e\x05\xc1\x41\x4f\xd9\xfc\x3b\x2e\x95\xfa\x24\xfc\xac\x26\x81\x66\x32\xf9\xbd\x6d\xfe\x75\x8a\xbd\x47\x4c\x3e\x8d\xab\xee\x7b\x49\xdb\xb4\xe7\xc5\xa9\x15\x85\xac\x5f\x36\x56\xf9\xa5\x52\xe5\x17\xbf\xca\x2f\x2d\x55\x56\x32\xb4\xa4\xbb\x16\xff\xb0\x2f\x2e\x99\x4c\x14\x2a\xeb\x5f\x59\xb9\x50\x98\xf5\x52\xfd\x0e\x35\xef\xa7\x93\x78\x7c\x9f\xf2\xc3\x71\x74\xca\x28\x2d\x89\x72\xae\x50\x56\x4d\x6f\xa8\x3f\x45\xa3\xd9\x7f\xc3\x52\xe9\x00\x37\xa1\x03\x9c\x91\xce\xd8\xc3\x6c\xc7\x7c\x71\xdb\x19\x2f\x65\x67\x52\xb2\x1f\x3a\x8a\xc2\x87\x1f\xd5\xfc\xc4\x72\x16\x74\xe8\x26\x95\x10\x00\xe6\x01\x84\x14\xb1\x09\x01\xdd\x99\x89\xe0\x8b\xce\xa4\xc2\x06\xe8\x18\x4a\xbf\xe3\x48\xfb\x0e\xd0\xf3\x1d\x20\xe2\x3b\x40\xb9\x77\x80\x5c\xef\x18\x53\x42\x9d\x4b\x83\x65\x5f\x5a\x01\x94\x4b\xc0\xb5\x15\xd1\xdc\xc9\x1c\x45\x6e\x82\x96\xe4\x86\x4f\xdd\x8b\xb9\xc3\xc8\xe7\x96\x80\xd6\xa1\x77\x4b\x93\x08\xdd\x9e\x1b\x5c\x1d\x08\xe0\x0e\x50\xbd\x1d\x45\xd9\x76\x0c\x79\xdb\xb1\x04\x6d\x47\x38\x62\xb6\x03\x16\xa0\x3a\x9a\x74\xed\x68\x72\xb5\x63\x28\xd4\x8e\xa1\x37\x3b\xda\x16\x54\xc7\x50\x9a\x1d\xe9\x88\xc9\x8e\x26\x1a\x3b\xb2\x24\x18\x75\x58\x0b\x49\x19\x8a\x50\x87\x8c\x9c\x91\x4f\xf2\x75\xae\x3c\x52\xae\x63\xe8\xb5\x8e\x26\xd2\xee\xa9\xb9\x74\xd2\x3f\xed\x49\xfe\x69\xb1\xb0\x2f\xa5\x5b\xd4\x10\x6a\x03\xf0\xc6\xc1\x59\xb0\x25\x87\x92\x28\xd4\x32\xd8\x92\x98\x91\x6f\x13\x5b\xac\xa4\xb1\xd8\x56\xb0\x49\xa2\x4d\x9e\x16\x38\x07\xc7\x7c\x0c\x2f\xc5\x89\x3c\x25\xcc\x9c\x81\x49\x95\xd8\xc9\xef\x49\xec\x90\xa5\xaa\x0d\x68\x8d\x56\xa2\xc1\x23\x12\x9c\xd4\x9c\xc2\xa9\xfb\x84\xa8\x1b\x94\xa1\x4a\xa1\x6f\x21\xd8\x94\x7c\xa6\xad\xc4\x42\x8d\x10\xa1\xe4\xb0\x9e\x0f\xce\x15\x34\xad\x1d\xb9\xea\x27\xc7\xd6\xd6\x3d\x3e\x60\x8d\x7c\xa8\x76\xca\x31\x86\x5a\x88\x8c\x4a\xc4\xbb\xa5\xac\xc7\x5c\x51\xaf\xd7\x15\x7a\x85\x92\xdb\x7a\xdf\xf7\x45\x72\x51\xe6\x36\xdc\xc7\x7a\x84\xf4\x6b\xb4\x3c\xc7\x6a\x8c\x7e\x65\xac\x44\x55\x3b\xe2\x78\x8c\x7e\x14\x87\x89\xff\xbd\xde\x29\x8f\xdf\x52\xe6\xb6\x7c\x96\x5a\x4c\x75\x92\x4a\xe6\x8a\xaa\xf8\xb7\x7a\xc5\x95\xf7\x93\xb8\x11\x5b\xbe\x91\x34\xd3\x5c\xa5\x5f\x1a\xbd\xad\x3c\x7f\xc4\x94\xfc\x5a\xcf\x61\x7c\x00\xc4\x94\x5c\xd4\x93\x80\x11\xa3\x52\x7e\x6e\xec\x2a\xbe\xb8\xf5\xd6\xd1\x5f\x67\x2d\x07\x1a\x53\xf2\x77\xc3\xd7\x17\x79\x6e\x69\x43\x49\xfc\xf7\x3e\xf7\xc6\xf7\x32\x92\x08\xcb\xa2\x18\xfe\xce\x49\xf3\xe0\x51\x72\xe3\x3e\xf0\xb5\xc2\x42\x2f\xa3\xee\x00\x15\x98\xf1\x5e\xbb\x32\x52\x14\x1c\xd1\x7c\xc1\xd9\x84\x0a\x2f\xa9\x73\x0c\xf2\x40\x7e\xcc\xc7\x64\xe1\x7f\x96\xfa\x25\x7e\xac\x06\x7d\x95\x8a\x80\x6a\xf6\x63\x3c\xe9\x5a\x2f\xda\x07\x90\xa0\x5c\x95\xf2\xde\x5d\x2a\x38\xf8\x81\xcd\x52\xd7\x05\xc2\x94\x9b\x94\xba\x56\x4f\xeb\x2c\x18\xcd\xab\x68\xd5\x18\x72\x3c\x11\xb8\x75\x98\xf1\xdf\x1c\x37\x46\x1a\xef\x73\xdc\x18\x6c\x9c\x08\xdc\x3e\xde\xf8\x1d\x37\xe0\x75\x2a\xc8\xc9\x29\x5e\x08\xb2\x3d\x18\x32\x7a\xdd\x39\xa6\xda\xc7\xc5\xb9\x28\x5d\x70\xbf\x61\x05\x9e\x79\xdf\xdd\x41\x81\xe7\x82\xbc\x61\xf8\xc2\xf0\xa5\xce\xcc\xff\x95\x20\x2d\xb2\xd9\xc7\xb7\xf3\x73\x9e\x85\xa1\xfe\x57\x17\x10\xbe\x15\xe4\x4a\x8c\xca\x88\x28\x00\xae\x61\xcf\x78\x4e\x0e\x50\xfc\xa8\x3f\xe8\xef\xe2\x9b\x0d\xf9\xc6\x49\x96\x99\x4c\x0f\xf1\xf5\x86\x4c\x06\x7d\xd3\xd9\x7e\xc4\x97\x1b\xb2\x2d\xc0\x27\xb0\xc9\xf6\x08\x1f\x6c\xc8\x36\x15\xc9\x85\xd7\xb7\xc7\x78\xef\x3e\x83\xd5\xaf\x67\x5c\xe0\x77\x82\x54\xfc\x5f\xe1\xf7\x82\xbc\x97\x51\xb7\x8f\xf0\x1b\x1d\x1a\x20\xfc\x1a\x10\xbe\x23\x51\x7f\xa4\x76\xb6\x63\x64\x81\xf0\x5f\x82\x1c\x89\x30\x7c\x29\xd7\xeb\x23\x81\x8f\x05\xf9\xcb\x89\x2a\x8c\xca\x60\xfc\x97\xc0\x1f\x05\x69\xc8\xcf\x2f\xa8\x00\xa7\x2a\x6c\x4c\x5b\x05\xe9\xbd\xf4\x1e\xe3\xd7\xf8\xa5\x75\x82\x3f\x7c\x29\xc8\x47\x31\x6a\x3e\x5c\xd7\x4a\x44\xa8\x68\x79\xdd\xf6\xf4\x8e\x60\x7f\x7d\xb2\xd5\xe2\xb7\xae\x81\x74\x1a\xf9\xac\x60\x30\xab\xdc\xd4\x29\x16\xf4\xef\x25\xcd\xe5\xab\x49\x46\xad\x02\xee\x7a\xdd\x92\x4f\x63\xbd\x7e\x36\x8d\x1a\xbc\x12\x78\xdf\x6c\xd7\xe7\xc0\x6a\xfb\xa2\x36\x3f\xfe\x1b\xc2\x87\x82\xf4\xf1\x57\x41\x76\x77\xf1\x67\xf5\x3b\x7c\x05\x83\x5e\x4d\x52\xa0\x52\xf8\x12\xdc\xc7\x2b\x9c\xed\xc8\x3a\x9c\x6f\x6a\x0b\x1d\x8a\xed\xc6\x9c\x58\xd1\x8b\xfe\x33\x3a\x02\xe2\x2e\xfe\xee\x3a\xcb\x29\x6c\x54\x06\x53\xfa\xbb\x28\x15\x26\xbd\x51\x3f\xa8\xf1\xde\xdb\xd5\x2d\x87\x86\xc7\x96\x4c\xb4\x90\x81\xe5\xdf\x47\xc1\x9c\xe6\x79\x72\x41\x03\xdc\xe0\x60\x68\x83\x26\x84\x10\xab\xca\x41\xb5\xf8\x2d\x21\xbf\x0b\xc8\xa2\xe7\x97\x92\x97\x22\x42\xb8\xff\x93\x1a\x84\x2e\xdb\x8d\xb6\x07\x5d\x42\xbe\x88\x30\xfc\x22\x9e\x11\x8a\x2a\x9e\xb1\xfe\x16\xeb\x75\xf4\x37\x68\x39\x9b\xc5\x76\x17\xe9\xa1\x50\xd0\xf2\x37\x81\x10\x1a\xbe\x12\xbd\x72\x0a\x49\xd7\xc8\x56\xd7\x62\x07\x43\xbd\xbc\x94\xec\x97\xeb\x6e\xa4\x91\x69\x18\xd2\xe8\x95\x40\x45\x81\xbb\x03\x0d\x0e\x7f\x13\xc4\x1f\x26\x6c\x8a\xa1\xc1\x8e\xb7\x0f\xc5\xd6\x67\x31\x94\xcf\x3e\x8b\x30\xfc\x2a\x9e\x7d\x16\xa3\xe8\xc9\x4f\xe0\x7b\x8a\x3c\x41\x6a\xbf\xc8\x67\x5f\xc5\xe8\xab\x88\x25\x8a\xbf\x0a\x22\xd5\x6e\xa2\x5b\x9f\x05\x7e\xae\x06\xf4\x1c\x06\x64\xa6\x79\xc1\x73\xf9\x46\x4f\x6c\xf4\xbb\xc0\xc1\x7f\x07\x08\x15\xc3\x4f\xa2\xa6\xd4\x64\x0f\x8f\x20\xb4\x74\xac\xd7\x30\x58\x2a\x41\xc1\x8f\x2f\x55\x57\xbe\x08\x98\xed\x2d\x17\x87\xf0\xbd\xa6\x13\xf7\x0b\x75\x12\xbd\x7d\xd7\x72\x48\x0a\x3d\xc5\x9f\x84\x55\xd4\x69\x39\x8b\xaa\x16\xcb\xaf\x6d\x9c\xc0\xa1\x2d\xdf\x22\x3d\x97\x53\x69\x56\xcd\xb7\x29\x49\xa3\xd5\xc6\x93\x61\x0a\x0e\x7e\xe8\x17\x05\x2a\x50\x75\x00\x0a\xc1\xcf\x68\x22\x6c\x9d\xd4\x00\x9e\x5f\x05\xf9\xe1\xff\x9c\xc4\xcf\xb7\xff\x38\x4b\xb6\xbf\xfe\xb9\xec\xf7\xf7\xfa\xdb\xea\x6f\xff\x11\xfc\x3e\x81\x8f\x43\xf8\x38\x84\x8f\x9d\xc3\xc3\x3f\x97\xfd\xdd\xc7\x90\x6d\xf7\xf1\x3e\xfc\x1e\x6e\xff\xb9\x1c\x1c\xaa\x94\x9d\x7e\x7f\x6f\x1b\xfe\xf6\xd5\x2f\x64\xdb\x19\x3c\x51\x29\x7b\x7d\xf8\x38\x3c\x38\xfc\x73\xb9\xdb\xef\x0f\xb6\xff\x5c\xee\x3f\x56\x65\x0e\x9f\x42\xca\xe1\xfe\x9e\xfa\xd8\x3f\x84\x8f\xc3\xc3\xfd\xd3\xff\x5b\x3b\xf6\xe7\x76\xaf\xbf\xfd\x54\x35\xfd\xe2\xb1\x6a\xa6\xaf\xdb\x7c\x04\xcd\xec\x1e\x42\x33\x0f\xfb\xa7\xff\xfd\xe0\x07\xfc\x33\xdc\x61\x2f\xe0\xf7\x83\x20\x2b\x30\x0a\x7f\xb7\xa7\x3d\x3c\x4f\xe4\x6c\x63\xae\xaa\x8b\x41\x9c\x5f\x5d\xdc\xe9\xee\xaf\xc0\x7f\xb8\xbb\xe5\x97\xb6\x9d\x16\x2c\xd9\x84\x4e\x53\x06\x6c\x55\x73\x84\xde\x1c\x3f\x5f\x2c\xc2\x10\xfe\x7a\xf4\x86\x8e\x3f\xb1\x3c\x99\xd2\xd7\x7c\x9c\x64\x87\xa6\x86\xf2\xea\x73\x66\xb1\xef\xcc\x1f\x35\xb7\x2a\x05\x1f\x48\x05\x2a\x62\x5a\x44\x0d\x83\x60\xda\xdf\x2d\x30\xf3\x3f\x1d\xbd\xea\x12\xf2\x41\xf4\xf2\xab\x0b\x90\x64\xb6\xf6\xd5\x53\xd6\xa1\xc8\xf7\x2a\x25\x87\xce\xe7\xea\x1f\x82\xfc\x21\xd6\xeb\x3b\x45\xa4\xf0\x1f\xa2\xea\x92\xea\xea\xe2\xa7\x60\x4b\x6e\x05\xcf\x7e\x80\x20\x96\xe4\x0f\xe1\x3b\x36\xac\x78\x39\x44\x77\x38\xab\x82\xe7\xbf\xa1\xac\x65\x4f\x16\x8a\xcc\xd7\xd9\xfd\x34\x50\xef\x7e\x20\x3c\x91\x35\x47\x3f\xed\xf1\x25\x03\x55\x84\x73\x30\xf4\xf3\x6a\x9e\x5c\x28\x4a\x35\xa7\xf5\xc8\x63\x75\x77\xd5\xe2\x3e\xa7\x13\xad\xff\x70\xce\x6f\x0e\x33\x7a\xe3\x05\x7f\x16\x7c\xb9\x30\xdf\xef\xc4\x24\x65\x49\xe6\xa2\xc6\x3c\x5b\xce\xcb\x96\xf5\x67\xae\x82\x53\x53\xc9\x54\xd7\x70\x6d\xc3\xd6\x32\xa5\xfd\x3e\x9e\x89\x94\x5d\xda\xaf\xb7\xf4\x22\xf1\x53\xb5\xe9\x85\x6e\x1f\x5f\x88\x74\x72\xa4\x6b\x31\xc1\x03\x36\xf1\xbe\xc0\xb2\x9e\xf7\x09\x4f\xfd\xe6\x7b\x0f\x7a\x55\xfd\xf2\x4a\xeb\x08\xbf\x02\x13\x63\xeb\x98\x72\x26\x3f\x83\x19\x1d\xf5\x95\xa5\x8c\xee\x65\xc9\x7c\x61\x3f\x5e\xba\x24\x63\x7f\x08\x82\xb6\xe3\x5c\x2c\x66\x89\x9e\x12\x99\x9c\x1f\xa7\x5f\x61\x6c\xd7\xe9\x84\x5f\x43\xe4\x57\x6d\xb4\x4f\x85\x38\x9f\x43\x73\x69\x96\xbd\x2b\x6b\x02\xab\x57\xde\x77\x2e\xf9\xa2\xf2\x29\xf8\x25\xdd\xb7\xd6\x94\xaa\x51\xda\x9e\x52\x19\xf7\xc6\x99\x4c\x2a\xe3\x1a\x75\xd9\xad\x50\x60\x9a\x91\x93\xe0\x33\x3d\xbf\x04\x63\xec\xf3\x3c\xc0\xc1\x1b\xfe\x35\xc0\xc1\xbb\xe0\x74\x68\x50\xed\x4b\x7a\x9b\x47\x0f\x44\x3b\x7f\x8c\x66\xcd\x68\xf0\xcd\x2c\xb7\x68\x6f\x3c\x4b\xc4\x73\x19\xf5\x51\x93\x77\xb6\x3c\xd7\x56\xc9\xa3\x81\xda\xec\x27\xf2\x94\x3c\x10\x27\xf4\x54\xdd\x56\x1a\xab\xd0\x2e\xaf\xe6\x94\x2d\x53\x49\xe7\xd0\xd9\x55\x22\x68\x02\xdb\x34\xc9\xf5\xee\x16\x66\x4b\x6a\x45\x9f\x73\x6d\xb5\x77\x06\xb1\xe9\xfc\x42\x9b\xf0\x5d\x2c\x61\x2a\x2e\xe9\xed\x85\x36\x09\x9c\x99\xdd\x38\xa7\x32\xd1\x5a\x40\x22\x81\x65\xd1\x58\x1b\xac\xa3\x48\xc6\x90\xe7\x1a\x9a\x28\x10\x66\x99\x82\x39\x0a\x26\x63\x9e\xd5\x14\xc6\xa3\x20\x40\x58\x64\xed\xe2\x88\xff\x54\x76\xef\xff\x03\xa1\xb0\x6f\x48\x6d\xfd\x53\x31\xad\xff\x29\xf1\xa8\xfb\xca\x42\xdd\x5f\xcc\x69\xb3\x34\xd3\xf7\x0a\x07\x15\x38\xcb\xea\xa4\x6b\xe5\x12\x8a\x13\x86\xab\x5e\x03\xe3\x31\xc3\x39\x95\xaf\xb4\xc7\x0d\xcf\x7c\x63\xce\xf0\x24\x9d\x4e\xbd\x98\x25\x33\x76\x4f\xbc\xb8\x89\xce\xa5\x6d\x51\xd3\x89\x97\x32\xad\xa6\x80\x65\x90\x05\xc3\xd7\x89\x60\x87\x5c\x7c\x62\x73\x6d\xc6\x1c\xe2\xbd\x8b\xba\xb0\x39\xf4\x4b\xf3\x44\x97\x4f\xce\x33\x37\x84\x7b\x65\xde\x58\xad\x7e\xcc\xb6\x59\xe9\xe4\xae\x5a\xeb\x79\x9b\x95\x1a\x55\xc2\x52\x63\x51\xbf\xc0\x55\x59\x73\x4d\xef\x1d\xda\xf5\x40\x3a\x8d\xbe\x1a\xbf\x0d\x92\x30\xe3\xd5\xdf\x79\x96\x61\x15\xcf\x32\xc6\x9f\x11\x23\x74\xc8\x8c\x22\x84\x5a\xbd\x21\x62\xa4\xf2\xad\x33\xb1\xde\xdf\x4b\x2a\x6e\x35\x43\x8a\x8b\xe7\x59\x66\x94\xd9\x4e\xc0\xa1\x7f\xb0\xf5\xcb\xf1\xbb\xb7\x3d\x0d\x1a\xd3\xe9\x6d\x04\x66\x43\xb7\xfe\xeb\xf4\x44\x6b\x10\xe8\x3e\x9c\xfe\x97\xea\x57\x7f\x28\x4b\x7f\xf7\xb2\xf4\xa8\x0a\x8e\x57\xd3\x69\x24\xac\xaa\x93\x22\xb7\xe1\x1d\x55\x05\xac\x4b\xb2\x77\x91\x40\xc3\x4c\x7b\x16\xef\x07\x08\x27\x34\x12\x08\x7f\x95\x91\xc0\x19\x2a\x8a\x62\xb3\xa3\x98\x76\x4f\x0b\xc6\xf5\x9e\xf3\xd3\x86\xda\x7c\xae\x81\x87\xb6\xa2\x28\xd0\xf0\xc8\x72\xfc\xee\xd2\x2b\x8d\x32\xeb\xfd\x4b\xeb\x79\xe0\xd4\xfc\x8f\x33\x72\x2c\x40\x41\xe1\x88\x73\xa9\xcb\x00\x3f\xbe\xc5\x54\x79\xe9\xa7\xa0\xe6\x67\xa2\xf3\x14\x18\xbf\x9d\xc1\x20\xa6\x24\xa2\xa4\xe1\xfa\x00\x8d\xaa\x68\x67\x4c\x19\x58\xa6\xc5\x41\xc3\xe9\xa1\x24\x4f\xc0\x6d\x36\xf5\x56\x3c\xa6\x98\x12\x59\xa9\x41\x3b\xc6\x02\xaf\xf0\x32\xb9\x78\xab\xb6\x15\x25\xc6\xc3\xb7\x73\x82\xa4\xad\x2c\x29\x4c\xb0\x7d\x60\xbe\xd7\x09\x53\x14\x3b\xbf\x01\xce\x50\x52\x9b\x12\x56\x81\x17\x82\xaa\x0e\x1e\x72\xb1\xc7\xe7\x0a\x47\xf0\xce\x4c\x92\x91\xcf\xdc\x18\xa4\x39\x60\x5a\x3b\x71\x1f\x64\x21\x56\xa5\x9e\xa2\xe3\xd8\x03\xb6\xad\x67\x78\xa5\xd5\x1d\x68\xaf\x9a\x05\x2b\x78\xea\x45\x1e\xb0\x49\xa1\xa9\x5c\x1a\x1b\xf7\x05\x86\x1a\xbe\xa0\xd2\x09\x71\x85\x61\x4b\xa4\xee\x0c\x0b\xc3\x7e\x57\x1d\x3f\x50\xd5\x05\x8c\x54\x21\x1a\xac\xa7\x4d\x3f\xc2\x29\x33\x26\x4c\x4c\xd4\x3b\x40\x8e\xb0\x20\xac\x07\xef\xa5\x90\x85\xd9\x2f\x9d\x3a\x94\xe2\x76\x55\x7a\x73\xc5\xc2\x05\x8b\x71\x62\xde\xc3\xa4\x27\xd1\xd6\xa1\x85\x71\x13\x85\x13\xb2\x3d\xc0\xa9\xfa\x19\x93\x3e\xce\x49\x1f\x2f\x09\xc5\x13\x9d\x59\x82\x03\x9f\xe1\x50\x83\x07\x60\x28\x0f\x97\xda\x9d\xa4\x1a\x05\x0f\xc3\xdd\x2e\x21\x4b\xcf\x8b\x46\x94\x90\x6c\x8b\x23\xac\x72\x09\x9d\x8b\xb5\xe4\x4a\x49\xb6\xc5\x10\xde\x25\x7e\x7c\x18\x46\xd9\x96\xf9\x06\x53\x3f\x06\x30\x58\xdf\xd8\x24\x9a\x92\x65\x85\x26\x41\x13\xb2\xc4\x4b\x32\x1d\xda\x6e\xa6\xd3\x68\xa9\xc0\x84\x96\x9c\xeb\x80\x64\xe3\x84\x80\x63\xb7\xad\xad\x31\x81\x2e\xab\x2e\x22\xac\x62\x85\x8a\xcd\x8d\x8f\xf2\x54\xc5\xfa\x0d\x31\x7a\x23\x8f\xd3\xf3\x2c\x65\x17\xc8\x48\xe2\x2d\xc9\x04\x4f\xc8\xd2\x3b\x20\xc5\x92\x4c\x0b\x49\xc0\xe5\x76\xb2\x5e\xc3\x7f\xaa\xad\x7f\x99\x2d\x95\xc0\x1e\x4a\x0d\x73\x45\xaf\x42\x21\xd5\x14\x9a\x0c\x7d\xc8\xd0\xaf\x64\x18\xa6\x19\x59\xc1\x02\xeb\x3b\x24\xa6\xd8\xed\xc1\x23\xb8\xaf\x65\x81\x5f\x53\xfd\xfe\x02\xcf\xd2\xcf\xa7\x92\xb6\x1c\x08\x7d\x14\x52\x75\x5e\xd5\x79\x00\x8f\x07\x5e\xbd\x98\x13\x6f\x77\x43\xcd\xe0\x36\x4f\x2f\xdb\x3b\x16\x35\xe4\x71\x0d\x5c\xc1\x4c\x1f\xa9\x7d\x78\x4c\x05\x4f\x7b\xbc\x97\xeb\x23\x43\x78\x8f\xb2\x09\xd6\x44\xba\x51\xf5\xa3\x44\x22\xdc\x72\xfe\x18\x62\xb5\x23\x47\x24\x66\x95\x03\xa7\xcd\xc9\xcd\x53\x05\x27\x0c\x60\xb6\x1b\xc3\x69\x11\xb7\x9c\x37\xb5\xe3\xdb\x8f\xa1\xbd\x62\xde\x46\xe8\xd4\xde\x3d\xb4\x6c\xc5\x8e\x43\x20\x6c\x15\xcb\x40\x7e\x96\xb2\xc9\x88\xc6\x5e\x36\x35\x48\x81\x70\x57\xf6\xe8\x8d\xa4\x6c\x12\x86\xf4\x27\x6d\x5c\x81\xab\x79\xc5\x94\x08\x84\x05\x79\x45\x23\x56\xfa\x37\x7e\x65\x24\x3f\xd5\xe5\x16\x86\x59\x18\x46\x03\x75\xa0\x3c\x78\xb0\x5e\x4b\x0f\x1a\x80\xbe\x32\x03\x75\x70\x59\x81\x08\x90\xa0\x29\x27\x95\xe4\x60\x03\x28\x44\xdb\x02\x1e\x8c\x80\x78\x9d\x1f\x59\x67\x70\x35\x5e\x02\xac\x7f\x84\x86\x49\x2f\xa7\x12\x16\x23\xd2\x6d\x63\xdb\x12\xc2\xd2\xda\x31\xcf\x32\xc8\x9e\x47\x08\xd3\x9f\xf8\x28\x92\xbd\x64\x32\xd1\x35\x24\x2a\x9b\x9e\x93\x48\xf7\x05\x97\x4d\xc7\x11\x54\x7f\xd0\x92\x86\x2b\x75\xa0\xa2\xd0\xd6\xa4\x4e\x4e\x31\x25\x0c\x1c\x6c\xf8\xb8\x49\xd5\x99\x7b\x18\x4a\xed\x6e\x6f\x45\xad\xd2\x2d\xce\xe8\x14\x40\xba\x96\xe5\xa3\x53\xa9\xd0\x5a\x17\xf1\x91\x2f\x0a\xcd\xd6\x78\xcf\x22\xa6\x8e\x46\x7f\xc8\x4a\xa7\x79\x6c\x6b\x0b\x51\x22\x4f\xd8\x29\xa6\xf6\xe1\xca\xab\x8a\xa8\x4d\x38\x95\x8d\xb4\x8f\x7c\x41\x68\x4f\xf2\x45\x61\x2f\xfa\xd7\x34\x4a\x32\x84\xcd\xfd\x5f\x18\x0c\xb9\xe5\x92\x2b\xfd\xb1\x39\x2b\x83\x9e\x5b\x73\x0c\x16\x07\x89\xc0\xc6\xfc\x1f\xa6\x05\xd6\xec\x17\x83\x5d\x6b\xef\x46\x35\x53\x81\x55\x0e\x0d\x2a\xf0\x34\x65\x49\x96\x7e\xa5\x7e\x21\x41\x59\x8b\xa0\x85\xe7\xe4\x7b\x58\xda\x65\xb1\x58\xa6\x16\x64\xb0\x1e\xd7\x34\xca\xd9\x74\x5b\x55\xa2\x5d\xda\x17\xae\x73\xbf\xe0\xee\x21\x4a\xba\x83\xa2\x71\xb9\x1b\x8a\xe5\x8e\xb9\xa9\xb8\xa8\x2d\x70\x3e\xe3\xcb\x6c\x72\x4c\xc1\x7a\x89\xf1\x6e\xd3\x8a\x6f\x94\x5d\x52\xdb\xa7\x74\x45\xe9\xb1\xdb\xad\xeb\x9d\xf5\xba\x85\x17\xef\x25\xd6\xdf\xde\x64\x6f\x83\xa3\x1e\x8b\x66\x93\x3b\x72\xb4\xf4\x63\x43\x5e\xe3\x89\xd3\x8e\x79\x9f\x2e\x44\xca\x45\x2a\xd3\xaf\xf4\x78\x79\x2e\x05\xad\x5b\xbe\x34\xce\xb2\xba\xb2\xa7\x3d\x4b\x14\x1e\x91\xb6\x71\x1b\x7a\x9b\xd0\x78\x8e\x37\x1b\x90\xab\x8d\xc7\xf8\x75\xfc\x52\x38\x8f\x32\xf1\x6a\x0c\x37\xcf\x1b\xe0\xb0\x55\x58\x2b\x1a\x04\x45\xda\xf8\xd1\x3c\x95\x77\xac\xac\xde\xd8\x02\x4f\xaa\x4b\x0b\xd7\x5b\xfb\xb2\xaa\xfa\x3d\x57\x6e\x24\x08\x6c\x33\x2a\x7f\x6b\x53\xaa\x88\x43\x30\x08\xb3\x67\xe8\x7e\x87\xc7\xfb\xfe\xc8\x9d\x8b\xa8\x5a\xb1\x27\x75\x6f\x64\x25\xd0\xea\xa5\x40\xf3\xe9\x57\xfb\x48\x62\x8a\xe2\x66\x23\x7e\x9e\x96\xce\xd7\xaa\x60\xae\xc4\x2b\xe6\x7a\xb4\xa1\xf0\x77\xf4\x8c\xa9\x9e\xb5\x34\xe5\xf1\x87\x1b\xb3\xe5\xf3\x8e\x65\x35\xef\xa1\xe0\xf3\x7f\x32\x5f\xd5\x2a\xe3\x46\x1b\x05\x9e\x59\x3f\x55\xf1\x6a\x9c\x30\x43\x4b\xb7\xee\xea\xf2\x45\xa9\xe6\xf9\x4d\x56\xed\x87\xb8\xd4\xa6\xb1\x13\x67\xd6\xb5\x6c\x6a\xe3\x21\x72\x00\x47\x01\x1a\xb9\x5e\xef\x56\x5a\xad\x18\x88\xa5\x37\xb2\xe4\x2d\x18\x94\xb3\xb2\xcb\xc1\xda\xa2\x2a\x5d\xa2\xa4\x43\x1a\x86\x75\x27\x7b\xd5\x26\x86\xa8\x5e\xa4\x42\x9d\x81\x61\xec\xb2\xd9\xfa\x92\x96\x8d\xfa\xaf\x05\xff\x49\x9b\xb3\x3b\xd6\xc6\x9a\xe3\x2e\xa1\x0e\xc0\x9a\xcc\x5c\x76\x0c\x4f\x4b\x8f\x7b\x1c\xb9\xba\xee\x80\x60\xb5\x9a\x18\x5e\x58\x3a\x73\x92\x4e\xde\x72\xf9\x46\xd1\x45\x96\xf5\xe2\xb6\x66\x7b\x85\x68\xd5\x5a\xea\x5b\x99\x4d\x3e\x57\xf9\x3d\xf2\xde\x95\xe5\x30\x65\x1e\x0b\xea\x5e\xb5\x6e\x28\xf2\xad\x8e\x57\x8b\xdd\x3f\xe7\xc6\x7a\x0b\x9c\x8f\x67\x74\xb2\xcc\xe8\x3e\x9d\x52\x21\x3c\x7b\xef\x9f\x84\x31\x77\xdb\x48\x79\x2b\xf0\x32\xa7\xc7\xb7\x6c\x7c\xac\x0b\x6b\x2f\x74\x05\x1a\xfe\x42\xc6\x59\xaf\xea\xa8\x10\x9f\x79\x36\x41\x7b\xda\x57\x42\xed\xb5\xbd\xc5\x7f\x21\xc5\x9b\x3c\x01\x6a\xdf\x81\x6a\xbb\x54\x2a\x5e\x32\xf0\x57\x59\x7b\x87\x6e\xd4\xab\x0b\xdf\x59\xb5\x7d\xb0\xce\x33\x62\x98\xa8\xef\x41\x50\x29\xbe\x60\x0a\x47\x9b\xec\xbf\x7b\xd3\x30\x3e\xed\xbc\x0a\x55\x6d\x9e\xa4\xd3\xa8\x8a\x0b\x3b\x9f\x43\x56\xb6\xa1\x57\x35\xb4\x0d\xfc\x2a\xa0\xf3\x50\xe9\xb6\x51\x35\xfa\x92\x97\x46\xb1\x23\x89\x86\x2d\x02\x44\x76\x72\x47\x3c\x0a\x06\x4f\x9e\x04\x28\xe6\x51\xb0\x33\xd8\x0d\xb0\xff\xd2\x42\x51\x79\x48\x37\x1d\xcc\xb9\xe1\x44\x01\x4b\xad\x6f\x6e\x17\x36\xa1\x75\x43\xfd\xed\x05\x06\x50\xc0\x7a\x0c\x3d\xd3\x25\x0d\xfe\xf3\x8a\xc9\x0d\xb7\xb3\x02\x20\xb6\x3e\x3b\x97\xeb\x75\x49\xa9\xb6\x4d\x94\x76\xcd\xb7\xfb\x24\x40\xd8\xf9\xfd\xec\x0e\x00\x35\x31\xdb\xc1\x31\xfb\x9e\xcb\x4d\x06\xc3\xc1\x85\x2a\x70\x2a\x1f\xf6\x03\x84\xbb\x5d\xda\xb6\x35\xc2\x30\xfa\x86\x13\x4e\x3b\x07\x66\x22\xba\x03\xec\x4b\x53\xb4\xfa\xb4\xd4\x1a\x07\x05\xc2\xdd\xbe\x3f\x63\xf5\x4d\xe7\x12\xaa\x8d\xc7\x0f\xca\x94\x89\x39\xa2\x36\x69\x9c\xf5\x6a\x51\x78\x9a\x2d\xf3\x99\x3a\xb3\x2a\xd1\x7d\xe0\xef\xb7\xf9\x12\xaf\x3c\x81\xc6\x97\xcb\xf3\x98\x73\xec\xc5\x18\x91\xf4\xdb\xf8\x0f\x66\xa2\x8d\x2d\x65\x2e\xf2\x78\xcc\xb1\xb6\x18\xe3\x58\xb2\xa5\x99\xbf\xbf\x4c\xda\xfe\xbb\x37\x2e\xf2\xa3\xc2\x99\xd3\x32\xa1\x22\x22\x15\xff\xc6\x8b\x62\x38\xce\x0c\xaf\x57\xed\xad\x7d\x7a\xf5\x91\xf3\x2c\x8f\x56\xea\xd0\xc0\x26\x79\x71\xeb\x9f\x9d\xf8\x00\x9f\x2f\xc1\x74\xcc\xed\x82\xc6\x7d\x6c\x4c\xd4\xc6\xc1\xe0\x51\x6f\xa7\xd7\x0f\xcc\x46\xa7\xe2\x7d\x32\xbe\x4c\x2e\xb4\x1f\x47\x2d\x88\xb8\x3d\xe1\xf3\xc0\xbc\x1f\x2e\x1b\xcf\x2d\x96\x57\x9b\x67\x05\xc2\x93\x8c\x2c\xb3\x30\xcc\xb3\xf5\x7a\x99\x0d\x69\x8f\xde\x2c\xb8\x90\x39\x99\x64\x4e\x60\xb0\x0c\xc6\x93\xac\xc0\xf5\x83\x15\x2c\x73\x0a\x1e\x70\xc6\x32\x30\x6c\xc7\x6e\xe4\x09\x50\xb8\x53\x6f\xed\x9a\x74\x0d\x47\xc5\x32\x0e\x9a\x31\x55\xb1\x04\x84\x05\x59\x39\x91\xbf\x98\x63\x1d\xfe\xcc\xc5\x25\x15\x79\xdc\x26\xab\xa1\xd3\x4c\xc6\xca\x4a\xe4\x31\x0f\xc3\x6e\xd4\xdd\x20\xcb\x16\x86\x2e\x45\xca\xc4\x18\xcf\x41\xa6\xa2\xdf\x52\x7a\xad\x66\x07\xaa\xb0\xf9\xb4\x41\x32\x9c\xe6\xaf\x98\x6e\x35\xee\xf2\xc2\x9b\x48\x71\xaf\x19\xd3\xae\x5f\x05\x59\x65\xd0\x91\x8d\x78\x48\xa3\xc3\xa3\xa8\x19\x17\x69\xe8\x82\xf0\x4a\x63\xba\x71\xe5\x78\xeb\xb8\xd6\x02\x45\x01\xb4\x44\x39\x72\xa8\xbd\xfc\x8c\xb4\x7a\x07\x66\x1b\xaa\x9e\xd0\x96\xac\xaa\x52\x63\xd9\xd4\x39\x48\xfc\x17\x86\xd7\xff\xde\xe1\xf5\xa1\x27\xb6\x0c\x07\x4f\x28\xea\xfc\x53\xd1\x62\xa7\xbe\xf8\x9e\x25\x74\x76\xc5\xb9\xb9\x60\xdd\x5d\xa0\x70\xdf\xf5\x3a\x6a\xdb\xa2\x76\xaf\x8f\x9c\x86\xbd\x2e\x85\xaa\x6a\x25\x52\xdc\x7a\xb3\xe3\x7b\xd9\x5b\xaf\x69\xef\x9c\x4f\x6e\x0d\x77\xde\x77\xc1\x0d\xd1\x45\x39\x00\xfe\x1d\x03\xf0\x5f\x55\xe0\x29\xa7\xdf\x85\xfb\xad\xaf\x59\xf6\x83\x1f\x28\x21\x83\x1f\x64\x4c\xf5\xab\x9a\xac\x7a\xf9\x16\x4e\x52\x49\x57\x65\xc6\xd2\x05\xc9\x5d\xcb\xec\x28\xcd\xdc\xeb\x77\x20\xc3\x44\xa9\xa7\x4a\x97\x6a\x51\x0c\x23\x68\xe9\x3c\xb4\x18\x34\x01\x8b\x4a\x84\xd4\x8f\x24\x86\x07\x07\x2c\x4e\xc3\xea\x75\xb5\x54\xcd\x10\x30\xdf\x0c\x81\x36\x3d\x30\x4e\xb2\x4c\x6d\x9b\x93\xe4\x14\xad\xd7\x5d\x1e\xd1\x13\x15\x3e\xc5\x52\xff\xa3\xb2\x2e\x3b\x42\xf3\x1a\x62\x7a\x52\xa2\x7b\x55\x2b\x06\xff\x70\x5b\x79\x2c\x18\xf0\x9d\xde\x95\x08\x18\xe2\xb0\x26\x5d\x11\x81\x75\x02\x11\x49\x34\x82\xcc\x1e\xf9\x8b\xb4\x21\xa1\x24\x65\xb9\xd3\x9c\x37\xdf\x8a\x1a\x56\x48\xc4\x98\xcf\x55\x7e\x6b\xc9\xe1\xbd\xb1\x4b\xa4\x80\x5c\x34\x78\x14\x6e\xcc\x10\x49\x84\x8c\xb1\x74\x41\x58\xf4\xe3\x2e\x1a\xfe\xb3\x3d\xe7\x76\x9c\x1e\xc8\xae\x8f\x87\x96\xd5\x3f\xfc\xe7\xd5\x1b\x14\x76\x54\x73\x5b\xb9\x5e\xd3\xd8\x1e\x3f\xcc\x88\xac\x9a\xf3\x33\xf6\xfb\x2a\xd3\x1e\xb5\x7a\xcb\x57\xf3\x3c\xa2\x9d\xd4\xdc\xdc\x2e\x2e\x6e\xb0\xf7\x68\x8b\x6c\xae\x4f\x09\x37\x38\x78\x25\x17\x01\xa1\x7f\x78\xa2\xd1\x4a\x01\x91\x92\x89\xe6\x5e\xf3\xfe\x29\x88\x28\x01\x44\x18\xd2\xde\xd9\x19\xcd\xb5\xaf\xa3\x11\x8d\x1d\x72\x41\x8b\x36\xa0\xd0\x8d\x2a\xb3\x24\x11\x02\x5f\x54\x1d\x46\xaf\x3b\x6a\x02\xb4\x9b\xab\x60\x2f\x61\x8c\xcb\x8e\x3a\x84\x9d\xa4\x03\xce\x55\x3b\x49\xde\x49\x3a\x6e\xf2\x3d\x7f\xec\x59\x59\x3b\xf5\xaa\x3b\x52\x48\x25\x65\x63\x5b\xa7\xa2\xa4\x3a\xb3\x24\x67\xff\x25\x3b\xe7\x94\xb2\x4e\xaa\x99\xd7\x69\x4e\x27\x9d\xed\x4e\xbe\x5c\x50\x11\xa1\x4a\x8e\xb1\x16\x8b\xb1\xb2\xf4\x5d\xd9\x06\xa4\x7c\x0d\x89\x32\x76\x44\x63\x0f\x2a\x26\xae\x8b\x6d\x79\x4b\xce\x6e\xfb\x6c\x1c\xab\xae\x75\xe8\x0d\xe8\x8c\xab\xea\xe6\xcb\x5c\x76\x68\x2a\x67\x54\x74\xce\x29\xdc\x13\x1d\x2e\xbc\xe9\xc1\x1d\x35\x7d\xc1\x96\x6d\x41\x1d\x9c\xd2\x67\x85\x81\x50\x1a\xc7\x8a\x24\x3c\x7a\x94\xbe\x5b\x3c\x1f\x16\xd6\x2d\x2d\xc5\x94\x2d\xe7\x54\x80\x33\x99\xee\x00\x5f\x0b\xe3\xbe\x18\x64\xce\xd8\x34\xbd\x58\x9a\x34\xd0\x48\x97\x61\x18\x99\x36\x72\xaa\x70\x6a\x5d\xf5\xbb\xe9\xa8\x35\x16\xe6\x26\x56\xfb\x08\x7a\x71\x76\x46\x24\x2a\x4c\x4e\x7d\x6b\x3a\x0b\x30\x12\x07\xe5\x6e\x0b\xb0\xe9\x1e\x90\xf3\xda\x78\x8c\x77\x83\xbb\xc9\xa7\xc6\xf9\x87\x01\xf9\x2d\x2f\x34\x2b\x8d\x83\xc9\x13\x76\x3a\xe4\xbd\x72\xac\xc4\xff\x58\xaf\xbb\x03\xcc\x7b\xfe\x78\x49\xb7\x8f\x8d\xc5\xdc\x94\x75\x78\x18\x46\xbc\x67\xe7\x06\x5c\xb4\xb4\x8f\x82\x62\xae\x2e\x2a\xcc\x51\x61\x1f\x2d\xaa\x62\xc3\xee\x7c\xb1\x30\xa4\x91\xbf\x3a\x0c\x61\x0e\x71\x60\xc9\xbc\x28\x22\x84\xc7\x0a\x77\x44\x38\x27\x3c\x1a\x23\xbc\x24\x2c\xea\x23\x3c\x21\x3c\x5a\x22\x3c\x55\x69\x3f\x22\xbc\x20\x3c\x9a\x22\x7c\xae\xa0\xe8\x63\x84\x67\x84\x47\xe7\x08\xcf\x09\x8b\x1e\x23\x7c\x41\x78\x34\x47\xf8\x8c\xb0\xe8\x09\xc2\x57\x84\x47\x67\x08\xdf\x56\xb8\x13\x6e\x2e\x65\xe4\x81\x6a\x70\x66\x27\x11\xce\x74\x28\x92\xe5\x12\xae\xd7\x66\xe8\x17\xd5\xa5\x96\x08\xb5\x7b\xc2\x73\x52\x1f\x09\x70\xb4\x71\x1a\x49\x7c\x02\x46\x31\x02\x4d\xe6\x04\xb8\xe6\x22\xd9\x73\x55\x67\x60\x4e\x9b\x30\x33\x5e\x95\x5e\x9a\x2f\x5c\x46\x58\x21\xad\x56\x98\xa7\x57\xb4\xc0\x1b\xeb\x80\x9c\x77\xd5\x72\x57\x59\x85\x86\x05\x9a\xca\xde\x9c\x49\x7c\x33\xc7\xcc\xe4\x08\x54\xfb\x01\xba\x4f\xce\xe7\x76\x62\xef\x97\x1d\x9e\x69\xe9\xa4\xf3\x5c\xde\x2f\x3f\x38\xe4\xde\x4e\xd9\x77\x17\x08\x90\xf1\x5b\xb4\x10\x7c\x91\xf7\xae\x81\x60\xea\xcd\x93\x45\xd4\xa6\xed\x71\xd7\x9c\xc1\xde\xa0\xa6\x86\xb3\x74\x72\xd7\x3a\x4c\x4c\x37\x68\xef\x2f\x7e\x7e\xc6\xd4\x5d\x7a\xaf\xdc\x89\xb8\xc8\xcf\xfe\xca\x39\xbb\x4f\xf6\x4d\x39\x16\x36\x1e\xaf\x64\x1e\x53\x2d\x17\x40\x27\x67\x89\x2c\xd0\xbf\x59\x2f\x98\x2b\x4f\xd9\xbd\xeb\x75\x25\x10\x2a\x00\x8d\x2b\x4e\x15\x48\x89\xca\x92\x8e\xb5\x81\x86\xb7\xb0\x60\xa0\x5e\x4f\x56\x7a\xce\xe3\x89\xcb\x08\xd2\xd8\xef\xa6\x51\x19\xa3\x6f\x49\xd4\x4b\x9d\x2b\x6b\xcd\xa8\xbc\xd9\x0c\x56\xb4\xbf\x28\x86\x39\x4e\x86\x0e\xb6\x38\x44\x3d\x25\x0e\x56\x18\xc8\x8d\xc7\x5a\x59\x32\x4a\x15\xf0\xeb\x0f\xf3\x67\xe9\x30\xdf\xda\x42\xe3\x93\xfc\xb4\xcc\x7c\x92\x9f\xda\xd7\x03\x46\x38\xb1\xa0\x0a\xec\xac\xdf\x07\x58\x29\x14\xc0\x40\x2c\x8a\x4f\x54\xe1\x53\x75\x05\x8c\x13\x19\x8d\x11\x42\x18\xe4\x3c\x24\x25\xab\xf3\x65\x7e\x6b\xe8\xff\x93\x53\xac\xa7\xe8\x3d\xe7\x59\x7c\x72\x5a\xe0\x84\x30\x9c\x45\x1c\x27\x77\x80\xb9\xb1\x9d\xed\xcf\x69\x96\xc1\x1b\x68\x0b\xc8\x2b\x5d\x8d\x0d\xbd\x63\x54\xb6\xfd\xe9\xe8\x75\x18\x4e\x29\xd0\x83\x1b\xd2\x51\x4f\xce\x28\x6b\x3b\x6f\xb4\xa7\xf6\x7a\x84\x8a\x7a\x16\x89\x56\xd2\xf8\x73\x07\xe1\xd1\xc8\x1f\xad\x04\xb6\x60\xe3\x50\xab\xb1\xb7\xf7\xa6\x92\xfe\xcf\x7a\xa3\xef\xf1\x93\xd3\xa1\x6c\xc0\x8e\x20\xe8\x12\xda\x9b\xf1\x5c\xa1\x55\x5a\x98\x83\xaa\x0e\xfa\xbd\xf7\x56\x87\x81\x56\x58\xa1\xc1\x49\x70\x41\xe5\x8b\x65\x7e\xab\x52\xf4\xe8\x1a\x4b\xe0\x68\x89\x13\xb7\xaf\x60\x68\xb0\x0b\xbc\x89\xae\xf6\x0b\xde\x5c\x1d\xa8\xca\x7b\x29\x1b\x67\xcb\x09\xcd\x23\x56\xc6\x22\x27\x7c\xc2\x60\x42\x5d\xa7\x36\xde\x7f\xde\x66\xf8\x16\xc4\xcc\xb5\xdc\xd2\xb7\xae\x9a\x19\x4d\xa0\x29\x0d\xb4\xdf\x6b\xd7\x9c\xf4\xce\x4b\x64\x61\x72\x7b\xb3\x50\x4e\xaf\x3d\xaa\x81\x61\xc8\x75\x8c\xb7\xcf\x88\xe6\xa8\xd7\x09\x70\xfb\xd4\xb9\x42\x9a\xdd\xd1\xd1\xf5\x45\x39\xea\xf0\xa5\xec\xf0\xa9\x2d\xa8\xe3\x41\xdc\x09\x07\xbd\x00\x6d\xe8\x43\x65\x25\xdc\xe6\x01\xdb\x05\xd5\xc5\x8e\x4a\xd7\x38\xdf\xc0\x29\xd4\xba\x48\xbb\x74\x0b\xce\xb3\xb3\x74\x82\x4b\x14\x21\xea\xe3\x2b\x5b\x01\x8a\x4a\x84\x61\x91\x30\x9a\xe1\xda\xb7\x61\x3e\xa1\x3b\x2e\xb0\xff\x57\x10\x19\x7b\xf5\x48\x38\x9f\x38\x88\xd5\x4a\xf6\x16\xe9\xe4\x3e\xf7\x95\x43\x52\x82\x7b\x5e\x89\xf2\xbb\xaf\xda\xe0\x75\x92\xcb\xce\x4b\x9a\x08\x79\x4e\x13\xf9\x1d\x2d\xcd\x6c\x99\xfb\xb7\xb5\xc7\x99\x36\x96\x30\xbe\x85\x69\x18\x97\xdf\x77\x56\x70\xaf\x69\x5e\x8d\x79\x06\x5a\x5e\xc1\xc3\xa0\xc0\xc1\x31\x15\x57\x29\x78\x4c\xdf\x3c\xa4\x59\x39\x24\xd0\x33\x92\x0e\x59\xca\x0b\x04\xe7\xeb\x5f\xee\x15\xbb\xe3\xa0\x27\x6c\x02\x93\xe2\xc1\x4c\x9d\x79\xdb\x2b\x95\x4e\x32\xfa\xef\x77\xeb\xde\xa7\xf0\x5f\x3e\xe5\xb7\xd8\x22\x56\x0c\xf0\x31\xc0\xc9\x50\x79\x37\x79\xd0\x2e\xc0\x15\xef\x22\xf6\x26\xe8\xb7\xdc\x48\x77\xc0\x41\xba\x45\x5a\x26\x58\x5d\x95\x77\xa2\x82\x37\x3e\x2a\x58\xc1\x2c\x3c\x8c\x50\x73\xb1\x70\xe5\xaa\x6f\x24\x17\xd8\x31\xdc\xc8\xcd\xff\xb2\x9f\xfe\x97\xfd\xf4\xbf\xec\xa7\xff\xf7\xd8\x4f\x3f\x3e\xf1\xd8\x4f\xff\xf7\xf3\x94\xbe\x03\xa7\x5e\x66\x95\xeb\xc0\xa1\x04\xbd\x65\x56\xf8\xf4\x90\xba\x4d\x6b\xc0\xd7\x7b\xf2\x64\xcf\x76\x47\x1b\x9b\xc8\x52\x83\x6c\x32\xdc\xd6\x50\x96\x16\x6a\xef\xee\x12\xc2\xfe\xa3\x3a\xa8\xdf\x53\x73\xcf\xee\xe2\xa0\x33\xe7\x82\x06\xee\xf1\xf6\x1b\x2c\x82\x73\xff\x5e\x00\x0c\xe2\x2e\x06\x81\xbe\x01\x2a\x0c\x02\xef\x36\x38\x6f\xde\x06\xf6\x99\xfc\xc7\xa7\x68\xd8\x78\x24\x81\xbd\x4f\x4e\x4e\x68\x2f\xc5\x1c\x07\xc1\xe9\xa9\x55\xd0\x58\xcd\xe6\xa0\xb7\x3c\x14\xda\x6d\x0a\xa8\x00\x1a\x5b\x42\x2c\xda\xed\xa3\x88\x63\x81\x86\xbc\x07\x06\x65\x73\xf0\xb4\xed\x9e\x44\x4c\x24\x6a\xf6\x46\x92\x32\x1b\x8b\x76\x9e\x22\xf3\xe4\x8b\xb0\xa1\xe1\xa0\x2b\xff\xd5\x3b\xdb\xd9\xd9\x4d\x0f\xb6\x97\x59\x67\xf5\x27\xeb\x74\x26\x69\xbe\xc8\x92\xdb\xb8\x93\xb2\x2c\x65\x74\xa8\xe2\xb2\x34\x97\xdb\xe0\x94\x26\xee\x30\x6e\x22\x17\xc9\x64\x92\xfe\xff\xd8\x7b\x13\xf5\xb6\x71\xa4\x5d\xf8\x56\x14\xce\xdf\x6e\xa9\x43\x28\xa2\x36\x6b\x19\xa5\x4f\xbc\x24\x76\x62\xc7\x6b\xec\x38\xcb\xa7\x8f\x22\x21\x89\x31\x45\xca\x24\x65\x79\x89\xee\xfd\x7f\xb0\x90\x04\x40\x80\x92\xec\xf4\xcc\x9c\x39\x3d\x99\x4e\xc4\xc2\x8b\x17\x40\xa1\x00\x14\x00\x12\xf0\x46\x9d\x42\xa5\xfb\xcd\x5b\x7c\xf3\xbe\x79\xe5\xbe\x51\x9f\xd7\xe6\xc0\x75\x54\x54\x02\xac\x63\x0e\x23\x18\x10\x30\x3d\xc9\x1c\xf9\xdb\x05\x4d\x02\x75\xcd\x30\x02\xf8\xa5\xf4\x0e\xb9\x31\x53\x8c\x86\xfd\xb1\xdc\x78\xd2\xd4\x68\x5a\xbf\xa3\x2a\x41\xaa\x21\xfa\xec\x3d\xce\xdc\x8e\x96\xa8\x46\xd3\x5d\xa7\xa3\x25\xa4\xda\x82\x57\x37\x9a\x3a\xc7\xaa\xce\x4e\xc3\x65\x3b\xeb\xf1\x51\x43\xf4\x05\x8d\xf8\xe0\x61\x7c\xd0\x23\x3b\xc6\xd1\xf1\x6d\xe8\xdc\x7d\x0a\xdc\x10\x1f\x1a\xe5\x04\x30\x2c\x08\xf1\x34\xbc\x9d\xfc\x82\x79\xcd\x3f\xdd\xbf\xe6\x5f\x92\xf3\x7a\xb4\xb3\xb4\x7c\xf7\xa5\xf6\xea\x95\xf6\x92\xce\x74\xfc\x9e\xf7\x12\x39\x82\xd1\x18\xf9\xd3\xe5\x00\x4e\x5d\xd3\x82\xc5\x57\xdf\x5e\x7d\xfd\x9f\x6f\xaf\xf0\x91\x28\xda\xab\x64\xa0\x2f\x30\x88\x59\xe0\x7e\x0b\xff\xf8\x56\x2c\x16\xff\xec\x7c\xfd\x9f\x52\xf1\xfb\xcf\x6f\xc9\xcf\x97\x3f\xbf\x15\xf1\x8f\x3f\xbe\x95\x4a\xf8\xbf\xd2\xb7\xd2\xab\x91\x23\xa8\x8f\x5e\xcb\x58\x8e\x02\x67\x52\x2c\xa5\xd4\xff\xa3\x15\xcb\x7f\x94\xb4\xff\xef\x95\x2e\x7d\xc1\x39\x5a\xb0\xd8\xdf\x11\xf6\xf7\x1c\x2c\xd2\xd1\xab\xff\x29\xfe\xe3\x27\xfe\x2c\xfe\x27\x3e\x7c\xe5\xdb\xab\x6f\xaf\xf0\xaf\x90\xfc\x1c\x3a\x2e\xc4\xbf\xbe\xbd\x2a\xbd\x72\xca\x11\x0c\xa3\x62\x50\xe2\x75\xe8\x26\xb7\xd3\xf6\x2a\xf8\x4a\x43\xc7\xb3\xe1\xdd\xd1\xb0\x88\xf4\x59\xfa\x33\xe8\x88\x52\xad\xf4\xa7\xf7\x32\xe8\xf8\x2f\x03\x26\xbb\xdf\xca\xdf\x5e\xbd\xd2\x35\xad\xa4\x6b\xb3\xc0\x2d\x66\xbe\x26\x76\x4b\x2f\xb5\x92\x86\x57\x7e\x9e\xd8\xb4\x5f\xfd\xf1\xe2\x9b\x57\xf8\xa3\xb0\xe5\xfb\x51\x18\x05\xe6\xb4\x70\x5b\x2b\xd7\xca\x8d\x42\x91\x9e\x3b\x33\x82\xd1\x20\x0e\x2b\x5b\xfe\xa4\x84\xe1\xdb\xfe\xf4\x3e\x70\x46\xe3\xa8\x50\xad\x18\x06\xa8\x56\x8c\x66\xe1\x7c\xee\x44\x11\x0c\xf4\xc2\xbe\x67\x95\x31\xea\xc0\xb1\xa0\x87\x1c\x43\x64\xdd\x41\xe1\x70\xff\x9c\xd0\x86\x88\xd7\x89\xc6\xb3\x01\x62\x7c\x15\xcd\x07\xe1\xab\x24\x91\x57\x03\xd7\x1f\xbc\x9a\x98\x61\x04\x83\x57\x07\xfb\xdb\xbb\x1f\xcf\x76\x71\xa2\xaf\x50\xab\x8d\xf3\xfb\x0e\x7a\x10\xbf\x78\x5c\x98\x85\x68\x42\x18\x8d\x21\x53\x86\xed\x59\x18\xf9\x13\xe7\x01\x06\xca\x72\xbc\xb2\x62\xcc\xab\x3f\x1d\xbb\x67\x9b\x6d\x58\x87\xd5\x46\xab\x5a\x6b\x55\x37\x1b\x66\xcb\x6a\x42\xab\x6d\x57\x6b\x83\xc1\xa0\x0a\xdb\xd5\xb8\xd8\xc8\x6d\x29\x84\xe6\x2d\xb4\x0b\x91\x5f\x20\x6e\x0c\x5e\xe1\xc3\x73\xbd\xb4\x70\x61\x54\x66\x4a\xb8\x8c\x1d\x17\x4f\x51\x15\xcd\xb5\xab\xa2\xf1\xd7\x57\xc5\xab\x3f\x5e\x14\x3c\x3f\x98\xe0\xcf\xa7\xca\x56\x18\xa2\xbc\x56\xca\xb5\xc2\x4f\x4c\x4d\x53\x2b\xfc\x2c\x30\xdc\x1e\xb4\x7c\xd7\x0c\x5f\xf1\xf1\xfe\x78\x35\x8e\x26\xee\xe3\xd0\xf7\x22\x30\x34\x27\x8e\x7b\xdf\x09\x4d\x2f\x04\x21\x0c\x9c\x61\x17\x4c\x42\x10\xc1\xbb\x08\x84\xce\x03\x04\xa6\xfd\x63\x16\x46\x1d\xa3\x52\xf9\xad\x0b\xe6\xf8\x90\x13\x79\xe8\x62\xe0\xdb\xf7\x8f\x13\x33\x18\x39\x5e\xa7\xb2\x30\x83\xc8\xb1\x5c\xa8\x9b\xa1\x63\x43\x7a\xe3\x67\xa8\x0f\x9d\x91\x65\x4e\xb1\xa3\x8f\x7d\x51\xa8\x0f\x7d\x1f\x69\x8d\x2c\x1f\xea\xe3\x51\xe0\xcf\xa6\xfa\xc4\x74\x3c\x7d\x02\xbd\x99\xee\x99\xb7\x3a\x5d\x81\xd4\xc3\xd9\x64\x62\x06\xf7\x8f\xf1\xf8\x35\x70\x7d\xeb\x7a\x61\xce\x6c\x07\xbf\xd7\x77\x6b\x86\x7a\x7c\xce\x85\x7e\xeb\xd8\xd0\x4f\x90\x64\xa0\x03\x38\x42\xf7\x16\xa2\xac\x99\x2e\x30\x5d\x67\xe4\x75\x06\x66\x08\x51\x28\x21\xea\x78\x7e\x54\xfc\x4a\x2f\x49\x0d\xbf\x97\x12\x0a\x3c\xbe\x8e\xc9\x19\x36\x95\xc5\x57\xf2\x25\xd3\x77\x3d\x82\x93\xa9\x6b\x46\x90\xc3\x2d\xcc\xc7\x81\x69\x5d\xa3\xb2\x78\x36\x20\xb7\x2d\x63\x17\x82\xbc\x5a\xb3\x30\x3b\x64\xd5\x42\x37\x3b\x63\xff\x16\x06\x8f\xfe\x2c\x42\x59\x40\x6a\x1b\x0c\x82\xaf\x91\x13\xb9\xf0\xfb\x23\x39\x77\x08\x0c\xfc\x28\xf2\x27\x1d\x63\x7a\x57\xb0\xfd\x28\x82\xf6\x62\x80\x8f\xa0\xf1\x46\xa4\x06\xe7\x24\x53\x03\xdf\xb5\x17\xf6\xd0\x23\x42\xe2\x15\x38\x91\xe9\x3a\xd6\x62\x6c\x50\xa1\xf3\x00\x3b\x55\x38\xe9\xc6\xb5\x54\x6e\x6e\xc2\x49\xa1\xb2\x98\x98\xc1\x35\x93\xe5\xce\x3f\x86\xc3\x4a\x97\xe4\xfb\x1f\x95\x4a\x65\x11\x4e\x4c\xd7\x65\x38\x5a\x95\xdf\x16\xe1\x6c\xa0\x87\xb3\x29\x23\xdd\x6c\xfc\xd6\xc5\x7a\x8e\xd5\xd4\x8d\xaf\x29\xeb\xe0\x6b\x33\x9c\x5b\xa8\x54\x3e\x62\x8a\xfc\x69\x07\x54\xca\x0d\x38\x41\xe4\x8f\xb4\xdc\xa0\x52\xae\x22\x91\x33\x19\x51\x8d\x74\x2a\x8b\xf0\x76\x84\x6b\xaa\x13\xf8\x7e\x54\x7a\x44\x4a\x1c\xba\xfe\xbc\x43\x3f\x30\x23\xb6\x15\x1b\xa3\x01\x27\x85\x7a\x65\x7a\xb7\x18\x07\x8f\xb1\x09\x0f\xfc\x3b\x94\x69\xe4\x28\x51\x9f\x03\x89\xba\x60\xe2\x3f\xa8\xc2\x14\xe2\xc4\x26\xa6\x01\x4c\x33\x62\xce\x22\x7f\x61\xf9\x36\xd4\xaf\x07\xb6\x3e\x0d\xa0\x1e\x9a\x93\x29\xd7\xe4\x26\xbe\xe7\xe3\x43\x0d\xf4\x42\xf2\xb3\x9b\x6a\xd3\x80\x93\x05\xf9\xc8\x91\x9c\xbf\xa3\xfb\xd3\x88\xb4\x0e\xf2\x85\xa3\x1e\x7f\x48\x48\xaf\xf3\x76\xbc\x31\x0c\x9c\x08\x33\x24\x0f\x49\x73\x24\x4c\x69\xfe\x6e\x9d\xd0\x19\xb8\x30\x4e\x81\x50\x3e\xe2\x86\x9d\x38\xbb\xc4\x96\x29\x02\xf5\x18\xe4\xa8\x74\x7a\x8c\x06\xfd\x00\xf3\xbb\xce\x0a\xf1\xb7\x72\x82\x8c\x9c\x48\xae\x7d\x4f\x74\x6f\x4e\xa7\xd0\x0c\xf0\x7b\xca\x84\xa4\x6b\xcd\x82\xd0\x0f\x3a\x53\xdf\xf1\x22\x18\xd0\x24\xbf\xda\x4e\x88\x6f\xb3\xfc\xce\x26\x9e\x08\x1f\x69\x24\xea\xf8\xd3\x48\x9d\x0e\xae\x42\xfc\xbe\x12\xc0\x27\x91\xd1\xd3\x8b\x32\xf2\xc4\x98\xba\xb1\xc7\x5c\x59\x60\xe8\x23\x6b\xc3\xa4\xe3\x5c\xb0\xe5\x49\x2e\x77\x16\x8a\x4e\x8e\x15\x91\x59\x58\xd2\x90\xb3\x06\xc6\x04\xc9\xa5\x42\xe6\x68\x62\xf4\x3d\xb0\xef\x9d\x4e\x9c\x1c\x2e\x13\x08\xa7\x8e\x07\x58\xab\x51\xe2\xfd\x59\xc4\xe3\x1f\x69\x89\xb1\xe5\x72\xf5\x87\xef\x72\x97\xd7\x1f\x32\x98\xa1\x03\x5d\xbb\xfb\xcb\x1b\x96\x34\x0f\x69\xfe\x89\x04\x90\x6f\x77\x64\x45\x56\x46\xb1\xa1\xe5\x93\x4f\xf6\x64\x25\xc2\x36\x8f\x8b\x14\xc2\x28\xb6\x11\xd4\xf7\x86\xbe\xeb\xd8\x85\x7f\x58\x15\xf4\x27\x69\x58\x85\xea\x94\xa9\xa3\x72\xad\x81\xfa\xd3\x72\xb3\x4a\xfe\xdd\x44\x1d\x97\x0b\x47\xd0\xb3\x65\xe6\x96\xb4\x60\xbe\xdb\x88\x1b\x7a\xb6\x7b\xc7\xeb\x33\xf1\xb8\x60\xf9\x2e\xbe\xe0\xa2\x13\xff\xe8\xd2\x00\xd4\x93\xd0\x04\x6c\x3d\x1a\x3f\xa6\x09\x22\x17\xe2\x8c\x1c\xd0\x55\x90\x78\x22\xe3\xc6\x60\x8a\x5d\x83\x06\x18\xf8\x8e\x0b\x03\x3c\xb4\x71\x1e\x49\x18\x58\xaf\xac\x30\x7c\x85\x46\x68\xea\x4b\xfc\x9f\x09\xb4\x1d\xb3\x30\x0d\x1c\x2f\x7a\xfc\x43\xff\x83\xce\x03\xf5\x3f\xc8\xc4\x8e\x1d\x57\x98\x41\xb0\xf0\xc2\x99\xe0\xa3\x91\xbd\x88\x19\x69\x58\x29\x67\x51\x63\xd3\xf6\xe7\xb8\x72\x58\x48\x4e\x10\xf1\x51\xa4\x61\x0b\x53\x37\x71\x07\x18\x41\x9b\x74\x79\xa9\x49\x74\xb0\xaf\x46\xfc\x81\xaf\xe3\x00\x0e\xbf\xd3\x52\xc4\x33\x53\xad\x50\xd4\x0a\x66\x14\x05\x45\x14\x5a\x2a\xa0\xb9\x00\x33\x68\x2b\xd1\x38\x98\xc2\x31\xf1\xff\xf4\xb4\x7f\x68\x14\xaf\x27\xa2\x1f\xe6\xad\x19\x5a\x81\x33\x8d\x3a\x5a\x86\x4c\x43\x63\x8c\x8e\xbd\x98\x9b\x99\x1f\x41\x89\x79\xb6\xdb\xed\xee\xd4\x1c\x41\x80\xbf\x6f\x07\x8e\x87\xdc\xaf\x8e\x89\xe6\x21\x8b\x08\x39\x59\x89\xa3\x82\x6d\x09\x10\xbf\x0b\x60\x7b\x5b\x44\x81\x8e\x46\x59\x55\x7c\x14\x36\x31\xef\xc0\x1c\x9f\x7c\x87\x7c\x3e\x56\xa9\x53\x7d\x5c\xd5\xc7\xb5\xc7\xf8\x24\xbf\x5a\x97\x9e\xde\x57\x5b\x90\x00\x86\x16\x97\x8b\xb2\x96\xef\x1e\x80\xb9\x05\x3c\xf3\x76\x60\x06\xbc\x1b\x55\xee\xd7\xc0\xe7\x4f\x2e\x18\x44\xde\xeb\xf2\xe5\xf0\xf3\xe9\x15\xb0\xcc\x00\x46\x7a\xf9\xee\xe4\xb0\xfe\x19\xd8\x81\x3f\x9d\x4d\x5f\xab\x60\x71\x43\x89\xfc\x29\x90\x9a\x18\x4a\x60\x74\xfd\xee\x0c\xb8\xe6\x00\xba\x12\x6d\x22\xdf\xa7\x3c\xdc\xdb\x6b\xb8\x20\xbf\xe9\x71\xa4\x4c\x84\x42\x64\xeb\xfc\xf3\x38\xeb\x1a\xfe\x63\x38\x1c\x0a\xb9\xba\xf9\x74\x7d\x4a\x62\x00\x92\x24\x9a\xfb\x8c\x75\x55\x88\x2d\xc9\xbb\x6d\xdb\x2c\xe9\xe2\x8f\x5f\x3c\x32\x2d\x32\x0d\xfd\x17\xf3\xa7\x73\x14\xe2\x0f\x55\xa6\x77\xe9\x0c\xc4\x9c\x82\xb1\x33\x1a\xbb\xa8\x6f\xa4\x6a\x0c\x46\x03\xb3\x58\xd1\xf1\x9f\x12\x99\x8d\xb0\xee\x96\xb6\x07\xdd\x5b\x88\x9c\xcf\xc2\x47\x38\x83\x9a\x9e\x3c\xeb\x6f\x02\xc7\x74\x75\x66\x0a\xc4\xa4\x5a\x9f\xde\x71\x4e\xad\x51\xae\x57\x5b\x8d\x4d\xa3\x5e\x8b\xbb\xad\x5a\xad\xd6\x95\xd6\x29\x19\xc1\x74\xce\xc5\x4a\xbd\x36\x36\x6f\xac\xef\x46\xd2\x8d\x25\x6c\xd2\x54\xb6\x88\x3d\xbe\x7f\xd4\x6a\x9b\xe6\x60\xb3\x2b\x76\x61\x64\x0a\x42\xa6\x16\xba\xd9\xc1\x3e\x4f\x1c\xa5\x5a\x6b\x54\x37\xad\x4c\x14\xa6\xd7\xa3\xf8\x78\x4a\x12\x8d\xf1\x1d\x99\x68\xde\xd1\x8d\x65\x8d\xe9\x5d\x01\x8d\x54\x85\xb8\x3a\x88\x5b\x15\x38\xde\x88\x14\x3f\x46\x02\x7a\xb2\x26\xa8\x4e\xef\x04\xa7\xbc\x82\xbb\x13\x61\x3a\x30\x71\x6c\xdb\x45\xed\xbe\xfa\x70\x1a\x85\xc0\x99\x8c\x40\x90\xbc\xa1\xc0\xcf\xfb\xba\x7c\x4f\xd4\x65\xbd\x97\x72\xdf\xa8\x1b\xf3\x4b\x12\x1f\x55\x0a\x8c\x1b\x08\x40\x5e\xda\x2c\xec\x34\xa7\x77\xa8\x99\x4d\x8e\x2f\xb6\x30\x2a\x1a\xcf\x26\x03\xcf\x74\xdc\x64\xb0\xcc\xa9\x77\x69\x65\x77\xa5\x2d\xb0\xcb\x27\x5b\x67\x4d\x38\xb9\x9a\xa3\x63\xba\x6e\xa1\x5c\x0d\x0b\xd0\x0c\x21\x70\x3c\xe4\x9b\x75\x81\xbf\x0c\xb1\x24\x58\x3a\xf7\xcd\x57\xda\xf4\xdd\xbb\x09\x56\x87\xe5\x04\x56\xda\xdb\xd1\xcc\x37\x2a\xbf\xa1\x19\x14\xa9\x3f\xd4\xa9\x76\xaa\xa8\x49\xd2\x67\x3a\x5b\xc3\xa2\xc4\xd5\x49\x3b\x60\x56\x2f\x10\xe2\x2a\x9e\xda\xe0\x0b\x08\x03\xe0\x7b\xee\xfd\x63\x32\x53\x34\x07\xa1\xef\xce\x22\xd8\xa5\xd9\x9c\x26\x33\x2c\x23\x49\xac\x03\x0c\xd6\xeb\xea\x0a\xd3\xbf\xae\xe5\x3a\xd3\x4e\x00\xad\xa8\x58\xd1\x0b\xf4\xff\xa5\x24\x57\x28\x6d\xff\x74\x3e\x8b\xd3\x26\xd6\x8b\x77\x01\xe9\xb4\x5c\x0d\x20\x6d\x23\xc9\x6c\x18\x99\x91\x63\xd1\xac\x22\x2d\xb2\x1a\x4d\x7c\xc4\xae\x38\xeb\x22\x19\xc4\x5a\xff\x1a\xf8\x2e\x33\x99\x7a\x14\x66\x42\x63\x83\x8c\xaa\xfa\xb8\xae\x8f\x1b\xfa\xb8\xa9\x97\xdf\x5a\x3b\x5b\x6d\x30\x36\x50\x2e\x4f\xa3\xdd\x16\x18\x57\xd1\xcf\xbd\x87\xf6\x15\x18\xd7\xd0\xcf\xca\xa7\xdd\x0a\x18\xd7\xd1\x68\xe1\x8c\xea\x9f\xc1\xb8\xa1\x97\xfb\xc6\xfe\xe5\xc0\x01\xe3\xa6\xba\xdf\xa1\x8e\x66\xa3\x52\x11\x0c\xdf\xe8\x72\x33\xcc\xc5\xd8\x28\xe0\x05\x01\x7d\x5c\x8d\x7f\xd4\xe2\x1f\xf5\xf8\x47\x23\xfe\xd1\xa4\x3f\x92\x8c\xc7\xcf\x49\xf6\x53\x01\x2d\x44\x2a\xa0\x45\x49\x04\x71\x81\x12\x41\x5c\xac\x38\x35\xa3\x50\xee\x1b\x77\x37\xbb\x01\x48\xb2\x28\x08\x6a\xa2\xa0\x2e\x0a\x1a\xa2\xa0\x29\x08\xd2\xa2\x08\xf2\xb4\x48\x99\x80\xb8\x68\x99\x80\xb8\x88\x62\x40\x52\x54\x31\x20\x29\x32\x17\xc0\x4d\x16\xc8\xac\x95\xaf\xc6\x78\xb8\xda\xdc\xdc\x44\x66\x95\xda\x11\xb1\x9f\xd8\x94\x88\x09\xd1\xdc\x2e\x69\xec\x06\x5e\x54\x31\x14\x15\xcc\x56\x2c\x5f\xd3\x6c\x0d\xf3\x55\x9e\x55\xa9\x42\xd5\x32\x15\xcb\x75\x2f\xd3\xb9\xb4\x32\x18\x5f\xa3\xd9\xf8\x6d\x81\x1b\x50\xd2\x96\x70\x1b\x4a\x9a\x13\x6a\x87\x69\x8b\x62\x94\x64\xa8\x94\x54\x57\xd9\x34\x63\xcb\x82\x71\xa7\x2d\x27\x63\xe5\x59\x6b\x51\x98\x91\xc4\x7c\x14\x76\x95\x35\xf2\x5c\x43\x8b\x17\xfc\x38\x53\x62\x82\x6a\x68\x84\xe5\x2c\x8b\x0d\xc4\x2a\xe1\x0c\x8d\x59\x9f\xac\x63\x7d\x31\x65\x62\x9d\xc0\x16\x0a\x64\xeb\xe2\x91\xf7\xd5\x16\x7c\xd5\x30\x81\xc8\x05\x99\x26\xde\x47\xa1\x52\xc0\x15\x53\xde\x3a\xf7\x0f\x26\xc0\x45\x33\x23\xc9\x48\xc6\xc4\x6f\xc6\x8f\xb4\x8d\xd5\x32\xfd\x64\x7d\x41\xa7\xc2\xc5\x89\xe3\xd1\x61\x76\xb3\xd9\x9a\xde\x95\x1e\xd9\x64\x98\xa2\x1a\xd3\xbb\xc5\x22\x51\xb7\x54\xc7\xad\xc6\x6f\x78\x79\x16\x01\xb6\x3f\x0d\x5d\x20\xac\xd5\x26\x2e\x88\x35\x6c\xc1\x5a\x32\x28\x96\xab\x70\x82\x86\x74\x7b\x77\xfc\x8e\xac\xd7\xbb\x70\x48\x17\xf8\x88\xaf\x85\x9e\x11\xa2\x06\x4e\x0f\x08\x02\xef\x68\xb0\x10\x2c\x40\x98\xea\xc9\xf5\x35\xc1\x58\x10\x8d\x4b\x2c\x88\x48\xd0\xa0\xba\xbf\xf5\xde\x27\xa8\x1f\xb3\x30\x72\x86\xf7\x2c\x8c\x8a\x10\x6e\xa7\x55\xf3\x08\xce\xf3\xe7\x81\x39\x7d\x9c\x8f\x9d\x08\xe2\x65\x0b\xd8\x21\x22\xe4\x9c\x4d\xf7\x0f\x2e\x69\xd6\xfd\x39\x0c\x2c\x33\x84\xe2\x02\x65\x12\xb0\x28\xef\xee\xbd\xb3\xdf\x12\xf8\x6c\x3a\x95\xc3\x93\x80\x45\xf9\xe3\x87\x63\xff\x81\x16\xc9\x9c\xe2\x75\xf2\x87\x0c\x3e\x0d\x59\x94\xcf\x0f\x0e\x1d\x1a\x61\x32\x8b\xa0\xfd\xc8\x74\xa7\xe5\x09\xb8\x88\x0c\x12\x38\x0d\x1c\xbc\x49\xc1\xb9\xe8\x0b\x53\x02\x89\x1d\x74\x59\x10\xef\xb3\xb7\x9a\x95\x36\x72\x5b\x8c\xd1\xfb\x93\x1d\xba\xfb\x32\xb3\x2c\x18\x26\x90\x9a\xb5\xd9\xac\xd9\x0b\x53\x86\x49\xd2\x91\x85\xf1\x09\x0d\x1a\xf5\xaa\x85\x74\xef\x7d\x78\xf7\x9e\x00\x1d\x6f\xe8\x27\xa9\x18\x9b\x95\xd6\x10\xa5\x22\x00\xd2\x24\xc4\x00\x9e\xbf\xde\xa8\x36\xdb\xa8\x20\x07\xad\x63\x8b\xa0\xe6\x66\xe0\x39\xde\x28\x86\xb4\xcc\xa6\x5d\x1b\xe0\x82\x64\x30\x4c\x41\xb2\x61\x5c\x42\xcd\x66\xc3\xc0\x05\xa9\x9e\xc0\x9b\x3b\x02\x24\xa7\xd9\xc5\x08\xb3\x5d\xaf\xd7\xab\x28\x9d\x0c\x24\x4d\x26\x1b\xc4\xa5\xd2\xaa\xd7\x1a\xb5\xfa\xa2\x7c\xf1\xae\xd5\x3c\x03\x83\x91\x58\xf7\x78\x56\x90\x69\xa8\x89\x45\x88\xd1\x92\x74\x33\x01\x24\xd5\x2c\x55\x6c\x18\x6f\xc7\x95\xb6\x89\x22\xc4\x56\x91\x85\xda\xc3\x61\xc5\x6e\x2d\xcc\x0c\x36\x49\x35\x13\xa0\x4a\xd5\x32\x60\x75\x50\x43\x56\xb2\x03\x1a\x3e\x8a\x81\x6d\x44\x92\x66\x1b\xda\xc3\x4d\x6c\x2e\x2c\x92\x31\x16\x4e\xac\x4a\xcf\x1c\xda\x6d\x32\x63\xa8\x5d\x7d\xf4\x10\x3c\xb6\x19\x55\x2f\x88\xab\x55\x00\x33\xb5\x2a\x86\xa8\x12\x1e\x6e\x42\x6b\xd0\xc0\xed\xae\xb9\xf9\x06\xc5\xa0\x36\x24\x81\x56\x6d\x68\x43\xd2\xfe\x38\x2c\xdb\xf8\xf8\x00\x55\xaa\xb0\x3e\x68\x0f\x50\x23\xa9\x99\xf7\x07\x63\x80\x57\xcd\xc8\x2a\x5d\x3c\x37\x8d\x47\xa8\x76\x3a\x21\xaa\x57\xa6\x77\x85\x4a\x81\x99\x7d\xb1\xdb\x86\xcc\xbc\x6b\xe6\xea\xbe\xcb\x7a\x2c\x15\x99\xbb\x32\x73\x0b\x18\x88\xfe\x9e\xb9\x05\x1f\xff\x4e\xe3\x51\x28\x9e\x49\x45\x83\xe6\x29\xc0\x2f\x22\xcd\x3c\xbc\xe9\x68\x27\xd9\xc4\xc7\x9f\xa2\x41\x32\x79\x4b\x89\xae\xea\x55\xcd\x63\x67\x46\x22\x91\xd9\xe9\x92\x28\x71\x16\x71\x28\x68\xe0\xb9\x7b\x86\xe3\xb5\xeb\xc8\xf7\x7b\x39\xee\x46\x3a\x71\x24\xe3\x1d\x92\x2c\xec\x5c\x95\x20\xad\x2e\xec\x48\xb7\xed\x47\xf9\x8a\xc0\xc2\x8e\x24\x5b\xb1\x89\x43\x41\x0a\x95\xe3\x1c\xf4\xab\x3b\x5f\xfc\x09\xb0\x5d\x30\xf6\x03\xe7\xc1\xf7\x22\xd3\x2d\x20\x4e\xd7\x37\x23\x3c\x50\xc7\xb3\xe1\x26\xaa\x60\x7c\xf1\x14\x11\x8b\x83\x75\x66\x2a\x8c\x01\x89\x10\xba\xae\x33\x0d\x9d\xb0\x2b\x1d\x71\xa5\xb9\xe0\x4b\x61\xb4\x90\x2e\xd8\x05\x6f\x1d\xff\xb6\xcd\xc8\x04\x7e\xe0\x8c\x1c\xcf\x74\x01\xdd\xbf\xa6\x73\xd9\x31\x74\xa7\x12\xa3\x24\x6b\x4a\x05\x32\x80\xf6\x6b\xed\xf7\xd7\x3b\x20\x79\xb1\x79\xc2\x78\x40\xed\xca\x6f\x5d\xe5\x40\xce\x2c\x86\xc7\xae\x0f\xb2\xe0\x02\x33\x5b\xc1\xce\x9e\xe8\xcd\x6d\x96\x1b\x69\x53\x89\x2d\x83\x6d\x28\x29\x71\x61\xca\xbc\xac\xc6\xac\xbe\x17\x66\xae\x22\xc0\x67\x03\x32\x8d\x86\x01\xd2\x97\x1f\x18\x09\x71\x06\x19\x01\xef\x17\xf2\x4b\x60\xdc\x86\xfc\x92\x55\x4a\xa4\xe6\x4c\xca\xf1\x0a\xae\x98\x01\x89\x9c\xcb\x07\x0d\x4f\x76\x26\xbe\xfd\xfe\xed\x5b\xb5\x62\xd4\x0b\x85\xc2\xb7\x6f\x6f\x2a\xdf\x7e\x47\x35\x7a\x74\xbd\x7f\x00\x52\x06\x10\xc0\x5b\x18\x84\x2c\x69\xb9\x6f\xec\xcc\xf7\x4f\xc1\x74\xe6\xba\xd4\xfb\xe4\xdb\xa6\xc1\x36\x57\xda\x37\xc4\x8b\x52\x71\xe3\x65\x2a\x8d\xab\xcf\x4a\xa6\x71\xe4\x64\x4a\xa9\x8f\x6c\x16\x05\x68\x0e\xa7\x42\x95\x12\x4a\x0e\x99\xc3\x28\xab\x84\x7c\xe6\x65\xd5\x96\x57\x53\x71\x49\xc9\x16\xd5\x2a\x3a\x21\xc8\xa5\x2a\x59\x81\x90\x05\xae\xac\x90\x15\x78\x25\x11\x38\x2b\x7e\x53\xc1\x36\x8c\x8c\xf9\xdb\xef\x0b\xd3\xb6\x03\xe4\x4f\x29\x67\x86\x74\x88\xca\xae\xb6\xa4\x63\x43\xf9\xdd\xf0\x62\x78\x05\xac\xf8\x3c\xbc\x98\x2c\x10\x17\x0a\x89\xd9\x62\x01\x67\xf2\x46\x76\xcc\x42\xa2\x9c\xf1\x44\x4c\x91\x86\x36\x70\xdf\x9d\x8d\xd6\x6e\x57\x73\xa2\xb5\x37\x15\xd1\x8c\x6a\xa5\x92\x13\xcf\x30\x48\xc4\x72\xdf\x38\x1f\xed\x9d\xa5\xe1\x60\xe8\xce\x1c\xfb\x97\xa9\xa1\xdc\xaf\x7d\xfe\x31\xba\x00\x81\x3f\xe7\x86\x2a\x80\x23\x70\xa9\x00\x1a\xa1\xbe\x6f\x5c\x61\x7f\x0b\xdc\x85\xc0\xd0\x0b\xe5\x2f\x3f\x3e\x98\x35\x2c\x08\x27\x58\xd0\xaf\xde\x9c\x7c\xb6\xb0\x64\x62\x53\x49\x25\xa2\x18\x77\x44\x25\x3b\xde\xf4\x3e\xa6\xa9\x22\x89\xe1\x54\x67\xa3\x98\x07\x4b\xaa\x51\x6d\xf3\x32\xe6\x21\x92\x3d\xb7\x76\x1f\xf3\x20\xc9\xd1\xe7\x69\xab\x1f\xd3\xd4\x30\x64\xdb\x6b\xbc\x8d\x69\x90\xe4\xcd\xa0\x06\xf7\x62\x16\x02\x69\xbe\x0b\xaf\x62\x16\x22\x39\x7d\x13\x3d\xc4\x34\x75\xbd\x50\x1e\x9a\x95\xfd\x20\x66\x41\x82\xf1\xb4\xb2\x3b\x8b\x59\xea\x38\xbf\x9b\xb7\xed\x9b\x98\x05\x4b\xaa\x57\xb5\xf9\x69\xcc\xd2\x40\x92\xda\xb8\xbf\xf5\x2e\xa6\x41\x92\x10\x1c\x5e\x57\x63\x1a\x02\x99\xfa\xe7\xd5\x98\x06\x4b\xaa\x07\x07\x43\x27\xa6\x69\x62\xc9\xec\x36\x38\x8b\x69\xb0\xa4\x66\x5e\x0e\x1a\x31\x0f\x96\x18\xd7\x1f\xa2\x66\xcc\x43\x24\xee\x95\x71\x11\xf3\x6c\xe2\x58\x87\x5f\x86\x89\x8a\x89\xc4\x7a\xef\x37\x63\x1e\x22\xb9\x7b\x38\xad\xc4\x3c\x9b\x44\xe9\x77\x6f\xef\x62\x9e\x16\x96\x8c\x1c\x7b\x37\xe6\x21\x92\xe3\x53\xf3\x2c\xe6\x41\x92\x83\xf7\x00\x84\x31\x0d\x81\xf8\x47\xc3\x1f\x31\x4d\x9b\xd4\xc3\xd6\x7e\x3d\xa6\x21\x92\x9d\xb1\xfd\x2e\xa6\xc1\x92\xda\x4d\x33\xd8\x8f\x79\x90\x64\x3a\x71\xb6\x3e\x27\xf6\x57\x41\x3a\x6d\x5c\x93\x19\x00\x36\xc0\x0a\x2e\xba\xff\x71\xfb\x30\xb1\xc0\x0a\xa1\xbe\xba\xfc\x92\x98\x20\x12\xdd\x5f\x7a\x4e\xa2\x67\x03\x59\xa5\xeb\x7c\xfc\x94\xd8\x8e\x41\xec\x74\x7b\xf2\x09\x26\x4c\x58\x64\xdc\xfc\x38\xdb\x49\x98\x08\xca\x1e\x59\x20\xa1\xc2\xa6\x5a\xbb\x77\xb7\x1b\x09\x17\xb1\xde\x9d\xed\xcb\xb4\x5d\x10\xd1\xdb\x8f\x81\x97\x70\x55\x1f\xb3\x2f\xfd\xa1\x3e\x83\xd9\xc4\x59\xb1\x59\x67\x5a\x69\xb6\xc1\x49\x9a\x8e\xd8\x0a\x24\x16\x9d\x35\xce\xac\x99\x49\x0c\x46\xac\xfb\x6c\x2d\x66\x6b\x43\xd4\x2a\xe3\xd2\x2f\xb2\x81\xe9\xce\xdc\x42\xe4\x8a\x3b\x63\xa3\xdc\x24\xff\xdb\xfc\x6d\x21\xe6\x80\x62\x5a\xb5\x72\x8d\xfe\xef\xb7\x85\x98\xef\x64\x2c\xc0\x41\x5c\x21\x69\x50\xb3\xc9\x26\x21\xa8\x86\x62\x1a\x2d\x3e\x09\x4e\xa1\x31\xa6\x82\x83\x38\xed\xd3\xa0\x3a\x57\x0a\xa1\xce\x28\xa6\xc6\x95\x82\xaf\x68\x0a\xa9\x92\x42\x70\x46\x11\xeb\x90\x2f\x04\x6f\x4a\xb1\x9e\xd8\x04\x66\xd7\xad\x83\x93\x18\x81\xfd\x05\xa3\xfa\x48\xcd\x11\xd7\x87\x77\x72\x0c\x3e\xf3\x00\x83\x02\xf8\x4a\xe9\xd7\x2e\xab\x7b\x7d\x1e\x59\xa1\x48\xa1\x6a\x8c\xd6\xcd\xfe\x98\x43\xb6\x29\x10\xd7\xcf\xf6\xee\x68\xea\x72\xc1\x2d\x1a\x2c\xd4\x51\xed\xd3\xc5\x21\xcf\xb3\x49\x81\x42\x45\x19\xc7\xbb\xe3\x5b\x0e\xd8\x8c\x81\xa8\x8c\x17\x77\x6f\x6f\x2f\xb9\xe0\x06\x0d\xae\x8b\x45\xbc\x99\x46\x1c\xb0\x4e\x81\x35\xa1\x84\xd5\x2f\xd0\xe6\x80\x35\x0a\x24\x95\x57\x9b\x82\x88\x57\x7b\xa2\x75\xae\x88\xa7\x3b\xbb\x37\x82\xf6\x63\x95\xf2\x96\xf8\xd6\xb8\xe4\xf9\x62\xd5\xc7\x7b\xdc\x6f\x8e\x6f\xf7\x53\x40\x38\x46\xf5\x4c\x3a\x22\x5c\xcd\xfd\xda\xf6\x66\x6d\x87\x07\x18\x04\xc0\x57\xf3\xb8\xd1\xdc\xbb\xe3\x71\x15\x82\x13\x2b\xf9\xb8\x5d\x3b\xe6\x80\x6d\x82\xa3\x6d\xf0\xe3\x60\x3e\xe7\x82\x5b\x24\x58\x6c\x87\x1f\x6a\xf0\x0d\x87\xdb\x24\x38\xb1\x2d\x7a\x5b\xd6\x39\x87\x6b\x52\x1c\x29\xde\x14\x46\x7c\x6e\x1a\x24\x58\x6c\x93\xfb\x37\x37\x97\x1c\xae\x4e\x70\x7c\x05\x1f\x6e\x47\xb3\x26\x07\xab\x11\x18\xad\xde\xe3\x43\x3b\xe0\x82\x63\x65\x0b\x85\x9b\x7f\x6a\xd7\x78\x65\x52\x5d\xf2\xaa\x9c\x9c\x9c\x4c\x39\x18\x55\x39\xad\xdc\x6a\x7b\xf7\x4b\xd2\xd5\x91\x57\x4f\x50\xf5\x72\x0b\x18\xa4\x96\xab\xe3\x21\x1d\xda\x19\xa4\xc1\x21\xf9\xea\x3e\xfe\xe4\x1c\xcf\xc4\x08\x15\x2e\x82\xd8\xef\x36\x2f\xdf\x9e\x0a\x31\xda\x5c\x04\x62\x00\x86\xb5\xbd\x07\x05\x5c\x8b\xc3\x89\xad\xfd\xf3\x79\xd4\x16\x22\x6c\x72\x11\x04\x93\xa8\x05\xb3\x1b\x43\x88\xd0\xe4\x23\x10\xa5\xbc\xbf\xb5\xe7\x02\xae\xc1\xe1\xc4\x6e\xe0\xc0\x32\x76\x84\x08\x75\x2e\x82\xd8\x1d\x1c\xbe\xbb\x18\x08\x11\x6a\x5c\x04\x6a\x37\x17\xbb\x07\x17\x02\x4e\xa8\x47\xc1\x80\x6e\xce\xf7\xc4\x22\xf2\xd5\x29\xa8\x64\x5a\x9f\x1c\x0b\xf8\xca\xca\xeb\x75\xd9\xe9\x82\xc4\xf1\x17\x7d\xf8\x8c\x3b\x9e\xf5\xac\x65\x4e\x72\xc6\xdd\x95\x38\xae\xa2\x0f\x9a\xf5\x26\x25\x5e\xa1\xe0\xdc\xf1\x9e\x89\x18\xc8\x7a\x26\x02\x97\xd4\x33\x11\x72\xa0\xf0\x4c\xb8\x7c\xf3\x9e\x09\x57\x48\xb9\x67\xc2\xab\x46\xe1\x99\x70\x0a\xe5\x3c\x13\x5e\xfb\x52\xcf\x84\xaf\x32\xa9\x63\x22\xd4\x33\xe7\x99\xf0\x46\x21\xf5\x4c\x78\x4b\x92\x39\x26\xfd\xaa\x3b\x32\x3f\xc6\x10\x99\x67\xd2\x6f\xef\xec\x1a\xa7\x3c\x42\xee\x9a\x5c\xef\x3e\x5c\x03\x1e\x28\xf7\x4c\x3e\x46\xd5\x1f\x36\x07\xe4\x1c\x93\x7e\x2d\x9c\x1f\x6f\x73\xe1\x2a\xcf\x64\xef\xc2\xbe\xe4\x80\x72\xcf\x64\x7e\xbe\xfb\xd6\xe4\x70\x9c\x63\x72\x7e\x70\xb3\x7d\xcf\x05\x2b\x1c\x13\xa3\xd1\x7f\xcf\x67\x4c\xee\x98\x5c\x7f\x98\x8d\x3e\x70\x38\xde\x2f\x31\x46\xc3\xf9\x17\x2e\x5c\xee\x97\x7c\x3a\x78\xb7\x75\xcc\x6b\x54\xee\x97\x6c\x36\x0d\x5e\x0f\xbc\x5f\x72\x7b\x71\x13\xde\xa6\xe1\x12\xb7\x24\x08\x1e\xb6\x78\x80\xd4\x2d\xe9\x1b\x4d\x67\xef\x0b\x0f\x94\xfb\x25\xb5\xd1\x51\xbd\xcf\x01\x79\xbf\xc4\x39\x67\xcd\x4e\xed\x97\x34\x0e\x6f\xce\x39\x9c\xdc\x2f\xa9\x5d\x6e\x6d\xef\x70\x38\xde\x2f\xb9\x77\x9a\x77\x5c\xb0\xd4\x2f\xd9\x73\xfa\x91\xc5\xc1\xa4\x6e\x49\xbf\xb6\xbd\x53\xbd\xe2\x70\x9c\x5f\x52\xfd\x70\x7c\x31\xe3\x82\x15\x7e\xc9\x6d\x10\x41\x5e\x99\x32\xbf\xa4\xea\xbc\x3b\x3b\xe0\x60\x82\x5f\x32\xda\x3a\x4a\xfa\x88\x5c\xbf\xc4\xf0\xae\x6f\x80\x88\xcc\xf3\x4b\xfa\x35\x6b\x70\x90\xe1\xce\x75\x4c\x8c\xab\x9b\x4f\xd7\x42\x0c\x89\x63\xe2\x36\x0e\xe8\xa2\x4e\x0a\xcb\xf5\x4b\x8c\xbe\x7b\x57\x11\x22\xe4\xf9\x25\x27\xc3\xe8\xf4\x4e\xc0\xcb\xdc\x12\x63\xdb\x79\xf3\x46\xc0\xe5\xbb\x25\x1f\x8e\xee\x0f\x85\x08\xb9\x6e\x49\xed\x73\xeb\xec\x4c\x88\x20\x73\x4b\xaa\xb3\xbb\x5b\x43\xc0\xe5\xba\x25\xb5\x83\x9d\x83\xf7\x62\xe5\xe4\xb8\x25\xd5\x9b\xdd\xe8\x83\x80\x17\xdd\x92\x9c\x05\x5c\xd9\xaa\xa5\xb8\xfe\x98\x59\x4a\xcc\xac\x0a\x4a\xd6\xf7\x32\x2b\x75\xd9\x35\xb7\xcc\xea\x99\x64\x21\x4c\xb6\xa4\x95\x5d\x9b\x12\x96\x98\x84\x25\x13\x21\x90\x75\x4c\x44\x32\xa9\x67\x22\x66\x42\xe1\x9a\x70\x79\xe7\x5d\x13\xae\xa0\x0a\xd7\x84\x53\x8f\xdc\x35\xe1\x95\xca\xba\x26\x7c\x05\x48\x3d\x13\xbe\xd2\xa4\x9e\x09\x5f\xd1\xfc\x92\x09\x67\x14\xf2\x25\x13\xde\x94\x64\x9e\xc9\xe0\xc7\xc9\x59\x10\x23\xa4\x8e\x49\xf5\xb4\x32\x3a\xe7\x11\x8a\x35\x13\xe3\x7e\xee\x35\x78\xa4\xdc\x33\xb9\xaa\x4d\xc0\x0f\x0e\xc8\x7b\x26\xd5\xeb\xfb\xeb\x36\x17\x2e\xf7\x4c\x6c\x2f\x38\x7c\xc7\xe1\x54\x4b\x26\x0f\x1f\xaf\x86\x1c\x90\xf3\x4c\xfa\x86\x3b\xba\xdb\xe3\xc2\xe5\xae\xc9\x70\xbf\x0f\xce\x38\x9c\x6a\xc9\xe4\x7e\xdb\xe0\xd5\xca\xbb\x26\xd5\x60\xf6\xc1\xe0\xc2\xe5\xae\x49\xbf\x36\xbd\xbe\x3b\xe1\x75\x2a\xf7\x4d\x3e\xee\x0c\x23\x0e\x27\xac\x99\x54\xed\x6b\xf7\x28\x05\x64\x9d\x93\xea\x51\xed\xfc\x8c\x07\xc8\x9d\x93\xda\xde\xe6\x55\x9d\x07\xca\x9d\x93\xea\xf1\xfe\x59\x85\x03\x72\xce\x89\x71\x73\x72\xe2\x70\xc1\x52\xe7\xe4\xaa\x75\x78\x7a\xce\xc1\x14\xbe\x49\xff\xfc\xf2\x84\xc3\xf1\xbe\xc9\x87\xa3\xe0\x07\x17\xac\x58\x33\x39\x9e\x9d\xb5\x38\x9c\xdc\x39\xa9\x3e\xec\x83\x3e\x87\xe3\x17\x4d\x76\x02\x87\x4f\x4e\xe1\x9c\x1c\x6e\x02\x41\x99\xd2\x45\x93\xeb\x63\x73\xce\xc1\x78\xe7\xa4\x76\x3c\x1f\x26\x2d\x21\xcf\x39\x09\xf7\x06\xce\x5c\x04\xe6\xfb\x26\x23\x78\x63\x88\x31\x72\x7d\x93\xda\xfd\xf4\xbd\x25\xc4\x90\xf8\x26\xf7\xf6\xce\xec\x4c\x80\xe5\xfb\x26\x87\x67\xb5\x2d\x21\x42\xee\x9a\x89\xf1\xa6\x55\x19\x0b\x11\x64\xce\x49\x6d\x54\x9b\xf6\x05\x5c\xae\x73\x52\x7d\xfb\xe3\x44\x2c\x61\x9e\x73\xf2\x19\x0e\x1e\x3c\x01\x2f\xf1\x4d\x3e\xcf\xdb\xc1\x44\x80\xe5\xb9\x26\xdb\xe7\xfe\x9e\x2d\x56\x8d\xda\x33\xf1\x3f\xef\x5a\x07\x02\x7c\x05\xc7\x24\xd9\x22\x96\xed\x9e\x8a\xfb\xa0\x92\x3d\xcd\xec\xfe\x64\x76\xab\x31\xbb\x69\x98\xd9\xfe\xcb\x6e\xe4\x65\xb6\xe4\x24\x7b\x6b\xd2\x4d\x32\x61\xaf\x8b\xf7\x4d\x84\x40\xce\x37\x11\xc8\xe4\xbe\x89\x90\x09\xb9\x6f\xc2\xe7\x9d\xf5\x4d\xf8\x72\x2a\x5c\x13\x4e\x39\x72\xd7\x84\x57\x29\xbf\x6a\xc2\xe9\x5f\xbe\x9f\xc3\xd7\x9a\x62\xd9\x84\xab\x6b\xde\x3b\xe1\x0c\x43\xe1\x9d\x70\xe6\x24\x5d\x37\xa9\x55\xfb\x73\x18\x43\xe4\xee\x49\x1d\xee\xce\x79\x84\xc2\x3d\xa9\xb6\x6f\xcc\x1b\x1e\xa9\xda\xd2\x39\xdb\xbc\xf2\x39\xa4\xe0\x9f\x6c\x8f\x8e\xb6\xb8\x70\xd5\xca\xc9\xfb\xdb\xca\x01\x07\x54\x39\x28\xd3\xbd\xc3\x07\x0e\xc8\x3b\x28\xd5\x87\xdb\xf0\x9e\x0b\x97\x3b\x28\xe1\xdb\x70\x72\xc5\xe1\xe4\x0e\xca\x27\xa7\xb6\x6f\x72\x38\xce\x3f\xb9\x9c\x8c\x3e\xdf\x72\xc1\x0a\xf7\xa4\xfa\xe1\x70\x77\xc2\xeb\x54\xe6\x9e\x9c\x5c\x9e\x9e\x5b\x1c\x4c\xf4\x4e\xde\x1e\x1d\x5e\xa6\x80\x8c\x77\xb2\x6f\x5f\x3e\x04\x7c\xb8\xc2\x39\xb9\x99\xd7\x06\x3c\x50\xb1\x72\x72\xf0\xf9\x47\x85\x03\xf2\xce\x49\xf3\xc6\xb9\xe0\x82\xe5\x2b\x27\xd5\xa3\x93\xeb\x26\x87\x53\x78\x27\xbe\x11\x42\x0e\xc7\x79\x27\x55\x7b\x72\xff\x81\x0b\x96\x7b\x27\xb5\x69\x30\x6b\x73\x38\x85\x77\xe2\x6e\x7d\xba\xe2\x70\xbc\x77\xf2\x76\xb6\xc3\xab\x5b\xee\x9d\x54\xaf\x2e\xf7\x00\xaf\x4d\xa9\x77\xe2\x5c\x7c\x9c\x73\x30\xce\x3b\x99\x9d\x9c\x5e\x25\xbd\x66\xfe\xca\x49\x74\x79\x37\x10\x91\xb9\xde\x89\x51\xd9\x37\x02\x31\x46\xbe\x77\xf2\xf0\xf9\x54\x4c\x43\xb6\xa5\x53\xdb\xfb\x38\xb9\x13\x70\xf9\x5b\x3a\x93\xfd\x6b\x4f\x88\x90\xeb\x9e\x54\xab\x67\x9f\xb6\x84\x08\xf2\xb5\x93\xd3\xba\x25\xe0\x72\xdd\x13\xe3\xcd\xe5\x75\x24\x44\xc8\x5d\x3b\xa9\x6e\x3d\x3c\xbc\x11\x22\x48\xb7\x74\x76\x47\x77\x8e\x80\xcb\x5f\x3b\x39\xd9\xfb\xf1\x4e\xac\x9e\xbc\x2d\x9d\x1f\xe7\xd7\x62\x51\x33\x2e\x0a\x3d\xc5\x21\xef\x70\x2e\x7a\x3e\x59\xf2\x26\x6a\xe4\x4f\x3b\x2d\xe6\x1d\x15\xfa\x12\x20\x12\xa5\xaf\xd6\x76\xc5\x0f\xab\xa2\x71\xf6\x5b\x2b\xf6\x20\x09\xe6\x23\x6c\xe1\x9b\x6c\xc9\xbb\xe7\x6c\xcc\xd7\xf8\x14\x8f\xd7\x51\xf0\x3a\x1a\xeb\x7c\xc0\xc0\xb7\xef\xa5\x01\x43\xdf\x8f\xa4\x01\x09\x95\xad\xa2\x12\x03\x12\xaa\xe4\x35\x7f\xac\x09\xc5\xbb\xc7\xc2\xe7\xfd\x91\x3f\x55\x7c\x12\x6e\xdb\xb6\xb2\x94\xe2\x21\x01\x44\x33\xc2\x1b\xe5\xd5\x1c\x2e\x5a\xa3\x2f\x63\xce\x0e\xbe\x51\x9e\xbc\x9d\x9d\xd1\x89\xe5\xbb\xf8\x30\x94\xd5\xd0\x18\xc4\x23\x96\xd0\xe7\xe6\xc5\x5e\x2b\x2f\x62\xdd\x2c\xcd\x8b\xcd\x9c\x88\xd2\xa9\x2c\xb2\x55\xfe\x12\xff\xcd\xa2\x72\xf4\x5a\xe0\x4f\x46\x91\x9e\x80\x51\xee\x1b\xee\x17\xf0\x8e\x1e\x58\x62\xf9\x9e\x8d\x4f\x44\xe4\x8d\x58\x85\x61\xed\x59\x85\x61\x4d\x7b\x69\x5a\xf6\x0a\x69\xe5\x60\x24\xb6\x4f\x5f\x40\x95\x1c\xca\x22\x3f\x91\x45\x01\x16\xf4\x21\x87\x70\xea\x90\x43\x38\x6d\x2c\x49\xc8\x5e\x9e\x90\x1a\xc2\xa8\xe2\x97\x97\x33\xcd\x21\x7f\x02\x22\xed\x24\xab\xe4\xf3\x9f\xfb\xf0\xed\x11\x8d\x1a\x46\x81\x33\x65\x32\xde\xf1\xa2\x31\xf0\x87\x20\xba\x9f\xc2\xa2\x6f\xdb\x25\x99\x71\xb6\xd1\x1f\x3c\xcc\xd8\x8d\x2f\x94\x08\x7f\xab\x95\xd2\x90\xb3\x19\x25\x71\x1b\xe8\x0f\x19\x4b\x0a\x96\xef\x7e\xc5\x87\x63\xff\xd1\xd3\xd0\xe0\xa3\x7d\xcf\x1c\x0f\x41\x26\x89\xf8\x43\x26\xfe\xf0\x25\xcb\x77\x67\x13\x6f\x11\x9f\x14\x24\xf0\xe8\xf1\x89\x41\x4f\xe7\x87\xae\xab\xea\x56\xed\x72\xbf\x1a\xdd\x5e\x5d\x81\xf8\xcc\x0b\x45\xff\x9f\x0b\x4b\xcd\x20\x17\x96\x56\xfa\x6a\x89\xe6\xc3\x52\x23\x5f\x25\x51\x1e\xa3\x1c\xe9\x96\xc1\x68\xa2\xcb\x60\x8a\x44\x15\x23\xf5\x32\x98\x22\x51\xe9\x11\x52\xc4\x26\xf3\xec\x39\x53\x4b\xf4\xdb\xc4\xfc\x38\xe3\xb5\xe3\xc8\x22\xd0\xbe\x64\x49\x5b\x7b\x2d\x56\xe8\xda\xa9\xc8\x54\x03\x5b\xe8\x4f\x5e\x3b\x38\x1a\xbd\xab\xc6\x9f\xbd\xe6\x35\x84\x1c\x1c\xd7\x12\x72\x70\x5c\x53\x58\x25\xdd\x25\x38\xae\x31\x2c\x4f\x57\x00\xe5\x35\x87\x7c\x5c\x6a\x9a\xf9\x38\x55\xba\xea\x16\x91\x8f\x53\xa5\x2b\xab\x78\xfa\xcd\xf3\xf2\x36\xc1\x52\xad\xdc\x28\xd6\x8c\x24\x8d\xb1\x7a\xb3\xe0\xab\x76\xfd\x74\xa4\xfa\xa9\xc0\xb6\xd5\xcc\x6b\x18\xfb\xd1\x7e\x03\x7f\x9c\x9d\xd7\x2a\x54\x20\xae\x49\xa8\x40\x5c\x7b\x58\x9a\x5c\x1e\x88\x6b\x09\x4b\x92\x63\x11\x79\x6d\x20\x07\x94\x1a\x62\x0e\x48\x9a\x9c\xda\xf4\x73\x40\xd2\xe4\xa4\x95\x4a\x3e\xba\x5f\x6e\xf4\x09\xcf\xca\x16\xbf\x4e\x8c\x2c\x7c\x75\x5b\x67\x2a\x6f\xcd\x14\x64\x0a\xb1\xea\xb0\x36\xac\xa9\xad\xfc\x43\x70\x7a\xd8\x8a\xcf\x02\x50\xdb\x79\x1e\x8c\xb1\xf4\x3c\x18\x63\xeb\x2b\x25\x9a\x0f\x63\xec\x7d\x85\x44\x79\x8c\xda\xe6\x97\xc0\x62\x33\x5c\x02\x53\x24\xaa\xb2\xfc\x25\x30\x45\xa2\x52\x37\x88\x9c\xff\xb0\xcc\xfa\x39\xa6\x15\xed\x7f\xdd\x38\xb2\x08\xab\xb6\x01\xa1\x42\xd7\x4e\x45\xaa\x1a\x73\x58\xb5\x2c\x75\x3b\xd8\x9e\x6f\x8f\x3e\xd2\xc3\x29\xd4\xcd\x20\x07\xc5\xb4\x82\x1c\x14\xd3\x08\x56\x49\x31\x17\xc5\x34\x81\xe5\x29\x72\x10\x75\x03\xc8\x47\xc5\xa6\x98\x8f\x92\xa7\xa8\xb2\xfe\x7c\x94\x3c\x45\x69\x05\x93\x33\x48\x96\xd9\x3e\x4b\xb4\xa2\xe9\xaf\x19\x45\x82\x5f\xd5\xf0\xf9\x6a\x5c\x37\x0d\xa9\xf7\x3f\xb0\x2c\x0b\x9f\x69\xe4\xbf\xbb\xfb\x41\xb9\x98\x43\x45\xe3\xb3\x31\xc0\x1d\xfd\x08\x39\xfd\x56\xb1\x52\xae\x18\xbf\xc5\xbb\xda\xa1\x15\x40\x48\x6e\x1c\x29\xa6\x2b\xb6\x9b\xcd\x4d\xb2\xbf\xad\x60\x57\xae\xeb\xe2\xcf\x1e\x93\xb4\xef\xe3\xe3\x3a\xc0\x24\x4c\x4e\xeb\xa0\x5f\x97\x23\x11\xca\xd9\xd8\xc1\xcb\xcf\xa1\x15\xf8\xae\x3b\x30\x03\xf9\x81\xa3\xca\x82\xbe\xe6\x56\xeb\xb2\x07\xb9\xac\x10\x4b\x5c\xbd\x59\x25\x06\xbf\x68\xb5\x4a\x0c\x7e\x0d\x6b\xad\x5c\xd9\x6b\xe7\x6a\xe5\x18\xcc\x82\x97\xfc\xb4\x32\x05\x49\xee\xda\x60\x9e\xde\x97\xae\x9f\xb1\x4b\xbb\x39\xa5\x58\xba\x98\xf8\x3c\x9e\xb4\xb6\x9e\xc7\x93\xd6\xe1\x2f\x2a\xd7\x33\x79\xd2\xfa\x66\x79\x1e\xb9\x03\x46\x9e\x55\x7b\xcc\xc9\x31\xcf\xa9\xbc\xe7\xd0\x30\x75\xf7\x1c\x1a\xa6\xea\x7e\x49\xa1\x9e\x47\xc3\x54\x1c\x73\x06\x0f\x77\x68\xcc\x13\x2a\x2e\x1e\xa6\x52\xca\xfc\xfe\x29\x3f\x73\xcf\xa5\x91\xe5\xe6\xe9\xaa\xe2\x69\x84\x5b\x87\x2a\x0b\xf6\x14\x7e\xf9\x01\x5b\xc2\x60\xd2\x95\x9c\x44\x9b\x39\x2f\x52\xdc\x1a\x24\x27\x7f\x93\xbb\xa7\xfc\x99\x35\xc6\xeb\x7a\xbe\xd7\x99\x98\x9e\x33\x9d\xb9\xe4\x16\x3e\x75\x08\x7f\x6c\x32\x7b\xea\x9e\x33\x31\x47\xf4\x14\xb1\xcc\x80\xc9\x6c\xfb\x4a\x4e\xc4\x4a\xce\xd2\x6c\x4e\xef\x0a\x46\x95\x3f\x3f\x2a\xef\x78\x70\xe5\x91\xdf\xb3\x10\x06\x80\x9c\x03\x4f\x72\x84\xcf\xc4\x97\x48\xc3\xac\x50\x14\xb0\xd5\x42\xce\x92\xd3\x59\x09\x5d\x16\xcd\x04\x08\xcb\xa6\xb2\x70\x78\xb0\xbd\x03\x54\x8c\xca\x70\x9e\x98\x83\xfd\x05\x67\xc9\xb3\x19\x4b\x1c\x52\xb5\x3a\xf8\xec\x30\xe7\xf6\x4b\x8f\xce\xcf\x96\x5a\x5d\xd0\xf4\xea\x2e\x85\xd1\x49\x6e\x31\x71\xbc\x10\x46\x85\x4a\xa1\x36\xbd\x2b\xa0\xe2\xb3\x17\x17\x94\x8d\x6a\xa3\xd4\x5d\x07\xbc\xe0\xf3\xf6\xe9\x78\x62\x81\xf8\x92\x24\x36\xdf\xcc\x75\x4a\xf1\xf5\x36\xa9\xa8\xc0\x36\x72\xda\x98\x3c\x1f\x35\x59\xd7\x9f\xa3\xda\x9a\x9a\x96\x13\xdd\x77\xca\xcd\x46\x77\xe8\xb8\xf8\xac\x27\x77\x3a\x36\x8b\x34\xa0\xd7\x6c\x94\x54\x17\xb6\x88\xb7\xb4\xe0\x03\x21\x95\x39\x96\xe4\x8d\xc5\x3f\xd2\x26\x0e\xe0\x2d\xf4\xa2\x30\xae\xb0\x4d\x50\x87\x36\x0a\x07\xf4\x3a\xa8\xc7\x65\x97\x33\x74\xd3\xab\x3c\xf0\x2a\x11\x9e\x23\x64\x68\x12\x53\xca\x84\x28\x4d\x4a\x32\x05\x69\xa2\x3f\x42\x82\x2d\x0b\xfd\x91\xa6\x49\xf6\x1d\xd7\xa7\x34\x6d\xf4\x47\x4a\x99\x98\xb1\xac\x1c\xdc\x96\x8b\xe5\xf6\xbd\x6b\xe0\x4f\xa1\xf7\xba\xdc\xaf\x7e\xf0\xae\x76\xf1\x35\x2b\xb6\x3f\xf7\x40\xe4\x8f\x46\x2e\x5c\x5b\xdb\x4f\xcb\x6c\xd2\xae\x97\x64\x39\xc6\x3d\x29\xe3\xea\x44\xc4\x1e\x74\x59\x2e\x28\xee\x69\xb9\x50\x26\xa2\xe8\x75\x97\x64\x46\x80\x3f\x29\x4f\x6b\xd8\xb7\x5d\x47\x7f\x56\xb7\xef\xbf\xda\x18\xa5\x1d\xb1\x2c\x27\x62\xe7\xa3\xb6\x86\xb4\x37\xa2\x18\x69\x17\xba\x86\x79\x65\x92\x56\x56\x2d\x93\x34\xc1\xac\x98\x74\x9e\xad\x70\x49\x2f\x35\xae\x34\x21\x1e\xba\x5a\x46\x78\x43\x7a\x46\x5f\x5c\x28\xf7\x8d\xcf\x9f\x6b\x0f\x60\x60\xda\x23\xb8\xec\x90\xe5\xda\xa2\xdc\x37\x2e\x06\xc7\x07\x98\x62\x8d\x93\x99\x85\xcc\x54\x61\xd3\x36\xeb\x32\xb2\x44\xc3\x99\x10\x69\xdb\x91\xa7\x49\x8e\x70\x16\xd2\x34\xaa\xd5\x41\xbd\x22\x4d\x93\x1b\x1b\xd6\xa0\xac\x56\xea\xf6\xa6\xbc\x18\x49\x73\x94\x95\x63\xed\xe6\xb8\xa6\xce\x9f\x96\xd9\xa4\x5d\x2d\xc9\xf2\x3a\x63\x83\x42\xd7\xb2\x44\xc4\xb1\x61\x59\x2e\xd6\xe8\x87\xd7\xb0\x32\xc5\xd8\xb0\x24\x33\x4f\x18\x1b\x9e\x65\xdf\xb8\x2a\x57\xb7\xef\xbf\xda\x18\x55\x63\x83\x2c\x39\xf9\xd8\x90\x41\xae\x36\x36\xac\x61\x5e\xca\xb1\x21\x2f\xe9\x9c\x2e\x79\xbd\x9e\x2b\x77\x6c\xc8\xc9\xc1\x0a\x63\xc3\x12\x43\x7a\x76\x8f\x2c\x1f\x21\x62\x12\xd5\x0b\xaa\xc7\x95\xeb\x08\xb3\x08\x77\x2a\xc8\x0d\xba\x61\x0d\x5a\x0d\x4b\xc8\x52\xdd\x32\x61\xdd\x92\x91\xa5\xaa\x16\x43\xd6\x68\x44\xf5\x7a\xdb\xae\x8b\x8d\xa8\xda\x68\x34\xab\x0d\x69\x9a\x2b\x0c\x12\x52\xca\x5a\xbb\x55\xaf\xb5\xa5\x94\x69\xbb\x94\x94\x63\xfd\x76\xb9\x9e\xce\x9f\x96\xd9\xb4\x81\xe5\x67\x79\xad\x41\x42\xae\x6b\x59\x22\x99\x41\x62\x49\x2e\xd6\xe9\x90\x57\xb7\x32\xd5\x20\x91\x9f\x99\xa7\x0c\x12\xcf\xb0\x6f\x52\x95\xab\xdb\xf7\x5f\x6d\x8c\xca\x41\x42\x92\x9c\x62\x90\x10\x91\x2b\x0e\x12\xab\x9b\x97\x7a\x90\xc8\x49\x3a\xaf\x6f\x5e\xab\xe7\xca\x1f\x24\xd4\x39\x58\x65\x90\xc8\x37\xa4\x67\xf7\xc8\xf2\x41\x22\x26\x51\x0c\x12\xd5\xc6\xfd\xbc\x8a\x59\xd8\xfb\x70\x14\x23\xc4\xc0\xaa\xd8\x50\xcc\x4f\x73\xd0\xb2\xcd\x0c\x53\xa2\x64\x5e\xbc\x4e\xdb\x31\x06\x15\xbb\x21\x3a\x58\x83\xa6\xdd\x6a\x64\x53\x5b\x61\x60\x90\xf2\x55\x9b\x6d\x73\x60\x65\xf9\xd2\xfb\x12\xc5\xec\xaf\xdd\x0a\xd7\xd1\xf0\x13\xf2\x98\x34\xa4\xbc\x9c\xae\x33\x12\xc8\x34\x9b\xa1\x17\xc7\x80\xdc\xc4\xd7\xe8\x6c\x57\x32\x22\x45\xd7\x9f\x97\x87\x27\xf4\xfb\x4f\x36\x5c\x52\x5f\x2b\x1a\xee\x5f\x67\x68\xaa\xbe\x3e\x93\x90\xbc\xa3\xe7\x61\xab\xf5\xf2\x2b\x99\x8e\xb2\x7f\x57\xa6\x98\xd3\xa7\xae\xda\xe9\xe4\x76\xeb\xaa\x84\x57\xe8\xd3\xf3\x8c\xe4\x79\x1d\xa8\xa2\x37\xa7\x0c\xf2\xde\x1c\x44\xa3\xb7\x47\x98\x41\xb8\x7c\x4c\x6e\xa8\xc3\x8a\x69\xd7\xc5\xec\x40\x68\x56\x6b\x4d\x09\x57\xac\xdc\x4c\xc0\x1a\x4d\x03\x5a\xed\x4d\x43\x5c\x15\x6b\xb7\x1a\xc3\x8a\x2d\x4b\x71\x85\x5e\x5d\xca\x68\x37\x5a\x0d\xa3\x2a\x63\x8c\xdb\x93\xac\x10\xeb\x36\xb8\x35\xb5\xfd\xa4\x9c\xc6\x6d\x68\x49\x7e\xd7\xe8\xdf\x15\x5a\x96\x24\x21\xf4\xf1\xcb\xb2\xb0\x7a\xd7\xba\xba\x69\xc9\x7b\xfa\x25\x39\x59\xbf\xaf\x7f\x8e\x49\x93\x1a\x5c\xd9\xa4\xff\x5a\x03\x54\xf4\xf9\xb2\xc4\xa4\xbd\x7e\x06\xb8\x52\xbf\xbf\xba\x49\xa9\xfa\xfe\xbc\x74\xd5\xbd\xef\x5a\x5d\x54\xde\x08\x90\x93\xfc\xf2\x31\x60\x89\xf1\x3c\xb7\xdb\x55\x6c\x0e\x50\x0e\xd5\xd2\xcf\xf0\xe8\x72\x40\xb6\x18\xb8\xcb\x21\x15\x26\xdc\x6e\xd4\xea\x99\x9e\xa9\x5e\x1b\xd6\x4c\x09\x57\x3a\x7d\x12\x02\xd6\x68\x34\x56\xbb\x56\xa9\x8a\x2e\xd2\x66\xd3\xb0\x8c\xb6\x2c\xc5\x15\xc6\x01\x29\xa3\x69\x55\xdb\x64\xa6\x2d\x32\xa6\x13\xed\x6c\x21\xd6\x9f\x67\xaf\xa5\xed\x27\xe5\x34\x9d\x2e\xe7\xe6\x77\xad\x15\x1f\xa9\x96\x25\x49\x64\xd6\x7b\xf2\xb3\xb0\xce\xd2\xca\xaa\xa6\xa5\x5a\xec\xc9\xcd\xc9\x53\xd6\x7a\x9e\x6e\xd2\xa4\x06\x57\x36\xe9\xbf\xd6\x00\x95\xeb\x3c\xd9\xc4\x14\xcb\x3c\x02\x70\xc5\x55\x9e\x55\x4d\x4a\xbd\xc6\xa3\x4e\x37\x6f\x65\x65\x8d\x2e\x2a\x7f\x81\x47\x99\xfc\x2a\xeb\x3b\xb9\xc6\xf3\xdc\x6e\x57\x3e\x0e\xc4\x1c\xaa\xd5\x9d\xeb\xc6\xed\x27\x4c\xe2\x3a\xde\xb5\xb0\x6f\x20\x79\x9b\x91\x7f\xc3\xaf\x92\x61\xd0\x45\x01\x33\x7f\xe5\xe4\x99\xef\x9d\xf9\xe0\x65\xaf\x6c\x09\xf9\xce\x3b\xd6\x66\xe5\x97\xb3\x96\x97\x25\x99\xaf\xf2\xe2\x64\x9a\x28\x2b\xf9\x23\x57\x7d\xec\x71\x3b\x6b\xd1\x27\x17\x49\xd7\x1a\xd5\x4d\x2b\xf3\xf6\xde\xcc\xb3\x61\xe0\x3a\x9e\x64\xc4\xcf\x4b\x72\xc5\x59\xfb\x2a\xd9\x5c\x71\x3a\x9e\x53\xb4\xe4\x30\xa1\xec\x5b\x89\xf5\xab\xd6\xcd\x3e\x89\x36\x42\x29\xb7\xb7\xef\xcf\xf0\x23\x3e\x24\x06\xb8\xa3\xd7\xdc\x8b\x72\xec\x75\xa8\xe9\xed\xf5\xc9\x35\xfa\xc2\xeb\xab\xf4\x24\x25\xc1\xb6\x9b\xe4\x5d\xcb\xca\x91\x33\x25\x6b\xa8\x13\x94\x30\xdc\x3d\xda\x66\x12\x0e\x27\xd2\x84\x1b\x28\x5d\xe1\xda\xd5\x6a\x26\xdd\x86\x90\x62\x8d\x1c\xef\x71\xbd\x7d\xbb\x8b\x93\xb8\xc3\x1d\xce\x9b\xea\xf9\x1d\x93\xe2\x5d\x28\x2f\x2a\x79\x47\xf2\x29\x09\xd6\x26\x8e\x45\xfa\x11\xfc\x4e\xb3\x70\xd1\x2a\x77\x92\xa3\x00\x7d\x99\x8d\xcc\xdc\x25\xdc\x98\xde\x2d\x1c\x6f\x3a\x8b\xbe\x46\xf7\x53\xd8\xd3\xc2\xd9\x60\xe2\x44\xda\xf7\x4c\x24\x9d\x45\x05\x30\x84\x4b\x41\x83\x59\x14\xf9\x9e\x04\x25\x1c\x8a\x7d\x1f\x1c\x1e\xa5\xba\xd3\xcb\xf0\xe3\x87\xfb\x0f\x8c\x32\xe3\x37\xb1\x25\x17\xb7\x49\x5f\xf7\x96\xbe\xb9\x9d\x4d\x88\xad\x21\x75\xa2\x7c\x3d\x66\x32\xc0\x9f\xaf\xa9\x4e\x20\x6e\x8e\xab\x24\x93\x36\xdd\x1c\x3e\xda\x9b\xad\xc4\x97\xf4\x7c\x39\x7c\x71\x0f\xbf\x12\x61\x3a\x1c\xa8\x19\xc5\xa1\x63\x15\x62\xe1\xd5\xe5\x07\xe0\x78\x36\xbc\xeb\x54\xb3\xba\x65\xdf\x07\x7e\xc9\x55\xe4\x12\x64\xc6\xda\x24\x78\x41\xb2\x94\x3f\x8b\x17\x24\xc2\xbd\x9f\xb8\x41\x1b\xe7\x9f\x9b\x64\xdf\x27\xf2\x7d\x77\x60\x06\x8f\x92\x4b\xc4\x33\xa0\x02\x9f\x17\x59\x78\xb6\x84\x52\x94\x3b\x9a\x7e\x01\xb8\xb9\xd2\x3c\x72\xa6\x9c\x89\xf2\x7a\x49\xc2\xaf\x57\x4a\xf8\xb5\x2c\x61\xee\x54\x40\x7a\x78\x92\xda\x50\x3d\x3f\x2a\xb2\x9f\x3c\x95\x88\x24\xfd\x46\x84\x08\x14\x4e\x76\xe9\x51\xe2\x1b\xe5\x35\x33\xe6\xdb\x2a\xfe\xa8\xbe\x95\xe3\x3d\x2d\x7f\xf4\x48\x29\xfc\x41\x50\x92\x59\xe6\x70\x3a\x21\x64\x49\x76\xd2\xd4\xb3\xfa\x93\x36\x63\x69\xe6\xb2\x71\x85\xdc\x22\xd5\x48\x33\xcb\x05\x48\xf3\x2a\xb6\x98\x25\x1d\xab\x20\x59\xc1\x2a\xb8\x8e\x7c\x15\x23\x10\x93\xc8\xab\x52\x85\xb2\xe5\xaa\x5d\x8f\x57\x5a\x13\xbf\xd2\x4a\xc4\xfc\xe4\xd9\x8a\xb2\x6d\xfc\x1a\x3b\x28\xa8\x0c\x4f\x35\xe0\x30\xd3\x6a\x55\xdc\xf4\x03\x98\xfc\x36\xf2\x52\x15\x9f\xbb\x0d\xb6\x95\xb9\x0c\xb6\xa5\xe8\xb1\x58\x67\x78\x35\x6e\xec\x0b\x0a\x37\xcd\x56\x65\xec\xab\x94\xfa\x5f\xf1\x59\xcf\xfa\xd9\xca\x4c\x09\x57\x9f\xf5\x25\x15\x55\x28\x5f\x0e\x3f\x9f\x5e\x01\xcb\x0c\x60\x94\xe9\x94\x59\xb5\xf3\x48\x6a\x86\xf4\xb0\x70\x5a\xac\x4a\x57\x76\x84\x5f\x65\x51\xbe\x3b\x39\xac\x7f\xc6\xd9\xc7\x66\xb9\x22\x6d\xa5\x40\x89\x17\x2b\xf9\x3b\xb9\x5e\xd1\xca\x7e\x71\x16\xfb\x5a\xf6\x49\x24\x71\x8e\x99\xb3\xf8\x94\x47\xbc\xe6\x66\x3e\x2f\xa9\x94\x7c\xb5\xf2\xbf\x5c\xdb\x05\x97\x79\x70\xab\x67\x76\xe5\xf4\x56\xf6\xe8\xd0\x14\x0a\x39\x74\x5d\xc1\x0e\x57\x72\xa3\x97\x0f\x5a\xd9\x81\x6a\x35\x87\x3f\x67\x48\x79\x54\x8d\x0e\xf5\xe9\x9d\xaa\xab\x66\x82\x72\x47\x1c\x59\xf7\xbf\x62\x96\xf3\x46\x1d\x65\x96\xd5\x63\x4b\x5e\x76\xb3\xa5\x11\xca\xba\x8e\xfd\x3f\xdb\xf3\x58\x23\xa9\x27\x79\x20\xbf\x8e\xff\xe9\x9e\xc8\xda\x76\xf1\x2b\x7d\x92\x35\xac\x66\x51\x1e\x3d\x1c\xb6\x2d\x26\x4f\x3f\x66\x61\xe4\x0c\x1d\x68\x3f\x72\x27\x97\xb2\x7d\x27\xf9\x6a\xdd\x35\xef\xfd\x59\xd4\x19\x3a\x77\xd0\x66\xd6\x82\x5d\x73\x1a\xc2\x4e\x08\xa7\x66\x60\x46\x30\x87\x9f\x1f\x16\xf2\x60\x72\x4f\x59\x71\xba\x6a\x9c\xd1\xdf\xf2\x93\xce\x99\x33\x73\x8b\x35\xeb\x71\x34\xcc\x77\xed\x79\x6a\x30\x13\xe8\xcd\x98\xb3\xf3\xbf\xda\x66\x64\x52\x2b\x8a\x17\x8b\x42\xed\x3b\xab\x88\x02\xb7\xe6\x64\xda\x8e\xaf\x7d\xd7\xd5\xf1\x72\x86\xa6\x35\x99\xe4\xf1\xac\x31\xb4\xae\x07\xfe\xdd\x2f\xc9\x44\x4a\x96\x2e\x2b\x99\x83\xd0\x77\x67\x11\xec\x5a\xae\x33\xed\x04\xd0\x8a\x8a\x15\xbd\x40\xff\x5f\xea\xca\x3f\x2d\x36\x5a\x17\xb5\x43\xe0\x99\xb7\xe2\xa1\x39\x5d\xce\xbb\xac\x74\x5d\x27\x8c\xe8\x99\x3d\x62\xd4\xd7\xae\x93\xb3\xba\x86\x3d\x07\x01\xfe\xda\x5c\x16\xa1\xcb\x2f\xf0\xd2\x05\x0d\x8e\x22\xdd\x58\xe2\xc5\x64\xc1\x59\xb6\xca\x2c\x7b\x21\x07\x8a\x45\x11\xb7\x87\x5e\x9b\xcc\xe2\xf5\x52\xac\x34\x53\x12\xd8\x4a\xab\xe2\xb9\x0b\xfd\xdd\xec\x87\xec\x6c\xee\x0a\xec\xa6\xa1\xa9\x2b\x43\xb2\x19\x16\xc2\x55\x3b\x57\x10\x8a\xaf\x2f\x90\x2d\x25\x3e\x13\xfd\xda\x8f\xf9\x18\xa2\xdf\xc0\x76\x6e\x1d\x1b\x06\x8f\xf1\x5a\x75\xe2\xf7\x74\xda\xd8\x97\x8e\x4f\x86\x8a\x4f\x8b\x92\x24\xd9\x40\x7f\x32\x86\xf0\xda\x99\x8c\x1e\x53\x27\x34\xde\xe8\xb1\x9c\xab\x63\x9c\x70\x64\x0e\x42\xe1\xe0\x8f\xcc\xb1\x52\x3c\x1a\x59\x74\xba\x86\x20\x1c\x01\x42\x97\xe0\x32\x31\x5e\x9b\x71\x1b\xa2\x17\xc6\x2c\x3b\x44\x43\x71\x56\x47\xe6\x84\x8d\x02\xfa\xaf\x52\xa8\xc8\x13\x8d\x0f\xf2\x16\xde\x24\x81\x05\xf2\x97\xa2\x78\xc2\xa9\xcb\xc8\x40\x96\x62\xd2\xa5\xe5\xa5\x48\xce\xbc\x1b\x8d\x46\xee\x29\x05\x42\x6d\x08\xe3\xbe\xd2\xf0\xe9\x87\xb3\x99\xd2\x95\xfb\x35\xef\xe0\xfd\x1b\xfc\x9c\x8e\xc0\xcc\x98\x2b\x9e\x01\xb3\x12\x41\x6a\x12\x52\xfb\x52\xc5\x79\x6d\x3e\x66\x4f\x87\xe1\xed\xa9\x21\x31\x27\x39\x1d\x3e\x03\xe7\x62\x27\x19\x14\x15\xa3\x24\x9a\x56\xe0\xd3\xde\xd2\xe1\x52\x7d\xdb\xfb\xaa\x65\xcf\xf5\x0e\x56\x57\x86\x78\x34\xdb\x13\xe2\xd2\x63\x8e\xb2\xed\x64\x65\x2d\x2e\x31\xfd\xd5\xa2\x29\x5a\xc3\x8a\x91\x69\xaf\x2a\x3d\xe4\xee\xd9\x95\xf5\xda\xcc\xed\xee\xd6\xe8\x61\xfe\x2f\xd1\x20\xdf\x57\xe0\xf7\x1e\x16\xe5\x7e\xcd\x69\x5c\x5f\x62\x9a\xa9\xe3\xba\x42\xa7\x2e\x0d\x4f\x15\xc7\x59\x55\x16\xf8\xd2\x75\xf8\x5b\x80\x14\x38\x89\xa6\x96\x83\xd2\x33\x7f\x96\x43\x57\xf9\x86\x26\x1e\x96\x6b\xd3\xe1\xc5\x17\x4c\x17\x46\xa6\x75\x2d\xe9\xd3\x64\x08\xa6\xa8\xf4\xca\x14\x71\xb9\x62\x49\x7f\x2b\x07\x64\xd3\x7e\x5e\xff\xf9\x2f\xeb\x2f\x9f\xd4\x3f\xae\xd3\x1f\x1a\xad\xf0\xe4\x20\x69\x0d\x8c\x4a\xb3\xe3\x96\x0a\xba\x72\x7f\xa9\x24\x90\x18\xee\xca\x58\xc6\xa5\x5c\x35\xc6\x13\xbb\xc3\xfc\xf2\x3f\xb9\x0f\xfc\x4f\xd4\x8a\xb4\x8b\x7b\x3b\x1a\x1c\xef\x20\x1a\x60\xf9\x5e\x04\xbd\x08\x71\xdc\x18\x17\x6f\xb1\x6c\x6a\x7a\x30\xb1\x4b\xd2\xca\xa4\x11\xd8\x5d\x73\x71\xd6\xc6\x77\xcd\x8a\x06\x24\x2e\x67\x3e\x65\xed\xc4\x33\x1a\x87\x1e\x98\x9a\x23\xc7\xc3\x13\x20\xf9\x61\x7c\xc2\xa4\x94\xce\x1f\xaa\x15\x76\x31\x9e\x35\x70\x91\x95\x6d\xa9\x84\x58\x8a\x41\x35\x2b\x13\x87\x53\x33\xff\x3d\x8e\xec\xa9\x7a\x8a\x19\x80\x74\xba\xb7\xc2\x27\xfd\x0a\x97\x39\xfb\x8a\x80\x24\xfb\xdc\x75\x59\xf2\x12\x72\x10\x5c\x5a\xae\xaf\xcf\x5f\xf9\xcc\x59\x00\x96\xe7\x87\x39\x9a\x51\x91\x1d\x06\x81\x73\xb3\xda\xc2\xac\x6c\x75\x5a\x51\xcf\x71\xab\x54\xd5\x76\x5e\xb8\x19\xbf\x1b\xa3\x8c\x4c\x1a\x70\xf2\x26\x4a\x97\x7f\xc1\x6f\x95\x39\x35\x9e\xbe\x65\xf8\xb3\xfd\xd0\x32\x08\xca\xce\x72\x94\x5a\x1d\x12\xba\x55\xb1\x6a\x35\xc9\x48\x79\x95\xd5\xba\xeb\x9f\x69\x44\x85\xe2\x2c\x51\x96\x3a\xbf\x2a\xa3\xd6\x51\x16\x97\x57\x78\x09\x3a\x47\x03\xc2\xca\xd0\x4a\xa0\xd5\x53\x97\xac\x34\xad\x72\x48\x15\xea\x53\x64\xeb\x4b\x07\x8d\xfd\xfa\x88\x49\x10\xb8\x23\xda\x5d\x2a\x42\x48\x8f\xf9\xa4\x97\x35\x55\xa9\x89\xdd\xd8\x0a\x28\x49\xdf\xc1\x76\x50\x4d\x75\xdf\x85\x5f\x11\x55\xa4\xc0\x77\x5f\xcb\x41\xcb\x7a\xb0\x66\x4e\x0f\x46\x5f\x55\x0d\xae\xaa\x37\x6c\x1a\xe1\x84\xaa\x5f\x15\xc4\xe9\x7f\xc5\x77\x56\x95\x09\x89\x9a\x5f\x05\xb6\x44\xf5\x35\xb5\xea\x6b\x39\x45\x16\x74\xbf\x02\x6a\x99\xf2\x6b\x39\xca\xc7\x39\x19\xdc\xf6\x0f\x7d\xec\x53\xb9\x8f\xd9\x5b\x4c\x57\x1d\xad\x73\x17\xfa\x64\xaf\xb1\x57\x0a\x28\xb2\x21\xbe\x44\x51\xe1\x5f\xb8\x50\xa3\x90\x0f\x37\xbf\x3d\xf0\x48\xce\x01\xbe\x01\x33\x69\x8f\x74\xea\x64\x4c\xe6\x2d\x1a\x3e\x86\x26\x0a\x7a\xcc\x2c\xbf\x77\x55\xde\xb4\xa4\x44\x32\x05\x2e\xa9\xe5\x6c\x16\x64\xb3\x37\xf9\x26\x22\xd1\xb7\xe3\x8d\x61\xe0\xe0\xd7\x0f\x8d\xea\xc9\x09\x25\x8b\x9c\x28\xbd\x10\x00\x5f\x0c\x2a\x3f\xd0\x99\x34\x86\x66\x72\xed\x6d\x0e\x1b\x71\xfb\x33\xd2\x70\x62\xba\xae\x34\xa4\xdc\x37\xee\x6e\x76\x03\xa0\x46\xe0\x10\x05\x2f\x17\x3b\xd9\x8c\x60\xf2\x77\x13\x0d\x1a\x34\xc6\xd0\xf7\x23\x18\xc8\x2a\x4f\x71\xa5\x9e\xfa\xc2\xda\xbc\x3d\xf0\x5a\xee\x1e\xb8\xd8\x5a\x50\x09\x6e\x0f\x3f\x5c\x02\xbc\x85\x44\x5f\xbb\x10\xc2\x9b\xd5\x73\x87\x96\x21\xde\xf7\x94\x44\xcb\x5e\xec\x90\x9f\x4c\xa1\x1c\x8c\xbf\x1c\x7c\x64\x24\xc0\x89\xe0\xe4\x89\xa9\x2b\xd8\xf8\x77\x78\x0c\xc9\x4c\x64\x69\x3e\xd9\x0e\x53\x91\x0a\x7f\xde\xff\x93\xf2\xbf\x66\x2a\xdc\x6d\xba\x4f\x6e\xd9\x4b\x0a\x9e\x76\xd0\xaa\x1c\xb1\x6f\x23\x3c\xad\xd8\xeb\xa4\x21\xae\xb1\xfc\xd2\x56\x20\xe9\xe4\x5e\x3e\xd7\xfa\x9e\xfd\xce\x82\x2a\x57\xcf\x4b\x3e\x79\x13\x2e\x43\xf4\x52\xda\x63\xc9\xa2\x72\xca\x63\xae\x2b\x11\xed\x60\x85\x1b\x4e\x56\x33\x9d\xdc\x2b\x64\x54\xb9\x29\xd0\x3b\xaf\x9f\x90\x2b\x55\xd4\xa5\xb9\x2b\x88\xb7\xb8\x93\x37\x40\x1b\xd9\x37\x40\x1b\x19\x2b\x64\x68\xf2\xfa\x14\x79\xf6\x39\xcf\x4e\x45\xa5\x34\xc3\xb5\xfa\x0a\x05\xf9\xd2\x7b\xc0\x7f\x61\x41\xd6\x4d\x4b\x49\x83\x9c\xae\x7f\x55\x96\x97\xa4\xf5\xa8\xae\x80\x95\xdd\xdf\xa7\x2b\xac\x20\x5e\x5c\xf3\xaf\xab\xab\xfc\xa4\x9f\xaa\xce\xbf\xb2\x40\xcf\x4a\xfa\xe9\x6a\x1a\xff\xfb\x6a\x28\x2f\xe9\xa7\xab\xe9\xaf\x2b\xd0\x7a\x49\xe7\x34\xbd\x5f\xd8\xbc\xd4\xfe\xd2\x5f\xdd\xba\x94\x29\x3f\xc7\xc2\xff\xa2\xe2\x3c\x27\xe5\xe7\xd8\xf7\xbf\xab\x76\x72\x52\x7e\x8e\x75\xff\xbb\x6a\x67\x2c\x71\xd9\xd7\x1c\xb6\xd6\xce\x3a\xb3\x96\xa4\x20\x5a\x71\x79\x69\xa5\xc9\x82\x3c\x05\xaa\x1a\x56\x10\xfc\x15\x25\x59\x2f\x1d\x15\x07\xf2\xed\xff\x15\x79\xcd\x4d\x67\xb5\xb5\x47\x45\x95\xfd\x8a\x4a\x79\x9a\x8b\xf0\xec\x3a\x5a\xdd\x3d\x58\x59\x95\x7f\x5d\x49\x9e\x91\xec\x13\x95\xf3\x14\xa7\xe0\x17\xd4\xc9\xaa\x1e\xce\x1a\xca\xf9\xab\x4a\xb2\x4e\xb2\xf9\x8d\xec\x57\xb5\xa2\x7f\x7d\x47\xb7\xb2\x1f\xb0\x96\x31\xff\xeb\x3b\xc1\x27\x95\x63\xa9\x29\xff\x3b\xea\x63\x45\x47\x66\x2d\x43\xfe\x77\xd4\x87\xcc\x83\x59\x6d\x10\xca\x6c\xe7\xbc\xcc\x5b\xe9\xca\x82\x15\x85\x52\x2b\xf3\x65\x96\x65\x35\x3d\x49\x22\x3e\x2a\xf7\x1b\xd4\x9d\xc4\x2a\xfe\xa0\x3a\xf7\xab\xf8\xfa\xdc\x52\x77\x66\xd5\x56\x72\xbb\xe8\xaa\x8b\x7d\xcb\xee\x47\x5e\x9e\x92\xfa\x4e\xe4\x67\xe4\x60\x65\xd2\xb5\x2e\x57\x7e\x56\x86\x56\x24\x5d\xeb\x96\xe6\x67\x65\x68\x45\xd2\xb5\xae\x7b\xfe\x35\x55\x96\x4f\xba\xd6\xbd\xd1\xbf\xa6\xca\x9e\x90\x21\xf9\x05\xd4\xbf\xaa\xca\x72\x6f\xb5\x5e\xb3\xcd\xad\x3b\x38\x3c\x93\x73\x9d\x1b\xb1\x7f\x55\x83\x5b\x37\x3b\x8a\x9b\xb5\x7f\x55\x73\x5b\x37\x3b\x8a\x1b\xba\x7f\x55\x63\x7b\x72\x65\xfd\xc2\xec\xac\xc6\xb9\xfa\x8d\xe1\xbf\xae\xa1\x65\xbd\x98\xe4\x16\xf2\x35\xea\x8e\x1f\x9e\x7f\x51\xe5\x2d\x23\xcd\xbd\x7c\xfc\x97\x66\x68\x45\xd2\xd5\x0b\x23\xfa\x3b\xbf\x44\x43\x59\xd2\xd5\x0b\xf3\xdc\x0c\xc9\x49\xb3\x5f\x3c\xac\x93\x41\xee\xe2\xf7\x5f\x94\xbf\x7c\xce\xd5\xef\xa1\xff\x35\x0d\xf0\x29\xd9\x51\xdc\xf5\xff\xcb\xb5\xb3\xa2\x35\xc9\x4a\xf2\xdc\xec\x48\x39\x97\xda\x92\x34\x8d\xc4\x57\x17\xdf\x1a\x5b\x94\xfb\xd5\x8b\xd6\xe5\x29\x9d\xdb\xc8\x5e\x4f\xaa\x56\xc8\x27\x89\x22\xac\x90\xf3\x2a\xa1\xe2\xab\xc6\x5c\x8a\x97\x32\xbe\xf8\x74\x4e\x69\x64\xe9\x1b\x7f\x92\xaf\x62\x57\x8b\xa9\x7e\x87\x25\x3b\x6d\x7c\x3e\x67\xe6\x9d\xb0\x9c\x19\xa5\x34\xb5\xdc\xb7\x4f\x94\xe5\xce\xc6\x52\x64\xb1\xa0\x9e\xf4\x2a\xbf\x3d\xb7\x9d\xb7\x26\xc5\x27\xb7\x3c\x67\xbf\x02\x90\xe1\xe4\x6f\x15\x2d\xb9\x1e\x9e\x7b\xf3\xef\x69\x29\xac\x5e\xe7\xec\xf6\xcc\xd3\xd2\x52\x5c\x7e\x40\x4b\xa1\xb8\x1c\x59\xce\xbe\x72\x2d\xe6\x14\x84\xff\x0e\x8b\x94\xc5\xd8\x6f\x54\x23\x8a\x4d\xee\x62\x95\x7c\x94\x20\x87\xe6\x57\xe2\xda\x5f\x3e\xac\x93\xc8\xb3\xea\x71\xfd\xe4\x9e\x76\x85\xa9\x94\xfd\xd7\x57\x65\x5a\x9c\xc9\xee\xa8\x4a\xe1\xc9\xa5\x89\x7c\x6b\x69\xc2\xb6\xd5\x94\x43\x97\x34\x49\x6b\xb3\x59\xb3\x65\xf7\x6f\x0c\x87\x15\xbb\x25\xb6\xca\xb5\xd3\x79\x5e\xc3\x5c\x3b\x39\xc5\x81\xf4\xb4\x2c\x59\xab\xc5\xa5\x57\x26\xf0\x17\x34\xcf\xb8\x44\xd5\xe0\xd3\x36\x1d\x22\xe9\xcd\x68\x9c\xa2\x07\x16\x6c\x0d\x0d\x09\x6e\x49\x6d\x1a\x9b\x95\x96\xfc\x36\x15\x68\x0f\xc5\xe6\xb9\x66\x22\xcf\xaa\xca\x35\xd3\x52\x5d\x2c\x40\x4a\x21\xb9\xa2\x10\x95\x5b\xce\xfe\xeb\x2b\x31\x29\x8b\xe1\x3f\x5c\x7f\xa2\xf0\xe4\xb6\x23\x4e\xc5\x43\x13\x0e\xf0\xfd\xfa\x12\x68\x7e\x55\xb6\xcc\xa6\x5d\x1b\xc8\x3a\x21\x6b\xd8\x82\xb5\xee\x73\xd3\x79\x56\x6d\xae\x9f\x9c\x62\xd0\xa4\x65\xc9\x14\x92\x94\x5e\x99\xc0\xaf\xaf\xd3\xa4\x44\x35\xfb\x4b\x15\xc4\x03\x35\xbd\xb8\x84\x53\x35\xc2\xd9\x86\x14\x99\x5f\xa3\x66\xbb\x5e\xaf\x57\x65\x35\x5a\xb5\x61\xe6\x06\xbe\xb5\x93\x79\x56\x85\xae\x9d\x9a\xa2\x3e\x69\x49\xb2\x17\xd1\xe0\xb2\xab\xf8\x7f\x7d\x75\x26\xe5\xa9\x7e\x6a\x5c\x58\xc0\x72\xa1\x19\x0c\x9d\xbb\xce\x00\x0e\xfd\x00\x1f\xbd\xcb\xcb\xcd\x61\x44\x4e\xfe\xd8\xf9\xe2\x4f\x80\xed\x82\xb1\x1f\x38\x0f\xbe\x17\x99\x6e\xc1\xb6\x99\x68\xf2\x70\x1a\xfd\xdd\xf0\x62\x78\x85\xbf\x64\x37\x1d\x0f\x06\x49\x34\x51\x1e\xa7\x66\x9c\x8f\xf6\xce\x52\x39\x18\xba\x33\x87\x49\x4c\x1e\x1c\x47\xae\x7d\xfe\x31\xba\x00\x81\x3f\x4f\x23\xa4\xa2\x34\x05\xe1\xcc\x72\x96\x5d\x0c\xa2\x91\xd6\x38\xc9\x30\x26\x5b\x23\x4a\x92\xb3\xf8\xc8\x2c\x26\x47\x89\x28\xa9\x0e\xa1\xb2\x99\x8a\x10\x43\x70\x94\x47\x7a\x8a\x40\x47\x2b\x68\xfc\xf9\x81\x59\x53\x58\x52\xe5\x8a\x2a\xcd\xaf\xba\x6c\xdd\xa8\x2b\x62\x7d\x6d\x67\x55\xa7\xd4\x13\xd5\x06\x2a\x6b\x67\xe0\x47\x63\xd4\x93\xc2\xe1\xa7\x06\x20\x27\x96\x48\xaf\xdd\x60\xbf\xac\xc7\xc7\x8f\x70\xe7\x75\xe0\x63\x48\xca\x7d\x63\x67\xbe\x7f\x0a\xa6\x33\xd7\x25\x72\x7a\x66\x0a\xfe\x5d\x78\xe1\x4c\xa6\x7e\x10\x99\xf8\xd6\x97\xda\xe7\xcf\x97\x1f\x08\x12\x51\x32\x47\xdd\x70\xb8\x89\x05\xbf\x7c\x04\x63\xc7\xe6\x8f\x86\x10\xb8\xb6\x6f\x8e\xef\x40\x38\xf6\xe7\x7c\x9e\x79\x54\xf5\xcb\xe8\xc7\x67\xe0\x78\xb7\x4e\xe8\x0c\x5c\xf8\x88\xff\x75\x5c\x27\xba\xa7\x47\xb8\xa1\xfc\xdf\xb7\x2e\x27\x00\x9f\x76\x80\xd3\x1c\xfa\x5e\xd4\xa9\xbc\xaa\x14\xcc\x6e\xf6\x60\x2f\x0c\xe3\x0e\x72\xce\x3b\xff\x2e\xdd\x46\xed\x1b\x8d\xc9\xc4\x05\x24\x51\x75\xb1\xc0\xfb\x87\x0f\x3f\x80\x39\x1c\x3a\x77\xe9\x21\x0e\xf8\xcc\xcd\xc5\xff\x01\x93\x10\xdc\x3a\x70\x8e\xc0\xf4\xec\x1a\x1b\xde\x3a\x16\x24\xdf\xa3\x20\x9d\x1c\xff\x98\xdc\x01\x5a\x56\x7c\x71\xcb\xed\xdb\x91\x05\x13\x09\xbe\x3c\xa6\x76\x73\xfe\xa5\x9d\x88\x26\xb6\x5e\x8e\xae\x5b\x93\xcb\x44\xe2\x8e\x72\xb4\x6e\xec\x8c\xcd\x6b\x26\x05\x7a\x2b\x4a\xb9\x5f\xfd\xf8\x1e\x9c\xb3\x01\xe4\xe8\x0a\x64\x9a\xc1\x8f\xf9\x97\x6c\x48\x1a\xd3\x7a\x37\xfd\xc1\x64\x31\x09\x30\xea\x47\x5b\x77\x6c\x40\x4a\xb9\xbf\xb5\x0d\xb3\x21\x69\xcc\xc1\x66\xe5\x23\x53\xc4\x34\xad\xb7\x1f\x83\xcf\x6c\x40\x4a\x79\xb8\xfd\x51\x12\x12\xc7\xbc\x7f\xd8\x1e\x1d\x30\x2a\x4a\x93\x7a\x63\x37\xaa\x6c\x40\xc2\x58\x1d\x6f\x4f\x8e\xb2\x21\x42\x3b\x13\x55\x9c\x9c\x24\x93\x9c\x15\xb8\xd9\xdc\xa4\xa7\xfa\x08\xb5\xab\xb6\x7b\xdc\xbd\xe5\x44\xc0\xe1\x5c\x84\x60\x19\x1a\xf5\x5c\x5c\x8c\xb1\xc4\xdc\x22\x7b\x29\x8d\x05\x5d\x97\xe5\xc9\x2b\xb0\xdc\xd8\xd4\xc5\xce\xe3\x52\xd8\xa7\x70\xd2\xca\xca\x39\xcb\xb3\x69\xe9\xb1\x30\x72\x66\xe1\xb8\xa0\x82\xe9\xd9\x6c\x7a\xed\xb6\x81\xd3\x13\x5b\xf1\xb2\x9a\x57\xe2\x65\x15\x9f\x0f\x96\xd4\x7b\xa6\x4f\x89\xec\x65\x24\xea\x5a\x5f\x51\x03\x8a\x5e\x62\x15\x5b\x58\x35\x05\x45\x77\xb3\x92\x85\xac\x9c\x46\x5e\xc7\xf5\x14\xbb\x69\xb7\xab\x92\xf4\x0c\xa3\xdd\xa6\x7d\x86\xd0\xd9\x2f\xef\x33\x54\x11\xe4\x7d\x46\x2e\x5a\xda\x67\x88\xa3\x0f\xee\x33\xf2\x69\x56\xb0\x9e\x65\x7a\x90\x8f\x08\x6b\x99\xcf\xb2\x24\x14\x63\xcb\x7a\xf6\xb3\xb4\x1c\x79\xc3\xd4\x53\x0c\xc8\xa8\x56\x2a\x98\x5a\xe9\x04\x28\x2c\x45\x89\x97\x19\x4a\x3e\x58\x62\x27\x62\x04\x64\x26\x4b\x48\x56\xb0\x92\xa4\xb0\xf2\xe1\x7c\x2d\x73\x48\xb8\x54\x2e\xc0\x7a\x15\x9f\xd2\xad\xe6\x37\x2c\xaf\x62\xc9\xa8\x75\xe3\x7e\x7c\x4f\x5d\x50\x76\x64\x16\x7d\x90\x27\xf4\x6b\x9f\xac\xe3\x56\xcc\xcc\xf4\xfe\x2b\x30\x2f\xb1\xf8\xf3\xfd\x83\xeb\xdd\x98\x99\xe9\x19\x56\x60\x66\x54\x3a\xff\x6c\xee\xc4\x24\x39\xfe\x2d\x72\xa1\xdf\xec\xfc\x18\x24\xda\x9f\x06\x8e\x17\x2d\xf3\xd5\x08\x28\x3f\xaa\xb2\xbb\xcd\x89\x23\xef\x71\x97\x45\x90\x76\xba\xd9\x48\xa4\xdf\x5d\x4a\x96\x69\x54\xe5\x7e\x6d\x64\x5f\x5a\x7c\xa4\xd5\x7c\xda\x44\x4f\x4b\x09\xb2\x56\x5d\xee\x57\xf7\xb6\xdf\x0f\x85\x58\x42\x43\x5b\x92\xee\x0a\x0c\x92\xa6\x5a\xee\x57\xfd\xfb\x0b\x31\xbf\xeb\xf8\xf2\x49\xfa\xab\xf2\x2c\x6b\xdb\x94\xf0\xee\x87\x65\xbc\x8b\xad\x3a\xdf\x52\x17\xbf\xeb\x9a\xf6\xbd\xa4\x47\x65\xd7\xb7\x4c\x37\xec\x3d\x7a\xe6\xed\xc0\x0c\x3a\xda\xdd\x03\x30\xb7\x00\x79\xd2\xf4\x41\xe4\x75\xb4\xf4\x3c\x7f\x4d\xc7\x57\x00\x75\x34\xe6\x3e\x20\x4d\x27\x77\x06\x75\x34\xf6\x06\x21\x4d\x77\xcd\x01\x74\x51\xe4\xd1\xf5\xbb\x33\x80\x9f\x34\x9d\xbc\x73\xae\x31\xef\x3a\x6b\xba\xc6\xbf\x0b\xa1\xa1\x38\x92\x77\x24\x68\xe4\x2d\xfa\xa8\x44\x69\xce\x64\xc4\xbc\x0d\x81\xe8\xaa\x0f\xa7\x51\x08\x04\xb9\xee\x4c\x46\xa7\xe9\xfb\xf1\x2a\x14\xa1\x43\xd3\x79\x92\x35\xa3\x6e\xcc\x2f\x01\x2b\xc4\x44\xe4\xb7\x3c\x1c\x53\x44\xe3\xd9\x64\xe0\x99\x8e\x8b\xcb\x37\x39\xbe\xd8\x02\xbc\x18\xd1\x9c\xc7\x4f\x2a\x0c\xa6\xb2\x9c\xc0\x72\x71\xc1\x8c\xe9\xbb\x77\x13\xc0\xc8\x10\xc9\x36\xfe\x29\x0d\xd5\xc2\x00\xf8\x9e\x7b\x8f\x95\x32\xb5\xc1\x17\x10\x0b\xf4\x30\x38\xf2\xdc\xfb\xac\x3c\x8e\x42\x6e\x83\xc6\x35\x86\x40\xfe\xe9\x7c\x06\xb2\x41\x94\xe6\x6d\x2c\xc8\x83\x8e\x8d\x8e\xf6\xd6\xda\xd9\x6a\x83\xb1\xa1\xe9\xe3\x2a\xc2\x9e\x46\xbb\x2d\x30\xae\x6a\xfa\xb8\x86\x1e\xf7\x1e\xda\x57\x60\x5c\xd3\xf4\x71\x1d\x3d\x56\x3e\xed\x56\xc0\xb8\xae\xe9\xe3\x06\x52\x90\x33\xaa\x7f\x06\xe3\x86\xa6\x8f\x9b\xa8\xb4\xfb\x97\x03\x07\x8c\x9b\x9a\x8e\x4f\x1d\x42\x92\xf4\x0c\x22\x4d\x77\xa1\x69\x77\xb4\xad\x73\xff\x60\x02\xd0\x6f\x4d\x9f\x98\xc1\x35\x42\x6d\x7f\x1a\xba\x00\x3d\x20\x53\x84\x77\x11\x5e\x8a\xc2\xda\xb5\x77\xc7\xef\x40\x2a\xd2\xd1\xcf\x03\x38\x8c\x64\x61\x24\x2a\x5e\xe3\xc2\x71\x6b\xe0\xf4\x00\x30\x32\x1c\xf9\x14\x2f\x93\xc9\x42\x49\x74\xb2\xf2\x86\xe3\x57\x4f\xae\xaf\x01\x2b\xc4\x04\xdb\xf8\xb7\x3c\x9c\x50\x90\xa3\x59\x49\x0d\xef\x6f\xbd\xf7\x01\x27\xc5\x24\xef\xc9\x83\x02\x41\x68\x3c\x7f\x1e\x98\x53\xcc\xb2\xd3\xaa\x79\x80\x15\x62\x92\x8f\xf8\xb7\x3c\x9c\xaa\xd1\x9f\xc3\xc0\x32\x43\x6c\x30\xb5\xe9\xfe\xc1\x25\x10\xe4\x44\xa1\xf1\xa3\x12\x45\xe8\x66\xd3\x69\x42\xb7\xbb\xf7\xce\x7e\x0b\x04\x31\x66\xfb\x14\x3f\xaa\x40\x54\xd1\xe6\xd4\x89\x4c\xd7\x79\x40\x6c\x1f\x3f\x1c\xfb\x0f\x40\x94\x13\x7d\x27\xcf\x4a\x18\x21\x9c\xcc\x22\xdc\x3f\x9c\x1f\x1c\x3a\x14\x44\x44\x98\xe6\x10\xfd\x94\x05\x92\xc8\xf4\x0d\x01\xad\xa3\x4d\xc0\x45\x64\x00\x4e\x88\x09\x8e\xc9\x83\x1c\x40\x48\xe8\x96\x34\x36\x9e\xd1\xfb\x93\x1d\xc0\x49\x31\xcb\x19\x79\x50\x20\x08\x8d\xe3\x0d\x7d\x5c\x61\xde\x87\x77\xef\x41\x2a\xc2\x04\xfb\xde\xd0\x97\x85\x91\xa8\x74\xef\x0d\xe7\xe0\xa0\x75\x6c\x01\x4e\x8a\x09\x2e\xc9\x83\x02\x41\x68\xc8\x96\x0f\x36\xbd\x13\x78\x73\x07\x58\x21\x26\xd9\x21\x77\xe5\x4b\xc3\xb5\xc1\x88\x51\xe7\xc5\xbb\x56\xf3\x0c\x30\x22\x7d\x30\x4a\x54\x99\x0d\x44\x91\x53\x35\xbe\x1d\x57\xda\x26\x60\x44\xfa\x60\x94\x68\x30\x1b\x88\x22\x27\xca\xdb\x01\x0d\x1f\xc4\x02\x7d\x30\x8a\x15\xc7\xcb\x51\x14\x46\x69\xd5\xda\xd5\x47\x0f\x30\x32\x7d\x30\x4a\x15\x96\x0d\x45\xd1\x53\x65\x19\xa3\xe6\xe6\x1b\x90\x8a\xf4\xc1\x28\x51\x54\x26\x4c\x9b\x9a\x23\x88\xf7\xe9\x48\xe4\x9a\x79\x7f\x30\x06\xac\x50\x47\x0f\x7b\xf8\xb7\x3c\x5c\xc3\xef\xb3\xcd\x3c\x7c\xbf\x0e\x1e\x1a\xab\xd1\xa0\x79\x0a\x78\xb1\x8e\x1e\x3f\xd1\x27\x15\x86\x50\x11\x2f\x07\x13\x99\xc7\xce\x0c\xb0\x42\x4c\xb3\x8f\x7f\xcb\xc3\x35\x6e\x73\x86\xf4\x5b\x99\x3d\x1b\x4d\xb7\xdd\xbd\xe4\x49\x85\x71\x3c\x27\x72\x4c\xd7\x09\x27\xa8\xe0\xed\xf7\xd7\x3b\x20\x15\x21\xad\x23\x37\xec\x66\xe6\x47\x10\x04\xf0\x16\x06\xb4\x83\x3b\xba\xde\x3f\x00\x92\x30\x3d\x95\x9d\x12\x51\x2e\x5a\x4b\xf7\x4d\x70\xad\x0a\x7b\x29\x9a\x8e\x7e\x27\xe3\x48\x26\x34\xdd\x85\xd2\x84\x6d\x29\x4d\xd7\x84\xad\x28\xcc\x2f\xdb\xa3\x62\x68\xde\xe2\x3d\x2b\x25\x2e\xf0\xe7\xa8\x34\xf1\x56\x16\x4e\xc3\x05\x77\x21\x30\x10\x79\x7d\xdf\xb8\xc2\xbb\x20\x44\xa2\x5b\xbe\xfb\x39\x34\x24\x01\x38\x56\x38\xc1\xb1\xbe\xfc\xf8\x60\xd6\x40\x22\x40\x91\xce\x26\x46\x56\x8e\xe3\x4c\x6c\x92\x52\xf5\xe6\xe4\xb3\x05\x12\x09\x8a\x74\x68\x1b\x92\x00\x1c\xcb\x1d\xd1\x58\x95\x88\x52\x62\x09\x8a\x75\x30\x32\x24\x01\x71\xa9\xaa\xc4\xb0\xbc\xe9\x3d\x48\x24\xa4\x54\x55\x49\x40\x5c\x2a\x1c\xcb\x70\xaa\xb3\x11\x48\x24\xa4\x58\x55\x49\x40\x5c\x2e\x92\x56\x54\xdb\xbc\x04\x89\x84\x94\xab\x2a\x09\x88\xcb\x45\x62\xed\xb9\xb5\x7b\x90\x48\x48\xb9\xaa\x92\x80\xb8\x5c\x35\xad\xa3\x1d\x7d\x9e\xb6\xfa\x20\x11\x90\x62\xd5\xb2\xf2\xb8\x54\x35\x9c\xd2\xb6\xd7\x78\x0b\x12\x09\x29\x55\x4d\x12\x10\x97\x0a\xc5\x7a\x33\xa8\xc1\x3d\x90\x08\x48\xa1\x6a\x59\x79\x5c\x26\x92\x52\xf3\x5d\x78\x05\x12\x09\x29\x53\x4d\x12\x10\x97\xa9\x8e\x63\x9d\xbe\x89\x1e\x40\x22\x21\x85\xaa\x4b\x02\xe2\x52\xa1\x58\x43\xb3\xb2\x1f\x80\x44\x40\x0a\x55\xcf\xca\xe3\x32\xa1\x38\xe3\x69\x65\x77\x06\x12\x01\x29\x53\x3d\x2b\x8f\xcb\x84\x73\x67\x6c\xde\xb6\x6f\x40\x22\x21\x65\xaa\x4b\x02\xe2\x32\x35\x70\x99\xae\x6a\xf3\x53\x90\x48\x48\x99\x1a\x92\x80\xb8\x4c\x38\x56\x6d\xdc\xdf\x7a\x07\x12\x09\x29\x54\x43\x12\x10\x97\x0a\xc5\x0a\xc1\xe1\x75\x15\x24\x02\x52\xaa\x46\x56\x1e\x97\x8a\xa4\x34\xf5\xcf\xab\x20\x91\x90\x52\x35\x24\x01\x71\xa9\x9a\xb8\x54\x07\x07\x43\x07\x24\x12\x52\xaa\xa6\x24\x20\x2e\x15\x89\x35\xbb\x0d\xce\x40\x22\x21\xa5\x6a\x4a\x02\xe2\x52\x35\xc9\x68\x77\x39\x68\x80\x44\x42\x8a\xd5\x94\x04\xc4\xe5\xc2\xb1\x8c\xeb\x0f\x51\x13\x24\x12\x52\xae\xa6\x24\x20\x2e\xd7\x26\x8e\xe5\x5e\x19\x17\x20\x91\x90\x72\x6d\x4a\x02\xe2\x72\xe1\x58\xb5\xc3\x2f\xc3\xa4\x53\xd8\xa4\xe5\xda\x94\x04\xc4\xe5\x22\xb1\xac\xf7\x7e\x13\x24\x12\x52\xae\x4d\x49\x40\x5c\x2e\x12\xeb\xee\xe1\xb4\x02\x12\x09\x29\xd7\xa6\x24\x20\x2e\x57\x8b\xf4\x31\x77\x6f\xef\x40\x22\x21\xe5\x6a\x49\x02\xe2\x72\x91\x58\x23\xc7\xde\x05\x89\x84\x94\xab\x25\x09\x88\xcb\x45\x62\x1d\x9f\x9a\x67\x20\x91\x90\x72\xb5\x24\x01\x71\xb9\x50\xac\x83\xf7\x00\x84\x20\x11\x90\x62\xb5\xb2\xf2\xb8\x54\x6d\x32\xaf\x3d\x1a\xfe\x00\x89\x84\x94\xaa\x2d\x09\x88\x4b\x45\x62\x35\xb7\xf6\xeb\x20\x91\x90\x52\xb5\x25\x01\x71\xa9\x48\xac\x9d\xb1\xfd\x0e\x24\x12\x52\xaa\xb6\x24\x20\x2e\x55\x9b\x2c\x8d\x34\x83\x7d\x90\x48\x48\xb1\xda\x92\x80\x64\x24\xae\x68\x1d\x6d\x3a\x71\xb6\x3e\x83\x54\x42\x87\xe2\x8a\x24\x24\x19\x8b\x51\xbc\xb0\x71\x4d\xde\x63\xa0\x12\x3a\x1a\x57\x24\x21\xc9\x78\x5c\xc1\x66\xef\x7f\xdc\x3e\x04\xa9\x88\x8e\xc8\x15\x59\x50\x32\x26\x57\x88\x5a\xae\x2e\xbf\x80\x54\x44\x47\xe5\x8a\x2c\x28\x29\x23\x1a\xce\xef\x2f\x3d\x27\xe9\x28\x8c\xc4\xdd\x30\x24\x21\x49\x19\x51\x3c\xd7\xf9\xf8\x29\x19\xaa\x8c\xc4\xe3\x30\x24\x21\x49\x19\x89\xfb\xb0\x3d\xf9\x04\x41\x2a\xa2\x65\x34\x64\x41\x49\x19\x71\x4c\xe3\xe6\xc7\xd9\x0e\x48\x45\xb4\x8c\x86\x2c\x28\x29\x23\x19\xda\xed\x91\x05\x40\x2a\xa2\x85\xac\xca\x82\x92\x52\xe2\x98\xb5\x7b\x77\xbb\x01\x52\x11\x2d\x66\x55\x16\x94\x94\x93\x3a\x3c\xdb\x97\xa9\x0f\x15\x7b\x21\x24\x4d\x31\x28\x29\x27\x89\xf9\xf6\x63\xe0\x81\x54\x44\xcb\x59\x95\x05\xc5\xe5\xc4\xde\x2c\x8e\x3e\xbb\x6e\x1d\x9c\x00\x41\x4c\x0a\x7c\x3c\x73\x5d\x44\x23\x87\xf0\x54\x48\xe3\xde\xc9\x31\xf8\x0c\x04\x31\x43\x65\xa8\x20\x3c\x15\x36\xd0\xda\x65\x75\xaf\x0f\x04\x39\xc3\x55\x51\x62\x38\x32\xdc\x9a\x8d\xd6\xcd\xfe\x18\xf0\xe2\x94\xaa\xad\x42\x70\x44\xa8\xb3\xdb\xde\x1d\x4d\x5d\xc0\x4b\x53\x9e\x96\x02\xc0\xd1\x90\xb1\xe0\xd3\xc5\x21\x9f\xda\x26\xc3\xb3\xa9\x42\x70\x44\x64\xb0\x3c\xde\x1d\xdf\x02\x5e\x9c\x12\x35\x55\x08\x8e\x08\x79\x13\x17\x77\x6f\x6f\x2f\x01\x2f\x4d\x79\x1a\x0a\x00\x47\x53\x27\x95\x76\x33\x8d\x00\x2f\x4e\x79\xea\x2a\x04\x47\x54\x23\x0b\x73\x5f\xa0\x0d\x78\x71\x4a\x54\x53\x21\x38\x22\xd2\x1e\xa7\x20\xe2\x4d\x97\x35\xee\xaa\x0a\xc1\x1b\xa4\xd6\xd1\x4e\x77\x76\x6f\x04\xbb\x65\xad\x51\x01\xe0\x68\x48\xbf\xfb\xd6\xb8\xe4\x53\x63\xad\xba\xa2\x42\xa4\x44\xe1\x98\xb6\x7a\xe3\xcd\xf1\xed\x3e\x10\xe4\x31\x55\x38\xc6\xcd\x5f\x8e\xe1\xc9\x70\x57\x59\xdb\xde\xac\xed\x00\x41\xce\x90\x19\x4a\x0c\x4f\x86\xca\x38\x6e\x34\xf7\xee\x80\x20\x66\xb8\x2a\x2a\x08\x47\x45\x1a\xee\x71\xbb\x76\x0c\x78\x71\xca\xd4\x56\x21\x38\x22\xe2\xdb\x7c\x1c\xcc\xe7\x80\x17\xa7\x44\x2d\x15\x82\x23\x22\x8e\xe6\x87\x1a\x7c\x03\x78\x71\x4a\xb4\xa9\x42\x70\x44\xc4\xa7\xf6\xb6\xac\x73\xc0\x8b\x53\xa2\xa6\x0a\xc1\x11\xd1\x89\x00\x8c\x78\x0d\x34\x18\xa2\x86\x0a\xc1\x11\x91\x59\xdc\xfe\xcd\xcd\x25\xe0\xc5\x29\x51\x5d\x85\xe0\x88\x50\xe3\x3d\xdc\x8e\x66\x4d\xc0\x4b\x53\x9e\x9a\x02\xc0\xd1\x90\xa6\x7b\x7c\x68\x07\x80\x17\xa7\x3c\x55\x15\x82\x37\x48\x5c\x67\xf3\x4f\xed\x1a\x6f\x6d\xac\x3d\xaa\x10\x1c\x11\x71\xb7\x26\x27\x27\x53\xc0\x8b\x53\xa2\x8a\x0a\x11\x13\xf9\xc3\x61\x08\xa3\x78\xd0\x6e\xef\x7e\x49\x5c\xdd\x34\x84\xd0\x1d\xe1\x67\x32\x82\x2b\x70\x22\x29\xf1\x95\xc6\x43\xea\x75\x73\x21\x1c\xa9\x91\x83\x13\x49\x51\xa9\x8f\x3f\x39\xc7\x33\x11\x5b\xe1\x39\x2b\x6a\x98\x40\x49\x3d\xf9\xcb\xb7\xa7\x40\x0c\x60\x19\xdb\x6a\x94\x40\x88\x9b\xb7\x61\x6d\xef\x41\x20\x06\xb0\x84\x2d\x35\x4a\x20\x24\x23\xf4\xe7\xf3\xa8\x0d\xc4\x00\x96\x70\x53\x8d\x12\x08\xc9\x64\x38\x98\xdd\x18\x40\x0c\x60\x09\x9b\x6a\x94\x40\x48\xd6\x27\xde\xdf\xda\x73\x20\x06\xb0\x84\x0d\x35\x4a\x20\x24\x63\xf7\x81\x65\xec\x00\x31\x80\x25\xac\xab\x51\x02\x21\x19\xc3\x0f\xdf\x5d\x0c\x80\x18\xc0\x12\xd6\xd4\x28\x81\x90\x74\x08\x17\xbb\x07\x17\x40\x0c\x60\x09\xab\x6a\x94\x68\xda\x64\x82\x70\xbe\x27\xaa\x5b\x68\x2d\x6a\x94\x40\x48\x9c\xd6\x69\x7d\x72\x0c\xc4\x00\x96\xb0\xa2\x46\xc5\x13\x89\xd4\x35\xef\x57\xdd\x91\xf9\x11\x08\x72\x32\xa5\x88\x7d\x73\x05\x86\x27\xc3\xa5\x6d\xef\xec\x1a\xa7\x40\x90\x33\x64\x86\x12\xc3\x93\xa1\x92\x5e\xef\x3e\x5c\x03\x20\x88\x19\xae\x8a\x0a\xc2\x51\xa1\xce\xe0\x63\x54\xfd\x61\x03\x5e\x9a\x12\xb5\x15\x00\x8e\x06\x77\x01\xb5\x70\x7e\xbc\x0d\x78\x71\xca\xd3\x52\x21\x38\x22\xd2\xf4\xf7\x2e\xec\x4b\xc0\x8b\x53\xa2\x4d\x15\x82\x23\x42\x4d\x7e\x7e\xbe\xfb\xd6\x04\xbc\x34\xe5\x69\x2a\x00\x1c\x4d\x03\x6f\x75\xde\x6c\xdf\x03\x5e\x9a\xd2\x34\x14\x00\x8e\x86\xac\x82\x36\xfa\xef\xf9\xd2\xd7\x19\x9e\xba\x0a\xc1\x11\xa1\x66\x7d\xfd\x61\x36\xfa\x00\x78\x69\xca\x53\x53\x00\x38\x1a\xe2\xbe\x8e\x86\xf3\x2f\x80\x17\xa7\x3c\x55\x15\x82\x37\x45\xad\xa3\x7d\x3a\x78\xb7\x75\xcc\x9b\x19\x6b\x88\x0a\x00\x47\x43\x1c\xf3\xcd\xa6\xc1\x57\x2a\x6b\xd0\x15\x15\x22\x25\x8a\x1d\xf3\xdb\x8b\x9b\xf0\x16\x08\xe2\x98\x89\xf8\xe5\x72\x08\x4f\x45\xdc\xf2\x20\x78\xd8\x02\x82\x9c\xe1\x32\x94\x18\x9e\x8c\x78\x2f\x4d\x67\xef\x0b\x10\xe4\x0c\x59\x45\x89\xe1\xc8\xc8\xfa\xd8\xe8\xa8\xde\x07\xbc\x38\xa5\x6a\xab\x10\x1c\x11\x71\xcc\x9d\x73\xb6\xf7\x4a\x1c\x73\x42\xd4\x52\x21\x38\x22\xe2\x98\x37\x0e\x6f\xce\x01\x2f\x4e\x89\x36\x55\x08\x8e\x88\x8c\xd4\x97\x5b\xdb\x3b\x80\x17\xa7\x44\x4d\x15\x82\x23\x22\x8e\xf9\xbd\xd3\xbc\x03\xbc\x38\x25\x6a\xa8\x10\x1c\x11\x6a\xba\x7b\x4e\x3f\xb2\x00\x2f\x4d\x79\xea\x0a\x00\x47\x53\x23\x73\xbc\x9d\xea\x15\xe0\xc5\x29\x4f\x4d\x85\xe0\x88\xc8\xa8\xf4\xe1\xf8\x62\x06\x78\x71\x4a\x54\x55\x21\x78\x93\xc4\x75\x76\x1b\x44\x90\xb7\x36\xd6\x20\x55\x08\x8e\x88\x34\x5e\xe7\xdd\xd9\x01\xe0\xc5\x29\x51\x45\x85\x88\x89\x78\xc7\x7c\xb4\x75\x94\x2c\x47\xf2\x8e\xf9\xd9\x84\x75\xcc\x15\x38\x91\x94\x94\xd4\xbb\xbe\x01\x22\xd8\xe0\x49\x8d\x1c\x9c\x48\x4a\xbc\x0d\x6b\x70\x90\xc9\x41\x85\x27\xad\xe4\xe0\x04\x52\x32\xe3\xbe\xba\xf9\x74\x0d\xc4\x00\x96\xb2\xad\x46\x09\x84\xa8\x81\xbb\x8d\x03\xba\x1b\xc5\xca\x59\xbe\x96\x12\x24\xd0\x91\x66\xde\x77\xef\x2a\x40\x0c\x60\xf9\x36\xd5\x28\x81\x10\x35\xf7\x93\x61\x74\x7a\x07\x44\x39\xcb\xd7\x54\x82\x04\x3a\xdc\xe8\x8d\x6d\xe7\xcd\x1b\x20\x06\xb0\x7c\x0d\x35\x4a\x20\x24\x6e\xf9\x87\xa3\xfb\x43\x20\x06\xb0\x84\x75\x35\x4a\x20\x24\xdd\xc0\xe7\xd6\xd9\x19\x10\x03\x58\xc2\x9a\x1a\x25\x10\x92\x06\x33\xbb\xbb\x35\x80\x18\xc0\x12\x56\xd5\x28\xd1\xb0\xc9\x4c\x64\xe7\xe0\xbd\x68\xaf\xbc\x59\xab\x51\x02\x21\xe9\x1e\x6e\x76\xa3\x0f\x40\x0c\x60\x09\x2b\x6a\x54\xbc\x4a\x9f\xba\xe5\x83\x1f\x27\x67\x64\xd9\x81\x11\x93\xe5\xfa\xd8\x2b\x97\x43\x78\x2a\x32\x63\x3f\xad\x8c\xce\x81\x20\x67\xb8\x0c\x25\x86\x27\x23\x23\xfc\xfd\xdc\x6b\x00\x41\xce\x90\x55\x94\x18\x8e\x0c\x75\x04\x57\xb5\x09\xf8\x01\x78\x69\xca\xd4\x56\x00\x38\x1a\x32\xbe\x5f\xdf\x5f\xb7\x01\x2f\x4e\x79\x5a\x2a\x04\x47\x84\x1a\xbe\xed\x05\x87\xef\x00\x2f\x4d\x79\x36\x15\x00\x8e\x86\xac\x98\x3f\x7c\xbc\x1a\x02\x5e\x9c\xf2\x34\x55\x08\x8e\x88\x34\x74\x77\x74\xb7\x07\x78\x71\x4a\xd4\x50\x21\x38\x22\xfc\x1a\xc4\x7e\x1f\x9c\x01\x5e\x9a\xf2\xd4\x15\x00\x8e\x86\xcc\xb6\xef\xb7\x0d\xde\xe2\x6a\x0c\x4f\x4d\x85\xe0\x88\x48\x73\x0e\x66\x1f\x0c\xc0\x8b\x53\xa2\xaa\x0a\xc1\x9b\x23\x99\x0c\x5f\xdf\x9d\xf0\x96\xc6\x1a\xa3\x0a\xc1\x11\x91\xe6\xfb\x71\x67\x18\x01\x5e\x9c\x12\x55\x54\x88\x94\x28\x59\x33\xaf\xda\xd7\xee\x11\x10\xe4\x31\x15\x5d\x33\x97\x63\x78\x32\xd2\x74\x8f\x6a\xe7\x67\x40\x90\x33\x64\x86\x12\xc3\x93\x91\xb1\x7c\x6f\xf3\xaa\x0e\x04\x39\x43\x56\x51\x62\x38\x32\xb2\xbc\x76\xbc\x7f\x56\x01\xbc\x38\xa5\x6a\xab\x10\x1c\x11\x59\x56\xbb\x39\x39\x71\x00\x2f\x4e\x89\x5a\x2a\x04\x47\x84\x1a\xef\x55\xeb\xf0\xf4\x1c\xf0\xd2\x94\x67\x53\x01\xe0\x68\x88\x6b\xde\x3f\xbf\x3c\x01\xbc\x38\xe5\x69\xaa\x10\x1c\x51\x83\x0e\xaa\xc1\x0f\xc0\x8b\x53\xa2\x86\x0a\xc1\x11\x91\x35\xf3\xe3\xd9\x59\x0b\xf0\xe2\x94\xa8\xae\x42\x70\x44\xe4\xc5\xab\x87\x7d\xd0\x07\xbc\x38\x25\xaa\xa9\x10\x1c\x11\x59\x24\xdb\x09\x1c\x3e\xe3\xac\x85\x57\x55\x08\xde\x24\xc9\xf2\xdd\x26\x10\xac\x8d\x35\x48\x15\x82\x23\x22\xa3\xd2\xf5\xb1\x39\x07\xbc\x38\x25\xaa\xa8\x10\x31\x11\xe7\x9c\xd7\x8e\xe7\xc3\xa4\x97\xe7\x9d\xf3\x43\x9b\x71\xce\x55\x38\x91\x14\x95\x34\xdc\x1b\x38\x73\x11\x6b\xf0\x9c\x86\x1a\x26\x52\x92\xe6\x3c\x82\x37\x86\x08\xae\xf0\x9c\x95\x1c\x9c\x40\x4a\xe6\xdc\xf7\xd3\xf7\x16\x10\x03\x58\xca\xb6\x1a\x25\x10\xa2\xe6\x7d\x6f\xef\xcc\xce\x80\x28\x67\xf9\x5a\x4a\x90\x40\x47\x5c\xf3\xc3\xb3\xda\x16\x10\x03\x58\xbe\x4d\x35\x4a\x20\x24\x63\xf5\x9b\x56\x65\x0c\xc4\x00\x96\xb0\xa9\x46\x09\x84\xa4\xd9\x8f\x6a\xd3\x3e\x10\x03\x58\xc2\x86\x1a\x25\x10\x92\xe6\xff\xf6\xc7\x89\xa8\xec\x3a\x47\x58\x57\xa3\x04\x42\xd4\x0d\x7c\x86\x83\x07\x0f\x88\x72\x96\xaf\xa6\x04\x09\x74\xa8\xb9\x7c\x9e\xb7\x83\x09\x10\xe5\x2c\x5d\x55\x09\x12\xcd\x5a\xeb\x68\xdb\xe7\xfe\x9e\x2d\x1a\x2b\x6f\xd3\x4a\x90\x40\x87\x1a\x89\xff\x79\xd7\x3a\x00\xa2\x9c\xa5\xab\x28\x41\xf1\x0b\x30\xcc\x5a\x79\xad\xda\x9f\x93\xa5\x02\x46\x4e\x5e\x85\x49\xd6\xca\xe5\x18\x9e\x8c\x8c\xed\x75\xb8\x3b\x07\x82\x9c\x21\x33\x94\x18\x9e\x8c\xf8\x2f\xed\x1b\xf3\x06\x08\x72\x86\xac\xa2\xc4\x70\x64\x64\x7e\x7e\xb6\x79\xe5\x03\x5e\x9c\x52\xb5\x55\x08\x8e\x88\x38\xe6\xdb\xa3\xa3\x2d\xc0\x8b\x53\xa2\x96\x0a\xc1\x11\x91\xf5\xf2\xf7\xb7\x95\x03\xc0\x8b\x53\xa2\x4d\x15\x82\x23\x22\xcd\x7d\xba\x77\xf8\x00\x78\x71\x4a\xd4\x54\x21\x38\x22\xb2\x35\xf6\x70\x1b\xde\x03\x5e\x9c\x12\x35\x54\x08\x8e\x08\x35\xef\xf0\x6d\x38\xb9\x02\xbc\x34\xe5\xa9\x2b\x00\x1c\x0d\x6a\xd4\x9f\x9c\xda\xbe\x09\x78\x69\x4a\x53\x53\x00\x38\x1a\x64\xe0\x97\x93\xd1\xe7\x5b\xc0\x4b\x53\x9a\xaa\x02\xc0\x9b\x23\x59\xbd\x3b\xdc\x9d\xf0\x96\xc6\x1a\xa3\x0a\xc1\x11\x21\xb3\x3e\xb9\x3c\x3d\xb7\x00\x2f\x4d\x79\x2a\x0a\x40\x4a\x93\x3a\xe5\x6f\x8f\x0e\x2f\x81\x20\x8f\x99\x62\xa7\x5c\x8a\xe1\xc9\x50\xe9\xf6\xed\xcb\x87\x00\x08\x62\x86\xcb\x50\x41\x78\x2a\x32\x86\xdf\xcc\x6b\x03\x20\xc8\x19\xae\x8a\x12\xc3\x91\x91\xb1\xfb\xe0\xf3\x8f\x0a\xe0\xc5\x29\x55\x5b\x85\xe0\x88\x88\x4b\xde\xbc\x71\x2e\x00\x2f\x4e\x89\x5a\x2a\x04\x47\xb4\x49\x66\x2f\x27\xd7\x4d\xc0\x8b\x53\xa2\x4d\x15\x82\x23\x22\x4e\xb9\x6f\x84\x10\xf0\xe2\x94\xa8\xa9\x42\x70\x44\xa4\xd9\xda\x93\xfb\x0f\x80\x17\xa7\x44\x0d\x15\x82\x23\x22\x4b\x66\xd3\x60\xd6\x06\xbc\x38\x25\xaa\xab\x10\x1c\x11\x71\xca\xdd\xad\x4f\x57\x80\x17\xa7\x44\x35\x15\x82\x23\x22\x63\xd3\xdb\xd9\x0e\x6f\xbe\xac\x85\x57\x55\x08\xde\x28\x71\x8e\xae\x2e\xf7\x00\x6f\x6f\xac\x49\xaa\x10\x1c\x11\x71\xca\x9d\x8b\x8f\x73\xc0\x8b\x53\xa2\x8a\x0a\x11\x13\xb1\x4e\xf9\xec\xe4\xf4\x2a\x79\x97\x96\xf7\xc9\x0f\x46\xa9\x4f\xae\x82\x89\x94\x64\xf2\x11\x5d\xde\x0d\x44\xb0\xc1\x73\x1a\x39\x38\x91\x94\x94\xb9\xb2\x6f\x04\x22\xb8\xc2\x93\x56\x72\x70\x02\x29\x69\xd8\x0f\x9f\x4f\xc5\x0c\xb4\x39\xca\xb6\x1a\x25\x10\x92\x7d\xec\xbd\x8f\x93\x3b\x20\x06\xb0\x84\x2d\x35\x4a\x20\x24\xe3\xf3\x64\xff\xda\x03\x62\x00\x4b\xb8\xa9\x46\x09\x84\xe4\xcd\xb5\xea\xd9\xa7\x2d\x20\x06\xb0\x84\x4d\x35\x4a\x20\xa4\x6b\xe6\xa7\x75\x0b\x88\x01\x2c\x61\x43\x8d\x12\x08\xc9\x5e\xf7\x9b\xcb\xeb\x08\x88\x01\x2c\x61\x5d\x8d\x12\x08\x49\x47\xb0\xf5\xf0\xf0\x06\x88\x01\x2c\x61\x4d\x8d\x12\x08\x49\x87\xb0\x3b\xba\x73\x80\x18\xc0\x12\x56\xd5\x28\xd1\xb8\x31\xe1\xc9\xde\x8f\x77\xa2\xcd\xf2\xa6\xad\x46\x09\x84\x64\xf4\xfb\x71\x7e\x2d\xaa\x9b\x6f\x2b\x15\x35\x8a\x1e\x36\x61\xf9\x9e\x0d\xbd\x90\x1e\xe9\xe0\x7e\x01\xef\x80\x18\x40\x8e\x9b\xd8\x8e\x9f\xd5\x38\x4a\x19\x46\x81\x33\xa5\x1f\xc2\xde\x87\x6f\x8f\x00\x2f\x26\x74\x67\xe4\x49\x85\xa1\x54\x63\xff\x96\x7e\x96\x7b\x62\x37\xbe\x00\x56\x48\x68\xf6\xd0\x6f\x79\xb8\x69\x45\xf4\x38\x8b\xe8\xf6\xea\x0a\x90\x47\x4d\x0f\x93\x4f\xbf\xab\x47\xa3\x77\xd5\xf4\xb3\x65\x87\x7c\x99\x5c\xdd\x8f\xf6\x1b\xf4\xb3\xe4\x79\xfc\xcd\xf1\x87\xe0\xf4\xb0\x95\x7e\x6f\x6c\xd3\xcf\x89\xb7\xe7\xdb\xa3\x8f\xe9\xa7\xc4\xe2\xfd\x23\x64\xe8\x95\xdd\x4c\x42\x73\xcf\x9d\xba\xa1\x42\xe2\x83\x22\x50\xc6\xe0\xc1\xf6\x0e\x39\x36\x42\xd3\x6d\x07\x9f\x1d\x81\xf5\xf7\xe9\x78\x62\x81\x58\xa0\xe9\xda\x20\xf2\xe2\x5b\x13\x50\x0e\x36\x41\x1d\xda\x80\x15\xea\x83\xc8\xdb\x21\xbf\xe5\xe1\xfe\x14\x7a\x1d\xcd\x72\xfb\xde\x35\x40\xbf\x35\x5d\xb3\x03\x7f\x6a\xfb\x73\x0f\x44\xfe\x68\x44\x8f\xbe\xf8\xe0\x5d\xed\x02\x31\x40\x8f\x05\xe7\xf8\x59\x8d\xc3\x67\x21\x23\x63\x4a\x4f\x46\xa6\x99\x4f\x3f\x5b\xef\x1b\x17\x83\xe3\x03\xc0\x0a\x51\xe6\x93\x2f\xd7\x65\xe1\x98\x82\x3d\x03\xe0\xb8\x72\x4d\x0e\x93\x4d\x3f\x5f\x8f\x3c\xe6\x04\x80\x6c\x38\xa6\x88\x3f\x61\xaf\x36\xee\xe7\x55\x90\x48\x50\x64\xfa\x11\xbb\x18\x82\xa3\xa5\x9f\xb1\x83\x68\xf4\x96\x9c\x48\x9b\x7e\xc6\x1e\x79\xc9\x77\xec\x92\x60\x52\x75\xe9\x87\xec\xc3\xa3\xcb\x01\x60\x64\xb8\xe2\x92\x4f\xd9\x33\xa1\x38\xba\xeb\x78\xd7\x64\x57\xa7\x71\xfb\x09\x24\x12\x14\xf5\xc0\xf1\xae\x25\x21\x24\x1a\xfe\xf0\xbe\x7e\xd5\xba\xd9\x07\xf4\x19\x47\x19\x65\xa4\x5a\x7a\xfc\x2e\x89\x54\x6d\x6f\xdf\x9f\x01\x4e\x8a\xa2\xbe\x43\x0f\x38\xbe\x0c\x40\x6a\x69\x82\x9b\x48\xe5\xc8\x99\x02\xfa\x8c\xeb\x66\x92\x91\x32\x89\x92\x48\x55\xb8\x7b\xb4\x0d\x38\x69\x92\x28\x8e\x2f\x03\x60\x96\xbb\x90\xa8\x67\xfb\x76\x17\xd0\x67\x14\xf3\x73\x98\x91\x32\x89\x92\x48\xc6\x9b\xea\xf9\x1d\xe0\xa4\x49\xa2\x38\xbe\x0c\x80\x59\xf0\x07\xee\x64\xa8\x77\x2c\x52\x6b\x44\x84\xe2\x6f\xa1\x5f\xb2\xb0\x34\x03\xa4\x33\xe5\x4e\x38\x4e\x93\x96\x85\x69\xd9\x43\x92\xb5\x8e\xa6\x3a\x3f\x39\xe5\xba\xa0\x92\x3c\xac\xc6\x9c\xcc\x8c\xd5\x22\x1e\xd7\x8c\xe9\xce\xe9\xd1\xcd\xd2\x70\xcd\xf1\xa6\xb3\x88\x29\x9b\x3b\x9a\x7e\x01\xac\x50\xc7\x0f\x49\xf9\xb2\xe1\x4c\x09\xc9\x91\x2d\x0e\x1e\x73\x46\x0f\x87\x6d\x0b\xc8\xc2\x92\x32\xbe\x8f\x45\xb9\xe8\xb4\xcb\x9b\x40\x6f\x86\x32\xd9\x30\xdf\xb5\xe7\x80\x17\x27\xdd\xdd\x21\xf4\x66\x2a\x8c\x67\xde\xe2\x0f\x98\xe8\x49\xd4\x9a\xae\x79\xe6\x2d\xb0\x9d\x5b\x27\x3e\x75\xe2\xc7\x7c\x0c\x01\x2b\x44\x71\x76\xc8\x6f\x79\x38\xa6\x88\xcc\x01\xb1\x66\xcb\xb9\x3a\x06\x89\x04\x45\x3e\x37\x07\xa1\x24\x04\x47\x63\xf5\xd5\xaf\x79\x07\xef\xdf\x00\x5e\x8c\x08\x18\x2d\xc9\x31\x71\x21\xc9\xd8\x75\xb1\x93\x94\x9a\xa6\x32\x75\x5c\x17\xe7\xae\xe6\x34\xae\x2f\x41\x2a\x42\xec\xc7\xe8\x97\x2c\x0c\x47\x0d\x23\xd3\xba\xa6\xd9\x9b\x0e\x2f\xbe\x00\x56\x88\xa2\x9f\x91\xdf\xf2\xf0\x44\x35\x7c\x41\x8d\x56\x78\x72\x00\x24\x61\xb1\xba\xd8\x12\xab\xc1\x68\x98\x07\xf4\xe8\x74\xad\xa3\xbd\x1d\x0d\x8e\x77\x00\x2b\x43\x63\xfb\x76\x7c\xb4\xba\x24\x18\x13\x4c\x4d\x7a\xc2\xc7\x8d\x71\xf1\x16\x24\x12\x14\xf7\xd8\x24\x67\x7b\x08\x21\x53\x73\xe4\x78\x26\x3e\xf6\x5a\xf3\x8c\xc6\xa1\x07\x52\x09\x39\xc7\x84\x3e\x90\x6e\xf9\xa0\xb1\x5f\x1f\x01\x5e\xca\x70\xa0\x8e\x59\x0a\x61\x89\x68\xff\x1c\x5c\x55\x6f\x00\x2f\x66\x98\x48\x6f\x2d\xc7\x78\xd0\xed\x68\xcc\x05\x67\x98\x3e\x3e\x77\x1d\x17\x5f\x38\x8b\x9d\x46\xda\xf2\xed\x7b\x69\xa8\xc6\xdd\xab\x80\x73\x97\xbd\x6e\x81\x92\xec\x91\x27\x15\x86\x52\x45\x4e\x44\x8f\x10\x33\xaa\x27\x27\x80\x15\x12\x9a\x73\xf4\x5b\x1e\xae\xb1\xb7\x30\x90\xdd\x58\xf1\x6e\x06\x4a\xf2\x16\x3f\x28\x10\x5a\x7a\x21\x1a\x79\xe5\x8e\xbf\x24\x8d\x9c\xf9\x12\xf7\x84\xd9\x50\x8d\xbf\xf6\x81\x2c\x41\x65\xaf\x83\xa0\x39\xd9\xa6\x8f\x4a\x14\x93\x1b\xe0\x44\x10\x99\x40\x30\xfe\x72\xf0\x11\x88\xf2\x34\x5b\xfb\x11\x9c\x28\x51\x1a\x73\x17\x1b\xae\x72\xf1\x82\x36\x9a\xb1\xa4\xab\xcf\x86\x6b\xdc\x55\x60\x64\x21\x2a\x73\x43\x18\xa5\x49\x1d\x5b\x29\x46\xe3\xae\xa2\xc2\xba\xca\xde\x50\x45\xa9\x18\x37\x53\x86\xd1\xb8\x1b\x90\x30\x55\xf6\x62\x24\x4a\xc5\xb8\x9b\x32\x8c\x96\x5e\xc2\x43\xde\xef\xe0\x2f\xe6\xa1\x24\xb1\xdb\x99\x09\xd5\xb8\x2b\x5f\x70\x4e\xb2\x37\xc1\x50\x12\xe6\xe0\x29\x19\x46\x63\x6f\x1b\xc1\xed\x2b\x73\x07\x49\xac\xe8\xd8\x11\x95\x21\x92\x8b\x23\x34\xe1\x26\x09\x34\x93\x65\x6e\x55\xc0\x79\xcd\xdc\xb5\xa0\xe9\xe4\x29\x76\x8c\x64\x08\x72\x3c\x50\x7c\x42\x9d\x70\x7f\x02\x39\x1c\x88\x9e\x50\x97\x09\x1b\x3b\x36\xec\x68\xe9\x5d\x0a\x9a\x1e\x8e\xc9\xf1\x3d\xc9\xcd\x09\xc8\xfd\xa0\xe7\x51\xa2\x22\xf0\x77\x25\xc4\xe7\x73\xe1\xb8\xf8\xad\x1d\xee\x9a\x04\x72\x36\xd7\x1e\x4e\x24\x1b\x46\x4e\xa5\xc4\xef\x3a\xa7\xd7\x1e\x68\x3a\xbe\xd8\xa0\xa3\x31\xb7\x1c\x68\xba\x96\x1e\xa3\x4e\x36\xd6\xf9\xf3\xe3\x35\x9d\xfe\xc6\xae\x67\x36\x54\x4b\x4f\xd3\xd6\x3a\x9a\x78\x0e\x79\x12\x1b\xf5\xdf\xd9\x40\x2d\x3d\x49\x99\x2c\xb4\xf3\xe7\x50\x27\xb1\x0f\x6d\x69\xa8\x96\x9e\xd2\xab\x75\x34\xf1\x7c\xe2\x24\x36\x1a\x85\xb2\x81\x9a\x78\xb0\x3d\x56\xb2\xf4\xc8\x7b\x46\x07\x89\xb1\x28\x80\x5a\xe6\x50\x7a\xf2\xe2\x92\xf4\xf4\x7b\x86\x37\x3d\x6c\x4b\x05\xcd\x32\x33\xd9\xce\x3b\x0f\x3f\x93\x4a\x52\x86\xfc\x58\x9a\x78\xda\x3b\xf1\x01\x65\xe7\xc0\x33\xd5\x1c\x93\xab\x80\x5a\xe6\xf4\x75\x5c\x00\xf9\xe1\xef\x0c\x6f\xa2\x20\x25\x34\xcb\xcc\x28\x28\xef\xe0\xf7\x4c\x2a\x89\x82\xf2\x63\x69\xe2\x79\xe6\x38\x25\xe9\x49\xe7\x8c\x25\x27\xe4\x0a\xa0\x96\x39\x5d\x5c\xa3\xe7\x37\x48\x0e\x1e\x67\x78\x53\x0b\x52\x41\xb3\xcc\x4c\xb6\xf3\x0e\x36\xcf\xa4\x92\x94\x21\x3f\x96\x26\x9e\xf0\xad\x75\x34\xf9\xd1\xdf\x4c\x5b\xa5\xdc\x2a\x9c\x96\x39\x99\x9b\xcc\x99\xa5\x67\x80\x33\xb4\xa9\xfd\xa8\xa0\x59\x66\xc6\xec\xf3\x0e\x05\xcf\xa4\x92\xb4\x81\xfc\x58\x5a\x72\x14\x38\xf1\xea\xb8\xd3\xc1\xe3\x3e\x9c\xcc\xf8\xc5\x30\x2d\x39\xeb\x1b\x47\xe5\x8f\xff\x8e\xa3\x62\x9f\x39\x13\xa6\x25\x87\x79\x6b\x1d\x4d\x38\xde\x3b\x8e\x89\xfa\xdb\x4c\x90\x96\x1c\xe0\x4d\x7c\x6a\xee\x4c\xef\x38\x26\x59\x8a\x11\xc3\x34\xee\xd4\x65\xdc\xd5\x67\x8f\xbe\x4e\xd4\x78\x8c\x9e\x54\x18\x4d\x72\x70\x35\x79\xa5\x47\x71\xaa\x35\x4f\x9b\xac\x82\xa8\xe1\x9a\xec\x88\x68\x5c\x64\xe5\x01\xd6\x7c\x1a\x69\x4b\xcc\x89\x20\x4d\x85\x31\xb8\x25\x87\x55\xcb\x52\x4c\xcc\x6e\x69\x5c\x8d\x3d\xb5\x5a\xeb\x68\xd9\xa3\xac\xe3\xfa\xa4\x75\x21\x01\x2c\x16\xfa\x70\xe6\x59\x68\x1e\x56\x84\x7a\xa4\x7b\xa5\x47\x6d\x16\xc2\x42\x18\x05\x8e\x15\x69\xdd\x38\xb0\xe0\x17\x61\xe9\x31\x80\xd1\x2c\xf0\x0a\x70\x63\x03\x96\xfb\x7d\x18\x1e\xfa\xf6\xcc\x85\x7f\xc2\xce\x23\x75\x9a\x3b\x70\xb1\x48\xa2\x04\x88\xb1\xf4\xe8\x0c\x8b\x2f\x8a\xb0\xe0\x78\x61\x64\x7a\x16\xf4\x87\x85\xa8\x54\x8a\xc6\x81\x3f\x2f\x78\x70\x5e\x38\xbf\x9f\xc2\xdd\x20\xf0\x83\xa2\xb6\x6d\x7a\x9e\x1f\x15\x2c\xd3\x75\x0b\x66\xc1\x72\xcd\x30\x2c\x98\x61\xc1\x2c\xc4\x94\x5a\x29\x65\x77\x53\x76\xc8\xd0\x9d\xc2\x21\x0c\xa0\x67\xc5\x9c\xd1\xd8\x09\x0b\x63\x33\xf4\x7e\x8f\x0a\x03\x08\xbd\x42\x72\x2c\x25\xb4\x0b\xa0\x10\xce\xa6\x30\x28\x96\x38\x04\x4a\x1f\xda\x5a\xa9\x4b\x8a\xfb\x22\xfa\xf9\x53\xf3\x07\x3f\xa0\x15\x69\x2f\x7a\xd1\xfd\x14\x17\x61\x63\x43\x4b\x72\x95\x4a\xff\x84\x9d\x28\xcd\xa2\x99\x64\x51\x86\xdd\xd8\xf0\x66\xae\xfb\xa2\xd7\x8b\xe4\xda\x38\x43\x59\x2b\xc0\xbb\x69\x00\xc3\x10\xd1\x4d\x66\x61\x54\x80\x4e\x34\x86\x41\x61\x00\x0b\x28\x76\xc1\x0f\x18\xf5\xe8\x05\xa4\x3e\xed\x65\x9c\x42\xa9\x0b\xcb\xd3\xc0\x8f\x7c\x24\xe8\x1d\xe1\x22\x94\xad\x00\x9a\x11\x2c\x46\x1b\x1b\x51\x1a\xa8\x3f\x5a\xbe\x17\x46\xc1\xcc\x8a\xfc\xa0\xf3\x78\x6b\xba\x33\xd8\x81\x3a\xf4\x66\x13\x18\xe0\x33\xa9\x5f\x18\xfa\x3c\x70\xc8\x51\xe4\x2f\x2a\xba\xe5\x7b\x43\x67\x34\xa3\x61\x95\xc5\xa2\xa4\x47\x1b\x1b\x45\x9a\x46\x08\xa3\xe3\x98\xfa\x68\xf8\xa7\x54\x8a\x75\xd3\x41\x76\x84\x73\xd1\xef\xf7\xa2\xd2\x82\x22\x6d\x38\x74\x3c\x78\x1c\xf8\x53\x18\x44\xf7\xc5\x48\xd7\x52\x6b\xd3\x74\x9a\xbd\x17\x95\x45\xa9\x7b\x6b\x06\x05\xa7\x97\x98\x70\xe9\x31\x51\x3e\x24\xca\x1f\xfa\x41\x11\x81\xbc\x5e\xa5\xeb\xfd\x33\x2a\xbb\xd0\x1b\x45\xe3\xae\xf7\xf2\x65\xe9\x11\xc9\xfd\x5e\xf4\xd5\xfb\xde\xf5\xcb\x69\x59\x7b\xec\xc3\xcf\x9f\x2f\x0c\xdd\x2f\xb3\xe5\xed\xbd\xa8\xe8\x1a\xce\x83\xe6\x78\x05\x7f\x63\xa3\xe8\x97\x63\xdd\xf4\x5e\x54\x4a\xba\xbc\x14\x50\xf7\xcb\xd7\xf0\x5e\xf7\x4b\x8b\x05\x6d\x48\x49\xbe\x23\xdd\xd3\xfd\xa4\x7d\x79\x1b\x1b\xb0\xc8\xd6\x8e\x57\xd2\x7d\x2c\xd3\xfd\x92\x1e\x2d\x16\xc5\x92\x6e\xf5\xbc\xa2\x51\xd2\xc3\x9e\x5f\xb4\x4a\xfa\xac\xe7\x15\x2b\x25\xdd\xee\xf9\xc5\x59\x49\x1f\xa2\xb0\x76\x49\x9f\xf6\xfc\xe2\xb0\xa4\x0f\xd0\x63\xa3\xa4\x8f\x7b\x7e\x71\x50\xd2\x27\x3d\xaf\xb8\x59\xd2\x47\x3d\xbf\x38\x29\xe9\xfd\x9e\x57\x6c\x95\xf4\xdb\x9e\x5f\xec\x97\xf4\xfb\x54\x95\x90\xd1\x65\x54\x24\xca\x82\x28\x9b\xba\xd9\x0d\x8a\xa8\x59\xe9\x51\xa9\x1b\xab\xd7\xe9\x99\xc1\x68\x36\x81\x5e\x14\x52\x15\xeb\x56\xef\x4d\x10\x98\xf7\x45\x07\xe5\xb2\xd2\x0d\xff\xe9\x74\xc3\x97\x2f\x4b\xd6\xd7\xf0\x7b\x0a\xfe\x1a\x7e\xef\xc6\xa5\xee\xf9\x3d\x97\x10\x17\x61\x2f\x4a\x0d\xe3\xe7\x4f\xaa\xd0\x11\x6f\x40\x51\xa9\x54\x46\x6d\xb5\x6c\x4e\xa7\x2e\xd2\xef\x57\x14\xf9\x3b\xaa\x2b\xcb\x8c\x8a\x56\xa9\x54\xd2\xfd\x72\x18\x99\x11\xec\x3d\x86\xd0\x85\x56\x04\xed\xce\xd7\xef\xf8\x94\xde\x8e\xa1\x5b\xfe\xcc\x8b\x3a\x15\xfd\x87\x3f\x08\x3b\x5f\xbf\x2f\x74\xb3\xe7\xe9\x6e\xd1\xd7\xcd\x52\x5c\x41\x66\x31\xd2\x61\x49\x77\x8a\x91\xfe\xf5\xf1\x1a\xde\x77\xb4\x21\x8c\xac\xb1\xa6\x13\x13\x54\x2a\x2b\xee\x28\x69\xd6\x70\xa1\x92\x32\x27\xec\x51\x39\xf2\xcf\xa2\xc0\xf1\x46\xac\x05\x27\x71\xe3\xc0\x62\x69\xa1\x47\x8b\x22\x03\xc1\x75\xd1\x43\xac\x5d\xf4\x17\xb2\x94\x69\x58\xc6\x59\xfb\x74\x7a\xb0\xb1\x81\x7f\x15\x25\x41\x2f\xb5\x3f\x51\xe1\x7b\xda\x4b\x1c\x88\x75\x53\x46\x92\x52\x39\x1a\x43\xaf\xc8\x16\x28\xc9\xc7\x8f\x10\x25\xba\x10\x21\x51\xe9\x11\xa2\x36\x7d\x86\x48\x8a\x9c\x82\x89\x66\xa3\x32\xfe\x97\xe8\x37\x2a\xa3\x7f\x16\x25\xfc\x47\x27\xba\xb4\xfc\xc9\xd4\xf7\xa0\x17\x5d\x3a\xae\x7b\x88\xb0\x19\xc5\x96\x1e\x71\x46\x49\x81\x4a\x8b\x38\xe6\x6c\x6a\x9b\x11\x3c\x36\x47\x50\x56\x15\xa4\x6c\x49\xce\x70\x75\xc3\x85\x9e\x32\xa5\x44\xd6\x18\x92\x85\xe0\x2c\x4b\x5c\x49\xa9\xa2\xe2\x22\x96\x1d\xcf\x72\x67\x36\x0c\x8b\x50\x60\x92\xf1\xa0\xca\x8a\x7a\x72\x1a\x1b\xde\xa1\x9e\xb0\xd4\x8d\x5e\xf7\x2a\x7f\xca\x30\xe1\xd4\x75\x2c\xd4\xf2\x8d\x52\x47\x16\x3e\x9d\x85\xe3\x22\x2c\xe9\x42\x99\x93\xda\x90\xc4\x59\x08\x99\x7e\xe3\xba\x12\xbd\x8b\x36\x26\xa4\x4b\x1a\xf9\xeb\x38\xd3\x32\x33\x58\x94\x3a\x45\x26\x2a\xaa\xff\xf2\xc4\x9c\x8a\x16\x24\x29\x4f\x54\x5a\xac\x59\xa2\xb4\x48\x36\x74\x61\x04\x57\x2b\x13\x69\x1c\x49\x0c\x79\xdb\x61\x83\xf5\xc7\x09\x8c\xc6\xbe\xdd\xd1\xa6\x7e\x18\x69\x99\x36\x81\x0a\x94\xda\x66\xd1\x28\x2d\xc4\x8c\x9d\xd1\x2c\xe7\xe6\x4e\x8f\x7a\x5f\xbf\x4b\xd5\xce\xe9\xcf\x43\xc9\xb1\x99\xc4\x05\x88\x88\x0e\x49\x39\x32\xe1\x2f\xb5\x57\xda\x4b\xaf\x6c\x3b\xd0\xee\x9b\x11\x7d\x72\xec\x4c\xc1\x50\x0d\x1c\x07\xfe\xc4\x09\x61\xd9\x74\xdd\x62\x24\x2b\x6a\xdc\x2c\xd3\x42\x06\x30\x0a\xee\xd7\x51\x7e\x1c\x41\xae\x7b\x26\xf4\x39\xaa\xc7\x34\x7f\x91\xe6\x31\xb7\x5a\xf1\x71\xf0\x5f\xae\x77\x0f\x6f\xf5\xe5\x69\x9d\x76\x68\xc8\x18\xb0\xb3\x4e\x5d\xc1\x5d\x17\xa2\x61\xa9\xa8\xd9\xce\xad\xa6\x23\xaf\x52\xcf\x87\x3c\x62\xcf\xfc\xa3\x39\x81\x9d\x62\x45\xbf\x8d\xb1\xa5\xe2\x28\x89\x86\x57\x78\x75\xe1\x99\x2e\xb7\x97\x16\xab\xf3\x0b\x0c\x74\x9b\x66\xa1\x6b\x3b\xd0\xb4\x0b\xef\xfd\x41\xa8\x95\x9e\xcc\xb6\xe5\xdb\xf7\x39\x79\x99\x52\x65\x30\xc6\x40\xc6\x33\xad\xf0\xc3\x1f\x14\xc3\x52\xc1\x0c\x60\xc1\x86\xa6\x5d\xce\xc9\xc4\x34\x96\xeb\x64\x1c\x12\xc6\x5d\x3d\xf2\x23\xd3\xdd\x26\xe3\xa5\x98\xd0\x14\x06\xc8\x96\x3b\xd5\x8a\xfe\x63\x36\x99\x9e\xfb\x1d\xb6\xeb\x14\x7d\x48\x66\xc4\x66\xda\x41\x54\x5a\x2c\x16\xa5\x27\x29\x49\x78\xef\x28\x47\x55\xf4\x52\x22\x35\x4b\x5e\x5c\xb2\x5b\x97\x6f\x77\x51\xb0\x14\x31\x5e\x86\xc0\x6f\x04\x68\xfa\x23\xf2\x1e\xe9\xc8\x37\xf0\xef\x34\x9d\xba\x00\xd2\xd1\x35\x1e\xe5\x74\xdf\xdb\x1e\x9b\xde\x88\x6b\x5a\x89\xba\xe3\x51\x14\x39\x28\x79\xaa\x4e\xf2\xa8\x21\x1d\xe5\x59\x6e\x8a\x7c\x13\x7b\x8d\xab\xc1\xf1\xdc\x71\x35\xe8\x8e\x03\xed\xc2\x9b\x48\x2b\x95\xf4\x65\xa3\xf4\xb2\x9e\x03\x55\x0f\xee\x87\xa2\xb2\x63\xe7\xd5\xb5\xfd\xdc\x4a\xa2\xca\x86\x76\x31\x2a\xad\x50\x29\xd8\xfc\x73\xab\x24\xce\x51\x54\xf6\xcc\x09\x5c\x05\xf9\xfe\xec\xe8\x63\x39\xc4\x9e\xb9\x33\xbc\x2f\x46\x65\x33\x18\x85\x2b\xa6\x01\x83\x60\x15\xa0\x0a\x31\x4e\x3b\x93\x08\xb9\xd4\xd1\xa2\x84\x06\x0c\xf4\xbf\xa7\x34\xf0\xf8\x25\x17\x3d\xf0\x5d\xd8\xd1\xc8\x0e\x6a\x4e\xf5\x0d\x66\x51\xe4\x7b\x49\xed\xc4\x8f\xcb\x87\x83\x41\xe4\xe9\xdc\x53\x3c\x14\xa0\x2a\x74\x1d\xeb\x5a\x5a\x83\xbc\xc3\x84\xbd\x7f\x6d\x07\xcb\x0a\xb1\x70\xe9\x10\xf0\x2f\xce\x32\xe7\x68\x90\x1c\x9f\x22\xd1\x7f\x6c\x86\x13\xe7\x96\x53\xef\x1b\xd7\xfd\x4f\xcb\x68\xec\x09\xb2\x4a\x4d\xb3\x89\xfc\xff\xef\x25\x34\x4b\x4e\xf3\xbb\x1d\xcf\x2e\x4b\xdd\x7b\xec\x8c\x9d\xdf\x4f\x61\xd8\x7b\x8c\xe7\xc2\x1d\x3b\x81\x92\xd6\xac\x27\x4e\xb2\x2a\x88\x78\xa2\xd9\xd0\xd8\xc9\x53\x84\x28\xa2\x2d\xf4\x28\x96\xf4\xee\xff\x5e\x87\xfd\x7b\x1d\xf6\xef\x75\xd8\xff\xbe\x75\xd8\x4d\x6e\x19\xb6\xc5\xac\xc2\xfe\xb7\xae\xb4\xde\xcc\xe0\x0c\xae\xbe\xa0\xba\xd2\x22\xa0\x7c\xed\x60\x16\xb8\x92\x35\x83\x59\xe0\x3e\x7f\x35\x93\x16\x22\x5a\xac\x34\xc9\x5e\x69\x66\xcd\x4f\x9b\xc7\xe9\xd0\x38\x15\xa6\xcd\xd3\x67\x4f\x9b\xa7\xaa\x69\x33\x29\xd6\x3a\x73\xe6\xe9\x73\xe7\xcc\x24\xc9\xd8\x54\xb5\x02\x7e\x46\xb3\xe7\xb9\x13\x8d\x0b\x66\x01\x4f\x80\x0b\xfe\xb0\xa0\x91\x58\x38\xdc\xde\xa6\x33\x6d\x27\x82\x13\x04\x26\xd2\xb2\xb6\x8e\xa3\x3b\xfd\x25\x33\xd9\xe9\xbf\x6d\x26\xbb\xc6\x2c\x11\xab\x6b\x35\xe8\x81\x19\x41\xcf\xba\x2f\x14\x43\x68\xf9\x9e\x1d\x96\x84\x09\x20\xad\x2f\x6e\x0a\x08\xd7\x98\x02\xe2\x29\x64\x1f\x4d\xa6\x56\x99\x08\xa6\xe8\x55\xe6\x45\x74\x61\x64\x35\xa8\x4b\x0a\x1a\x4f\x90\x92\x56\xcc\x18\x98\xa6\x8f\x60\x94\xed\x67\x2a\xdd\xec\xda\xbf\x4c\x2d\xa8\xcf\x78\xd9\xa3\xdb\x1c\x8b\x92\x0e\x73\x9d\xd0\x09\xeb\x84\xce\x02\x37\xd7\x1f\x9c\xfc\xed\x0f\xfe\xed\x0f\xfe\xed\x0f\xfe\xf7\xf9\x83\x7f\xef\xcb\xf3\xde\xe2\x7f\xe9\x5e\xbc\xd2\x35\xfd\x0b\x77\xe0\xff\xef\xd9\x75\xff\x65\xbb\x55\xff\x09\x5b\x51\x64\x2d\xea\xdf\xbd\x17\x15\x5a\x63\x88\xba\x61\xbb\x10\xf9\x68\x14\x0a\x60\x14\x38\xf0\xef\xad\xa9\xff\xa4\xad\xa9\xff\x88\x6d\x1f\xba\x74\xba\x7c\xdf\x67\x3d\xa7\x7f\xb5\x7d\x1f\xf8\xd4\x5d\x16\xb8\xfa\x2e\x0b\xfc\xb5\xbb\x2c\x90\xdd\x65\x59\x7d\x95\x79\x99\x83\xff\xf7\x82\xef\xdf\x0e\xfe\xdf\x0e\xfe\xdf\x0e\xfe\xdf\x0e\xfe\xdf\x0e\xfe\xdf\x0e\xfe\x7f\xb4\x83\x7f\x96\xb8\xd6\xff\x31\x4e\xfe\xdf\x6e\xfd\xff\x13\x6e\x7d\x6a\x79\x6f\x91\x7b\xff\x5f\xea\xb0\xaf\xea\x87\x07\x33\xaf\x6f\xfe\x5b\x9c\xf1\x27\x79\x47\x7e\xcf\x2b\x36\x37\x4b\x5d\xaf\x6c\x23\xe8\xa9\x3f\xc3\x27\x78\x64\x5b\x8f\x5f\x36\x69\x94\xa0\xe7\x15\x6b\xf5\x24\xca\x01\x3e\x73\x2b\x1b\x21\x48\x22\xb8\xc8\xa5\x68\x24\x11\xf6\x3d\x1b\xde\xa9\x62\xb9\x49\x2c\x13\xc5\x6a\x26\xb1\xe6\x4e\x34\x56\xe7\xce\x4c\xa2\x39\x28\x5a\x8b\x4f\xec\x14\xda\x4e\x80\x1c\x74\x49\x4c\x27\x89\x89\x7c\xa6\xcd\xb6\x10\x13\xa5\x28\x8b\x66\x25\xd1\x42\xa4\x8e\xb4\x74\x79\x69\x85\x49\x24\xe4\x91\xb5\x2a\xbc\xda\x65\x31\x66\x49\x0c\xbb\xe7\x15\xd3\x54\x88\x0d\xe2\x68\xa1\x2c\x9e\x5d\x1e\xd0\x78\xc8\xdb\xab\x1a\x42\x05\xe3\xd3\x7c\xee\xa4\x79\x1c\x26\x29\x4e\x51\xcc\x5a\x12\xd3\xf5\x2d\x72\x3c\xce\xd8\x9c\x4a\xf3\x3a\x45\x31\x75\x8a\x0e\x70\x3a\x39\xd8\x38\x7f\xf8\x85\x83\x34\x7f\x13\x13\x3b\x53\xd9\x18\x83\x24\x5f\x63\xa4\xf0\xb4\x9e\x66\x21\xd1\x43\xb0\xe7\x84\x91\x1f\xdc\xcb\x22\x8f\x93\xc8\xc8\xbd\x35\x52\xc5\x0f\xfd\x60\x62\x46\xc7\x66\x14\xc1\xc0\x93\xc5\x9c\x24\x31\x47\x28\xa3\xa9\x3d\x62\x37\x8e\x24\x7c\xe8\xd8\xb6\x0b\xe7\x66\x20\x2d\xea\x28\x61\xc0\xce\x74\xda\xd6\x06\x81\x3f\x0f\x73\xb3\xdd\x4f\xa2\xde\xf6\xbc\x62\x3b\xcd\xf6\xd8\x0c\xc7\x39\xf1\x6e\x93\x78\xf7\x48\x57\x4d\xc1\x6c\x0e\xe1\xc4\x0f\xee\x73\xe2\xdf\xa3\xf8\x6b\x4c\xfd\xd1\x4c\x8a\xcc\xa2\x1e\x17\x89\xcf\xef\x17\x1c\xaf\x00\x4b\x51\xf2\x01\x91\x5f\x7a\xdd\xab\x24\xde\x7a\x32\x7d\x29\x8f\xcd\xf0\x68\xee\xc5\x1d\x16\xf6\xd9\xd1\x6c\xa8\xb4\xb1\x51\xf4\xbe\xfa\xdf\x7b\xf0\xab\xff\xbd\x94\x4c\x03\x16\x71\x17\x54\x2d\xe9\x6e\xcf\x2b\x7b\xc5\xa0\x84\xdc\x74\x34\xdd\x71\xf0\xb3\x49\xa6\x3f\x35\x34\xb1\x40\xcf\xec\x04\xa8\x88\x04\xb3\x92\xee\x15\x6b\x46\x89\x4e\x85\xaa\x68\x2a\x84\x1b\x09\x9e\x0b\xe1\xa9\x90\x57\xac\xd5\xd0\x5c\xa8\xe8\x15\xdb\xc9\x8c\xcd\x0c\x43\x67\xe4\xfd\xfc\xc9\xf9\xf8\xb4\xb4\x51\xcf\xe8\x46\xff\x14\x67\x39\xdd\x28\x9e\x48\x7a\xcc\xa4\x26\xfa\xce\x2b\xc9\x2b\xad\xa4\x13\x8f\xe8\x04\xa9\xa3\x87\x14\x93\x4c\x1a\xe0\x02\xcd\xd7\x1e\xc7\xa4\x42\x3b\xb3\x32\x59\x8e\xd0\xad\xb1\xe3\xda\x01\xf4\x3a\xc3\xb2\xad\xe3\x06\x19\x92\x9f\xd8\xef\xed\xcc\xca\xa8\x20\x3a\x37\x9c\xc5\x42\xdf\xc3\xcb\x0c\xe9\xe3\x27\xec\x3a\xc5\xcf\xb8\x95\xd2\x4e\x24\x49\x6f\xa1\xf7\x7b\x61\xb1\x54\x7c\xb4\x9d\x70\xea\x9a\xf7\xd8\xe1\x49\x46\x94\x64\xa0\xeb\x8c\xf4\x11\x8c\xa8\xeb\x8b\x4a\x18\x66\x5f\x52\x79\xa4\x59\x94\x78\x0d\x4e\xd9\xcc\xb8\x92\xa6\x0e\x91\x3b\x87\x78\xf7\xc9\xfa\x0d\xf6\xfe\x25\xbc\x71\x27\xd6\xc1\x63\x3a\xd5\x09\xfe\x3d\x35\x03\x73\x42\x7f\x27\x73\x13\xf2\xbc\x58\xe8\x63\xd3\xb3\x5d\xb2\xf4\xc2\x65\xca\x19\x16\x5f\x30\x33\x2b\xaa\x36\xba\x62\x03\xbb\xd9\x20\x52\x95\x78\xf6\x07\x4b\x0b\x9d\xe9\xc7\x03\x62\x04\xaa\xaf\xf2\x08\x0b\xab\xf8\xae\x83\x26\xb9\xc9\xc4\x91\xf4\xb8\x5d\x62\x6c\x4c\x14\x6a\x16\x71\x03\x22\xa9\x14\xc7\xe5\x41\xa9\xe8\x11\x57\x2d\x0a\x4c\x2f\x74\x50\x9a\x87\xa6\x67\x8e\x60\xc0\x64\xed\x5c\x0c\x93\xcf\x93\x14\xd9\x83\xa5\x74\xea\x2a\x12\x75\x33\x85\xa3\x39\xd5\xd9\xec\xeb\x7e\xcf\x23\x45\x0b\xf5\xa0\xe7\x95\x63\x9b\x4e\x5e\x7e\x40\xd3\xfe\xed\x59\x10\x40\x2f\x3a\xa0\x95\xfb\xf3\xa7\x5b\x2c\x15\x5f\x18\x71\xc3\x2d\xda\x65\xb3\x54\x8c\xe2\xa7\x01\x2a\xba\xff\xf3\x67\x50\x42\xa5\xcc\x4c\x43\xf3\xde\xe8\xca\x14\x82\xe4\x54\xa1\xaa\x22\x75\x85\x49\xcd\xb0\x50\xb6\xc2\x99\xaf\x0f\x29\xbe\x3f\xf3\x5c\x27\x8c\x20\xd5\x43\x26\xd1\x32\x09\x2d\xb2\xeb\x43\xa5\xc7\xe8\x4f\xd4\x6d\x24\x66\x5a\x8c\x4a\x9d\x62\x52\xd9\x66\xa9\x08\x69\x3e\x74\xaf\xa4\x33\xf3\x75\x4f\x87\x89\x85\x92\x46\x5e\xc2\xb3\x74\x4e\x31\xa7\xd0\x82\xce\x2d\x14\x5a\x2b\x2c\x3d\x0a\xb8\x4f\xde\x44\x54\x21\x5f\xa2\x8d\x0d\xfe\xb9\x58\x5a\xe8\x62\x5b\xe7\xec\x0a\xeb\x45\x8f\x7a\xb0\x1c\x37\x5d\xdd\xeb\xc1\xd4\x22\xd0\x74\x11\x35\x5c\xdd\xed\xa1\xe9\x61\xdc\x6e\x75\x93\xb5\x21\xa7\x27\xf4\x19\xba\xd5\x33\xcb\x24\x5d\xbc\x46\x66\xea\x5f\x35\x0e\xa0\xe9\xf1\xea\x00\x33\xf4\xcc\x5c\xb7\xd7\x8b\xfe\x44\xff\xc6\xaa\x2d\x5f\xc3\xfb\xb0\x38\x2a\x95\x87\x7e\xb0\x6b\x5a\x63\xd9\x3c\x87\xbc\x95\x5d\x08\xbf\xc2\xef\x8b\x92\x6e\x15\x27\xc5\xc7\x85\x1e\xea\x8f\xa4\x3a\x3a\x8c\x89\xe8\x49\xf7\x14\x25\x7d\x53\xdc\x31\x05\x6c\xaf\xe4\x0a\xbd\xb6\x83\xe7\x1b\x8b\x52\x37\x2a\x9b\xbd\xfe\x93\x16\xed\x85\x85\x79\x14\x4b\x5c\xaa\x27\x5d\x4b\x5f\x18\x9c\x8a\x51\xe9\xcf\xfe\xd7\xe8\x3b\xee\x2a\xbb\xf3\x6c\xe8\xc6\x86\x53\xd4\x8e\x2e\x76\x4f\x4f\xf7\x77\x76\xfb\x5b\x6f\xce\x76\xb5\x5e\xaf\xe7\x21\x57\xd9\xb4\xa2\x6d\x34\x37\xde\xf7\x22\x18\x0c\x4d\x0b\x76\x0a\x57\xfe\x0c\x7f\xb2\x86\x5c\xb2\xc9\x34\x72\xbc\x51\x21\xf2\x0b\xfe\x2d\x0c\x02\xc7\x86\x85\xff\xfd\x2d\xfc\xdf\xc2\x30\xf0\x27\x85\x7b\x7f\x16\xd0\x5d\x80\x70\x0a\x2d\x67\xe8\x10\xdd\x95\x0b\xbb\x5e\x38\x0b\x60\x21\x1a\x9b\x11\x41\x91\xaf\x17\x0b\x68\x92\x18\x16\x6c\x1f\xaf\x83\x23\x46\xd7\x9c\x92\x17\xfc\x70\x4e\x28\x2c\x2c\x6b\x7a\x54\xd2\x21\xce\xf6\xce\xee\xdb\xfd\x8f\xbb\xfd\xc3\x37\x1f\xaf\x70\xa6\x7f\xfe\x64\x45\xfd\xc3\xdd\xd3\x77\xbb\x3b\xeb\x17\x87\xcc\xd1\x48\x61\x7c\x8f\x16\x25\xae\xde\xc2\xc4\x27\xb9\xf7\x0a\xbe\x67\xc1\x72\xe1\x7c\xec\x84\x05\xcb\xf7\x86\xae\x83\x72\x69\xde\x17\x06\xb0\x60\xcf\x20\x62\x32\x0b\x13\xe7\xce\xf1\x70\x9e\xd3\x2a\x0c\x8b\x10\x55\xa0\x33\x2c\xa2\xbf\x65\xfb\x0b\x5c\x7e\x71\x36\x7f\xcf\xe4\x12\x19\x8d\xc9\xe4\x8b\x28\xdb\x0f\x0a\xe9\x16\x46\x98\x64\xa0\xb0\xef\x85\x11\x34\x6d\xbd\xf0\x63\x16\x46\x34\x6e\x00\x47\x33\x17\xb9\x39\xa4\xb1\x68\x25\xdd\x29\xbe\x88\x8a\x5e\xe9\x09\xa9\xaf\x9d\x16\x9d\xdf\x32\x9b\x1c\xba\xdb\xf3\xcb\xfd\x7e\x80\x92\x7e\x33\x8b\xfc\x2d\xc7\xb3\x8f\x4d\x27\x08\xbb\x9e\x68\xb6\x56\x69\x63\xe3\xb6\x8c\x93\xc3\xca\xa4\x3f\xd3\x25\x6d\x93\x78\x6e\x48\xc5\x62\x5c\xb3\xb4\xb1\x61\xbe\xe8\xf5\x2c\xd2\x64\xc2\x9e\xf7\xd5\xfc\xae\xcf\x7a\x7e\x16\x88\x06\xc9\xa0\x38\xd3\xcd\x92\x7e\x9b\x0d\x2d\xdd\x7e\x35\xbf\x17\xa1\x1e\x96\xba\xd0\x0d\xe1\x23\x99\x07\x66\x1a\xa0\x89\x1c\xd7\xb4\x8e\x7b\x71\x1d\x87\xfa\xb8\x37\xd8\xd8\x78\x61\x6f\x6c\xbc\x98\x6d\x6c\xbc\x30\x5e\xf4\x7a\x5e\xd9\x9c\x45\xfe\xc0\xf1\x6c\x94\xf4\xb8\xe4\x92\xcf\x81\x4d\x3d\x2c\xe9\xfe\x57\xf3\x7b\x2f\xc4\x49\x15\x9c\x61\x71\x46\xb2\x3f\xe9\xf5\xbf\x9a\xdf\xbb\x4e\xd1\xde\xd8\x28\x2a\xac\x7f\xc2\xb7\x0b\x2c\x12\xea\xf8\x93\x07\xef\xa6\xe4\xeb\x22\xd4\x5c\x0b\x53\xdf\x75\xac\xfb\xc2\x6f\x61\x61\xe8\x07\x85\x6b\x88\x7f\xce\xc7\xd0\x23\x75\x3c\x42\xea\x4d\x2b\x1f\x45\x41\x0d\x73\x82\x34\xa5\xca\xc4\x9f\xb8\x00\xc3\x22\xfa\x47\x0f\x4b\x9d\x4c\x96\x36\x36\x70\x58\x6f\x1a\x43\x4a\x0b\x5c\x58\x52\xf0\xc5\x02\x3f\x75\xd3\x66\x34\x4b\x76\xe8\xa2\x52\xb2\x53\x84\x32\x16\x71\xbb\x43\x28\x5c\xac\x13\xaf\x44\x20\x41\x0f\x47\xb8\xed\x3a\xc5\x17\x81\xfe\xbb\x60\xf5\xea\xae\x01\xd9\x73\x08\x83\x5b\x68\x17\xa6\x94\x53\xc7\xfd\x85\x4e\xfa\xb5\x70\xec\xcf\x5c\xdb\xfb\x86\x77\x25\x51\x1f\x12\x8d\x61\x41\x43\xa3\xa5\x63\x85\x1a\xd2\x67\xb9\xb0\x43\xa8\x1c\xd2\x76\xbc\x64\x8f\x35\x61\xc4\x12\x68\xda\x5d\x84\x99\x3b\xae\x5b\x08\x23\xf4\xf7\x00\x16\x4c\x7c\x90\xa7\x33\x70\x13\x72\x66\x0b\xb0\xfc\xbb\xee\x25\x4b\x3b\x78\x9a\x87\x8a\xe7\x66\x1a\xf5\xbf\xa8\xe7\x43\x0e\xcd\x57\xef\x7b\xcf\x5f\x30\xdb\xcb\x36\xad\xbb\x22\xdc\xd8\x88\x36\x36\xe2\x2d\xda\xa4\x71\x40\x89\x2c\xd2\xb5\x09\x0c\x46\x70\xdf\x8b\xfc\x4b\x27\x1a\x7f\xf4\x77\x66\x53\x17\x8d\x2b\xf0\x03\x1a\xe7\x4b\x9d\x02\xdd\x84\xc6\xb0\x82\xe7\x7b\x80\x70\x84\xa8\xbf\x11\x4c\x44\x62\x13\x1b\x1b\x45\xa7\x78\xeb\x3b\x76\xa1\xd2\xeb\xf5\x50\xa6\x97\xa7\x78\x1e\x38\xe4\x7d\x19\x92\x66\x34\xf7\x69\x17\x17\x92\xb1\x0b\xd5\x4d\x68\x4e\x20\xaa\xf3\x0e\x56\xec\x4a\x7a\xeb\xa2\x4c\x4e\xcd\x20\x72\x2c\xd4\x6d\xea\xf8\x35\xe2\x18\x6a\x99\xb3\x10\xda\x85\xc1\x3d\x4e\x4e\x98\x56\x15\x4b\x68\x0c\x10\xe6\x70\xc5\x52\x3c\x7c\x16\x88\x47\x81\xea\x9b\xcb\x27\x1a\x3d\xc6\x48\x8a\x5c\x26\xa6\xd6\x50\x13\x4a\x7c\x2c\xc6\x0d\x21\x5b\xc0\x92\xe5\x7c\xe2\x86\x28\x36\xd2\x74\xbf\x17\xc9\x43\x50\x33\x25\x2e\x9c\x17\xcf\x4a\xfc\x54\xe6\xc7\x32\x8f\xae\x71\x3e\x2e\xe2\x3c\xd9\x45\xec\x33\xa3\x7f\xfc\x92\x1e\x30\x36\x36\x55\x65\x51\x95\x39\x45\xd6\x18\xca\x01\xeb\x6a\x45\x65\xd4\x51\x17\x21\xb3\xfa\x91\x00\xc7\xfc\xea\x03\x94\x8e\x6b\x7a\x66\x5f\xbb\x57\x65\xbb\x2e\x3d\x40\xff\xbc\x34\xbe\x77\xf1\xaa\x02\x4a\x3c\x60\x73\x33\x49\x27\xa1\x3e\xe3\xda\xea\xbe\x1e\xc4\x9e\xbd\x24\x55\x9a\xda\xc6\x06\xd9\x75\xa4\xb3\x1a\xec\x8a\xf7\x20\x79\xb0\xc8\x1c\xb1\xe7\xd3\x19\x12\x1c\x86\x3d\x93\xfc\x26\x9b\x34\x41\x2f\xf8\xf9\xd3\x63\xb6\x12\x7a\xd8\xbd\x24\x7d\x0e\x96\x0a\x56\xf9\xa7\x4c\x58\x2c\x11\xaf\xd4\x29\x66\xda\xba\xbb\xb1\xf1\x02\xef\x40\x97\x9d\x90\xec\x44\xbb\x25\x5d\xfb\x4d\x46\x41\x5e\xad\x88\x57\x9a\x3d\x6a\xd6\xa8\x11\x20\x72\x4d\x8f\xca\xcc\x8a\xc7\xcf\x9f\xb4\x17\x44\x9d\x5a\xe8\x44\x30\x59\xee\xd7\xd8\x9d\x91\x9e\x8b\xbd\xf5\xf4\xfd\x0b\x0f\xce\x0b\xd7\x3a\xbb\x06\xc4\x74\xb8\xbd\x88\x0b\x91\x29\xbd\xf7\xf5\xbb\x3e\x4a\xa6\x21\x21\xb1\x1c\xb2\x53\x57\x2a\xe9\x61\x31\xd2\xef\xc9\x3f\x90\xfc\x73\x87\xac\x51\x68\xc4\x1b\x1b\xc5\x64\xbb\x01\x0b\x7a\x19\x48\xb1\x84\xf7\xbe\x99\xcc\xd0\x69\x14\x9d\x3f\xe1\xce\xbf\x58\x2e\x97\x51\x5f\x99\x75\xce\x89\x2a\x9d\xc9\x94\x4c\x5c\x0a\x66\xe1\x7f\x49\xfc\xff\xa5\xdd\x07\xdb\x8f\x06\xa8\x8b\xea\x97\x98\xc4\xbe\x06\xdf\x7f\xfe\x2c\xf2\x02\x6c\x18\x49\x0b\x89\x16\x64\xad\xf6\xeb\x77\xbd\xdf\x7b\x24\x4e\x1b\xef\x08\xe8\x74\x90\x14\xa4\xe9\xfa\x14\x2f\xa7\x96\x2a\x0d\x1a\x3b\xae\xbd\xad\x0e\x17\x97\xb9\x64\x7e\x4b\x66\xcd\x4a\x05\xda\x66\x12\x93\x83\xe8\x3c\x3a\x0e\x3b\xfa\xb8\xbd\xab\xc9\xd6\x37\xc4\xf2\x51\xc0\x8e\x63\xe7\x86\x67\xd6\x01\x04\xad\x62\x87\x24\x31\x76\xba\x5e\x98\x93\x19\x01\x91\xcd\x4d\x3e\x80\x5d\x6e\xe0\x21\xa4\x03\x49\x72\xd2\x11\xe6\x9e\x0b\xfd\xb6\xc7\x2d\x51\xb2\x93\x65\xd4\x75\x33\x61\xbd\x68\xa1\x53\x1b\xe2\x51\xbc\x4b\x98\x7d\x79\x08\xcd\x19\xf0\xc0\xb6\x90\x58\x89\x98\x60\x06\xd1\x73\x8b\x8f\x0b\x5d\x12\x80\x26\x79\xbc\x45\x66\xb8\x64\x34\x22\x83\x72\xfd\x95\x92\x08\xe1\x7f\x66\x24\xbd\x61\x31\x23\x23\xef\x6b\x89\xc0\x68\xc1\xb4\x2c\x31\x9d\x74\xf3\x93\xe6\x34\x11\xe0\x6c\xc6\x0d\x95\x8f\x46\x9c\xf2\x85\x1e\xcf\x63\xd8\x85\xa3\xc5\x42\xbf\xef\x3d\x66\x4d\x3a\xb3\x1e\xd5\x77\x42\x1c\x02\xed\xde\x8b\xca\x62\xa1\xdf\x31\xb1\x72\x17\xb2\xd8\x88\xc6\x62\xa1\xcf\x7b\x8f\x01\x9c\xba\xa6\x05\x85\x25\x67\x9c\x57\x76\x40\x2b\x43\x0f\x7f\x9e\x75\xca\xc0\xe9\x42\x30\x2e\x4f\x42\x9c\x5d\xb6\x7e\xf1\x42\x4c\x7c\xb1\xd0\xaf\xd9\x37\x84\x12\x67\xc5\x2d\x5e\x33\xf3\x5d\x76\xee\x3b\x2f\xe9\x93\x45\xbc\x1d\x6b\xd4\xe8\xa6\x49\x0b\xef\x9a\x14\xab\xad\x92\x6e\xf5\x34\x62\xeb\x5a\x17\x96\xe1\xdd\xd4\x0f\xa2\xb0\x17\xac\xb5\x0d\x84\x82\xe3\x2c\xc3\xf2\xd4\x44\x4e\x00\x5d\x83\x74\xcb\x83\x52\x91\xc8\x4a\xe5\xd0\x9f\x40\xd9\x52\x59\x44\x57\xf3\xbe\xc2\xef\x78\xae\x9a\x3c\x2d\x4a\x99\x17\x47\xa9\x1b\xb8\xb1\xc1\xac\x06\x46\xf1\x4f\xb7\x47\xbc\x6c\xdd\x8c\x7f\x38\xf4\x47\x97\x2c\x8a\x90\x0d\xdf\x17\x46\xd7\xed\x79\xe5\xa1\xe3\x46\x30\xe0\x0e\xce\x72\x86\x45\x8b\xba\x84\x2f\x2a\xd4\xed\x00\x46\xaf\xd7\x0b\x92\xfd\x2c\xaf\xf4\xf3\xa7\x5f\xf4\x70\xf5\x25\xea\xdf\xd8\x28\x5a\xf8\x2d\x3f\x77\x51\xd2\xdd\x72\x00\x6f\x61\x10\xc2\x22\xd2\xf2\xd7\xef\xba\x89\xfe\x0a\xa4\x8b\x85\xc4\xcb\xc2\x49\x78\xcc\x99\x7b\xba\xdf\x03\x68\xde\xee\x72\xe7\xf0\xfd\xfc\xe9\xff\xe9\xc4\xa7\xea\x75\xcc\xf8\xd7\x82\xce\x67\xdd\x24\x2d\xa7\x17\x74\xe3\xad\x0f\x68\xde\xd2\x1d\xe3\x8e\xab\x5b\xf8\x4c\x1a\xfa\x68\xea\xf8\x26\x02\xfa\xe4\x2c\x52\x33\xa9\x90\x25\xc5\xf5\xac\xe0\x2f\x7b\xa9\x37\xc8\xbc\xc1\x49\x5e\x8c\x24\x1e\x3b\xa4\x1d\xf0\x3f\x3d\xdd\xe5\xde\xf9\x4c\xba\xe9\xcc\x4b\x88\x3e\x7d\x09\xd1\x2b\xe9\x6e\xaf\xd2\x75\xff\xe9\x75\xdd\x97\x2f\x4b\xfe\x57\x97\x7d\x09\xd1\xc5\x53\xfc\xc4\xa7\xc7\xb3\x80\xd2\x63\xb1\xa2\xfb\x5f\x7d\xca\x04\x8c\xef\xa5\x62\x69\x91\x34\x43\xbf\x6c\xda\x36\xf2\x28\xdd\x05\xf3\x16\x60\xc4\x18\x7a\x00\xed\x99\x05\x85\x35\xfc\xd8\xcd\x2f\xfb\xde\x2e\xaa\x91\xe4\xb8\x36\x58\x4c\x64\xba\xa7\xd7\xf4\xb0\x54\xd2\xa3\x85\xfe\xf5\x3b\xa3\x1d\x6f\x2d\x7e\x72\x28\x91\x90\x00\x11\xea\x9e\x5e\xd7\x67\x92\x14\xfc\xcc\xca\x30\x59\x42\xee\xc1\x05\x79\xd1\x3a\xde\x7f\x46\x2d\xcd\x2b\xc6\xaf\x8b\xd0\x86\x97\x74\x04\x66\xa9\x08\x59\x7b\xf2\x74\xb7\xf4\x18\xe1\x69\x05\xdb\x22\xe0\xcf\x9f\xc1\x9f\x2e\x9e\x92\x74\xf0\x7b\x87\x0b\xdd\x13\xba\x01\x0f\x55\x44\x58\xc6\x77\x71\x14\xe3\x15\x89\x88\x99\x30\xf9\x45\x37\xae\x6b\xce\x80\x13\xa3\x61\xec\x24\x44\xf3\xf5\xa2\xfb\x15\x7e\x2f\xe1\x97\x76\x49\x75\xc7\xfd\x48\x3a\x89\x43\xd3\x95\x89\x7f\x0b\x09\xb4\xb4\xe8\xa2\x7f\x8b\x9e\x1e\xa1\xb9\x13\xfa\x2f\xc9\xa2\x83\xd3\x0a\x50\xf1\x66\x5c\x26\x51\xd7\xcb\x66\xd2\x94\x64\x32\xb1\x6c\xce\x98\x67\x38\x93\xe6\xd2\x4c\xce\xe2\x4c\x9a\x34\x93\xe8\xdf\x22\xca\x0c\xd2\xf6\x02\xfd\x97\x64\xd3\xca\xbc\x0b\xad\xfb\x49\x6b\xea\x7a\xff\xf4\xbb\x2f\x5f\x7a\x25\x34\x59\x2f\xfb\xde\x01\xea\x46\x36\x36\xd8\x27\xba\x65\x8f\xe6\x93\x51\x69\x41\x97\x3c\xe1\xbc\x60\xea\x33\xf2\x6f\xdc\x05\x05\x33\x62\xc2\x7b\xbe\x7f\x1d\x76\x02\x3d\x98\x51\x8b\x23\x02\x07\x09\x30\x23\x79\xb6\x16\x0b\xdc\xf9\x24\x2f\x11\x55\x2b\x68\xd4\x62\x7a\x00\x76\x87\xb4\xeb\xc7\xdb\xaa\x64\x9a\x35\x46\x1c\xa8\x0f\xc4\x4f\xa6\x6d\xf7\xa4\x03\x0e\x86\x25\x1d\x68\x3c\x1d\x45\xaa\xcb\xc1\xf7\xe2\x78\xe2\xc8\xc1\xb4\xc0\x17\xbd\x1e\x5c\xc4\x8c\x63\x33\x94\xd0\xe1\x9e\x3d\xa6\x4a\xfb\x77\x1a\x07\x1b\x8c\xe4\x35\xe0\x28\x29\xda\x62\x9d\x83\xd1\x69\xbf\x0c\x7b\xbd\x28\x1d\xd8\x92\xf5\x0f\xf8\xf3\x27\xdd\xdf\x8a\x03\x0d\x14\xc8\xcf\x90\x61\x29\x6e\xe1\xbc\x3c\x2a\xa1\x51\x98\xd8\x4b\x0f\x95\x28\x5e\x00\x80\x65\x34\xfe\xdd\xb3\x4b\x07\x69\x2f\xe4\x27\x3e\x32\x5e\x9b\x49\x67\xe8\x3d\x66\x89\xec\x4f\x6d\xe6\x91\x35\x43\x5b\xeb\x84\x28\x07\x8c\xa1\x92\x37\x4c\x9c\x61\x71\xc5\x77\x4a\xbc\x12\x02\x73\xeb\x6f\x58\x27\x44\x82\xea\x02\x49\xe2\xf2\xe3\xb1\x14\x0f\x65\x2b\xd1\x23\xed\x73\xba\x7b\xe1\xd3\x36\x81\x48\x53\xd6\x58\xf7\xf1\xdb\x1b\xf4\x8d\x6e\x58\xea\xf5\x7a\xf4\x77\x94\xf5\x76\x08\x58\x7b\xa5\x61\x8b\xb1\xc6\x66\xf0\x26\x2a\x56\x50\x1f\x10\xf5\xb4\x57\xda\xcb\xa8\xa4\x93\x40\x18\x07\xc2\x64\x68\xc2\x6f\x8d\xbc\x44\xb0\x18\x94\x30\x44\x1c\x28\xa2\xa0\x08\x29\x47\xf8\x96\x06\x77\xfb\xf1\x5b\x2b\x3d\xa8\x07\xa8\x69\x51\x47\x03\x79\x57\xc9\xf4\xc7\xfc\xa7\xd3\x7d\xf9\xd2\x8c\xf7\x3e\x22\xb2\xf7\x11\x62\xcf\xef\xe7\x4f\x4d\xc3\x75\xfd\x0a\x55\xf3\x8c\x2b\x07\x47\x5a\xd2\xe9\xd7\x2f\xfe\xc6\x06\xdd\x87\xb0\xe9\x27\x2a\x45\xab\x6c\x95\x8a\x33\xdd\xc7\x56\x63\xff\x59\xf4\x7b\x36\x6a\xb1\xa6\xe3\x39\xde\xe8\xd8\x8c\xc6\x9e\x39\x21\x54\xf1\xbb\xfe\x81\x6e\xff\xff\xec\x5d\x7b\x93\xe3\x36\x72\xff\x2a\x16\x9d\x30\x80\x05\x73\xa5\xd9\x97\x4d\x19\x56\xf9\x1c\xfb\xee\x2a\x4e\xec\x6c\xec\xdc\x1f\x5a\xc6\x45\x51\xd0\x90\x39\x0a\x50\x40\x6a\x7d\x53\x12\xbf\x7b\x0a\x8d\x07\x41\x12\x9a\xc7\xc6\x4e\xf6\x2a\xf7\xcf\x8c\xf8\x02\xc9\x46\xa3\xfb\xd7\x4f\x6a\x28\xa9\x6c\xba\x06\xc3\x1d\xec\xb1\xda\x1e\xfb\xf7\xbc\x3e\xb1\x06\xe3\x54\x50\x9d\xd7\xaa\x1e\xd1\xb9\x05\xe5\x94\x93\xfb\xb5\x6e\xa6\xad\xde\xb4\x99\x37\x8b\x5c\x49\xde\xce\x95\x73\xcc\x96\x93\xd2\x9f\x40\x60\x99\x52\x96\xba\x15\xa9\x57\xed\x58\x9f\x70\x22\x48\x65\x11\xac\x46\xd4\xf0\xc6\x0d\x85\x06\x10\x7d\xaa\x87\x26\x21\x8f\x63\xa4\x67\xbd\x18\xd0\xbb\x00\xbe\x29\x30\x99\xcd\x90\x44\x05\xe1\x6e\x24\x7c\xb9\xcc\xda\x38\xae\x51\x01\x77\xc2\x71\x9c\xa3\x86\x70\x3d\x38\xc6\x20\x94\x2b\x97\x32\xb9\x5c\x60\xd2\x84\xc2\x4f\xff\x76\x77\xd8\x8a\x3a\x8e\xa3\x06\x7e\x8c\x0f\x24\x55\xcb\x64\xde\x0a\xb9\x0e\xc9\x59\xe3\xa4\xef\x42\x29\x40\xcc\xaf\xae\x9a\xdc\x6e\xe8\x38\xa3\xd4\xed\x9f\xd9\xdf\xfd\x42\x5e\xdb\x67\x4b\xdd\x0d\x9f\x6c\xef\x40\xf4\xc7\x19\x8f\x97\x8b\xb7\xd1\x8c\x00\x91\xe9\xaf\x78\xed\x64\x13\xb0\xd4\x29\x2d\xfe\x39\xfe\x76\xa3\xb8\xde\xc1\x17\x61\x65\x8f\x12\x6e\x16\x61\xe5\x0a\x61\x49\x1c\xc7\xf2\x6a\x31\x87\x79\x16\xa6\x31\x15\x18\x0d\x0a\x62\x8d\xa5\xce\xd0\x76\x33\xb6\x95\x0f\x29\x15\xfe\xd2\x94\x50\xd8\xc7\x68\xff\x5e\x55\x73\xf4\xfc\xe6\x7d\x2c\x08\xe0\x71\xa9\xf5\x95\xf6\x82\x68\xdb\xc4\xd0\x73\x63\xfb\xac\xf4\x47\x00\xa2\xcf\xc0\xff\xf0\xf5\xf4\xfc\xcc\x40\x88\x59\x6f\x07\x92\x82\x9e\xbd\x74\x0b\x93\x65\x91\x43\x96\x5d\x07\x6b\x69\x38\x14\x2a\xc8\xd4\x05\x44\x67\xcc\x19\xb8\x7b\x45\xa4\x16\x93\xda\x9f\x74\x54\xd1\x0d\x23\x6d\x86\x57\x9a\xa2\x1d\x1e\xe5\x61\x9d\xd4\x5c\x35\x38\x8e\x9b\xab\x73\x25\xf5\x5c\xf9\xb7\x61\x18\x90\x26\xa9\xe9\x6c\x49\xaa\x49\xea\x06\xa9\x95\x80\x00\xe2\x55\x2e\x8b\x19\x57\x66\xce\xfd\x7d\x2e\xd8\xcb\xb4\xdf\xdb\x1d\x30\xe2\xe5\x0a\x89\x14\x82\xdc\xd1\xd1\x35\x53\x0a\x21\x46\xa6\x04\xda\x2c\x40\xe3\xfb\xef\xbf\xc3\x71\xbc\xbb\xfa\xfe\x55\xe8\xfd\x61\x14\x52\x19\xde\x1d\xf3\xc9\xe5\x32\xe1\x84\xb3\x4e\x69\xf6\x83\x18\x9a\x44\x83\x35\x5a\x29\x58\xae\x11\xba\x98\x80\x3b\xf7\x48\xc6\xb7\xd1\xbf\x45\xa3\xde\x22\x04\xe2\x41\x52\x4b\x00\xe1\x66\x62\x86\x06\x99\x62\xa2\xcb\x85\xdb\xe5\xbc\x51\x27\x3a\xd5\x34\x04\x59\x1c\xaf\x79\x0a\xb1\xb2\x0a\xc1\x72\xd3\xa1\x6b\x6d\x18\x8d\x09\x6f\x1c\xff\xea\xd0\x96\x0a\xc7\x15\x47\xbc\xda\xc6\xf1\x71\x68\x35\x6c\x2d\x11\xd1\xb8\x7e\xd6\x03\x6b\x53\x83\x72\x60\x46\xf1\x38\xe6\x1b\xe1\x8a\xf5\x46\xb0\x51\xe9\xc5\xb5\xfa\xab\x71\xb6\xc4\xa9\xda\xa0\x2d\xe0\xb7\xf5\x46\x6d\x10\x99\xa5\x92\xb0\x4e\x89\xa4\x2b\x6a\x52\xfb\x08\x83\x0a\xb1\x26\x15\x31\x53\x7c\x32\x2a\xf1\x3e\xa8\xc1\x69\xdb\xab\xcd\x5a\xfb\x49\x3c\xcc\xc1\x01\x73\xb4\xf2\xee\xac\x13\xe8\x5d\x16\x21\xe0\x0e\x8e\x57\xfb\x35\xe2\x74\x1f\x00\x1d\x43\x60\xb1\x1f\x80\x8e\xca\x3b\x56\xd9\x63\x0e\x74\x70\x00\x1d\x5d\x91\x43\x5b\x76\x7c\x86\xaf\x36\xa8\xc7\x87\xe4\x22\xcb\xbd\x67\x93\x15\xa6\xd8\xc9\x2d\x47\xb5\xd8\x9d\xe3\x01\xe6\x54\x8b\x01\x45\x94\x89\xc8\x62\x58\x8d\xbc\xb2\xd0\x76\x8c\xe3\x8d\x5b\x6d\x85\x38\x3d\x1a\x79\x8f\x61\xd2\x0c\xcb\x70\x45\x7e\xe0\x96\x56\xf1\x91\x3e\xc3\x7e\x0e\x61\xd5\x68\xbe\x3b\xe2\x4e\xc1\x1e\x63\x5b\xcc\x28\x57\xcb\xb1\x18\xaf\xc5\x6d\xbf\x16\x41\xd6\x8b\x75\x83\x04\x4e\xe5\xba\x40\x92\xb4\x64\x9c\xdc\xd8\xa0\x16\xa7\x7c\x8d\x6c\x36\x68\x72\xe2\x4d\x59\xed\x95\xb1\x4f\xcc\x7d\x39\xc6\x69\x83\x94\x4a\x03\x01\xa8\x7f\x97\x86\xfd\x15\x35\xb6\x78\x55\xc6\xf1\x76\xc8\xfe\xa5\x79\xa1\x06\x8d\x4d\x62\xdf\xb9\x34\x76\x1d\x7d\xf9\x22\x8e\x9d\xd9\xd0\xbb\x8a\x5e\x64\x6b\x7f\x23\x05\x38\x3b\xb9\xf6\x65\xf0\xda\x97\xfe\xb5\x2f\xd5\xb5\x2b\x67\xaa\x08\x07\xe2\x7a\xce\x1d\x59\x01\x47\xb5\x3c\xa0\x9c\x4e\x1f\x4e\xc1\x2c\x70\x67\x77\x58\xc7\xc6\x1d\xc8\x23\xbe\xec\x62\x13\xd9\xc5\x49\x4e\x0a\x90\xe1\x60\xc3\x28\x7d\xec\x4b\x2f\xed\xc1\xbf\x5c\xda\xb5\xf6\x1e\xa4\xb9\xf3\xd0\x28\x75\x5f\xb8\xd2\x18\x05\x04\x4e\x1a\x08\x90\x9d\x81\x8b\x7b\x93\x57\xcf\xd1\x4b\x8c\xc9\x91\x7e\xf0\xf9\xf5\xef\x5b\x0b\xe1\xb2\x9f\x15\xd5\xd7\x36\x1d\x57\xfa\xae\xf0\x90\x2f\xf8\x91\xf6\x26\xd3\x25\x12\x2c\xa3\xad\x36\x35\x08\xc7\x69\x5f\x20\x61\x9d\xb7\xe2\x81\xa7\xb7\x65\x60\x4b\x0c\x19\xd6\x1c\x09\xa8\xac\x40\x3a\x44\x90\x70\x54\xeb\x18\xc1\xf3\x17\x98\x14\x1f\xfe\x5c\x91\x86\xe6\x93\x2a\x04\xaf\xe6\x6c\x9a\x6e\xdc\x17\xac\x8d\x2a\x0b\xaa\x24\x27\x05\x2c\xab\x3e\x97\xf8\x2c\x78\x7d\xf7\x55\xd1\x56\xef\xd8\xf7\x1c\x86\x85\x62\x3a\x97\x7d\xdb\xbc\x57\xf6\xed\x30\x83\x40\xd7\x33\x5e\x2e\x51\x9f\x3b\x70\x25\xfc\x01\x7d\x1a\xa0\x34\x8e\xed\x89\xa4\xa7\xc9\x8b\xff\xc9\x2b\x9b\x33\x91\xcc\x8d\x61\xc3\xa3\x62\x43\x53\xa8\x15\xe1\x6c\x18\x57\xb4\xd9\xc9\xdb\x64\xeb\x47\xf0\x06\xbb\x6f\x59\xfb\x27\x99\x1f\x8f\x6c\xf7\x47\xe3\xe8\x0f\x50\x95\x5f\x2e\xb9\xc9\xc6\x07\x2a\xfe\x32\xbc\xe2\x5a\xfa\xb7\xf6\xe9\x29\x89\xe5\x7d\x67\x05\xee\x7d\xb9\xf8\x19\x24\xb6\xec\x01\xfc\x2d\xb8\x2f\xce\x1b\xcd\x24\xf3\xa6\xd0\xba\xa5\x45\x9f\x3a\x2e\xfa\xec\x72\x05\xfc\x8c\xa1\x53\xd1\x72\x3c\xf9\xe6\xf5\x45\x9f\x95\xed\x00\xb2\x2b\x27\xc9\x7b\x90\xaf\x6c\xef\x2a\x91\x6c\x3f\x70\xfe\xb5\x63\x22\x50\xd6\x61\x12\x7a\xe8\x0a\xd8\xca\xf1\xa7\x1f\x99\xf6\x0a\x22\x51\x34\x57\xcc\x34\x8f\x70\x44\x64\x62\xa6\xc4\xb1\x0e\x65\x64\x8f\x30\x82\xa2\x8e\x91\x3b\x75\xb4\xc0\x97\x50\x38\x95\x70\xdd\x93\x01\x04\xc0\x09\xb6\x1b\x2d\xba\x5f\xbf\x86\x8a\xa9\x84\xa3\x9d\x29\x99\xba\xd1\x25\x53\x37\xcf\x31\x29\x3f\x7c\xc9\xf0\x28\x39\x78\x9e\xc6\xeb\x75\x43\x93\xc1\xb6\x9f\x24\x03\xdb\xde\x9a\x9b\x2d\x26\x01\xf6\xd9\xc2\x2e\xbe\xd9\xc2\x5b\x4d\xb3\x05\x81\xbe\xf4\xb3\x45\x47\x24\x3d\x73\x73\xb5\xa6\x8a\x39\x55\xbf\x2a\x3c\x43\x5e\xd7\x4c\xaa\x5f\x8e\x48\x7a\xa3\x6a\xef\x60\x88\x3a\xe4\x85\xe9\x9b\x63\x78\xa4\xd2\x7e\x90\xc6\x8b\xf3\x8e\x29\xa3\xa0\xa7\x2e\x41\xf6\x7a\xd2\xe8\x49\xca\x69\x70\x4c\x80\xb9\x0a\x05\xd6\x71\x8c\x72\x9a\x5b\xac\x7b\xdf\x03\x28\xc4\xe9\x77\x09\x59\xac\xaa\x2f\xac\x19\xb5\x9a\xcf\x2b\x0c\x81\x44\xb1\xc9\x37\x55\x96\x5d\x2e\xd2\xfe\x00\x73\x03\x7e\x63\xac\x70\x3a\xd3\x1b\xb4\xd5\xff\x7b\x18\xdd\x3d\x71\xf6\x81\xe9\x07\x5a\x10\xfc\x18\xc8\xac\x12\x83\x5a\x6e\xf0\xb8\xd6\xf0\xa5\x5e\x31\xcb\x1b\xb5\x64\x64\x58\xfd\xf4\x95\xc1\x36\xb1\xe1\xec\x55\x03\x7d\x2b\xc5\x01\x12\xc7\x6c\x4d\xc7\x28\x93\x00\x72\xb3\x7a\xcb\x9d\xf6\xe2\x22\x78\x39\xb8\x08\x06\x92\xbb\x15\x69\x6d\xaa\xca\x93\xaa\x79\xc3\xfe\xeb\x54\x49\xb6\x23\xe0\xd4\x4b\x6b\x5b\x33\x08\xc9\x69\xfd\xa6\x89\x39\xa6\x4d\x52\xf4\xe5\x84\x4d\x52\x84\xc4\x76\xa5\xc5\xbc\x55\x84\xa7\xdf\x92\xe0\x8f\xa2\xb7\x2e\xaa\xfe\x35\x88\xed\x3c\xcf\x39\x9e\x12\x56\xe1\x38\x4d\x20\x97\x72\xd4\x28\xe0\xd0\x97\xeb\x34\xc9\x96\xf8\x3e\xbc\xb4\xd6\x65\x94\x03\xbf\x9e\xd9\xf9\xbf\x47\xd9\xa7\x12\xf5\x29\xf4\x2c\x92\x7c\x4a\x23\xcb\x7e\x1f\x0c\xa1\xae\xe2\xf6\xdf\xba\x86\xf9\x4a\x2e\x8b\xab\x80\x94\x7d\x89\x5b\xee\x97\xbe\x1d\xc1\x98\xdd\x44\xa5\xad\xdd\xd6\xf0\xad\x89\xfa\xf2\xfc\x28\xc3\x2b\x85\xb9\x2e\x17\x43\x0c\xc2\x95\x01\xee\x73\xf0\xd1\x56\xde\xfb\x16\x20\x27\xce\x23\xb7\xc5\x48\x62\xbc\xca\x69\xbe\xe6\x46\xc6\xd8\xd2\x4a\x94\xe3\x94\x07\x4a\x2e\x11\x26\x5b\x5d\xfd\x89\x72\x32\x4e\x14\xd6\x4a\xa3\x4f\xbf\x31\xbb\xaa\xde\xb1\x02\xb5\xa8\x5b\x22\xd5\x4d\xf7\x0a\x73\x49\x07\xb5\xaa\x61\xb1\xf1\x79\x52\x0d\x99\x6e\x89\x3d\xb5\xeb\x70\x07\x79\x04\x71\x3c\x79\x70\x41\xea\xe4\xcd\x37\x3f\x7c\xf7\xd5\xd7\xdf\x60\x92\x2b\x5b\xd5\x25\xb9\xbc\x7a\x60\x5d\x3c\x7f\x65\xf0\xd0\x52\x9b\xb2\x2f\x35\x1c\x7a\xfe\x5c\xc1\xa1\x0f\x1e\xf5\x3c\xd9\xfd\x8e\xcf\xcd\x2f\x95\xfe\x3a\x60\x2e\x65\x7e\xf7\x2d\xf4\x48\xc0\xe7\x22\x6f\x58\x04\x0b\x21\x4a\x1f\xe8\x50\x06\x01\x2c\xca\xd7\x1b\x6d\xa6\x92\x68\x13\x11\x41\xa2\x2c\xca\x92\xff\x14\x15\x47\x51\x84\x53\xff\x58\x8d\x04\xfc\xcc\xa8\xfa\xcd\x09\xc3\xfd\x89\xdd\x0a\x6e\xbc\x95\x79\xf1\x67\xd6\x06\x6f\x3d\xbd\xb1\x1e\xdb\xbb\x47\x78\x64\xdb\x97\xf0\xbd\xc6\x0c\x8d\x38\xec\x6f\x68\x4c\x99\xd5\xd3\xe9\x69\xd2\x3f\x20\xc6\xf0\xec\xed\x06\xbd\xdd\x7d\x82\xdf\x66\x7f\xf7\x2c\x61\x7f\x61\x05\x62\x98\x30\x25\x21\x74\x26\x21\x7a\xf6\x76\xf3\x76\xf7\x89\x3a\x4c\xa2\x08\x93\x59\x3b\x88\x40\x88\x0d\xcb\x28\xc7\x9e\x2f\x69\xc3\x32\xa8\x84\x62\x19\x3d\x77\x98\xa8\x1f\x9b\x76\xb3\xcc\x32\xca\x1f\x22\x36\x1b\xcc\x73\x4b\x9f\xa1\xb7\x9b\xb7\x19\xbe\xe7\xc1\xec\x53\xb5\xeb\xc1\x03\xac\xfb\x67\xdb\xf0\x0c\xa7\xde\xa6\xf3\x92\xaa\x4d\x70\xea\xf9\xef\x71\x7d\xd2\x7a\xe4\xea\xee\x04\x21\x58\x9f\x18\xda\x5b\x82\x57\x7c\x78\x27\x0e\xfe\xf9\xc1\xf4\xd5\x03\xdf\x73\x9b\x30\x5e\x88\x1d\x5b\xeb\xc6\x3c\x45\xbb\xae\x10\xc3\xa9\xde\xf9\xd3\x9b\x3f\x3a\xed\x04\x7b\x07\x1e\x6c\x37\xc6\x38\x97\x62\xcd\x92\x46\xc8\x16\xe1\x74\x5a\x9c\xb4\xce\x07\x05\xc6\x0c\x63\x7d\x6e\x30\xfc\xfc\x2f\xa7\xc3\x96\x29\x76\xfb\xd4\xfc\x6a\x71\x87\xaf\x7d\x1e\x8f\x6d\xda\xac\x53\xcf\x68\x9b\xe5\x7c\xf6\xdc\xb4\x0b\x7c\xae\x94\x26\xfb\x4b\x2b\xf3\xa2\x0d\xe5\xbe\x30\xf8\xcc\x6f\x8b\xa2\x75\x84\x37\xcb\xec\x72\x89\xa2\x8e\x40\xc6\x66\xc3\xe8\x08\x40\xd1\x02\x9d\x3d\x66\x4f\x23\x2e\x38\x53\xa7\x63\xd3\xa0\x40\xc2\xa7\xf1\x46\x5d\x27\xfd\x4c\xfe\x89\xf5\xc1\xd6\x22\x45\x8a\xbb\x5a\x59\x1d\x10\xee\x99\xec\x3f\xd0\xdb\xf5\xe5\xe3\x4b\x8c\x81\xd1\xf0\x1a\xb9\x07\x8d\xa3\xb0\x7f\xcd\x96\xcc\xf4\x7c\x3a\x7f\x76\x4b\xa2\x8f\x22\x6c\xaf\xa4\x11\x86\x74\x52\xed\x62\x56\xf0\xa9\xf5\x3e\x26\x6c\xd6\x3b\x8d\x34\x6b\x7e\xb4\x58\xd9\x7c\x53\x4a\x69\xad\x0b\xc4\x77\x6c\xc2\x1a\xa0\x5d\x02\xfb\x25\x26\x35\x11\xb8\x73\x8d\x46\x60\xce\x85\x99\x72\x1c\x8a\xbc\x58\xc5\x21\x94\xc2\x30\xf3\xf3\x3b\x21\x6a\x96\x73\x28\x33\x9b\x70\x14\x9f\x94\xbb\x70\x1d\x92\xa1\x39\xe2\x26\x1e\xc3\x09\xeb\x48\x60\x46\x30\x4e\x85\x9a\x69\xd7\x37\x2b\x34\xdb\x7a\x29\x28\x83\x54\x2f\x10\x6d\x9a\xde\xc3\x01\x02\xf5\x69\xb3\x6c\x3d\xe4\x76\xfb\xe6\x03\x1e\xee\x13\x3d\x37\x02\xc2\xbd\x8e\xe4\xd2\xac\x71\x1d\xf1\x31\x02\xdb\xee\xfc\x48\x29\x97\x16\x4f\xb3\x99\x24\xb6\xd0\x64\x93\xf5\x3e\x96\x06\xbe\x64\x7d\x85\x6f\xac\xd3\x9e\xc5\xb1\x49\xba\x55\xc0\x82\x11\x6b\xb3\xc2\x87\x71\x73\xc3\x1e\x71\xe4\xb4\xb0\x7e\x84\x79\x44\xa3\x79\x8d\x24\x04\xe6\xae\x47\x17\x3f\x62\x8e\xd5\x3a\xdc\x8f\x95\x46\xd1\x43\x36\x6c\xc8\x9c\xef\x47\x0d\xc9\xaa\x7e\x09\x6c\x66\xff\x80\xf0\x27\xd9\xb3\x5b\x32\xbd\x36\xfa\xfb\x68\xae\xc3\x0d\x5f\x8b\x1d\x83\x90\x43\xdf\x8a\x72\xf9\x4a\x6d\xfc\x74\x3c\x32\xf9\x75\xde\x30\xf3\xc1\xdf\xf7\xf0\x81\xde\xdb\x36\xb8\xf5\x0e\x51\x93\x91\x2d\x3f\x7c\xf0\x05\x76\xd7\x0b\xc0\x97\xc2\x47\x97\xc2\x62\xcb\xe5\x52\x63\xcb\x57\x1a\x5a\xde\xbc\x1c\xb6\x69\x7d\x85\xc9\x31\x2c\x8b\xef\x63\x1f\x30\xe2\xbb\x2b\x82\xa3\xd7\x03\x6a\xb5\x65\x4a\xc8\x41\x8f\x0d\xd6\x91\xb3\x8e\x17\x8f\x5c\xb1\x6c\x1a\xc0\x5a\x04\x03\x58\x0b\x3f\x80\xb5\xc8\xd2\x73\xb7\x9a\xea\x3c\x7a\x66\xbc\x95\x15\x6b\x52\xd6\xa5\x56\xc8\xfb\x55\xb9\xc8\x3b\x05\x32\xff\x4d\xa3\x98\xc9\x53\x95\x9b\x43\x46\x5a\x8a\x16\xc4\xb6\x55\xf9\x21\x6f\x4b\x65\xa0\x13\x6e\x93\x3c\x84\x35\x3b\x98\x7a\x59\x08\xfd\x32\xdd\x66\x97\xbe\x43\x1c\xdb\x94\x61\x18\x03\x14\x99\x1e\xc2\x49\x26\xb4\x20\xc5\xc8\x92\xc0\x48\x2a\x0b\xa5\x26\x67\xed\x2b\x11\x1d\x26\xe6\x6e\x1c\x77\x84\xd3\xa9\xa6\x39\xcc\x99\xab\x4f\xfb\x92\x2e\xe2\xb8\xfd\xa2\x34\xa4\xec\x88\x18\x5c\x50\xed\x11\x8b\x63\xf5\x13\x9f\x0f\x73\xca\x6c\x4e\x10\xc2\xab\x93\xd7\x09\xe6\x47\xa1\x9f\x42\x90\x73\x0e\xd7\xa6\xfb\xe4\x87\xef\x7f\xd0\x9f\x98\xaf\x07\x23\x1e\xe6\x74\x49\x0e\xee\x86\x71\x5c\xda\xcf\xf5\x1f\x30\x29\xdd\xa7\xf9\x7f\x46\x9a\x30\x86\x7b\x70\xe7\x65\xda\xaa\x61\x14\xb5\x29\x0b\x9c\x76\x52\xd4\xdb\xf5\x3d\x4f\xa5\x0e\xd3\x9f\xa7\x46\x62\xda\x12\x75\xb7\xef\x3c\x8f\xb9\x96\x3e\x6e\x4f\x4e\x6e\x85\x22\x28\x26\x5b\xca\x48\x49\xb7\x89\xe1\x04\x72\xa0\xdb\xa4\xd0\xc3\xad\x26\x5c\x53\xae\x4b\xba\x29\xb3\x74\xc8\x6d\x25\xbe\x5c\x50\x49\x37\xd1\xb3\x28\xc3\xa4\xa4\xe5\x95\x46\x94\xc1\x39\x66\x10\x4e\x03\x4d\x72\x58\x1f\x68\xe9\xf2\x24\xd3\x03\xcc\x60\x4f\xd0\xcb\x05\x2d\x48\xd5\xbf\xff\x6c\x69\xfb\xc5\x1d\x51\x89\xc9\xcf\x34\xb8\xfc\x6e\x01\x88\x76\xe4\x5d\x68\x75\xab\x83\x2e\x78\x08\xf4\x3c\x91\x73\x91\xf3\xdf\x8b\x94\x77\x58\x59\x74\xb6\x27\xe4\xf6\x01\x49\x3b\x16\x9b\x6d\x52\x0b\x71\xfc\xaa\xb9\xe3\xc5\xc4\xe5\xab\x39\x6d\x41\x24\x9d\x2d\x75\x66\x51\x0e\xe9\x45\x7d\xc2\x94\xb7\x04\xad\x9c\x9c\x0a\x07\xd2\x52\xbb\xda\x89\xa0\x8b\x95\xf8\x82\xad\xc4\x7c\x8e\x5b\x25\x1e\x7b\xf9\xa0\x55\xb8\xa4\xb3\xc5\x24\x63\x4a\xe1\xf3\x61\x8c\xbe\xc5\xdd\x6a\xe6\xb4\x46\x83\x74\x85\x89\x04\x47\xf3\x6c\x41\x66\xb5\xc9\x0a\xae\xd5\x3b\xaa\xfd\xe2\x0b\xa5\xa2\x57\x18\xde\xa0\x45\x62\x3e\x27\x0d\x29\x00\x04\xc0\x9b\xc9\x61\x66\xde\xf0\x6e\x15\x5e\x89\x2f\x41\xc7\xc7\xb1\x7e\x42\x8e\xd4\xc2\x42\x0f\x2a\xb6\x6b\xa1\xd4\x9b\xa5\x76\x31\xfc\x75\x34\x93\x03\x8f\xc1\x93\xe7\x5a\x57\x41\x32\x28\x7f\x6c\x37\xdc\x9f\x6b\x9e\x99\xd6\x9c\xed\xb5\x5e\xb0\xb6\x8c\x78\xd0\x9e\xb2\x07\x49\x06\xda\x2a\x95\xf9\xb8\x21\x1c\xc6\x09\x8c\xd1\xfc\x6a\x0a\x4e\x0c\x03\x76\xab\xfb\xdc\x08\x85\xd1\xc6\x6f\xaa\xdb\xf2\x8a\x1d\xd7\x42\x8a\x7a\x47\x98\xce\x25\xef\xab\x89\x42\x49\x71\xe1\xe1\x06\x8e\x0b\xf0\x53\x74\x64\x1a\xd7\xae\x93\x9c\xe4\x46\x4c\x0f\x1b\x49\x35\x88\x0d\xcf\xc5\xba\xb3\xd4\x23\xf9\xfe\xb3\xcf\x46\x8c\xff\x62\xa9\xb9\xc9\xaf\xfd\x91\x49\xfe\x94\xe6\x92\x56\x27\xfa\xe0\xd0\xb5\x95\x33\xd8\xe0\xdc\xad\x5c\xb6\x11\xc3\xa3\xfa\x80\x47\x17\x07\xc4\x31\x02\xc6\x65\x7e\x47\x8a\x5e\xd6\x32\xd2\x8e\xbc\x3b\xef\x8d\x5c\xeb\xbf\x02\xe4\x9a\xeb\x08\x71\x45\xa5\xc5\xaa\x37\xc6\x0f\x7a\xf3\x5a\x81\x55\x61\xc3\xc2\x9f\x7d\xae\xc0\xaa\xb0\x41\xe1\xe5\x6b\x13\x14\x86\x46\x9a\x72\xf4\x19\xd9\xff\x39\xa4\x2c\x92\x22\xe7\x3f\x35\xec\x1f\xbf\xff\xe7\x6b\xaa\x57\xd9\xf6\x7b\x21\x0b\xf6\x86\xed\x25\x6b\xca\xcb\x65\x86\x16\xe4\x98\x34\xa7\x23\x98\x47\xa6\xf5\x29\x46\x4a\x6a\xb5\xeb\x7d\x7a\x82\x36\x83\xb7\xac\xfd\xa9\x01\xe9\xb3\xaf\x94\xcd\x5a\x09\x0e\xdc\x3c\x45\x32\x10\x37\xf7\xb1\x0c\xb4\x1a\x1d\xa1\x19\x45\x9c\xe4\x56\x90\x2d\x1d\x7e\x49\xb5\x46\xe7\xc0\x9d\x94\x89\x1d\x46\x4d\x72\x88\x9a\xf2\x09\x6a\x6a\x14\x6a\xda\x29\xd4\x74\xa0\x0b\x72\x6b\xf5\xf5\x18\x79\x2c\x29\x9d\xcf\x0f\x71\x8c\x6e\xe9\x49\xa1\x37\xd9\x7e\x07\x3d\xf8\x98\x44\xdb\x01\xb4\xc4\xd6\x44\x6f\xd7\x5b\xd3\x6b\xf0\x77\x6c\x2f\x24\x43\x0c\xa7\x76\x8f\x57\xbf\xe6\x4d\x2e\x47\x98\x2c\x28\xfd\xf4\xd3\x43\x1c\xdf\xfa\x05\x91\x90\xbc\xb9\x25\x67\x7f\xb8\x50\x6e\xff\xcf\x88\x91\xd9\x02\x77\x44\x9f\x78\xf5\x14\x08\xf2\xf8\x48\xe8\xa1\x6f\x51\x8e\x96\x21\x69\xc7\xd3\x45\xdb\xc1\x8c\xd2\x50\x67\x49\xb5\xd3\x24\xe8\x8f\xe7\xcf\x1a\x1a\x56\x14\xde\xbc\xc6\xd7\x3b\x72\x07\xae\x8f\xc8\xd9\xff\x9e\xca\x62\xfc\xd1\x4f\x57\x58\x1a\xb8\xb6\xeb\xae\x7d\x55\x44\xdd\x4b\x3c\x7e\x68\xd1\x79\x3d\xbe\x95\x69\x5a\x83\x69\xba\x0a\xd2\x62\x32\x02\x5a\x10\x39\x01\xd2\xbf\x54\x7c\x27\x7e\x71\x21\x2c\x0c\x2e\x43\x9f\xce\x81\x19\x1e\x5d\x93\x94\x92\xed\xd5\x22\xaa\xc7\x76\xde\x6c\xd9\x05\x26\xf2\x11\x23\x5a\xb7\x47\x60\x50\x18\xf5\x91\xfa\xee\xf3\x31\xd0\xfb\x55\xf4\xdd\xff\x6b\x87\x88\x55\x32\xcb\xd7\xda\x23\xf2\x39\xe4\x8d\x4e\x4d\xe4\x0f\x02\x0e\x58\x3f\x8d\xd2\x75\xee\x73\x3a\x01\xff\x19\xe4\xa0\x7b\x09\xbb\x6b\x9d\x9d\xcb\x3a\x52\xd2\x73\x99\x37\xe5\x36\xe7\xb7\xa9\xf1\xa1\x2a\x46\x0c\xc8\xbd\x68\x16\x18\x64\x06\x83\x68\x9f\xf2\xe3\xaf\x4b\x9a\xd3\xb6\x31\xde\x3b\xac\x98\x89\x70\xd1\xd4\x79\x53\x3e\xf4\x08\xd3\xf7\x18\x0f\xe5\x3f\xcb\xb6\x23\xd3\x51\xb7\xc3\x33\xba\xff\x13\x70\x00\x49\x2f\xff\xc4\xee\xa0\x97\xac\xa2\xff\x8f\x77\x47\xb6\x9a\x26\x3c\xe9\xf2\xca\x9f\xff\x1c\x59\x5f\x00\x07\x17\x52\x04\xaf\xa5\x76\x2a\x96\x2a\x2f\x17\x6f\x9f\x91\x0d\xe5\x86\x67\xa4\xa6\xbb\x20\xa8\xc8\x03\x55\xbd\xbb\x50\x54\x5d\x40\x67\x90\x53\x48\xa2\xed\x06\x52\x14\x42\xed\xea\xdc\x7d\xf8\xdc\x91\x88\x74\xa7\x03\x36\x39\x0e\x9c\x37\x21\x6c\x52\x5f\xc3\x26\xf9\x10\x9b\x9c\x26\xd8\x64\x0f\xd8\x44\x69\x96\x7b\xe1\x09\x1f\xc0\x93\xdd\xbd\xf0\x04\x1e\xdd\xa9\xa9\x27\x43\x14\x39\x86\x28\x61\x1f\x8c\x03\x22\x77\x57\x8f\x2e\x71\xb7\x02\xcf\xa1\xc5\x95\xbf\x87\x1e\x7e\xe2\xd4\xbe\x61\xb5\xc8\x77\x3f\x35\x15\xbf\xfd\x43\xde\x94\x18\xe1\x81\x37\x67\x8c\x83\xde\x59\xb4\x73\xa7\xa8\xe5\xdf\x6e\x9b\xdc\x0a\xd4\x77\xe3\xfe\x83\x64\xfb\xd0\xba\xfc\x38\x9a\x8b\xa4\x5f\x64\x68\x9b\xf4\xe7\xeb\x84\xa5\xdf\x1a\x2b\x0d\x26\xec\x6f\xd8\xe9\x85\x82\x03\x03\x2f\xc5\x48\x65\x75\x48\x2a\xe5\xb7\x5c\x62\xd3\x88\xe7\xb5\xce\xc1\x7d\xb1\xd0\x6a\xf0\x55\xd0\x4f\x11\x02\x46\xd0\x1c\xdb\xe6\x27\x45\x1f\xbb\x0f\x13\x42\x2b\x99\x76\x1d\x45\xa9\x2f\xa4\xdb\xf9\xf2\x9a\x44\x99\x0c\x9e\x37\x25\x55\x12\x3d\xe0\xd1\x0e\x3d\xc8\xf0\x21\xae\x21\xae\xe0\x95\x3a\xe8\xb6\x20\xed\x97\x74\xb1\x6e\xd3\x05\x9e\x2b\x96\x66\x20\xcf\xee\xc7\x9e\x83\xf4\xa9\x5e\xb3\x20\x68\x54\x27\x28\xf8\x76\x6f\x59\xfb\xaf\x4a\xe0\xeb\x80\x15\x94\x5c\x7d\x2b\xc5\x41\x03\x3e\x4e\x5a\x85\xe1\x0c\x07\x0a\x90\xef\x70\x95\x22\xd8\xf1\xc1\xeb\x40\xc7\x48\x96\xef\xa0\x9f\x13\x46\xd6\x78\xca\xf5\x20\x5e\x4c\x81\x3b\x19\x90\x9b\x16\x80\x92\x78\xf0\xb3\x47\xcc\xb9\x0d\x27\x08\xdc\x91\xa3\x15\x97\x5b\x8a\xc6\xab\x2c\xec\xc0\x1d\x31\x8d\xa0\x0d\xd2\xb1\x6c\x4f\x3e\xe8\xda\x79\x31\xa3\x54\xe2\x1d\x92\x5e\x7b\xe1\x9a\xee\xc1\x89\xa4\x8e\x1f\xe3\xb8\xd6\x61\x93\xa3\xfa\x47\x29\x85\x4d\x83\xb6\x56\x47\x5a\x13\x86\x6a\x25\x41\x25\xdc\xa4\x1e\xde\x44\xf6\x42\x6f\x46\x69\x1d\xc7\xd0\x10\x07\x2d\x48\x9e\xe4\xbb\xdd\x37\xef\xd4\x7c\x9a\x57\xb1\x66\x82\xfe\xb2\x89\xee\x4d\x14\x11\x81\xa7\xdf\x26\x81\xcb\x75\x7f\x8e\x47\x8d\x30\x41\xf2\x7e\xbf\x20\x1d\xf8\xaa\x4d\x48\x28\x1f\x3e\xbe\x17\x16\x70\xa6\xc1\xca\xa1\x10\xed\x7b\x86\x73\xf2\xdd\x6e\xcc\x26\x3f\x0a\x7d\x49\x0e\xdd\x56\x08\x30\x49\x93\xbf\x63\x86\x49\x6a\x22\xa1\xee\x89\x11\x05\x7b\x75\x23\xc8\xb0\x49\x34\xa8\xc1\xdc\x9a\x37\xf0\x97\x63\x83\xb0\x8e\x47\x9f\x74\xff\xa7\x7b\xcd\xa1\xc7\x8f\xb6\xd3\xa3\x75\x19\x5e\xfd\x77\x00\x00\x00\xff\xff\x2d\x5d\xcb\xc0\x0e\x3e\x04\x00")
-
-func work_js() ([]byte, error) {
-	return bindata_read(
-		_work_js,
-		"work.js",
-	)
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
+// Asset returns the contents of the named file in build/, eg, "work.js".
 func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		return f()
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() ([]byte, error){
-	"index.html": index_html,
-	"work.js": work_js,
-}
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for name := range node.Children {
-		rv = append(rv, name)
-	}
-	return rv, nil
-}
-
-type _bintree_t struct {
-	Func func() ([]byte, error)
-	Children map[string]*_bintree_t
+	return build.ReadFile("build/" + name)
 }
-var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
-	"index.html": &_bintree_t{index_html, map[string]*_bintree_t{
-	}},
-	"work.js": &_bintree_t{work_js, map[string]*_bintree_t{
-	}},
-}}
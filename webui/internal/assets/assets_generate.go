@@ -1,3 +0,0 @@
-package assets
-
-//go:generate go-bindata -prefix build -o assets.go -pkg assets build
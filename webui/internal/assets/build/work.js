@@ -1 +1 @@
-!function(e){function t(o){if(n[o])return n[o].exports;var r=n[o]={i:o,l:!1,exports:{}};return e[o].call(r.exports,r,r.exports,t),r.l=!0,r.exports}var n={};t.m=e,t.c=n,t.d=function(e,n,o){t.o(e,n)||Object.defineProperty(e,n,{configurable:!1,enumerable:!0,get:o})},t.n=function(e){var n=e&&e.__esModule?function(){return e.default}:function(){return e};return t.d(n,"a",n),n},t.o=function(e,t){return Object.prototype.hasOwnProperty.call(e,t)},t.p="",t(t.s=42)}([function(e,t,n){e.exports=n(44)()},function(e,t,n){"use strict";e.exports=n(43)},function(e,t,n){"use strict";var o=function(e,t,n,o,r,l,a,i){if(!e){var c;if(void 0===t)c=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var s=[n,o,r,l,a,i],u=0;c=new Error(t.replace(/%s/g,function(){return s[u++]})),c.name="Invariant Violation"}throw c.framesToPop=1,c}};e.exports=o},function(e,t,n){"use strict";var o=n(1),r=n(68);if(void 0===o)throw Error("create-react-class could not find the React object. If you are using script tags, make sure that React is being loaded before create-react-class.");var l=(new o.Component).updater;e.exports=r(o.Component,o.isValidElement,l)},function(e,t,n){"use strict";var o=function(){};e.exports=o},function(e,t,n){"use strict";function o(e){return null==e||u.a.isValidElement(e)}function r(e){return o(e)||Array.isArray(e)&&e.every(o)}function l(e,t){return d({},e,t)}function a(e){var t=e.type,n=l(t.defaultProps,e.props);if(n.children){var o=i(n.children,n);o.length&&(n.childRoutes=o),delete n.children}return n}function i(e,t){var n=[];return u.a.Children.forEach(e,function(e){if(u.a.isValidElement(e))if(e.type.createRouteFromReactElement){var o=e.type.createRouteFromReactElement(e,t);o&&n.push(o)}else n.push(a(e))}),n}function c(e){return r(e)?e=i(e):e&&!Array.isArray(e)&&(e=[e]),e}t.c=r,t.a=a,t.b=c;var s=n(1),u=n.n(s),d=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}},function(e,t,n){"use strict";t.__esModule=!0,t.createPath=t.parsePath=t.getQueryStringValueFromPath=t.stripQueryStringValueFromPath=t.addQueryStringValueToPath=void 0;var o=n(4),r=(function(e){e&&e.__esModule}(o),t.addQueryStringValueToPath=function(e,t,n){var o=l(e),r=o.pathname,i=o.search,c=o.hash;return a({pathname:r,search:i+(-1===i.indexOf("?")?"?":"&")+t+"="+n,hash:c})},t.stripQueryStringValueFromPath=function(e,t){var n=l(e),o=n.pathname,r=n.search,i=n.hash;return a({pathname:o,search:r.replace(new RegExp("([?&])"+t+"=[a-zA-Z0-9]+(&?)"),function(e,t,n){return"?"===t?t:n}),hash:i})},t.getQueryStringValueFromPath=function(e,t){var n=l(e),o=n.search,r=o.match(new RegExp("[?&]"+t+"=([a-zA-Z0-9]+)"));return r&&r[1]},function(e){var t=e.match(/^(https?:)?\/\/[^\/]*/);return null==t?e:e.substring(t[0].length)}),l=t.parsePath=function(e){var t=r(e),n="",o="",l=t.indexOf("#");-1!==l&&(o=t.substring(l),t=t.substring(0,l));var a=t.indexOf("?");return-1!==a&&(n=t.substring(a),t=t.substring(0,a)),""===t&&(t="/"),{pathname:t,search:n,hash:o}},a=t.createPath=function(e){if(null==e||"string"==typeof e)return e;var t=e.basename,n=e.pathname,o=e.search,r=e.hash,l=(t||"")+n;return o&&"?"!==o&&(l+=o),r&&(l+=r),l}},function(e,t,n){var o=n(61);"string"==typeof o&&(o=[[e.i,o,""]]);var r={hmr:!0};r.transform=void 0;n(30)(o,r);o.locals&&(e.exports=o.locals)},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0}),t.default=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];return t.join(" ")}},function(e,t,n){"use strict";var o=n(4);n.n(o)},function(e,t,n){"use strict";function o(e){return e.replace(/[.*+?^${}()|[\]\\]/g,"\\$&")}function r(e){for(var t="",n=[],r=[],l=void 0,a=0,i=/:([a-zA-Z_$][a-zA-Z0-9_$]*)|\*\*|\*|\(|\)|\\\(|\\\)/g;l=i.exec(e);)l.index!==a&&(r.push(e.slice(a,l.index)),t+=o(e.slice(a,l.index))),l[1]?(t+="([^/]+)",n.push(l[1])):"**"===l[0]?(t+="(.*)",n.push("splat")):"*"===l[0]?(t+="(.*?)",n.push("splat")):"("===l[0]?t+="(?:":")"===l[0]?t+=")?":"\\("===l[0]?t+="\\(":"\\)"===l[0]&&(t+="\\)"),r.push(l[0]),a=i.lastIndex;return a!==e.length&&(r.push(e.slice(a,e.length)),t+=o(e.slice(a,e.length))),{pattern:e,regexpSource:t,paramNames:n,tokens:r}}function l(e){return d[e]||(d[e]=r(e)),d[e]}function a(e,t){"/"!==e.charAt(0)&&(e="/"+e);var n=l(e),o=n.regexpSource,r=n.paramNames,a=n.tokens;"/"!==e.charAt(e.length-1)&&(o+="/?"),"*"===a[a.length-1]&&(o+="$");var i=t.match(new RegExp("^"+o,"i"));if(null==i)return null;var c=i[0],s=t.substr(c.length);if(s){if("/"!==c.charAt(c.length-1))return null;s="/"+s}return{remainingPathname:s,paramNames:r,paramValues:i.slice(1).map(function(e){return e&&decodeURIComponent(e)})}}function i(e){return l(e).paramNames}function c(e,t){t=t||{};for(var n=l(e),o=n.tokens,r=0,a="",i=0,c=[],s=void 0,d=void 0,f=void 0,p=0,b=o.length;p<b;++p)if("*"===(s=o[p])||"**"===s)f=Array.isArray(t.splat)?t.splat[i++]:t.splat,null!=f||r>0||u()(!1),null!=f&&(a+=encodeURI(f));else if("("===s)c[r]="",r+=1;else if(")"===s){var h=c.pop();r-=1,r?c[r-1]+=h:a+=h}else if("\\("===s)a+="(";else if("\\)"===s)a+=")";else if(":"===s.charAt(0))if(d=s.substring(1),f=t[d],null!=f||r>0||u()(!1),null==f){if(r){c[r-1]="";for(var m=o.indexOf(s),g=o.slice(m,o.length),_=-1,v=0;v<g.length;v++)if(")"==g[v]){_=v;break}_>0||u()(!1),p=m+_-1}}else r?c[r-1]+=encodeURIComponent(f):a+=encodeURIComponent(f);else r?c[r-1]+=s:a+=s;return r<=0||u()(!1),a.replace(/\/+/g,"/")}t.c=a,t.b=i,t.a=c;var s=n(2),u=n.n(s),d=Object.create(null)},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.locationsAreEqual=t.statesAreEqual=t.createLocation=t.createQuery=void 0;var r="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e},l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(2),i=o(a),c=n(4),s=(o(c),n(6)),u=n(16),d=(t.createQuery=function(e){return l(Object.create(null),e)},t.createLocation=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"/",t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:u.POP,n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:null,o="string"==typeof e?(0,s.parsePath)(e):e;return{pathname:o.pathname||"/",search:o.search||"",hash:o.hash||"",state:o.state,action:t,key:n}},function(e){return"[object Date]"===Object.prototype.toString.call(e)}),f=t.statesAreEqual=function e(t,n){if(t===n)return!0;var o=void 0===t?"undefined":r(t);if(o!==(void 0===n?"undefined":r(n)))return!1;if("function"===o&&(0,i.default)(!1),"object"===o){if(d(t)&&d(n)&&(0,i.default)(!1),!Array.isArray(t)){var l=Object.keys(t),a=Object.keys(n);return l.length===a.length&&l.every(function(o){return e(t[o],n[o])})}return Array.isArray(n)&&t.length===n.length&&t.every(function(t,o){return e(t,n[o])})}return!1};t.locationsAreEqual=function(e,t){return e.key===t.key&&e.pathname===t.pathname&&e.search===t.search&&e.hash===t.hash&&f(e.state,t.state)}},function(e,t,n){"use strict";function o(e,t,n){if(e[t])return new Error("<"+n+'> should not have a "'+t+'" prop')}t.c=o,n.d(t,"a",function(){return l}),n.d(t,"b",function(){return a}),n.d(t,"d",function(){return c});var r=n(0),l=(n.n(r),Object(r.shape)({listen:r.func.isRequired,push:r.func.isRequired,replace:r.func.isRequired,go:r.func.isRequired,goBack:r.func.isRequired,goForward:r.func.isRequired}),Object(r.oneOfType)([r.func,r.string])),a=Object(r.oneOfType)([l,r.object]),i=Object(r.oneOfType)([r.object,r.element]),c=Object(r.oneOfType)([i,Object(r.arrayOf)(i)])},function(e,t,n){"use strict";function o(e){if(null===e||void 0===e)throw new TypeError("Object.assign cannot be called with null or undefined");return Object(e)}var r=Object.getOwnPropertySymbols,l=Object.prototype.hasOwnProperty,a=Object.prototype.propertyIsEnumerable;e.exports=function(){try{if(!Object.assign)return!1;var e=new String("abc");if(e[5]="de","5"===Object.getOwnPropertyNames(e)[0])return!1;for(var t={},n=0;n<10;n++)t["_"+String.fromCharCode(n)]=n;if("0123456789"!==Object.getOwnPropertyNames(t).map(function(e){return t[e]}).join(""))return!1;var o={};return"abcdefghijklmnopqrst".split("").forEach(function(e){o[e]=e}),"abcdefghijklmnopqrst"===Object.keys(Object.assign({},o)).join("")}catch(e){return!1}}()?Object.assign:function(e,t){for(var n,i,c=o(e),s=1;s<arguments.length;s++){n=Object(arguments[s]);for(var u in n)l.call(n,u)&&(c[u]=n[u]);if(r){i=r(n);for(var d=0;d<i.length;d++)a.call(n,i[d])&&(c[i[d]]=n[i[d]])}}return c}},function(e,t,n){"use strict";function o(e){return function(){return e}}var r=function(){};r.thatReturns=o,r.thatReturnsFalse=o(!1),r.thatReturnsTrue=o(!0),r.thatReturnsNull=o(null),r.thatReturnsThis=function(){return this},r.thatReturnsArgument=function(e){return e},e.exports=r},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){var e=new Date(1e3*this.props.ts);return s.default.createElement("time",{dateTime:e.toISOString()},e.toISOString().slice(0,19).replace(/-/g,"/").replace("T"," "))}}]),t}(s.default.Component);f.propTypes={ts:d.default.number.isRequired},t.default=f},function(e,t,n){"use strict";t.__esModule=!0;t.PUSH="PUSH",t.REPLACE="REPLACE",t.POP="POP"},function(e,t,n){"use strict";t.__esModule=!0;t.addEventListener=function(e,t,n){return e.addEventListener?e.addEventListener(t,n,!1):e.attachEvent("on"+t,n)},t.removeEventListener=function(e,t,n){return e.removeEventListener?e.removeEventListener(t,n,!1):e.detachEvent("on"+t,n)},t.supportsHistory=function(){var e=window.navigator.userAgent;return(-1===e.indexOf("Android 2.")&&-1===e.indexOf("Android 4.0")||-1===e.indexOf("Mobile Safari")||-1!==e.indexOf("Chrome")||-1!==e.indexOf("Windows Phone"))&&(window.history&&"pushState"in window.history)},t.supportsGoWithoutReloadUsingHash=function(){return-1===window.navigator.userAgent.indexOf("Firefox")},t.supportsPopstateOnHashchange=function(){return-1===window.navigator.userAgent.indexOf("Trident")},t.isExtraneousPopstateEvent=function(e){return void 0===e.state&&-1===navigator.userAgent.indexOf("CriOS")}},function(e,t,n){"use strict";var o={};e.exports=o},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(7),p=o(f),b=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"shouldShow",value:function(e){return 1==e||e==this.totalPage||Math.abs(this.props.page-e)<=1}},{key:"render",value:function(){if(0==this.totalPage)return null;for(var e=[],t=1;t<=this.totalPage;t++)t==this.props.page?e.push(s.default.createElement("li",{key:t,className:p.default.active},s.default.createElement("span",null,t))):this.shouldShow(t)?e.push(s.default.createElement("li",{key:t},s.default.createElement("a",{onClick:this.props.jumpTo(t)},t))):this.shouldShow(t-1)&&e.push(s.default.createElement("li",{key:t,className:p.default.disabled},s.default.createElement("span",null,"..")));return s.default.createElement("ul",{className:p.default.pagination},e)}},{key:"totalPage",get:function(){return Math.ceil(this.props.totalCount/this.props.perPage)}}]),t}(s.default.Component);b.propTypes={page:d.default.number.isRequired,perPage:d.default.number.isRequired,totalCount:d.default.number.isRequired,jumpTo:d.default.func.isRequired},t.default=b},function(e,t,n){"use strict";function o(e,t,n){function o(){if(a=!0,i)return void(s=[].concat(Array.prototype.slice.call(arguments)));n.apply(this,arguments)}function r(){if(!a&&(c=!0,!i)){for(i=!0;!a&&l<e&&c;)c=!1,t.call(this,l++,r,o);if(i=!1,a)return void n.apply(this,s);l>=e&&c&&(a=!0,n())}}var l=0,a=!1,i=!1,c=!1,s=void 0;r()}function r(e,t,n){function o(e,t,o){a||(t?(a=!0,n(t)):(l[e]=o,(a=++i===r)&&n(null,l)))}var r=e.length,l=[];if(0===r)return n(null,l);var a=!1,i=0;e.forEach(function(e,n){t(e,n,function(e,t){o(n,e,t)})})}t.a=o,t.b=r},function(e,t,n){"use strict";var o=n(2),r=n.n(o),l=n(1),a=n.n(l),i=n(3),c=n.n(i),s=n(0),u=(n.n(s),n(74)),d=n(22),f=n(5),p=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},b="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e},h=c()({displayName:"RouterContext",mixins:[Object(d.a)("router")],propTypes:{router:s.object.isRequired,location:s.object.isRequired,routes:s.array.isRequired,params:s.object.isRequired,components:s.array.isRequired,createElement:s.func.isRequired},getDefaultProps:function(){return{createElement:a.a.createElement}},childContextTypes:{router:s.object.isRequired},getChildContext:function(){return{router:this.props.router}},createElement:function(e,t){return null==e?null:this.props.createElement(e,t)},render:function(){var e=this,t=this.props,n=t.location,o=t.routes,l=t.params,i=t.components,c=t.router,s=null;return i&&(s=i.reduceRight(function(t,r,a){if(null==r)return t;var i=o[a],s=Object(u.a)(i,l),d={location:n,params:l,route:i,router:c,routeParams:s,routes:o};if(Object(f.c)(t))d.children=t;else if(t)for(var h in t)Object.prototype.hasOwnProperty.call(t,h)&&(d[h]=t[h]);if("object"===(void 0===r?"undefined":b(r))){var m={};for(var g in r)Object.prototype.hasOwnProperty.call(r,g)&&(m[g]=e.createElement(r[g],p({key:g},d)));return m}return e.createElement(r,d)},s)),null===s||!1===s||a.a.isValidElement(s)||r()(!1),s}});t.a=h},function(e,t,n){"use strict";function o(e){return"@@contextSubscriber/"+e}function r(e){var t,n,r=o(e),l=r+"/listeners",a=r+"/eventIndex",i=r+"/subscribe";return n={childContextTypes:(t={},t[r]=c.isRequired,t),getChildContext:function(){var e;return e={},e[r]={eventIndex:this[a],subscribe:this[i]},e},componentWillMount:function(){this[l]=[],this[a]=0},componentWillReceiveProps:function(){this[a]++},componentDidUpdate:function(){var e=this;this[l].forEach(function(t){return t(e[a])})}},n[i]=function(e){var t=this;return this[l].push(e),function(){t[l]=t[l].filter(function(t){return t!==e})}},n}function l(e){var t,n,r=o(e),l=r+"/lastRenderedEventIndex",a=r+"/handleContextUpdate",i=r+"/unsubscribe";return n={contextTypes:(t={},t[r]=c,t),getInitialState:function(){var e;return this.context[r]?(e={},e[l]=this.context[r].eventIndex,e):{}},componentDidMount:function(){this.context[r]&&(this[i]=this.context[r].subscribe(this[a]))},componentWillReceiveProps:function(){var e;this.context[r]&&this.setState((e={},e[l]=this.context[r].eventIndex,e))},componentWillUnmount:function(){this[i]&&(this[i](),this[i]=null)}},n[a]=function(e){if(e!==this.state[l]){var t;this.setState((t={},t[l]=e,t))}},n}t.a=r,t.b=l;var a=n(0),i=n.n(a),c=i.a.shape({subscribe:i.a.func.isRequired,eventIndex:i.a.number.isRequired})},function(e,t,n){"use strict";n.d(t,"b",function(){return r}),n.d(t,"a",function(){return l});var o=n(0),r=(n.n(o),Object(o.shape)({push:o.func.isRequired,replace:o.func.isRequired,go:o.func.isRequired,goBack:o.func.isRequired,goForward:o.func.isRequired,setRouteLeaveHook:o.func.isRequired,isActive:o.func.isRequired})),l=Object(o.shape)({pathname:o.string.isRequired,search:o.string.isRequired,state:o.object,action:o.string.isRequired,key:o.string})},function(e,t,n){"use strict";t.__esModule=!0;var o=n(4),r=(function(e){e&&e.__esModule}(o),function(e,t,n){var o=e(t,n);e.length<2&&n(o)});t.default=r},function(e,t,n){"use strict";t.__esModule=!0;var o=n(85),r=n(6),l=n(24),a=function(e){return e&&e.__esModule?e:{default:e}}(l),i=n(16),c=n(11),s=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},t=e.getCurrentLocation,n=e.getUserConfirmation,l=e.pushLocation,s=e.replaceLocation,u=e.go,d=e.keyLength,f=void 0,p=void 0,b=[],h=[],m=[],g=function(){return p&&p.action===i.POP?m.indexOf(p.key):f?m.indexOf(f.key):-1},_=function(e){var t=g();f=e,f.action===i.PUSH?m=[].concat(m.slice(0,t+1),[f.key]):f.action===i.REPLACE&&(m[t]=f.key),h.forEach(function(e){return e(f)})},v=function(e){return b.push(e),function(){return b=b.filter(function(t){return t!==e})}},y=function(e){return h.push(e),function(){return h=h.filter(function(t){return t!==e})}},x=function(e,t){(0,o.loopAsync)(b.length,function(t,n,o){(0,a.default)(b[t],e,function(e){return null!=e?o(e):n()})},function(e){n&&"string"==typeof e?n(e,function(e){return t(!1!==e)}):t(!1!==e)})},w=function(e){f&&(0,c.locationsAreEqual)(f,e)||p&&(0,c.locationsAreEqual)(p,e)||(p=e,x(e,function(t){if(p===e)if(p=null,t){if(e.action===i.PUSH){var n=(0,r.createPath)(f),o=(0,r.createPath)(e);o===n&&(0,c.statesAreEqual)(f.state,e.state)&&(e.action=i.REPLACE)}e.action===i.POP?_(e):e.action===i.PUSH?!1!==l(e)&&_(e):e.action===i.REPLACE&&!1!==s(e)&&_(e)}else if(f&&e.action===i.POP){var a=m.indexOf(f.key),d=m.indexOf(e.key);-1!==a&&-1!==d&&u(a-d)}}))},k=function(e){return w(L(e,i.PUSH))},E=function(e){return w(L(e,i.REPLACE))},C=function(){return u(-1)},O=function(){return u(1)},P=function(){return Math.random().toString(36).substr(2,d||6)},M=function(e){return(0,r.createPath)(e)},L=function(e,t){var n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:P();return(0,c.createLocation)(e,t,n)};return{getCurrentLocation:t,listenBefore:v,listen:y,transitionTo:w,push:k,replace:E,go:u,goBack:C,goForward:O,createKey:P,createPath:r.createPath,createHref:M,createLocation:L}};t.default=s},function(e,t,n){"use strict";t.__esModule=!0;t.canUseDOM=!("undefined"==typeof window||!window.document||!window.document.createElement)},function(e,t,n){"use strict";t.__esModule=!0,t.go=t.replaceLocation=t.pushLocation=t.startListener=t.getUserConfirmation=t.getCurrentLocation=void 0;var o=n(11),r=n(17),l=n(40),a=n(6),i=n(26),c=i.canUseDOM&&!(0,r.supportsPopstateOnHashchange)(),s=function(e){var t=e&&e.key;return(0,o.createLocation)({pathname:window.location.pathname,search:window.location.search,hash:window.location.hash,state:t?(0,l.readState)(t):void 0},void 0,t)},u=t.getCurrentLocation=function(){var e=void 0;try{e=window.history.state||{}}catch(t){e={}}return s(e)},d=(t.getUserConfirmation=function(e,t){return t(window.confirm(e))},t.startListener=function(e){var t=function(t){(0,r.isExtraneousPopstateEvent)(t)||e(s(t.state))};(0,r.addEventListener)(window,"popstate",t);var n=function(){return e(u())};return c&&(0,r.addEventListener)(window,"hashchange",n),function(){(0,r.removeEventListener)(window,"popstate",t),c&&(0,r.removeEventListener)(window,"hashchange",n)}},function(e,t){var n=e.state,o=e.key;void 0!==n&&(0,l.saveState)(o,n),t({key:o},(0,a.createPath)(e))});t.pushLocation=function(e){return d(e,function(e,t){return window.history.pushState(e,null,t)})},t.replaceLocation=function(e){return d(e,function(e,t){return window.history.replaceState(e,null,t)})},t.go=function(e){e&&window.history.go(e)}},function(e,t,n){"use strict";function o(e,t,n,o,l,a,i,c){if(r(t),!e){var s;if(void 0===t)s=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var u=[n,o,l,a,i,c],d=0;s=new Error(t.replace(/%s/g,function(){return u[d++]})),s.name="Invariant Violation"}throw s.framesToPop=1,s}}var r=function(e){};e.exports=o},function(e,t){function n(e,t){var n=e[1]||"",r=e[3];if(!r)return n;if(t&&"function"==typeof btoa){var l=o(r);return[n].concat(r.sources.map(function(e){return"/*# sourceURL="+r.sourceRoot+e+" */"})).concat([l]).join("\n")}return[n].join("\n")}function o(e){return"/*# sourceMappingURL=data:application/json;charset=utf-8;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(e))))+" */"}e.exports=function(e){var t=[];return t.toString=function(){return this.map(function(t){var o=n(t,e);return t[2]?"@media "+t[2]+"{"+o+"}":o}).join("")},t.i=function(e,n){"string"==typeof e&&(e=[[null,e,""]]);for(var o={},r=0;r<this.length;r++){var l=this[r][0];"number"==typeof l&&(o[l]=!0)}for(r=0;r<e.length;r++){var a=e[r];"number"==typeof a[0]&&o[a[0]]||(n&&!a[2]?a[2]=n:n&&(a[2]="("+a[2]+") and ("+n+")"),t.push(a))}},t}},function(e,t,n){function o(e,t){for(var n=0;n<e.length;n++){var o=e[n],r=b[o.id];if(r){r.refs++;for(var l=0;l<r.parts.length;l++)r.parts[l](o.parts[l]);for(;l<o.parts.length;l++)r.parts.push(u(o.parts[l],t))}else{for(var a=[],l=0;l<o.parts.length;l++)a.push(u(o.parts[l],t));b[o.id]={id:o.id,refs:1,parts:a}}}}function r(e,t){for(var n=[],o={},r=0;r<e.length;r++){var l=e[r],a=t.base?l[0]+t.base:l[0],i=l[1],c=l[2],s=l[3],u={css:i,media:c,sourceMap:s};o[a]?o[a].parts.push(u):n.push(o[a]={id:a,parts:[u]})}return n}function l(e,t){var n=m(e.insertInto);if(!n)throw new Error("Couldn't find a style target. This probably means that the value for the 'insertInto' parameter is invalid.");var o=v[v.length-1];if("top"===e.insertAt)o?o.nextSibling?n.insertBefore(t,o.nextSibling):n.appendChild(t):n.insertBefore(t,n.firstChild),v.push(t);else if("bottom"===e.insertAt)n.appendChild(t);else{if("object"!=typeof e.insertAt||!e.insertAt.before)throw new Error("[Style Loader]\n\n Invalid value for parameter 'insertAt' ('options.insertAt') found.\n Must be 'top', 'bottom', or Object.\n (https://github.com/webpack-contrib/style-loader#insertat)\n");var r=m(e.insertInto+" "+e.insertAt.before);n.insertBefore(t,r)}}function a(e){if(null===e.parentNode)return!1;e.parentNode.removeChild(e);var t=v.indexOf(e);t>=0&&v.splice(t,1)}function i(e){var t=document.createElement("style");return e.attrs.type="text/css",s(t,e.attrs),l(e,t),t}function c(e){var t=document.createElement("link");return e.attrs.type="text/css",e.attrs.rel="stylesheet",s(t,e.attrs),l(e,t),t}function s(e,t){Object.keys(t).forEach(function(n){e.setAttribute(n,t[n])})}function u(e,t){var n,o,r,l;if(t.transform&&e.css){if(!(l=t.transform(e.css)))return function(){};e.css=l}if(t.singleton){var s=_++;n=g||(g=i(t)),o=d.bind(null,n,s,!1),r=d.bind(null,n,s,!0)}else e.sourceMap&&"function"==typeof URL&&"function"==typeof URL.createObjectURL&&"function"==typeof URL.revokeObjectURL&&"function"==typeof Blob&&"function"==typeof btoa?(n=c(t),o=p.bind(null,n,t),r=function(){a(n),n.href&&URL.revokeObjectURL(n.href)}):(n=i(t),o=f.bind(null,n),r=function(){a(n)});return o(e),function(t){if(t){if(t.css===e.css&&t.media===e.media&&t.sourceMap===e.sourceMap)return;o(e=t)}else r()}}function d(e,t,n,o){var r=n?"":o.css;if(e.styleSheet)e.styleSheet.cssText=x(t,r);else{var l=document.createTextNode(r),a=e.childNodes;a[t]&&e.removeChild(a[t]),a.length?e.insertBefore(l,a[t]):e.appendChild(l)}}function f(e,t){var n=t.css,o=t.media;if(o&&e.setAttribute("media",o),e.styleSheet)e.styleSheet.cssText=n;else{for(;e.firstChild;)e.removeChild(e.firstChild);e.appendChild(document.createTextNode(n))}}function p(e,t,n){var o=n.css,r=n.sourceMap,l=void 0===t.convertToAbsoluteUrls&&r;(t.convertToAbsoluteUrls||l)&&(o=y(o)),r&&(o+="\n/*# sourceMappingURL=data:application/json;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(r))))+" */");var a=new Blob([o],{type:"text/css"}),i=e.href;e.href=URL.createObjectURL(a),i&&URL.revokeObjectURL(i)}var b={},h=function(e){var t;return function(){return void 0===t&&(t=e.apply(this,arguments)),t}}(function(){return window&&document&&document.all&&!window.atob}),m=function(e){var t={};return function(n){if(void 0===t[n]){var o=e.call(this,n);if(o instanceof window.HTMLIFrameElement)try{o=o.contentDocument.head}catch(e){o=null}t[n]=o}return t[n]}}(function(e){return document.querySelector(e)}),g=null,_=0,v=[],y=n(60);e.exports=function(e,t){if("undefined"!=typeof DEBUG&&DEBUG&&"object"!=typeof document)throw new Error("The style-loader cannot be used in a non-browser environment");t=t||{},t.attrs="object"==typeof t.attrs?t.attrs:{},t.singleton||(t.singleton=h()),t.insertInto||(t.insertInto="head"),t.insertAt||(t.insertAt="bottom");var n=r(e,t);return o(n,t),function(e){for(var l=[],a=0;a<n.length;a++){var i=n[a],c=b[i.id];c.refs--,l.push(c)}if(e){o(r(e,t),t)}for(var a=0;a<l.length;a++){var c=l[a];if(0===c.refs){for(var s=0;s<c.parts.length;s++)c.parts[s]();delete b[c.id]}}}};var x=function(){var e=[];return function(t,n){return e[t]=n,e.filter(Boolean).join("\n")}}()},function(e,t,n){"use strict";function o(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!0;return!1}function r(e,t){function n(t,n){return t=e.createLocation(t),Object(i.a)(t,n,v.location,v.routes,v.params)}function r(e,n){E&&E.location===e?d(E,n):Object(s.a)(t,e,function(t,o){t?n(t):o?d(u({},o,{location:e}),n):n()})}function d(e,t){function n(n,r){if(n||r)return o(n,r);Object(c.a)(e,function(n,o){n?t(n):t(null,null,v=u({},e,{components:o}))})}function o(e,n){e?t(e):t(null,n)}var r=Object(l.a)(v,e),a=r.leaveRoutes,i=r.changeRoutes,s=r.enterRoutes;k(a,v),a.filter(function(e){return-1===s.indexOf(e)}).forEach(m),w(i,v,e,function(t,r){if(t||r)return o(t,r);x(s,e,n)})}function f(e){var t=arguments.length>1&&void 0!==arguments[1]&&arguments[1];return e.__id__||t&&(e.__id__=C++)}function p(e){return e.map(function(e){return O[f(e)]}).filter(function(e){return e})}function b(e,n){Object(s.a)(t,e,function(t,o){if(null==o)return void n();E=u({},o,{location:e});for(var r=p(Object(l.a)(v,E).leaveRoutes),a=void 0,i=0,c=r.length;null==a&&i<c;++i)a=r[i](e);n(a)})}function h(){if(v.routes){for(var e=p(v.routes),t=void 0,n=0,o=e.length;"string"!=typeof t&&n<o;++n)t=e[n]();return t}}function m(e){var t=f(e);t&&(delete O[t],o(O)||(P&&(P(),P=null),M&&(M(),M=null)))}function g(t,n){var r=!o(O),l=f(t,!0);return O[l]=n,r&&(P=e.listenBefore(b),e.listenBeforeUnload&&(M=e.listenBeforeUnload(h))),function(){m(t)}}function _(t){function n(n){v.location===n?t(null,v):r(n,function(n,o,r){n?t(n):o?e.replace(o):r&&t(null,r)})}var o=e.listen(n);return v.location?t(null,v):n(e.getCurrentLocation()),o}var v={},y=Object(a.a)(),x=y.runEnterHooks,w=y.runChangeHooks,k=y.runLeaveHooks,E=void 0,C=1,O=Object.create(null),P=void 0,M=void 0;return{isActive:n,match:r,listenBeforeLeavingRoute:g,listen:_}}t.a=r;var l=(n(9),n(69)),a=n(70),i=n(71),c=n(72),s=n(73),u=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}},function(e,t,n){"use strict";function o(e){return e&&"function"==typeof e.then}t.a=o},function(e,t,n){"use strict";function o(e,t,n){return r(l({},e,{setRouteLeaveHook:t.listenBeforeLeavingRoute,isActive:t.isActive}),n)}function r(e,t){var n=t.location,o=t.params,r=t.routes;return e.location=n,e.params=o,e.routes=r,e}t.b=o,t.a=r;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}},function(e,t,n){"use strict";function o(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function r(e){return 0===e.button}function l(e){return!!(e.metaKey||e.altKey||e.ctrlKey||e.shiftKey)}function a(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!1;return!0}function i(e,t){return"function"==typeof e?e(t.location):e}var c=n(1),s=n.n(c),u=n(3),d=n.n(u),f=n(0),p=(n.n(f),n(2)),b=n.n(p),h=n(23),m=n(22),g=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},_=d()({displayName:"Link",mixins:[Object(m.b)("router")],contextTypes:{router:h.b},propTypes:{to:Object(f.oneOfType)([f.string,f.object,f.func]),activeStyle:f.object,activeClassName:f.string,onlyActiveOnIndex:f.bool.isRequired,onClick:f.func,target:f.string},getDefaultProps:function(){return{onlyActiveOnIndex:!1,style:{}}},handleClick:function(e){if(this.props.onClick&&this.props.onClick(e),!e.defaultPrevented){var t=this.context.router;t||b()(!1),!l(e)&&r(e)&&(this.props.target||(e.preventDefault(),t.push(i(this.props.to,t))))}},render:function(){var e=this.props,t=e.to,n=e.activeClassName,r=e.activeStyle,l=e.onlyActiveOnIndex,c=o(e,["to","activeClassName","activeStyle","onlyActiveOnIndex"]),u=this.context.router;if(u){if(!t)return s.a.createElement("a",c);var d=i(t,u);c.href=u.createHref(d),(n||null!=r&&!a(r))&&u.isActive(d,l)&&(n&&(c.className?c.className+=" "+n:c.className=n),r&&(c.style=g({},c.style,r)))}return s.a.createElement("a",g({},c,{onClick:this.handleClick}))}});t.a=_},function(e,t,n){"use strict";var o=n(3),r=n.n(o),l=n(0),a=(n.n(l),n(2)),i=n.n(a),c=n(5),s=n(10),u=n(12),d=r()({displayName:"Redirect",statics:{createRouteFromReactElement:function(e){var t=Object(c.a)(e);return t.from&&(t.path=t.from),t.onEnter=function(e,n){var o=e.location,r=e.params,l=void 0;if("/"===t.to.charAt(0))l=Object(s.a)(t.to,r);else if(t.to){var a=e.routes.indexOf(t),i=d.getRoutePattern(e.routes,a-1),c=i.replace(/\/*$/,"/")+t.to;l=Object(s.a)(c,r)}else l=o.pathname;n({pathname:l,query:t.query||o.query,state:t.state||o.state})},t},getRoutePattern:function(e,t){for(var n="",o=t;o>=0;o--){var r=e[o],l=r.path||"";if(n=l.replace(/\/*$/,"/")+n,0===l.indexOf("/"))break}return"/"+n}},propTypes:{path:l.string,from:l.string,to:l.string.isRequired,query:l.object,state:l.object,onEnter:u.c,children:u.c},render:function(){i()(!1)}});t.a=d},function(e,t,n){"use strict";function o(e){var t=s()(e),n=function(){return t};return l()(i()(n))(e)}t.a=o;var r=n(37),l=n.n(r),a=n(38),i=n.n(a),c=n(84),s=n.n(c)},function(e,t,n){"use strict";t.__esModule=!0;var o=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},r=n(82),l=n(24),a=function(e){return e&&e.__esModule?e:{default:e}}(l),i=n(11),c=n(6),s=function(e){return(0,r.stringify)(e).replace(/%20/g,"+")},u=r.parse,d=function(e){return function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},n=e(t),r=t.stringifyQuery,l=t.parseQueryString;"function"!=typeof r&&(r=s),"function"!=typeof l&&(l=u);var d=function(e){return e?(null==e.query&&(e.query=l(e.search.substring(1))),e):e},f=function(e,t){if(null==t)return e;var n="string"==typeof e?(0,c.parsePath)(e):e,l=r(t);return o({},n,{search:l?"?"+l:""})};return o({},n,{getCurrentLocation:function(){return d(n.getCurrentLocation())},listenBefore:function(e){return n.listenBefore(function(t,n){return(0,a.default)(e,d(t),n)})},listen:function(e){return n.listen(function(t){return e(d(t))})},push:function(e){return n.push(f(e,e.query))},replace:function(e){return n.replace(f(e,e.query))},createPath:function(e){return n.createPath(f(e,e.query))},createHref:function(e){return n.createHref(f(e,e.query))},createLocation:function(e){for(var t=arguments.length,o=Array(t>1?t-1:0),r=1;r<t;r++)o[r-1]=arguments[r];var l=n.createLocation.apply(n,[f(e,e.query)].concat(o));return e.query&&(l.query=(0,i.createQuery)(e.query)),d(l)}})}};t.default=d},function(e,t,n){"use strict";t.__esModule=!0;var o=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},r=n(24),l=function(e){return e&&e.__esModule?e:{default:e}}(r),a=n(6),i=function(e){return function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},n=e(t),r=t.basename,i=function(e){return e?(r&&null==e.basename&&(0===e.pathname.toLowerCase().indexOf(r.toLowerCase())?(e.pathname=e.pathname.substring(r.length),e.basename=r,""===e.pathname&&(e.pathname="/")):e.basename=""),e):e},c=function(e){if(!r)return e;var t="string"==typeof e?(0,a.parsePath)(e):e,n=t.pathname,l="/"===r.slice(-1)?r:r+"/",i="/"===n.charAt(0)?n.slice(1):n;return o({},t,{pathname:l+i})};return o({},n,{getCurrentLocation:function(){return i(n.getCurrentLocation())},listenBefore:function(e){return n.listenBefore(function(t,n){return(0,l.default)(e,i(t),n)})},listen:function(e){return n.listen(function(t){return e(i(t))})},push:function(e){return n.push(c(e))},replace:function(e){return n.replace(c(e))},createPath:function(e){return n.createPath(c(e))},createHref:function(e){return n.createHref(c(e))},createLocation:function(e){for(var t=arguments.length,o=Array(t>1?t-1:0),r=1;r<t;r++)o[r-1]=arguments[r];return i(n.createLocation.apply(n,[c(e)].concat(o)))}})}};t.default=i},function(e,t,n){"use strict";function o(e){return function(t){return l()(i()(e))(t)}}t.a=o;var r=n(37),l=n.n(r),a=n(38),i=n.n(a)},function(e,t,n){"use strict";t.__esModule=!0,t.readState=t.saveState=void 0;var o=n(4),r=(function(e){e&&e.__esModule}(o),{QuotaExceededError:!0,QUOTA_EXCEEDED_ERR:!0}),l={SecurityError:!0},a=function(e){return"@@History/"+e};t.saveState=function(e,t){if(window.sessionStorage)try{null==t?window.sessionStorage.removeItem(a(e)):window.sessionStorage.setItem(a(e),JSON.stringify(t))}catch(e){if(l[e.name])return;if(r[e.name]&&0===window.sessionStorage.length)return;throw e}},t.readState=function(e){var t=void 0;try{t=window.sessionStorage.getItem(a(e))}catch(e){if(l[e.name])return}if(t)try{return JSON.parse(t)}catch(e){}}},function(e,t,n){"use strict";function o(e){var t=void 0;return l&&(t=Object(r.a)(e)()),t}t.a=o;var r=n(39),l=!("undefined"==typeof window||!window.document||!window.document.createElement)},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(46),p=n(56),b=o(p),h=n(62),m=o(h),g=n(63),_=o(g),v=n(64),y=o(v),x=n(65),w=o(x),k=n(66),E=n(7),C=o(E),O=n(8),P=o(O),M=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){return s.default.createElement("div",{className:C.default.container,style:{marginTop:30,marginBottom:60}},s.default.createElement("header",null,s.default.createElement("h1",null,"gocraft/work")),s.default.createElement("hr",null),s.default.createElement("div",{className:C.default.row},s.default.createElement("main",{className:C.default.colMd10},this.props.children),s.default.createElement("aside",{className:C.default.colMd2},s.default.createElement("nav",null,s.default.createElement("ul",{className:(0,P.default)(C.default.nav,C.default.navPills,C.default.navStacked)},s.default.createElement("li",null,s.default.createElement(k.Link,{to:"/processes"},"Processes")),s.default.createElement("li",null,s.default.createElement(k.Link,{to:"/queues"},"Queues")),s.default.createElement("li",null,s.default.createElement(k.Link,{to:"/retry_jobs"},"Retry Jobs")),s.default.createElement("li",null,s.default.createElement(k.Link,{to:"/scheduled_jobs"},"Scheduled Jobs")),s.default.createElement("li",null,s.default.createElement(k.Link,{to:"/dead_jobs"},"Dead Jobs")))))))}}],[{key:"apiURL",value:function(e){return location.pathname.slice(0,-1)+e}}]),t}(s.default.Component);M.propTypes={children:d.default.element.isRequired},(0,f.render)(s.default.createElement(k.Router,{history:k.hashHistory},s.default.createElement(k.Route,{path:"/",component:M},s.default.createElement(k.Route,{path:"/processes",component:function(){return s.default.createElement(b.default,{busyWorkerURL:M.apiURL("/busy_workers"),workerPoolURL:M.apiURL("/worker_pools")})}}),s.default.createElement(k.Route,{path:"/queues",component:function(){return s.default.createElement(_.default,{url:M.apiURL("/queues")})}}),s.default.createElement(k.Route,{path:"/retry_jobs",component:function(){return s.default.createElement(y.default,{url:M.apiURL("/retry_jobs")})}}),s.default.createElement(k.Route,{path:"/scheduled_jobs",component:function(){return s.default.createElement(w.default,{url:M.apiURL("/scheduled_jobs")})}}),s.default.createElement(k.Route,{path:"/dead_jobs",component:function(){return s.default.createElement(m.default,{fetchURL:M.apiURL("/dead_jobs"),retryURL:M.apiURL("/retry_dead_job"),retryAllURL:M.apiURL("/retry_all_dead_jobs"),deleteURL:M.apiURL("/delete_dead_job"),deleteAllURL:M.apiURL("/delete_all_dead_jobs")})}}),s.default.createElement(k.IndexRedirect,{from:"",to:"/processes"}))),document.getElementById("app"))},function(e,t,n){"use strict";function o(e){for(var t=arguments.length-1,n="Minified React error #"+e+"; visit http://facebook.github.io/react/docs/error-decoder.html?invariant="+e,o=0;o<t;o++)n+="&args[]="+encodeURIComponent(arguments[o+1]);throw t=Error(n+" for the full message or use the non-minified dev environment for full errors and additional helpful warnings."),t.name="Invariant Violation",t.framesToPop=1,t}function r(e,t,n){this.props=e,this.context=t,this.refs=v,this.updater=n||M}function l(e,t,n){this.props=e,this.context=t,this.refs=v,this.updater=n||M}function a(){}function i(e,t,n){this.props=e,this.context=t,this.refs=v,this.updater=n||M}function c(e,t,n){var o,r={},l=null,a=null;if(null!=t)for(o in void 0!==t.ref&&(a=t.ref),void 0!==t.key&&(l=""+t.key),t)S.call(t,o)&&!T.hasOwnProperty(o)&&(r[o]=t[o]);var i=arguments.length-2;if(1===i)r.children=n;else if(1<i){for(var c=Array(i),s=0;s<i;s++)c[s]=arguments[s+2];r.children=c}if(e&&e.defaultProps)for(o in i=e.defaultProps)void 0===r[o]&&(r[o]=i[o]);return{$$typeof:w,type:e,key:l,ref:a,props:r,_owner:j.current}}function s(e){return"object"==typeof e&&null!==e&&e.$$typeof===w}function u(e){var t={"=":"=0",":":"=2"};return"$"+(""+e).replace(/[=:]/g,function(e){return t[e]})}function d(e,t,n,o){if(U.length){var r=U.pop();return r.result=e,r.keyPrefix=t,r.func=n,r.context=o,r.count=0,r}return{result:e,keyPrefix:t,func:n,context:o,count:0}}function f(e){e.result=null,e.keyPrefix=null,e.func=null,e.context=null,e.count=0,10>U.length&&U.push(e)}function p(e,t,n,r){var l=typeof e;"undefined"!==l&&"boolean"!==l||(e=null);var a=!1;if(null===e)a=!0;else switch(l){case"string":case"number":a=!0;break;case"object":switch(e.$$typeof){case w:case k:case E:case C:a=!0}}if(a)return n(r,e,""===t?"."+b(e,0):t),1;if(a=0,t=""===t?".":t+":",Array.isArray(e))for(var i=0;i<e.length;i++){l=e[i];var c=t+b(l,i);a+=p(l,c,n,r)}else if(null===e||void 0===e?c=null:(c=P&&e[P]||e["@@iterator"],c="function"==typeof c?c:null),"function"==typeof c)for(e=c.call(e),i=0;!(l=e.next()).done;)l=l.value,c=t+b(l,i++),a+=p(l,c,n,r);else"object"===l&&(n=""+e,o("31","[object Object]"===n?"object with keys {"+Object.keys(e).join(", ")+"}":n,""));return a}function b(e,t){return"object"==typeof e&&null!==e&&null!=e.key?u(e.key):t.toString(36)}function h(e,t){e.func.call(e.context,t,e.count++)}function m(e,t,n){var o=e.result,r=e.keyPrefix;e=e.func.call(e.context,t,e.count++),Array.isArray(e)?g(e,o,n,y.thatReturnsArgument):null!=e&&(s(e)&&(t=r+(!e.key||t&&t.key===e.key?"":(""+e.key).replace(H,"$&/")+"/")+n,e={$$typeof:w,type:e.type,key:t,ref:e.ref,props:e.props,_owner:e._owner}),o.push(e))}function g(e,t,n,o,r){var l="";null!=n&&(l=(""+n).replace(H,"$&/")+"/"),t=d(t,l,o,r),null==e||p(e,"",m,t),f(t)}var _=n(13),v=n(18),y=n(14),x="function"==typeof Symbol&&Symbol.for,w=x?Symbol.for("react.element"):60103,k=x?Symbol.for("react.call"):60104,E=x?Symbol.for("react.return"):60105,C=x?Symbol.for("react.portal"):60106,O=x?Symbol.for("react.fragment"):60107,P="function"==typeof Symbol&&Symbol.iterator,M={isMounted:function(){return!1},enqueueForceUpdate:function(){},enqueueReplaceState:function(){},enqueueSetState:function(){}};r.prototype.isReactComponent={},r.prototype.setState=function(e,t){"object"!=typeof e&&"function"!=typeof e&&null!=e&&o("85"),this.updater.enqueueSetState(this,e,t,"setState")},r.prototype.forceUpdate=function(e){this.updater.enqueueForceUpdate(this,e,"forceUpdate")},a.prototype=r.prototype;var L=l.prototype=new a;L.constructor=l,_(L,r.prototype),L.isPureReactComponent=!0;var R=i.prototype=new a;R.constructor=i,_(R,r.prototype),R.unstable_isAsyncReactComponent=!0,R.render=function(){return this.props.children};var j={current:null},S=Object.prototype.hasOwnProperty,T={key:!0,ref:!0,__self:!0,__source:!0},H=/\/+/g,U=[],N={Children:{map:function(e,t,n){if(null==e)return e;var o=[];return g(e,o,null,t,n),o},forEach:function(e,t,n){if(null==e)return e;t=d(null,null,t,n),null==e||p(e,"",h,t),f(t)},count:function(e){return null==e?0:p(e,"",y.thatReturnsNull,null)},toArray:function(e){var t=[];return g(e,t,null,y.thatReturnsArgument),t},only:function(e){return s(e)||o("143"),e}},Component:r,PureComponent:l,unstable_AsyncComponent:i,Fragment:O,createElement:c,cloneElement:function(e,t,n){var o=_({},e.props),r=e.key,l=e.ref,a=e._owner;if(null!=t){if(void 0!==t.ref&&(l=t.ref,a=j.current),void 0!==t.key&&(r=""+t.key),e.type&&e.type.defaultProps)var i=e.type.defaultProps;for(c in t)S.call(t,c)&&!T.hasOwnProperty(c)&&(o[c]=void 0===t[c]&&void 0!==i?i[c]:t[c])}var c=arguments.length-2;if(1===c)o.children=n;else if(1<c){i=Array(c);for(var s=0;s<c;s++)i[s]=arguments[s+2];o.children=i}return{$$typeof:w,type:e.type,key:r,ref:l,props:o,_owner:a}},createFactory:function(e){var t=c.bind(null,e);return t.type=e,t},isValidElement:s,version:"16.2.0",__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED:{ReactCurrentOwner:j,assign:_}},I=Object.freeze({default:N}),D=I&&N||I;e.exports=D.default?D.default:D},function(e,t,n){"use strict";var o=n(14),r=n(28),l=n(45);e.exports=function(){function e(e,t,n,o,a,i){i!==l&&r(!1,"Calling PropTypes validators directly is not supported by the `prop-types` package. Use PropTypes.checkPropTypes() to call them. Read more at http://fb.me/use-check-prop-types")}function t(){return e}e.isRequired=e;var n={array:e,bool:e,func:e,number:e,object:e,string:e,symbol:e,any:e,arrayOf:t,element:e,instanceOf:t,node:e,objectOf:t,oneOf:t,oneOfType:t,shape:t,exact:t};return n.checkPropTypes=o,n.PropTypes=n,n}},function(e,t,n){"use strict";e.exports="SECRET_DO_NOT_PASS_THIS_OR_YOU_WILL_BE_FIRED"},function(e,t,n){"use strict";function o(){if("undefined"!=typeof __REACT_DEVTOOLS_GLOBAL_HOOK__&&"function"==typeof __REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE)try{__REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE(o)}catch(e){console.error(e)}}o(),e.exports=n(47)},function(e,t,n){"use strict";function o(e){for(var t=arguments.length-1,n="Minified React error #"+e+"; visit http://facebook.github.io/react/docs/error-decoder.html?invariant="+e,o=0;o<t;o++)n+="&args[]="+encodeURIComponent(arguments[o+1]);throw t=Error(n+" for the full message or use the non-minified dev environment for full errors and additional helpful warnings."),t.name="Invariant Violation",t.framesToPop=1,t}function r(e,t){return(e&t)===t}function l(e,t){if(Ln.hasOwnProperty(e)||2<e.length&&("o"===e[0]||"O"===e[0])&&("n"===e[1]||"N"===e[1]))return!1;if(null===t)return!0;switch(typeof t){case"boolean":return Ln.hasOwnProperty(e)?e=!0:(t=a(e))?e=t.hasBooleanValue||t.hasStringBooleanValue||t.hasOverloadedBooleanValue:(e=e.toLowerCase().slice(0,5),e="data-"===e||"aria-"===e),e;case"undefined":case"number":case"string":case"object":return!0;default:return!1}}function a(e){return jn.hasOwnProperty(e)?jn[e]:null}function i(e){return e[1].toUpperCase()}function c(e,t,n,o,r,l,a,i,c){Wn._hasCaughtError=!1,Wn._caughtError=null;var s=Array.prototype.slice.call(arguments,3);try{t.apply(n,s)}catch(e){Wn._caughtError=e,Wn._hasCaughtError=!0}}function s(){if(Wn._hasRethrowError){var e=Wn._rethrowError;throw Wn._rethrowError=null,Wn._hasRethrowError=!1,e}}function u(){if(Xn)for(var e in Vn){var t=Vn[e],n=Xn.indexOf(e);if(-1<n||o("96",e),!Kn[n]){t.extractEvents||o("97",e),Kn[n]=t,n=t.eventTypes;for(var r in n){var l=void 0,a=n[r],i=t,c=r;Gn.hasOwnProperty(c)&&o("99",c),Gn[c]=a;var s=a.phasedRegistrationNames;if(s){for(l in s)s.hasOwnProperty(l)&&d(s[l],i,c);l=!0}else a.registrationName?(d(a.registrationName,i,c),l=!0):l=!1;l||o("98",r,e)}}}}function d(e,t,n){Bn[e]&&o("100",e),Bn[e]=t,Qn[e]=t.eventTypes[n].dependencies}function f(e){Xn&&o("101"),Xn=Array.prototype.slice.call(e),u()}function p(e){var t,n=!1;for(t in e)if(e.hasOwnProperty(t)){var r=e[t];Vn.hasOwnProperty(t)&&Vn[t]===r||(Vn[t]&&o("102",t),Vn[t]=r,n=!0)}n&&u()}function b(e,t,n,o){t=e.type||"unknown-event",e.currentTarget=eo(o),Wn.invokeGuardedCallbackAndCatchFirstError(t,n,void 0,e),e.currentTarget=null}function h(e,t){return null==t&&o("30"),null==e?t:Array.isArray(e)?Array.isArray(t)?(e.push.apply(e,t),e):(e.push(t),e):Array.isArray(t)?[e].concat(t):[e,t]}function m(e,t,n){Array.isArray(e)?e.forEach(t,n):e&&t.call(n,e)}function g(e,t){if(e){var n=e._dispatchListeners,o=e._dispatchInstances;if(Array.isArray(n))for(var r=0;r<n.length&&!e.isPropagationStopped();r++)b(e,t,n[r],o[r]);else n&&b(e,t,n,o);e._dispatchListeners=null,e._dispatchInstances=null,e.isPersistent()||e.constructor.release(e)}}function _(e){return g(e,!0)}function v(e){return g(e,!1)}function y(e,t){var n=e.stateNode;if(!n)return null;var r=Jn(n);if(!r)return null;n=r[t];e:switch(t){case"onClick":case"onClickCapture":case"onDoubleClick":case"onDoubleClickCapture":case"onMouseDown":case"onMouseDownCapture":case"onMouseMove":case"onMouseMoveCapture":case"onMouseUp":case"onMouseUpCapture":(r=!r.disabled)||(e=e.type,r=!("button"===e||"input"===e||"select"===e||"textarea"===e)),e=!r;break e;default:e=!1}return e?null:(n&&"function"!=typeof n&&o("231",t,typeof n),n)}function x(e,t,n,o){for(var r,l=0;l<Kn.length;l++){var a=Kn[l];a&&(a=a.extractEvents(e,t,n,o))&&(r=h(r,a))}return r}function w(e){e&&(to=h(to,e))}function k(e){var t=to;to=null,t&&(e?m(t,_):m(t,v),to&&o("95"),Wn.rethrowCaughtError())}function E(e){if(e[lo])return e[lo];for(var t=[];!e[lo];){if(t.push(e),!e.parentNode)return null;e=e.parentNode}var n=void 0,o=e[lo];if(5===o.tag||6===o.tag)return o;for(;e&&(o=e[lo]);e=t.pop())n=o;return n}function C(e){if(5===e.tag||6===e.tag)return e.stateNode;o("33")}function O(e){return e[ao]||null}function P(e){do{e=e.return}while(e&&5!==e.tag);return e||null}function M(e,t,n){for(var o=[];e;)o.push(e),e=P(e);for(e=o.length;0<e--;)t(o[e],"captured",n);for(e=0;e<o.length;e++)t(o[e],"bubbled",n)}function L(e,t,n){(t=y(e,n.dispatchConfig.phasedRegistrationNames[t]))&&(n._dispatchListeners=h(n._dispatchListeners,t),n._dispatchInstances=h(n._dispatchInstances,e))}function R(e){e&&e.dispatchConfig.phasedRegistrationNames&&M(e._targetInst,L,e)}function j(e){if(e&&e.dispatchConfig.phasedRegistrationNames){var t=e._targetInst;t=t?P(t):null,M(t,L,e)}}function S(e,t,n){e&&n&&n.dispatchConfig.registrationName&&(t=y(e,n.dispatchConfig.registrationName))&&(n._dispatchListeners=h(n._dispatchListeners,t),n._dispatchInstances=h(n._dispatchInstances,e))}function T(e){e&&e.dispatchConfig.registrationName&&S(e._targetInst,null,e)}function H(e){m(e,R)}function U(e,t,n,o){if(n&&o)e:{for(var r=n,l=o,a=0,i=r;i;i=P(i))a++;i=0;for(var c=l;c;c=P(c))i++;for(;0<a-i;)r=P(r),a--;for(;0<i-a;)l=P(l),i--;for(;a--;){if(r===l||r===l.alternate)break e;r=P(r),l=P(l)}r=null}else r=null;for(l=r,r=[];n&&n!==l&&(null===(a=n.alternate)||a!==l);)r.push(n),n=P(n);for(n=[];o&&o!==l&&(null===(a=o.alternate)||a!==l);)n.push(o),o=P(o);for(o=0;o<r.length;o++)S(r[o],"bubbled",e);for(e=n.length;0<e--;)S(n[e],"captured",t)}function N(){return!so&&yn.canUseDOM&&(so="textContent"in document.documentElement?"textContent":"innerText"),so}function I(){if(uo._fallbackText)return uo._fallbackText;var e,t,n=uo._startText,o=n.length,r=D(),l=r.length;for(e=0;e<o&&n[e]===r[e];e++);var a=o-e;for(t=1;t<=a&&n[o-t]===r[l-t];t++);return uo._fallbackText=r.slice(e,1<t?1-t:void 0),uo._fallbackText}function D(){return"value"in uo._root?uo._root.value:uo._root[N()]}function A(e,t,n,o){this.dispatchConfig=e,this._targetInst=t,this.nativeEvent=n,e=this.constructor.Interface;for(var r in e)e.hasOwnProperty(r)&&((t=e[r])?this[r]=t(n):"target"===r?this.target=o:this[r]=n[r]);return this.isDefaultPrevented=(null!=n.defaultPrevented?n.defaultPrevented:!1===n.returnValue)?wn.thatReturnsTrue:wn.thatReturnsFalse,this.isPropagationStopped=wn.thatReturnsFalse,this}function Y(e,t,n,o){if(this.eventPool.length){var r=this.eventPool.pop();return this.call(r,e,t,n,o),r}return new this(e,t,n,o)}function q(e){e instanceof this||o("223"),e.destructor(),10>this.eventPool.length&&this.eventPool.push(e)}function F(e){e.eventPool=[],e.getPooled=Y,e.release=q}function z(e,t,n,o){return A.call(this,e,t,n,o)}function W(e,t,n,o){return A.call(this,e,t,n,o)}function X(e,t){switch(e){case"topKeyUp":return-1!==bo.indexOf(t.keyCode);case"topKeyDown":return 229!==t.keyCode;case"topKeyPress":case"topMouseDown":case"topBlur":return!0;default:return!1}}function V(e){return e=e.detail,"object"==typeof e&&"data"in e?e.data:null}function K(e,t){switch(e){case"topCompositionEnd":return V(t);case"topKeyPress":return 32!==t.which?null:(Eo=!0,wo);case"topTextInput":return e=t.data,e===wo&&Eo?null:e;default:return null}}function G(e,t){if(Co)return"topCompositionEnd"===e||!ho&&X(e,t)?(e=I(),uo._root=null,uo._startText=null,uo._fallbackText=null,Co=!1,e):null;switch(e){case"topPaste":return null;case"topKeyPress":if(!(t.ctrlKey||t.altKey||t.metaKey)||t.ctrlKey&&t.altKey){if(t.char&&1<t.char.length)return t.char;if(t.which)return String.fromCharCode(t.which)}return null;case"topCompositionEnd":return xo?null:t.data;default:return null}}function B(e){if(e=$n(e)){Po&&"function"==typeof Po.restoreControlledState||o("194");var t=Jn(e.stateNode);Po.restoreControlledState(e.stateNode,e.type,t)}}function Q(e){Mo?Lo?Lo.push(e):Lo=[e]:Mo=e}function Z(){if(Mo){var e=Mo,t=Lo;if(Lo=Mo=null,B(e),t)for(e=0;e<t.length;e++)B(t[e])}}function J(e,t){return e(t)}function $(e,t){if(So)return J(e,t);So=!0;try{return J(e,t)}finally{So=!1,Z()}}function ee(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return"input"===t?!!To[e.type]:"textarea"===t}function te(e){return e=e.target||e.srcElement||window,e.correspondingUseElement&&(e=e.correspondingUseElement),3===e.nodeType?e.parentNode:e}function ne(e,t){if(!yn.canUseDOM||t&&!("addEventListener"in document))return!1;t="on"+e;var n=t in document;return n||(n=document.createElement("div"),n.setAttribute(t,"return;"),n="function"==typeof n[t]),!n&&vo&&"wheel"===e&&(n=document.implementation.hasFeature("Events.wheel","3.0")),n}function oe(e){var t=e.type;return(e=e.nodeName)&&"input"===e.toLowerCase()&&("checkbox"===t||"radio"===t)}function re(e){var t=oe(e)?"checked":"value",n=Object.getOwnPropertyDescriptor(e.constructor.prototype,t),o=""+e[t];if(!e.hasOwnProperty(t)&&"function"==typeof n.get&&"function"==typeof n.set)return Object.defineProperty(e,t,{enumerable:n.enumerable,configurable:!0,get:function(){return n.get.call(this)},set:function(e){o=""+e,n.set.call(this,e)}}),{getValue:function(){return o},setValue:function(e){o=""+e},stopTracking:function(){e._valueTracker=null,delete e[t]}}}function le(e){e._valueTracker||(e._valueTracker=re(e))}function ae(e){if(!e)return!1;var t=e._valueTracker;if(!t)return!0;var n=t.getValue(),o="";return e&&(o=oe(e)?e.checked?"true":"false":e.value),(e=o)!==n&&(t.setValue(e),!0)}function ie(e,t,n){return e=A.getPooled(Ho.change,e,t,n),e.type="change",Q(n),H(e),e}function ce(e){w(e),k(!1)}function se(e){if(ae(C(e)))return e}function ue(e,t){if("topChange"===e)return t}function de(){Uo&&(Uo.detachEvent("onpropertychange",fe),No=Uo=null)}function fe(e){"value"===e.propertyName&&se(No)&&(e=ie(No,e,te(e)),$(ce,e))}function pe(e,t,n){"topFocus"===e?(de(),Uo=t,No=n,Uo.attachEvent("onpropertychange",fe)):"topBlur"===e&&de()}function be(e){if("topSelectionChange"===e||"topKeyUp"===e||"topKeyDown"===e)return se(No)}function he(e,t){if("topClick"===e)return se(t)}function me(e,t){if("topInput"===e||"topChange"===e)return se(t)}function ge(e,t,n,o){return A.call(this,e,t,n,o)}function _e(e){var t=this.nativeEvent;return t.getModifierState?t.getModifierState(e):!!(e=Ao[e])&&!!t[e]}function ve(){return _e}function ye(e,t,n,o){return A.call(this,e,t,n,o)}function xe(e){return e=e.type,"string"==typeof e?e:"function"==typeof e?e.displayName||e.name:null}function we(e){var t=e;if(e.alternate)for(;t.return;)t=t.return;else{if(0!=(2&t.effectTag))return 1;for(;t.return;)if(t=t.return,0!=(2&t.effectTag))return 1}return 3===t.tag?2:3}function ke(e){return!!(e=e._reactInternalFiber)&&2===we(e)}function Ee(e){2!==we(e)&&o("188")}function Ce(e){var t=e.alternate;if(!t)return t=we(e),3===t&&o("188"),1===t?null:e;for(var n=e,r=t;;){var l=n.return,a=l?l.alternate:null;if(!l||!a)break;if(l.child===a.child){for(var i=l.child;i;){if(i===n)return Ee(l),e;if(i===r)return Ee(l),t;i=i.sibling}o("188")}if(n.return!==r.return)n=l,r=a;else{i=!1;for(var c=l.child;c;){if(c===n){i=!0,n=l,r=a;break}if(c===r){i=!0,r=l,n=a;break}c=c.sibling}if(!i){for(c=a.child;c;){if(c===n){i=!0,n=a,r=l;break}if(c===r){i=!0,r=a,n=l;break}c=c.sibling}i||o("189")}}n.alternate!==r&&o("190")}return 3!==n.tag&&o("188"),n.stateNode.current===n?e:t}function Oe(e){if(!(e=Ce(e)))return null;for(var t=e;;){if(5===t.tag||6===t.tag)return t;if(t.child)t.child.return=t,t=t.child;else{if(t===e)break;for(;!t.sibling;){if(!t.return||t.return===e)return null;t=t.return}t.sibling.return=t.return,t=t.sibling}}return null}function Pe(e){if(!(e=Ce(e)))return null;for(var t=e;;){if(5===t.tag||6===t.tag)return t;if(t.child&&4!==t.tag)t.child.return=t,t=t.child;else{if(t===e)break;for(;!t.sibling;){if(!t.return||t.return===e)return null;t=t.return}t.sibling.return=t.return,t=t.sibling}}return null}function Me(e){var t=e.targetInst;do{if(!t){e.ancestors.push(t);break}var n;for(n=t;n.return;)n=n.return;if(!(n=3!==n.tag?null:n.stateNode.containerInfo))break;e.ancestors.push(t),t=E(n)}while(t);for(n=0;n<e.ancestors.length;n++)t=e.ancestors[n],Xo(e.topLevelType,t,e.nativeEvent,te(e.nativeEvent))}function Le(e){Wo=!!e}function Re(e,t,n){return n?kn.listen(n,t,Se.bind(null,e)):null}function je(e,t,n){return n?kn.capture(n,t,Se.bind(null,e)):null}function Se(e,t){if(Wo){var n=te(t);if(n=E(n),null===n||"number"!=typeof n.tag||2===we(n)||(n=null),zo.length){var o=zo.pop();o.topLevelType=e,o.nativeEvent=t,o.targetInst=n,e=o}else e={topLevelType:e,nativeEvent:t,targetInst:n,ancestors:[]};try{$(Me,e)}finally{e.topLevelType=null,e.nativeEvent=null,e.targetInst=null,e.ancestors.length=0,10>zo.length&&zo.push(e)}}}function Te(e,t){var n={};return n[e.toLowerCase()]=t.toLowerCase(),n["Webkit"+e]="webkit"+t,n["Moz"+e]="moz"+t,n["ms"+e]="MS"+t,n["O"+e]="o"+t.toLowerCase(),n}function He(e){if(Go[e])return Go[e];if(!Ko[e])return e;var t,n=Ko[e];for(t in n)if(n.hasOwnProperty(t)&&t in Bo)return Go[e]=n[t];return""}function Ue(e){return Object.prototype.hasOwnProperty.call(e,$o)||(e[$o]=Jo++,Zo[e[$o]]={}),Zo[e[$o]]}function Ne(e){for(;e&&e.firstChild;)e=e.firstChild;return e}function Ie(e,t){var n=Ne(e);e=0;for(var o;n;){if(3===n.nodeType){if(o=e+n.textContent.length,e<=t&&o>=t)return{node:n,offset:t-e};e=o}e:{for(;n;){if(n.nextSibling){n=n.nextSibling;break e}n=n.parentNode}n=void 0}n=Ne(n)}}function De(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return t&&("input"===t&&"text"===e.type||"textarea"===t||"true"===e.contentEditable)}function Ae(e,t){if(lr||null==nr||nr!==En())return null;var n=nr;return"selectionStart"in n&&De(n)?n={start:n.selectionStart,end:n.selectionEnd}:window.getSelection?(n=window.getSelection(),n={anchorNode:n.anchorNode,anchorOffset:n.anchorOffset,focusNode:n.focusNode,focusOffset:n.focusOffset}):n=void 0,rr&&Cn(rr,n)?null:(rr=n,e=A.getPooled(tr.select,or,e,t),e.type="select",e.target=nr,H(e),e)}function Ye(e,t,n,o){return A.call(this,e,t,n,o)}function qe(e,t,n,o){return A.call(this,e,t,n,o)}function Fe(e,t,n,o){return A.call(this,e,t,n,o)}function ze(e){var t=e.keyCode;return"charCode"in e?0===(e=e.charCode)&&13===t&&(e=13):e=t,32<=e||13===e?e:0}function We(e,t,n,o){return A.call(this,e,t,n,o)}function Xe(e,t,n,o){return A.call(this,e,t,n,o)}function Ve(e,t,n,o){return A.call(this,e,t,n,o)}function Ke(e,t,n,o){return A.call(this,e,t,n,o)}function Ge(e,t,n,o){return A.call(this,e,t,n,o)}function Be(e){0>pr||(e.current=fr[pr],fr[pr]=null,pr--)}function Qe(e,t){pr++,fr[pr]=e.current,e.current=t}function Ze(e){return $e(e)?mr:br.current}function Je(e,t){var n=e.type.contextTypes;if(!n)return Mn;var o=e.stateNode;if(o&&o.__reactInternalMemoizedUnmaskedChildContext===t)return o.__reactInternalMemoizedMaskedChildContext;var r,l={};for(r in n)l[r]=t[r];return o&&(e=e.stateNode,e.__reactInternalMemoizedUnmaskedChildContext=t,e.__reactInternalMemoizedMaskedChildContext=l),l}function $e(e){return 2===e.tag&&null!=e.type.childContextTypes}function et(e){$e(e)&&(Be(hr,e),Be(br,e))}function tt(e,t,n){null!=br.cursor&&o("168"),Qe(br,t,e),Qe(hr,n,e)}function nt(e,t){var n=e.stateNode,r=e.type.childContextTypes;if("function"!=typeof n.getChildContext)return t;n=n.getChildContext();for(var l in n)l in r||o("108",xe(e)||"Unknown",l);return xn({},t,n)}function ot(e){if(!$e(e))return!1;var t=e.stateNode;return t=t&&t.__reactInternalMemoizedMergedChildContext||Mn,mr=br.current,Qe(br,t,e),Qe(hr,hr.current,e),!0}function rt(e,t){var n=e.stateNode;if(n||o("169"),t){var r=nt(e,mr);n.__reactInternalMemoizedMergedChildContext=r,Be(hr,e),Be(br,e),Qe(br,r,e)}else Be(hr,e);Qe(hr,t,e)}function lt(e,t,n){this.tag=e,this.key=t,this.stateNode=this.type=null,this.sibling=this.child=this.return=null,this.index=0,this.memoizedState=this.updateQueue=this.memoizedProps=this.pendingProps=this.ref=null,this.internalContextTag=n,this.effectTag=0,this.lastEffect=this.firstEffect=this.nextEffect=null,this.expirationTime=0,this.alternate=null}function at(e,t,n){var o=e.alternate;return null===o?(o=new lt(e.tag,e.key,e.internalContextTag),o.type=e.type,o.stateNode=e.stateNode,o.alternate=e,e.alternate=o):(o.effectTag=0,o.nextEffect=null,o.firstEffect=null,o.lastEffect=null),o.expirationTime=n,o.pendingProps=t,o.child=e.child,o.memoizedProps=e.memoizedProps,o.memoizedState=e.memoizedState,o.updateQueue=e.updateQueue,o.sibling=e.sibling,o.index=e.index,o.ref=e.ref,o}function it(e,t,n){var r=void 0,l=e.type,a=e.key;return"function"==typeof l?(r=l.prototype&&l.prototype.isReactComponent?new lt(2,a,t):new lt(0,a,t),r.type=l,r.pendingProps=e.props):"string"==typeof l?(r=new lt(5,a,t),r.type=l,r.pendingProps=e.props):"object"==typeof l&&null!==l&&"number"==typeof l.tag?(r=l,r.pendingProps=e.props):o("130",null==l?l:typeof l,""),r.expirationTime=n,r}function ct(e,t,n,o){return t=new lt(10,o,t),t.pendingProps=e,t.expirationTime=n,t}function st(e,t,n){return t=new lt(6,null,t),t.pendingProps=e,t.expirationTime=n,t}function ut(e,t,n){return t=new lt(7,e.key,t),t.type=e.handler,t.pendingProps=e,t.expirationTime=n,t}function dt(e,t,n){return e=new lt(9,null,t),e.expirationTime=n,e}function ft(e,t,n){return t=new lt(4,e.key,t),t.pendingProps=e.children||[],t.expirationTime=n,t.stateNode={containerInfo:e.containerInfo,pendingChildren:null,implementation:e.implementation},t}function pt(e){return function(t){try{return e(t)}catch(e){}}}function bt(e){if("undefined"==typeof __REACT_DEVTOOLS_GLOBAL_HOOK__)return!1;var t=__REACT_DEVTOOLS_GLOBAL_HOOK__;if(t.isDisabled||!t.supportsFiber)return!0;try{var n=t.inject(e);gr=pt(function(e){return t.onCommitFiberRoot(n,e)}),_r=pt(function(e){return t.onCommitFiberUnmount(n,e)})}catch(e){}return!0}function ht(e){"function"==typeof gr&&gr(e)}function mt(e){"function"==typeof _r&&_r(e)}function gt(e){return{baseState:e,expirationTime:0,first:null,last:null,callbackList:null,hasForceUpdate:!1,isInitialized:!1}}function _t(e,t){null===e.last?e.first=e.last=t:(e.last.next=t,e.last=t),(0===e.expirationTime||e.expirationTime>t.expirationTime)&&(e.expirationTime=t.expirationTime)}function vt(e,t){var n=e.alternate,o=e.updateQueue;null===o&&(o=e.updateQueue=gt(null)),null!==n?null===(e=n.updateQueue)&&(e=n.updateQueue=gt(null)):e=null,e=e!==o?e:null,null===e?_t(o,t):null===o.last||null===e.last?(_t(o,t),_t(e,t)):(_t(o,t),e.last=t)}function yt(e,t,n,o){return e=e.partialState,"function"==typeof e?e.call(t,n,o):e}function xt(e,t,n,o,r,l){null!==e&&e.updateQueue===n&&(n=t.updateQueue={baseState:n.baseState,expirationTime:n.expirationTime,first:n.first,last:n.last,isInitialized:n.isInitialized,callbackList:null,hasForceUpdate:!1}),n.expirationTime=0,n.isInitialized?e=n.baseState:(e=n.baseState=t.memoizedState,n.isInitialized=!0);for(var a=!0,i=n.first,c=!1;null!==i;){var s=i.expirationTime;if(s>l){var u=n.expirationTime;(0===u||u>s)&&(n.expirationTime=s),c||(c=!0,n.baseState=e)}else c||(n.first=i.next,null===n.first&&(n.last=null)),i.isReplace?(e=yt(i,o,e,r),a=!0):(s=yt(i,o,e,r))&&(e=a?xn({},e,s):xn(e,s),a=!1),i.isForced&&(n.hasForceUpdate=!0),null!==i.callback&&(s=n.callbackList,null===s&&(s=n.callbackList=[]),s.push(i));i=i.next}return null!==n.callbackList?t.effectTag|=32:null!==n.first||n.hasForceUpdate||(t.updateQueue=null),c||(n.baseState=e),e}function wt(e,t){var n=e.callbackList;if(null!==n)for(e.callbackList=null,e=0;e<n.length;e++){var r=n[e],l=r.callback;r.callback=null,"function"!=typeof l&&o("191",l),l.call(t)}}function kt(e,t,n,r){function l(e,t){t.updater=a,e.stateNode=t,t._reactInternalFiber=e}var a={isMounted:ke,enqueueSetState:function(n,o,r){n=n._reactInternalFiber,r=void 0===r?null:r;var l=t(n);vt(n,{expirationTime:l,partialState:o,callback:r,isReplace:!1,isForced:!1,nextCallback:null,next:null}),e(n,l)},enqueueReplaceState:function(n,o,r){n=n._reactInternalFiber,r=void 0===r?null:r;var l=t(n);vt(n,{expirationTime:l,partialState:o,callback:r,isReplace:!0,isForced:!1,nextCallback:null,next:null}),e(n,l)},enqueueForceUpdate:function(n,o){n=n._reactInternalFiber,o=void 0===o?null:o;var r=t(n);vt(n,{expirationTime:r,partialState:null,callback:o,isReplace:!1,isForced:!0,nextCallback:null,next:null}),e(n,r)}};return{adoptClassInstance:l,constructClassInstance:function(e,t){var n=e.type,o=Ze(e),r=2===e.tag&&null!=e.type.contextTypes,a=r?Je(e,o):Mn;return t=new n(t,a),l(e,t),r&&(e=e.stateNode,e.__reactInternalMemoizedUnmaskedChildContext=o,e.__reactInternalMemoizedMaskedChildContext=a),t},mountClassInstance:function(e,t){var n=e.alternate,r=e.stateNode,l=r.state||null,i=e.pendingProps;i||o("158");var c=Ze(e);r.props=i,r.state=e.memoizedState=l,r.refs=Mn,r.context=Je(e,c),null!=e.type&&null!=e.type.prototype&&!0===e.type.prototype.unstable_isAsyncReactComponent&&(e.internalContextTag|=1),"function"==typeof r.componentWillMount&&(l=r.state,r.componentWillMount(),l!==r.state&&a.enqueueReplaceState(r,r.state,null),null!==(l=e.updateQueue)&&(r.state=xt(n,e,l,r,i,t))),"function"==typeof r.componentDidMount&&(e.effectTag|=4)},updateClassInstance:function(e,t,l){var i=t.stateNode;i.props=t.memoizedProps,i.state=t.memoizedState;var c=t.memoizedProps,s=t.pendingProps;s||null==(s=c)&&o("159");var u=i.context,d=Ze(t);if(d=Je(t,d),"function"!=typeof i.componentWillReceiveProps||c===s&&u===d||(u=i.state,i.componentWillReceiveProps(s,d),i.state!==u&&a.enqueueReplaceState(i,i.state,null)),u=t.memoizedState,l=null!==t.updateQueue?xt(e,t,t.updateQueue,i,s,l):u,!(c!==s||u!==l||hr.current||null!==t.updateQueue&&t.updateQueue.hasForceUpdate))return"function"!=typeof i.componentDidUpdate||c===e.memoizedProps&&u===e.memoizedState||(t.effectTag|=4),!1;var f=s;if(null===c||null!==t.updateQueue&&t.updateQueue.hasForceUpdate)f=!0;else{var p=t.stateNode,b=t.type;f="function"==typeof p.shouldComponentUpdate?p.shouldComponentUpdate(f,l,d):!b.prototype||!b.prototype.isPureReactComponent||(!Cn(c,f)||!Cn(u,l))}return f?("function"==typeof i.componentWillUpdate&&i.componentWillUpdate(s,l,d),"function"==typeof i.componentDidUpdate&&(t.effectTag|=4)):("function"!=typeof i.componentDidUpdate||c===e.memoizedProps&&u===e.memoizedState||(t.effectTag|=4),n(t,s),r(t,l)),i.props=s,i.state=l,i.context=d,f}}}function Et(e){return null===e||void 0===e?null:(e=Cr&&e[Cr]||e["@@iterator"],"function"==typeof e?e:null)}function Ct(e,t){var n=t.ref;if(null!==n&&"function"!=typeof n){if(t._owner){t=t._owner;var r=void 0;t&&(2!==t.tag&&o("110"),r=t.stateNode),r||o("147",n);var l=""+n;return null!==e&&null!==e.ref&&e.ref._stringRef===l?e.ref:(e=function(e){var t=r.refs===Mn?r.refs={}:r.refs;null===e?delete t[l]:t[l]=e},e._stringRef=l,e)}"string"!=typeof n&&o("148"),t._owner||o("149",n)}return n}function Ot(e,t){"textarea"!==e.type&&o("31","[object Object]"===Object.prototype.toString.call(t)?"object with keys {"+Object.keys(t).join(", ")+"}":t,"")}function Pt(e){function t(t,n){if(e){var o=t.lastEffect;null!==o?(o.nextEffect=n,t.lastEffect=n):t.firstEffect=t.lastEffect=n,n.nextEffect=null,n.effectTag=8}}function n(n,o){if(!e)return null;for(;null!==o;)t(n,o),o=o.sibling;return null}function r(e,t){for(e=new Map;null!==t;)null!==t.key?e.set(t.key,t):e.set(t.index,t),t=t.sibling;return e}function l(e,t,n){return e=at(e,t,n),e.index=0,e.sibling=null,e}function a(t,n,o){return t.index=o,e?null!==(o=t.alternate)?(o=o.index,o<n?(t.effectTag=2,n):o):(t.effectTag=2,n):n}function i(t){return e&&null===t.alternate&&(t.effectTag=2),t}function c(e,t,n,o){return null===t||6!==t.tag?(t=st(n,e.internalContextTag,o),t.return=e,t):(t=l(t,n,o),t.return=e,t)}function s(e,t,n,o){return null!==t&&t.type===n.type?(o=l(t,n.props,o),o.ref=Ct(t,n),o.return=e,o):(o=it(n,e.internalContextTag,o),o.ref=Ct(t,n),o.return=e,o)}function u(e,t,n,o){return null===t||7!==t.tag?(t=ut(n,e.internalContextTag,o),t.return=e,t):(t=l(t,n,o),t.return=e,t)}function d(e,t,n,o){return null===t||9!==t.tag?(t=dt(n,e.internalContextTag,o),t.type=n.value,t.return=e,t):(t=l(t,null,o),t.type=n.value,t.return=e,t)}function f(e,t,n,o){return null===t||4!==t.tag||t.stateNode.containerInfo!==n.containerInfo||t.stateNode.implementation!==n.implementation?(t=ft(n,e.internalContextTag,o),t.return=e,t):(t=l(t,n.children||[],o),t.return=e,t)}function p(e,t,n,o,r){return null===t||10!==t.tag?(t=ct(n,e.internalContextTag,o,r),t.return=e,t):(t=l(t,n,o),t.return=e,t)}function b(e,t,n){if("string"==typeof t||"number"==typeof t)return t=st(""+t,e.internalContextTag,n),t.return=e,t;if("object"==typeof t&&null!==t){switch(t.$$typeof){case yr:return t.type===Er?(t=ct(t.props.children,e.internalContextTag,n,t.key),t.return=e,t):(n=it(t,e.internalContextTag,n),n.ref=Ct(null,t),n.return=e,n);case xr:return t=ut(t,e.internalContextTag,n),t.return=e,t;case wr:return n=dt(t,e.internalContextTag,n),n.type=t.value,n.return=e,n;case kr:return t=ft(t,e.internalContextTag,n),t.return=e,t}if(Or(t)||Et(t))return t=ct(t,e.internalContextTag,n,null),t.return=e,t;Ot(e,t)}return null}function h(e,t,n,o){var r=null!==t?t.key:null;if("string"==typeof n||"number"==typeof n)return null!==r?null:c(e,t,""+n,o);if("object"==typeof n&&null!==n){switch(n.$$typeof){case yr:return n.key===r?n.type===Er?p(e,t,n.props.children,o,r):s(e,t,n,o):null;case xr:return n.key===r?u(e,t,n,o):null;case wr:return null===r?d(e,t,n,o):null;case kr:return n.key===r?f(e,t,n,o):null}if(Or(n)||Et(n))return null!==r?null:p(e,t,n,o,null);Ot(e,n)}return null}function m(e,t,n,o,r){if("string"==typeof o||"number"==typeof o)return e=e.get(n)||null,c(t,e,""+o,r);if("object"==typeof o&&null!==o){switch(o.$$typeof){case yr:return e=e.get(null===o.key?n:o.key)||null,o.type===Er?p(t,e,o.props.children,r,o.key):s(t,e,o,r);case xr:return e=e.get(null===o.key?n:o.key)||null,u(t,e,o,r);case wr:return e=e.get(n)||null,d(t,e,o,r);case kr:return e=e.get(null===o.key?n:o.key)||null,f(t,e,o,r)}if(Or(o)||Et(o))return e=e.get(n)||null,p(t,e,o,r,null);Ot(t,o)}return null}function g(o,l,i,c){for(var s=null,u=null,d=l,f=l=0,p=null;null!==d&&f<i.length;f++){d.index>f?(p=d,d=null):p=d.sibling;var g=h(o,d,i[f],c);if(null===g){null===d&&(d=p);break}e&&d&&null===g.alternate&&t(o,d),l=a(g,l,f),null===u?s=g:u.sibling=g,u=g,d=p}if(f===i.length)return n(o,d),s;if(null===d){for(;f<i.length;f++)(d=b(o,i[f],c))&&(l=a(d,l,f),null===u?s=d:u.sibling=d,u=d);return s}for(d=r(o,d);f<i.length;f++)(p=m(d,o,f,i[f],c))&&(e&&null!==p.alternate&&d.delete(null===p.key?f:p.key),l=a(p,l,f),null===u?s=p:u.sibling=p,u=p);return e&&d.forEach(function(e){return t(o,e)}),s}function _(l,i,c,s){var u=Et(c);"function"!=typeof u&&o("150"),null==(c=u.call(c))&&o("151");for(var d=u=null,f=i,p=i=0,g=null,_=c.next();null!==f&&!_.done;p++,_=c.next()){f.index>p?(g=f,f=null):g=f.sibling;var v=h(l,f,_.value,s);if(null===v){f||(f=g);break}e&&f&&null===v.alternate&&t(l,f),i=a(v,i,p),null===d?u=v:d.sibling=v,d=v,f=g}if(_.done)return n(l,f),u;if(null===f){for(;!_.done;p++,_=c.next())null!==(_=b(l,_.value,s))&&(i=a(_,i,p),null===d?u=_:d.sibling=_,d=_);return u}for(f=r(l,f);!_.done;p++,_=c.next())null!==(_=m(f,l,p,_.value,s))&&(e&&null!==_.alternate&&f.delete(null===_.key?p:_.key),i=a(_,i,p),null===d?u=_:d.sibling=_,d=_);return e&&f.forEach(function(e){return t(l,e)}),u}return function(e,r,a,c){"object"==typeof a&&null!==a&&a.type===Er&&null===a.key&&(a=a.props.children);var s="object"==typeof a&&null!==a;if(s)switch(a.$$typeof){case yr:e:{var u=a.key;for(s=r;null!==s;){if(s.key===u){if(10===s.tag?a.type===Er:s.type===a.type){n(e,s.sibling),r=l(s,a.type===Er?a.props.children:a.props,c),r.ref=Ct(s,a),r.return=e,e=r;break e}n(e,s);break}t(e,s),s=s.sibling}a.type===Er?(r=ct(a.props.children,e.internalContextTag,c,a.key),r.return=e,e=r):(c=it(a,e.internalContextTag,c),c.ref=Ct(r,a),c.return=e,e=c)}return i(e);case xr:e:{for(s=a.key;null!==r;){if(r.key===s){if(7===r.tag){n(e,r.sibling),r=l(r,a,c),r.return=e,e=r;break e}n(e,r);break}t(e,r),r=r.sibling}r=ut(a,e.internalContextTag,c),r.return=e,e=r}return i(e);case wr:e:{if(null!==r){if(9===r.tag){n(e,r.sibling),r=l(r,null,c),r.type=a.value,r.return=e,e=r;break e}n(e,r)}r=dt(a,e.internalContextTag,c),r.type=a.value,r.return=e,e=r}return i(e);case kr:e:{for(s=a.key;null!==r;){if(r.key===s){if(4===r.tag&&r.stateNode.containerInfo===a.containerInfo&&r.stateNode.implementation===a.implementation){n(e,r.sibling),r=l(r,a.children||[],c),r.return=e,e=r;break e}n(e,r);break}t(e,r),r=r.sibling}r=ft(a,e.internalContextTag,c),r.return=e,e=r}return i(e)}if("string"==typeof a||"number"==typeof a)return a=""+a,null!==r&&6===r.tag?(n(e,r.sibling),r=l(r,a,c)):(n(e,r),r=st(a,e.internalContextTag,c)),r.return=e,e=r,i(e);if(Or(a))return g(e,r,a,c);if(Et(a))return _(e,r,a,c);if(s&&Ot(e,a),void 0===a)switch(e.tag){case 2:case 1:c=e.type,o("152",c.displayName||c.name||"Component")}return n(e,r)}}function Mt(e,t,n,r,l){function a(e,t,n){var o=t.expirationTime;t.child=null===e?Mr(t,null,n,o):Pr(t,e.child,n,o)}function i(e,t){var n=t.ref;null===n||e&&e.ref===n||(t.effectTag|=128)}function c(e,t,n,o){if(i(e,t),!n)return o&&rt(t,!1),u(e,t);n=t.stateNode,Fo.current=t;var r=n.render();return t.effectTag|=1,a(e,t,r),t.memoizedState=n.state,t.memoizedProps=n.props,o&&rt(t,!0),t.child}function s(e){var t=e.stateNode;t.pendingContext?tt(e,t.pendingContext,t.pendingContext!==t.context):t.context&&tt(e,t.context,!1),m(e,t.containerInfo)}function u(e,t){if(null!==e&&t.child!==e.child&&o("153"),null!==t.child){e=t.child;var n=at(e,e.pendingProps,e.expirationTime);for(t.child=n,n.return=t;null!==e.sibling;)e=e.sibling,n=n.sibling=at(e,e.pendingProps,e.expirationTime),n.return=t;n.sibling=null}return t.child}function d(e,t){switch(t.tag){case 3:s(t);break;case 2:ot(t);break;case 4:m(t,t.stateNode.containerInfo)}return null}var f=e.shouldSetTextContent,p=e.useSyncScheduling,b=e.shouldDeprioritizeSubtree,h=t.pushHostContext,m=t.pushHostContainer,g=n.enterHydrationState,_=n.resetHydrationState,v=n.tryToClaimNextHydratableInstance;e=kt(r,l,function(e,t){e.memoizedProps=t},function(e,t){e.memoizedState=t});var y=e.adoptClassInstance,x=e.constructClassInstance,w=e.mountClassInstance,k=e.updateClassInstance;return{beginWork:function(e,t,n){if(0===t.expirationTime||t.expirationTime>n)return d(e,t);switch(t.tag){case 0:null!==e&&o("155");var r=t.type,l=t.pendingProps,E=Ze(t);return E=Je(t,E),r=r(l,E),t.effectTag|=1,"object"==typeof r&&null!==r&&"function"==typeof r.render?(t.tag=2,l=ot(t),y(t,r),w(t,n),t=c(e,t,!0,l)):(t.tag=1,a(e,t,r),t.memoizedProps=l,t=t.child),t;case 1:e:{if(l=t.type,n=t.pendingProps,r=t.memoizedProps,hr.current)null===n&&(n=r);else if(null===n||r===n){t=u(e,t);break e}r=Ze(t),r=Je(t,r),l=l(n,r),t.effectTag|=1,a(e,t,l),t.memoizedProps=n,t=t.child}return t;case 2:return l=ot(t),r=void 0,null===e?t.stateNode?o("153"):(x(t,t.pendingProps),w(t,n),r=!0):r=k(e,t,n),c(e,t,r,l);case 3:return s(t),l=t.updateQueue,null!==l?(r=t.memoizedState,l=xt(e,t,l,null,null,n),r===l?(_(),t=u(e,t)):(r=l.element,E=t.stateNode,(null===e||null===e.child)&&E.hydrate&&g(t)?(t.effectTag|=2,t.child=Mr(t,null,r,n)):(_(),a(e,t,r)),t.memoizedState=l,t=t.child)):(_(),t=u(e,t)),t;case 5:h(t),null===e&&v(t),l=t.type;var C=t.memoizedProps;return r=t.pendingProps,null===r&&null===(r=C)&&o("154"),E=null!==e?e.memoizedProps:null,hr.current||null!==r&&C!==r?(C=r.children,f(l,r)?C=null:E&&f(l,E)&&(t.effectTag|=16),i(e,t),2147483647!==n&&!p&&b(l,r)?(t.expirationTime=2147483647,t=null):(a(e,t,C),t.memoizedProps=r,t=t.child)):t=u(e,t),t;case 6:return null===e&&v(t),e=t.pendingProps,null===e&&(e=t.memoizedProps),t.memoizedProps=e,null;case 8:t.tag=7;case 7:return l=t.pendingProps,hr.current?null===l&&null===(l=e&&e.memoizedProps)&&o("154"):null!==l&&t.memoizedProps!==l||(l=t.memoizedProps),r=l.children,t.stateNode=null===e?Mr(t,t.stateNode,r,n):Pr(t,t.stateNode,r,n),t.memoizedProps=l,t.stateNode;case 9:return null;case 4:e:{if(m(t,t.stateNode.containerInfo),l=t.pendingProps,hr.current)null===l&&null==(l=e&&e.memoizedProps)&&o("154");else if(null===l||t.memoizedProps===l){t=u(e,t);break e}null===e?t.child=Pr(t,null,l,n):a(e,t,l),t.memoizedProps=l,t=t.child}return t;case 10:e:{if(n=t.pendingProps,hr.current)null===n&&(n=t.memoizedProps);else if(null===n||t.memoizedProps===n){t=u(e,t);break e}a(e,t,n),t.memoizedProps=n,t=t.child}return t;default:o("156")}},beginFailedWork:function(e,t,n){switch(t.tag){case 2:ot(t);break;case 3:s(t);break;default:o("157")}return t.effectTag|=64,null===e?t.child=null:t.child!==e.child&&(t.child=e.child),0===t.expirationTime||t.expirationTime>n?d(e,t):(t.firstEffect=null,t.lastEffect=null,t.child=null===e?Mr(t,null,null,n):Pr(t,e.child,null,n),2===t.tag&&(e=t.stateNode,t.memoizedProps=e.props,t.memoizedState=e.state),t.child)}}}function Lt(e,t,n){function r(e){e.effectTag|=4}var l=e.createInstance,a=e.createTextInstance,i=e.appendInitialChild,c=e.finalizeInitialChildren,s=e.prepareUpdate,u=e.persistence,d=t.getRootHostContainer,f=t.popHostContext,p=t.getHostContext,b=t.popHostContainer,h=n.prepareToHydrateHostInstance,m=n.prepareToHydrateHostTextInstance,g=n.popHydrationState,_=void 0,v=void 0,y=void 0;return e.mutation?(_=function(){},v=function(e,t,n){(t.updateQueue=n)&&r(t)},y=function(e,t,n,o){n!==o&&r(t)}):o(u?"235":"236"),{completeWork:function(e,t,n){var u=t.pendingProps;switch(null===u?u=t.memoizedProps:2147483647===t.expirationTime&&2147483647!==n||(t.pendingProps=null),t.tag){case 1:return null;case 2:return et(t),null;case 3:return b(t),Be(hr,t),Be(br,t),u=t.stateNode,u.pendingContext&&(u.context=u.pendingContext,u.pendingContext=null),null!==e&&null!==e.child||(g(t),t.effectTag&=-3),_(t),null;case 5:f(t),n=d();var x=t.type;if(null!==e&&null!=t.stateNode){var w=e.memoizedProps,k=t.stateNode,E=p();k=s(k,x,w,u,n,E),v(e,t,k,x,w,u,n),e.ref!==t.ref&&(t.effectTag|=128)}else{if(!u)return null===t.stateNode&&o("166"),null;if(e=p(),g(t))h(t,n,e)&&r(t);else{e=l(x,u,n,e,t);e:for(w=t.child;null!==w;){if(5===w.tag||6===w.tag)i(e,w.stateNode);else if(4!==w.tag&&null!==w.child){w.child.return=w,w=w.child;continue}if(w===t)break;for(;null===w.sibling;){if(null===w.return||w.return===t)break e;w=w.return}w.sibling.return=w.return,w=w.sibling}c(e,x,u,n)&&r(t),t.stateNode=e}null!==t.ref&&(t.effectTag|=128)}return null;case 6:if(e&&null!=t.stateNode)y(e,t,e.memoizedProps,u);else{if("string"!=typeof u)return null===t.stateNode&&o("166"),null;e=d(),n=p(),g(t)?m(t)&&r(t):t.stateNode=a(u,e,n,t)}return null;case 7:(u=t.memoizedProps)||o("165"),t.tag=8,x=[];e:for((w=t.stateNode)&&(w.return=t);null!==w;){if(5===w.tag||6===w.tag||4===w.tag)o("247");else if(9===w.tag)x.push(w.type);else if(null!==w.child){w.child.return=w,w=w.child;continue}for(;null===w.sibling;){if(null===w.return||w.return===t)break e;w=w.return}w.sibling.return=w.return,w=w.sibling}return w=u.handler,u=w(u.props,x),t.child=Pr(t,null!==e?e.child:null,u,n),t.child;case 8:return t.tag=7,null;case 9:case 10:return null;case 4:return b(t),_(t),null;case 0:o("167");default:o("156")}}}}function Rt(e,t){function n(e){var n=e.ref;if(null!==n)try{n(null)}catch(n){t(e,n)}}function r(e){switch("function"==typeof mt&&mt(e),e.tag){case 2:n(e);var o=e.stateNode;if("function"==typeof o.componentWillUnmount)try{o.props=e.memoizedProps,o.state=e.memoizedState,o.componentWillUnmount()}catch(n){t(e,n)}break;case 5:n(e);break;case 7:l(e.stateNode);break;case 4:s&&i(e)}}function l(e){for(var t=e;;)if(r(t),null===t.child||s&&4===t.tag){if(t===e)break;for(;null===t.sibling;){if(null===t.return||t.return===e)return;t=t.return}t.sibling.return=t.return,t=t.sibling}else t.child.return=t,t=t.child}function a(e){return 5===e.tag||3===e.tag||4===e.tag}function i(e){for(var t=e,n=!1,a=void 0,i=void 0;;){if(!n){n=t.return;e:for(;;){switch(null===n&&o("160"),n.tag){case 5:a=n.stateNode,i=!1;break e;case 3:case 4:a=n.stateNode.containerInfo,i=!0;break e}n=n.return}n=!0}if(5===t.tag||6===t.tag)l(t),i?v(a,t.stateNode):_(a,t.stateNode);else if(4===t.tag?a=t.stateNode.containerInfo:r(t),null!==t.child){t.child.return=t,t=t.child;continue}if(t===e)break;for(;null===t.sibling;){if(null===t.return||t.return===e)return;t=t.return,4===t.tag&&(n=!1)}t.sibling.return=t.return,t=t.sibling}}var c=e.getPublicInstance,s=e.mutation;e=e.persistence,s||o(e?"235":"236");var u=s.commitMount,d=s.commitUpdate,f=s.resetTextContent,p=s.commitTextUpdate,b=s.appendChild,h=s.appendChildToContainer,m=s.insertBefore,g=s.insertInContainerBefore,_=s.removeChild,v=s.removeChildFromContainer;return{commitResetTextContent:function(e){f(e.stateNode)},commitPlacement:function(e){e:{for(var t=e.return;null!==t;){if(a(t)){var n=t;break e}t=t.return}o("160"),n=void 0}var r=t=void 0;switch(n.tag){case 5:t=n.stateNode,r=!1;break;case 3:case 4:t=n.stateNode.containerInfo,r=!0;break;default:o("161")}16&n.effectTag&&(f(t),n.effectTag&=-17);e:t:for(n=e;;){for(;null===n.sibling;){if(null===n.return||a(n.return)){n=null;break e}n=n.return}for(n.sibling.return=n.return,n=n.sibling;5!==n.tag&&6!==n.tag;){if(2&n.effectTag)continue t;if(null===n.child||4===n.tag)continue t;n.child.return=n,n=n.child}if(!(2&n.effectTag)){n=n.stateNode;break e}}for(var l=e;;){if(5===l.tag||6===l.tag)n?r?g(t,l.stateNode,n):m(t,l.stateNode,n):r?h(t,l.stateNode):b(t,l.stateNode);else if(4!==l.tag&&null!==l.child){l.child.return=l,l=l.child;continue}if(l===e)break;for(;null===l.sibling;){if(null===l.return||l.return===e)return;l=l.return}l.sibling.return=l.return,l=l.sibling}},commitDeletion:function(e){i(e),e.return=null,e.child=null,e.alternate&&(e.alternate.child=null,e.alternate.return=null)},commitWork:function(e,t){switch(t.tag){case 2:break;case 5:var n=t.stateNode;if(null!=n){var r=t.memoizedProps;e=null!==e?e.memoizedProps:r;var l=t.type,a=t.updateQueue;t.updateQueue=null,null!==a&&d(n,a,l,e,r,t)}break;case 6:null===t.stateNode&&o("162"),n=t.memoizedProps,p(t.stateNode,null!==e?e.memoizedProps:n,n);break;case 3:break;default:o("163")}},commitLifeCycles:function(e,t){switch(t.tag){case 2:var n=t.stateNode;if(4&t.effectTag)if(null===e)n.props=t.memoizedProps,n.state=t.memoizedState,n.componentDidMount();else{var r=e.memoizedProps;e=e.memoizedState,n.props=t.memoizedProps,n.state=t.memoizedState,n.componentDidUpdate(r,e)}t=t.updateQueue,null!==t&&wt(t,n);break;case 3:n=t.updateQueue,null!==n&&wt(n,null!==t.child?t.child.stateNode:null);break;case 5:n=t.stateNode,null===e&&4&t.effectTag&&u(n,t.type,t.memoizedProps,t);break;case 6:case 4:break;default:o("163")}},commitAttachRef:function(e){var t=e.ref;if(null!==t){var n=e.stateNode;switch(e.tag){case 5:t(c(n));break;default:t(n)}}},commitDetachRef:function(e){null!==(e=e.ref)&&e(null)}}}function jt(e){function t(e){return e===Lr&&o("174"),e}var n=e.getChildHostContext,r=e.getRootHostContext,l={current:Lr},a={current:Lr},i={current:Lr};return{getHostContext:function(){return t(l.current)},getRootHostContainer:function(){return t(i.current)},popHostContainer:function(e){Be(l,e),Be(a,e),Be(i,e)},popHostContext:function(e){a.current===e&&(Be(l,e),Be(a,e))},pushHostContainer:function(e,t){Qe(i,t,e),t=r(t),Qe(a,e,e),Qe(l,t,e)},pushHostContext:function(e){var o=t(i.current),r=t(l.current);o=n(r,e.type,o),r!==o&&(Qe(a,e,e),Qe(l,o,e))},resetHostContainer:function(){l.current=Lr,i.current=Lr}}}function St(e){function t(e,t){var n=new lt(5,null,0);n.type="DELETED",n.stateNode=t,n.return=e,n.effectTag=8,null!==e.lastEffect?(e.lastEffect.nextEffect=n,e.lastEffect=n):e.firstEffect=e.lastEffect=n}function n(e,t){switch(e.tag){case 5:return null!==(t=a(t,e.type,e.pendingProps))&&(e.stateNode=t,!0);case 6:return null!==(t=i(t,e.pendingProps))&&(e.stateNode=t,!0);default:return!1}}function r(e){for(e=e.return;null!==e&&5!==e.tag&&3!==e.tag;)e=e.return;f=e}var l=e.shouldSetTextContent;if(!(e=e.hydration))return{enterHydrationState:function(){return!1},resetHydrationState:function(){},tryToClaimNextHydratableInstance:function(){},prepareToHydrateHostInstance:function(){o("175")},prepareToHydrateHostTextInstance:function(){o("176")},popHydrationState:function(){return!1}};var a=e.canHydrateInstance,i=e.canHydrateTextInstance,c=e.getNextHydratableSibling,s=e.getFirstHydratableChild,u=e.hydrateInstance,d=e.hydrateTextInstance,f=null,p=null,b=!1;return{enterHydrationState:function(e){return p=s(e.stateNode.containerInfo),f=e,b=!0},resetHydrationState:function(){p=f=null,b=!1},tryToClaimNextHydratableInstance:function(e){if(b){var o=p;if(o){if(!n(e,o)){if(!(o=c(o))||!n(e,o))return e.effectTag|=2,b=!1,void(f=e);t(f,p)}f=e,p=s(o)}else e.effectTag|=2,b=!1,f=e}},prepareToHydrateHostInstance:function(e,t,n){return t=u(e.stateNode,e.type,e.memoizedProps,t,n,e),e.updateQueue=t,null!==t},prepareToHydrateHostTextInstance:function(e){return d(e.stateNode,e.memoizedProps,e)},popHydrationState:function(e){if(e!==f)return!1;if(!b)return r(e),b=!0,!1;var n=e.type;if(5!==e.tag||"head"!==n&&"body"!==n&&!l(n,e.memoizedProps))for(n=p;n;)t(e,n),n=c(n);return r(e),p=f?c(e.stateNode):null,!0}}}function Tt(e){function t(e){le=B=!0;var t=e.stateNode;if(t.current===e&&o("177"),t.isReadyForCommit=!1,Fo.current=null,1<e.effectTag)if(null!==e.lastEffect){e.lastEffect.nextEffect=e;var n=e.firstEffect}else n=e;else n=e.firstEffect;for(W(),$=n;null!==$;){var r=!1,l=void 0;try{for(;null!==$;){var a=$.effectTag;if(16&a&&T($),128&a){var i=$.alternate;null!==i&&A(i)}switch(-242&a){case 2:H($),$.effectTag&=-3;break;case 6:H($),$.effectTag&=-3,N($.alternate,$);break;case 4:N($.alternate,$);break;case 8:ae=!0,U($),ae=!1}$=$.nextEffect}}catch(e){r=!0,l=e}r&&(null===$&&o("178"),c($,l),null!==$&&($=$.nextEffect))}for(X(),t.current=e,$=n;null!==$;){n=!1,r=void 0;try{for(;null!==$;){var s=$.effectTag;if(36&s&&I($.alternate,$),128&s&&D($),64&s)switch(l=$,a=void 0,null!==ee&&(a=ee.get(l),ee.delete(l),null==a&&null!==l.alternate&&(l=l.alternate,a=ee.get(l),ee.delete(l))),null==a&&o("184"),l.tag){case 2:l.stateNode.componentDidCatch(a.error,{componentStack:a.componentStack});break;case 3:null===oe&&(oe=a.error);break;default:o("157")}var u=$.nextEffect;$.nextEffect=null,$=u}}catch(e){n=!0,r=e}n&&(null===$&&o("178"),c($,r),null!==$&&($=$.nextEffect))}return B=le=!1,"function"==typeof ht&&ht(e.stateNode),ne&&(ne.forEach(h),ne=null),null!==oe&&(e=oe,oe=null,k(e)),t=t.current.expirationTime,0===t&&(te=ee=null),t}function n(e){for(;;){var t=S(e.alternate,e,J),n=e.return,o=e.sibling,r=e;if(2147483647===J||2147483647!==r.expirationTime){if(2!==r.tag&&3!==r.tag)var l=0;else l=r.updateQueue,l=null===l?0:l.expirationTime;for(var a=r.child;null!==a;)0!==a.expirationTime&&(0===l||l>a.expirationTime)&&(l=a.expirationTime),a=a.sibling;r.expirationTime=l}if(null!==t)return t;if(null!==n&&(null===n.firstEffect&&(n.firstEffect=e.firstEffect),null!==e.lastEffect&&(null!==n.lastEffect&&(n.lastEffect.nextEffect=e.firstEffect),n.lastEffect=e.lastEffect),1<e.effectTag&&(null!==n.lastEffect?n.lastEffect.nextEffect=e:n.firstEffect=e,n.lastEffect=e)),null!==o)return o;if(null===n){e.stateNode.isReadyForCommit=!0;break}e=n}return null}function r(e){var t=R(e.alternate,e,J);return null===t&&(t=n(e)),Fo.current=null,t}function l(e){var t=j(e.alternate,e,J);return null===t&&(t=n(e)),Fo.current=null,t}function a(e){if(null!==ee){if(!(0===J||J>e))if(J<=K)for(;null!==Q;)Q=s(Q)?l(Q):r(Q);else for(;null!==Q&&!w();)Q=s(Q)?l(Q):r(Q)}else if(!(0===J||J>e))if(J<=K)for(;null!==Q;)Q=r(Q);else for(;null!==Q&&!w();)Q=r(Q)}function i(e,t){if(B&&o("243"),B=!0,e.isReadyForCommit=!1,e!==Z||t!==J||null===Q){for(;-1<pr;)fr[pr]=null,pr--;mr=Mn,br.current=Mn,hr.current=!1,M(),Z=e,J=t,Q=at(Z.current,null,t)}var n=!1,r=null;try{a(t)}catch(e){n=!0,r=e}for(;n;){if(re){oe=r;break}var i=Q;if(null===i)re=!0;else{var s=c(i,r);if(null===s&&o("183"),!re){try{for(n=s,r=t,s=n;null!==i;){switch(i.tag){case 2:et(i);break;case 5:P(i);break;case 3:O(i);break;case 4:O(i)}if(i===s||i.alternate===s)break;i=i.return}Q=l(n),a(r)}catch(e){n=!0,r=e;continue}break}}}return t=oe,re=B=!1,oe=null,null!==t&&k(t),e.isReadyForCommit?e.current.alternate:null}function c(e,t){var n=Fo.current=null,o=!1,r=!1,l=null;if(3===e.tag)n=e,u(e)&&(re=!0);else for(var a=e.return;null!==a&&null===n;){if(2===a.tag?"function"==typeof a.stateNode.componentDidCatch&&(o=!0,l=xe(a),n=a,r=!0):3===a.tag&&(n=a),u(a)){if(ae||null!==ne&&(ne.has(a)||null!==a.alternate&&ne.has(a.alternate)))return null;n=null,r=!1}a=a.return}if(null!==n){null===te&&(te=new Set),te.add(n);var i="";a=e;do{e:switch(a.tag){case 0:case 1:case 2:case 5:var c=a._debugOwner,s=a._debugSource,d=xe(a),f=null;c&&(f=xe(c)),c=s,d="\n    in "+(d||"Unknown")+(c?" (at "+c.fileName.replace(/^.*[\\\/]/,"")+":"+c.lineNumber+")":f?" (created by "+f+")":"");break e;default:d=""}i+=d,a=a.return}while(a);a=i,e=xe(e),null===ee&&(ee=new Map),t={componentName:e,componentStack:a,error:t,errorBoundary:o?n.stateNode:null,errorBoundaryFound:o,errorBoundaryName:l,willRetry:r},ee.set(n,t);try{var p=t.error;p&&p.suppressReactErrorLogging||console.error(p)}catch(e){e&&e.suppressReactErrorLogging||console.error(e)}return le?(null===ne&&(ne=new Set),ne.add(n)):h(n),n}return null===oe&&(oe=t),null}function s(e){return null!==ee&&(ee.has(e)||null!==e.alternate&&ee.has(e.alternate))}function u(e){return null!==te&&(te.has(e)||null!==e.alternate&&te.has(e.alternate))}function d(){return 20*(1+((m()+100)/20|0))}function f(e){return 0!==G?G:B?le?1:J:!z||1&e.internalContextTag?d():1}function p(e,t){return b(e,t,!1)}function b(e,t){for(;null!==e;){if((0===e.expirationTime||e.expirationTime>t)&&(e.expirationTime=t),null!==e.alternate&&(0===e.alternate.expirationTime||e.alternate.expirationTime>t)&&(e.alternate.expirationTime=t),null===e.return){if(3!==e.tag)break;var n=e.stateNode;!B&&n===Z&&t<J&&(Q=Z=null,J=0);var r=n,l=t;if(we>ye&&o("185"),null===r.nextScheduledRoot)r.remainingExpirationTime=l,null===ce?(ie=ce=r,r.nextScheduledRoot=r):(ce=ce.nextScheduledRoot=r,ce.nextScheduledRoot=ie);else{var a=r.remainingExpirationTime;(0===a||l<a)&&(r.remainingExpirationTime=l)}de||(_e?ve&&(fe=r,pe=1,x(fe,pe)):1===l?y(1,null):g(l)),!B&&n===Z&&t<J&&(Q=Z=null,J=0)}e=e.return}}function h(e){b(e,1,!0)}function m(){return K=2+((Y()-V)/10|0)}function g(e){if(0!==se){if(e>se)return;F(ue)}var t=Y()-V;se=e,ue=q(v,{timeout:10*(e-2)-t})}function _(){var e=0,t=null;if(null!==ce)for(var n=ce,r=ie;null!==r;){var l=r.remainingExpirationTime;if(0===l){if((null===n||null===ce)&&o("244"),r===r.nextScheduledRoot){ie=ce=r.nextScheduledRoot=null;break}if(r===ie)ie=l=r.nextScheduledRoot,ce.nextScheduledRoot=l,r.nextScheduledRoot=null;else{if(r===ce){ce=n,ce.nextScheduledRoot=ie,r.nextScheduledRoot=null;break}n.nextScheduledRoot=r.nextScheduledRoot,r.nextScheduledRoot=null}r=n.nextScheduledRoot}else{if((0===e||l<e)&&(e=l,t=r),r===ce)break;n=r,r=r.nextScheduledRoot}}n=fe,null!==n&&n===t?we++:we=0,fe=t,pe=e}function v(e){y(0,e)}function y(e,t){for(ge=t,_();null!==fe&&0!==pe&&(0===e||pe<=e)&&!be;)x(fe,pe),_();if(null!==ge&&(se=0,ue=-1),0!==pe&&g(pe),ge=null,be=!1,we=0,he)throw e=me,me=null,he=!1,e}function x(e,n){if(de&&o("245"),de=!0,n<=m()){var r=e.finishedWork;null!==r?(e.finishedWork=null,e.remainingExpirationTime=t(r)):(e.finishedWork=null,null!==(r=i(e,n))&&(e.remainingExpirationTime=t(r)))}else r=e.finishedWork,null!==r?(e.finishedWork=null,e.remainingExpirationTime=t(r)):(e.finishedWork=null,null!==(r=i(e,n))&&(w()?e.finishedWork=r:e.remainingExpirationTime=t(r)));de=!1}function w(){return!(null===ge||ge.timeRemaining()>ke)&&(be=!0)}function k(e){null===fe&&o("246"),fe.remainingExpirationTime=0,he||(he=!0,me=e)}var E=jt(e),C=St(e),O=E.popHostContainer,P=E.popHostContext,M=E.resetHostContainer,L=Mt(e,E,C,p,f),R=L.beginWork,j=L.beginFailedWork,S=Lt(e,E,C).completeWork;E=Rt(e,c);var T=E.commitResetTextContent,H=E.commitPlacement,U=E.commitDeletion,N=E.commitWork,I=E.commitLifeCycles,D=E.commitAttachRef,A=E.commitDetachRef,Y=e.now,q=e.scheduleDeferredCallback,F=e.cancelDeferredCallback,z=e.useSyncScheduling,W=e.prepareForCommit,X=e.resetAfterCommit,V=Y(),K=2,G=0,B=!1,Q=null,Z=null,J=0,$=null,ee=null,te=null,ne=null,oe=null,re=!1,le=!1,ae=!1,ie=null,ce=null,se=0,ue=-1,de=!1,fe=null,pe=0,be=!1,he=!1,me=null,ge=null,_e=!1,ve=!1,ye=1e3,we=0,ke=1;return{computeAsyncExpiration:d,computeExpirationForFiber:f,scheduleWork:p,batchedUpdates:function(e,t){var n=_e;_e=!0;try{return e(t)}finally{(_e=n)||de||y(1,null)}},unbatchedUpdates:function(e){if(_e&&!ve){ve=!0;try{return e()}finally{ve=!1}}return e()},flushSync:function(e){var t=_e;_e=!0;try{e:{var n=G;G=1;try{var r=e();break e}finally{G=n}r=void 0}return r}finally{_e=t,de&&o("187"),y(1,null)}},deferredUpdates:function(e){var t=G;G=d();try{return e()}finally{G=t}}}}function Ht(e){function t(e){return e=Oe(e),null===e?null:e.stateNode}var n=e.getPublicInstance;e=Tt(e);var r=e.computeAsyncExpiration,l=e.computeExpirationForFiber,a=e.scheduleWork;return{createContainer:function(e,t){var n=new lt(3,null,0);return e={current:n,containerInfo:e,pendingChildren:null,remainingExpirationTime:0,isReadyForCommit:!1,finishedWork:null,context:null,pendingContext:null,hydrate:t,nextScheduledRoot:null},n.stateNode=e},updateContainer:function(e,t,n,i){var c=t.current;if(n){n=n._reactInternalFiber;var s;e:{for(2===we(n)&&2===n.tag||o("170"),s=n;3!==s.tag;){if($e(s)){s=s.stateNode.__reactInternalMemoizedMergedChildContext;break e}(s=s.return)||o("171")}s=s.stateNode.context}n=$e(n)?nt(n,s):s}else n=Mn;null===t.context?t.context=n:t.pendingContext=n,t=i,t=void 0===t?null:t,i=null!=e&&null!=e.type&&null!=e.type.prototype&&!0===e.type.prototype.unstable_isAsyncReactComponent?r():l(c),vt(c,{expirationTime:i,partialState:{element:e},callback:t,isReplace:!1,isForced:!1,nextCallback:null,next:null}),a(c,i)},batchedUpdates:e.batchedUpdates,unbatchedUpdates:e.unbatchedUpdates,deferredUpdates:e.deferredUpdates,flushSync:e.flushSync,getPublicRootInstance:function(e){if(e=e.current,!e.child)return null;switch(e.child.tag){case 5:return n(e.child.stateNode);default:return e.child.stateNode}},findHostInstance:t,findHostInstanceWithNoPortals:function(e){return e=Pe(e),null===e?null:e.stateNode},injectIntoDevTools:function(e){var n=e.findFiberByHostInstance;return bt(xn({},e,{findHostInstanceByFiber:function(e){return t(e)},findFiberByHostInstance:function(e){return n?n(e):null}}))}}}function Ut(e,t,n){var o=3<arguments.length&&void 0!==arguments[3]?arguments[3]:null;return{$$typeof:kr,key:null==o?null:""+o,children:e,containerInfo:t,implementation:n}}function Nt(e){return!!Br.hasOwnProperty(e)||!Gr.hasOwnProperty(e)&&(Kr.test(e)?Br[e]=!0:(Gr[e]=!0,!1))}function It(e,t,n){var o=a(t);if(o&&l(t,n)){var r=o.mutationMethod;r?r(e,n):null==n||o.hasBooleanValue&&!n||o.hasNumericValue&&isNaN(n)||o.hasPositiveNumericValue&&1>n||o.hasOverloadedBooleanValue&&!1===n?At(e,t):o.mustUseProperty?e[o.propertyName]=n:(t=o.attributeName,(r=o.attributeNamespace)?e.setAttributeNS(r,t,""+n):o.hasBooleanValue||o.hasOverloadedBooleanValue&&!0===n?e.setAttribute(t,""):e.setAttribute(t,""+n))}else Dt(e,t,l(t,n)?n:null)}function Dt(e,t,n){Nt(t)&&(null==n?e.removeAttribute(t):e.setAttribute(t,""+n))}function At(e,t){var n=a(t);n?(t=n.mutationMethod)?t(e,void 0):n.mustUseProperty?e[n.propertyName]=!n.hasBooleanValue&&"":e.removeAttribute(n.attributeName):e.removeAttribute(t)}function Yt(e,t){var n=t.value,o=t.checked;return xn({type:void 0,step:void 0,min:void 0,max:void 0},t,{defaultChecked:void 0,defaultValue:void 0,value:null!=n?n:e._wrapperState.initialValue,checked:null!=o?o:e._wrapperState.initialChecked})}function qt(e,t){var n=t.defaultValue;e._wrapperState={initialChecked:null!=t.checked?t.checked:t.defaultChecked,initialValue:null!=t.value?t.value:n,controlled:"checkbox"===t.type||"radio"===t.type?null!=t.checked:null!=t.value}}function Ft(e,t){null!=(t=t.checked)&&It(e,"checked",t)}function zt(e,t){Ft(e,t);var n=t.value;null!=n?0===n&&""===e.value?e.value="0":"number"===t.type?(t=parseFloat(e.value)||0,(n!=t||n==t&&e.value!=n)&&(e.value=""+n)):e.value!==""+n&&(e.value=""+n):(null==t.value&&null!=t.defaultValue&&e.defaultValue!==""+t.defaultValue&&(e.defaultValue=""+t.defaultValue),null==t.checked&&null!=t.defaultChecked&&(e.defaultChecked=!!t.defaultChecked))}function Wt(e,t){switch(t.type){case"submit":case"reset":break;case"color":case"date":case"datetime":case"datetime-local":case"month":case"time":case"week":e.value="",e.value=e.defaultValue;break;default:e.value=e.value}t=e.name,""!==t&&(e.name=""),e.defaultChecked=!e.defaultChecked,e.defaultChecked=!e.defaultChecked,""!==t&&(e.name=t)}function Xt(e){var t="";return vn.Children.forEach(e,function(e){null==e||"string"!=typeof e&&"number"!=typeof e||(t+=e)}),t}function Vt(e,t){return e=xn({children:void 0},t),(t=Xt(t.children))&&(e.children=t),e}function Kt(e,t,n,o){if(e=e.options,t){t={};for(var r=0;r<n.length;r++)t["$"+n[r]]=!0;for(n=0;n<e.length;n++)r=t.hasOwnProperty("$"+e[n].value),e[n].selected!==r&&(e[n].selected=r),r&&o&&(e[n].defaultSelected=!0)}else{for(n=""+n,t=null,r=0;r<e.length;r++){if(e[r].value===n)return e[r].selected=!0,void(o&&(e[r].defaultSelected=!0));null!==t||e[r].disabled||(t=e[r])}null!==t&&(t.selected=!0)}}function Gt(e,t){var n=t.value;e._wrapperState={initialValue:null!=n?n:t.defaultValue,wasMultiple:!!t.multiple}}function Bt(e,t){return null!=t.dangerouslySetInnerHTML&&o("91"),xn({},t,{value:void 0,defaultValue:void 0,children:""+e._wrapperState.initialValue})}function Qt(e,t){var n=t.value;null==n&&(n=t.defaultValue,t=t.children,null!=t&&(null!=n&&o("92"),Array.isArray(t)&&(1>=t.length||o("93"),t=t[0]),n=""+t),null==n&&(n="")),e._wrapperState={initialValue:""+n}}function Zt(e,t){var n=t.value;null!=n&&(n=""+n,n!==e.value&&(e.value=n),null==t.defaultValue&&(e.defaultValue=n)),null!=t.defaultValue&&(e.defaultValue=t.defaultValue)}function Jt(e){var t=e.textContent;t===e._wrapperState.initialValue&&(e.value=t)}function $t(e){switch(e){case"svg":return"http://www.w3.org/2000/svg";case"math":return"http://www.w3.org/1998/Math/MathML";default:return"http://www.w3.org/1999/xhtml"}}function en(e,t){return null==e||"http://www.w3.org/1999/xhtml"===e?$t(t):"http://www.w3.org/2000/svg"===e&&"foreignObject"===t?"http://www.w3.org/1999/xhtml":e}function tn(e,t){if(t){var n=e.firstChild;if(n&&n===e.lastChild&&3===n.nodeType)return void(n.nodeValue=t)}e.textContent=t}function nn(e,t){e=e.style;for(var n in t)if(t.hasOwnProperty(n)){var o=0===n.indexOf("--"),r=n,l=t[n];r=null==l||"boolean"==typeof l||""===l?"":o||"number"!=typeof l||0===l||$r.hasOwnProperty(r)&&$r[r]?(""+l).trim():l+"px","float"===n&&(n="cssFloat"),o?e.setProperty(n,r):e[n]=r}}function on(e,t,n){t&&(tl[e]&&(null!=t.children||null!=t.dangerouslySetInnerHTML)&&o("137",e,n()),null!=t.dangerouslySetInnerHTML&&(null!=t.children&&o("60"),"object"==typeof t.dangerouslySetInnerHTML&&"__html"in t.dangerouslySetInnerHTML||o("61")),null!=t.style&&"object"!=typeof t.style&&o("62",n()))}function rn(e,t){if(-1===e.indexOf("-"))return"string"==typeof t.is;switch(e){case"annotation-xml":case"color-profile":case"font-face":case"font-face-src":case"font-face-uri":case"font-face-format":case"font-face-name":case"missing-glyph":return!1;default:return!0}}function ln(e,t){e=9===e.nodeType||11===e.nodeType?e:e.ownerDocument;var n=Ue(e);t=Qn[t];for(var o=0;o<t.length;o++){var r=t[o];n.hasOwnProperty(r)&&n[r]||("topScroll"===r?je("topScroll","scroll",e):"topFocus"===r||"topBlur"===r?(je("topFocus","focus",e),je("topBlur","blur",e),n.topBlur=!0,n.topFocus=!0):"topCancel"===r?(ne("cancel",!0)&&je("topCancel","cancel",e),n.topCancel=!0):"topClose"===r?(ne("close",!0)&&je("topClose","close",e),n.topClose=!0):Qo.hasOwnProperty(r)&&Re(r,Qo[r],e),n[r]=!0)}}function an(e,t,n,o){return n=9===n.nodeType?n:n.ownerDocument,o===nl&&(o=$t(e)),o===nl?"script"===e?(e=n.createElement("div"),e.innerHTML="<script><\/script>",e=e.removeChild(e.firstChild)):e="string"==typeof t.is?n.createElement(e,{is:t.is}):n.createElement(e):e=n.createElementNS(o,e),e}function cn(e,t){return(9===t.nodeType?t:t.ownerDocument).createTextNode(e)}function sn(e,t,n,o){var r=rn(t,n);switch(t){case"iframe":case"object":Re("topLoad","load",e);var l=n;break;case"video":case"audio":for(l in rl)rl.hasOwnProperty(l)&&Re(l,rl[l],e);l=n;break;case"source":Re("topError","error",e),l=n;break;case"img":case"image":Re("topError","error",e),Re("topLoad","load",e),l=n;break;case"form":Re("topReset","reset",e),Re("topSubmit","submit",e),l=n;break;case"details":Re("topToggle","toggle",e),l=n;break;case"input":qt(e,n),l=Yt(e,n),Re("topInvalid","invalid",e),ln(o,"onChange");break;case"option":l=Vt(e,n);break;case"select":Gt(e,n),l=xn({},n,{value:void 0}),Re("topInvalid","invalid",e),ln(o,"onChange");break;case"textarea":Qt(e,n),l=Bt(e,n),Re("topInvalid","invalid",e),ln(o,"onChange");break;default:l=n}on(t,l,ol);var a,i=l;for(a in i)if(i.hasOwnProperty(a)){var c=i[a];"style"===a?nn(e,c,ol):"dangerouslySetInnerHTML"===a?null!=(c=c?c.__html:void 0)&&Jr(e,c):"children"===a?"string"==typeof c?("textarea"!==t||""!==c)&&tn(e,c):"number"==typeof c&&tn(e,""+c):"suppressContentEditableWarning"!==a&&"suppressHydrationWarning"!==a&&"autoFocus"!==a&&(Bn.hasOwnProperty(a)?null!=c&&ln(o,a):r?Dt(e,a,c):null!=c&&It(e,a,c))}switch(t){case"input":le(e),Wt(e,n);break;case"textarea":le(e),Jt(e,n);break;case"option":null!=n.value&&e.setAttribute("value",n.value);break;case"select":e.multiple=!!n.multiple,t=n.value,null!=t?Kt(e,!!n.multiple,t,!1):null!=n.defaultValue&&Kt(e,!!n.multiple,n.defaultValue,!0);break;default:"function"==typeof l.onClick&&(e.onclick=wn)}}function un(e,t,n,o,r){var l=null;switch(t){case"input":n=Yt(e,n),o=Yt(e,o),l=[];break;case"option":n=Vt(e,n),o=Vt(e,o),l=[];break;case"select":n=xn({},n,{value:void 0}),o=xn({},o,{value:void 0}),l=[];break;case"textarea":n=Bt(e,n),o=Bt(e,o),l=[];break;default:"function"!=typeof n.onClick&&"function"==typeof o.onClick&&(e.onclick=wn)}on(t,o,ol);var a,i;e=null;for(a in n)if(!o.hasOwnProperty(a)&&n.hasOwnProperty(a)&&null!=n[a])if("style"===a)for(i in t=n[a])t.hasOwnProperty(i)&&(e||(e={}),e[i]="");else"dangerouslySetInnerHTML"!==a&&"children"!==a&&"suppressContentEditableWarning"!==a&&"suppressHydrationWarning"!==a&&"autoFocus"!==a&&(Bn.hasOwnProperty(a)?l||(l=[]):(l=l||[]).push(a,null));for(a in o){var c=o[a];if(t=null!=n?n[a]:void 0,o.hasOwnProperty(a)&&c!==t&&(null!=c||null!=t))if("style"===a)if(t){for(i in t)!t.hasOwnProperty(i)||c&&c.hasOwnProperty(i)||(e||(e={}),e[i]="");for(i in c)c.hasOwnProperty(i)&&t[i]!==c[i]&&(e||(e={}),e[i]=c[i])}else e||(l||(l=[]),l.push(a,e)),e=c;else"dangerouslySetInnerHTML"===a?(c=c?c.__html:void 0,t=t?t.__html:void 0,null!=c&&t!==c&&(l=l||[]).push(a,""+c)):"children"===a?t===c||"string"!=typeof c&&"number"!=typeof c||(l=l||[]).push(a,""+c):"suppressContentEditableWarning"!==a&&"suppressHydrationWarning"!==a&&(Bn.hasOwnProperty(a)?(null!=c&&ln(r,a),l||t===c||(l=[])):(l=l||[]).push(a,c))}return e&&(l=l||[]).push("style",e),l}function dn(e,t,n,o,r){"input"===n&&"radio"===r.type&&null!=r.name&&Ft(e,r),rn(n,o),o=rn(n,r);for(var l=0;l<t.length;l+=2){var a=t[l],i=t[l+1];"style"===a?nn(e,i,ol):"dangerouslySetInnerHTML"===a?Jr(e,i):"children"===a?tn(e,i):o?null!=i?Dt(e,a,i):e.removeAttribute(a):null!=i?It(e,a,i):At(e,a)}switch(n){case"input":zt(e,r);break;case"textarea":Zt(e,r);break;case"select":e._wrapperState.initialValue=void 0,t=e._wrapperState.wasMultiple,e._wrapperState.wasMultiple=!!r.multiple,n=r.value,null!=n?Kt(e,!!r.multiple,n,!1):t!==!!r.multiple&&(null!=r.defaultValue?Kt(e,!!r.multiple,r.defaultValue,!0):Kt(e,!!r.multiple,r.multiple?[]:"",!1))}}function fn(e,t,n,o,r){switch(t){case"iframe":case"object":Re("topLoad","load",e);break;case"video":case"audio":for(var l in rl)rl.hasOwnProperty(l)&&Re(l,rl[l],e);break;case"source":Re("topError","error",e);break;case"img":case"image":Re("topError","error",e),Re("topLoad","load",e);break;case"form":Re("topReset","reset",e),Re("topSubmit","submit",e);break;case"details":Re("topToggle","toggle",e);break;case"input":qt(e,n),Re("topInvalid","invalid",e),ln(r,"onChange");break;case"select":Gt(e,n),Re("topInvalid","invalid",e),ln(r,"onChange");break;case"textarea":Qt(e,n),Re("topInvalid","invalid",e),ln(r,"onChange")}on(t,n,ol),o=null;for(var a in n)n.hasOwnProperty(a)&&(l=n[a],"children"===a?"string"==typeof l?e.textContent!==l&&(o=["children",l]):"number"==typeof l&&e.textContent!==""+l&&(o=["children",""+l]):Bn.hasOwnProperty(a)&&null!=l&&ln(r,a));switch(t){case"input":le(e),Wt(e,n);break;case"textarea":le(e),Jt(e,n);break;case"select":case"option":break;default:"function"==typeof n.onClick&&(e.onclick=wn)}return o}function pn(e,t){return e.nodeValue!==t}function bn(e){return!(!e||1!==e.nodeType&&9!==e.nodeType&&11!==e.nodeType&&(8!==e.nodeType||" react-mount-point-unstable "!==e.nodeValue))}function hn(e){return!(!(e=e?9===e.nodeType?e.documentElement:e.firstChild:null)||1!==e.nodeType||!e.hasAttribute("data-reactroot"))}function mn(e,t,n,r,l){bn(n)||o("200");var a=n._reactRootContainer;if(a)cl.updateContainer(t,a,e,l);else{if(!(r=r||hn(n)))for(a=void 0;a=n.lastChild;)n.removeChild(a);var i=cl.createContainer(n,r);a=n._reactRootContainer=i,cl.unbatchedUpdates(function(){cl.updateContainer(t,i,e,l)})}return cl.getPublicRootInstance(a)}function gn(e,t){var n=2<arguments.length&&void 0!==arguments[2]?arguments[2]:null;return bn(t)||o("200"),Ut(e,t,null,n)}function _n(e,t){this._reactRootContainer=cl.createContainer(e,t)}var vn=n(1),yn=n(48),xn=n(13),wn=n(14),kn=n(49),En=n(50),Cn=n(51),On=n(52),Pn=n(55),Mn=n(18);vn||o("227");var Ln={children:!0,dangerouslySetInnerHTML:!0,defaultValue:!0,defaultChecked:!0,innerHTML:!0,suppressContentEditableWarning:!0,suppressHydrationWarning:!0,style:!0},Rn={MUST_USE_PROPERTY:1,HAS_BOOLEAN_VALUE:4,HAS_NUMERIC_VALUE:8,HAS_POSITIVE_NUMERIC_VALUE:24,HAS_OVERLOADED_BOOLEAN_VALUE:32,HAS_STRING_BOOLEAN_VALUE:64,injectDOMPropertyConfig:function(e){var t=Rn,n=e.Properties||{},l=e.DOMAttributeNamespaces||{},a=e.DOMAttributeNames||{};e=e.DOMMutationMethods||{};for(var i in n){jn.hasOwnProperty(i)&&o("48",i);var c=i.toLowerCase(),s=n[i];c={attributeName:c,attributeNamespace:null,propertyName:i,mutationMethod:null,mustUseProperty:r(s,t.MUST_USE_PROPERTY),hasBooleanValue:r(s,t.HAS_BOOLEAN_VALUE),hasNumericValue:r(s,t.HAS_NUMERIC_VALUE),hasPositiveNumericValue:r(s,t.HAS_POSITIVE_NUMERIC_VALUE),hasOverloadedBooleanValue:r(s,t.HAS_OVERLOADED_BOOLEAN_VALUE),hasStringBooleanValue:r(s,t.HAS_STRING_BOOLEAN_VALUE)},1>=c.hasBooleanValue+c.hasNumericValue+c.hasOverloadedBooleanValue||o("50",i),a.hasOwnProperty(i)&&(c.attributeName=a[i]),l.hasOwnProperty(i)&&(c.attributeNamespace=l[i]),e.hasOwnProperty(i)&&(c.mutationMethod=e[i]),jn[i]=c}}},jn={},Sn=Rn,Tn=Sn.MUST_USE_PROPERTY,Hn=Sn.HAS_BOOLEAN_VALUE,Un=Sn.HAS_NUMERIC_VALUE,Nn=Sn.HAS_POSITIVE_NUMERIC_VALUE,In=Sn.HAS_OVERLOADED_BOOLEAN_VALUE,Dn=Sn.HAS_STRING_BOOLEAN_VALUE,An={Properties:{allowFullScreen:Hn,async:Hn,autoFocus:Hn,autoPlay:Hn,capture:In,checked:Tn|Hn,cols:Nn,contentEditable:Dn,controls:Hn,default:Hn,defer:Hn,disabled:Hn,download:In,draggable:Dn,formNoValidate:Hn,hidden:Hn,loop:Hn,multiple:Tn|Hn,muted:Tn|Hn,noValidate:Hn,open:Hn,playsInline:Hn,readOnly:Hn,required:Hn,reversed:Hn,rows:Nn,rowSpan:Un,scoped:Hn,seamless:Hn,selected:Tn|Hn,size:Nn,start:Un,span:Nn,spellCheck:Dn,style:0,tabIndex:0,itemScope:Hn,acceptCharset:0,className:0,htmlFor:0,httpEquiv:0,value:Dn},DOMAttributeNames:{acceptCharset:"accept-charset",className:"class",htmlFor:"for",httpEquiv:"http-equiv"},DOMMutationMethods:{value:function(e,t){if(null==t)return e.removeAttribute("value");"number"!==e.type||!1===e.hasAttribute("value")?e.setAttribute("value",""+t):e.validity&&!e.validity.badInput&&e.ownerDocument.activeElement!==e&&e.setAttribute("value",""+t)}}},Yn=Sn.HAS_STRING_BOOLEAN_VALUE,qn={xlink:"http://www.w3.org/1999/xlink",xml:"http://www.w3.org/XML/1998/namespace"},Fn={Properties:{autoReverse:Yn,externalResourcesRequired:Yn,preserveAlpha:Yn},DOMAttributeNames:{autoReverse:"autoReverse",externalResourcesRequired:"externalResourcesRequired",preserveAlpha:"preserveAlpha"},DOMAttributeNamespaces:{xlinkActuate:qn.xlink,xlinkArcrole:qn.xlink,xlinkHref:qn.xlink,xlinkRole:qn.xlink,xlinkShow:qn.xlink,xlinkTitle:qn.xlink,xlinkType:qn.xlink,xmlBase:qn.xml,xmlLang:qn.xml,xmlSpace:qn.xml}},zn=/[\-\:]([a-z])/g;"accent-height alignment-baseline arabic-form baseline-shift cap-height clip-path clip-rule color-interpolation color-interpolation-filters color-profile color-rendering dominant-baseline enable-background fill-opacity fill-rule flood-color flood-opacity font-family font-size font-size-adjust font-stretch font-style font-variant font-weight glyph-name glyph-orientation-horizontal glyph-orientation-vertical horiz-adv-x horiz-origin-x image-rendering letter-spacing lighting-color marker-end marker-mid marker-start overline-position overline-thickness paint-order panose-1 pointer-events rendering-intent shape-rendering stop-color stop-opacity strikethrough-position strikethrough-thickness stroke-dasharray stroke-dashoffset stroke-linecap stroke-linejoin stroke-miterlimit stroke-opacity stroke-width text-anchor text-decoration text-rendering underline-position underline-thickness unicode-bidi unicode-range units-per-em v-alphabetic v-hanging v-ideographic v-mathematical vector-effect vert-adv-y vert-origin-x vert-origin-y word-spacing writing-mode x-height xlink:actuate xlink:arcrole xlink:href xlink:role xlink:show xlink:title xlink:type xml:base xmlns:xlink xml:lang xml:space".split(" ").forEach(function(e){var t=e.replace(zn,i);Fn.Properties[t]=0,Fn.DOMAttributeNames[t]=e}),Sn.injectDOMPropertyConfig(An),Sn.injectDOMPropertyConfig(Fn);var Wn={_caughtError:null,_hasCaughtError:!1,_rethrowError:null,_hasRethrowError:!1,injection:{injectErrorUtils:function(e){"function"!=typeof e.invokeGuardedCallback&&o("197"),c=e.invokeGuardedCallback}},invokeGuardedCallback:function(e,t,n,o,r,l,a,i,s){c.apply(Wn,arguments)},invokeGuardedCallbackAndCatchFirstError:function(e,t,n,o,r,l,a,i,c){if(Wn.invokeGuardedCallback.apply(this,arguments),Wn.hasCaughtError()){var s=Wn.clearCaughtError();Wn._hasRethrowError||(Wn._hasRethrowError=!0,Wn._rethrowError=s)}},rethrowCaughtError:function(){return s.apply(Wn,arguments)},hasCaughtError:function(){return Wn._hasCaughtError},clearCaughtError:function(){if(Wn._hasCaughtError){var e=Wn._caughtError;return Wn._caughtError=null,Wn._hasCaughtError=!1,e}o("198")}},Xn=null,Vn={},Kn=[],Gn={},Bn={},Qn={},Zn=Object.freeze({plugins:Kn,eventNameDispatchConfigs:Gn,registrationNameModules:Bn,registrationNameDependencies:Qn,possibleRegistrationNames:null,injectEventPluginOrder:f,injectEventPluginsByName:p}),Jn=null,$n=null,eo=null,to=null,no={injectEventPluginOrder:f,injectEventPluginsByName:p},oo=Object.freeze({injection:no,getListener:y,extractEvents:x,enqueueEvents:w,processEventQueue:k}),ro=Math.random().toString(36).slice(2),lo="__reactInternalInstance$"+ro,ao="__reactEventHandlers$"+ro,io=Object.freeze({precacheFiberNode:function(e,t){t[lo]=e},getClosestInstanceFromNode:E,getInstanceFromNode:function(e){return e=e[lo],!e||5!==e.tag&&6!==e.tag?null:e},getNodeFromInstance:C,getFiberCurrentPropsFromNode:O,updateFiberProps:function(e,t){e[ao]=t}}),co=Object.freeze({accumulateTwoPhaseDispatches:H,accumulateTwoPhaseDispatchesSkipTarget:function(e){m(e,j)},accumulateEnterLeaveDispatches:U,accumulateDirectDispatches:function(e){m(e,T)}}),so=null,uo={_root:null,_startText:null,_fallbackText:null},fo="dispatchConfig _targetInst nativeEvent isDefaultPrevented isPropagationStopped _dispatchListeners _dispatchInstances".split(" "),po={type:null,target:null,currentTarget:wn.thatReturnsNull,eventPhase:null,bubbles:null,cancelable:null,timeStamp:function(e){return e.timeStamp||Date.now()},defaultPrevented:null,isTrusted:null};xn(A.prototype,{preventDefault:function(){this.defaultPrevented=!0;var e=this.nativeEvent;e&&(e.preventDefault?e.preventDefault():"unknown"!=typeof e.returnValue&&(e.returnValue=!1),this.isDefaultPrevented=wn.thatReturnsTrue)},stopPropagation:function(){var e=this.nativeEvent;e&&(e.stopPropagation?e.stopPropagation():"unknown"!=typeof e.cancelBubble&&(e.cancelBubble=!0),this.isPropagationStopped=wn.thatReturnsTrue)},persist:function(){this.isPersistent=wn.thatReturnsTrue},isPersistent:wn.thatReturnsFalse,destructor:function(){var e,t=this.constructor.Interface;for(e in t)this[e]=null;for(t=0;t<fo.length;t++)this[fo[t]]=null}}),A.Interface=po,A.augmentClass=function(e,t){function n(){}n.prototype=this.prototype;var o=new n;xn(o,e.prototype),e.prototype=o,e.prototype.constructor=e,e.Interface=xn({},this.Interface,t),e.augmentClass=this.augmentClass,F(e)},F(A),A.augmentClass(z,{data:null}),A.augmentClass(W,{data:null});var bo=[9,13,27,32],ho=yn.canUseDOM&&"CompositionEvent"in window,mo=null;yn.canUseDOM&&"documentMode"in document&&(mo=document.documentMode);var go;if(go=yn.canUseDOM&&"TextEvent"in window&&!mo){var _o=window.opera;go=!("object"==typeof _o&&"function"==typeof _o.version&&12>=parseInt(_o.version(),10))}var vo,yo=go,xo=yn.canUseDOM&&(!ho||mo&&8<mo&&11>=mo),wo=String.fromCharCode(32),ko={beforeInput:{phasedRegistrationNames:{bubbled:"onBeforeInput",captured:"onBeforeInputCapture"},dependencies:["topCompositionEnd","topKeyPress","topTextInput","topPaste"]},compositionEnd:{phasedRegistrationNames:{bubbled:"onCompositionEnd",captured:"onCompositionEndCapture"},dependencies:"topBlur topCompositionEnd topKeyDown topKeyPress topKeyUp topMouseDown".split(" ")},compositionStart:{phasedRegistrationNames:{bubbled:"onCompositionStart",captured:"onCompositionStartCapture"},dependencies:"topBlur topCompositionStart topKeyDown topKeyPress topKeyUp topMouseDown".split(" ")},compositionUpdate:{phasedRegistrationNames:{bubbled:"onCompositionUpdate",captured:"onCompositionUpdateCapture"},dependencies:"topBlur topCompositionUpdate topKeyDown topKeyPress topKeyUp topMouseDown".split(" ")}},Eo=!1,Co=!1,Oo={eventTypes:ko,extractEvents:function(e,t,n,o){var r;if(ho)e:{switch(e){case"topCompositionStart":var l=ko.compositionStart;break e;case"topCompositionEnd":l=ko.compositionEnd;break e;case"topCompositionUpdate":l=ko.compositionUpdate;break e}l=void 0}else Co?X(e,n)&&(l=ko.compositionEnd):"topKeyDown"===e&&229===n.keyCode&&(l=ko.compositionStart);return l?(xo&&(Co||l!==ko.compositionStart?l===ko.compositionEnd&&Co&&(r=I()):(uo._root=o,uo._startText=D(),Co=!0)),l=z.getPooled(l,t,n,o),r?l.data=r:null!==(r=V(n))&&(l.data=r),H(l),r=l):r=null,(e=yo?K(e,n):G(e,n))?(t=W.getPooled(ko.beforeInput,t,n,o),t.data=e,H(t)):t=null,[r,t]}},Po=null,Mo=null,Lo=null,Ro={injectFiberControlledHostComponent:function(e){Po=e}},jo=Object.freeze({injection:Ro,enqueueStateRestore:Q,restoreStateIfNeeded:Z}),So=!1,To={color:!0,date:!0,datetime:!0,"datetime-local":!0,email:!0,month:!0,number:!0,password:!0,range:!0,search:!0,tel:!0,text:!0,time:!0,url:!0,week:!0};yn.canUseDOM&&(vo=document.implementation&&document.implementation.hasFeature&&!0!==document.implementation.hasFeature("",""));var Ho={change:{phasedRegistrationNames:{bubbled:"onChange",captured:"onChangeCapture"},dependencies:"topBlur topChange topClick topFocus topInput topKeyDown topKeyUp topSelectionChange".split(" ")}},Uo=null,No=null,Io=!1;yn.canUseDOM&&(Io=ne("input")&&(!document.documentMode||9<document.documentMode));var Do={eventTypes:Ho,_isInputEventSupported:Io,extractEvents:function(e,t,n,o){var r=t?C(t):window,l=r.nodeName&&r.nodeName.toLowerCase();if("select"===l||"input"===l&&"file"===r.type)var a=ue;else if(ee(r))if(Io)a=me;else{a=be;var i=pe}else!(l=r.nodeName)||"input"!==l.toLowerCase()||"checkbox"!==r.type&&"radio"!==r.type||(a=he);if(a&&(a=a(e,t)))return ie(a,n,o);i&&i(e,r,t),"topBlur"===e&&null!=t&&(e=t._wrapperState||r._wrapperState)&&e.controlled&&"number"===r.type&&(e=""+r.value,r.getAttribute("value")!==e&&r.setAttribute("value",e))}};A.augmentClass(ge,{view:null,detail:null});var Ao={Alt:"altKey",Control:"ctrlKey",Meta:"metaKey",Shift:"shiftKey"};ge.augmentClass(ye,{screenX:null,screenY:null,clientX:null,clientY:null,pageX:null,pageY:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,getModifierState:ve,button:null,buttons:null,relatedTarget:function(e){return e.relatedTarget||(e.fromElement===e.srcElement?e.toElement:e.fromElement)}});var Yo={mouseEnter:{registrationName:"onMouseEnter",dependencies:["topMouseOut","topMouseOver"]},mouseLeave:{registrationName:"onMouseLeave",dependencies:["topMouseOut","topMouseOver"]}},qo={eventTypes:Yo,extractEvents:function(e,t,n,o){if("topMouseOver"===e&&(n.relatedTarget||n.fromElement)||"topMouseOut"!==e&&"topMouseOver"!==e)return null;var r=o.window===o?o:(r=o.ownerDocument)?r.defaultView||r.parentWindow:window;if("topMouseOut"===e?(e=t,t=(t=n.relatedTarget||n.toElement)?E(t):null):e=null,e===t)return null;var l=null==e?r:C(e);r=null==t?r:C(t);var a=ye.getPooled(Yo.mouseLeave,e,n,o);return a.type="mouseleave",a.target=l,a.relatedTarget=r,n=ye.getPooled(Yo.mouseEnter,t,n,o),n.type="mouseenter",n.target=r,n.relatedTarget=l,U(a,n,e,t),[a,n]}},Fo=vn.__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED.ReactCurrentOwner,zo=[],Wo=!0,Xo=void 0,Vo=Object.freeze({get _enabled(){return Wo},get _handleTopLevel(){return Xo},setHandleTopLevel:function(e){Xo=e},setEnabled:Le,isEnabled:function(){return Wo},trapBubbledEvent:Re,trapCapturedEvent:je,dispatchEvent:Se}),Ko={animationend:Te("Animation","AnimationEnd"),animationiteration:Te("Animation","AnimationIteration"),animationstart:Te("Animation","AnimationStart"),transitionend:Te("Transition","TransitionEnd")},Go={},Bo={};yn.canUseDOM&&(Bo=document.createElement("div").style,"AnimationEvent"in window||(delete Ko.animationend.animation,delete Ko.animationiteration.animation,delete Ko.animationstart.animation),"TransitionEvent"in window||delete Ko.transitionend.transition);var Qo={topAbort:"abort",topAnimationEnd:He("animationend")||"animationend",topAnimationIteration:He("animationiteration")||"animationiteration",topAnimationStart:He("animationstart")||"animationstart",topBlur:"blur",topCancel:"cancel",topCanPlay:"canplay",topCanPlayThrough:"canplaythrough",topChange:"change",topClick:"click",topClose:"close",topCompositionEnd:"compositionend",topCompositionStart:"compositionstart",topCompositionUpdate:"compositionupdate",topContextMenu:"contextmenu",topCopy:"copy",topCut:"cut",topDoubleClick:"dblclick",topDrag:"drag",topDragEnd:"dragend",topDragEnter:"dragenter",topDragExit:"dragexit",topDragLeave:"dragleave",topDragOver:"dragover",topDragStart:"dragstart",topDrop:"drop",topDurationChange:"durationchange",topEmptied:"emptied",topEncrypted:"encrypted",topEnded:"ended",topError:"error",topFocus:"focus",topInput:"input",topKeyDown:"keydown",topKeyPress:"keypress",topKeyUp:"keyup",topLoadedData:"loadeddata",topLoad:"load",topLoadedMetadata:"loadedmetadata",topLoadStart:"loadstart",topMouseDown:"mousedown",topMouseMove:"mousemove",topMouseOut:"mouseout",topMouseOver:"mouseover",topMouseUp:"mouseup",topPaste:"paste",topPause:"pause",topPlay:"play",topPlaying:"playing",topProgress:"progress",topRateChange:"ratechange",topScroll:"scroll",topSeeked:"seeked",topSeeking:"seeking",topSelectionChange:"selectionchange",topStalled:"stalled",topSuspend:"suspend",topTextInput:"textInput",topTimeUpdate:"timeupdate",topToggle:"toggle",topTouchCancel:"touchcancel",topTouchEnd:"touchend",topTouchMove:"touchmove",topTouchStart:"touchstart",topTransitionEnd:He("transitionend")||"transitionend",topVolumeChange:"volumechange",topWaiting:"waiting",topWheel:"wheel"},Zo={},Jo=0,$o="_reactListenersID"+(""+Math.random()).slice(2),er=yn.canUseDOM&&"documentMode"in document&&11>=document.documentMode,tr={select:{phasedRegistrationNames:{bubbled:"onSelect",captured:"onSelectCapture"},dependencies:"topBlur topContextMenu topFocus topKeyDown topKeyUp topMouseDown topMouseUp topSelectionChange".split(" ")}},nr=null,or=null,rr=null,lr=!1,ar={eventTypes:tr,extractEvents:function(e,t,n,o){var r,l=o.window===o?o.document:9===o.nodeType?o:o.ownerDocument;if(!(r=!l)){e:{l=Ue(l),r=Qn.onSelect;for(var a=0;a<r.length;a++){var i=r[a];if(!l.hasOwnProperty(i)||!l[i]){l=!1;break e}}l=!0}r=!l}if(r)return null;switch(l=t?C(t):window,e){case"topFocus":(ee(l)||"true"===l.contentEditable)&&(nr=l,or=t,rr=null);break;case"topBlur":rr=or=nr=null;break;case"topMouseDown":lr=!0;break;case"topContextMenu":case"topMouseUp":return lr=!1,Ae(n,o);case"topSelectionChange":if(er)break;case"topKeyDown":case"topKeyUp":return Ae(n,o)}return null}};A.augmentClass(Ye,{animationName:null,elapsedTime:null,pseudoElement:null}),A.augmentClass(qe,{clipboardData:function(e){return"clipboardData"in e?e.clipboardData:window.clipboardData}}),ge.augmentClass(Fe,{relatedTarget:null});var ir={Esc:"Escape",Spacebar:" ",Left:"ArrowLeft",Up:"ArrowUp",Right:"ArrowRight",Down:"ArrowDown",Del:"Delete",Win:"OS",Menu:"ContextMenu",Apps:"ContextMenu",Scroll:"ScrollLock",MozPrintableKey:"Unidentified"},cr={8:"Backspace",9:"Tab",12:"Clear",13:"Enter",16:"Shift",17:"Control",18:"Alt",19:"Pause",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",45:"Insert",46:"Delete",112:"F1",113:"F2",114:"F3",115:"F4",116:"F5",117:"F6",118:"F7",119:"F8",120:"F9",121:"F10",122:"F11",123:"F12",144:"NumLock",145:"ScrollLock",224:"Meta"};ge.augmentClass(We,{key:function(e){if(e.key){var t=ir[e.key]||e.key;if("Unidentified"!==t)return t}return"keypress"===e.type?(e=ze(e),13===e?"Enter":String.fromCharCode(e)):"keydown"===e.type||"keyup"===e.type?cr[e.keyCode]||"Unidentified":""},location:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,repeat:null,locale:null,getModifierState:ve,charCode:function(e){return"keypress"===e.type?ze(e):0},keyCode:function(e){return"keydown"===e.type||"keyup"===e.type?e.keyCode:0},which:function(e){return"keypress"===e.type?ze(e):"keydown"===e.type||"keyup"===e.type?e.keyCode:0}}),ye.augmentClass(Xe,{dataTransfer:null}),ge.augmentClass(Ve,{touches:null,targetTouches:null,changedTouches:null,altKey:null,metaKey:null,ctrlKey:null,shiftKey:null,getModifierState:ve}),A.augmentClass(Ke,{propertyName:null,elapsedTime:null,pseudoElement:null}),ye.augmentClass(Ge,{deltaX:function(e){return"deltaX"in e?e.deltaX:"wheelDeltaX"in e?-e.wheelDeltaX:0},deltaY:function(e){return"deltaY"in e?e.deltaY:"wheelDeltaY"in e?-e.wheelDeltaY:"wheelDelta"in e?-e.wheelDelta:0},deltaZ:null,deltaMode:null});var sr={},ur={};"abort animationEnd animationIteration animationStart blur cancel canPlay canPlayThrough click close contextMenu copy cut doubleClick drag dragEnd dragEnter dragExit dragLeave dragOver dragStart drop durationChange emptied encrypted ended error focus input invalid keyDown keyPress keyUp load loadedData loadedMetadata loadStart mouseDown mouseMove mouseOut mouseOver mouseUp paste pause play playing progress rateChange reset scroll seeked seeking stalled submit suspend timeUpdate toggle touchCancel touchEnd touchMove touchStart transitionEnd volumeChange waiting wheel".split(" ").forEach(function(e){var t=e[0].toUpperCase()+e.slice(1),n="on"+t;t="top"+t,n={phasedRegistrationNames:{bubbled:n,captured:n+"Capture"},dependencies:[t]},sr[e]=n,ur[t]=n});var dr={eventTypes:sr,extractEvents:function(e,t,n,o){var r=ur[e];if(!r)return null;switch(e){case"topKeyPress":if(0===ze(n))return null;case"topKeyDown":case"topKeyUp":e=We;break;case"topBlur":case"topFocus":e=Fe;break;case"topClick":if(2===n.button)return null;case"topDoubleClick":case"topMouseDown":case"topMouseMove":case"topMouseUp":case"topMouseOut":case"topMouseOver":case"topContextMenu":e=ye;break;case"topDrag":case"topDragEnd":case"topDragEnter":case"topDragExit":case"topDragLeave":case"topDragOver":case"topDragStart":case"topDrop":e=Xe;break;case"topTouchCancel":case"topTouchEnd":case"topTouchMove":case"topTouchStart":e=Ve;break;case"topAnimationEnd":case"topAnimationIteration":case"topAnimationStart":e=Ye;break;case"topTransitionEnd":e=Ke;break;case"topScroll":e=ge;break;case"topWheel":e=Ge;break;case"topCopy":case"topCut":case"topPaste":e=qe;break;default:e=A}return t=e.getPooled(r,t,n,o),H(t),t}};Xo=function(e,t,n,o){e=x(e,t,n,o),w(e),k(!1)},no.injectEventPluginOrder("ResponderEventPlugin SimpleEventPlugin TapEventPlugin EnterLeaveEventPlugin ChangeEventPlugin SelectEventPlugin BeforeInputEventPlugin".split(" ")),Jn=io.getFiberCurrentPropsFromNode,$n=io.getInstanceFromNode,eo=io.getNodeFromInstance,no.injectEventPluginsByName({SimpleEventPlugin:dr,EnterLeaveEventPlugin:qo,ChangeEventPlugin:Do,SelectEventPlugin:ar,BeforeInputEventPlugin:Oo});var fr=[],pr=-1;new Set;var br={current:Mn},hr={current:!1},mr=Mn,gr=null,_r=null,vr="function"==typeof Symbol&&Symbol.for,yr=vr?Symbol.for("react.element"):60103,xr=vr?Symbol.for("react.call"):60104,wr=vr?Symbol.for("react.return"):60105,kr=vr?Symbol.for("react.portal"):60106,Er=vr?Symbol.for("react.fragment"):60107,Cr="function"==typeof Symbol&&Symbol.iterator,Or=Array.isArray,Pr=Pt(!0),Mr=Pt(!1),Lr={},Rr=Object.freeze({default:Ht}),jr=Rr&&Ht||Rr,Sr=jr.default?jr.default:jr,Tr="object"==typeof performance&&"function"==typeof performance.now,Hr=void 0;Hr=Tr?function(){return performance.now()}:function(){return Date.now()};var Ur=void 0,Nr=void 0;if(yn.canUseDOM)if("function"!=typeof requestIdleCallback||"function"!=typeof cancelIdleCallback){var Ir,Dr=null,Ar=!1,Yr=-1,qr=!1,Fr=0,zr=33,Wr=33;Ir=Tr?{didTimeout:!1,timeRemaining:function(){var e=Fr-performance.now();return 0<e?e:0}}:{didTimeout:!1,timeRemaining:function(){var e=Fr-Date.now();return 0<e?e:0}};var Xr="__reactIdleCallback$"+Math.random().toString(36).slice(2);window.addEventListener("message",function(e){if(e.source===window&&e.data===Xr){if(Ar=!1,e=Hr(),0>=Fr-e){if(!(-1!==Yr&&Yr<=e))return void(qr||(qr=!0,requestAnimationFrame(Vr)));Ir.didTimeout=!0}else Ir.didTimeout=!1;Yr=-1,e=Dr,Dr=null,null!==e&&e(Ir)}},!1);var Vr=function(e){qr=!1;var t=e-Fr+Wr;t<Wr&&zr<Wr?(8>t&&(t=8),Wr=t<zr?zr:t):zr=t,Fr=e+Wr,Ar||(Ar=!0,window.postMessage(Xr,"*"))};Ur=function(e,t){return Dr=e,null!=t&&"number"==typeof t.timeout&&(Yr=Hr()+t.timeout),qr||(qr=!0,requestAnimationFrame(Vr)),0},Nr=function(){Dr=null,Ar=!1,Yr=-1}}else Ur=window.requestIdleCallback,Nr=window.cancelIdleCallback;else Ur=function(e){return setTimeout(function(){e({timeRemaining:function(){return 1/0}})})},Nr=function(e){clearTimeout(e)};var Kr=/^[:A-Z_a-z\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u02FF\u0370-\u037D\u037F-\u1FFF\u200C-\u200D\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD][:A-Z_a-z\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u02FF\u0370-\u037D\u037F-\u1FFF\u200C-\u200D\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD\-.0-9\u00B7\u0300-\u036F\u203F-\u2040]*$/,Gr={},Br={},Qr={html:"http://www.w3.org/1999/xhtml",mathml:"http://www.w3.org/1998/Math/MathML",svg:"http://www.w3.org/2000/svg"},Zr=void 0,Jr=function(e){return"undefined"!=typeof MSApp&&MSApp.execUnsafeLocalFunction?function(t,n,o,r){MSApp.execUnsafeLocalFunction(function(){return e(t,n)})}:e}(function(e,t){if(e.namespaceURI!==Qr.svg||"innerHTML"in e)e.innerHTML=t;else{for(Zr=Zr||document.createElement("div"),Zr.innerHTML="<svg>"+t+"</svg>",t=Zr.firstChild;e.firstChild;)e.removeChild(e.firstChild);for(;t.firstChild;)e.appendChild(t.firstChild)}}),$r={animationIterationCount:!0,borderImageOutset:!0,borderImageSlice:!0,borderImageWidth:!0,boxFlex:!0,boxFlexGroup:!0,boxOrdinalGroup:!0,columnCount:!0,columns:!0,flex:!0,flexGrow:!0,flexPositive:!0,flexShrink:!0,flexNegative:!0,flexOrder:!0,gridRow:!0,gridRowEnd:!0,gridRowSpan:!0,gridRowStart:!0,gridColumn:!0,gridColumnEnd:!0,gridColumnSpan:!0,gridColumnStart:!0,fontWeight:!0,lineClamp:!0,lineHeight:!0,opacity:!0,order:!0,orphans:!0,tabSize:!0,widows:!0,zIndex:!0,zoom:!0,fillOpacity:!0,floodOpacity:!0,stopOpacity:!0,strokeDasharray:!0,strokeDashoffset:!0,strokeMiterlimit:!0,strokeOpacity:!0,strokeWidth:!0},el=["Webkit","ms","Moz","O"];Object.keys($r).forEach(function(e){el.forEach(function(t){t=t+e.charAt(0).toUpperCase()+e.substring(1),$r[t]=$r[e]})});var tl=xn({menuitem:!0},{area:!0,base:!0,br:!0,col:!0,embed:!0,hr:!0,img:!0,input:!0,keygen:!0,link:!0,meta:!0,param:!0,source:!0,track:!0,wbr:!0}),nl=Qr.html,ol=wn.thatReturns(""),rl={topAbort:"abort",topCanPlay:"canplay",topCanPlayThrough:"canplaythrough",topDurationChange:"durationchange",topEmptied:"emptied",topEncrypted:"encrypted",topEnded:"ended",topError:"error",topLoadedData:"loadeddata",topLoadedMetadata:"loadedmetadata",topLoadStart:"loadstart",topPause:"pause",topPlay:"play",topPlaying:"playing",topProgress:"progress",topRateChange:"ratechange",topSeeked:"seeked",topSeeking:"seeking",topStalled:"stalled",topSuspend:"suspend",topTimeUpdate:"timeupdate",topVolumeChange:"volumechange",topWaiting:"waiting"},ll=Object.freeze({createElement:an,createTextNode:cn,setInitialProperties:sn,diffProperties:un,updateProperties:dn,diffHydratedProperties:fn,diffHydratedText:pn,warnForUnmatchedText:function(){},warnForDeletedHydratableElement:function(){},warnForDeletedHydratableText:function(){},warnForInsertedHydratedElement:function(){},warnForInsertedHydratedText:function(){},restoreControlledState:function(e,t,n){switch(t){case"input":if(zt(e,n),t=n.name,"radio"===n.type&&null!=t){for(n=e;n.parentNode;)n=n.parentNode;for(n=n.querySelectorAll("input[name="+JSON.stringify(""+t)+'][type="radio"]'),t=0;t<n.length;t++){var r=n[t];if(r!==e&&r.form===e.form){var l=O(r);l||o("90"),ae(r),zt(r,l)}}}break;case"textarea":Zt(e,n);break;case"select":null!=(t=n.value)&&Kt(e,!!n.multiple,t,!1)}}});Ro.injectFiberControlledHostComponent(ll);var al=null,il=null,cl=Sr({getRootHostContext:function(e){var t=e.nodeType;switch(t){case 9:case 11:e=(e=e.documentElement)?e.namespaceURI:en(null,"");break;default:t=8===t?e.parentNode:e,e=t.namespaceURI||null,t=t.tagName,e=en(e,t)}return e},getChildHostContext:function(e,t){return en(e,t)},getPublicInstance:function(e){return e},prepareForCommit:function(){al=Wo;var e=En();if(De(e)){if("selectionStart"in e)var t={start:e.selectionStart,end:e.selectionEnd};else e:{var n=window.getSelection&&window.getSelection();if(n&&0!==n.rangeCount){t=n.anchorNode;var o=n.anchorOffset,r=n.focusNode;n=n.focusOffset;try{t.nodeType,r.nodeType}catch(e){t=null;break e}var l=0,a=-1,i=-1,c=0,s=0,u=e,d=null;t:for(;;){for(var f;u!==t||0!==o&&3!==u.nodeType||(a=l+o),u!==r||0!==n&&3!==u.nodeType||(i=l+n),3===u.nodeType&&(l+=u.nodeValue.length),null!==(f=u.firstChild);)d=u,u=f;for(;;){if(u===e)break t;if(d===t&&++c===o&&(a=l),d===r&&++s===n&&(i=l),null!==(f=u.nextSibling))break;u=d,d=u.parentNode}u=f}t=-1===a||-1===i?null:{start:a,end:i}}else t=null}t=t||{start:0,end:0}}else t=null;il={focusedElem:e,selectionRange:t},Le(!1)},resetAfterCommit:function(){var e=il,t=En(),n=e.focusedElem,o=e.selectionRange;if(t!==n&&On(document.documentElement,n)){if(De(n))if(t=o.start,e=o.end,void 0===e&&(e=t),"selectionStart"in n)n.selectionStart=t,n.selectionEnd=Math.min(e,n.value.length);else if(window.getSelection){t=window.getSelection();var r=n[N()].length;e=Math.min(o.start,r),o=void 0===o.end?e:Math.min(o.end,r),!t.extend&&e>o&&(r=o,o=e,e=r),r=Ie(n,e);var l=Ie(n,o);if(r&&l&&(1!==t.rangeCount||t.anchorNode!==r.node||t.anchorOffset!==r.offset||t.focusNode!==l.node||t.focusOffset!==l.offset)){var a=document.createRange();a.setStart(r.node,r.offset),t.removeAllRanges(),e>o?(t.addRange(a),t.extend(l.node,l.offset)):(a.setEnd(l.node,l.offset),t.addRange(a))}}for(t=[],e=n;e=e.parentNode;)1===e.nodeType&&t.push({element:e,left:e.scrollLeft,top:e.scrollTop});for(Pn(n),n=0;n<t.length;n++)e=t[n],e.element.scrollLeft=e.left,e.element.scrollTop=e.top}il=null,Le(al),al=null},createInstance:function(e,t,n,o,r){return e=an(e,t,n,o),e[lo]=r,e[ao]=t,e},appendInitialChild:function(e,t){e.appendChild(t)},finalizeInitialChildren:function(e,t,n,o){sn(e,t,n,o);e:{switch(t){case"button":case"input":case"select":case"textarea":e=!!n.autoFocus;break e}e=!1}return e},prepareUpdate:function(e,t,n,o,r){return un(e,t,n,o,r)},shouldSetTextContent:function(e,t){return"textarea"===e||"string"==typeof t.children||"number"==typeof t.children||"object"==typeof t.dangerouslySetInnerHTML&&null!==t.dangerouslySetInnerHTML&&"string"==typeof t.dangerouslySetInnerHTML.__html},shouldDeprioritizeSubtree:function(e,t){return!!t.hidden},createTextInstance:function(e,t,n,o){return e=cn(e,t),e[lo]=o,e},now:Hr,mutation:{commitMount:function(e){e.focus()},commitUpdate:function(e,t,n,o,r){e[ao]=r,dn(e,t,n,o,r)},resetTextContent:function(e){e.textContent=""},commitTextUpdate:function(e,t,n){e.nodeValue=n},appendChild:function(e,t){e.appendChild(t)},appendChildToContainer:function(e,t){8===e.nodeType?e.parentNode.insertBefore(t,e):e.appendChild(t)},insertBefore:function(e,t,n){e.insertBefore(t,n)},insertInContainerBefore:function(e,t,n){8===e.nodeType?e.parentNode.insertBefore(t,n):e.insertBefore(t,n)},removeChild:function(e,t){e.removeChild(t)},removeChildFromContainer:function(e,t){8===e.nodeType?e.parentNode.removeChild(t):e.removeChild(t)}},hydration:{canHydrateInstance:function(e,t){return 1!==e.nodeType||t.toLowerCase()!==e.nodeName.toLowerCase()?null:e},canHydrateTextInstance:function(e,t){return""===t||3!==e.nodeType?null:e},getNextHydratableSibling:function(e){for(e=e.nextSibling;e&&1!==e.nodeType&&3!==e.nodeType;)e=e.nextSibling;return e},getFirstHydratableChild:function(e){for(e=e.firstChild;e&&1!==e.nodeType&&3!==e.nodeType;)e=e.nextSibling;return e},hydrateInstance:function(e,t,n,o,r,l){return e[lo]=l,e[ao]=n,fn(e,t,n,r,o)},hydrateTextInstance:function(e,t,n){return e[lo]=n,pn(e,t)},didNotMatchHydratedContainerTextInstance:function(){},didNotMatchHydratedTextInstance:function(){},didNotHydrateContainerInstance:function(){},didNotHydrateInstance:function(){},didNotFindHydratableContainerInstance:function(){},didNotFindHydratableContainerTextInstance:function(){},didNotFindHydratableInstance:function(){},didNotFindHydratableTextInstance:function(){}},scheduleDeferredCallback:Ur,cancelDeferredCallback:Nr,useSyncScheduling:!0});J=cl.batchedUpdates,_n.prototype.render=function(e,t){cl.updateContainer(e,this._reactRootContainer,null,t)},_n.prototype.unmount=function(e){cl.updateContainer(null,this._reactRootContainer,null,e)};var sl={createPortal:gn,findDOMNode:function(e){if(null==e)return null;if(1===e.nodeType)return e;var t=e._reactInternalFiber;if(t)return cl.findHostInstance(t);"function"==typeof e.render?o("188"):o("213",Object.keys(e))},hydrate:function(e,t,n){return mn(null,e,t,!0,n)},render:function(e,t,n){return mn(null,e,t,!1,n)},unstable_renderSubtreeIntoContainer:function(e,t,n,r){return(null==e||void 0===e._reactInternalFiber)&&o("38"),mn(e,t,n,!1,r)},unmountComponentAtNode:function(e){return bn(e)||o("40"),!!e._reactRootContainer&&(cl.unbatchedUpdates(function(){mn(null,null,e,!1,function(){e._reactRootContainer=null})}),!0)},unstable_createPortal:gn,unstable_batchedUpdates:$,unstable_deferredUpdates:cl.deferredUpdates,flushSync:cl.flushSync,__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED:{EventPluginHub:oo,EventPluginRegistry:Zn,EventPropagators:co,ReactControlledComponent:jo,ReactDOMComponentTree:io,ReactDOMEventListener:Vo}};cl.injectIntoDevTools({findFiberByHostInstance:E,bundleType:0,version:"16.2.0",rendererPackageName:"react-dom"});var ul=Object.freeze({default:sl}),dl=ul&&sl||ul;e.exports=dl.default?dl.default:dl},function(e,t,n){"use strict";var o=!("undefined"==typeof window||!window.document||!window.document.createElement),r={canUseDOM:o,canUseWorkers:"undefined"!=typeof Worker,canUseEventListeners:o&&!(!window.addEventListener&&!window.attachEvent),canUseViewport:o&&!!window.screen,isInWorker:!o};e.exports=r},function(e,t,n){"use strict";var o=n(14),r={listen:function(e,t,n){return e.addEventListener?(e.addEventListener(t,n,!1),{remove:function(){e.removeEventListener(t,n,!1)}}):e.attachEvent?(e.attachEvent("on"+t,n),{remove:function(){e.detachEvent("on"+t,n)}}):void 0},capture:function(e,t,n){return e.addEventListener?(e.addEventListener(t,n,!0),{remove:function(){e.removeEventListener(t,n,!0)}}):{remove:o}},registerDefault:function(){}};e.exports=r},function(e,t,n){"use strict";function o(e){if(void 0===(e=e||("undefined"!=typeof document?document:void 0)))return null;try{return e.activeElement||e.body}catch(t){return e.body}}e.exports=o},function(e,t,n){"use strict";function o(e,t){return e===t?0!==e||0!==t||1/e==1/t:e!==e&&t!==t}function r(e,t){if(o(e,t))return!0;if("object"!=typeof e||null===e||"object"!=typeof t||null===t)return!1;var n=Object.keys(e),r=Object.keys(t);if(n.length!==r.length)return!1;for(var a=0;a<n.length;a++)if(!l.call(t,n[a])||!o(e[n[a]],t[n[a]]))return!1;return!0}var l=Object.prototype.hasOwnProperty;e.exports=r},function(e,t,n){"use strict";function o(e,t){return!(!e||!t)&&(e===t||!r(e)&&(r(t)?o(e,t.parentNode):"contains"in e?e.contains(t):!!e.compareDocumentPosition&&!!(16&e.compareDocumentPosition(t))))}var r=n(53);e.exports=o},function(e,t,n){"use strict";function o(e){return r(e)&&3==e.nodeType}var r=n(54);e.exports=o},function(e,t,n){"use strict";function o(e){var t=e?e.ownerDocument||e:document,n=t.defaultView||window;return!(!e||!("function"==typeof n.Node?e instanceof n.Node:"object"==typeof e&&"number"==typeof e.nodeType&&"string"==typeof e.nodeName))}e.exports=o},function(e,t,n){"use strict";function o(e){try{e.focus()}catch(e){}}e.exports=o},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(15),p=o(f),b=n(57),h=o(b),m=n(7),g=o(m),_=n(8),v=o(_),y=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){return s.default.createElement("div",{className:g.default.tableResponsive},s.default.createElement("table",{className:g.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("th",null,"Name"),s.default.createElement("th",null,"Arguments"),s.default.createElement("th",null,"Started At"),s.default.createElement("th",null,"Check-in At"),s.default.createElement("th",null,"Check-in")),this.props.worker.map(function(e){return s.default.createElement("tr",{key:e.worker_id},s.default.createElement("td",null,e.job_name),s.default.createElement("td",null,e.args_json),s.default.createElement("td",null,s.default.createElement(p.default,{ts:e.started_at})),s.default.createElement("td",null,s.default.createElement(p.default,{ts:e.checkin_at})),s.default.createElement("td",null,e.checkin))}))))}}]),t}(s.default.Component);y.propTypes={worker:d.default.arrayOf(d.default.object).isRequired};var x=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,c=Array(i),s=0;s<i;s++)c[s]=arguments[s];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(c))),o.state={busyWorker:[],workerPool:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"componentWillMount",value:function(){var e=this;this.props.busyWorkerURL&&fetch(this.props.busyWorkerURL).then(function(e){return e.json()}).then(function(t){t&&e.setState({busyWorker:t})}),this.props.workerPoolURL&&fetch(this.props.workerPoolURL).then(function(e){return e.json()}).then(function(t){var n=[];t.map(function(e){""!=e.host&&n.push(e)}),e.setState({workerPool:n})})}},{key:"getBusyPoolWorker",value:function(e){var t=[];return this.state.busyWorker.map(function(n){e.worker_ids.includes(n.worker_id)&&t.push(n)}),t}},{key:"render",value:function(){var e=this;return s.default.createElement("section",null,s.default.createElement("header",null,"Processes"),s.default.createElement("p",null,this.state.workerPool.length," Worker process(es). ",this.state.busyWorker.length," active worker(s) out of ",this.workerCount,"."),this.state.workerPool.map(function(t){var n=e.getBusyPoolWorker(t);return s.default.createElement("div",{key:t.worker_pool_id,className:(0,v.default)(g.default.panel,g.default.panelDefault)},s.default.createElement("div",{className:g.default.tableResponsive},s.default.createElement("table",{className:g.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("td",null,t.host,": ",t.pid),s.default.createElement("td",null,"Started ",s.default.createElement(p.default,{ts:t.started_at})),s.default.createElement("td",null,"Last Heartbeat ",s.default.createElement(p.default,{ts:t.heartbeat_at})),s.default.createElement("td",null,"Concurrency ",t.concurrency)),s.default.createElement("tr",null,s.default.createElement("td",{colSpan:"4"},"Servicing ",s.default.createElement(h.default,{item:t.job_names}),".")),s.default.createElement("tr",null,s.default.createElement("td",{colSpan:"4"},n.length," active worker(s) and ",t.worker_ids.length-n.length," idle.")),s.default.createElement("tr",null,s.default.createElement("td",{colSpan:"4"},s.default.createElement("div",{className:(0,v.default)(g.default.panel,g.default.panelDefault)},s.default.createElement(y,{worker:n}))))))))}))}},{key:"workerCount",get:function(){var e=0;return this.state.workerPool.map(function(t){e+=t.worker_ids.length}),e}}]),t}(s.default.Component);x.propTypes={busyWorkerURL:d.default.string,workerPoolURL:d.default.string},t.default=x},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(58),p=o(f),b=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){var e=this;return s.default.createElement("ul",{className:p.default.ul},this.props.item.map(function(t,n){return n<3?s.default.createElement("li",{key:n,className:p.default.li},t):3==n?s.default.createElement("li",{key:n,className:p.default.li},e.props.item.length-3," more"):void 0}))}}]),t}(s.default.Component);b.propTypes={item:d.default.arrayOf(d.default.string).isRequired},t.default=b},function(e,t,n){var o=n(59);"string"==typeof o&&(o=[[e.i,o,""]]);var r={hmr:!0};r.transform=void 0;n(30)(o,r);o.locals&&(e.exports=o.locals)},function(e,t,n){t=e.exports=n(29)(void 0),t.push([e.i,'._223iE-ul {\n  display: inline;\n  list-style: none;\n  padding: 0;\n}\n\n._14w3w-li {\n  display: inline;\n}\n\n._14w3w-li:after {\n  content: ", ";\n}\n\n._14w3w-li:last-child:before {\n  content: "and "\n}\n\n._14w3w-li:last-child:after {\n  content: "";\n}\n',""]),t.locals={ul:"_223iE-ul",li:"_14w3w-li"}},function(e,t){e.exports=function(e){var t="undefined"!=typeof window&&window.location;if(!t)throw new Error("fixUrls requires window.location");if(!e||"string"!=typeof e)return e;var n=t.protocol+"//"+t.host,o=n+t.pathname.replace(/\/[^\/]*$/,"/");return e.replace(/url\s*\(((?:[^)(]|\((?:[^)(]+|\([^)(]*\))*\))*)\)/gi,function(e,t){var r=t.trim().replace(/^"(.*)"$/,function(e,t){return t}).replace(/^'(.*)'$/,function(e,t){return t});if(/^(#|data:|http:\/\/|https:\/\/|file:\/\/\/)/i.test(r))return e;var l;return l=0===r.indexOf("//")?r:0===r.indexOf("/")?n+r:o+r.replace(/^\.\//,""),"url("+JSON.stringify(l)+")"})}},function(e,t,n){t=e.exports=n(29)(void 0),t.push([e.i,'/*!\n * Bootstrap v3.3.5 (http://getbootstrap.com)\n * Copyright 2011-2016 Twitter, Inc.\n * Licensed under MIT (https://github.com/twbs/bootstrap/blob/master/LICENSE)\n */\n\n/*!\n * Generated using the Bootstrap Customizer (http://getbootstrap.com/customize/?id=da9e4e258238275a8c6ec9d23bbb2e92)\n * Config saved to config.json and https://gist.github.com/da9e4e258238275a8c6ec9d23bbb2e92\n *//*!\n * Bootstrap v3.3.6 (http://getbootstrap.com)\n * Copyright 2011-2015 Twitter, Inc.\n * Licensed under MIT (https://github.com/twbs/bootstrap/blob/master/LICENSE)\n *//*! normalize.css v3.0.3 | MIT License | github.com/necolas/normalize.css */html{font-family:sans-serif;-ms-text-size-adjust:100%;-webkit-text-size-adjust:100%}body{margin:0}article,aside,details,figcaption,figure,footer,header,hgroup,main,menu,nav,section,summary{display:block}audio,canvas,progress,video{display:inline-block;vertical-align:baseline}audio:not([controls]){display:none;height:0}[hidden],template{display:none}a{background-color:transparent}a:active,a:hover{outline:0}abbr[title]{border-bottom:1px dotted}b,strong{font-weight:bold}dfn{font-style:italic}h1{font-size:2em;margin:0.67em 0}mark{background:#ff0;color:#000}small{font-size:80%}sub,sup{font-size:75%;line-height:0;position:relative;vertical-align:baseline}sup{top:-0.5em}sub{bottom:-0.25em}img{border:0}svg:not(:root){overflow:hidden}figure{margin:1em 40px}hr{-webkit-box-sizing:content-box;-moz-box-sizing:content-box;box-sizing:content-box;height:0}pre{overflow:auto}code,kbd,pre,samp{font-family:monospace, monospace;font-size:1em}button,input,optgroup,select,textarea{color:inherit;font:inherit;margin:0}button{overflow:visible}button,select{text-transform:none}button,html input[type="button"],input[type="reset"],input[type="submit"]{-webkit-appearance:button;cursor:pointer}button[disabled],html input[disabled]{cursor:default}button::-moz-focus-inner,input::-moz-focus-inner{border:0;padding:0}input{line-height:normal}input[type="checkbox"],input[type="radio"]{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box;padding:0}input[type="number"]::-webkit-inner-spin-button,input[type="number"]::-webkit-outer-spin-button{height:auto}input[type="search"]{-webkit-appearance:textfield;-webkit-box-sizing:content-box;-moz-box-sizing:content-box;box-sizing:content-box}input[type="search"]::-webkit-search-cancel-button,input[type="search"]::-webkit-search-decoration{-webkit-appearance:none}fieldset{border:1px solid #c0c0c0;margin:0 2px;padding:0.35em 0.625em 0.75em}legend{border:0;padding:0}textarea{overflow:auto}optgroup{font-weight:bold}table{border-collapse:collapse;border-spacing:0}td,th{padding:0}/*! Source: https://github.com/h5bp/html5-boilerplate/blob/master/src/css/main.css */@media print{*,*:before,*:after{background:transparent !important;color:#000 !important;-webkit-box-shadow:none !important;box-shadow:none !important;text-shadow:none !important}a,a:visited{text-decoration:underline}a[href]:after{content:" (" attr(href) ")"}abbr[title]:after{content:" (" attr(title) ")"}a[href^="#"]:after,a[href^="javascript:"]:after{content:""}pre,blockquote{border:1px solid #999;page-break-inside:avoid}thead{display:table-header-group}tr,img{page-break-inside:avoid}img{max-width:100% !important}p,h2,h3{orphans:3;widows:3}h2,h3{page-break-after:avoid}.xz-aB-navbar{display:none}._3-XUl-btn>.WfXRY-caret,.xQM4X-dropup>._3-XUl-btn>.WfXRY-caret{border-top-color:#000 !important}._3gkGS-label{border:1px solid #000}.fHH5l-table{border-collapse:collapse !important}.fHH5l-table td,.fHH5l-table th{background-color:#fff !important}._3qUkR-table-bordered th,._3qUkR-table-bordered td{border:1px solid #ddd !important}}*{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box}*:before,*:after{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box}html{font-size:10px;-webkit-tap-highlight-color:rgba(0,0,0,0)}body{font-family:"Helvetica Neue",Helvetica,Arial,sans-serif;font-size:14px;line-height:1.42857143;color:#333;background-color:#fff}input,button,select,textarea{font-family:inherit;font-size:inherit;line-height:inherit}a{color:#337ab7;text-decoration:none}a:hover,a:focus{color:#23527c;text-decoration:underline}a:focus{outline:thin dotted;outline:5px auto -webkit-focus-ring-color;outline-offset:-2px}figure{margin:0}img{vertical-align:middle}._2zRts-img-responsive{display:block;max-width:100%;height:auto}._141wW-img-rounded{border-radius:6px}._3mPVB-img-thumbnail{padding:4px;line-height:1.42857143;background-color:#fff;border:1px solid #ddd;border-radius:4px;-webkit-transition:all .2s ease-in-out;-o-transition:all .2s ease-in-out;transition:all .2s ease-in-out;display:inline-block;max-width:100%;height:auto}._1pGGm-img-circle{border-radius:50%}hr{margin-top:20px;margin-bottom:20px;border:0;border-top:1px solid #eee}._2pd-Z-sr-only{position:absolute;width:1px;height:1px;margin:-1px;padding:0;overflow:hidden;clip:rect(0, 0, 0, 0);border:0}._2oRwu-sr-only-focusable:active,._2oRwu-sr-only-focusable:focus{position:static;width:auto;height:auto;margin:0;overflow:visible;clip:auto}[role="button"]{cursor:pointer}h1,h2,h3,h4,h5,h6,.FcDB9-h1,._2RtE8-h2,._2Hz9Y-h3,._20UE0-h4,._3ig4X-h5,._1IWbi-h6{font-family:inherit;font-weight:500;line-height:1.1;color:inherit}h1 small,h2 small,h3 small,h4 small,h5 small,h6 small,.FcDB9-h1 small,._2RtE8-h2 small,._2Hz9Y-h3 small,._20UE0-h4 small,._3ig4X-h5 small,._1IWbi-h6 small,h1 ._1xqEr-small,h2 ._1xqEr-small,h3 ._1xqEr-small,h4 ._1xqEr-small,h5 ._1xqEr-small,h6 ._1xqEr-small,.FcDB9-h1 ._1xqEr-small,._2RtE8-h2 ._1xqEr-small,._2Hz9Y-h3 ._1xqEr-small,._20UE0-h4 ._1xqEr-small,._3ig4X-h5 ._1xqEr-small,._1IWbi-h6 ._1xqEr-small{font-weight:normal;line-height:1;color:#777}h1,.FcDB9-h1,h2,._2RtE8-h2,h3,._2Hz9Y-h3{margin-top:20px;margin-bottom:10px}h1 small,.FcDB9-h1 small,h2 small,._2RtE8-h2 small,h3 small,._2Hz9Y-h3 small,h1 ._1xqEr-small,.FcDB9-h1 ._1xqEr-small,h2 ._1xqEr-small,._2RtE8-h2 ._1xqEr-small,h3 ._1xqEr-small,._2Hz9Y-h3 ._1xqEr-small{font-size:65%}h4,._20UE0-h4,h5,._3ig4X-h5,h6,._1IWbi-h6{margin-top:10px;margin-bottom:10px}h4 small,._20UE0-h4 small,h5 small,._3ig4X-h5 small,h6 small,._1IWbi-h6 small,h4 ._1xqEr-small,._20UE0-h4 ._1xqEr-small,h5 ._1xqEr-small,._3ig4X-h5 ._1xqEr-small,h6 ._1xqEr-small,._1IWbi-h6 ._1xqEr-small{font-size:75%}h1,.FcDB9-h1{font-size:36px}h2,._2RtE8-h2{font-size:30px}h3,._2Hz9Y-h3{font-size:24px}h4,._20UE0-h4{font-size:18px}h5,._3ig4X-h5{font-size:14px}h6,._1IWbi-h6{font-size:12px}p{margin:0 0 10px}.BToLm-lead{margin-bottom:20px;font-size:16px;font-weight:300;line-height:1.4}@media (min-width:768px){.BToLm-lead{font-size:21px}}small,._1xqEr-small{font-size:85%}mark,._1CUfl-mark{background-color:#fcf8e3;padding:.2em}._1dEhG-text-left{text-align:left}._13-RL-text-right{text-align:right}._12Qkk-text-center{text-align:center}._2IBJo-text-justify{text-align:justify}._2D83n-text-nowrap{white-space:nowrap}._3pILW-text-lowercase{text-transform:lowercase}.EHGdF-text-uppercase{text-transform:uppercase}.NKPoz-text-capitalize{text-transform:capitalize}.TLMiz-text-muted{color:#777}.m-Vt1-text-primary{color:#337ab7}a.m-Vt1-text-primary:hover,a.m-Vt1-text-primary:focus{color:#286090}._1gJQD-text-success{color:#3c763d}a._1gJQD-text-success:hover,a._1gJQD-text-success:focus{color:#2b542c}._3nKGJ-text-info{color:#31708f}a._3nKGJ-text-info:hover,a._3nKGJ-text-info:focus{color:#245269}._1L8Pc-text-warning{color:#8a6d3b}a._1L8Pc-text-warning:hover,a._1L8Pc-text-warning:focus{color:#66512c}._2Qeqx-text-danger{color:#a94442}a._2Qeqx-text-danger:hover,a._2Qeqx-text-danger:focus{color:#843534}.VG86S-bg-primary{color:#fff;background-color:#337ab7}a.VG86S-bg-primary:hover,a.VG86S-bg-primary:focus{background-color:#286090}.Fh09a-bg-success{background-color:#dff0d8}a.Fh09a-bg-success:hover,a.Fh09a-bg-success:focus{background-color:#c1e2b3}._3D-5o-bg-info{background-color:#d9edf7}a._3D-5o-bg-info:hover,a._3D-5o-bg-info:focus{background-color:#afd9ee}._23YNn-bg-warning{background-color:#fcf8e3}a._23YNn-bg-warning:hover,a._23YNn-bg-warning:focus{background-color:#f7ecb5}._1g67A-bg-danger{background-color:#f2dede}a._1g67A-bg-danger:hover,a._1g67A-bg-danger:focus{background-color:#e4b9b9}._3ayLh-page-header{padding-bottom:9px;margin:40px 0 20px;border-bottom:1px solid #eee}ul,ol{margin-top:0;margin-bottom:10px}ul ul,ol ul,ul ol,ol ol{margin-bottom:0}._2tb6R-list-unstyled{padding-left:0;list-style:none}._2aPiu-list-inline{padding-left:0;list-style:none;margin-left:-5px}._2aPiu-list-inline>li{display:inline-block;padding-left:5px;padding-right:5px}dl{margin-top:0;margin-bottom:20px}dt,dd{line-height:1.42857143}dt{font-weight:bold}dd{margin-left:0}@media (min-width:768px){._2DZom-dl-horizontal dt{float:left;width:160px;clear:left;text-align:right;overflow:hidden;text-overflow:ellipsis;white-space:nowrap}._2DZom-dl-horizontal dd{margin-left:180px}}abbr[title],abbr[data-original-title]{cursor:help;border-bottom:1px dotted #777}._39JkD-initialism{font-size:90%;text-transform:uppercase}blockquote{padding:10px 20px;margin:0 0 20px;font-size:17.5px;border-left:5px solid #eee}blockquote p:last-child,blockquote ul:last-child,blockquote ol:last-child{margin-bottom:0}blockquote footer,blockquote small,blockquote ._1xqEr-small{display:block;font-size:80%;line-height:1.42857143;color:#777}blockquote footer:before,blockquote small:before,blockquote ._1xqEr-small:before{content:\'\\2014   \\A0\'}._3OkIL-blockquote-reverse,blockquote._1DwIR-pull-right{padding-right:15px;padding-left:0;border-right:5px solid #eee;border-left:0;text-align:right}._3OkIL-blockquote-reverse footer:before,blockquote._1DwIR-pull-right footer:before,._3OkIL-blockquote-reverse small:before,blockquote._1DwIR-pull-right small:before,._3OkIL-blockquote-reverse ._1xqEr-small:before,blockquote._1DwIR-pull-right ._1xqEr-small:before{content:\'\'}._3OkIL-blockquote-reverse footer:after,blockquote._1DwIR-pull-right footer:after,._3OkIL-blockquote-reverse small:after,blockquote._1DwIR-pull-right small:after,._3OkIL-blockquote-reverse ._1xqEr-small:after,blockquote._1DwIR-pull-right ._1xqEr-small:after{content:\'\\A0   \\2014\'}address{margin-bottom:20px;font-style:normal;line-height:1.42857143}.GfVfY-container{margin-right:auto;margin-left:auto;padding-left:15px;padding-right:15px}@media (min-width:768px){.GfVfY-container{width:750px}}@media (min-width:992px){.GfVfY-container{width:970px}}@media (min-width:1200px){.GfVfY-container{width:1170px}}._1TgHS-container-fluid{margin-right:auto;margin-left:auto;padding-left:15px;padding-right:15px}._3XjgV-row{margin-left:-15px;margin-right:-15px}._4I1Yd-col-xs-1, .ZjKa3-col-sm-1, ._2qQXc-col-md-1, ._20ta3-col-lg-1, ._2Dnpy-col-xs-2, ._1i2ug-col-sm-2, ._2t37W-col-md-2, ._2Hl3y-col-lg-2, .OXp8_-col-xs-3, ._2Cn5F-col-sm-3, .Ab3eH-col-md-3, ._26GsY-col-lg-3, ._2RAtz-col-xs-4, .fa0Ir-col-sm-4, .hp0Eu-col-md-4, ._17v9q-col-lg-4, ._2Y3wR-col-xs-5, ._3h_BG-col-sm-5, .s-Mk2-col-md-5, ._3poT2-col-lg-5, ._2LLfi-col-xs-6, ._2uvrS-col-sm-6, ._3aWb5-col-md-6, ._1kKt6-col-lg-6, ._1lY1V-col-xs-7, ._3MZfg-col-sm-7, ._3cJo6-col-md-7, ._3xzR0-col-lg-7, ._2HxFx-col-xs-8, ._2gidE-col-sm-8, ._2PRaS-col-md-8, .LJ--s-col-lg-8, ._2oOfj-col-xs-9, ._26BI4-col-sm-9, ._2DhdG-col-md-9, ._3q6rI-col-lg-9, .pmiBX-col-xs-10, .s5knd-col-sm-10, ._1oNCM-col-md-10, ._2DYWZ-col-lg-10, .yWnii-col-xs-11, .liNUF-col-sm-11, ._2CmUe-col-md-11, ._1qjSD-col-lg-11, ._2dgc--col-xs-12, ._3ylC5-col-sm-12, ._2DCWc-col-md-12, ._2FNrn-col-lg-12{position:relative;min-height:1px;padding-left:15px;padding-right:15px}._4I1Yd-col-xs-1, ._2Dnpy-col-xs-2, .OXp8_-col-xs-3, ._2RAtz-col-xs-4, ._2Y3wR-col-xs-5, ._2LLfi-col-xs-6, ._1lY1V-col-xs-7, ._2HxFx-col-xs-8, ._2oOfj-col-xs-9, .pmiBX-col-xs-10, .yWnii-col-xs-11, ._2dgc--col-xs-12{float:left}._2dgc--col-xs-12{width:100%}.yWnii-col-xs-11{width:91.66666667%}.pmiBX-col-xs-10{width:83.33333333%}._2oOfj-col-xs-9{width:75%}._2HxFx-col-xs-8{width:66.66666667%}._1lY1V-col-xs-7{width:58.33333333%}._2LLfi-col-xs-6{width:50%}._2Y3wR-col-xs-5{width:41.66666667%}._2RAtz-col-xs-4{width:33.33333333%}.OXp8_-col-xs-3{width:25%}._2Dnpy-col-xs-2{width:16.66666667%}._4I1Yd-col-xs-1{width:8.33333333%}.uk8LQ-col-xs-pull-12{right:100%}.nQP-X-col-xs-pull-11{right:91.66666667%}._3W2H_-col-xs-pull-10{right:83.33333333%}._18qIh-col-xs-pull-9{right:75%}.CEgpl-col-xs-pull-8{right:66.66666667%}._3UVMh-col-xs-pull-7{right:58.33333333%}._1PEhv-col-xs-pull-6{right:50%}.VxFvW-col-xs-pull-5{right:41.66666667%}._3Wqpt-col-xs-pull-4{right:33.33333333%}._12Zed-col-xs-pull-3{right:25%}._3p-tQ-col-xs-pull-2{right:16.66666667%}.RDEqX-col-xs-pull-1{right:8.33333333%}._2F1WQ-col-xs-pull-0{right:auto}._1APvI-col-xs-push-12{left:100%}._3C73D-col-xs-push-11{left:91.66666667%}.h56Hx-col-xs-push-10{left:83.33333333%}._1P93P-col-xs-push-9{left:75%}._2Nbww-col-xs-push-8{left:66.66666667%}._1K3eA-col-xs-push-7{left:58.33333333%}._2nBcT-col-xs-push-6{left:50%}._3petP-col-xs-push-5{left:41.66666667%}._2IqqW-col-xs-push-4{left:33.33333333%}.MCtu6-col-xs-push-3{left:25%}._3PMdr-col-xs-push-2{left:16.66666667%}._1wU93-col-xs-push-1{left:8.33333333%}._1mQQp-col-xs-push-0{left:auto}._29EZj-col-xs-offset-12{margin-left:100%}._2hf-s-col-xs-offset-11{margin-left:91.66666667%}.PUiPu-col-xs-offset-10{margin-left:83.33333333%}._26WFR-col-xs-offset-9{margin-left:75%}._1cCHe-col-xs-offset-8{margin-left:66.66666667%}._3XTt9-col-xs-offset-7{margin-left:58.33333333%}._3ruq1-col-xs-offset-6{margin-left:50%}._2Jvdw-col-xs-offset-5{margin-left:41.66666667%}._3Lc1D-col-xs-offset-4{margin-left:33.33333333%}._1MGVb-col-xs-offset-3{margin-left:25%}._3VELV-col-xs-offset-2{margin-left:16.66666667%}._1qTH1-col-xs-offset-1{margin-left:8.33333333%}._3p4mP-col-xs-offset-0{margin-left:0}@media (min-width:768px){.ZjKa3-col-sm-1, ._1i2ug-col-sm-2, ._2Cn5F-col-sm-3, .fa0Ir-col-sm-4, ._3h_BG-col-sm-5, ._2uvrS-col-sm-6, ._3MZfg-col-sm-7, ._2gidE-col-sm-8, ._26BI4-col-sm-9, .s5knd-col-sm-10, .liNUF-col-sm-11, ._3ylC5-col-sm-12{float:left}._3ylC5-col-sm-12{width:100%}.liNUF-col-sm-11{width:91.66666667%}.s5knd-col-sm-10{width:83.33333333%}._26BI4-col-sm-9{width:75%}._2gidE-col-sm-8{width:66.66666667%}._3MZfg-col-sm-7{width:58.33333333%}._2uvrS-col-sm-6{width:50%}._3h_BG-col-sm-5{width:41.66666667%}.fa0Ir-col-sm-4{width:33.33333333%}._2Cn5F-col-sm-3{width:25%}._1i2ug-col-sm-2{width:16.66666667%}.ZjKa3-col-sm-1{width:8.33333333%}._2lgaN-col-sm-pull-12{right:100%}._9DE1R-col-sm-pull-11{right:91.66666667%}.kEzk--col-sm-pull-10{right:83.33333333%}.Nt2jd-col-sm-pull-9{right:75%}._3swPC-col-sm-pull-8{right:66.66666667%}._3HVdW-col-sm-pull-7{right:58.33333333%}.wTEFa-col-sm-pull-6{right:50%}.TLqCy-col-sm-pull-5{right:41.66666667%}._15_JC-col-sm-pull-4{right:33.33333333%}.kKugK-col-sm-pull-3{right:25%}._1gfwZ-col-sm-pull-2{right:16.66666667%}.ULGBP-col-sm-pull-1{right:8.33333333%}._2761W-col-sm-pull-0{right:auto}.vVqsv-col-sm-push-12{left:100%}._3rrzB-col-sm-push-11{left:91.66666667%}._16iHZ-col-sm-push-10{left:83.33333333%}._3gO4_-col-sm-push-9{left:75%}._2iTaN-col-sm-push-8{left:66.66666667%}._15MqT-col-sm-push-7{left:58.33333333%}._3WBCD-col-sm-push-6{left:50%}._3yi6x-col-sm-push-5{left:41.66666667%}.Hi_tc-col-sm-push-4{left:33.33333333%}._3CD2Y-col-sm-push-3{left:25%}._2KPVu-col-sm-push-2{left:16.66666667%}._1vrte-col-sm-push-1{left:8.33333333%}._2iGSL-col-sm-push-0{left:auto}._2gBOF-col-sm-offset-12{margin-left:100%}._1nkq--col-sm-offset-11{margin-left:91.66666667%}._3cbLF-col-sm-offset-10{margin-left:83.33333333%}._1YqUk-col-sm-offset-9{margin-left:75%}.l5LT2-col-sm-offset-8{margin-left:66.66666667%}._1_lx0-col-sm-offset-7{margin-left:58.33333333%}.QftRx-col-sm-offset-6{margin-left:50%}._1CiAA-col-sm-offset-5{margin-left:41.66666667%}._3KOyM-col-sm-offset-4{margin-left:33.33333333%}._3X8SS-col-sm-offset-3{margin-left:25%}._2uxv1-col-sm-offset-2{margin-left:16.66666667%}._3LDLJ-col-sm-offset-1{margin-left:8.33333333%}._2qEtK-col-sm-offset-0{margin-left:0}}@media (min-width:992px){._2qQXc-col-md-1, ._2t37W-col-md-2, .Ab3eH-col-md-3, .hp0Eu-col-md-4, .s-Mk2-col-md-5, ._3aWb5-col-md-6, ._3cJo6-col-md-7, ._2PRaS-col-md-8, ._2DhdG-col-md-9, ._1oNCM-col-md-10, ._2CmUe-col-md-11, ._2DCWc-col-md-12{float:left}._2DCWc-col-md-12{width:100%}._2CmUe-col-md-11{width:91.66666667%}._1oNCM-col-md-10{width:83.33333333%}._2DhdG-col-md-9{width:75%}._2PRaS-col-md-8{width:66.66666667%}._3cJo6-col-md-7{width:58.33333333%}._3aWb5-col-md-6{width:50%}.s-Mk2-col-md-5{width:41.66666667%}.hp0Eu-col-md-4{width:33.33333333%}.Ab3eH-col-md-3{width:25%}._2t37W-col-md-2{width:16.66666667%}._2qQXc-col-md-1{width:8.33333333%}.bjQSr-col-md-pull-12{right:100%}._2R0gT-col-md-pull-11{right:91.66666667%}._1ywn5-col-md-pull-10{right:83.33333333%}.Y3m-j-col-md-pull-9{right:75%}._2kyk9-col-md-pull-8{right:66.66666667%}.dnrMG-col-md-pull-7{right:58.33333333%}._1zNYf-col-md-pull-6{right:50%}._1lgxH-col-md-pull-5{right:41.66666667%}.fI_-S-col-md-pull-4{right:33.33333333%}._1yC1r-col-md-pull-3{right:25%}._2ruK1-col-md-pull-2{right:16.66666667%}._3pkxQ-col-md-pull-1{right:8.33333333%}._2NDft-col-md-pull-0{right:auto}._2dklO-col-md-push-12{left:100%}._2O3TS-col-md-push-11{left:91.66666667%}._3H7Y4-col-md-push-10{left:83.33333333%}._2PIS0-col-md-push-9{left:75%}._1qQQi-col-md-push-8{left:66.66666667%}.Y8MRT-col-md-push-7{left:58.33333333%}._3_TWQ-col-md-push-6{left:50%}._3KOrj-col-md-push-5{left:41.66666667%}._2PuS8-col-md-push-4{left:33.33333333%}._2zI-_-col-md-push-3{left:25%}._3Drij-col-md-push-2{left:16.66666667%}._1M7-4-col-md-push-1{left:8.33333333%}._1kPaw-col-md-push-0{left:auto}._3PwfG-col-md-offset-12{margin-left:100%}.sHbiw-col-md-offset-11{margin-left:91.66666667%}._3geq1-col-md-offset-10{margin-left:83.33333333%}._3ypJc-col-md-offset-9{margin-left:75%}.ydDuS-col-md-offset-8{margin-left:66.66666667%}._1MS3B-col-md-offset-7{margin-left:58.33333333%}._1A80h-col-md-offset-6{margin-left:50%}._3g3p_-col-md-offset-5{margin-left:41.66666667%}._2FjQc-col-md-offset-4{margin-left:33.33333333%}.Xebzn-col-md-offset-3{margin-left:25%}.Xw9rm-col-md-offset-2{margin-left:16.66666667%}.CToHd-col-md-offset-1{margin-left:8.33333333%}.oXEcL-col-md-offset-0{margin-left:0}}@media (min-width:1200px){._20ta3-col-lg-1, ._2Hl3y-col-lg-2, ._26GsY-col-lg-3, ._17v9q-col-lg-4, ._3poT2-col-lg-5, ._1kKt6-col-lg-6, ._3xzR0-col-lg-7, .LJ--s-col-lg-8, ._3q6rI-col-lg-9, ._2DYWZ-col-lg-10, ._1qjSD-col-lg-11, ._2FNrn-col-lg-12{float:left}._2FNrn-col-lg-12{width:100%}._1qjSD-col-lg-11{width:91.66666667%}._2DYWZ-col-lg-10{width:83.33333333%}._3q6rI-col-lg-9{width:75%}.LJ--s-col-lg-8{width:66.66666667%}._3xzR0-col-lg-7{width:58.33333333%}._1kKt6-col-lg-6{width:50%}._3poT2-col-lg-5{width:41.66666667%}._17v9q-col-lg-4{width:33.33333333%}._26GsY-col-lg-3{width:25%}._2Hl3y-col-lg-2{width:16.66666667%}._20ta3-col-lg-1{width:8.33333333%}._32_we-col-lg-pull-12{right:100%}._24eEw-col-lg-pull-11{right:91.66666667%}._29qaq-col-lg-pull-10{right:83.33333333%}._1S7Yo-col-lg-pull-9{right:75%}._2CgOB-col-lg-pull-8{right:66.66666667%}._3Jv0L-col-lg-pull-7{right:58.33333333%}._1pHMz-col-lg-pull-6{right:50%}._2zvsy-col-lg-pull-5{right:41.66666667%}.sFsmY-col-lg-pull-4{right:33.33333333%}.Ui3Ia-col-lg-pull-3{right:25%}.WmgXv-col-lg-pull-2{right:16.66666667%}._2KMEm-col-lg-pull-1{right:8.33333333%}.QWRTc-col-lg-pull-0{right:auto}._2FOMW-col-lg-push-12{left:100%}.IdWzr-col-lg-push-11{left:91.66666667%}._3qw3b-col-lg-push-10{left:83.33333333%}._3LXj0-col-lg-push-9{left:75%}._16qiV-col-lg-push-8{left:66.66666667%}._2OQk6-col-lg-push-7{left:58.33333333%}._3o1se-col-lg-push-6{left:50%}._2dmyK-col-lg-push-5{left:41.66666667%}._3pru9-col-lg-push-4{left:33.33333333%}._2lBUY-col-lg-push-3{left:25%}._3FuDW-col-lg-push-2{left:16.66666667%}._2YWH--col-lg-push-1{left:8.33333333%}._1iVNw-col-lg-push-0{left:auto}.uQRYD-col-lg-offset-12{margin-left:100%}._1tWxb-col-lg-offset-11{margin-left:91.66666667%}._10I1r-col-lg-offset-10{margin-left:83.33333333%}._3zXRb-col-lg-offset-9{margin-left:75%}._3HNmx-col-lg-offset-8{margin-left:66.66666667%}._3mIkn-col-lg-offset-7{margin-left:58.33333333%}._22SUB-col-lg-offset-6{margin-left:50%}._1CR4c-col-lg-offset-5{margin-left:41.66666667%}._1AWkt-col-lg-offset-4{margin-left:33.33333333%}._2BzzA-col-lg-offset-3{margin-left:25%}._3Egxi-col-lg-offset-2{margin-left:16.66666667%}._3QHjG-col-lg-offset-1{margin-left:8.33333333%}._3jTkc-col-lg-offset-0{margin-left:0}}table{background-color:transparent}caption{padding-top:8px;padding-bottom:8px;color:#777;text-align:left}th{text-align:left}.fHH5l-table{width:100%;max-width:100%;margin-bottom:20px}.fHH5l-table>thead>tr>th,.fHH5l-table>tbody>tr>th,.fHH5l-table>tfoot>tr>th,.fHH5l-table>thead>tr>td,.fHH5l-table>tbody>tr>td,.fHH5l-table>tfoot>tr>td{padding:8px;line-height:1.42857143;vertical-align:top;border-top:1px solid #ddd}.fHH5l-table>thead>tr>th{vertical-align:bottom;border-bottom:2px solid #ddd}.fHH5l-table>caption+thead>tr:first-child>th,.fHH5l-table>colgroup+thead>tr:first-child>th,.fHH5l-table>thead:first-child>tr:first-child>th,.fHH5l-table>caption+thead>tr:first-child>td,.fHH5l-table>colgroup+thead>tr:first-child>td,.fHH5l-table>thead:first-child>tr:first-child>td{border-top:0}.fHH5l-table>tbody+tbody{border-top:2px solid #ddd}.fHH5l-table .fHH5l-table{background-color:#fff}._1lZ-G-table-condensed>thead>tr>th,._1lZ-G-table-condensed>tbody>tr>th,._1lZ-G-table-condensed>tfoot>tr>th,._1lZ-G-table-condensed>thead>tr>td,._1lZ-G-table-condensed>tbody>tr>td,._1lZ-G-table-condensed>tfoot>tr>td{padding:5px}._3qUkR-table-bordered{border:1px solid #ddd}._3qUkR-table-bordered>thead>tr>th,._3qUkR-table-bordered>tbody>tr>th,._3qUkR-table-bordered>tfoot>tr>th,._3qUkR-table-bordered>thead>tr>td,._3qUkR-table-bordered>tbody>tr>td,._3qUkR-table-bordered>tfoot>tr>td{border:1px solid #ddd}._3qUkR-table-bordered>thead>tr>th,._3qUkR-table-bordered>thead>tr>td{border-bottom-width:2px}._2ysFO-table-striped>tbody>tr:nth-of-type(odd){background-color:#f9f9f9}._3Qd5Z-table-hover>tbody>tr:hover{background-color:#f5f5f5}table col[class*="col-"]{position:static;float:none;display:table-column}table td[class*="col-"],table th[class*="col-"]{position:static;float:none;display:table-cell}.fHH5l-table>thead>tr>td._2tvYY-active,.fHH5l-table>tbody>tr>td._2tvYY-active,.fHH5l-table>tfoot>tr>td._2tvYY-active,.fHH5l-table>thead>tr>th._2tvYY-active,.fHH5l-table>tbody>tr>th._2tvYY-active,.fHH5l-table>tfoot>tr>th._2tvYY-active,.fHH5l-table>thead>tr._2tvYY-active>td,.fHH5l-table>tbody>tr._2tvYY-active>td,.fHH5l-table>tfoot>tr._2tvYY-active>td,.fHH5l-table>thead>tr._2tvYY-active>th,.fHH5l-table>tbody>tr._2tvYY-active>th,.fHH5l-table>tfoot>tr._2tvYY-active>th{background-color:#f5f5f5}._3Qd5Z-table-hover>tbody>tr>td._2tvYY-active:hover,._3Qd5Z-table-hover>tbody>tr>th._2tvYY-active:hover,._3Qd5Z-table-hover>tbody>tr._2tvYY-active:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2tvYY-active,._3Qd5Z-table-hover>tbody>tr._2tvYY-active:hover>th{background-color:#e8e8e8}.fHH5l-table>thead>tr>td._2OgG2-success,.fHH5l-table>tbody>tr>td._2OgG2-success,.fHH5l-table>tfoot>tr>td._2OgG2-success,.fHH5l-table>thead>tr>th._2OgG2-success,.fHH5l-table>tbody>tr>th._2OgG2-success,.fHH5l-table>tfoot>tr>th._2OgG2-success,.fHH5l-table>thead>tr._2OgG2-success>td,.fHH5l-table>tbody>tr._2OgG2-success>td,.fHH5l-table>tfoot>tr._2OgG2-success>td,.fHH5l-table>thead>tr._2OgG2-success>th,.fHH5l-table>tbody>tr._2OgG2-success>th,.fHH5l-table>tfoot>tr._2OgG2-success>th{background-color:#dff0d8}._3Qd5Z-table-hover>tbody>tr>td._2OgG2-success:hover,._3Qd5Z-table-hover>tbody>tr>th._2OgG2-success:hover,._3Qd5Z-table-hover>tbody>tr._2OgG2-success:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2OgG2-success,._3Qd5Z-table-hover>tbody>tr._2OgG2-success:hover>th{background-color:#d0e9c6}.fHH5l-table>thead>tr>td._2ItI5-info,.fHH5l-table>tbody>tr>td._2ItI5-info,.fHH5l-table>tfoot>tr>td._2ItI5-info,.fHH5l-table>thead>tr>th._2ItI5-info,.fHH5l-table>tbody>tr>th._2ItI5-info,.fHH5l-table>tfoot>tr>th._2ItI5-info,.fHH5l-table>thead>tr._2ItI5-info>td,.fHH5l-table>tbody>tr._2ItI5-info>td,.fHH5l-table>tfoot>tr._2ItI5-info>td,.fHH5l-table>thead>tr._2ItI5-info>th,.fHH5l-table>tbody>tr._2ItI5-info>th,.fHH5l-table>tfoot>tr._2ItI5-info>th{background-color:#d9edf7}._3Qd5Z-table-hover>tbody>tr>td._2ItI5-info:hover,._3Qd5Z-table-hover>tbody>tr>th._2ItI5-info:hover,._3Qd5Z-table-hover>tbody>tr._2ItI5-info:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2ItI5-info,._3Qd5Z-table-hover>tbody>tr._2ItI5-info:hover>th{background-color:#c4e3f3}.fHH5l-table>thead>tr>td.KrRM8-warning,.fHH5l-table>tbody>tr>td.KrRM8-warning,.fHH5l-table>tfoot>tr>td.KrRM8-warning,.fHH5l-table>thead>tr>th.KrRM8-warning,.fHH5l-table>tbody>tr>th.KrRM8-warning,.fHH5l-table>tfoot>tr>th.KrRM8-warning,.fHH5l-table>thead>tr.KrRM8-warning>td,.fHH5l-table>tbody>tr.KrRM8-warning>td,.fHH5l-table>tfoot>tr.KrRM8-warning>td,.fHH5l-table>thead>tr.KrRM8-warning>th,.fHH5l-table>tbody>tr.KrRM8-warning>th,.fHH5l-table>tfoot>tr.KrRM8-warning>th{background-color:#fcf8e3}._3Qd5Z-table-hover>tbody>tr>td.KrRM8-warning:hover,._3Qd5Z-table-hover>tbody>tr>th.KrRM8-warning:hover,._3Qd5Z-table-hover>tbody>tr.KrRM8-warning:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>.KrRM8-warning,._3Qd5Z-table-hover>tbody>tr.KrRM8-warning:hover>th{background-color:#faf2cc}.fHH5l-table>thead>tr>td.CwCgN-danger,.fHH5l-table>tbody>tr>td.CwCgN-danger,.fHH5l-table>tfoot>tr>td.CwCgN-danger,.fHH5l-table>thead>tr>th.CwCgN-danger,.fHH5l-table>tbody>tr>th.CwCgN-danger,.fHH5l-table>tfoot>tr>th.CwCgN-danger,.fHH5l-table>thead>tr.CwCgN-danger>td,.fHH5l-table>tbody>tr.CwCgN-danger>td,.fHH5l-table>tfoot>tr.CwCgN-danger>td,.fHH5l-table>thead>tr.CwCgN-danger>th,.fHH5l-table>tbody>tr.CwCgN-danger>th,.fHH5l-table>tfoot>tr.CwCgN-danger>th{background-color:#f2dede}._3Qd5Z-table-hover>tbody>tr>td.CwCgN-danger:hover,._3Qd5Z-table-hover>tbody>tr>th.CwCgN-danger:hover,._3Qd5Z-table-hover>tbody>tr.CwCgN-danger:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>.CwCgN-danger,._3Qd5Z-table-hover>tbody>tr.CwCgN-danger:hover>th{background-color:#ebcccc}._3oGxj-table-responsive{overflow-x:auto;min-height:0.01%}@media screen and (max-width:767px){._3oGxj-table-responsive{width:100%;margin-bottom:15px;overflow-y:hidden;-ms-overflow-style:-ms-autohiding-scrollbar;border:1px solid #ddd}._3oGxj-table-responsive>.fHH5l-table{margin-bottom:0}._3oGxj-table-responsive>.fHH5l-table>thead>tr>th,._3oGxj-table-responsive>.fHH5l-table>tbody>tr>th,._3oGxj-table-responsive>.fHH5l-table>tfoot>tr>th,._3oGxj-table-responsive>.fHH5l-table>thead>tr>td,._3oGxj-table-responsive>.fHH5l-table>tbody>tr>td,._3oGxj-table-responsive>.fHH5l-table>tfoot>tr>td{white-space:nowrap}._3oGxj-table-responsive>._3qUkR-table-bordered{border:0}._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:first-child{border-left:0}._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:last-child{border-right:0}._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>th,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>th,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>td,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>td{border-bottom:0}}._3-XUl-btn{display:inline-block;margin-bottom:0;font-weight:normal;text-align:center;vertical-align:middle;-ms-touch-action:manipulation;touch-action:manipulation;cursor:pointer;background-image:none;border:1px solid transparent;white-space:nowrap;padding:6px 12px;font-size:14px;line-height:1.42857143;border-radius:4px;-webkit-user-select:none;-moz-user-select:none;-ms-user-select:none;user-select:none}._3-XUl-btn:focus,._3-XUl-btn:active:focus,._3-XUl-btn._2tvYY-active:focus,._3-XUl-btn._2eLCD-focus,._3-XUl-btn:active._2eLCD-focus,._3-XUl-btn._2tvYY-active._2eLCD-focus{outline:thin dotted;outline:5px auto -webkit-focus-ring-color;outline-offset:-2px}._3-XUl-btn:hover,._3-XUl-btn:focus,._3-XUl-btn._2eLCD-focus{color:#333;text-decoration:none}._3-XUl-btn:active,._3-XUl-btn._2tvYY-active{outline:0;background-image:none;-webkit-box-shadow:inset 0 3px 5px rgba(0,0,0,0.125);box-shadow:inset 0 3px 5px rgba(0,0,0,0.125)}._3-XUl-btn._2UPmc-disabled,._3-XUl-btn[disabled],fieldset[disabled] ._3-XUl-btn{cursor:not-allowed;opacity:.65;filter:alpha(opacity=65);-webkit-box-shadow:none;box-shadow:none}a._3-XUl-btn._2UPmc-disabled,fieldset[disabled] a._3-XUl-btn{pointer-events:none}._7-4ed-btn-default{color:#333;background-color:#fff;border-color:#ccc}._7-4ed-btn-default:focus,._7-4ed-btn-default._2eLCD-focus{color:#333;background-color:#e6e6e6;border-color:#8c8c8c}._7-4ed-btn-default:hover{color:#333;background-color:#e6e6e6;border-color:#adadad}._7-4ed-btn-default:active,._7-4ed-btn-default._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default{color:#333;background-color:#e6e6e6;border-color:#adadad}._7-4ed-btn-default:active:hover,._7-4ed-btn-default._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default:hover,._7-4ed-btn-default:active:focus,._7-4ed-btn-default._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default:focus,._7-4ed-btn-default:active._2eLCD-focus,._7-4ed-btn-default._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default._2eLCD-focus{color:#333;background-color:#d4d4d4;border-color:#8c8c8c}._7-4ed-btn-default:active,._7-4ed-btn-default._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default{background-image:none}._7-4ed-btn-default._2UPmc-disabled:hover,._7-4ed-btn-default[disabled]:hover,fieldset[disabled] ._7-4ed-btn-default:hover,._7-4ed-btn-default._2UPmc-disabled:focus,._7-4ed-btn-default[disabled]:focus,fieldset[disabled] ._7-4ed-btn-default:focus,._7-4ed-btn-default._2UPmc-disabled._2eLCD-focus,._7-4ed-btn-default[disabled]._2eLCD-focus,fieldset[disabled] ._7-4ed-btn-default._2eLCD-focus{background-color:#fff;border-color:#ccc}._7-4ed-btn-default ._1XX3z-badge{color:#fff;background-color:#333}._1VbPL-btn-primary{color:#fff;background-color:#337ab7;border-color:#2e6da4}._1VbPL-btn-primary:focus,._1VbPL-btn-primary._2eLCD-focus{color:#fff;background-color:#286090;border-color:#122b40}._1VbPL-btn-primary:hover{color:#fff;background-color:#286090;border-color:#204d74}._1VbPL-btn-primary:active,._1VbPL-btn-primary._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary{color:#fff;background-color:#286090;border-color:#204d74}._1VbPL-btn-primary:active:hover,._1VbPL-btn-primary._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary:hover,._1VbPL-btn-primary:active:focus,._1VbPL-btn-primary._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary:focus,._1VbPL-btn-primary:active._2eLCD-focus,._1VbPL-btn-primary._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary._2eLCD-focus{color:#fff;background-color:#204d74;border-color:#122b40}._1VbPL-btn-primary:active,._1VbPL-btn-primary._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary{background-image:none}._1VbPL-btn-primary._2UPmc-disabled:hover,._1VbPL-btn-primary[disabled]:hover,fieldset[disabled] ._1VbPL-btn-primary:hover,._1VbPL-btn-primary._2UPmc-disabled:focus,._1VbPL-btn-primary[disabled]:focus,fieldset[disabled] ._1VbPL-btn-primary:focus,._1VbPL-btn-primary._2UPmc-disabled._2eLCD-focus,._1VbPL-btn-primary[disabled]._2eLCD-focus,fieldset[disabled] ._1VbPL-btn-primary._2eLCD-focus{background-color:#337ab7;border-color:#2e6da4}._1VbPL-btn-primary ._1XX3z-badge{color:#337ab7;background-color:#fff}._1P0kt-btn-success{color:#fff;background-color:#5cb85c;border-color:#4cae4c}._1P0kt-btn-success:focus,._1P0kt-btn-success._2eLCD-focus{color:#fff;background-color:#449d44;border-color:#255625}._1P0kt-btn-success:hover{color:#fff;background-color:#449d44;border-color:#398439}._1P0kt-btn-success:active,._1P0kt-btn-success._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success{color:#fff;background-color:#449d44;border-color:#398439}._1P0kt-btn-success:active:hover,._1P0kt-btn-success._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success:hover,._1P0kt-btn-success:active:focus,._1P0kt-btn-success._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success:focus,._1P0kt-btn-success:active._2eLCD-focus,._1P0kt-btn-success._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success._2eLCD-focus{color:#fff;background-color:#398439;border-color:#255625}._1P0kt-btn-success:active,._1P0kt-btn-success._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success{background-image:none}._1P0kt-btn-success._2UPmc-disabled:hover,._1P0kt-btn-success[disabled]:hover,fieldset[disabled] ._1P0kt-btn-success:hover,._1P0kt-btn-success._2UPmc-disabled:focus,._1P0kt-btn-success[disabled]:focus,fieldset[disabled] ._1P0kt-btn-success:focus,._1P0kt-btn-success._2UPmc-disabled._2eLCD-focus,._1P0kt-btn-success[disabled]._2eLCD-focus,fieldset[disabled] ._1P0kt-btn-success._2eLCD-focus{background-color:#5cb85c;border-color:#4cae4c}._1P0kt-btn-success ._1XX3z-badge{color:#5cb85c;background-color:#fff}._25yw2-btn-info{color:#fff;background-color:#5bc0de;border-color:#46b8da}._25yw2-btn-info:focus,._25yw2-btn-info._2eLCD-focus{color:#fff;background-color:#31b0d5;border-color:#1b6d85}._25yw2-btn-info:hover{color:#fff;background-color:#31b0d5;border-color:#269abc}._25yw2-btn-info:active,._25yw2-btn-info._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info{color:#fff;background-color:#31b0d5;border-color:#269abc}._25yw2-btn-info:active:hover,._25yw2-btn-info._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info:hover,._25yw2-btn-info:active:focus,._25yw2-btn-info._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info:focus,._25yw2-btn-info:active._2eLCD-focus,._25yw2-btn-info._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info._2eLCD-focus{color:#fff;background-color:#269abc;border-color:#1b6d85}._25yw2-btn-info:active,._25yw2-btn-info._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info{background-image:none}._25yw2-btn-info._2UPmc-disabled:hover,._25yw2-btn-info[disabled]:hover,fieldset[disabled] ._25yw2-btn-info:hover,._25yw2-btn-info._2UPmc-disabled:focus,._25yw2-btn-info[disabled]:focus,fieldset[disabled] ._25yw2-btn-info:focus,._25yw2-btn-info._2UPmc-disabled._2eLCD-focus,._25yw2-btn-info[disabled]._2eLCD-focus,fieldset[disabled] ._25yw2-btn-info._2eLCD-focus{background-color:#5bc0de;border-color:#46b8da}._25yw2-btn-info ._1XX3z-badge{color:#5bc0de;background-color:#fff}.-tgFO-btn-warning{color:#fff;background-color:#f0ad4e;border-color:#eea236}.-tgFO-btn-warning:focus,.-tgFO-btn-warning._2eLCD-focus{color:#fff;background-color:#ec971f;border-color:#985f0d}.-tgFO-btn-warning:hover{color:#fff;background-color:#ec971f;border-color:#d58512}.-tgFO-btn-warning:active,.-tgFO-btn-warning._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning{color:#fff;background-color:#ec971f;border-color:#d58512}.-tgFO-btn-warning:active:hover,.-tgFO-btn-warning._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning:hover,.-tgFO-btn-warning:active:focus,.-tgFO-btn-warning._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning:focus,.-tgFO-btn-warning:active._2eLCD-focus,.-tgFO-btn-warning._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning._2eLCD-focus{color:#fff;background-color:#d58512;border-color:#985f0d}.-tgFO-btn-warning:active,.-tgFO-btn-warning._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning{background-image:none}.-tgFO-btn-warning._2UPmc-disabled:hover,.-tgFO-btn-warning[disabled]:hover,fieldset[disabled] .-tgFO-btn-warning:hover,.-tgFO-btn-warning._2UPmc-disabled:focus,.-tgFO-btn-warning[disabled]:focus,fieldset[disabled] .-tgFO-btn-warning:focus,.-tgFO-btn-warning._2UPmc-disabled._2eLCD-focus,.-tgFO-btn-warning[disabled]._2eLCD-focus,fieldset[disabled] .-tgFO-btn-warning._2eLCD-focus{background-color:#f0ad4e;border-color:#eea236}.-tgFO-btn-warning ._1XX3z-badge{color:#f0ad4e;background-color:#fff}._1fOWb-btn-danger{color:#fff;background-color:#d9534f;border-color:#d43f3a}._1fOWb-btn-danger:focus,._1fOWb-btn-danger._2eLCD-focus{color:#fff;background-color:#c9302c;border-color:#761c19}._1fOWb-btn-danger:hover{color:#fff;background-color:#c9302c;border-color:#ac2925}._1fOWb-btn-danger:active,._1fOWb-btn-danger._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger{color:#fff;background-color:#c9302c;border-color:#ac2925}._1fOWb-btn-danger:active:hover,._1fOWb-btn-danger._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger:hover,._1fOWb-btn-danger:active:focus,._1fOWb-btn-danger._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger:focus,._1fOWb-btn-danger:active._2eLCD-focus,._1fOWb-btn-danger._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger._2eLCD-focus{color:#fff;background-color:#ac2925;border-color:#761c19}._1fOWb-btn-danger:active,._1fOWb-btn-danger._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger{background-image:none}._1fOWb-btn-danger._2UPmc-disabled:hover,._1fOWb-btn-danger[disabled]:hover,fieldset[disabled] ._1fOWb-btn-danger:hover,._1fOWb-btn-danger._2UPmc-disabled:focus,._1fOWb-btn-danger[disabled]:focus,fieldset[disabled] ._1fOWb-btn-danger:focus,._1fOWb-btn-danger._2UPmc-disabled._2eLCD-focus,._1fOWb-btn-danger[disabled]._2eLCD-focus,fieldset[disabled] ._1fOWb-btn-danger._2eLCD-focus{background-color:#d9534f;border-color:#d43f3a}._1fOWb-btn-danger ._1XX3z-badge{color:#d9534f;background-color:#fff}._2k5vU-btn-link{color:#337ab7;font-weight:normal;border-radius:0}._2k5vU-btn-link,._2k5vU-btn-link:active,._2k5vU-btn-link._2tvYY-active,._2k5vU-btn-link[disabled],fieldset[disabled] ._2k5vU-btn-link{background-color:transparent;-webkit-box-shadow:none;box-shadow:none}._2k5vU-btn-link,._2k5vU-btn-link:hover,._2k5vU-btn-link:focus,._2k5vU-btn-link:active{border-color:transparent}._2k5vU-btn-link:hover,._2k5vU-btn-link:focus{color:#23527c;text-decoration:underline;background-color:transparent}._2k5vU-btn-link[disabled]:hover,fieldset[disabled] ._2k5vU-btn-link:hover,._2k5vU-btn-link[disabled]:focus,fieldset[disabled] ._2k5vU-btn-link:focus{color:#777;text-decoration:none}._4Y8qI-btn-lg,._29CyS-btn-group-lg>._3-XUl-btn{padding:10px 16px;font-size:18px;line-height:1.3333333;border-radius:6px}._30Oip-btn-sm,._2eEOC-btn-group-sm>._3-XUl-btn{padding:5px 10px;font-size:12px;line-height:1.5;border-radius:3px}._2kCvE-btn-xs,._1A2Tx-btn-group-xs>._3-XUl-btn{padding:1px 5px;font-size:12px;line-height:1.5;border-radius:3px}._3micb-btn-block{display:block;width:100%}._3micb-btn-block+._3micb-btn-block{margin-top:5px}input[type="submit"]._3micb-btn-block,input[type="reset"]._3micb-btn-block,input[type="button"]._3micb-btn-block{width:100%}._2yrMO-btn-group,.eNKyK-btn-group-vertical{position:relative;display:inline-block;vertical-align:middle}._2yrMO-btn-group>._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn{position:relative;float:left}._2yrMO-btn-group>._3-XUl-btn:hover,.eNKyK-btn-group-vertical>._3-XUl-btn:hover,._2yrMO-btn-group>._3-XUl-btn:focus,.eNKyK-btn-group-vertical>._3-XUl-btn:focus,._2yrMO-btn-group>._3-XUl-btn:active,.eNKyK-btn-group-vertical>._3-XUl-btn:active,._2yrMO-btn-group>._3-XUl-btn._2tvYY-active,.eNKyK-btn-group-vertical>._3-XUl-btn._2tvYY-active{z-index:2}._2yrMO-btn-group ._3-XUl-btn+._3-XUl-btn,._2yrMO-btn-group ._3-XUl-btn+._2yrMO-btn-group,._2yrMO-btn-group ._2yrMO-btn-group+._3-XUl-btn,._2yrMO-btn-group ._2yrMO-btn-group+._2yrMO-btn-group{margin-left:-1px}._1TX6t-btn-toolbar{margin-left:-5px}._1TX6t-btn-toolbar ._3-XUl-btn,._1TX6t-btn-toolbar ._2yrMO-btn-group,._1TX6t-btn-toolbar ._2lgpZ-input-group{float:left}._1TX6t-btn-toolbar>._3-XUl-btn,._1TX6t-btn-toolbar>._2yrMO-btn-group,._1TX6t-btn-toolbar>._2lgpZ-input-group{margin-left:5px}._2yrMO-btn-group>._3-XUl-btn:not(:first-child):not(:last-child):not(._2KnYE-dropdown-toggle){border-radius:0}._2yrMO-btn-group>._3-XUl-btn:first-child{margin-left:0}._2yrMO-btn-group>._3-XUl-btn:first-child:not(:last-child):not(._2KnYE-dropdown-toggle){border-bottom-right-radius:0;border-top-right-radius:0}._2yrMO-btn-group>._3-XUl-btn:last-child:not(:first-child),._2yrMO-btn-group>._2KnYE-dropdown-toggle:not(:first-child){border-bottom-left-radius:0;border-top-left-radius:0}._2yrMO-btn-group>._2yrMO-btn-group{float:left}._2yrMO-btn-group>._2yrMO-btn-group:not(:first-child):not(:last-child)>._3-XUl-btn{border-radius:0}._2yrMO-btn-group>._2yrMO-btn-group:first-child:not(:last-child)>._3-XUl-btn:last-child,._2yrMO-btn-group>._2yrMO-btn-group:first-child:not(:last-child)>._2KnYE-dropdown-toggle{border-bottom-right-radius:0;border-top-right-radius:0}._2yrMO-btn-group>._2yrMO-btn-group:last-child:not(:first-child)>._3-XUl-btn:first-child{border-bottom-left-radius:0;border-top-left-radius:0}._2yrMO-btn-group ._2KnYE-dropdown-toggle:active,._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle{outline:0}._2yrMO-btn-group>._3-XUl-btn+._2KnYE-dropdown-toggle{padding-left:8px;padding-right:8px}._2yrMO-btn-group>._4Y8qI-btn-lg+._2KnYE-dropdown-toggle{padding-left:12px;padding-right:12px}._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle{-webkit-box-shadow:inset 0 3px 5px rgba(0,0,0,0.125);box-shadow:inset 0 3px 5px rgba(0,0,0,0.125)}._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle._2k5vU-btn-link{-webkit-box-shadow:none;box-shadow:none}._3-XUl-btn .WfXRY-caret{margin-left:0}._4Y8qI-btn-lg .WfXRY-caret{border-width:5px 5px 0;border-bottom-width:0}.xQM4X-dropup ._4Y8qI-btn-lg .WfXRY-caret{border-width:0 5px 5px}.eNKyK-btn-group-vertical>._3-XUl-btn,.eNKyK-btn-group-vertical>._2yrMO-btn-group,.eNKyK-btn-group-vertical>._2yrMO-btn-group>._3-XUl-btn{display:block;float:none;width:100%;max-width:100%}.eNKyK-btn-group-vertical>._2yrMO-btn-group>._3-XUl-btn{float:none}.eNKyK-btn-group-vertical>._3-XUl-btn+._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn+._2yrMO-btn-group,.eNKyK-btn-group-vertical>._2yrMO-btn-group+._3-XUl-btn,.eNKyK-btn-group-vertical>._2yrMO-btn-group+._2yrMO-btn-group{margin-top:-1px;margin-left:0}.eNKyK-btn-group-vertical>._3-XUl-btn:not(:first-child):not(:last-child){border-radius:0}.eNKyK-btn-group-vertical>._3-XUl-btn:first-child:not(:last-child){border-top-right-radius:4px;border-top-left-radius:4px;border-bottom-right-radius:0;border-bottom-left-radius:0}.eNKyK-btn-group-vertical>._3-XUl-btn:last-child:not(:first-child){border-top-right-radius:0;border-top-left-radius:0;border-bottom-right-radius:4px;border-bottom-left-radius:4px}.eNKyK-btn-group-vertical>._2yrMO-btn-group:not(:first-child):not(:last-child)>._3-XUl-btn{border-radius:0}.eNKyK-btn-group-vertical>._2yrMO-btn-group:first-child:not(:last-child)>._3-XUl-btn:last-child,.eNKyK-btn-group-vertical>._2yrMO-btn-group:first-child:not(:last-child)>._2KnYE-dropdown-toggle{border-bottom-right-radius:0;border-bottom-left-radius:0}.eNKyK-btn-group-vertical>._2yrMO-btn-group:last-child:not(:first-child)>._3-XUl-btn:first-child{border-top-right-radius:0;border-top-left-radius:0}.gzM9c-btn-group-justified{display:table;width:100%;table-layout:fixed;border-collapse:separate}.gzM9c-btn-group-justified>._3-XUl-btn,.gzM9c-btn-group-justified>._2yrMO-btn-group{float:none;display:table-cell;width:1%}.gzM9c-btn-group-justified>._2yrMO-btn-group ._3-XUl-btn{width:100%}.gzM9c-btn-group-justified>._2yrMO-btn-group ._5aG9w-dropdown-menu{left:auto}[data-toggle="buttons"]>._3-XUl-btn input[type="radio"],[data-toggle="buttons"]>._2yrMO-btn-group>._3-XUl-btn input[type="radio"],[data-toggle="buttons"]>._3-XUl-btn input[type="checkbox"],[data-toggle="buttons"]>._2yrMO-btn-group>._3-XUl-btn input[type="checkbox"]{position:absolute;clip:rect(0, 0, 0, 0);pointer-events:none}._18V3M-nav{margin-bottom:0;padding-left:0;list-style:none}._18V3M-nav>li{position:relative;display:block}._18V3M-nav>li>a{position:relative;display:block;padding:10px 15px}._18V3M-nav>li>a:hover,._18V3M-nav>li>a:focus{text-decoration:none;background-color:#eee}._18V3M-nav>li._2UPmc-disabled>a{color:#777}._18V3M-nav>li._2UPmc-disabled>a:hover,._18V3M-nav>li._2UPmc-disabled>a:focus{color:#777;text-decoration:none;background-color:transparent;cursor:not-allowed}._18V3M-nav .cl_nk-open>a,._18V3M-nav .cl_nk-open>a:hover,._18V3M-nav .cl_nk-open>a:focus{background-color:#eee;border-color:#337ab7}._18V3M-nav ._3jwhe-nav-divider{height:1px;margin:9px 0;overflow:hidden;background-color:#e5e5e5}._18V3M-nav>li>a>img{max-width:none}._2ciYP-nav-tabs{border-bottom:1px solid #ddd}._2ciYP-nav-tabs>li{float:left;margin-bottom:-1px}._2ciYP-nav-tabs>li>a{margin-right:2px;line-height:1.42857143;border:1px solid transparent;border-radius:4px 4px 0 0}._2ciYP-nav-tabs>li>a:hover{border-color:#eee #eee #ddd}._2ciYP-nav-tabs>li._2tvYY-active>a,._2ciYP-nav-tabs>li._2tvYY-active>a:hover,._2ciYP-nav-tabs>li._2tvYY-active>a:focus{color:#555;background-color:#fff;border:1px solid #ddd;border-bottom-color:transparent;cursor:default}._2ciYP-nav-tabs._3nLJA-nav-justified{width:100%;border-bottom:0}._2ciYP-nav-tabs._3nLJA-nav-justified>li{float:none}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{text-align:center;margin-bottom:5px}._2ciYP-nav-tabs._3nLJA-nav-justified>._3oGVD-dropdown ._5aG9w-dropdown-menu{top:auto;left:auto}@media (min-width:768px){._2ciYP-nav-tabs._3nLJA-nav-justified>li{display:table-cell;width:1%}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-bottom:0}}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-right:0;border-radius:4px}._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:hover,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:focus{border:1px solid #ddd}@media (min-width:768px){._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{border-bottom:1px solid #ddd;border-radius:4px 4px 0 0}._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:hover,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:focus{border-bottom-color:#fff}}._3i5kW-nav-pills>li{float:left}._3i5kW-nav-pills>li>a{border-radius:4px}._3i5kW-nav-pills>li+li{margin-left:2px}._3i5kW-nav-pills>li._2tvYY-active>a,._3i5kW-nav-pills>li._2tvYY-active>a:hover,._3i5kW-nav-pills>li._2tvYY-active>a:focus{color:#fff;background-color:#337ab7}._3pfVZ-nav-stacked>li{float:none}._3pfVZ-nav-stacked>li+li{margin-top:2px;margin-left:0}._3nLJA-nav-justified{width:100%}._3nLJA-nav-justified>li{float:none}._3nLJA-nav-justified>li>a{text-align:center;margin-bottom:5px}._3nLJA-nav-justified>._3oGVD-dropdown ._5aG9w-dropdown-menu{top:auto;left:auto}@media (min-width:768px){._3nLJA-nav-justified>li{display:table-cell;width:1%}._3nLJA-nav-justified>li>a{margin-bottom:0}}._18sQL-nav-tabs-justified{border-bottom:0}._18sQL-nav-tabs-justified>li>a{margin-right:0;border-radius:4px}._18sQL-nav-tabs-justified>._2tvYY-active>a,._18sQL-nav-tabs-justified>._2tvYY-active>a:hover,._18sQL-nav-tabs-justified>._2tvYY-active>a:focus{border:1px solid #ddd}@media (min-width:768px){._18sQL-nav-tabs-justified>li>a{border-bottom:1px solid #ddd;border-radius:4px 4px 0 0}._18sQL-nav-tabs-justified>._2tvYY-active>a,._18sQL-nav-tabs-justified>._2tvYY-active>a:hover,._18sQL-nav-tabs-justified>._2tvYY-active>a:focus{border-bottom-color:#fff}}.FgbPD-tab-content>._2q1VF-tab-pane{display:none}.FgbPD-tab-content>._2tvYY-active{display:block}._2ciYP-nav-tabs ._5aG9w-dropdown-menu{margin-top:-1px;border-top-right-radius:0;border-top-left-radius:0}.n15Mn-pagination{display:inline-block;padding-left:0;margin:20px 0;border-radius:4px}.n15Mn-pagination>li{display:inline}.n15Mn-pagination>li>a,.n15Mn-pagination>li>span{position:relative;float:left;padding:6px 12px;line-height:1.42857143;text-decoration:none;color:#337ab7;background-color:#fff;border:1px solid #ddd;margin-left:-1px}.n15Mn-pagination>li:first-child>a,.n15Mn-pagination>li:first-child>span{margin-left:0;border-bottom-left-radius:4px;border-top-left-radius:4px}.n15Mn-pagination>li:last-child>a,.n15Mn-pagination>li:last-child>span{border-bottom-right-radius:4px;border-top-right-radius:4px}.n15Mn-pagination>li>a:hover,.n15Mn-pagination>li>span:hover,.n15Mn-pagination>li>a:focus,.n15Mn-pagination>li>span:focus{z-index:2;color:#23527c;background-color:#eee;border-color:#ddd}.n15Mn-pagination>._2tvYY-active>a,.n15Mn-pagination>._2tvYY-active>span,.n15Mn-pagination>._2tvYY-active>a:hover,.n15Mn-pagination>._2tvYY-active>span:hover,.n15Mn-pagination>._2tvYY-active>a:focus,.n15Mn-pagination>._2tvYY-active>span:focus{z-index:3;color:#fff;background-color:#337ab7;border-color:#337ab7;cursor:default}.n15Mn-pagination>._2UPmc-disabled>span,.n15Mn-pagination>._2UPmc-disabled>span:hover,.n15Mn-pagination>._2UPmc-disabled>span:focus,.n15Mn-pagination>._2UPmc-disabled>a,.n15Mn-pagination>._2UPmc-disabled>a:hover,.n15Mn-pagination>._2UPmc-disabled>a:focus{color:#777;background-color:#fff;border-color:#ddd;cursor:not-allowed}.L5I4g-pagination-lg>li>a,.L5I4g-pagination-lg>li>span{padding:10px 16px;font-size:18px;line-height:1.3333333}.L5I4g-pagination-lg>li:first-child>a,.L5I4g-pagination-lg>li:first-child>span{border-bottom-left-radius:6px;border-top-left-radius:6px}.L5I4g-pagination-lg>li:last-child>a,.L5I4g-pagination-lg>li:last-child>span{border-bottom-right-radius:6px;border-top-right-radius:6px}._3rY2q-pagination-sm>li>a,._3rY2q-pagination-sm>li>span{padding:5px 10px;font-size:12px;line-height:1.5}._3rY2q-pagination-sm>li:first-child>a,._3rY2q-pagination-sm>li:first-child>span{border-bottom-left-radius:3px;border-top-left-radius:3px}._3rY2q-pagination-sm>li:last-child>a,._3rY2q-pagination-sm>li:last-child>span{border-bottom-right-radius:3px;border-top-right-radius:3px}.bv_Mo-panel{margin-bottom:20px;background-color:#fff;border:1px solid transparent;border-radius:4px;-webkit-box-shadow:0 1px 1px rgba(0,0,0,0.05);box-shadow:0 1px 1px rgba(0,0,0,0.05)}._2wvLn-panel-body{padding:15px}._31mw8-panel-heading{padding:10px 15px;border-bottom:1px solid transparent;border-top-right-radius:3px;border-top-left-radius:3px}._31mw8-panel-heading>._3oGVD-dropdown ._2KnYE-dropdown-toggle{color:inherit}._112QQ-panel-title{margin-top:0;margin-bottom:0;font-size:16px;color:inherit}._112QQ-panel-title>a,._112QQ-panel-title>small,._112QQ-panel-title>._1xqEr-small,._112QQ-panel-title>small>a,._112QQ-panel-title>._1xqEr-small>a{color:inherit}._1qtb5-panel-footer{padding:10px 15px;background-color:#f5f5f5;border-top:1px solid #ddd;border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._1vMKW-list-group,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group{margin-bottom:0}.bv_Mo-panel>._1vMKW-list-group .rhZLN-list-group-item,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group .rhZLN-list-group-item{border-width:1px 0;border-radius:0}.bv_Mo-panel>._1vMKW-list-group:first-child .rhZLN-list-group-item:first-child,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group:first-child .rhZLN-list-group-item:first-child{border-top:0;border-top-right-radius:3px;border-top-left-radius:3px}.bv_Mo-panel>._1vMKW-list-group:last-child .rhZLN-list-group-item:last-child,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group:last-child .rhZLN-list-group-item:last-child{border-bottom:0;border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._31mw8-panel-heading+._162Ti-panel-collapse>._1vMKW-list-group .rhZLN-list-group-item:first-child{border-top-right-radius:0;border-top-left-radius:0}._31mw8-panel-heading+._1vMKW-list-group .rhZLN-list-group-item:first-child{border-top-width:0}._1vMKW-list-group+._1qtb5-panel-footer{border-top-width:0}.bv_Mo-panel>.fHH5l-table,.bv_Mo-panel>._3oGxj-table-responsive>.fHH5l-table,.bv_Mo-panel>._162Ti-panel-collapse>.fHH5l-table{margin-bottom:0}.bv_Mo-panel>.fHH5l-table caption,.bv_Mo-panel>._3oGxj-table-responsive>.fHH5l-table caption,.bv_Mo-panel>._162Ti-panel-collapse>.fHH5l-table caption{padding-left:15px;padding-right:15px}.bv_Mo-panel>.fHH5l-table:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child{border-top-right-radius:3px;border-top-left-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child{border-top-left-radius:3px;border-top-right-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child td:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:first-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child th:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:first-child{border-top-left-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child td:last-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:last-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child th:last-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:last-child{border-top-right-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child{border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child{border-bottom-left-radius:3px;border-bottom-right-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:first-child{border-bottom-left-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:last-child{border-bottom-right-radius:3px}.bv_Mo-panel>._2wvLn-panel-body+.fHH5l-table,.bv_Mo-panel>._2wvLn-panel-body+._3oGxj-table-responsive,.bv_Mo-panel>.fHH5l-table+._2wvLn-panel-body,.bv_Mo-panel>._3oGxj-table-responsive+._2wvLn-panel-body{border-top:1px solid #ddd}.bv_Mo-panel>.fHH5l-table>tbody:first-child>tr:first-child th,.bv_Mo-panel>.fHH5l-table>tbody:first-child>tr:first-child td{border-top:0}.bv_Mo-panel>._3qUkR-table-bordered,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered{border:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:first-child{border-left:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:last-child{border-right:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr:first-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr:first-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:first-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:first-child>td,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr:first-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr:first-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:first-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:first-child>th{border-bottom:0}.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:last-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr:last-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:last-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr:last-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>th{border-bottom:0}.bv_Mo-panel>._3oGxj-table-responsive{border:0;margin-bottom:0}._2V8WR-panel-group{margin-bottom:20px}._2V8WR-panel-group .bv_Mo-panel{margin-bottom:0;border-radius:4px}._2V8WR-panel-group .bv_Mo-panel+.bv_Mo-panel{margin-top:5px}._2V8WR-panel-group ._31mw8-panel-heading{border-bottom:0}._2V8WR-panel-group ._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body,._2V8WR-panel-group ._31mw8-panel-heading+._162Ti-panel-collapse>._1vMKW-list-group{border-top:1px solid #ddd}._2V8WR-panel-group ._1qtb5-panel-footer{border-top:0}._2V8WR-panel-group ._1qtb5-panel-footer+._162Ti-panel-collapse ._2wvLn-panel-body{border-bottom:1px solid #ddd}._2diFa-panel-default{border-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading{color:#333;background-color:#f5f5f5;border-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading ._1XX3z-badge{color:#f5f5f5;background-color:#333}._2diFa-panel-default>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#ddd}._1I52t-panel-primary{border-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading{color:#fff;background-color:#337ab7;border-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading ._1XX3z-badge{color:#337ab7;background-color:#fff}._1I52t-panel-primary>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#337ab7}._1mEg2-panel-success{border-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading{color:#3c763d;background-color:#dff0d8;border-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading ._1XX3z-badge{color:#dff0d8;background-color:#3c763d}._1mEg2-panel-success>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#d6e9c6}._2rUCo-panel-info{border-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading{color:#31708f;background-color:#d9edf7;border-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading ._1XX3z-badge{color:#d9edf7;background-color:#31708f}._2rUCo-panel-info>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#bce8f1}._1ozkU-panel-warning{border-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading{color:#8a6d3b;background-color:#fcf8e3;border-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading ._1XX3z-badge{color:#fcf8e3;background-color:#8a6d3b}._1ozkU-panel-warning>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#faebcc}._3dZ2--panel-danger{border-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading{color:#a94442;background-color:#f2dede;border-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading ._1XX3z-badge{color:#f2dede;background-color:#a94442}._3dZ2--panel-danger>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#ebccd1}._2U5Vc-clearfix:before,._2U5Vc-clearfix:after,._2DZom-dl-horizontal dd:before,._2DZom-dl-horizontal dd:after,.GfVfY-container:before,.GfVfY-container:after,._1TgHS-container-fluid:before,._1TgHS-container-fluid:after,._3XjgV-row:before,._3XjgV-row:after,._1TX6t-btn-toolbar:before,._1TX6t-btn-toolbar:after,.eNKyK-btn-group-vertical>._2yrMO-btn-group:before,.eNKyK-btn-group-vertical>._2yrMO-btn-group:after,._18V3M-nav:before,._18V3M-nav:after,._2wvLn-panel-body:before,._2wvLn-panel-body:after{content:" ";display:table}._2U5Vc-clearfix:after,._2DZom-dl-horizontal dd:after,.GfVfY-container:after,._1TgHS-container-fluid:after,._3XjgV-row:after,._1TX6t-btn-toolbar:after,.eNKyK-btn-group-vertical>._2yrMO-btn-group:after,._18V3M-nav:after,._2wvLn-panel-body:after{clear:both}._1efU5-center-block{display:block;margin-left:auto;margin-right:auto}._1DwIR-pull-right{float:right !important}._3XXWK-pull-left{float:left !important}.mceZN-hide{display:none !important}._3CqPx-show{display:block !important}._2ZgjX-invisible{visibility:hidden}._1y8Wm-text-hide{font:0/0 a;color:transparent;text-shadow:none;background-color:transparent;border:0}._15mml-hidden{display:none !important}.-JzKj-affix{position:fixed}@-ms-viewport{width:device-width}._3Pjmx-visible-xs,.vFgce-visible-sm,._3qTZ9-visible-md,.tk8mW-visible-lg{display:none !important}._1Dhak-visible-xs-block,._2NJ-T-visible-xs-inline,._1rjwZ-visible-xs-inline-block,._2cGpj-visible-sm-block,._14OBx-visible-sm-inline,._1IBCe-visible-sm-inline-block,._1b70N-visible-md-block,._2FNrX-visible-md-inline,._1MCNX-visible-md-inline-block,.yzCgL-visible-lg-block,._1Ad52-visible-lg-inline,._2hCmO-visible-lg-inline-block{display:none !important}@media (max-width:767px){._3Pjmx-visible-xs{display:block !important}table._3Pjmx-visible-xs{display:table !important}tr._3Pjmx-visible-xs{display:table-row !important}th._3Pjmx-visible-xs,td._3Pjmx-visible-xs{display:table-cell !important}}@media (max-width:767px){._1Dhak-visible-xs-block{display:block !important}}@media (max-width:767px){._2NJ-T-visible-xs-inline{display:inline !important}}@media (max-width:767px){._1rjwZ-visible-xs-inline-block{display:inline-block !important}}@media (min-width:768px) and (max-width:991px){.vFgce-visible-sm{display:block !important}table.vFgce-visible-sm{display:table !important}tr.vFgce-visible-sm{display:table-row !important}th.vFgce-visible-sm,td.vFgce-visible-sm{display:table-cell !important}}@media (min-width:768px) and (max-width:991px){._2cGpj-visible-sm-block{display:block !important}}@media (min-width:768px) and (max-width:991px){._14OBx-visible-sm-inline{display:inline !important}}@media (min-width:768px) and (max-width:991px){._1IBCe-visible-sm-inline-block{display:inline-block !important}}@media (min-width:992px) and (max-width:1199px){._3qTZ9-visible-md{display:block !important}table._3qTZ9-visible-md{display:table !important}tr._3qTZ9-visible-md{display:table-row !important}th._3qTZ9-visible-md,td._3qTZ9-visible-md{display:table-cell !important}}@media (min-width:992px) and (max-width:1199px){._1b70N-visible-md-block{display:block !important}}@media (min-width:992px) and (max-width:1199px){._2FNrX-visible-md-inline{display:inline !important}}@media (min-width:992px) and (max-width:1199px){._1MCNX-visible-md-inline-block{display:inline-block !important}}@media (min-width:1200px){.tk8mW-visible-lg{display:block !important}table.tk8mW-visible-lg{display:table !important}tr.tk8mW-visible-lg{display:table-row !important}th.tk8mW-visible-lg,td.tk8mW-visible-lg{display:table-cell !important}}@media (min-width:1200px){.yzCgL-visible-lg-block{display:block !important}}@media (min-width:1200px){._1Ad52-visible-lg-inline{display:inline !important}}@media (min-width:1200px){._2hCmO-visible-lg-inline-block{display:inline-block !important}}@media (max-width:767px){._1qlNJ-hidden-xs{display:none !important}}@media (min-width:768px) and (max-width:991px){._1UcP8-hidden-sm{display:none !important}}@media (min-width:992px) and (max-width:1199px){.TILkE-hidden-md{display:none !important}}@media (min-width:1200px){._2wXaD-hidden-lg{display:none !important}}._3ADjb-visible-print{display:none !important}@media print{._3ADjb-visible-print{display:block !important}table._3ADjb-visible-print{display:table !important}tr._3ADjb-visible-print{display:table-row !important}th._3ADjb-visible-print,td._3ADjb-visible-print{display:table-cell !important}}._3gdWc-visible-print-block{display:none !important}@media print{._3gdWc-visible-print-block{display:block !important}}._2HCJf-visible-print-inline{display:none !important}@media print{._2HCJf-visible-print-inline{display:inline !important}}._2oyVc-visible-print-inline-block{display:none !important}@media print{._2oyVc-visible-print-inline-block{display:inline-block !important}}@media print{.xjc1G-hidden-print{display:none !important}}',""]),t.locals={navbar:"xz-aB-navbar",btn:"_3-XUl-btn",caret:"WfXRY-caret",dropup:"xQM4X-dropup",label:"_3gkGS-label",table:"fHH5l-table","table-bordered":"_3qUkR-table-bordered",tableBordered:"_3qUkR-table-bordered","img-responsive":"_2zRts-img-responsive",imgResponsive:"_2zRts-img-responsive","img-rounded":"_141wW-img-rounded",imgRounded:"_141wW-img-rounded","img-thumbnail":"_3mPVB-img-thumbnail",imgThumbnail:"_3mPVB-img-thumbnail","img-circle":"_1pGGm-img-circle",imgCircle:"_1pGGm-img-circle","sr-only":"_2pd-Z-sr-only",srOnly:"_2pd-Z-sr-only","sr-only-focusable":"_2oRwu-sr-only-focusable",srOnlyFocusable:"_2oRwu-sr-only-focusable",h1:"FcDB9-h1",h2:"_2RtE8-h2",h3:"_2Hz9Y-h3",h4:"_20UE0-h4",h5:"_3ig4X-h5",h6:"_1IWbi-h6",small:"_1xqEr-small",lead:"BToLm-lead",mark:"_1CUfl-mark","text-left":"_1dEhG-text-left",textLeft:"_1dEhG-text-left","text-right":"_13-RL-text-right",textRight:"_13-RL-text-right","text-center":"_12Qkk-text-center",textCenter:"_12Qkk-text-center","text-justify":"_2IBJo-text-justify",textJustify:"_2IBJo-text-justify","text-nowrap":"_2D83n-text-nowrap",textNowrap:"_2D83n-text-nowrap","text-lowercase":"_3pILW-text-lowercase",textLowercase:"_3pILW-text-lowercase","text-uppercase":"EHGdF-text-uppercase",textUppercase:"EHGdF-text-uppercase","text-capitalize":"NKPoz-text-capitalize",textCapitalize:"NKPoz-text-capitalize","text-muted":"TLMiz-text-muted",textMuted:"TLMiz-text-muted","text-primary":"m-Vt1-text-primary",textPrimary:"m-Vt1-text-primary","text-success":"_1gJQD-text-success",textSuccess:"_1gJQD-text-success","text-info":"_3nKGJ-text-info",textInfo:"_3nKGJ-text-info","text-warning":"_1L8Pc-text-warning",textWarning:"_1L8Pc-text-warning","text-danger":"_2Qeqx-text-danger",textDanger:"_2Qeqx-text-danger","bg-primary":"VG86S-bg-primary",bgPrimary:"VG86S-bg-primary","bg-success":"Fh09a-bg-success",bgSuccess:"Fh09a-bg-success","bg-info":"_3D-5o-bg-info",bgInfo:"_3D-5o-bg-info","bg-warning":"_23YNn-bg-warning",bgWarning:"_23YNn-bg-warning","bg-danger":"_1g67A-bg-danger",bgDanger:"_1g67A-bg-danger","page-header":"_3ayLh-page-header",pageHeader:"_3ayLh-page-header","list-unstyled":"_2tb6R-list-unstyled",listUnstyled:"_2tb6R-list-unstyled","list-inline":"_2aPiu-list-inline",listInline:"_2aPiu-list-inline","dl-horizontal":"_2DZom-dl-horizontal",dlHorizontal:"_2DZom-dl-horizontal",initialism:"_39JkD-initialism","blockquote-reverse":"_3OkIL-blockquote-reverse",blockquoteReverse:"_3OkIL-blockquote-reverse","pull-right":"_1DwIR-pull-right",pullRight:"_1DwIR-pull-right",container:"GfVfY-container","container-fluid":"_1TgHS-container-fluid",containerFluid:"_1TgHS-container-fluid",row:"_3XjgV-row","col-xs-1":"_4I1Yd-col-xs-1",colXs1:"_4I1Yd-col-xs-1","col-sm-1":"ZjKa3-col-sm-1",colSm1:"ZjKa3-col-sm-1","col-md-1":"_2qQXc-col-md-1",colMd1:"_2qQXc-col-md-1","col-lg-1":"_20ta3-col-lg-1",colLg1:"_20ta3-col-lg-1","col-xs-2":"_2Dnpy-col-xs-2",colXs2:"_2Dnpy-col-xs-2","col-sm-2":"_1i2ug-col-sm-2",colSm2:"_1i2ug-col-sm-2","col-md-2":"_2t37W-col-md-2",colMd2:"_2t37W-col-md-2","col-lg-2":"_2Hl3y-col-lg-2",colLg2:"_2Hl3y-col-lg-2","col-xs-3":"OXp8_-col-xs-3",colXs3:"OXp8_-col-xs-3","col-sm-3":"_2Cn5F-col-sm-3",colSm3:"_2Cn5F-col-sm-3","col-md-3":"Ab3eH-col-md-3",colMd3:"Ab3eH-col-md-3","col-lg-3":"_26GsY-col-lg-3",colLg3:"_26GsY-col-lg-3","col-xs-4":"_2RAtz-col-xs-4",colXs4:"_2RAtz-col-xs-4","col-sm-4":"fa0Ir-col-sm-4",colSm4:"fa0Ir-col-sm-4","col-md-4":"hp0Eu-col-md-4",colMd4:"hp0Eu-col-md-4","col-lg-4":"_17v9q-col-lg-4",colLg4:"_17v9q-col-lg-4","col-xs-5":"_2Y3wR-col-xs-5",colXs5:"_2Y3wR-col-xs-5","col-sm-5":"_3h_BG-col-sm-5",colSm5:"_3h_BG-col-sm-5","col-md-5":"s-Mk2-col-md-5",colMd5:"s-Mk2-col-md-5","col-lg-5":"_3poT2-col-lg-5",colLg5:"_3poT2-col-lg-5","col-xs-6":"_2LLfi-col-xs-6",colXs6:"_2LLfi-col-xs-6","col-sm-6":"_2uvrS-col-sm-6",colSm6:"_2uvrS-col-sm-6","col-md-6":"_3aWb5-col-md-6",colMd6:"_3aWb5-col-md-6","col-lg-6":"_1kKt6-col-lg-6",colLg6:"_1kKt6-col-lg-6","col-xs-7":"_1lY1V-col-xs-7",colXs7:"_1lY1V-col-xs-7","col-sm-7":"_3MZfg-col-sm-7",colSm7:"_3MZfg-col-sm-7","col-md-7":"_3cJo6-col-md-7",colMd7:"_3cJo6-col-md-7","col-lg-7":"_3xzR0-col-lg-7",colLg7:"_3xzR0-col-lg-7","col-xs-8":"_2HxFx-col-xs-8",colXs8:"_2HxFx-col-xs-8","col-sm-8":"_2gidE-col-sm-8",colSm8:"_2gidE-col-sm-8","col-md-8":"_2PRaS-col-md-8",colMd8:"_2PRaS-col-md-8","col-lg-8":"LJ--s-col-lg-8",colLg8:"LJ--s-col-lg-8","col-xs-9":"_2oOfj-col-xs-9",colXs9:"_2oOfj-col-xs-9","col-sm-9":"_26BI4-col-sm-9",colSm9:"_26BI4-col-sm-9","col-md-9":"_2DhdG-col-md-9",colMd9:"_2DhdG-col-md-9","col-lg-9":"_3q6rI-col-lg-9",colLg9:"_3q6rI-col-lg-9","col-xs-10":"pmiBX-col-xs-10",colXs10:"pmiBX-col-xs-10","col-sm-10":"s5knd-col-sm-10",colSm10:"s5knd-col-sm-10","col-md-10":"_1oNCM-col-md-10",colMd10:"_1oNCM-col-md-10","col-lg-10":"_2DYWZ-col-lg-10",colLg10:"_2DYWZ-col-lg-10","col-xs-11":"yWnii-col-xs-11",colXs11:"yWnii-col-xs-11","col-sm-11":"liNUF-col-sm-11",colSm11:"liNUF-col-sm-11","col-md-11":"_2CmUe-col-md-11",colMd11:"_2CmUe-col-md-11","col-lg-11":"_1qjSD-col-lg-11",colLg11:"_1qjSD-col-lg-11","col-xs-12":"_2dgc--col-xs-12",colXs12:"_2dgc--col-xs-12","col-sm-12":"_3ylC5-col-sm-12",colSm12:"_3ylC5-col-sm-12","col-md-12":"_2DCWc-col-md-12",colMd12:"_2DCWc-col-md-12","col-lg-12":"_2FNrn-col-lg-12",colLg12:"_2FNrn-col-lg-12","col-xs-pull-12":"uk8LQ-col-xs-pull-12",colXsPull12:"uk8LQ-col-xs-pull-12","col-xs-pull-11":"nQP-X-col-xs-pull-11",colXsPull11:"nQP-X-col-xs-pull-11","col-xs-pull-10":"_3W2H_-col-xs-pull-10",colXsPull10:"_3W2H_-col-xs-pull-10","col-xs-pull-9":"_18qIh-col-xs-pull-9",colXsPull9:"_18qIh-col-xs-pull-9","col-xs-pull-8":"CEgpl-col-xs-pull-8",colXsPull8:"CEgpl-col-xs-pull-8","col-xs-pull-7":"_3UVMh-col-xs-pull-7",colXsPull7:"_3UVMh-col-xs-pull-7","col-xs-pull-6":"_1PEhv-col-xs-pull-6",colXsPull6:"_1PEhv-col-xs-pull-6","col-xs-pull-5":"VxFvW-col-xs-pull-5",colXsPull5:"VxFvW-col-xs-pull-5","col-xs-pull-4":"_3Wqpt-col-xs-pull-4",colXsPull4:"_3Wqpt-col-xs-pull-4","col-xs-pull-3":"_12Zed-col-xs-pull-3",colXsPull3:"_12Zed-col-xs-pull-3","col-xs-pull-2":"_3p-tQ-col-xs-pull-2",colXsPull2:"_3p-tQ-col-xs-pull-2","col-xs-pull-1":"RDEqX-col-xs-pull-1",colXsPull1:"RDEqX-col-xs-pull-1","col-xs-pull-0":"_2F1WQ-col-xs-pull-0",colXsPull0:"_2F1WQ-col-xs-pull-0","col-xs-push-12":"_1APvI-col-xs-push-12",colXsPush12:"_1APvI-col-xs-push-12","col-xs-push-11":"_3C73D-col-xs-push-11",colXsPush11:"_3C73D-col-xs-push-11","col-xs-push-10":"h56Hx-col-xs-push-10",colXsPush10:"h56Hx-col-xs-push-10","col-xs-push-9":"_1P93P-col-xs-push-9",colXsPush9:"_1P93P-col-xs-push-9","col-xs-push-8":"_2Nbww-col-xs-push-8",colXsPush8:"_2Nbww-col-xs-push-8","col-xs-push-7":"_1K3eA-col-xs-push-7",colXsPush7:"_1K3eA-col-xs-push-7","col-xs-push-6":"_2nBcT-col-xs-push-6",colXsPush6:"_2nBcT-col-xs-push-6","col-xs-push-5":"_3petP-col-xs-push-5",colXsPush5:"_3petP-col-xs-push-5","col-xs-push-4":"_2IqqW-col-xs-push-4",colXsPush4:"_2IqqW-col-xs-push-4","col-xs-push-3":"MCtu6-col-xs-push-3",colXsPush3:"MCtu6-col-xs-push-3","col-xs-push-2":"_3PMdr-col-xs-push-2",colXsPush2:"_3PMdr-col-xs-push-2","col-xs-push-1":"_1wU93-col-xs-push-1",colXsPush1:"_1wU93-col-xs-push-1","col-xs-push-0":"_1mQQp-col-xs-push-0",colXsPush0:"_1mQQp-col-xs-push-0","col-xs-offset-12":"_29EZj-col-xs-offset-12",colXsOffset12:"_29EZj-col-xs-offset-12","col-xs-offset-11":"_2hf-s-col-xs-offset-11",colXsOffset11:"_2hf-s-col-xs-offset-11","col-xs-offset-10":"PUiPu-col-xs-offset-10",colXsOffset10:"PUiPu-col-xs-offset-10","col-xs-offset-9":"_26WFR-col-xs-offset-9",colXsOffset9:"_26WFR-col-xs-offset-9","col-xs-offset-8":"_1cCHe-col-xs-offset-8",colXsOffset8:"_1cCHe-col-xs-offset-8","col-xs-offset-7":"_3XTt9-col-xs-offset-7",colXsOffset7:"_3XTt9-col-xs-offset-7","col-xs-offset-6":"_3ruq1-col-xs-offset-6",colXsOffset6:"_3ruq1-col-xs-offset-6","col-xs-offset-5":"_2Jvdw-col-xs-offset-5",colXsOffset5:"_2Jvdw-col-xs-offset-5","col-xs-offset-4":"_3Lc1D-col-xs-offset-4",colXsOffset4:"_3Lc1D-col-xs-offset-4","col-xs-offset-3":"_1MGVb-col-xs-offset-3",colXsOffset3:"_1MGVb-col-xs-offset-3","col-xs-offset-2":"_3VELV-col-xs-offset-2",colXsOffset2:"_3VELV-col-xs-offset-2","col-xs-offset-1":"_1qTH1-col-xs-offset-1",colXsOffset1:"_1qTH1-col-xs-offset-1","col-xs-offset-0":"_3p4mP-col-xs-offset-0",colXsOffset0:"_3p4mP-col-xs-offset-0","col-sm-pull-12":"_2lgaN-col-sm-pull-12",colSmPull12:"_2lgaN-col-sm-pull-12","col-sm-pull-11":"_9DE1R-col-sm-pull-11",colSmPull11:"_9DE1R-col-sm-pull-11","col-sm-pull-10":"kEzk--col-sm-pull-10",colSmPull10:"kEzk--col-sm-pull-10","col-sm-pull-9":"Nt2jd-col-sm-pull-9",colSmPull9:"Nt2jd-col-sm-pull-9","col-sm-pull-8":"_3swPC-col-sm-pull-8",colSmPull8:"_3swPC-col-sm-pull-8","col-sm-pull-7":"_3HVdW-col-sm-pull-7",colSmPull7:"_3HVdW-col-sm-pull-7","col-sm-pull-6":"wTEFa-col-sm-pull-6",colSmPull6:"wTEFa-col-sm-pull-6","col-sm-pull-5":"TLqCy-col-sm-pull-5",colSmPull5:"TLqCy-col-sm-pull-5","col-sm-pull-4":"_15_JC-col-sm-pull-4",colSmPull4:"_15_JC-col-sm-pull-4","col-sm-pull-3":"kKugK-col-sm-pull-3",colSmPull3:"kKugK-col-sm-pull-3","col-sm-pull-2":"_1gfwZ-col-sm-pull-2",colSmPull2:"_1gfwZ-col-sm-pull-2","col-sm-pull-1":"ULGBP-col-sm-pull-1",colSmPull1:"ULGBP-col-sm-pull-1","col-sm-pull-0":"_2761W-col-sm-pull-0",colSmPull0:"_2761W-col-sm-pull-0","col-sm-push-12":"vVqsv-col-sm-push-12",colSmPush12:"vVqsv-col-sm-push-12","col-sm-push-11":"_3rrzB-col-sm-push-11",colSmPush11:"_3rrzB-col-sm-push-11","col-sm-push-10":"_16iHZ-col-sm-push-10",colSmPush10:"_16iHZ-col-sm-push-10","col-sm-push-9":"_3gO4_-col-sm-push-9",colSmPush9:"_3gO4_-col-sm-push-9","col-sm-push-8":"_2iTaN-col-sm-push-8",colSmPush8:"_2iTaN-col-sm-push-8","col-sm-push-7":"_15MqT-col-sm-push-7",colSmPush7:"_15MqT-col-sm-push-7","col-sm-push-6":"_3WBCD-col-sm-push-6",colSmPush6:"_3WBCD-col-sm-push-6","col-sm-push-5":"_3yi6x-col-sm-push-5",colSmPush5:"_3yi6x-col-sm-push-5","col-sm-push-4":"Hi_tc-col-sm-push-4",colSmPush4:"Hi_tc-col-sm-push-4","col-sm-push-3":"_3CD2Y-col-sm-push-3",colSmPush3:"_3CD2Y-col-sm-push-3","col-sm-push-2":"_2KPVu-col-sm-push-2",colSmPush2:"_2KPVu-col-sm-push-2","col-sm-push-1":"_1vrte-col-sm-push-1",colSmPush1:"_1vrte-col-sm-push-1","col-sm-push-0":"_2iGSL-col-sm-push-0",colSmPush0:"_2iGSL-col-sm-push-0","col-sm-offset-12":"_2gBOF-col-sm-offset-12",colSmOffset12:"_2gBOF-col-sm-offset-12","col-sm-offset-11":"_1nkq--col-sm-offset-11",colSmOffset11:"_1nkq--col-sm-offset-11","col-sm-offset-10":"_3cbLF-col-sm-offset-10",colSmOffset10:"_3cbLF-col-sm-offset-10","col-sm-offset-9":"_1YqUk-col-sm-offset-9",colSmOffset9:"_1YqUk-col-sm-offset-9","col-sm-offset-8":"l5LT2-col-sm-offset-8",colSmOffset8:"l5LT2-col-sm-offset-8","col-sm-offset-7":"_1_lx0-col-sm-offset-7",colSmOffset7:"_1_lx0-col-sm-offset-7","col-sm-offset-6":"QftRx-col-sm-offset-6",colSmOffset6:"QftRx-col-sm-offset-6","col-sm-offset-5":"_1CiAA-col-sm-offset-5",colSmOffset5:"_1CiAA-col-sm-offset-5","col-sm-offset-4":"_3KOyM-col-sm-offset-4",colSmOffset4:"_3KOyM-col-sm-offset-4","col-sm-offset-3":"_3X8SS-col-sm-offset-3",colSmOffset3:"_3X8SS-col-sm-offset-3","col-sm-offset-2":"_2uxv1-col-sm-offset-2",colSmOffset2:"_2uxv1-col-sm-offset-2","col-sm-offset-1":"_3LDLJ-col-sm-offset-1",colSmOffset1:"_3LDLJ-col-sm-offset-1","col-sm-offset-0":"_2qEtK-col-sm-offset-0",colSmOffset0:"_2qEtK-col-sm-offset-0","col-md-pull-12":"bjQSr-col-md-pull-12",colMdPull12:"bjQSr-col-md-pull-12","col-md-pull-11":"_2R0gT-col-md-pull-11",colMdPull11:"_2R0gT-col-md-pull-11","col-md-pull-10":"_1ywn5-col-md-pull-10",colMdPull10:"_1ywn5-col-md-pull-10","col-md-pull-9":"Y3m-j-col-md-pull-9",colMdPull9:"Y3m-j-col-md-pull-9","col-md-pull-8":"_2kyk9-col-md-pull-8",colMdPull8:"_2kyk9-col-md-pull-8","col-md-pull-7":"dnrMG-col-md-pull-7",colMdPull7:"dnrMG-col-md-pull-7","col-md-pull-6":"_1zNYf-col-md-pull-6",colMdPull6:"_1zNYf-col-md-pull-6","col-md-pull-5":"_1lgxH-col-md-pull-5",colMdPull5:"_1lgxH-col-md-pull-5","col-md-pull-4":"fI_-S-col-md-pull-4",colMdPull4:"fI_-S-col-md-pull-4","col-md-pull-3":"_1yC1r-col-md-pull-3",colMdPull3:"_1yC1r-col-md-pull-3","col-md-pull-2":"_2ruK1-col-md-pull-2",colMdPull2:"_2ruK1-col-md-pull-2","col-md-pull-1":"_3pkxQ-col-md-pull-1",colMdPull1:"_3pkxQ-col-md-pull-1","col-md-pull-0":"_2NDft-col-md-pull-0",colMdPull0:"_2NDft-col-md-pull-0","col-md-push-12":"_2dklO-col-md-push-12",colMdPush12:"_2dklO-col-md-push-12","col-md-push-11":"_2O3TS-col-md-push-11",colMdPush11:"_2O3TS-col-md-push-11","col-md-push-10":"_3H7Y4-col-md-push-10",colMdPush10:"_3H7Y4-col-md-push-10","col-md-push-9":"_2PIS0-col-md-push-9",colMdPush9:"_2PIS0-col-md-push-9","col-md-push-8":"_1qQQi-col-md-push-8",colMdPush8:"_1qQQi-col-md-push-8","col-md-push-7":"Y8MRT-col-md-push-7",colMdPush7:"Y8MRT-col-md-push-7","col-md-push-6":"_3_TWQ-col-md-push-6",colMdPush6:"_3_TWQ-col-md-push-6","col-md-push-5":"_3KOrj-col-md-push-5",colMdPush5:"_3KOrj-col-md-push-5","col-md-push-4":"_2PuS8-col-md-push-4",colMdPush4:"_2PuS8-col-md-push-4","col-md-push-3":"_2zI-_-col-md-push-3",colMdPush3:"_2zI-_-col-md-push-3","col-md-push-2":"_3Drij-col-md-push-2",colMdPush2:"_3Drij-col-md-push-2","col-md-push-1":"_1M7-4-col-md-push-1",colMdPush1:"_1M7-4-col-md-push-1","col-md-push-0":"_1kPaw-col-md-push-0",colMdPush0:"_1kPaw-col-md-push-0","col-md-offset-12":"_3PwfG-col-md-offset-12",colMdOffset12:"_3PwfG-col-md-offset-12","col-md-offset-11":"sHbiw-col-md-offset-11",colMdOffset11:"sHbiw-col-md-offset-11","col-md-offset-10":"_3geq1-col-md-offset-10",colMdOffset10:"_3geq1-col-md-offset-10","col-md-offset-9":"_3ypJc-col-md-offset-9",colMdOffset9:"_3ypJc-col-md-offset-9","col-md-offset-8":"ydDuS-col-md-offset-8",colMdOffset8:"ydDuS-col-md-offset-8","col-md-offset-7":"_1MS3B-col-md-offset-7",colMdOffset7:"_1MS3B-col-md-offset-7","col-md-offset-6":"_1A80h-col-md-offset-6",colMdOffset6:"_1A80h-col-md-offset-6","col-md-offset-5":"_3g3p_-col-md-offset-5",colMdOffset5:"_3g3p_-col-md-offset-5","col-md-offset-4":"_2FjQc-col-md-offset-4",colMdOffset4:"_2FjQc-col-md-offset-4","col-md-offset-3":"Xebzn-col-md-offset-3",colMdOffset3:"Xebzn-col-md-offset-3","col-md-offset-2":"Xw9rm-col-md-offset-2",colMdOffset2:"Xw9rm-col-md-offset-2","col-md-offset-1":"CToHd-col-md-offset-1",colMdOffset1:"CToHd-col-md-offset-1","col-md-offset-0":"oXEcL-col-md-offset-0",colMdOffset0:"oXEcL-col-md-offset-0","col-lg-pull-12":"_32_we-col-lg-pull-12",colLgPull12:"_32_we-col-lg-pull-12","col-lg-pull-11":"_24eEw-col-lg-pull-11",colLgPull11:"_24eEw-col-lg-pull-11","col-lg-pull-10":"_29qaq-col-lg-pull-10",colLgPull10:"_29qaq-col-lg-pull-10","col-lg-pull-9":"_1S7Yo-col-lg-pull-9",colLgPull9:"_1S7Yo-col-lg-pull-9","col-lg-pull-8":"_2CgOB-col-lg-pull-8",colLgPull8:"_2CgOB-col-lg-pull-8","col-lg-pull-7":"_3Jv0L-col-lg-pull-7",colLgPull7:"_3Jv0L-col-lg-pull-7","col-lg-pull-6":"_1pHMz-col-lg-pull-6",colLgPull6:"_1pHMz-col-lg-pull-6","col-lg-pull-5":"_2zvsy-col-lg-pull-5",colLgPull5:"_2zvsy-col-lg-pull-5","col-lg-pull-4":"sFsmY-col-lg-pull-4",colLgPull4:"sFsmY-col-lg-pull-4","col-lg-pull-3":"Ui3Ia-col-lg-pull-3",colLgPull3:"Ui3Ia-col-lg-pull-3","col-lg-pull-2":"WmgXv-col-lg-pull-2",colLgPull2:"WmgXv-col-lg-pull-2","col-lg-pull-1":"_2KMEm-col-lg-pull-1",colLgPull1:"_2KMEm-col-lg-pull-1","col-lg-pull-0":"QWRTc-col-lg-pull-0",colLgPull0:"QWRTc-col-lg-pull-0","col-lg-push-12":"_2FOMW-col-lg-push-12",colLgPush12:"_2FOMW-col-lg-push-12","col-lg-push-11":"IdWzr-col-lg-push-11",colLgPush11:"IdWzr-col-lg-push-11","col-lg-push-10":"_3qw3b-col-lg-push-10",colLgPush10:"_3qw3b-col-lg-push-10","col-lg-push-9":"_3LXj0-col-lg-push-9",colLgPush9:"_3LXj0-col-lg-push-9","col-lg-push-8":"_16qiV-col-lg-push-8",colLgPush8:"_16qiV-col-lg-push-8","col-lg-push-7":"_2OQk6-col-lg-push-7",colLgPush7:"_2OQk6-col-lg-push-7","col-lg-push-6":"_3o1se-col-lg-push-6",colLgPush6:"_3o1se-col-lg-push-6","col-lg-push-5":"_2dmyK-col-lg-push-5",colLgPush5:"_2dmyK-col-lg-push-5","col-lg-push-4":"_3pru9-col-lg-push-4",colLgPush4:"_3pru9-col-lg-push-4","col-lg-push-3":"_2lBUY-col-lg-push-3",colLgPush3:"_2lBUY-col-lg-push-3","col-lg-push-2":"_3FuDW-col-lg-push-2",colLgPush2:"_3FuDW-col-lg-push-2","col-lg-push-1":"_2YWH--col-lg-push-1",colLgPush1:"_2YWH--col-lg-push-1","col-lg-push-0":"_1iVNw-col-lg-push-0",colLgPush0:"_1iVNw-col-lg-push-0","col-lg-offset-12":"uQRYD-col-lg-offset-12",colLgOffset12:"uQRYD-col-lg-offset-12","col-lg-offset-11":"_1tWxb-col-lg-offset-11",colLgOffset11:"_1tWxb-col-lg-offset-11","col-lg-offset-10":"_10I1r-col-lg-offset-10",colLgOffset10:"_10I1r-col-lg-offset-10","col-lg-offset-9":"_3zXRb-col-lg-offset-9",colLgOffset9:"_3zXRb-col-lg-offset-9","col-lg-offset-8":"_3HNmx-col-lg-offset-8",colLgOffset8:"_3HNmx-col-lg-offset-8","col-lg-offset-7":"_3mIkn-col-lg-offset-7",colLgOffset7:"_3mIkn-col-lg-offset-7","col-lg-offset-6":"_22SUB-col-lg-offset-6",colLgOffset6:"_22SUB-col-lg-offset-6","col-lg-offset-5":"_1CR4c-col-lg-offset-5",colLgOffset5:"_1CR4c-col-lg-offset-5","col-lg-offset-4":"_1AWkt-col-lg-offset-4",colLgOffset4:"_1AWkt-col-lg-offset-4","col-lg-offset-3":"_2BzzA-col-lg-offset-3",colLgOffset3:"_2BzzA-col-lg-offset-3","col-lg-offset-2":"_3Egxi-col-lg-offset-2",colLgOffset2:"_3Egxi-col-lg-offset-2","col-lg-offset-1":"_3QHjG-col-lg-offset-1",colLgOffset1:"_3QHjG-col-lg-offset-1","col-lg-offset-0":"_3jTkc-col-lg-offset-0",colLgOffset0:"_3jTkc-col-lg-offset-0","table-condensed":"_1lZ-G-table-condensed",tableCondensed:"_1lZ-G-table-condensed","table-striped":"_2ysFO-table-striped",tableStriped:"_2ysFO-table-striped","table-hover":"_3Qd5Z-table-hover",tableHover:"_3Qd5Z-table-hover",active:"_2tvYY-active",success:"_2OgG2-success",info:"_2ItI5-info",warning:"KrRM8-warning",danger:"CwCgN-danger","table-responsive":"_3oGxj-table-responsive",tableResponsive:"_3oGxj-table-responsive",focus:"_2eLCD-focus",disabled:"_2UPmc-disabled","btn-default":"_7-4ed-btn-default",btnDefault:"_7-4ed-btn-default",open:"cl_nk-open","dropdown-toggle":"_2KnYE-dropdown-toggle",dropdownToggle:"_2KnYE-dropdown-toggle",badge:"_1XX3z-badge","btn-primary":"_1VbPL-btn-primary",btnPrimary:"_1VbPL-btn-primary","btn-success":"_1P0kt-btn-success",btnSuccess:"_1P0kt-btn-success","btn-info":"_25yw2-btn-info",btnInfo:"_25yw2-btn-info","btn-warning":"-tgFO-btn-warning",btnWarning:"-tgFO-btn-warning","btn-danger":"_1fOWb-btn-danger",btnDanger:"_1fOWb-btn-danger","btn-link":"_2k5vU-btn-link",btnLink:"_2k5vU-btn-link","btn-lg":"_4Y8qI-btn-lg",btnLg:"_4Y8qI-btn-lg","btn-group-lg":"_29CyS-btn-group-lg",btnGroupLg:"_29CyS-btn-group-lg","btn-sm":"_30Oip-btn-sm",btnSm:"_30Oip-btn-sm","btn-group-sm":"_2eEOC-btn-group-sm",btnGroupSm:"_2eEOC-btn-group-sm","btn-xs":"_2kCvE-btn-xs",btnXs:"_2kCvE-btn-xs","btn-group-xs":"_1A2Tx-btn-group-xs",btnGroupXs:"_1A2Tx-btn-group-xs","btn-block":"_3micb-btn-block",btnBlock:"_3micb-btn-block","btn-group":"_2yrMO-btn-group",btnGroup:"_2yrMO-btn-group","btn-group-vertical":"eNKyK-btn-group-vertical",btnGroupVertical:"eNKyK-btn-group-vertical","btn-toolbar":"_1TX6t-btn-toolbar",btnToolbar:"_1TX6t-btn-toolbar","input-group":"_2lgpZ-input-group",inputGroup:"_2lgpZ-input-group","btn-group-justified":"gzM9c-btn-group-justified",btnGroupJustified:"gzM9c-btn-group-justified","dropdown-menu":"_5aG9w-dropdown-menu",dropdownMenu:"_5aG9w-dropdown-menu",nav:"_18V3M-nav","nav-divider":"_3jwhe-nav-divider",navDivider:"_3jwhe-nav-divider","nav-tabs":"_2ciYP-nav-tabs",navTabs:"_2ciYP-nav-tabs","nav-justified":"_3nLJA-nav-justified",navJustified:"_3nLJA-nav-justified",dropdown:"_3oGVD-dropdown","nav-pills":"_3i5kW-nav-pills",navPills:"_3i5kW-nav-pills","nav-stacked":"_3pfVZ-nav-stacked",navStacked:"_3pfVZ-nav-stacked","nav-tabs-justified":"_18sQL-nav-tabs-justified",navTabsJustified:"_18sQL-nav-tabs-justified","tab-content":"FgbPD-tab-content",tabContent:"FgbPD-tab-content","tab-pane":"_2q1VF-tab-pane",tabPane:"_2q1VF-tab-pane",pagination:"n15Mn-pagination","pagination-lg":"L5I4g-pagination-lg",paginationLg:"L5I4g-pagination-lg","pagination-sm":"_3rY2q-pagination-sm",paginationSm:"_3rY2q-pagination-sm",panel:"bv_Mo-panel","panel-body":"_2wvLn-panel-body",panelBody:"_2wvLn-panel-body","panel-heading":"_31mw8-panel-heading",panelHeading:"_31mw8-panel-heading","panel-title":"_112QQ-panel-title",panelTitle:"_112QQ-panel-title","panel-footer":"_1qtb5-panel-footer",panelFooter:"_1qtb5-panel-footer","list-group":"_1vMKW-list-group",listGroup:"_1vMKW-list-group","panel-collapse":"_162Ti-panel-collapse",panelCollapse:"_162Ti-panel-collapse","list-group-item":"rhZLN-list-group-item",listGroupItem:"rhZLN-list-group-item","panel-group":"_2V8WR-panel-group",panelGroup:"_2V8WR-panel-group","panel-default":"_2diFa-panel-default",panelDefault:"_2diFa-panel-default","panel-primary":"_1I52t-panel-primary",panelPrimary:"_1I52t-panel-primary","panel-success":"_1mEg2-panel-success",panelSuccess:"_1mEg2-panel-success","panel-info":"_2rUCo-panel-info",panelInfo:"_2rUCo-panel-info","panel-warning":"_1ozkU-panel-warning",panelWarning:"_1ozkU-panel-warning","panel-danger":"_3dZ2--panel-danger",panelDanger:"_3dZ2--panel-danger",clearfix:"_2U5Vc-clearfix","center-block":"_1efU5-center-block",centerBlock:"_1efU5-center-block","pull-left":"_3XXWK-pull-left",pullLeft:"_3XXWK-pull-left",hide:"mceZN-hide",show:"_3CqPx-show",invisible:"_2ZgjX-invisible","text-hide":"_1y8Wm-text-hide",textHide:"_1y8Wm-text-hide",hidden:"_15mml-hidden",affix:"-JzKj-affix","visible-xs":"_3Pjmx-visible-xs",visibleXs:"_3Pjmx-visible-xs","visible-sm":"vFgce-visible-sm",visibleSm:"vFgce-visible-sm","visible-md":"_3qTZ9-visible-md",visibleMd:"_3qTZ9-visible-md","visible-lg":"tk8mW-visible-lg",visibleLg:"tk8mW-visible-lg","visible-xs-block":"_1Dhak-visible-xs-block",visibleXsBlock:"_1Dhak-visible-xs-block","visible-xs-inline":"_2NJ-T-visible-xs-inline",visibleXsInline:"_2NJ-T-visible-xs-inline","visible-xs-inline-block":"_1rjwZ-visible-xs-inline-block",visibleXsInlineBlock:"_1rjwZ-visible-xs-inline-block","visible-sm-block":"_2cGpj-visible-sm-block",visibleSmBlock:"_2cGpj-visible-sm-block","visible-sm-inline":"_14OBx-visible-sm-inline",visibleSmInline:"_14OBx-visible-sm-inline","visible-sm-inline-block":"_1IBCe-visible-sm-inline-block",visibleSmInlineBlock:"_1IBCe-visible-sm-inline-block","visible-md-block":"_1b70N-visible-md-block",visibleMdBlock:"_1b70N-visible-md-block","visible-md-inline":"_2FNrX-visible-md-inline",visibleMdInline:"_2FNrX-visible-md-inline","visible-md-inline-block":"_1MCNX-visible-md-inline-block",visibleMdInlineBlock:"_1MCNX-visible-md-inline-block","visible-lg-block":"yzCgL-visible-lg-block",visibleLgBlock:"yzCgL-visible-lg-block","visible-lg-inline":"_1Ad52-visible-lg-inline",visibleLgInline:"_1Ad52-visible-lg-inline","visible-lg-inline-block":"_2hCmO-visible-lg-inline-block",visibleLgInlineBlock:"_2hCmO-visible-lg-inline-block","hidden-xs":"_1qlNJ-hidden-xs",hiddenXs:"_1qlNJ-hidden-xs","hidden-sm":"_1UcP8-hidden-sm",hiddenSm:"_1UcP8-hidden-sm","hidden-md":"TILkE-hidden-md",hiddenMd:"TILkE-hidden-md","hidden-lg":"_2wXaD-hidden-lg",hiddenLg:"_2wXaD-hidden-lg","visible-print":"_3ADjb-visible-print",visiblePrint:"_3ADjb-visible-print","visible-print-block":"_3gdWc-visible-print-block",visiblePrintBlock:"_3gdWc-visible-print-block","visible-print-inline":"_2HCJf-visible-print-inline",visiblePrintInline:"_2HCJf-visible-print-inline","visible-print-inline-block":"_2oyVc-visible-print-inline-block",visiblePrintInlineBlock:"_2oyVc-visible-print-inline-block","hidden-print":"xjc1G-hidden-print",hiddenPrint:"xjc1G-hidden-print"}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(19),p=o(f),b=n(15),h=o(b),m=n(7),g=o(m),_=n(8),v=o(_),y=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,c=Array(i),s=0;s<i;s++)c[s]=arguments[s];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(c))),o.state={selected:[],page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.fetchURL&&fetch(this.props.fetchURL+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({selected:[],count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"checked",value:function(e){return this.state.selected.includes(e)}},{key:"check",value:function(e){var t=this.state.selected.indexOf(e);t>=0?this.state.selected.splice(t,1):this.state.selected.push(e),this.setState({selected:this.state.selected})}},{key:"checkAll",value:function(){var e=this;this.state.selected.length>0?this.setState({selected:[]}):(this.state.jobs.map(function(t){e.state.selected.push(t)}),this.setState({selected:this.state.selected}))}},{key:"deleteAll",value:function(){var e=this;this.props.deleteAllURL&&fetch(this.props.deleteAllURL,{method:"post"}).then(function(){e.updatePage(1)})}},{key:"deleteSelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.deleteURL&&t.push(fetch(e.props.deleteURL+"/"+n.died_at+"/"+n.id,{method:"post"}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"retryAll",value:function(){var e=this;this.props.retryAllURL&&fetch(this.props.retryAllURL,{method:"post"}).then(function(){e.updatePage(1)})}},{key:"retrySelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.retryURL&&t.push(fetch(e.props.retryURL+"/"+n.died_at+"/"+n.id,{method:"post"}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"render",value:function(){var e=this;return s.default.createElement("div",null,s.default.createElement("div",{className:(0,v.default)(g.default.panel,g.default.panelDefault)},s.default.createElement("div",{className:g.default.panelHeading},"Dead Jobs"),s.default.createElement("div",{className:g.default.panelBody},s.default.createElement("p",null,this.state.count," job(s) are dead."),s.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),s.default.createElement("div",{className:g.default.tableResponsive},s.default.createElement("table",{className:g.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("th",null,s.default.createElement("input",{type:"checkbox",checked:this.state.selected.length>0,onChange:function(){return e.checkAll()}})),s.default.createElement("th",null,"Name"),s.default.createElement("th",null,"Arguments"),s.default.createElement("th",null,"Error"),s.default.createElement("th",null,"Died At")),this.state.jobs.map(function(t){return s.default.createElement("tr",{key:t.id},s.default.createElement("td",null,s.default.createElement("input",{type:"checkbox",checked:e.checked(t),onChange:function(){return e.check(t)}})),s.default.createElement("td",null,t.name),s.default.createElement("td",null,JSON.stringify(t.args)),s.default.createElement("td",null,t.err),s.default.createElement("td",null,s.default.createElement(h.default,{ts:t.t})))}))))),s.default.createElement("div",{className:g.default.btnGroup,role:"group"},s.default.createElement("button",{type:"button",className:(0,v.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.deleteSelected()}},"Delete Selected Jobs"),s.default.createElement("button",{type:"button",className:(0,v.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.retrySelected()}},"Retry Selected Jobs"),s.default.createElement("button",{type:"button",className:(0,v.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.deleteAll()}},"Delete All Jobs"),s.default.createElement("button",{type:"button",className:(0,v.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.retryAll()}},"Retry All Jobs")))}}]),t}(s.default.Component);y.propTypes={fetchURL:d.default.string,deleteURL:d.default.string,deleteAllURL:d.default.string,retryURL:d.default.string,retryAllURL:d.default.string},t.default=y},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(7),p=o(f),b=n(8),h=o(b),m=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,c=Array(i),s=0;s<i;s++)c[s]=arguments[s];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(c))),o.state={queues:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"componentWillMount",value:function(){var e=this;this.props.url&&fetch(this.props.url).then(function(e){return e.json()}).then(function(t){e.setState({queues:t})})}},{key:"render",value:function(){return s.default.createElement("div",{className:(0,h.default)(p.default.panel,p.default.panelDefault)},s.default.createElement("div",{className:p.default.panelHeading},"queues"),s.default.createElement("div",{className:p.default.panelBody},s.default.createElement("p",null,this.state.queues.length," queue(s) with a total of ",this.queuedCount," item(s) queued.")),s.default.createElement("div",{className:p.default.tableResponsive},s.default.createElement("table",{className:p.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("th",null,"Name"),s.default.createElement("th",null,"Count"),s.default.createElement("th",null,"Latency (seconds)")),this.state.queues.map(function(e){return s.default.createElement("tr",{key:e.job_name},s.default.createElement("td",null,e.job_name),s.default.createElement("td",null,e.count),s.default.createElement("td",null,e.latency))})))))}},{key:"queuedCount",get:function(){var e=0;return this.state.queues.map(function(t){e+=t.count}),e}}]),t}(s.default.Component);m.propTypes={url:d.default.string},t.default=m},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(19),p=o(f),b=n(15),h=o(b),m=n(7),g=o(m),_=n(8),v=o(_),y=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,c=Array(i),s=0;s<i;s++)c[s]=arguments[s];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(c))),o.state={page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return s.default.createElement("div",{className:(0,v.default)(g.default.panel,g.default.panelDefault)},s.default.createElement("div",{className:g.default.panelHeading},"Retry Jobs"),s.default.createElement("div",{className:g.default.panelBody},s.default.createElement("p",null,this.state.count," job(s) scheduled to be retried."),s.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),s.default.createElement("div",{className:g.default.tableResponsive},s.default.createElement("table",{className:g.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("th",null,"Name"),s.default.createElement("th",null,"Arguments"),s.default.createElement("th",null,"Error"),s.default.createElement("th",null,"Retry At")),this.state.jobs.map(function(e){return s.default.createElement("tr",{key:e.id},s.default.createElement("td",null,e.name),s.default.createElement("td",null,JSON.stringify(e.args)),s.default.createElement("td",null,e.err),s.default.createElement("td",null,s.default.createElement(h.default,{ts:e.t})))})))))}}]),t}(s.default.Component);y.propTypes={url:d.default.string},t.default=y},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(19),p=o(f),b=n(15),h=o(b),m=n(7),g=o(m),_=n(8),v=o(_),y=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,c=Array(i),s=0;s<i;s++)c[s]=arguments[s];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(c))),o.state={page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return s.default.createElement("div",{className:(0,v.default)(g.default.panel,g.default.panelDefault)},s.default.createElement("div",{className:g.default.panelHeading},"Scheduled Jobs"),s.default.createElement("div",{className:g.default.panelBody},s.default.createElement("p",null,this.state.count," job(s) scheduled."),s.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),s.default.createElement("div",{className:g.default.tableResponsive},s.default.createElement("table",{className:g.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("th",null,"Name"),s.default.createElement("th",null,"Arguments"),s.default.createElement("th",null,"Scheduled For")),this.state.jobs.map(function(e){return s.default.createElement("tr",{key:e.id},s.default.createElement("td",null,e.name),s.default.createElement("td",null,JSON.stringify(e.args)),s.default.createElement("td",null,s.default.createElement(h.default,{ts:e.run_at})))})))))}}]),t}(s.default.Component);y.propTypes={url:d.default.string},t.default=y},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0});var o=n(67);n.d(t,"Router",function(){return o.a});var r=n(34);n.d(t,"Link",function(){return r.a});var l=n(75);n.d(t,"IndexLink",function(){return l.a});var a=n(76);n.d(t,"withRouter",function(){return a.a});var i=n(78);n.d(t,"IndexRedirect",function(){return i.a});var c=n(79);n.d(t,"IndexRoute",function(){return c.a});var s=n(35);n.d(t,"Redirect",function(){return s.a});var u=n(80);n.d(t,"Route",function(){return u.a});var d=n(5);n.d(t,"createRoutes",function(){return d.b});var f=n(21);n.d(t,"RouterContext",function(){return f.a});var p=n(23);n.d(t,"locationShape",function(){return p.a}),n.d(t,"routerShape",function(){return p.b});var b=n(81);n.d(t,"match",function(){return b.a});var h=n(39);n.d(t,"useRouterHistory",function(){return h.a});var m=n(10);n.d(t,"formatPattern",function(){return m.a});var g=n(86);n.d(t,"applyRouterMiddleware",function(){return g.a});var _=n(87);n.d(t,"browserHistory",function(){return _.a});var v=n(90);n.d(t,"hashHistory",function(){return v.a});var y=n(36);n.d(t,"createMemoryHistory",function(){return y.a})},function(e,t,n){"use strict";function o(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}var r=n(2),l=n.n(r),a=n(1),i=n.n(a),c=n(3),s=n.n(c),u=n(0),d=(n.n(u),n(31)),f=n(12),p=n(21),b=n(5),h=n(33),m=(n(9),Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}),g={history:u.object,children:f.d,routes:f.d,render:u.func,createElement:u.func,onError:u.func,onUpdate:u.func,matchContext:u.object},_=s()({displayName:"Router",propTypes:g,getDefaultProps:function(){return{render:function(e){return i.a.createElement(p.a,e)}}},getInitialState:function(){return{location:null,routes:null,params:null,components:null}},handleError:function(e){if(!this.props.onError)throw e;this.props.onError.call(this,e)},createRouterObject:function(e){var t=this.props.matchContext;if(t)return t.router;var n=this.props.history;return Object(h.b)(n,this.transitionManager,e)},createTransitionManager:function(){var e=this.props.matchContext;if(e)return e.transitionManager;var t=this.props.history,n=this.props,o=n.routes,r=n.children;return t.getCurrentLocation||l()(!1),Object(d.a)(t,Object(b.b)(o||r))},componentWillMount:function(){var e=this;this.transitionManager=this.createTransitionManager(),this.router=this.createRouterObject(this.state),this._unlisten=this.transitionManager.listen(function(t,n){t?e.handleError(t):(Object(h.a)(e.router,n),e.setState(n,e.props.onUpdate))})},componentWillReceiveProps:function(e){},componentWillUnmount:function(){this._unlisten&&this._unlisten()},render:function(){var e=this.state,t=e.location,n=e.routes,r=e.params,l=e.components,a=this.props,i=a.createElement,c=a.render,s=o(a,["createElement","render"]);return null==t?null:(Object.keys(g).forEach(function(e){return delete s[e]}),c(m({},s,{router:this.router,location:t,routes:n,params:r,components:l,createElement:i})))}});t.a=_},function(e,t,n){"use strict";function o(e){return e}function r(e,t,n){function r(e,t){var n=_.hasOwnProperty(t)?_[t]:null;w.hasOwnProperty(t)&&i("OVERRIDE_BASE"===n,"ReactClassInterface: You are attempting to override `%s` from your class specification. Ensure that your method names do not overlap with React methods.",t),e&&i("DEFINE_MANY"===n||"DEFINE_MANY_MERGED"===n,"ReactClassInterface: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",t)}function s(e,n){if(n){i("function"!=typeof n,"ReactClass: You're attempting to use a component class or function as a mixin. Instead, just use a regular object."),i(!t(n),"ReactClass: You're attempting to use a component as a mixin. Instead, just use a regular object.");var o=e.prototype,l=o.__reactAutoBindPairs;n.hasOwnProperty(c)&&v.mixins(e,n.mixins);for(var a in n)if(n.hasOwnProperty(a)&&a!==c){var s=n[a],u=o.hasOwnProperty(a);if(r(u,a),v.hasOwnProperty(a))v[a](e,s);else{var d=_.hasOwnProperty(a),b="function"==typeof s,h=b&&!d&&!u&&!1!==n.autobind;if(h)l.push(a,s),o[a]=s;else if(u){var m=_[a];i(d&&("DEFINE_MANY_MERGED"===m||"DEFINE_MANY"===m),"ReactClass: Unexpected spec policy %s for key %s when mixing in component specs.",m,a),"DEFINE_MANY_MERGED"===m?o[a]=f(o[a],s):"DEFINE_MANY"===m&&(o[a]=p(o[a],s))}else o[a]=s}}}else;}function u(e,t){if(t)for(var n in t){var o=t[n];if(t.hasOwnProperty(n)){var r=n in v;i(!r,'ReactClass: You are attempting to define a reserved property, `%s`, that shouldn\'t be on the "statics" key. Define it as an instance property instead; it will still be accessible on the constructor.',n);var l=n in e;i(!l,"ReactClass: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",n),e[n]=o}}}function d(e,t){i(e&&t&&"object"==typeof e&&"object"==typeof t,"mergeIntoWithNoDuplicateKeys(): Cannot merge non-objects.");for(var n in t)t.hasOwnProperty(n)&&(i(void 0===e[n],"mergeIntoWithNoDuplicateKeys(): Tried to merge two objects with the same key: `%s`. This conflict may be due to a mixin; in particular, this may be caused by two getInitialState() or getDefaultProps() methods returning objects with clashing keys.",n),e[n]=t[n]);return e}function f(e,t){return function(){var n=e.apply(this,arguments),o=t.apply(this,arguments);if(null==n)return o;if(null==o)return n;var r={};return d(r,n),d(r,o),r}}function p(e,t){return function(){e.apply(this,arguments),t.apply(this,arguments)}}function b(e,t){var n=t.bind(e);return n}function h(e){for(var t=e.__reactAutoBindPairs,n=0;n<t.length;n+=2){var o=t[n],r=t[n+1];e[o]=b(e,r)}}function m(e){var t=o(function(e,o,r){this.__reactAutoBindPairs.length&&h(this),this.props=e,this.context=o,this.refs=a,this.updater=r||n,this.state=null;var l=this.getInitialState?this.getInitialState():null;i("object"==typeof l&&!Array.isArray(l),"%s.getInitialState(): must return an object or null",t.displayName||"ReactCompositeComponent"),this.state=l});t.prototype=new k,t.prototype.constructor=t,t.prototype.__reactAutoBindPairs=[],g.forEach(s.bind(null,t)),s(t,y),s(t,e),s(t,x),t.getDefaultProps&&(t.defaultProps=t.getDefaultProps()),i(t.prototype.render,"createClass(...): Class specification must implement a `render` method.");for(var r in _)t.prototype[r]||(t.prototype[r]=null);return t}var g=[],_={mixins:"DEFINE_MANY",statics:"DEFINE_MANY",propTypes:"DEFINE_MANY",contextTypes:"DEFINE_MANY",childContextTypes:"DEFINE_MANY",getDefaultProps:"DEFINE_MANY_MERGED",getInitialState:"DEFINE_MANY_MERGED",getChildContext:"DEFINE_MANY_MERGED",render:"DEFINE_ONCE",componentWillMount:"DEFINE_MANY",componentDidMount:"DEFINE_MANY",componentWillReceiveProps:"DEFINE_MANY",shouldComponentUpdate:"DEFINE_ONCE",componentWillUpdate:"DEFINE_MANY",componentDidUpdate:"DEFINE_MANY",componentWillUnmount:"DEFINE_MANY",updateComponent:"OVERRIDE_BASE"},v={displayName:function(e,t){e.displayName=t},mixins:function(e,t){if(t)for(var n=0;n<t.length;n++)s(e,t[n])},childContextTypes:function(e,t){e.childContextTypes=l({},e.childContextTypes,t)},contextTypes:function(e,t){e.contextTypes=l({},e.contextTypes,t)},getDefaultProps:function(e,t){e.getDefaultProps?e.getDefaultProps=f(e.getDefaultProps,t):e.getDefaultProps=t},propTypes:function(e,t){e.propTypes=l({},e.propTypes,t)},statics:function(e,t){u(e,t)},autobind:function(){}},y={componentDidMount:function(){this.__isMounted=!0}},x={componentWillUnmount:function(){this.__isMounted=!1}},w={replaceState:function(e,t){this.updater.enqueueReplaceState(this,e,t)},isMounted:function(){return!!this.__isMounted}},k=function(){};return l(k.prototype,e.prototype,w),m}var l=n(13),a=n(18),i=n(28),c="mixins";e.exports=r},function(e,t,n){"use strict";function o(e,t,n){return!!e.path&&Object(l.b)(e.path).some(function(e){return t.params[e]!==n.params[e]})}function r(e,t){var n=e&&e.routes,r=t.routes,l=void 0,a=void 0,i=void 0;if(n){var c=!1;l=n.filter(function(n){if(c)return!0;var l=-1===r.indexOf(n)||o(n,e,t);return l&&(c=!0),l}),l.reverse(),i=[],a=[],r.forEach(function(e){var t=-1===n.indexOf(e),o=-1!==l.indexOf(e);t||o?i.push(e):a.push(e)})}else l=[],a=[],i=r;return{leaveRoutes:l,changeRoutes:a,enterRoutes:i}}var l=n(10);t.a=r},function(e,t,n){"use strict";function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function r(){function e(e,t,n,o){var r=e.length<n,l=function(){for(var n=arguments.length,o=Array(n),l=0;l<n;l++)o[l]=arguments[l];if(e.apply(t,o),r){(0,o[o.length-1])()}};return o.add(l),l}function t(t){return t.reduce(function(t,n){return n.onEnter&&t.push(e(n.onEnter,n,3,s)),t},[])}function n(t){return t.reduce(function(t,n){return n.onChange&&t.push(e(n.onChange,n,4,u)),t},[])}function o(e,t,n){function o(e){r=e}if(!e)return void n();var r=void 0;Object(l.a)(e,function(e,n,l){t(e,o,function(e){e||r?l(e,r):n()})},n)}function r(e,n,r){s.clear();var l=t(e);return o(l.length,function(e,t,o){var r=function(){s.has(l[e])&&(o.apply(void 0,arguments),s.remove(l[e]))};l[e](n,t,r)},r)}function i(e,t,r,l){u.clear();var a=n(e);return o(a.length,function(e,n,o){var l=function(){u.has(a[e])&&(o.apply(void 0,arguments),u.remove(a[e]))};a[e](t,r,n,l)},l)}function c(e,t){for(var n=0,o=e.length;n<o;++n)e[n].onLeave&&e[n].onLeave.call(e[n],t)}var s=new a,u=new a;return{runEnterHooks:r,runChangeHooks:i,runLeaveHooks:c}}t.a=r;var l=n(20),a=function e(){var t=this;o(this,e),this.hooks=[],this.add=function(e){return t.hooks.push(e)},this.remove=function(e){return t.hooks=t.hooks.filter(function(t){return t!==e})},this.has=function(e){return-1!==t.hooks.indexOf(e)},this.clear=function(){return t.hooks=[]}}},function(e,t,n){"use strict";function o(e,t){if(e==t)return!0;if(null==e||null==t)return!1;if(Array.isArray(e))return Array.isArray(t)&&e.length===t.length&&e.every(function(e,n){return o(e,t[n])});if("object"===(void 0===e?"undefined":s(e))){for(var n in e)if(Object.prototype.hasOwnProperty.call(e,n))if(void 0===e[n]){if(void 0!==t[n])return!1}else{if(!Object.prototype.hasOwnProperty.call(t,n))return!1;if(!o(e[n],t[n]))return!1}return!0}return String(e)===String(t)}function r(e,t){return"/"!==t.charAt(0)&&(t="/"+t),"/"!==e.charAt(e.length-1)&&(e+="/"),"/"!==t.charAt(t.length-1)&&(t+="/"),t===e}function l(e,t,n){for(var o=e,r=[],l=[],a=0,i=t.length;a<i;++a){var s=t[a],u=s.path||"";if("/"===u.charAt(0)&&(o=e,r=[],l=[]),null!==o&&u){var d=Object(c.c)(u,o);if(d?(o=d.remainingPathname,r=[].concat(r,d.paramNames),l=[].concat(l,d.paramValues)):o=null,""===o)return r.every(function(e,t){return String(l[t])===String(n[e])})}}return!1}function a(e,t){return null==t?null==e:null==e||o(e,t)}function i(e,t,n,o,i){var c=e.pathname,s=e.query;return null!=n&&("/"!==c.charAt(0)&&(c="/"+c),!!(r(c,n.pathname)||!t&&l(c,o,i))&&a(s,n.query))}t.a=i;var c=n(10),s="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e}},function(e,t,n){"use strict";function o(e,t,n){if(t.component||t.components)return void n(null,t.component||t.components);var o=t.getComponent||t.getComponents;if(o){var r=o.call(t,e,n);Object(a.a)(r)&&r.then(function(e){return n(null,e)},n)}else n()}function r(e,t){Object(l.b)(e.routes,function(t,n,r){o(e,t,r)},t)}var l=n(20),a=n(32);t.a=r},function(e,t,n){"use strict";function o(e,t,n,o,r){if(e.childRoutes)return[null,e.childRoutes];if(!e.getChildRoutes)return[];var l=!0,i=void 0,c={location:t,params:a(n,o)},s=e.getChildRoutes(c,function(e,t){if(t=!e&&Object(f.b)(t),l)return void(i=[e,t]);r(e,t)});return Object(u.a)(s)&&s.then(function(e){return r(null,Object(f.b)(e))},r),l=!1,i}function r(e,t,n,l,i){if(e.indexRoute)i(null,e.indexRoute);else if(e.getIndexRoute){var c={location:t,params:a(n,l)},d=e.getIndexRoute(c,function(e,t){i(e,!e&&Object(f.b)(t)[0])});Object(u.a)(d)&&d.then(function(e){return i(null,Object(f.b)(e)[0])},i)}else if(e.childRoutes||e.getChildRoutes){var p=function(e,o){if(e)return void i(e);var a=o.filter(function(e){return!e.path});Object(s.a)(a.length,function(e,o,i){r(a[e],t,n,l,function(t,n){if(t||n){var r=[a[e]].concat(Array.isArray(n)?n:[n]);i(t,r)}else o()})},function(e,t){i(null,t)})},b=o(e,t,n,l,p);b&&p.apply(void 0,b)}else i()}function l(e,t,n){return t.reduce(function(e,t,o){var r=n&&n[o];return Array.isArray(e[t])?e[t].push(r):e[t]=t in e?[e[t],r]:r,e},e)}function a(e,t){return l({},e,t)}function i(e,t,n,l,i,s){var u=e.path||"";if("/"===u.charAt(0)&&(n=t.pathname,l=[],i=[]),null!==n&&u){try{var f=Object(d.c)(u,n);f?(n=f.remainingPathname,l=[].concat(l,f.paramNames),i=[].concat(i,f.paramValues)):n=null}catch(e){s(e)}if(""===n){var p={routes:[e],params:a(l,i)};return void r(e,t,l,i,function(e,t){if(e)s(e);else{if(Array.isArray(t)){var n;(n=p.routes).push.apply(n,t)}else t&&p.routes.push(t);s(null,p)}})}}if(null!=n||e.childRoutes){var b=function(o,r){o?s(o):r?c(r,t,function(t,n){t?s(t):n?(n.routes.unshift(e),s(null,n)):s()},n,l,i):s()},h=o(e,t,l,i,b);h&&b.apply(void 0,h)}else s()}function c(e,t,n,o){var r=arguments.length>4&&void 0!==arguments[4]?arguments[4]:[],l=arguments.length>5&&void 0!==arguments[5]?arguments[5]:[];void 0===o&&("/"!==t.pathname.charAt(0)&&(t=p({},t,{pathname:"/"+t.pathname})),o=t.pathname),Object(s.a)(e.length,function(n,a,c){i(e[n],t,o,r,l,function(e,t){e||t?c(e,t):a()})},n)}t.a=c;var s=n(20),u=n(32),d=n(10),f=(n(9),n(5)),p=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}},function(e,t,n){"use strict";function o(e,t){var n={};return e.path?(Object(r.b)(e.path).forEach(function(e){Object.prototype.hasOwnProperty.call(t,e)&&(n[e]=t[e])}),n):n}var r=n(10);t.a=o},function(e,t,n){"use strict";var o=n(1),r=n.n(o),l=n(3),a=n.n(l),i=n(34),c=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},s=a()({displayName:"IndexLink",render:function(){return r.a.createElement(i.a,c({},this.props,{onlyActiveOnIndex:!0}))}});t.a=s},function(e,t,n){"use strict";function o(e){return e.displayName||e.name||"Component"}function r(e,t){var n=t&&t.withRef,r=u()({displayName:"WithRouter",mixins:[Object(p.b)("router")],contextTypes:{router:b.b},propTypes:{router:b.b},getWrappedInstance:function(){return n||a()(!1),this.wrappedInstance},render:function(){var t=this,o=this.props.router||this.context.router;if(!o)return c.a.createElement(e,this.props);var r=o.params,l=o.location,a=o.routes,i=h({},this.props,{router:o,params:r,location:l,routes:a});return n&&(i.ref=function(e){t.wrappedInstance=e}),c.a.createElement(e,i)}});return r.displayName="withRouter("+o(e)+")",r.WrappedComponent=e,f()(r,e)}t.a=r;var l=n(2),a=n.n(l),i=n(1),c=n.n(i),s=n(3),u=n.n(s),d=n(77),f=n.n(d),p=n(22),b=n(23),h=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}},function(e,t,n){"use strict";var o={childContextTypes:!0,contextTypes:!0,defaultProps:!0,displayName:!0,getDefaultProps:!0,mixins:!0,propTypes:!0,type:!0},r={name:!0,length:!0,prototype:!0,caller:!0,arguments:!0,arity:!0},l="function"==typeof Object.getOwnPropertySymbols;e.exports=function(e,t,n){if("string"!=typeof t){var a=Object.getOwnPropertyNames(t);l&&(a=a.concat(Object.getOwnPropertySymbols(t)));for(var i=0;i<a.length;++i)if(!(o[a[i]]||r[a[i]]||n&&n[a[i]]))try{e[a[i]]=t[a[i]]}catch(e){}}return e}},function(e,t,n){"use strict";var o=n(3),r=n.n(o),l=n(0),a=(n.n(l),n(9),n(2)),i=n.n(a),c=n(35),s=n(12),u=r()({displayName:"IndexRedirect",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=c.a.createRouteFromReactElement(e))}},propTypes:{to:l.string.isRequired,query:l.object,state:l.object,onEnter:s.c,children:s.c},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";var o=n(3),r=n.n(o),l=n(0),a=(n.n(l),n(9),n(2)),i=n.n(a),c=n(5),s=n(12),u=r()({displayName:"IndexRoute",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=Object(c.a)(e))}},propTypes:{path:s.c,component:s.a,components:s.b,getComponent:l.func,getComponents:l.func},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";var o=n(3),r=n.n(o),l=n(0),a=(n.n(l),n(2)),i=n.n(a),c=n(5),s=n(12),u=r()({displayName:"Route",statics:{createRouteFromReactElement:c.a},propTypes:{path:l.string,component:s.a,components:s.b,getComponent:l.func,getComponents:l.func},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";function o(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function r(e,t){var n=e.history,r=e.routes,a=e.location,p=o(e,["history","routes","location"]);n||a||i()(!1),n=n||Object(c.a)(p);var b=Object(s.a)(n,Object(u.b)(r));a=a?n.createLocation(a):n.getCurrentLocation(),b.match(a,function(e,o,r){var a=void 0;if(r){var i=Object(d.b)(n,b,r);a=f({},r,{router:i,matchContext:{transitionManager:b,router:i}})}t(e,o&&n.createLocation(o,l.REPLACE),a)})}var l=n(16),a=(n.n(l),n(2)),i=n.n(a),c=n(36),s=n(31),u=n(5),d=n(33),f=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.a=r},function(e,t,n){"use strict";function o(e){switch(e.arrayFormat){case"index":return function(t,n,o){return null===n?[l(t,e),"[",o,"]"].join(""):[l(t,e),"[",l(o,e),"]=",l(n,e)].join("")};case"bracket":return function(t,n){return null===n?l(t,e):[l(t,e),"[]=",l(n,e)].join("")};default:return function(t,n){return null===n?l(t,e):[l(t,e),"=",l(n,e)].join("")}}}function r(e){var t;switch(e.arrayFormat){case"index":return function(e,n,o){if(t=/\[(\d*)\]$/.exec(e),e=e.replace(/\[\d*\]$/,""),!t)return void(o[e]=n);void 0===o[e]&&(o[e]={}),o[e][t[1]]=n};case"bracket":return function(e,n,o){return t=/(\[\])$/.exec(e),e=e.replace(/\[\]$/,""),t?void 0===o[e]?void(o[e]=[n]):void(o[e]=[].concat(o[e],n)):void(o[e]=n)};default:return function(e,t,n){if(void 0===n[e])return void(n[e]=t);n[e]=[].concat(n[e],t)}}}function l(e,t){return t.encode?t.strict?i(e):encodeURIComponent(e):e}function a(e){return Array.isArray(e)?e.sort():"object"==typeof e?a(Object.keys(e)).sort(function(e,t){return Number(e)-Number(t)}).map(function(t){return e[t]}):e}var i=n(83),c=n(13);t.extract=function(e){return e.split("?")[1]||""},t.parse=function(e,t){t=c({arrayFormat:"none"},t);var n=r(t),o=Object.create(null);return"string"!=typeof e?o:(e=e.trim().replace(/^(\?|#|&)/,""))?(e.split("&").forEach(function(e){var t=e.replace(/\+/g," ").split("="),r=t.shift(),l=t.length>0?t.join("="):void 0;l=void 0===l?null:decodeURIComponent(l),n(decodeURIComponent(r),l,o)}),Object.keys(o).sort().reduce(function(e,t){var n=o[t];return Boolean(n)&&"object"==typeof n&&!Array.isArray(n)?e[t]=a(n):e[t]=n,e},Object.create(null))):o},t.stringify=function(e,t){t=c({encode:!0,strict:!0,arrayFormat:"none"},t);var n=o(t);return e?Object.keys(e).sort().map(function(o){var r=e[o];if(void 0===r)return"";if(null===r)return l(o,t);if(Array.isArray(r)){var a=[];return r.slice().forEach(function(e){void 0!==e&&a.push(n(o,e,a.length))}),a.join("&")}return l(o,t)+"="+l(r,t)}).filter(function(e){return e.length>0}).join("&"):""}},function(e,t,n){"use strict";e.exports=function(e){return encodeURIComponent(e).replace(/[!'()*]/g,function(e){return"%"+e.charCodeAt(0).toString(16).toUpperCase()})}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},l=n(4),a=(o(l),n(2)),i=o(a),c=n(11),s=n(6),u=n(25),d=o(u),f=n(16),p=function(e){return e.filter(function(e){return e.state}).reduce(function(e,t){return e[t.key]=t.state,e},{})},b=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};Array.isArray(e)?e={entries:e}:"string"==typeof e&&(e={entries:[e]});var t=function(){var e=h[m],t=(0,s.createPath)(e),n=void 0,o=void 0;e.key&&(n=e.key,o=v(n));var l=(0,s.parsePath)(t);return(0,c.createLocation)(r({},l,{state:o}),void 0,n)},n=function(e){var t=m+e;return t>=0&&t<h.length},o=function(e){if(e&&n(e)){m+=e;var o=t();u.transitionTo(r({},o,{action:f.POP}))}},l=function(e){m+=1,m<h.length&&h.splice(m),h.push(e),_(e.key,e.state)},a=function(e){h[m]=e,_(e.key,e.state)},u=(0,d.default)(r({},e,{getCurrentLocation:t,pushLocation:l,replaceLocation:a,go:o})),b=e,h=b.entries,m=b.current;"string"==typeof h?h=[h]:Array.isArray(h)||(h=["/"]),h=h.map(function(e){return(0,c.createLocation)(e)}),null==m?m=h.length-1:m>=0&&m<h.length||(0,i.default)(!1);var g=p(h),_=function(e,t){return g[e]=t},v=function(e){return g[e]};return r({},u,{canGo:n})};t.default=b},function(e,t,n){"use strict";t.__esModule=!0;t.loopAsync=function(e,t,n){var o=0,r=!1,l=!1,a=!1,i=void 0,c=function(){for(var e=arguments.length,t=Array(e),o=0;o<e;o++)t[o]=arguments[o];if(r=!0,l)return void(i=t);n.apply(void 0,t)};!function s(){if(!r&&(a=!0,!l)){for(l=!0;!r&&o<e&&a;)a=!1,t(o++,s,c);if(l=!1,r)return void n.apply(void 0,i);o>=e&&a&&(r=!0,n())}}()}},function(e,t,n){"use strict";var o=n(1),r=n.n(o),l=n(21),a=(n(9),Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e});t.a=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];var i=t.map(function(e){return e.renderRouterContext}).filter(Boolean),c=t.map(function(e){return e.renderRouteComponent}).filter(Boolean),s=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:o.createElement;return function(t,n){return c.reduceRight(function(e,t){return t(e,n)},e(t,n))}};return function(e){return i.reduceRight(function(t,n){return n(t,e)},r.a.createElement(l.a,a({},e,{createElement:s(e.createElement)})))}}},function(e,t,n){"use strict";var o=n(88),r=n.n(o),l=n(41);t.a=Object(l.a)(r.a)},function(e,t,n){"use strict";function o(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(2),i=r(a),c=n(26),s=n(27),u=o(s),d=n(89),f=o(d),p=n(17),b=n(25),h=r(b),m=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};c.canUseDOM||(0,i.default)(!1);var t=e.forceRefresh||!(0,p.supportsHistory)(),n=t?f:u,o=n.getUserConfirmation,r=n.getCurrentLocation,a=n.pushLocation,s=n.replaceLocation,d=n.go,b=(0,h.default)(l({getUserConfirmation:o},e,{getCurrentLocation:r,pushLocation:a,replaceLocation:s,go:d})),m=0,g=void 0,_=function(e,t){1==++m&&(g=u.startListener(b.transitionTo));var n=t?b.listenBefore(e):b.listen(e);return function(){n(),0==--m&&g()}};return l({},b,{listenBefore:function(e){return _(e,!0)},listen:function(e){return _(e,!1)}})};t.default=m},function(e,t,n){"use strict";t.__esModule=!0,t.replaceLocation=t.pushLocation=t.getCurrentLocation=t.go=t.getUserConfirmation=void 0;var o=n(27);Object.defineProperty(t,"getUserConfirmation",{enumerable:!0,get:function(){return o.getUserConfirmation}}),Object.defineProperty(t,"go",{enumerable:!0,get:function(){return o.go}});var r=n(11),l=n(6);t.getCurrentLocation=function(){return(0,r.createLocation)(window.location)},t.pushLocation=function(e){return window.location.href=(0,l.createPath)(e),!1},t.replaceLocation=function(e){return window.location.replace((0,l.createPath)(e)),!1}},function(e,t,n){"use strict";var o=n(91),r=n.n(o),l=n(41);t.a=Object(l.a)(r.a)},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},l=n(4),a=(o(l),n(2)),i=o(a),c=n(26),s=n(17),u=n(92),d=function(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}(u),f=n(25),p=o(f),b=function(e){return"/"===e.charAt(0)?e:"/"+e},h={hashbang:{encodePath:function(e){return"!"===e.charAt(0)?e:"!"+e},decodePath:function(e){return"!"===e.charAt(0)?e.substring(1):e}},noslash:{encodePath:function(e){return"/"===e.charAt(0)?e.substring(1):e},decodePath:b},slash:{encodePath:b,decodePath:b}},m=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};c.canUseDOM||(0,i.default)(!1);var t=e.queryKey,n=e.hashType;"string"!=typeof t&&(t="_k"),null==n&&(n="slash"),n in h||(n="slash");var o=h[n],l=d.getUserConfirmation,a=function(){return d.getCurrentLocation(o,t)},u=function(e){return d.pushLocation(e,o,t)},f=function(e){return d.replaceLocation(e,o,t)},b=(0,p.default)(r({getUserConfirmation:l},e,{getCurrentLocation:a,pushLocation:u,replaceLocation:f,go:d.go})),m=0,g=void 0,_=function(e,n){1==++m&&(g=d.startListener(b.transitionTo,o,t));var r=n?b.listenBefore(e):b.listen(e);return function(){r(),0==--m&&g()}},v=function(e){return _(e,!0)},y=function(e){return _(e,!1)};(0,s.supportsGoWithoutReloadUsingHash)();return r({},b,{listenBefore:v,listen:y,go:function(e){b.go(e)},createHref:function(e){return"#"+o.encodePath(b.createHref(e))}})};t.default=m},function(e,t,n){"use strict";t.__esModule=!0,t.replaceLocation=t.pushLocation=t.startListener=t.getCurrentLocation=t.go=t.getUserConfirmation=void 0;var o=n(27);Object.defineProperty(t,"getUserConfirmation",{enumerable:!0,get:function(){return o.getUserConfirmation}}),Object.defineProperty(t,"go",{enumerable:!0,get:function(){return o.go}});var r=n(4),l=(function(e){e&&e.__esModule}(r),n(11)),a=n(17),i=n(40),c=n(6),s=function(){var e=window.location.href,t=e.indexOf("#");return-1===t?"":e.substring(t+1)},u=function(e){return window.location.hash=e},d=function(e){var t=window.location.href.indexOf("#");window.location.replace(window.location.href.slice(0,t>=0?t:0)+"#"+e)},f=t.getCurrentLocation=function(e,t){var n=e.decodePath(s()),o=(0,c.getQueryStringValueFromPath)(n,t),r=void 0;o&&(n=(0,c.stripQueryStringValueFromPath)(n,t),r=(0,i.readState)(o));var a=(0,c.parsePath)(n);return a.state=r,(0,l.createLocation)(a,void 0,o)},p=void 0,b=(t.startListener=function(e,t,n){var o=function(){var o=s(),r=t.encodePath(o);if(o!==r)d(r);else{var l=f(t,n);if(p&&l.key&&p.key===l.key)return;p=l,e(l)}},r=s(),l=t.encodePath(r);return r!==l&&d(l),(0,a.addEventListener)(window,"hashchange",o),function(){return(0,a.removeEventListener)(window,"hashchange",o)}},function(e,t,n,o){var r=e.state,l=e.key,a=t.encodePath((0,c.createPath)(e));void 0!==r&&(a=(0,c.addQueryStringValueToPath)(a,n,l),(0,i.saveState)(l,r)),p=e,o(a)});t.pushLocation=function(e,t,n){return b(e,t,n,function(e){s()!==e&&u(e)})},t.replaceLocation=function(e,t,n){return b(e,t,n,function(e){s()!==e&&d(e)})}}]);
\ No newline at end of file
+!function(e){function t(o){if(n[o])return n[o].exports;var r=n[o]={i:o,l:!1,exports:{}};return e[o].call(r.exports,r,r.exports,t),r.l=!0,r.exports}var n={};t.m=e,t.c=n,t.d=function(e,n,o){t.o(e,n)||Object.defineProperty(e,n,{configurable:!1,enumerable:!0,get:o})},t.n=function(e){var n=e&&e.__esModule?function(){return e.default}:function(){return e};return t.d(n,"a",n),n},t.o=function(e,t){return Object.prototype.hasOwnProperty.call(e,t)},t.p="",t(t.s=42)}([function(e,t,n){e.exports=n(44)()},function(e,t,n){"use strict";e.exports=n(43)},function(e,t,n){"use strict";var o=function(e,t,n,o,r,l,a,i){if(!e){var c;if(void 0===t)c=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var s=[n,o,r,l,a,i],u=0;c=new Error(t.replace(/%s/g,function(){return s[u++]})),c.name="Invariant Violation"}throw c.framesToPop=1,c}};e.exports=o},function(e,t,n){"use strict";var o=n(1),r=n(68);if(void 0===o)throw Error("create-react-class could not find the React object. If you are using script tags, make sure that React is being loaded before create-react-class.");var l=(new o.Component).updater;e.exports=r(o.Component,o.isValidElement,l)},function(e,t,n){"use strict";var o=function(){};e.exports=o},function(e,t,n){"use strict";function o(e){return null==e||u.a.isValidElement(e)}function r(e){return o(e)||Array.isArray(e)&&e.every(o)}function l(e,t){return d({},e,t)}function a(e){var t=e.type,n=l(t.defaultProps,e.props);if(n.children){var o=i(n.children,n);o.length&&(n.childRoutes=o),delete n.children}return n}function i(e,t){var n=[];return u.a.Children.forEach(e,function(e){if(u.a.isValidElement(e))if(e.type.createRouteFromReactElement){var o=e.type.createRouteFromReactElement(e,t);o&&n.push(o)}else n.push(a(e))}),n}function c(e){return r(e)?e=i(e):e&&!Array.isArray(e)&&(e=[e]),e}t.c=r,t.a=a,t.b=c;var s=n(1),u=n.n(s),d=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}},function(e,t,n){"use strict";t.__esModule=!0,t.createPath=t.parsePath=t.getQueryStringValueFromPath=t.stripQueryStringValueFromPath=t.addQueryStringValueToPath=void 0;var o=n(4),r=(function(e){e&&e.__esModule}(o),t.addQueryStringValueToPath=function(e,t,n){var o=l(e),r=o.pathname,i=o.search,c=o.hash;return a({pathname:r,search:i+(-1===i.indexOf("?")?"?":"&")+t+"="+n,hash:c})},t.stripQueryStringValueFromPath=function(e,t){var n=l(e),o=n.pathname,r=n.search,i=n.hash;return a({pathname:o,search:r.replace(new RegExp("([?&])"+t+"=[a-zA-Z0-9]+(&?)"),function(e,t,n){return"?"===t?t:n}),hash:i})},t.getQueryStringValueFromPath=function(e,t){var n=l(e),o=n.search,r=o.match(new RegExp("[?&]"+t+"=([a-zA-Z0-9]+)"));return r&&r[1]},function(e){var t=e.match(/^(https?:)?\/\/[^\/]*/);return null==t?e:e.substring(t[0].length)}),l=t.parsePath=function(e){var t=r(e),n="",o="",l=t.indexOf("#");-1!==l&&(o=t.substring(l),t=t.substring(0,l));var a=t.indexOf("?");return-1!==a&&(n=t.substring(a),t=t.substring(0,a)),""===t&&(t="/"),{pathname:t,search:n,hash:o}},a=t.createPath=function(e){if(null==e||"string"==typeof e)return e;var t=e.basename,n=e.pathname,o=e.search,r=e.hash,l=(t||"")+n;return o&&"?"!==o&&(l+=o),r&&(l+=r),l}},function(e,t,n){var o=n(61);"string"==typeof o&&(o=[[e.i,o,""]]);var r={hmr:!0};r.transform=void 0;n(30)(o,r);o.locals&&(e.exports=o.locals)},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0}),t.default=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];return t.join(" ")}},function(e,t,n){"use strict";var o=n(4);n.n(o)},function(e,t,n){"use strict";function o(e){return e.replace(/[.*+?^${}()|[\]\\]/g,"\\$&")}function r(e){for(var t="",n=[],r=[],l=void 0,a=0,i=/:([a-zA-Z_$][a-zA-Z0-9_$]*)|\*\*|\*|\(|\)|\\\(|\\\)/g;l=i.exec(e);)l.index!==a&&(r.push(e.slice(a,l.index)),t+=o(e.slice(a,l.index))),l[1]?(t+="([^/]+)",n.push(l[1])):"**"===l[0]?(t+="(.*)",n.push("splat")):"*"===l[0]?(t+="(.*?)",n.push("splat")):"("===l[0]?t+="(?:":")"===l[0]?t+=")?":"\\("===l[0]?t+="\\(":"\\)"===l[0]&&(t+="\\)"),r.push(l[0]),a=i.lastIndex;return a!==e.length&&(r.push(e.slice(a,e.length)),t+=o(e.slice(a,e.length))),{pattern:e,regexpSource:t,paramNames:n,tokens:r}}function l(e){return d[e]||(d[e]=r(e)),d[e]}function a(e,t){"/"!==e.charAt(0)&&(e="/"+e);var n=l(e),o=n.regexpSource,r=n.paramNames,a=n.tokens;"/"!==e.charAt(e.length-1)&&(o+="/?"),"*"===a[a.length-1]&&(o+="$");var i=t.match(new RegExp("^"+o,"i"));if(null==i)return null;var c=i[0],s=t.substr(c.length);if(s){if("/"!==c.charAt(c.length-1))return null;s="/"+s}return{remainingPathname:s,paramNames:r,paramValues:i.slice(1).map(function(e){return e&&decodeURIComponent(e)})}}function i(e){return l(e).paramNames}function c(e,t){t=t||{};for(var n=l(e),o=n.tokens,r=0,a="",i=0,c=[],s=void 0,d=void 0,f=void 0,p=0,b=o.length;p<b;++p)if("*"===(s=o[p])||"**"===s)f=Array.isArray(t.splat)?t.splat[i++]:t.splat,null!=f||r>0||u()(!1),null!=f&&(a+=encodeURI(f));else if("("===s)c[r]="",r+=1;else if(")"===s){var h=c.pop();r-=1,r?c[r-1]+=h:a+=h}else if("\\("===s)a+="(";else if("\\)"===s)a+=")";else if(":"===s.charAt(0))if(d=s.substring(1),f=t[d],null!=f||r>0||u()(!1),null==f){if(r){c[r-1]="";for(var m=o.indexOf(s),g=o.slice(m,o.length),_=-1,v=0;v<g.length;v++)if(")"==g[v]){_=v;break}_>0||u()(!1),p=m+_-1}}else r?c[r-1]+=encodeURIComponent(f):a+=encodeURIComponent(f);else r?c[r-1]+=s:a+=s;return r<=0||u()(!1),a.replace(/\/+/g,"/")}t.c=a,t.b=i,t.a=c;var s=n(2),u=n.n(s),d=Object.create(null)},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.locationsAreEqual=t.statesAreEqual=t.createLocation=t.createQuery=void 0;var r="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e},l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(2),i=o(a),c=n(4),s=(o(c),n(6)),u=n(16),d=(t.createQuery=function(e){return l(Object.create(null),e)},t.createLocation=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"/",t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:u.POP,n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:null,o="string"==typeof e?(0,s.parsePath)(e):e;return{pathname:o.pathname||"/",search:o.search||"",hash:o.hash||"",state:o.state,action:t,key:n}},function(e){return"[object Date]"===Object.prototype.toString.call(e)}),f=t.statesAreEqual=function e(t,n){if(t===n)return!0;var o=void 0===t?"undefined":r(t);if(o!==(void 0===n?"undefined":r(n)))return!1;if("function"===o&&(0,i.default)(!1),"object"===o){if(d(t)&&d(n)&&(0,i.default)(!1),!Array.isArray(t)){var l=Object.keys(t),a=Object.keys(n);return l.length===a.length&&l.every(function(o){return e(t[o],n[o])})}return Array.isArray(n)&&t.length===n.length&&t.every(function(t,o){return e(t,n[o])})}return!1};t.locationsAreEqual=function(e,t){return e.key===t.key&&e.pathname===t.pathname&&e.search===t.search&&e.hash===t.hash&&f(e.state,t.state)}},function(e,t,n){"use strict";function o(e,t,n){if(e[t])return new Error("<"+n+'> should not have a "'+t+'" prop')}t.c=o,n.d(t,"a",function(){return l}),n.d(t,"b",function(){return a}),n.d(t,"d",function(){return c});var r=n(0),l=(n.n(r),Object(r.shape)({listen:r.func.isRequired,push:r.func.isRequired,replace:r.func.isRequired,go:r.func.isRequired,goBack:r.func.isRequired,goForward:r.func.isRequired}),Object(r.oneOfType)([r.func,r.string])),a=Object(r.oneOfType)([l,r.object]),i=Object(r.oneOfType)([r.object,r.element]),c=Object(r.oneOfType)([i,Object(r.arrayOf)(i)])},function(e,t,n){"use strict";function o(e){if(null===e||void 0===e)throw new TypeError("Object.assign cannot be called with null or undefined");return Object(e)}var r=Object.getOwnPropertySymbols,l=Object.prototype.hasOwnProperty,a=Object.prototype.propertyIsEnumerable;e.exports=function(){try{if(!Object.assign)return!1;var e=new String("abc");if(e[5]="de","5"===Object.getOwnPropertyNames(e)[0])return!1;for(var t={},n=0;n<10;n++)t["_"+String.fromCharCode(n)]=n;if("0123456789"!==Object.getOwnPropertyNames(t).map(function(e){return t[e]}).join(""))return!1;var o={};return"abcdefghijklmnopqrst".split("").forEach(function(e){o[e]=e}),"abcdefghijklmnopqrst"===Object.keys(Object.assign({},o)).join("")}catch(e){return!1}}()?Object.assign:function(e,t){for(var n,i,c=o(e),s=1;s<arguments.length;s++){n=Object(arguments[s]);for(var u in n)l.call(n,u)&&(c[u]=n[u]);if(r){i=r(n);for(var d=0;d<i.length;d++)a.call(n,i[d])&&(c[i[d]]=n[i[d]])}}return c}},function(e,t,n){"use strict";function o(e){return function(){return e}}var r=function(){};r.thatReturns=o,r.thatReturnsFalse=o(!1),r.thatReturnsTrue=o(!0),r.thatReturnsNull=o(null),r.thatReturnsThis=function(){return this},r.thatReturnsArgument=function(e){return e},e.exports=r},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){var e=new Date(1e3*this.props.ts);return s.default.createElement("time",{dateTime:e.toISOString()},e.toISOString().slice(0,19).replace(/-/g,"/").replace("T"," "))}}]),t}(s.default.Component);f.propTypes={ts:d.default.number.isRequired},t.default=f},function(e,t,n){"use strict";t.__esModule=!0;t.PUSH="PUSH",t.REPLACE="REPLACE",t.POP="POP"},function(e,t,n){"use strict";t.__esModule=!0;t.addEventListener=function(e,t,n){return e.addEventListener?e.addEventListener(t,n,!1):e.attachEvent("on"+t,n)},t.removeEventListener=function(e,t,n){return e.removeEventListener?e.removeEventListener(t,n,!1):e.detachEvent("on"+t,n)},t.supportsHistory=function(){var e=window.navigator.userAgent;return(-1===e.indexOf("Android 2.")&&-1===e.indexOf("Android 4.0")||-1===e.indexOf("Mobile Safari")||-1!==e.indexOf("Chrome")||-1!==e.indexOf("Windows Phone"))&&(window.history&&"pushState"in window.history)},t.supportsGoWithoutReloadUsingHash=function(){return-1===window.navigator.userAgent.indexOf("Firefox")},t.supportsPopstateOnHashchange=function(){return-1===window.navigator.userAgent.indexOf("Trident")},t.isExtraneousPopstateEvent=function(e){return void 0===e.state&&-1===navigator.userAgent.indexOf("CriOS")}},function(e,t,n){"use strict";var o={};e.exports=o},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(7),p=o(f),b=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"shouldShow",value:function(e){return 1==e||e==this.totalPage||Math.abs(this.props.page-e)<=1}},{key:"render",value:function(){if(0==this.totalPage)return null;for(var e=[],t=1;t<=this.totalPage;t++)t==this.props.page?e.push(s.default.createElement("li",{key:t,className:p.default.active},s.default.createElement("span",null,t))):this.shouldShow(t)?e.push(s.default.createElement("li",{key:t},s.default.createElement("a",{onClick:this.props.jumpTo(t)},t))):this.shouldShow(t-1)&&e.push(s.default.createElement("li",{key:t,className:p.default.disabled},s.default.createElement("span",null,"..")));return s.default.createElement("ul",{className:p.default.pagination},e)}},{key:"totalPage",get:function(){return Math.ceil(this.props.totalCount/this.props.perPage)}}]),t}(s.default.Component);b.propTypes={page:d.default.number.isRequired,perPage:d.default.number.isRequired,totalCount:d.default.number.isRequired,jumpTo:d.default.func.isRequired},t.default=b},function(e,t,n){"use strict";function o(e,t,n){function o(){if(a=!0,i)return void(s=[].concat(Array.prototype.slice.call(arguments)));n.apply(this,arguments)}function r(){if(!a&&(c=!0,!i)){for(i=!0;!a&&l<e&&c;)c=!1,t.call(this,l++,r,o);if(i=!1,a)return void n.apply(this,s);l>=e&&c&&(a=!0,n())}}var l=0,a=!1,i=!1,c=!1,s=void 0;r()}function r(e,t,n){function o(e,t,o){a||(t?(a=!0,n(t)):(l[e]=o,(a=++i===r)&&n(null,l)))}var r=e.length,l=[];if(0===r)return n(null,l);var a=!1,i=0;e.forEach(function(e,n){t(e,n,function(e,t){o(n,e,t)})})}t.a=o,t.b=r},function(e,t,n){"use strict";var o=n(2),r=n.n(o),l=n(1),a=n.n(l),i=n(3),c=n.n(i),s=n(0),u=(n.n(s),n(74)),d=n(22),f=n(5),p=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},b="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e},h=c()({displayName:"RouterContext",mixins:[Object(d.a)("router")],propTypes:{router:s.object.isRequired,location:s.object.isRequired,routes:s.array.isRequired,params:s.object.isRequired,components:s.array.isRequired,createElement:s.func.isRequired},getDefaultProps:function(){return{createElement:a.a.createElement}},childContextTypes:{router:s.object.isRequired},getChildContext:function(){return{router:this.props.router}},createElement:function(e,t){return null==e?null:this.props.createElement(e,t)},render:function(){var e=this,t=this.props,n=t.location,o=t.routes,l=t.params,i=t.components,c=t.router,s=null;return i&&(s=i.reduceRight(function(t,r,a){if(null==r)return t;var i=o[a],s=Object(u.a)(i,l),d={location:n,params:l,route:i,router:c,routeParams:s,routes:o};if(Object(f.c)(t))d.children=t;else if(t)for(var h in t)Object.prototype.hasOwnProperty.call(t,h)&&(d[h]=t[h]);if("object"===(void 0===r?"undefined":b(r))){var m={};for(var g in r)Object.prototype.hasOwnProperty.call(r,g)&&(m[g]=e.createElement(r[g],p({key:g},d)));return m}return e.createElement(r,d)},s)),null===s||!1===s||a.a.isValidElement(s)||r()(!1),s}});t.a=h},function(e,t,n){"use strict";function o(e){return"@@contextSubscriber/"+e}function r(e){var t,n,r=o(e),l=r+"/listeners",a=r+"/eventIndex",i=r+"/subscribe";return n={childContextTypes:(t={},t[r]=c.isRequired,t),getChildContext:function(){var e;return e={},e[r]={eventIndex:this[a],subscribe:this[i]},e},componentWillMount:function(){this[l]=[],this[a]=0},componentWillReceiveProps:function(){this[a]++},componentDidUpdate:function(){var e=this;this[l].forEach(function(t){return t(e[a])})}},n[i]=function(e){var t=this;return this[l].push(e),function(){t[l]=t[l].filter(function(t){return t!==e})}},n}function l(e){var t,n,r=o(e),l=r+"/lastRenderedEventIndex",a=r+"/handleContextUpdate",i=r+"/unsubscribe";return n={contextTypes:(t={},t[r]=c,t),getInitialState:function(){var e;return this.context[r]?(e={},e[l]=this.context[r].eventIndex,e):{}},componentDidMount:function(){this.context[r]&&(this[i]=this.context[r].subscribe(this[a]))},componentWillReceiveProps:function(){var e;this.context[r]&&this.setState((e={},e[l]=this.context[r].eventIndex,e))},componentWillUnmount:function(){this[i]&&(this[i](),this[i]=null)}},n[a]=function(e){if(e!==this.state[l]){var t;this.setState((t={},t[l]=e,t))}},n}t.a=r,t.b=l;var a=n(0),i=n.n(a),c=i.a.shape({subscribe:i.a.func.isRequired,eventIndex:i.a.number.isRequired})},function(e,t,n){"use strict";n.d(t,"b",function(){return r}),n.d(t,"a",function(){return l});var o=n(0),r=(n.n(o),Object(o.shape)({push:o.func.isRequired,replace:o.func.isRequired,go:o.func.isRequired,goBack:o.func.isRequired,goForward:o.func.isRequired,setRouteLeaveHook:o.func.isRequired,isActive:o.func.isRequired})),l=Object(o.shape)({pathname:o.string.isRequired,search:o.string.isRequired,state:o.object,action:o.string.isRequired,key:o.string})},function(e,t,n){"use strict";t.__esModule=!0;var o=n(4),r=(function(e){e&&e.__esModule}(o),function(e,t,n){var o=e(t,n);e.length<2&&n(o)});t.default=r},function(e,t,n){"use strict";t.__esModule=!0;var o=n(85),r=n(6),l=n(24),a=function(e){return e&&e.__esModule?e:{default:e}}(l),i=n(16),c=n(11),s=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},t=e.getCurrentLocation,n=e.getUserConfirmation,l=e.pushLocation,s=e.replaceLocation,u=e.go,d=e.keyLength,f=void 0,p=void 0,b=[],h=[],m=[],g=function(){return p&&p.action===i.POP?m.indexOf(p.key):f?m.indexOf(f.key):-1},_=function(e){var t=g();f=e,f.action===i.PUSH?m=[].concat(m.slice(0,t+1),[f.key]):f.action===i.REPLACE&&(m[t]=f.key),h.forEach(function(e){return e(f)})},v=function(e){return b.push(e),function(){return b=b.filter(function(t){return t!==e})}},y=function(e){return h.push(e),function(){return h=h.filter(function(t){return t!==e})}},x=function(e,t){(0,o.loopAsync)(b.length,function(t,n,o){(0,a.default)(b[t],e,function(e){return null!=e?o(e):n()})},function(e){n&&"string"==typeof e?n(e,function(e){return t(!1!==e)}):t(!1!==e)})},w=function(e){f&&(0,c.locationsAreEqual)(f,e)||p&&(0,c.locationsAreEqual)(p,e)||(p=e,x(e,function(t){if(p===e)if(p=null,t){if(e.action===i.PUSH){var n=(0,r.createPath)(f),o=(0,r.createPath)(e);o===n&&(0,c.statesAreEqual)(f.state,e.state)&&(e.action=i.REPLACE)}e.action===i.POP?_(e):e.action===i.PUSH?!1!==l(e)&&_(e):e.action===i.REPLACE&&!1!==s(e)&&_(e)}else if(f&&e.action===i.POP){var a=m.indexOf(f.key),d=m.indexOf(e.key);-1!==a&&-1!==d&&u(a-d)}}))},k=function(e){return w(L(e,i.PUSH))},E=function(e){return w(L(e,i.REPLACE))},C=function(){return u(-1)},O=function(){return u(1)},P=function(){return Math.random().toString(36).substr(2,d||6)},M=function(e){return(0,r.createPath)(e)},L=function(e,t){var n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:P();return(0,c.createLocation)(e,t,n)};return{getCurrentLocation:t,listenBefore:v,listen:y,transitionTo:w,push:k,replace:E,go:u,goBack:C,goForward:O,createKey:P,createPath:r.createPath,createHref:M,createLocation:L}};t.default=s},function(e,t,n){"use strict";t.__esModule=!0;t.canUseDOM=!("undefined"==typeof window||!window.document||!window.document.createElement)},function(e,t,n){"use strict";t.__esModule=!0,t.go=t.replaceLocation=t.pushLocation=t.startListener=t.getUserConfirmation=t.getCurrentLocation=void 0;var o=n(11),r=n(17),l=n(40),a=n(6),i=n(26),c=i.canUseDOM&&!(0,r.supportsPopstateOnHashchange)(),s=function(e){var t=e&&e.key;return(0,o.createLocation)({pathname:window.location.pathname,search:window.location.search,hash:window.location.hash,state:t?(0,l.readState)(t):void 0},void 0,t)},u=t.getCurrentLocation=function(){var e=void 0;try{e=window.history.state||{}}catch(t){e={}}return s(e)},d=(t.getUserConfirmation=function(e,t){return t(window.confirm(e))},t.startListener=function(e){var t=function(t){(0,r.isExtraneousPopstateEvent)(t)||e(s(t.state))};(0,r.addEventListener)(window,"popstate",t);var n=function(){return e(u())};return c&&(0,r.addEventListener)(window,"hashchange",n),function(){(0,r.removeEventListener)(window,"popstate",t),c&&(0,r.removeEventListener)(window,"hashchange",n)}},function(e,t){var n=e.state,o=e.key;void 0!==n&&(0,l.saveState)(o,n),t({key:o},(0,a.createPath)(e))});t.pushLocation=function(e){return d(e,function(e,t){return window.history.pushState(e,null,t)})},t.replaceLocation=function(e){return d(e,function(e,t){return window.history.replaceState(e,null,t)})},t.go=function(e){e&&window.history.go(e)}},function(e,t,n){"use strict";function o(e,t,n,o,l,a,i,c){if(r(t),!e){var s;if(void 0===t)s=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var u=[n,o,l,a,i,c],d=0;s=new Error(t.replace(/%s/g,function(){return u[d++]})),s.name="Invariant Violation"}throw s.framesToPop=1,s}}var r=function(e){};e.exports=o},function(e,t){function n(e,t){var n=e[1]||"",r=e[3];if(!r)return n;if(t&&"function"==typeof btoa){var l=o(r);return[n].concat(r.sources.map(function(e){return"/*# sourceURL="+r.sourceRoot+e+" */"})).concat([l]).join("\n")}return[n].join("\n")}function o(e){return"/*# sourceMappingURL=data:application/json;charset=utf-8;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(e))))+" */"}e.exports=function(e){var t=[];return t.toString=function(){return this.map(function(t){var o=n(t,e);return t[2]?"@media "+t[2]+"{"+o+"}":o}).join("")},t.i=function(e,n){"string"==typeof e&&(e=[[null,e,""]]);for(var o={},r=0;r<this.length;r++){var l=this[r][0];"number"==typeof l&&(o[l]=!0)}for(r=0;r<e.length;r++){var a=e[r];"number"==typeof a[0]&&o[a[0]]||(n&&!a[2]?a[2]=n:n&&(a[2]="("+a[2]+") and ("+n+")"),t.push(a))}},t}},function(e,t,n){function o(e,t){for(var n=0;n<e.length;n++){var o=e[n],r=b[o.id];if(r){r.refs++;for(var l=0;l<r.parts.length;l++)r.parts[l](o.parts[l]);for(;l<o.parts.length;l++)r.parts.push(u(o.parts[l],t))}else{for(var a=[],l=0;l<o.parts.length;l++)a.push(u(o.parts[l],t));b[o.id]={id:o.id,refs:1,parts:a}}}}function r(e,t){for(var n=[],o={},r=0;r<e.length;r++){var l=e[r],a=t.base?l[0]+t.base:l[0],i=l[1],c=l[2],s=l[3],u={css:i,media:c,sourceMap:s};o[a]?o[a].parts.push(u):n.push(o[a]={id:a,parts:[u]})}return n}function l(e,t){var n=m(e.insertInto);if(!n)throw new Error("Couldn't find a style target. This probably means that the value for the 'insertInto' parameter is invalid.");var o=v[v.length-1];if("top"===e.insertAt)o?o.nextSibling?n.insertBefore(t,o.nextSibling):n.appendChild(t):n.insertBefore(t,n.firstChild),v.push(t);else if("bottom"===e.insertAt)n.appendChild(t);else{if("object"!=typeof e.insertAt||!e.insertAt.before)throw new Error("[Style Loader]\n\n Invalid value for parameter 'insertAt' ('options.insertAt') found.\n Must be 'top', 'bottom', or Object.\n (https://github.com/webpack-contrib/style-loader#insertat)\n");var r=m(e.insertInto+" "+e.insertAt.before);n.insertBefore(t,r)}}function a(e){if(null===e.parentNode)return!1;e.parentNode.removeChild(e);var t=v.indexOf(e);t>=0&&v.splice(t,1)}function i(e){var t=document.createElement("style");return e.attrs.type="text/css",s(t,e.attrs),l(e,t),t}function c(e){var t=document.createElement("link");return e.attrs.type="text/css",e.attrs.rel="stylesheet",s(t,e.attrs),l(e,t),t}function s(e,t){Object.keys(t).forEach(function(n){e.setAttribute(n,t[n])})}function u(e,t){var n,o,r,l;if(t.transform&&e.css){if(!(l=t.transform(e.css)))return function(){};e.css=l}if(t.singleton){var s=_++;n=g||(g=i(t)),o=d.bind(null,n,s,!1),r=d.bind(null,n,s,!0)}else e.sourceMap&&"function"==typeof URL&&"function"==typeof URL.createObjectURL&&"function"==typeof URL.revokeObjectURL&&"function"==typeof Blob&&"function"==typeof btoa?(n=c(t),o=p.bind(null,n,t),r=function(){a(n),n.href&&URL.revokeObjectURL(n.href)}):(n=i(t),o=f.bind(null,n),r=function(){a(n)});return o(e),function(t){if(t){if(t.css===e.css&&t.media===e.media&&t.sourceMap===e.sourceMap)return;o(e=t)}else r()}}function d(e,t,n,o){var r=n?"":o.css;if(e.styleSheet)e.styleSheet.cssText=x(t,r);else{var l=document.createTextNode(r),a=e.childNodes;a[t]&&e.removeChild(a[t]),a.length?e.insertBefore(l,a[t]):e.appendChild(l)}}function f(e,t){var n=t.css,o=t.media;if(o&&e.setAttribute("media",o),e.styleSheet)e.styleSheet.cssText=n;else{for(;e.firstChild;)e.removeChild(e.firstChild);e.appendChild(document.createTextNode(n))}}function p(e,t,n){var o=n.css,r=n.sourceMap,l=void 0===t.convertToAbsoluteUrls&&r;(t.convertToAbsoluteUrls||l)&&(o=y(o)),r&&(o+="\n/*# sourceMappingURL=data:application/json;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(r))))+" */");var a=new Blob([o],{type:"text/css"}),i=e.href;e.href=URL.createObjectURL(a),i&&URL.revokeObjectURL(i)}var b={},h=function(e){var t;return function(){return void 0===t&&(t=e.apply(this,arguments)),t}}(function(){return window&&document&&document.all&&!window.atob}),m=function(e){var t={};return function(n){if(void 0===t[n]){var o=e.call(this,n);if(o instanceof window.HTMLIFrameElement)try{o=o.contentDocument.head}catch(e){o=null}t[n]=o}return t[n]}}(function(e){return document.querySelector(e)}),g=null,_=0,v=[],y=n(60);e.exports=function(e,t){if("undefined"!=typeof DEBUG&&DEBUG&&"object"!=typeof document)throw new Error("The style-loader cannot be used in a non-browser environment");t=t||{},t.attrs="object"==typeof t.attrs?t.attrs:{},t.singleton||(t.singleton=h()),t.insertInto||(t.insertInto="head"),t.insertAt||(t.insertAt="bottom");var n=r(e,t);return o(n,t),function(e){for(var l=[],a=0;a<n.length;a++){var i=n[a],c=b[i.id];c.refs--,l.push(c)}if(e){o(r(e,t),t)}for(var a=0;a<l.length;a++){var c=l[a];if(0===c.refs){for(var s=0;s<c.parts.length;s++)c.parts[s]();delete b[c.id]}}}};var x=function(){var e=[];return function(t,n){return e[t]=n,e.filter(Boolean).join("\n")}}()},function(e,t,n){"use strict";function o(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!0;return!1}function r(e,t){function n(t,n){return t=e.createLocation(t),Object(i.a)(t,n,v.location,v.routes,v.params)}function r(e,n){E&&E.location===e?d(E,n):Object(s.a)(t,e,function(t,o){t?n(t):o?d(u({},o,{location:e}),n):n()})}function d(e,t){function n(n,r){if(n||r)return o(n,r);Object(c.a)(e,function(n,o){n?t(n):t(null,null,v=u({},e,{components:o}))})}function o(e,n){e?t(e):t(null,n)}var r=Object(l.a)(v,e),a=r.leaveRoutes,i=r.changeRoutes,s=r.enterRoutes;k(a,v),a.filter(function(e){return-1===s.indexOf(e)}).forEach(m),w(i,v,e,function(t,r){if(t||r)return o(t,r);x(s,e,n)})}function f(e){var t=arguments.length>1&&void 0!==arguments[1]&&arguments[1];return e.__id__||t&&(e.__id__=C++)}function p(e){return e.map(function(e){return O[f(e)]}).filter(function(e){return e})}function b(e,n){Object(s.a)(t,e,function(t,o){if(null==o)return void n();E=u({},o,{location:e});for(var r=p(Object(l.a)(v,E).leaveRoutes),a=void 0,i=0,c=r.length;null==a&&i<c;++i)a=r[i](e);n(a)})}function h(){if(v.routes){for(var e=p(v.routes),t=void 0,n=0,o=e.length;"string"!=typeof t&&n<o;++n)t=e[n]();return t}}function m(e){var t=f(e);t&&(delete O[t],o(O)||(P&&(P(),P=null),M&&(M(),M=null)))}function g(t,n){var r=!o(O),l=f(t,!0);return O[l]=n,r&&(P=e.listenBefore(b),e.listenBeforeUnload&&(M=e.listenBeforeUnload(h))),function(){m(t)}}function _(t){function n(n){v.location===n?t(null,v):r(n,function(n,o,r){n?t(n):o?e.replace(o):r&&t(null,r)})}var o=e.listen(n);return v.location?t(null,v):n(e.getCurrentLocation()),o}var v={},y=Object(a.a)(),x=y.runEnterHooks,w=y.runChangeHooks,k=y.runLeaveHooks,E=void 0,C=1,O=Object.create(null),P=void 0,M=void 0;return{isActive:n,match:r,listenBeforeLeavingRoute:g,listen:_}}t.a=r;var l=(n(9),n(69)),a=n(70),i=n(71),c=n(72),s=n(73),u=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}},function(e,t,n){"use strict";function o(e){return e&&"function"==typeof e.then}t.a=o},function(e,t,n){"use strict";function o(e,t,n){return r(l({},e,{setRouteLeaveHook:t.listenBeforeLeavingRoute,isActive:t.isActive}),n)}function r(e,t){var n=t.location,o=t.params,r=t.routes;return e.location=n,e.params=o,e.routes=r,e}t.b=o,t.a=r;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}},function(e,t,n){"use strict";function o(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function r(e){return 0===e.button}function l(e){return!!(e.metaKey||e.altKey||e.ctrlKey||e.shiftKey)}function a(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!1;return!0}function i(e,t){return"function"==typeof e?e(t.location):e}var c=n(1),s=n.n(c),u=n(3),d=n.n(u),f=n(0),p=(n.n(f),n(2)),b=n.n(p),h=n(23),m=n(22),g=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},_=d()({displayName:"Link",mixins:[Object(m.b)("router")],contextTypes:{router:h.b},propTypes:{to:Object(f.oneOfType)([f.string,f.object,f.func]),activeStyle:f.object,activeClassName:f.string,onlyActiveOnIndex:f.bool.isRequired,onClick:f.func,target:f.string},getDefaultProps:function(){return{onlyActiveOnIndex:!1,style:{}}},handleClick:function(e){if(this.props.onClick&&this.props.onClick(e),!e.defaultPrevented){var t=this.context.router;t||b()(!1),!l(e)&&r(e)&&(this.props.target||(e.preventDefault(),t.push(i(this.props.to,t))))}},render:function(){var e=this.props,t=e.to,n=e.activeClassName,r=e.activeStyle,l=e.onlyActiveOnIndex,c=o(e,["to","activeClassName","activeStyle","onlyActiveOnIndex"]),u=this.context.router;if(u){if(!t)return s.a.createElement("a",c);var d=i(t,u);c.href=u.createHref(d),(n||null!=r&&!a(r))&&u.isActive(d,l)&&(n&&(c.className?c.className+=" "+n:c.className=n),r&&(c.style=g({},c.style,r)))}return s.a.createElement("a",g({},c,{onClick:this.handleClick}))}});t.a=_},function(e,t,n){"use strict";var o=n(3),r=n.n(o),l=n(0),a=(n.n(l),n(2)),i=n.n(a),c=n(5),s=n(10),u=n(12),d=r()({displayName:"Redirect",statics:{createRouteFromReactElement:function(e){var t=Object(c.a)(e);return t.from&&(t.path=t.from),t.onEnter=function(e,n){var o=e.location,r=e.params,l=void 0;if("/"===t.to.charAt(0))l=Object(s.a)(t.to,r);else if(t.to){var a=e.routes.indexOf(t),i=d.getRoutePattern(e.routes,a-1),c=i.replace(/\/*$/,"/")+t.to;l=Object(s.a)(c,r)}else l=o.pathname;n({pathname:l,query:t.query||o.query,state:t.state||o.state})},t},getRoutePattern:function(e,t){for(var n="",o=t;o>=0;o--){var r=e[o],l=r.path||"";if(n=l.replace(/\/*$/,"/")+n,0===l.indexOf("/"))break}return"/"+n}},propTypes:{path:l.string,from:l.string,to:l.string.isRequired,query:l.object,state:l.object,onEnter:u.c,children:u.c},render:function(){i()(!1)}});t.a=d},function(e,t,n){"use strict";function o(e){var t=s()(e),n=function(){return t};return l()(i()(n))(e)}t.a=o;var r=n(37),l=n.n(r),a=n(38),i=n.n(a),c=n(84),s=n.n(c)},function(e,t,n){"use strict";t.__esModule=!0;var o=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},r=n(82),l=n(24),a=function(e){return e&&e.__esModule?e:{default:e}}(l),i=n(11),c=n(6),s=function(e){return(0,r.stringify)(e).replace(/%20/g,"+")},u=r.parse,d=function(e){return function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},n=e(t),r=t.stringifyQuery,l=t.parseQueryString;"function"!=typeof r&&(r=s),"function"!=typeof l&&(l=u);var d=function(e){return e?(null==e.query&&(e.query=l(e.search.substring(1))),e):e},f=function(e,t){if(null==t)return e;var n="string"==typeof e?(0,c.parsePath)(e):e,l=r(t);return o({},n,{search:l?"?"+l:""})};return o({},n,{getCurrentLocation:function(){return d(n.getCurrentLocation())},listenBefore:function(e){return n.listenBefore(function(t,n){return(0,a.default)(e,d(t),n)})},listen:function(e){return n.listen(function(t){return e(d(t))})},push:function(e){return n.push(f(e,e.query))},replace:function(e){return n.replace(f(e,e.query))},createPath:function(e){return n.createPath(f(e,e.query))},createHref:function(e){return n.createHref(f(e,e.query))},createLocation:function(e){for(var t=arguments.length,o=Array(t>1?t-1:0),r=1;r<t;r++)o[r-1]=arguments[r];var l=n.createLocation.apply(n,[f(e,e.query)].concat(o));return e.query&&(l.query=(0,i.createQuery)(e.query)),d(l)}})}};t.default=d},function(e,t,n){"use strict";t.__esModule=!0;var o=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},r=n(24),l=function(e){return e&&e.__esModule?e:{default:e}}(r),a=n(6),i=function(e){return function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},n=e(t),r=t.basename,i=function(e){return e?(r&&null==e.basename&&(0===e.pathname.toLowerCase().indexOf(r.toLowerCase())?(e.pathname=e.pathname.substring(r.length),e.basename=r,""===e.pathname&&(e.pathname="/")):e.basename=""),e):e},c=function(e){if(!r)return e;var t="string"==typeof e?(0,a.parsePath)(e):e,n=t.pathname,l="/"===r.slice(-1)?r:r+"/",i="/"===n.charAt(0)?n.slice(1):n;return o({},t,{pathname:l+i})};return o({},n,{getCurrentLocation:function(){return i(n.getCurrentLocation())},listenBefore:function(e){return n.listenBefore(function(t,n){return(0,l.default)(e,i(t),n)})},listen:function(e){return n.listen(function(t){return e(i(t))})},push:function(e){return n.push(c(e))},replace:function(e){return n.replace(c(e))},createPath:function(e){return n.createPath(c(e))},createHref:function(e){return n.createHref(c(e))},createLocation:function(e){for(var t=arguments.length,o=Array(t>1?t-1:0),r=1;r<t;r++)o[r-1]=arguments[r];return i(n.createLocation.apply(n,[c(e)].concat(o)))}})}};t.default=i},function(e,t,n){"use strict";function o(e){return function(t){return l()(i()(e))(t)}}t.a=o;var r=n(37),l=n.n(r),a=n(38),i=n.n(a)},function(e,t,n){"use strict";t.__esModule=!0,t.readState=t.saveState=void 0;var o=n(4),r=(function(e){e&&e.__esModule}(o),{QuotaExceededError:!0,QUOTA_EXCEEDED_ERR:!0}),l={SecurityError:!0},a=function(e){return"@@History/"+e};t.saveState=function(e,t){if(window.sessionStorage)try{null==t?window.sessionStorage.removeItem(a(e)):window.sessionStorage.setItem(a(e),JSON.stringify(t))}catch(e){if(l[e.name])return;if(r[e.name]&&0===window.sessionStorage.length)return;throw e}},t.readState=function(e){var t=void 0;try{t=window.sessionStorage.getItem(a(e))}catch(e){if(l[e.name])return}if(t)try{return JSON.parse(t)}catch(e){}}},function(e,t,n){"use strict";function o(e){var t=void 0;return l&&(t=Object(r.a)(e)()),t}t.a=o;var r=n(39),l=!("undefined"==typeof window||!window.document||!window.document.createElement)},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(46),p=n(56),b=o(p),h=n(62),m=o(h),g=n(63),_=o(g),v=n(64),y=o(v),x=n(65),w=o(x),k=n(66),E=n(7),C=o(E),O=n(8),P=o(O),M=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){return s.default.createElement("div",{className:C.default.container,style:{marginTop:30,marginBottom:60}},s.default.createElement("header",null,s.default.createElement("h1",null,"gocraft/work")),s.default.createElement("hr",null),s.default.createElement("div",{className:C.default.row},s.default.createElement("main",{className:C.default.colMd10},this.props.children),s.default.createElement("aside",{className:C.default.colMd2},s.default.createElement("nav",null,s.default.createElement("ul",{className:(0,P.default)(C.default.nav,C.default.navPills,C.default.navStacked)},s.default.createElement("li",null,s.default.createElement(k.Link,{to:"/processes"},"Processes")),s.default.createElement("li",null,s.default.createElement(k.Link,{to:"/queues"},"Queues")),s.default.createElement("li",null,s.default.createElement(k.Link,{to:"/retry_jobs"},"Retry Jobs")),s.default.createElement("li",null,s.default.createElement(k.Link,{to:"/scheduled_jobs"},"Scheduled Jobs")),s.default.createElement("li",null,s.default.createElement(k.Link,{to:"/dead_jobs"},"Dead Jobs")))))))}}],[{key:"apiURL",value:function(e){return location.pathname.slice(0,-1)+e}}]),t}(s.default.Component);M.propTypes={children:d.default.element.isRequired},(0,f.render)(s.default.createElement(k.Router,{history:k.hashHistory},s.default.createElement(k.Route,{path:"/",component:M},s.default.createElement(k.Route,{path:"/processes",component:function(){return s.default.createElement(b.default,{busyWorkerURL:M.apiURL("/busy_workers"),workerPoolURL:M.apiURL("/worker_pools")})}}),s.default.createElement(k.Route,{path:"/queues",component:function(){return s.default.createElement(_.default,{url:M.apiURL("/queues")})}}),s.default.createElement(k.Route,{path:"/retry_jobs",component:function(){return s.default.createElement(y.default,{url:M.apiURL("/retry_jobs")})}}),s.default.createElement(k.Route,{path:"/scheduled_jobs",component:function(){return s.default.createElement(w.default,{url:M.apiURL("/scheduled_jobs")})}}),s.default.createElement(k.Route,{path:"/dead_jobs",component:function(){return s.default.createElement(m.default,{fetchURL:M.apiURL("/dead_jobs"),retryURL:M.apiURL("/retry_dead_job"),retryAllURL:M.apiURL("/retry_all_dead_jobs"),deleteURL:M.apiURL("/delete_dead_job"),deleteAllURL:M.apiURL("/delete_all_dead_jobs")})}}),s.default.createElement(k.IndexRedirect,{from:"",to:"/processes"}))),document.getElementById("app"))},function(e,t,n){"use strict";function o(e){for(var t=arguments.length-1,n="Minified React error #"+e+"; visit http://facebook.github.io/react/docs/error-decoder.html?invariant="+e,o=0;o<t;o++)n+="&args[]="+encodeURIComponent(arguments[o+1]);throw t=Error(n+" for the full message or use the non-minified dev environment for full errors and additional helpful warnings."),t.name="Invariant Violation",t.framesToPop=1,t}function r(e,t,n){this.props=e,this.context=t,this.refs=v,this.updater=n||M}function l(e,t,n){this.props=e,this.context=t,this.refs=v,this.updater=n||M}function a(){}function i(e,t,n){this.props=e,this.context=t,this.refs=v,this.updater=n||M}function c(e,t,n){var o,r={},l=null,a=null;if(null!=t)for(o in void 0!==t.ref&&(a=t.ref),void 0!==t.key&&(l=""+t.key),t)S.call(t,o)&&!T.hasOwnProperty(o)&&(r[o]=t[o]);var i=arguments.length-2;if(1===i)r.children=n;else if(1<i){for(var c=Array(i),s=0;s<i;s++)c[s]=arguments[s+2];r.children=c}if(e&&e.defaultProps)for(o in i=e.defaultProps)void 0===r[o]&&(r[o]=i[o]);return{$$typeof:w,type:e,key:l,ref:a,props:r,_owner:j.current}}function s(e){return"object"==typeof e&&null!==e&&e.$$typeof===w}function u(e){var t={"=":"=0",":":"=2"};return"$"+(""+e).replace(/[=:]/g,function(e){return t[e]})}function d(e,t,n,o){if(U.length){var r=U.pop();return r.result=e,r.keyPrefix=t,r.func=n,r.context=o,r.count=0,r}return{result:e,keyPrefix:t,func:n,context:o,count:0}}function f(e){e.result=null,e.keyPrefix=null,e.func=null,e.context=null,e.count=0,10>U.length&&U.push(e)}function p(e,t,n,r){var l=typeof e;"undefined"!==l&&"boolean"!==l||(e=null);var a=!1;if(null===e)a=!0;else switch(l){case"string":case"number":a=!0;break;case"object":switch(e.$$typeof){case w:case k:case E:case C:a=!0}}if(a)return n(r,e,""===t?"."+b(e,0):t),1;if(a=0,t=""===t?".":t+":",Array.isArray(e))for(var i=0;i<e.length;i++){l=e[i];var c=t+b(l,i);a+=p(l,c,n,r)}else if(null===e||void 0===e?c=null:(c=P&&e[P]||e["@@iterator"],c="function"==typeof c?c:null),"function"==typeof c)for(e=c.call(e),i=0;!(l=e.next()).done;)l=l.value,c=t+b(l,i++),a+=p(l,c,n,r);else"object"===l&&(n=""+e,o("31","[object Object]"===n?"object with keys {"+Object.keys(e).join(", ")+"}":n,""));return a}function b(e,t){return"object"==typeof e&&null!==e&&null!=e.key?u(e.key):t.toString(36)}function h(e,t){e.func.call(e.context,t,e.count++)}function m(e,t,n){var o=e.result,r=e.keyPrefix;e=e.func.call(e.context,t,e.count++),Array.isArray(e)?g(e,o,n,y.thatReturnsArgument):null!=e&&(s(e)&&(t=r+(!e.key||t&&t.key===e.key?"":(""+e.key).replace(H,"$&/")+"/")+n,e={$$typeof:w,type:e.type,key:t,ref:e.ref,props:e.props,_owner:e._owner}),o.push(e))}function g(e,t,n,o,r){var l="";null!=n&&(l=(""+n).replace(H,"$&/")+"/"),t=d(t,l,o,r),null==e||p(e,"",m,t),f(t)}var _=n(13),v=n(18),y=n(14),x="function"==typeof Symbol&&Symbol.for,w=x?Symbol.for("react.element"):60103,k=x?Symbol.for("react.call"):60104,E=x?Symbol.for("react.return"):60105,C=x?Symbol.for("react.portal"):60106,O=x?Symbol.for("react.fragment"):60107,P="function"==typeof Symbol&&Symbol.iterator,M={isMounted:function(){return!1},enqueueForceUpdate:function(){},enqueueReplaceState:function(){},enqueueSetState:function(){}};r.prototype.isReactComponent={},r.prototype.setState=function(e,t){"object"!=typeof e&&"function"!=typeof e&&null!=e&&o("85"),this.updater.enqueueSetState(this,e,t,"setState")},r.prototype.forceUpdate=function(e){this.updater.enqueueForceUpdate(this,e,"forceUpdate")},a.prototype=r.prototype;var L=l.prototype=new a;L.constructor=l,_(L,r.prototype),L.isPureReactComponent=!0;var R=i.prototype=new a;R.constructor=i,_(R,r.prototype),R.unstable_isAsyncReactComponent=!0,R.render=function(){return this.props.children};var j={current:null},S=Object.prototype.hasOwnProperty,T={key:!0,ref:!0,__self:!0,__source:!0},H=/\/+/g,U=[],N={Children:{map:function(e,t,n){if(null==e)return e;var o=[];return g(e,o,null,t,n),o},forEach:function(e,t,n){if(null==e)return e;t=d(null,null,t,n),null==e||p(e,"",h,t),f(t)},count:function(e){return null==e?0:p(e,"",y.thatReturnsNull,null)},toArray:function(e){var t=[];return g(e,t,null,y.thatReturnsArgument),t},only:function(e){return s(e)||o("143"),e}},Component:r,PureComponent:l,unstable_AsyncComponent:i,Fragment:O,createElement:c,cloneElement:function(e,t,n){var o=_({},e.props),r=e.key,l=e.ref,a=e._owner;if(null!=t){if(void 0!==t.ref&&(l=t.ref,a=j.current),void 0!==t.key&&(r=""+t.key),e.type&&e.type.defaultProps)var i=e.type.defaultProps;for(c in t)S.call(t,c)&&!T.hasOwnProperty(c)&&(o[c]=void 0===t[c]&&void 0!==i?i[c]:t[c])}var c=arguments.length-2;if(1===c)o.children=n;else if(1<c){i=Array(c);for(var s=0;s<c;s++)i[s]=arguments[s+2];o.children=i}return{$$typeof:w,type:e.type,key:r,ref:l,props:o,_owner:a}},createFactory:function(e){var t=c.bind(null,e);return t.type=e,t},isValidElement:s,version:"16.2.0",__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED:{ReactCurrentOwner:j,assign:_}},I=Object.freeze({default:N}),D=I&&N||I;e.exports=D.default?D.default:D},function(e,t,n){"use strict";var o=n(14),r=n(28),l=n(45);e.exports=function(){function e(e,t,n,o,a,i){i!==l&&r(!1,"Calling PropTypes validators directly is not supported by the `prop-types` package. Use PropTypes.checkPropTypes() to call them. Read more at http://fb.me/use-check-prop-types")}function t(){return e}e.isRequired=e;var n={array:e,bool:e,func:e,number:e,object:e,string:e,symbol:e,any:e,arrayOf:t,element:e,instanceOf:t,node:e,objectOf:t,oneOf:t,oneOfType:t,shape:t,exact:t};return n.checkPropTypes=o,n.PropTypes=n,n}},function(e,t,n){"use strict";e.exports="SECRET_DO_NOT_PASS_THIS_OR_YOU_WILL_BE_FIRED"},function(e,t,n){"use strict";function o(){if("undefined"!=typeof __REACT_DEVTOOLS_GLOBAL_HOOK__&&"function"==typeof __REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE)try{__REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE(o)}catch(e){console.error(e)}}o(),e.exports=n(47)},function(e,t,n){"use strict";function o(e){for(var t=arguments.length-1,n="Minified React error #"+e+"; visit http://facebook.github.io/react/docs/error-decoder.html?invariant="+e,o=0;o<t;o++)n+="&args[]="+encodeURIComponent(arguments[o+1]);throw t=Error(n+" for the full message or use the non-minified dev environment for full errors and additional helpful warnings."),t.name="Invariant Violation",t.framesToPop=1,t}function r(e,t){return(e&t)===t}function l(e,t){if(Ln.hasOwnProperty(e)||2<e.length&&("o"===e[0]||"O"===e[0])&&("n"===e[1]||"N"===e[1]))return!1;if(null===t)return!0;switch(typeof t){case"boolean":return Ln.hasOwnProperty(e)?e=!0:(t=a(e))?e=t.hasBooleanValue||t.hasStringBooleanValue||t.hasOverloadedBooleanValue:(e=e.toLowerCase().slice(0,5),e="data-"===e||"aria-"===e),e;case"undefined":case"number":case"string":case"object":return!0;default:return!1}}function a(e){return jn.hasOwnProperty(e)?jn[e]:null}function i(e){return e[1].toUpperCase()}function c(e,t,n,o,r,l,a,i,c){Wn._hasCaughtError=!1,Wn._caughtError=null;var s=Array.prototype.slice.call(arguments,3);try{t.apply(n,s)}catch(e){Wn._caughtError=e,Wn._hasCaughtError=!0}}function s(){if(Wn._hasRethrowError){var e=Wn._rethrowError;throw Wn._rethrowError=null,Wn._hasRethrowError=!1,e}}function u(){if(Xn)for(var e in Vn){var t=Vn[e],n=Xn.indexOf(e);if(-1<n||o("96",e),!Kn[n]){t.extractEvents||o("97",e),Kn[n]=t,n=t.eventTypes;for(var r in n){var l=void 0,a=n[r],i=t,c=r;Gn.hasOwnProperty(c)&&o("99",c),Gn[c]=a;var s=a.phasedRegistrationNames;if(s){for(l in s)s.hasOwnProperty(l)&&d(s[l],i,c);l=!0}else a.registrationName?(d(a.registrationName,i,c),l=!0):l=!1;l||o("98",r,e)}}}}function d(e,t,n){Bn[e]&&o("100",e),Bn[e]=t,Qn[e]=t.eventTypes[n].dependencies}function f(e){Xn&&o("101"),Xn=Array.prototype.slice.call(e),u()}function p(e){var t,n=!1;for(t in e)if(e.hasOwnProperty(t)){var r=e[t];Vn.hasOwnProperty(t)&&Vn[t]===r||(Vn[t]&&o("102",t),Vn[t]=r,n=!0)}n&&u()}function b(e,t,n,o){t=e.type||"unknown-event",e.currentTarget=eo(o),Wn.invokeGuardedCallbackAndCatchFirstError(t,n,void 0,e),e.currentTarget=null}function h(e,t){return null==t&&o("30"),null==e?t:Array.isArray(e)?Array.isArray(t)?(e.push.apply(e,t),e):(e.push(t),e):Array.isArray(t)?[e].concat(t):[e,t]}function m(e,t,n){Array.isArray(e)?e.forEach(t,n):e&&t.call(n,e)}function g(e,t){if(e){var n=e._dispatchListeners,o=e._dispatchInstances;if(Array.isArray(n))for(var r=0;r<n.length&&!e.isPropagationStopped();r++)b(e,t,n[r],o[r]);else n&&b(e,t,n,o);e._dispatchListeners=null,e._dispatchInstances=null,e.isPersistent()||e.constructor.release(e)}}function _(e){return g(e,!0)}function v(e){return g(e,!1)}function y(e,t){var n=e.stateNode;if(!n)return null;var r=Jn(n);if(!r)return null;n=r[t];e:switch(t){case"onClick":case"onClickCapture":case"onDoubleClick":case"onDoubleClickCapture":case"onMouseDown":case"onMouseDownCapture":case"onMouseMove":case"onMouseMoveCapture":case"onMouseUp":case"onMouseUpCapture":(r=!r.disabled)||(e=e.type,r=!("button"===e||"input"===e||"select"===e||"textarea"===e)),e=!r;break e;default:e=!1}return e?null:(n&&"function"!=typeof n&&o("231",t,typeof n),n)}function x(e,t,n,o){for(var r,l=0;l<Kn.length;l++){var a=Kn[l];a&&(a=a.extractEvents(e,t,n,o))&&(r=h(r,a))}return r}function w(e){e&&(to=h(to,e))}function k(e){var t=to;to=null,t&&(e?m(t,_):m(t,v),to&&o("95"),Wn.rethrowCaughtError())}function E(e){if(e[lo])return e[lo];for(var t=[];!e[lo];){if(t.push(e),!e.parentNode)return null;e=e.parentNode}var n=void 0,o=e[lo];if(5===o.tag||6===o.tag)return o;for(;e&&(o=e[lo]);e=t.pop())n=o;return n}function C(e){if(5===e.tag||6===e.tag)return e.stateNode;o("33")}function O(e){return e[ao]||null}function P(e){do{e=e.return}while(e&&5!==e.tag);return e||null}function M(e,t,n){for(var o=[];e;)o.push(e),e=P(e);for(e=o.length;0<e--;)t(o[e],"captured",n);for(e=0;e<o.length;e++)t(o[e],"bubbled",n)}function L(e,t,n){(t=y(e,n.dispatchConfig.phasedRegistrationNames[t]))&&(n._dispatchListeners=h(n._dispatchListeners,t),n._dispatchInstances=h(n._dispatchInstances,e))}function R(e){e&&e.dispatchConfig.phasedRegistrationNames&&M(e._targetInst,L,e)}function j(e){if(e&&e.dispatchConfig.phasedRegistrationNames){var t=e._targetInst;t=t?P(t):null,M(t,L,e)}}function S(e,t,n){e&&n&&n.dispatchConfig.registrationName&&(t=y(e,n.dispatchConfig.registrationName))&&(n._dispatchListeners=h(n._dispatchListeners,t),n._dispatchInstances=h(n._dispatchInstances,e))}function T(e){e&&e.dispatchConfig.registrationName&&S(e._targetInst,null,e)}function H(e){m(e,R)}function U(e,t,n,o){if(n&&o)e:{for(var r=n,l=o,a=0,i=r;i;i=P(i))a++;i=0;for(var c=l;c;c=P(c))i++;for(;0<a-i;)r=P(r),a--;for(;0<i-a;)l=P(l),i--;for(;a--;){if(r===l||r===l.alternate)break e;r=P(r),l=P(l)}r=null}else r=null;for(l=r,r=[];n&&n!==l&&(null===(a=n.alternate)||a!==l);)r.push(n),n=P(n);for(n=[];o&&o!==l&&(null===(a=o.alternate)||a!==l);)n.push(o),o=P(o);for(o=0;o<r.length;o++)S(r[o],"bubbled",e);for(e=n.length;0<e--;)S(n[e],"captured",t)}function N(){return!so&&yn.canUseDOM&&(so="textContent"in document.documentElement?"textContent":"innerText"),so}function I(){if(uo._fallbackText)return uo._fallbackText;var e,t,n=uo._startText,o=n.length,r=D(),l=r.length;for(e=0;e<o&&n[e]===r[e];e++);var a=o-e;for(t=1;t<=a&&n[o-t]===r[l-t];t++);return uo._fallbackText=r.slice(e,1<t?1-t:void 0),uo._fallbackText}function D(){return"value"in uo._root?uo._root.value:uo._root[N()]}function A(e,t,n,o){this.dispatchConfig=e,this._targetInst=t,this.nativeEvent=n,e=this.constructor.Interface;for(var r in e)e.hasOwnProperty(r)&&((t=e[r])?this[r]=t(n):"target"===r?this.target=o:this[r]=n[r]);return this.isDefaultPrevented=(null!=n.defaultPrevented?n.defaultPrevented:!1===n.returnValue)?wn.thatReturnsTrue:wn.thatReturnsFalse,this.isPropagationStopped=wn.thatReturnsFalse,this}function Y(e,t,n,o){if(this.eventPool.length){var r=this.eventPool.pop();return this.call(r,e,t,n,o),r}return new this(e,t,n,o)}function q(e){e instanceof this||o("223"),e.destructor(),10>this.eventPool.length&&this.eventPool.push(e)}function F(e){e.eventPool=[],e.getPooled=Y,e.release=q}function z(e,t,n,o){return A.call(this,e,t,n,o)}function W(e,t,n,o){return A.call(this,e,t,n,o)}function X(e,t){switch(e){case"topKeyUp":return-1!==bo.indexOf(t.keyCode);case"topKeyDown":return 229!==t.keyCode;case"topKeyPress":case"topMouseDown":case"topBlur":return!0;default:return!1}}function V(e){return e=e.detail,"object"==typeof e&&"data"in e?e.data:null}function K(e,t){switch(e){case"topCompositionEnd":return V(t);case"topKeyPress":return 32!==t.which?null:(Eo=!0,wo);case"topTextInput":return e=t.data,e===wo&&Eo?null:e;default:return null}}function G(e,t){if(Co)return"topCompositionEnd"===e||!ho&&X(e,t)?(e=I(),uo._root=null,uo._startText=null,uo._fallbackText=null,Co=!1,e):null;switch(e){case"topPaste":return null;case"topKeyPress":if(!(t.ctrlKey||t.altKey||t.metaKey)||t.ctrlKey&&t.altKey){if(t.char&&1<t.char.length)return t.char;if(t.which)return String.fromCharCode(t.which)}return null;case"topCompositionEnd":return xo?null:t.data;default:return null}}function B(e){if(e=$n(e)){Po&&"function"==typeof Po.restoreControlledState||o("194");var t=Jn(e.stateNode);Po.restoreControlledState(e.stateNode,e.type,t)}}function Q(e){Mo?Lo?Lo.push(e):Lo=[e]:Mo=e}function Z(){if(Mo){var e=Mo,t=Lo;if(Lo=Mo=null,B(e),t)for(e=0;e<t.length;e++)B(t[e])}}function J(e,t){return e(t)}function $(e,t){if(So)return J(e,t);So=!0;try{return J(e,t)}finally{So=!1,Z()}}function ee(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return"input"===t?!!To[e.type]:"textarea"===t}function te(e){return e=e.target||e.srcElement||window,e.correspondingUseElement&&(e=e.correspondingUseElement),3===e.nodeType?e.parentNode:e}function ne(e,t){if(!yn.canUseDOM||t&&!("addEventListener"in document))return!1;t="on"+e;var n=t in document;return n||(n=document.createElement("div"),n.setAttribute(t,"return;"),n="function"==typeof n[t]),!n&&vo&&"wheel"===e&&(n=document.implementation.hasFeature("Events.wheel","3.0")),n}function oe(e){var t=e.type;return(e=e.nodeName)&&"input"===e.toLowerCase()&&("checkbox"===t||"radio"===t)}function re(e){var t=oe(e)?"checked":"value",n=Object.getOwnPropertyDescriptor(e.constructor.prototype,t),o=""+e[t];if(!e.hasOwnProperty(t)&&"function"==typeof n.get&&"function"==typeof n.set)return Object.defineProperty(e,t,{enumerable:n.enumerable,configurable:!0,get:function(){return n.get.call(this)},set:function(e){o=""+e,n.set.call(this,e)}}),{getValue:function(){return o},setValue:function(e){o=""+e},stopTracking:function(){e._valueTracker=null,delete e[t]}}}function le(e){e._valueTracker||(e._valueTracker=re(e))}function ae(e){if(!e)return!1;var t=e._valueTracker;if(!t)return!0;var n=t.getValue(),o="";return e&&(o=oe(e)?e.checked?"true":"false":e.value),(e=o)!==n&&(t.setValue(e),!0)}function ie(e,t,n){return e=A.getPooled(Ho.change,e,t,n),e.type="change",Q(n),H(e),e}function ce(e){w(e),k(!1)}function se(e){if(ae(C(e)))return e}function ue(e,t){if("topChange"===e)return t}function de(){Uo&&(Uo.detachEvent("onpropertychange",fe),No=Uo=null)}function fe(e){"value"===e.propertyName&&se(No)&&(e=ie(No,e,te(e)),$(ce,e))}function pe(e,t,n){"topFocus"===e?(de(),Uo=t,No=n,Uo.attachEvent("onpropertychange",fe)):"topBlur"===e&&de()}function be(e){if("topSelectionChange"===e||"topKeyUp"===e||"topKeyDown"===e)return se(No)}function he(e,t){if("topClick"===e)return se(t)}function me(e,t){if("topInput"===e||"topChange"===e)return se(t)}function ge(e,t,n,o){return A.call(this,e,t,n,o)}function _e(e){var t=this.nativeEvent;return t.getModifierState?t.getModifierState(e):!!(e=Ao[e])&&!!t[e]}function ve(){return _e}function ye(e,t,n,o){return A.call(this,e,t,n,o)}function xe(e){return e=e.type,"string"==typeof e?e:"function"==typeof e?e.displayName||e.name:null}function we(e){var t=e;if(e.alternate)for(;t.return;)t=t.return;else{if(0!=(2&t.effectTag))return 1;for(;t.return;)if(t=t.return,0!=(2&t.effectTag))return 1}return 3===t.tag?2:3}function ke(e){return!!(e=e._reactInternalFiber)&&2===we(e)}function Ee(e){2!==we(e)&&o("188")}function Ce(e){var t=e.alternate;if(!t)return t=we(e),3===t&&o("188"),1===t?null:e;for(var n=e,r=t;;){var l=n.return,a=l?l.alternate:null;if(!l||!a)break;if(l.child===a.child){for(var i=l.child;i;){if(i===n)return Ee(l),e;if(i===r)return Ee(l),t;i=i.sibling}o("188")}if(n.return!==r.return)n=l,r=a;else{i=!1;for(var c=l.child;c;){if(c===n){i=!0,n=l,r=a;break}if(c===r){i=!0,r=l,n=a;break}c=c.sibling}if(!i){for(c=a.child;c;){if(c===n){i=!0,n=a,r=l;break}if(c===r){i=!0,r=a,n=l;break}c=c.sibling}i||o("189")}}n.alternate!==r&&o("190")}return 3!==n.tag&&o("188"),n.stateNode.current===n?e:t}function Oe(e){if(!(e=Ce(e)))return null;for(var t=e;;){if(5===t.tag||6===t.tag)return t;if(t.child)t.child.return=t,t=t.child;else{if(t===e)break;for(;!t.sibling;){if(!t.return||t.return===e)return null;t=t.return}t.sibling.return=t.return,t=t.sibling}}return null}function Pe(e){if(!(e=Ce(e)))return null;for(var t=e;;){if(5===t.tag||6===t.tag)return t;if(t.child&&4!==t.tag)t.child.return=t,t=t.child;else{if(t===e)break;for(;!t.sibling;){if(!t.return||t.return===e)return null;t=t.return}t.sibling.return=t.return,t=t.sibling}}return null}function Me(e){var t=e.targetInst;do{if(!t){e.ancestors.push(t);break}var n;for(n=t;n.return;)n=n.return;if(!(n=3!==n.tag?null:n.stateNode.containerInfo))break;e.ancestors.push(t),t=E(n)}while(t);for(n=0;n<e.ancestors.length;n++)t=e.ancestors[n],Xo(e.topLevelType,t,e.nativeEvent,te(e.nativeEvent))}function Le(e){Wo=!!e}function Re(e,t,n){return n?kn.listen(n,t,Se.bind(null,e)):null}function je(e,t,n){return n?kn.capture(n,t,Se.bind(null,e)):null}function Se(e,t){if(Wo){var n=te(t);if(n=E(n),null===n||"number"!=typeof n.tag||2===we(n)||(n=null),zo.length){var o=zo.pop();o.topLevelType=e,o.nativeEvent=t,o.targetInst=n,e=o}else e={topLevelType:e,nativeEvent:t,targetInst:n,ancestors:[]};try{$(Me,e)}finally{e.topLevelType=null,e.nativeEvent=null,e.targetInst=null,e.ancestors.length=0,10>zo.length&&zo.push(e)}}}function Te(e,t){var n={};return n[e.toLowerCase()]=t.toLowerCase(),n["Webkit"+e]="webkit"+t,n["Moz"+e]="moz"+t,n["ms"+e]="MS"+t,n["O"+e]="o"+t.toLowerCase(),n}function He(e){if(Go[e])return Go[e];if(!Ko[e])return e;var t,n=Ko[e];for(t in n)if(n.hasOwnProperty(t)&&t in Bo)return Go[e]=n[t];return""}function Ue(e){return Object.prototype.hasOwnProperty.call(e,$o)||(e[$o]=Jo++,Zo[e[$o]]={}),Zo[e[$o]]}function Ne(e){for(;e&&e.firstChild;)e=e.firstChild;return e}function Ie(e,t){var n=Ne(e);e=0;for(var o;n;){if(3===n.nodeType){if(o=e+n.textContent.length,e<=t&&o>=t)return{node:n,offset:t-e};e=o}e:{for(;n;){if(n.nextSibling){n=n.nextSibling;break e}n=n.parentNode}n=void 0}n=Ne(n)}}function De(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return t&&("input"===t&&"text"===e.type||"textarea"===t||"true"===e.contentEditable)}function Ae(e,t){if(lr||null==nr||nr!==En())return null;var n=nr;return"selectionStart"in n&&De(n)?n={start:n.selectionStart,end:n.selectionEnd}:window.getSelection?(n=window.getSelection(),n={anchorNode:n.anchorNode,anchorOffset:n.anchorOffset,focusNode:n.focusNode,focusOffset:n.focusOffset}):n=void 0,rr&&Cn(rr,n)?null:(rr=n,e=A.getPooled(tr.select,or,e,t),e.type="select",e.target=nr,H(e),e)}function Ye(e,t,n,o){return A.call(this,e,t,n,o)}function qe(e,t,n,o){return A.call(this,e,t,n,o)}function Fe(e,t,n,o){return A.call(this,e,t,n,o)}function ze(e){var t=e.keyCode;return"charCode"in e?0===(e=e.charCode)&&13===t&&(e=13):e=t,32<=e||13===e?e:0}function We(e,t,n,o){return A.call(this,e,t,n,o)}function Xe(e,t,n,o){return A.call(this,e,t,n,o)}function Ve(e,t,n,o){return A.call(this,e,t,n,o)}function Ke(e,t,n,o){return A.call(this,e,t,n,o)}function Ge(e,t,n,o){return A.call(this,e,t,n,o)}function Be(e){0>pr||(e.current=fr[pr],fr[pr]=null,pr--)}function Qe(e,t){pr++,fr[pr]=e.current,e.current=t}function Ze(e){return $e(e)?mr:br.current}function Je(e,t){var n=e.type.contextTypes;if(!n)return Mn;var o=e.stateNode;if(o&&o.__reactInternalMemoizedUnmaskedChildContext===t)return o.__reactInternalMemoizedMaskedChildContext;var r,l={};for(r in n)l[r]=t[r];return o&&(e=e.stateNode,e.__reactInternalMemoizedUnmaskedChildContext=t,e.__reactInternalMemoizedMaskedChildContext=l),l}function $e(e){return 2===e.tag&&null!=e.type.childContextTypes}function et(e){$e(e)&&(Be(hr,e),Be(br,e))}function tt(e,t,n){null!=br.cursor&&o("168"),Qe(br,t,e),Qe(hr,n,e)}function nt(e,t){var n=e.stateNode,r=e.type.childContextTypes;if("function"!=typeof n.getChildContext)return t;n=n.getChildContext();for(var l in n)l in r||o("108",xe(e)||"Unknown",l);return xn({},t,n)}function ot(e){if(!$e(e))return!1;var t=e.stateNode;return t=t&&t.__reactInternalMemoizedMergedChildContext||Mn,mr=br.current,Qe(br,t,e),Qe(hr,hr.current,e),!0}function rt(e,t){var n=e.stateNode;if(n||o("169"),t){var r=nt(e,mr);n.__reactInternalMemoizedMergedChildContext=r,Be(hr,e),Be(br,e),Qe(br,r,e)}else Be(hr,e);Qe(hr,t,e)}function lt(e,t,n){this.tag=e,this.key=t,this.stateNode=this.type=null,this.sibling=this.child=this.return=null,this.index=0,this.memoizedState=this.updateQueue=this.memoizedProps=this.pendingProps=this.ref=null,this.internalContextTag=n,this.effectTag=0,this.lastEffect=this.firstEffect=this.nextEffect=null,this.expirationTime=0,this.alternate=null}function at(e,t,n){var o=e.alternate;return null===o?(o=new lt(e.tag,e.key,e.internalContextTag),o.type=e.type,o.stateNode=e.stateNode,o.alternate=e,e.alternate=o):(o.effectTag=0,o.nextEffect=null,o.firstEffect=null,o.lastEffect=null),o.expirationTime=n,o.pendingProps=t,o.child=e.child,o.memoizedProps=e.memoizedProps,o.memoizedState=e.memoizedState,o.updateQueue=e.updateQueue,o.sibling=e.sibling,o.index=e.index,o.ref=e.ref,o}function it(e,t,n){var r=void 0,l=e.type,a=e.key;return"function"==typeof l?(r=l.prototype&&l.prototype.isReactComponent?new lt(2,a,t):new lt(0,a,t),r.type=l,r.pendingProps=e.props):"string"==typeof l?(r=new lt(5,a,t),r.type=l,r.pendingProps=e.props):"object"==typeof l&&null!==l&&"number"==typeof l.tag?(r=l,r.pendingProps=e.props):o("130",null==l?l:typeof l,""),r.expirationTime=n,r}function ct(e,t,n,o){return t=new lt(10,o,t),t.pendingProps=e,t.expirationTime=n,t}function st(e,t,n){return t=new lt(6,null,t),t.pendingProps=e,t.expirationTime=n,t}function ut(e,t,n){return t=new lt(7,e.key,t),t.type=e.handler,t.pendingProps=e,t.expirationTime=n,t}function dt(e,t,n){return e=new lt(9,null,t),e.expirationTime=n,e}function ft(e,t,n){return t=new lt(4,e.key,t),t.pendingProps=e.children||[],t.expirationTime=n,t.stateNode={containerInfo:e.containerInfo,pendingChildren:null,implementation:e.implementation},t}function pt(e){return function(t){try{return e(t)}catch(e){}}}function bt(e){if("undefined"==typeof __REACT_DEVTOOLS_GLOBAL_HOOK__)return!1;var t=__REACT_DEVTOOLS_GLOBAL_HOOK__;if(t.isDisabled||!t.supportsFiber)return!0;try{var n=t.inject(e);gr=pt(function(e){return t.onCommitFiberRoot(n,e)}),_r=pt(function(e){return t.onCommitFiberUnmount(n,e)})}catch(e){}return!0}function ht(e){"function"==typeof gr&&gr(e)}function mt(e){"function"==typeof _r&&_r(e)}function gt(e){return{baseState:e,expirationTime:0,first:null,last:null,callbackList:null,hasForceUpdate:!1,isInitialized:!1}}function _t(e,t){null===e.last?e.first=e.last=t:(e.last.next=t,e.last=t),(0===e.expirationTime||e.expirationTime>t.expirationTime)&&(e.expirationTime=t.expirationTime)}function vt(e,t){var n=e.alternate,o=e.updateQueue;null===o&&(o=e.updateQueue=gt(null)),null!==n?null===(e=n.updateQueue)&&(e=n.updateQueue=gt(null)):e=null,e=e!==o?e:null,null===e?_t(o,t):null===o.last||null===e.last?(_t(o,t),_t(e,t)):(_t(o,t),e.last=t)}function yt(e,t,n,o){return e=e.partialState,"function"==typeof e?e.call(t,n,o):e}function xt(e,t,n,o,r,l){null!==e&&e.updateQueue===n&&(n=t.updateQueue={baseState:n.baseState,expirationTime:n.expirationTime,first:n.first,last:n.last,isInitialized:n.isInitialized,callbackList:null,hasForceUpdate:!1}),n.expirationTime=0,n.isInitialized?e=n.baseState:(e=n.baseState=t.memoizedState,n.isInitialized=!0);for(var a=!0,i=n.first,c=!1;null!==i;){var s=i.expirationTime;if(s>l){var u=n.expirationTime;(0===u||u>s)&&(n.expirationTime=s),c||(c=!0,n.baseState=e)}else c||(n.first=i.next,null===n.first&&(n.last=null)),i.isReplace?(e=yt(i,o,e,r),a=!0):(s=yt(i,o,e,r))&&(e=a?xn({},e,s):xn(e,s),a=!1),i.isForced&&(n.hasForceUpdate=!0),null!==i.callback&&(s=n.callbackList,null===s&&(s=n.callbackList=[]),s.push(i));i=i.next}return null!==n.callbackList?t.effectTag|=32:null!==n.first||n.hasForceUpdate||(t.updateQueue=null),c||(n.baseState=e),e}function wt(e,t){var n=e.callbackList;if(null!==n)for(e.callbackList=null,e=0;e<n.length;e++){var r=n[e],l=r.callback;r.callback=null,"function"!=typeof l&&o("191",l),l.call(t)}}function kt(e,t,n,r){function l(e,t){t.updater=a,e.stateNode=t,t._reactInternalFiber=e}var a={isMounted:ke,enqueueSetState:function(n,o,r){n=n._reactInternalFiber,r=void 0===r?null:r;var l=t(n);vt(n,{expirationTime:l,partialState:o,callback:r,isReplace:!1,isForced:!1,nextCallback:null,next:null}),e(n,l)},enqueueReplaceState:function(n,o,r){n=n._reactInternalFiber,r=void 0===r?null:r;var l=t(n);vt(n,{expirationTime:l,partialState:o,callback:r,isReplace:!0,isForced:!1,nextCallback:null,next:null}),e(n,l)},enqueueForceUpdate:function(n,o){n=n._reactInternalFiber,o=void 0===o?null:o;var r=t(n);vt(n,{expirationTime:r,partialState:null,callback:o,isReplace:!1,isForced:!0,nextCallback:null,next:null}),e(n,r)}};return{adoptClassInstance:l,constructClassInstance:function(e,t){var n=e.type,o=Ze(e),r=2===e.tag&&null!=e.type.contextTypes,a=r?Je(e,o):Mn;return t=new n(t,a),l(e,t),r&&(e=e.stateNode,e.__reactInternalMemoizedUnmaskedChildContext=o,e.__reactInternalMemoizedMaskedChildContext=a),t},mountClassInstance:function(e,t){var n=e.alternate,r=e.stateNode,l=r.state||null,i=e.pendingProps;i||o("158");var c=Ze(e);r.props=i,r.state=e.memoizedState=l,r.refs=Mn,r.context=Je(e,c),null!=e.type&&null!=e.type.prototype&&!0===e.type.prototype.unstable_isAsyncReactComponent&&(e.internalContextTag|=1),"function"==typeof r.componentWillMount&&(l=r.state,r.componentWillMount(),l!==r.state&&a.enqueueReplaceState(r,r.state,null),null!==(l=e.updateQueue)&&(r.state=xt(n,e,l,r,i,t))),"function"==typeof r.componentDidMount&&(e.effectTag|=4)},updateClassInstance:function(e,t,l){var i=t.stateNode;i.props=t.memoizedProps,i.state=t.memoizedState;var c=t.memoizedProps,s=t.pendingProps;s||null==(s=c)&&o("159");var u=i.context,d=Ze(t);if(d=Je(t,d),"function"!=typeof i.componentWillReceiveProps||c===s&&u===d||(u=i.state,i.componentWillReceiveProps(s,d),i.state!==u&&a.enqueueReplaceState(i,i.state,null)),u=t.memoizedState,l=null!==t.updateQueue?xt(e,t,t.updateQueue,i,s,l):u,!(c!==s||u!==l||hr.current||null!==t.updateQueue&&t.updateQueue.hasForceUpdate))return"function"!=typeof i.componentDidUpdate||c===e.memoizedProps&&u===e.memoizedState||(t.effectTag|=4),!1;var f=s;if(null===c||null!==t.updateQueue&&t.updateQueue.hasForceUpdate)f=!0;else{var p=t.stateNode,b=t.type;f="function"==typeof p.shouldComponentUpdate?p.shouldComponentUpdate(f,l,d):!b.prototype||!b.prototype.isPureReactComponent||(!Cn(c,f)||!Cn(u,l))}return f?("function"==typeof i.componentWillUpdate&&i.componentWillUpdate(s,l,d),"function"==typeof i.componentDidUpdate&&(t.effectTag|=4)):("function"!=typeof i.componentDidUpdate||c===e.memoizedProps&&u===e.memoizedState||(t.effectTag|=4),n(t,s),r(t,l)),i.props=s,i.state=l,i.context=d,f}}}function Et(e){return null===e||void 0===e?null:(e=Cr&&e[Cr]||e["@@iterator"],"function"==typeof e?e:null)}function Ct(e,t){var n=t.ref;if(null!==n&&"function"!=typeof n){if(t._owner){t=t._owner;var r=void 0;t&&(2!==t.tag&&o("110"),r=t.stateNode),r||o("147",n);var l=""+n;return null!==e&&null!==e.ref&&e.ref._stringRef===l?e.ref:(e=function(e){var t=r.refs===Mn?r.refs={}:r.refs;null===e?delete t[l]:t[l]=e},e._stringRef=l,e)}"string"!=typeof n&&o("148"),t._owner||o("149",n)}return n}function Ot(e,t){"textarea"!==e.type&&o("31","[object Object]"===Object.prototype.toString.call(t)?"object with keys {"+Object.keys(t).join(", ")+"}":t,"")}function Pt(e){function t(t,n){if(e){var o=t.lastEffect;null!==o?(o.nextEffect=n,t.lastEffect=n):t.firstEffect=t.lastEffect=n,n.nextEffect=null,n.effectTag=8}}function n(n,o){if(!e)return null;for(;null!==o;)t(n,o),o=o.sibling;return null}function r(e,t){for(e=new Map;null!==t;)null!==t.key?e.set(t.key,t):e.set(t.index,t),t=t.sibling;return e}function l(e,t,n){return e=at(e,t,n),e.index=0,e.sibling=null,e}function a(t,n,o){return t.index=o,e?null!==(o=t.alternate)?(o=o.index,o<n?(t.effectTag=2,n):o):(t.effectTag=2,n):n}function i(t){return e&&null===t.alternate&&(t.effectTag=2),t}function c(e,t,n,o){return null===t||6!==t.tag?(t=st(n,e.internalContextTag,o),t.return=e,t):(t=l(t,n,o),t.return=e,t)}function s(e,t,n,o){return null!==t&&t.type===n.type?(o=l(t,n.props,o),o.ref=Ct(t,n),o.return=e,o):(o=it(n,e.internalContextTag,o),o.ref=Ct(t,n),o.return=e,o)}function u(e,t,n,o){return null===t||7!==t.tag?(t=ut(n,e.internalContextTag,o),t.return=e,t):(t=l(t,n,o),t.return=e,t)}function d(e,t,n,o){return null===t||9!==t.tag?(t=dt(n,e.internalContextTag,o),t.type=n.value,t.return=e,t):(t=l(t,null,o),t.type=n.value,t.return=e,t)}function f(e,t,n,o){return null===t||4!==t.tag||t.stateNode.containerInfo!==n.containerInfo||t.stateNode.implementation!==n.implementation?(t=ft(n,e.internalContextTag,o),t.return=e,t):(t=l(t,n.children||[],o),t.return=e,t)}function p(e,t,n,o,r){return null===t||10!==t.tag?(t=ct(n,e.internalContextTag,o,r),t.return=e,t):(t=l(t,n,o),t.return=e,t)}function b(e,t,n){if("string"==typeof t||"number"==typeof t)return t=st(""+t,e.internalContextTag,n),t.return=e,t;if("object"==typeof t&&null!==t){switch(t.$$typeof){case yr:return t.type===Er?(t=ct(t.props.children,e.internalContextTag,n,t.key),t.return=e,t):(n=it(t,e.internalContextTag,n),n.ref=Ct(null,t),n.return=e,n);case xr:return t=ut(t,e.internalContextTag,n),t.return=e,t;case wr:return n=dt(t,e.internalContextTag,n),n.type=t.value,n.return=e,n;case kr:return t=ft(t,e.internalContextTag,n),t.return=e,t}if(Or(t)||Et(t))return t=ct(t,e.internalContextTag,n,null),t.return=e,t;Ot(e,t)}return null}function h(e,t,n,o){var r=null!==t?t.key:null;if("string"==typeof n||"number"==typeof n)return null!==r?null:c(e,t,""+n,o);if("object"==typeof n&&null!==n){switch(n.$$typeof){case yr:return n.key===r?n.type===Er?p(e,t,n.props.children,o,r):s(e,t,n,o):null;case xr:return n.key===r?u(e,t,n,o):null;case wr:return null===r?d(e,t,n,o):null;case kr:return n.key===r?f(e,t,n,o):null}if(Or(n)||Et(n))return null!==r?null:p(e,t,n,o,null);Ot(e,n)}return null}function m(e,t,n,o,r){if("string"==typeof o||"number"==typeof o)return e=e.get(n)||null,c(t,e,""+o,r);if("object"==typeof o&&null!==o){switch(o.$$typeof){case yr:return e=e.get(null===o.key?n:o.key)||null,o.type===Er?p(t,e,o.props.children,r,o.key):s(t,e,o,r);case xr:return e=e.get(null===o.key?n:o.key)||null,u(t,e,o,r);case wr:return e=e.get(n)||null,d(t,e,o,r);case kr:return e=e.get(null===o.key?n:o.key)||null,f(t,e,o,r)}if(Or(o)||Et(o))return e=e.get(n)||null,p(t,e,o,r,null);Ot(t,o)}return null}function g(o,l,i,c){for(var s=null,u=null,d=l,f=l=0,p=null;null!==d&&f<i.length;f++){d.index>f?(p=d,d=null):p=d.sibling;var g=h(o,d,i[f],c);if(null===g){null===d&&(d=p);break}e&&d&&null===g.alternate&&t(o,d),l=a(g,l,f),null===u?s=g:u.sibling=g,u=g,d=p}if(f===i.length)return n(o,d),s;if(null===d){for(;f<i.length;f++)(d=b(o,i[f],c))&&(l=a(d,l,f),null===u?s=d:u.sibling=d,u=d);return s}for(d=r(o,d);f<i.length;f++)(p=m(d,o,f,i[f],c))&&(e&&null!==p.alternate&&d.delete(null===p.key?f:p.key),l=a(p,l,f),null===u?s=p:u.sibling=p,u=p);return e&&d.forEach(function(e){return t(o,e)}),s}function _(l,i,c,s){var u=Et(c);"function"!=typeof u&&o("150"),null==(c=u.call(c))&&o("151");for(var d=u=null,f=i,p=i=0,g=null,_=c.next();null!==f&&!_.done;p++,_=c.next()){f.index>p?(g=f,f=null):g=f.sibling;var v=h(l,f,_.value,s);if(null===v){f||(f=g);break}e&&f&&null===v.alternate&&t(l,f),i=a(v,i,p),null===d?u=v:d.sibling=v,d=v,f=g}if(_.done)return n(l,f),u;if(null===f){for(;!_.done;p++,_=c.next())null!==(_=b(l,_.value,s))&&(i=a(_,i,p),null===d?u=_:d.sibling=_,d=_);return u}for(f=r(l,f);!_.done;p++,_=c.next())null!==(_=m(f,l,p,_.value,s))&&(e&&null!==_.alternate&&f.delete(null===_.key?p:_.key),i=a(_,i,p),null===d?u=_:d.sibling=_,d=_);return e&&f.forEach(function(e){return t(l,e)}),u}return function(e,r,a,c){"object"==typeof a&&null!==a&&a.type===Er&&null===a.key&&(a=a.props.children);var s="object"==typeof a&&null!==a;if(s)switch(a.$$typeof){case yr:e:{var u=a.key;for(s=r;null!==s;){if(s.key===u){if(10===s.tag?a.type===Er:s.type===a.type){n(e,s.sibling),r=l(s,a.type===Er?a.props.children:a.props,c),r.ref=Ct(s,a),r.return=e,e=r;break e}n(e,s);break}t(e,s),s=s.sibling}a.type===Er?(r=ct(a.props.children,e.internalContextTag,c,a.key),r.return=e,e=r):(c=it(a,e.internalContextTag,c),c.ref=Ct(r,a),c.return=e,e=c)}return i(e);case xr:e:{for(s=a.key;null!==r;){if(r.key===s){if(7===r.tag){n(e,r.sibling),r=l(r,a,c),r.return=e,e=r;break e}n(e,r);break}t(e,r),r=r.sibling}r=ut(a,e.internalContextTag,c),r.return=e,e=r}return i(e);case wr:e:{if(null!==r){if(9===r.tag){n(e,r.sibling),r=l(r,null,c),r.type=a.value,r.return=e,e=r;break e}n(e,r)}r=dt(a,e.internalContextTag,c),r.type=a.value,r.return=e,e=r}return i(e);case kr:e:{for(s=a.key;null!==r;){if(r.key===s){if(4===r.tag&&r.stateNode.containerInfo===a.containerInfo&&r.stateNode.implementation===a.implementation){n(e,r.sibling),r=l(r,a.children||[],c),r.return=e,e=r;break e}n(e,r);break}t(e,r),r=r.sibling}r=ft(a,e.internalContextTag,c),r.return=e,e=r}return i(e)}if("string"==typeof a||"number"==typeof a)return a=""+a,null!==r&&6===r.tag?(n(e,r.sibling),r=l(r,a,c)):(n(e,r),r=st(a,e.internalContextTag,c)),r.return=e,e=r,i(e);if(Or(a))return g(e,r,a,c);if(Et(a))return _(e,r,a,c);if(s&&Ot(e,a),void 0===a)switch(e.tag){case 2:case 1:c=e.type,o("152",c.displayName||c.name||"Component")}return n(e,r)}}function Mt(e,t,n,r,l){function a(e,t,n){var o=t.expirationTime;t.child=null===e?Mr(t,null,n,o):Pr(t,e.child,n,o)}function i(e,t){var n=t.ref;null===n||e&&e.ref===n||(t.effectTag|=128)}function c(e,t,n,o){if(i(e,t),!n)return o&&rt(t,!1),u(e,t);n=t.stateNode,Fo.current=t;var r=n.render();return t.effectTag|=1,a(e,t,r),t.memoizedState=n.state,t.memoizedProps=n.props,o&&rt(t,!0),t.child}function s(e){var t=e.stateNode;t.pendingContext?tt(e,t.pendingContext,t.pendingContext!==t.context):t.context&&tt(e,t.context,!1),m(e,t.containerInfo)}function u(e,t){if(null!==e&&t.child!==e.child&&o("153"),null!==t.child){e=t.child;var n=at(e,e.pendingProps,e.expirationTime);for(t.child=n,n.return=t;null!==e.sibling;)e=e.sibling,n=n.sibling=at(e,e.pendingProps,e.expirationTime),n.return=t;n.sibling=null}return t.child}function d(e,t){switch(t.tag){case 3:s(t);break;case 2:ot(t);break;case 4:m(t,t.stateNode.containerInfo)}return null}var f=e.shouldSetTextContent,p=e.useSyncScheduling,b=e.shouldDeprioritizeSubtree,h=t.pushHostContext,m=t.pushHostContainer,g=n.enterHydrationState,_=n.resetHydrationState,v=n.tryToClaimNextHydratableInstance;e=kt(r,l,function(e,t){e.memoizedProps=t},function(e,t){e.memoizedState=t});var y=e.adoptClassInstance,x=e.constructClassInstance,w=e.mountClassInstance,k=e.updateClassInstance;return{beginWork:function(e,t,n){if(0===t.expirationTime||t.expirationTime>n)return d(e,t);switch(t.tag){case 0:null!==e&&o("155");var r=t.type,l=t.pendingProps,E=Ze(t);return E=Je(t,E),r=r(l,E),t.effectTag|=1,"object"==typeof r&&null!==r&&"function"==typeof r.render?(t.tag=2,l=ot(t),y(t,r),w(t,n),t=c(e,t,!0,l)):(t.tag=1,a(e,t,r),t.memoizedProps=l,t=t.child),t;case 1:e:{if(l=t.type,n=t.pendingProps,r=t.memoizedProps,hr.current)null===n&&(n=r);else if(null===n||r===n){t=u(e,t);break e}r=Ze(t),r=Je(t,r),l=l(n,r),t.effectTag|=1,a(e,t,l),t.memoizedProps=n,t=t.child}return t;case 2:return l=ot(t),r=void 0,null===e?t.stateNode?o("153"):(x(t,t.pendingProps),w(t,n),r=!0):r=k(e,t,n),c(e,t,r,l);case 3:return s(t),l=t.updateQueue,null!==l?(r=t.memoizedState,l=xt(e,t,l,null,null,n),r===l?(_(),t=u(e,t)):(r=l.element,E=t.stateNode,(null===e||null===e.child)&&E.hydrate&&g(t)?(t.effectTag|=2,t.child=Mr(t,null,r,n)):(_(),a(e,t,r)),t.memoizedState=l,t=t.child)):(_(),t=u(e,t)),t;case 5:h(t),null===e&&v(t),l=t.type;var C=t.memoizedProps;return r=t.pendingProps,null===r&&null===(r=C)&&o("154"),E=null!==e?e.memoizedProps:null,hr.current||null!==r&&C!==r?(C=r.children,f(l,r)?C=null:E&&f(l,E)&&(t.effectTag|=16),i(e,t),2147483647!==n&&!p&&b(l,r)?(t.expirationTime=2147483647,t=null):(a(e,t,C),t.memoizedProps=r,t=t.child)):t=u(e,t),t;case 6:return null===e&&v(t),e=t.pendingProps,null===e&&(e=t.memoizedProps),t.memoizedProps=e,null;case 8:t.tag=7;case 7:return l=t.pendingProps,hr.current?null===l&&null===(l=e&&e.memoizedProps)&&o("154"):null!==l&&t.memoizedProps!==l||(l=t.memoizedProps),r=l.children,t.stateNode=null===e?Mr(t,t.stateNode,r,n):Pr(t,t.stateNode,r,n),t.memoizedProps=l,t.stateNode;case 9:return null;case 4:e:{if(m(t,t.stateNode.containerInfo),l=t.pendingProps,hr.current)null===l&&null==(l=e&&e.memoizedProps)&&o("154");else if(null===l||t.memoizedProps===l){t=u(e,t);break e}null===e?t.child=Pr(t,null,l,n):a(e,t,l),t.memoizedProps=l,t=t.child}return t;case 10:e:{if(n=t.pendingProps,hr.current)null===n&&(n=t.memoizedProps);else if(null===n||t.memoizedProps===n){t=u(e,t);break e}a(e,t,n),t.memoizedProps=n,t=t.child}return t;default:o("156")}},beginFailedWork:function(e,t,n){switch(t.tag){case 2:ot(t);break;case 3:s(t);break;default:o("157")}return t.effectTag|=64,null===e?t.child=null:t.child!==e.child&&(t.child=e.child),0===t.expirationTime||t.expirationTime>n?d(e,t):(t.firstEffect=null,t.lastEffect=null,t.child=null===e?Mr(t,null,null,n):Pr(t,e.child,null,n),2===t.tag&&(e=t.stateNode,t.memoizedProps=e.props,t.memoizedState=e.state),t.child)}}}function Lt(e,t,n){function r(e){e.effectTag|=4}var l=e.createInstance,a=e.createTextInstance,i=e.appendInitialChild,c=e.finalizeInitialChildren,s=e.prepareUpdate,u=e.persistence,d=t.getRootHostContainer,f=t.popHostContext,p=t.getHostContext,b=t.popHostContainer,h=n.prepareToHydrateHostInstance,m=n.prepareToHydrateHostTextInstance,g=n.popHydrationState,_=void 0,v=void 0,y=void 0;return e.mutation?(_=function(){},v=function(e,t,n){(t.updateQueue=n)&&r(t)},y=function(e,t,n,o){n!==o&&r(t)}):o(u?"235":"236"),{completeWork:function(e,t,n){var u=t.pendingProps;switch(null===u?u=t.memoizedProps:2147483647===t.expirationTime&&2147483647!==n||(t.pendingProps=null),t.tag){case 1:return null;case 2:return et(t),null;case 3:return b(t),Be(hr,t),Be(br,t),u=t.stateNode,u.pendingContext&&(u.context=u.pendingContext,u.pendingContext=null),null!==e&&null!==e.child||(g(t),t.effectTag&=-3),_(t),null;case 5:f(t),n=d();var x=t.type;if(null!==e&&null!=t.stateNode){var w=e.memoizedProps,k=t.stateNode,E=p();k=s(k,x,w,u,n,E),v(e,t,k,x,w,u,n),e.ref!==t.ref&&(t.effectTag|=128)}else{if(!u)return null===t.stateNode&&o("166"),null;if(e=p(),g(t))h(t,n,e)&&r(t);else{e=l(x,u,n,e,t);e:for(w=t.child;null!==w;){if(5===w.tag||6===w.tag)i(e,w.stateNode);else if(4!==w.tag&&null!==w.child){w.child.return=w,w=w.child;continue}if(w===t)break;for(;null===w.sibling;){if(null===w.return||w.return===t)break e;w=w.return}w.sibling.return=w.return,w=w.sibling}c(e,x,u,n)&&r(t),t.stateNode=e}null!==t.ref&&(t.effectTag|=128)}return null;case 6:if(e&&null!=t.stateNode)y(e,t,e.memoizedProps,u);else{if("string"!=typeof u)return null===t.stateNode&&o("166"),null;e=d(),n=p(),g(t)?m(t)&&r(t):t.stateNode=a(u,e,n,t)}return null;case 7:(u=t.memoizedProps)||o("165"),t.tag=8,x=[];e:for((w=t.stateNode)&&(w.return=t);null!==w;){if(5===w.tag||6===w.tag||4===w.tag)o("247");else if(9===w.tag)x.push(w.type);else if(null!==w.child){w.child.return=w,w=w.child;continue}for(;null===w.sibling;){if(null===w.return||w.return===t)break e;w=w.return}w.sibling.return=w.return,w=w.sibling}return w=u.handler,u=w(u.props,x),t.child=Pr(t,null!==e?e.child:null,u,n),t.child;case 8:return t.tag=7,null;case 9:case 10:return null;case 4:return b(t),_(t),null;case 0:o("167");default:o("156")}}}}function Rt(e,t){function n(e){var n=e.ref;if(null!==n)try{n(null)}catch(n){t(e,n)}}function r(e){switch("function"==typeof mt&&mt(e),e.tag){case 2:n(e);var o=e.stateNode;if("function"==typeof o.componentWillUnmount)try{o.props=e.memoizedProps,o.state=e.memoizedState,o.componentWillUnmount()}catch(n){t(e,n)}break;case 5:n(e);break;case 7:l(e.stateNode);break;case 4:s&&i(e)}}function l(e){for(var t=e;;)if(r(t),null===t.child||s&&4===t.tag){if(t===e)break;for(;null===t.sibling;){if(null===t.return||t.return===e)return;t=t.return}t.sibling.return=t.return,t=t.sibling}else t.child.return=t,t=t.child}function a(e){return 5===e.tag||3===e.tag||4===e.tag}function i(e){for(var t=e,n=!1,a=void 0,i=void 0;;){if(!n){n=t.return;e:for(;;){switch(null===n&&o("160"),n.tag){case 5:a=n.stateNode,i=!1;break e;case 3:case 4:a=n.stateNode.containerInfo,i=!0;break e}n=n.return}n=!0}if(5===t.tag||6===t.tag)l(t),i?v(a,t.stateNode):_(a,t.stateNode);else if(4===t.tag?a=t.stateNode.containerInfo:r(t),null!==t.child){t.child.return=t,t=t.child;continue}if(t===e)break;for(;null===t.sibling;){if(null===t.return||t.return===e)return;t=t.return,4===t.tag&&(n=!1)}t.sibling.return=t.return,t=t.sibling}}var c=e.getPublicInstance,s=e.mutation;e=e.persistence,s||o(e?"235":"236");var u=s.commitMount,d=s.commitUpdate,f=s.resetTextContent,p=s.commitTextUpdate,b=s.appendChild,h=s.appendChildToContainer,m=s.insertBefore,g=s.insertInContainerBefore,_=s.removeChild,v=s.removeChildFromContainer;return{commitResetTextContent:function(e){f(e.stateNode)},commitPlacement:function(e){e:{for(var t=e.return;null!==t;){if(a(t)){var n=t;break e}t=t.return}o("160"),n=void 0}var r=t=void 0;switch(n.tag){case 5:t=n.stateNode,r=!1;break;case 3:case 4:t=n.stateNode.containerInfo,r=!0;break;default:o("161")}16&n.effectTag&&(f(t),n.effectTag&=-17);e:t:for(n=e;;){for(;null===n.sibling;){if(null===n.return||a(n.return)){n=null;break e}n=n.return}for(n.sibling.return=n.return,n=n.sibling;5!==n.tag&&6!==n.tag;){if(2&n.effectTag)continue t;if(null===n.child||4===n.tag)continue t;n.child.return=n,n=n.child}if(!(2&n.effectTag)){n=n.stateNode;break e}}for(var l=e;;){if(5===l.tag||6===l.tag)n?r?g(t,l.stateNode,n):m(t,l.stateNode,n):r?h(t,l.stateNode):b(t,l.stateNode);else if(4!==l.tag&&null!==l.child){l.child.return=l,l=l.child;continue}if(l===e)break;for(;null===l.sibling;){if(null===l.return||l.return===e)return;l=l.return}l.sibling.return=l.return,l=l.sibling}},commitDeletion:function(e){i(e),e.return=null,e.child=null,e.alternate&&(e.alternate.child=null,e.alternate.return=null)},commitWork:function(e,t){switch(t.tag){case 2:break;case 5:var n=t.stateNode;if(null!=n){var r=t.memoizedProps;e=null!==e?e.memoizedProps:r;var l=t.type,a=t.updateQueue;t.updateQueue=null,null!==a&&d(n,a,l,e,r,t)}break;case 6:null===t.stateNode&&o("162"),n=t.memoizedProps,p(t.stateNode,null!==e?e.memoizedProps:n,n);break;case 3:break;default:o("163")}},commitLifeCycles:function(e,t){switch(t.tag){case 2:var n=t.stateNode;if(4&t.effectTag)if(null===e)n.props=t.memoizedProps,n.state=t.memoizedState,n.componentDidMount();else{var r=e.memoizedProps;e=e.memoizedState,n.props=t.memoizedProps,n.state=t.memoizedState,n.componentDidUpdate(r,e)}t=t.updateQueue,null!==t&&wt(t,n);break;case 3:n=t.updateQueue,null!==n&&wt(n,null!==t.child?t.child.stateNode:null);break;case 5:n=t.stateNode,null===e&&4&t.effectTag&&u(n,t.type,t.memoizedProps,t);break;case 6:case 4:break;default:o("163")}},commitAttachRef:function(e){var t=e.ref;if(null!==t){var n=e.stateNode;switch(e.tag){case 5:t(c(n));break;default:t(n)}}},commitDetachRef:function(e){null!==(e=e.ref)&&e(null)}}}function jt(e){function t(e){return e===Lr&&o("174"),e}var n=e.getChildHostContext,r=e.getRootHostContext,l={current:Lr},a={current:Lr},i={current:Lr};return{getHostContext:function(){return t(l.current)},getRootHostContainer:function(){return t(i.current)},popHostContainer:function(e){Be(l,e),Be(a,e),Be(i,e)},popHostContext:function(e){a.current===e&&(Be(l,e),Be(a,e))},pushHostContainer:function(e,t){Qe(i,t,e),t=r(t),Qe(a,e,e),Qe(l,t,e)},pushHostContext:function(e){var o=t(i.current),r=t(l.current);o=n(r,e.type,o),r!==o&&(Qe(a,e,e),Qe(l,o,e))},resetHostContainer:function(){l.current=Lr,i.current=Lr}}}function St(e){function t(e,t){var n=new lt(5,null,0);n.type="DELETED",n.stateNode=t,n.return=e,n.effectTag=8,null!==e.lastEffect?(e.lastEffect.nextEffect=n,e.lastEffect=n):e.firstEffect=e.lastEffect=n}function n(e,t){switch(e.tag){case 5:return null!==(t=a(t,e.type,e.pendingProps))&&(e.stateNode=t,!0);case 6:return null!==(t=i(t,e.pendingProps))&&(e.stateNode=t,!0);default:return!1}}function r(e){for(e=e.return;null!==e&&5!==e.tag&&3!==e.tag;)e=e.return;f=e}var l=e.shouldSetTextContent;if(!(e=e.hydration))return{enterHydrationState:function(){return!1},resetHydrationState:function(){},tryToClaimNextHydratableInstance:function(){},prepareToHydrateHostInstance:function(){o("175")},prepareToHydrateHostTextInstance:function(){o("176")},popHydrationState:function(){return!1}};var a=e.canHydrateInstance,i=e.canHydrateTextInstance,c=e.getNextHydratableSibling,s=e.getFirstHydratableChild,u=e.hydrateInstance,d=e.hydrateTextInstance,f=null,p=null,b=!1;return{enterHydrationState:function(e){return p=s(e.stateNode.containerInfo),f=e,b=!0},resetHydrationState:function(){p=f=null,b=!1},tryToClaimNextHydratableInstance:function(e){if(b){var o=p;if(o){if(!n(e,o)){if(!(o=c(o))||!n(e,o))return e.effectTag|=2,b=!1,void(f=e);t(f,p)}f=e,p=s(o)}else e.effectTag|=2,b=!1,f=e}},prepareToHydrateHostInstance:function(e,t,n){return t=u(e.stateNode,e.type,e.memoizedProps,t,n,e),e.updateQueue=t,null!==t},prepareToHydrateHostTextInstance:function(e){return d(e.stateNode,e.memoizedProps,e)},popHydrationState:function(e){if(e!==f)return!1;if(!b)return r(e),b=!0,!1;var n=e.type;if(5!==e.tag||"head"!==n&&"body"!==n&&!l(n,e.memoizedProps))for(n=p;n;)t(e,n),n=c(n);return r(e),p=f?c(e.stateNode):null,!0}}}function Tt(e){function t(e){le=B=!0;var t=e.stateNode;if(t.current===e&&o("177"),t.isReadyForCommit=!1,Fo.current=null,1<e.effectTag)if(null!==e.lastEffect){e.lastEffect.nextEffect=e;var n=e.firstEffect}else n=e;else n=e.firstEffect;for(W(),$=n;null!==$;){var r=!1,l=void 0;try{for(;null!==$;){var a=$.effectTag;if(16&a&&T($),128&a){var i=$.alternate;null!==i&&A(i)}switch(-242&a){case 2:H($),$.effectTag&=-3;break;case 6:H($),$.effectTag&=-3,N($.alternate,$);break;case 4:N($.alternate,$);break;case 8:ae=!0,U($),ae=!1}$=$.nextEffect}}catch(e){r=!0,l=e}r&&(null===$&&o("178"),c($,l),null!==$&&($=$.nextEffect))}for(X(),t.current=e,$=n;null!==$;){n=!1,r=void 0;try{for(;null!==$;){var s=$.effectTag;if(36&s&&I($.alternate,$),128&s&&D($),64&s)switch(l=$,a=void 0,null!==ee&&(a=ee.get(l),ee.delete(l),null==a&&null!==l.alternate&&(l=l.alternate,a=ee.get(l),ee.delete(l))),null==a&&o("184"),l.tag){case 2:l.stateNode.componentDidCatch(a.error,{componentStack:a.componentStack});break;case 3:null===oe&&(oe=a.error);break;default:o("157")}var u=$.nextEffect;$.nextEffect=null,$=u}}catch(e){n=!0,r=e}n&&(null===$&&o("178"),c($,r),null!==$&&($=$.nextEffect))}return B=le=!1,"function"==typeof ht&&ht(e.stateNode),ne&&(ne.forEach(h),ne=null),null!==oe&&(e=oe,oe=null,k(e)),t=t.current.expirationTime,0===t&&(te=ee=null),t}function n(e){for(;;){var t=S(e.alternate,e,J),n=e.return,o=e.sibling,r=e;if(2147483647===J||2147483647!==r.expirationTime){if(2!==r.tag&&3!==r.tag)var l=0;else l=r.updateQueue,l=null===l?0:l.expirationTime;for(var a=r.child;null!==a;)0!==a.expirationTime&&(0===l||l>a.expirationTime)&&(l=a.expirationTime),a=a.sibling;r.expirationTime=l}if(null!==t)return t;if(null!==n&&(null===n.firstEffect&&(n.firstEffect=e.firstEffect),null!==e.lastEffect&&(null!==n.lastEffect&&(n.lastEffect.nextEffect=e.firstEffect),n.lastEffect=e.lastEffect),1<e.effectTag&&(null!==n.lastEffect?n.lastEffect.nextEffect=e:n.firstEffect=e,n.lastEffect=e)),null!==o)return o;if(null===n){e.stateNode.isReadyForCommit=!0;break}e=n}return null}function r(e){var t=R(e.alternate,e,J);return null===t&&(t=n(e)),Fo.current=null,t}function l(e){var t=j(e.alternate,e,J);return null===t&&(t=n(e)),Fo.current=null,t}function a(e){if(null!==ee){if(!(0===J||J>e))if(J<=K)for(;null!==Q;)Q=s(Q)?l(Q):r(Q);else for(;null!==Q&&!w();)Q=s(Q)?l(Q):r(Q)}else if(!(0===J||J>e))if(J<=K)for(;null!==Q;)Q=r(Q);else for(;null!==Q&&!w();)Q=r(Q)}function i(e,t){if(B&&o("243"),B=!0,e.isReadyForCommit=!1,e!==Z||t!==J||null===Q){for(;-1<pr;)fr[pr]=null,pr--;mr=Mn,br.current=Mn,hr.current=!1,M(),Z=e,J=t,Q=at(Z.current,null,t)}var n=!1,r=null;try{a(t)}catch(e){n=!0,r=e}for(;n;){if(re){oe=r;break}var i=Q;if(null===i)re=!0;else{var s=c(i,r);if(null===s&&o("183"),!re){try{for(n=s,r=t,s=n;null!==i;){switch(i.tag){case 2:et(i);break;case 5:P(i);break;case 3:O(i);break;case 4:O(i)}if(i===s||i.alternate===s)break;i=i.return}Q=l(n),a(r)}catch(e){n=!0,r=e;continue}break}}}return t=oe,re=B=!1,oe=null,null!==t&&k(t),e.isReadyForCommit?e.current.alternate:null}function c(e,t){var n=Fo.current=null,o=!1,r=!1,l=null;if(3===e.tag)n=e,u(e)&&(re=!0);else for(var a=e.return;null!==a&&null===n;){if(2===a.tag?"function"==typeof a.stateNode.componentDidCatch&&(o=!0,l=xe(a),n=a,r=!0):3===a.tag&&(n=a),u(a)){if(ae||null!==ne&&(ne.has(a)||null!==a.alternate&&ne.has(a.alternate)))return null;n=null,r=!1}a=a.return}if(null!==n){null===te&&(te=new Set),te.add(n);var i="";a=e;do{e:switch(a.tag){case 0:case 1:case 2:case 5:var c=a._debugOwner,s=a._debugSource,d=xe(a),f=null;c&&(f=xe(c)),c=s,d="\n    in "+(d||"Unknown")+(c?" (at "+c.fileName.replace(/^.*[\\\/]/,"")+":"+c.lineNumber+")":f?" (created by "+f+")":"");break e;default:d=""}i+=d,a=a.return}while(a);a=i,e=xe(e),null===ee&&(ee=new Map),t={componentName:e,componentStack:a,error:t,errorBoundary:o?n.stateNode:null,errorBoundaryFound:o,errorBoundaryName:l,willRetry:r},ee.set(n,t);try{var p=t.error;p&&p.suppressReactErrorLogging||console.error(p)}catch(e){e&&e.suppressReactErrorLogging||console.error(e)}return le?(null===ne&&(ne=new Set),ne.add(n)):h(n),n}return null===oe&&(oe=t),null}function s(e){return null!==ee&&(ee.has(e)||null!==e.alternate&&ee.has(e.alternate))}function u(e){return null!==te&&(te.has(e)||null!==e.alternate&&te.has(e.alternate))}function d(){return 20*(1+((m()+100)/20|0))}function f(e){return 0!==G?G:B?le?1:J:!z||1&e.internalContextTag?d():1}function p(e,t){return b(e,t,!1)}function b(e,t){for(;null!==e;){if((0===e.expirationTime||e.expirationTime>t)&&(e.expirationTime=t),null!==e.alternate&&(0===e.alternate.expirationTime||e.alternate.expirationTime>t)&&(e.alternate.expirationTime=t),null===e.return){if(3!==e.tag)break;var n=e.stateNode;!B&&n===Z&&t<J&&(Q=Z=null,J=0);var r=n,l=t;if(we>ye&&o("185"),null===r.nextScheduledRoot)r.remainingExpirationTime=l,null===ce?(ie=ce=r,r.nextScheduledRoot=r):(ce=ce.nextScheduledRoot=r,ce.nextScheduledRoot=ie);else{var a=r.remainingExpirationTime;(0===a||l<a)&&(r.remainingExpirationTime=l)}de||(_e?ve&&(fe=r,pe=1,x(fe,pe)):1===l?y(1,null):g(l)),!B&&n===Z&&t<J&&(Q=Z=null,J=0)}e=e.return}}function h(e){b(e,1,!0)}function m(){return K=2+((Y()-V)/10|0)}function g(e){if(0!==se){if(e>se)return;F(ue)}var t=Y()-V;se=e,ue=q(v,{timeout:10*(e-2)-t})}function _(){var e=0,t=null;if(null!==ce)for(var n=ce,r=ie;null!==r;){var l=r.remainingExpirationTime;if(0===l){if((null===n||null===ce)&&o("244"),r===r.nextScheduledRoot){ie=ce=r.nextScheduledRoot=null;break}if(r===ie)ie=l=r.nextScheduledRoot,ce.nextScheduledRoot=l,r.nextScheduledRoot=null;else{if(r===ce){ce=n,ce.nextScheduledRoot=ie,r.nextScheduledRoot=null;break}n.nextScheduledRoot=r.nextScheduledRoot,r.nextScheduledRoot=null}r=n.nextScheduledRoot}else{if((0===e||l<e)&&(e=l,t=r),r===ce)break;n=r,r=r.nextScheduledRoot}}n=fe,null!==n&&n===t?we++:we=0,fe=t,pe=e}function v(e){y(0,e)}function y(e,t){for(ge=t,_();null!==fe&&0!==pe&&(0===e||pe<=e)&&!be;)x(fe,pe),_();if(null!==ge&&(se=0,ue=-1),0!==pe&&g(pe),ge=null,be=!1,we=0,he)throw e=me,me=null,he=!1,e}function x(e,n){if(de&&o("245"),de=!0,n<=m()){var r=e.finishedWork;null!==r?(e.finishedWork=null,e.remainingExpirationTime=t(r)):(e.finishedWork=null,null!==(r=i(e,n))&&(e.remainingExpirationTime=t(r)))}else r=e.finishedWork,null!==r?(e.finishedWork=null,e.remainingExpirationTime=t(r)):(e.finishedWork=null,null!==(r=i(e,n))&&(w()?e.finishedWork=r:e.remainingExpirationTime=t(r)));de=!1}function w(){return!(null===ge||ge.timeRemaining()>ke)&&(be=!0)}function k(e){null===fe&&o("246"),fe.remainingExpirationTime=0,he||(he=!0,me=e)}var E=jt(e),C=St(e),O=E.popHostContainer,P=E.popHostContext,M=E.resetHostContainer,L=Mt(e,E,C,p,f),R=L.beginWork,j=L.beginFailedWork,S=Lt(e,E,C).completeWork;E=Rt(e,c);var T=E.commitResetTextContent,H=E.commitPlacement,U=E.commitDeletion,N=E.commitWork,I=E.commitLifeCycles,D=E.commitAttachRef,A=E.commitDetachRef,Y=e.now,q=e.scheduleDeferredCallback,F=e.cancelDeferredCallback,z=e.useSyncScheduling,W=e.prepareForCommit,X=e.resetAfterCommit,V=Y(),K=2,G=0,B=!1,Q=null,Z=null,J=0,$=null,ee=null,te=null,ne=null,oe=null,re=!1,le=!1,ae=!1,ie=null,ce=null,se=0,ue=-1,de=!1,fe=null,pe=0,be=!1,he=!1,me=null,ge=null,_e=!1,ve=!1,ye=1e3,we=0,ke=1;return{computeAsyncExpiration:d,computeExpirationForFiber:f,scheduleWork:p,batchedUpdates:function(e,t){var n=_e;_e=!0;try{return e(t)}finally{(_e=n)||de||y(1,null)}},unbatchedUpdates:function(e){if(_e&&!ve){ve=!0;try{return e()}finally{ve=!1}}return e()},flushSync:function(e){var t=_e;_e=!0;try{e:{var n=G;G=1;try{var r=e();break e}finally{G=n}r=void 0}return r}finally{_e=t,de&&o("187"),y(1,null)}},deferredUpdates:function(e){var t=G;G=d();try{return e()}finally{G=t}}}}function Ht(e){function t(e){return e=Oe(e),null===e?null:e.stateNode}var n=e.getPublicInstance;e=Tt(e);var r=e.computeAsyncExpiration,l=e.computeExpirationForFiber,a=e.scheduleWork;return{createContainer:function(e,t){var n=new lt(3,null,0);return e={current:n,containerInfo:e,pendingChildren:null,remainingExpirationTime:0,isReadyForCommit:!1,finishedWork:null,context:null,pendingContext:null,hydrate:t,nextScheduledRoot:null},n.stateNode=e},updateContainer:function(e,t,n,i){var c=t.current;if(n){n=n._reactInternalFiber;var s;e:{for(2===we(n)&&2===n.tag||o("170"),s=n;3!==s.tag;){if($e(s)){s=s.stateNode.__reactInternalMemoizedMergedChildContext;break e}(s=s.return)||o("171")}s=s.stateNode.context}n=$e(n)?nt(n,s):s}else n=Mn;null===t.context?t.context=n:t.pendingContext=n,t=i,t=void 0===t?null:t,i=null!=e&&null!=e.type&&null!=e.type.prototype&&!0===e.type.prototype.unstable_isAsyncReactComponent?r():l(c),vt(c,{expirationTime:i,partialState:{element:e},callback:t,isReplace:!1,isForced:!1,nextCallback:null,next:null}),a(c,i)},batchedUpdates:e.batchedUpdates,unbatchedUpdates:e.unbatchedUpdates,deferredUpdates:e.deferredUpdates,flushSync:e.flushSync,getPublicRootInstance:function(e){if(e=e.current,!e.child)return null;switch(e.child.tag){case 5:return n(e.child.stateNode);default:return e.child.stateNode}},findHostInstance:t,findHostInstanceWithNoPortals:function(e){return e=Pe(e),null===e?null:e.stateNode},injectIntoDevTools:function(e){var n=e.findFiberByHostInstance;return bt(xn({},e,{findHostInstanceByFiber:function(e){return t(e)},findFiberByHostInstance:function(e){return n?n(e):null}}))}}}function Ut(e,t,n){var o=3<arguments.length&&void 0!==arguments[3]?arguments[3]:null;return{$$typeof:kr,key:null==o?null:""+o,children:e,containerInfo:t,implementation:n}}function Nt(e){return!!Br.hasOwnProperty(e)||!Gr.hasOwnProperty(e)&&(Kr.test(e)?Br[e]=!0:(Gr[e]=!0,!1))}function It(e,t,n){var o=a(t);if(o&&l(t,n)){var r=o.mutationMethod;r?r(e,n):null==n||o.hasBooleanValue&&!n||o.hasNumericValue&&isNaN(n)||o.hasPositiveNumericValue&&1>n||o.hasOverloadedBooleanValue&&!1===n?At(e,t):o.mustUseProperty?e[o.propertyName]=n:(t=o.attributeName,(r=o.attributeNamespace)?e.setAttributeNS(r,t,""+n):o.hasBooleanValue||o.hasOverloadedBooleanValue&&!0===n?e.setAttribute(t,""):e.setAttribute(t,""+n))}else Dt(e,t,l(t,n)?n:null)}function Dt(e,t,n){Nt(t)&&(null==n?e.removeAttribute(t):e.setAttribute(t,""+n))}function At(e,t){var n=a(t);n?(t=n.mutationMethod)?t(e,void 0):n.mustUseProperty?e[n.propertyName]=!n.hasBooleanValue&&"":e.removeAttribute(n.attributeName):e.removeAttribute(t)}function Yt(e,t){var n=t.value,o=t.checked;return xn({type:void 0,step:void 0,min:void 0,max:void 0},t,{defaultChecked:void 0,defaultValue:void 0,value:null!=n?n:e._wrapperState.initialValue,checked:null!=o?o:e._wrapperState.initialChecked})}function qt(e,t){var n=t.defaultValue;e._wrapperState={initialChecked:null!=t.checked?t.checked:t.defaultChecked,initialValue:null!=t.value?t.value:n,controlled:"checkbox"===t.type||"radio"===t.type?null!=t.checked:null!=t.value}}function Ft(e,t){null!=(t=t.checked)&&It(e,"checked",t)}function zt(e,t){Ft(e,t);var n=t.value;null!=n?0===n&&""===e.value?e.value="0":"number"===t.type?(t=parseFloat(e.value)||0,(n!=t||n==t&&e.value!=n)&&(e.value=""+n)):e.value!==""+n&&(e.value=""+n):(null==t.value&&null!=t.defaultValue&&e.defaultValue!==""+t.defaultValue&&(e.defaultValue=""+t.defaultValue),null==t.checked&&null!=t.defaultChecked&&(e.defaultChecked=!!t.defaultChecked))}function Wt(e,t){switch(t.type){case"submit":case"reset":break;case"color":case"date":case"datetime":case"datetime-local":case"month":case"time":case"week":e.value="",e.value=e.defaultValue;break;default:e.value=e.value}t=e.name,""!==t&&(e.name=""),e.defaultChecked=!e.defaultChecked,e.defaultChecked=!e.defaultChecked,""!==t&&(e.name=t)}function Xt(e){var t="";return vn.Children.forEach(e,function(e){null==e||"string"!=typeof e&&"number"!=typeof e||(t+=e)}),t}function Vt(e,t){return e=xn({children:void 0},t),(t=Xt(t.children))&&(e.children=t),e}function Kt(e,t,n,o){if(e=e.options,t){t={};for(var r=0;r<n.length;r++)t["$"+n[r]]=!0;for(n=0;n<e.length;n++)r=t.hasOwnProperty("$"+e[n].value),e[n].selected!==r&&(e[n].selected=r),r&&o&&(e[n].defaultSelected=!0)}else{for(n=""+n,t=null,r=0;r<e.length;r++){if(e[r].value===n)return e[r].selected=!0,void(o&&(e[r].defaultSelected=!0));null!==t||e[r].disabled||(t=e[r])}null!==t&&(t.selected=!0)}}function Gt(e,t){var n=t.value;e._wrapperState={initialValue:null!=n?n:t.defaultValue,wasMultiple:!!t.multiple}}function Bt(e,t){return null!=t.dangerouslySetInnerHTML&&o("91"),xn({},t,{value:void 0,defaultValue:void 0,children:""+e._wrapperState.initialValue})}function Qt(e,t){var n=t.value;null==n&&(n=t.defaultValue,t=t.children,null!=t&&(null!=n&&o("92"),Array.isArray(t)&&(1>=t.length||o("93"),t=t[0]),n=""+t),null==n&&(n="")),e._wrapperState={initialValue:""+n}}function Zt(e,t){var n=t.value;null!=n&&(n=""+n,n!==e.value&&(e.value=n),null==t.defaultValue&&(e.defaultValue=n)),null!=t.defaultValue&&(e.defaultValue=t.defaultValue)}function Jt(e){var t=e.textContent;t===e._wrapperState.initialValue&&(e.value=t)}function $t(e){switch(e){case"svg":return"http://www.w3.org/2000/svg";case"math":return"http://www.w3.org/1998/Math/MathML";default:return"http://www.w3.org/1999/xhtml"}}function en(e,t){return null==e||"http://www.w3.org/1999/xhtml"===e?$t(t):"http://www.w3.org/2000/svg"===e&&"foreignObject"===t?"http://www.w3.org/1999/xhtml":e}function tn(e,t){if(t){var n=e.firstChild;if(n&&n===e.lastChild&&3===n.nodeType)return void(n.nodeValue=t)}e.textContent=t}function nn(e,t){e=e.style;for(var n in t)if(t.hasOwnProperty(n)){var o=0===n.indexOf("--"),r=n,l=t[n];r=null==l||"boolean"==typeof l||""===l?"":o||"number"!=typeof l||0===l||$r.hasOwnProperty(r)&&$r[r]?(""+l).trim():l+"px","float"===n&&(n="cssFloat"),o?e.setProperty(n,r):e[n]=r}}function on(e,t,n){t&&(tl[e]&&(null!=t.children||null!=t.dangerouslySetInnerHTML)&&o("137",e,n()),null!=t.dangerouslySetInnerHTML&&(null!=t.children&&o("60"),"object"==typeof t.dangerouslySetInnerHTML&&"__html"in t.dangerouslySetInnerHTML||o("61")),null!=t.style&&"object"!=typeof t.style&&o("62",n()))}function rn(e,t){if(-1===e.indexOf("-"))return"string"==typeof t.is;switch(e){case"annotation-xml":case"color-profile":case"font-face":case"font-face-src":case"font-face-uri":case"font-face-format":case"font-face-name":case"missing-glyph":return!1;default:return!0}}function ln(e,t){e=9===e.nodeType||11===e.nodeType?e:e.ownerDocument;var n=Ue(e);t=Qn[t];for(var o=0;o<t.length;o++){var r=t[o];n.hasOwnProperty(r)&&n[r]||("topScroll"===r?je("topScroll","scroll",e):"topFocus"===r||"topBlur"===r?(je("topFocus","focus",e),je("topBlur","blur",e),n.topBlur=!0,n.topFocus=!0):"topCancel"===r?(ne("cancel",!0)&&je("topCancel","cancel",e),n.topCancel=!0):"topClose"===r?(ne("close",!0)&&je("topClose","close",e),n.topClose=!0):Qo.hasOwnProperty(r)&&Re(r,Qo[r],e),n[r]=!0)}}function an(e,t,n,o){return n=9===n.nodeType?n:n.ownerDocument,o===nl&&(o=$t(e)),o===nl?"script"===e?(e=n.createElement("div"),e.innerHTML="<script><\/script>",e=e.removeChild(e.firstChild)):e="string"==typeof t.is?n.createElement(e,{is:t.is}):n.createElement(e):e=n.createElementNS(o,e),e}function cn(e,t){return(9===t.nodeType?t:t.ownerDocument).createTextNode(e)}function sn(e,t,n,o){var r=rn(t,n);switch(t){case"iframe":case"object":Re("topLoad","load",e);var l=n;break;case"video":case"audio":for(l in rl)rl.hasOwnProperty(l)&&Re(l,rl[l],e);l=n;break;case"source":Re("topError","error",e),l=n;break;case"img":case"image":Re("topError","error",e),Re("topLoad","load",e),l=n;break;case"form":Re("topReset","reset",e),Re("topSubmit","submit",e),l=n;break;case"details":Re("topToggle","toggle",e),l=n;break;case"input":qt(e,n),l=Yt(e,n),Re("topInvalid","invalid",e),ln(o,"onChange");break;case"option":l=Vt(e,n);break;case"select":Gt(e,n),l=xn({},n,{value:void 0}),Re("topInvalid","invalid",e),ln(o,"onChange");break;case"textarea":Qt(e,n),l=Bt(e,n),Re("topInvalid","invalid",e),ln(o,"onChange");break;default:l=n}on(t,l,ol);var a,i=l;for(a in i)if(i.hasOwnProperty(a)){var c=i[a];"style"===a?nn(e,c,ol):"dangerouslySetInnerHTML"===a?null!=(c=c?c.__html:void 0)&&Jr(e,c):"children"===a?"string"==typeof c?("textarea"!==t||""!==c)&&tn(e,c):"number"==typeof c&&tn(e,""+c):"suppressContentEditableWarning"!==a&&"suppressHydrationWarning"!==a&&"autoFocus"!==a&&(Bn.hasOwnProperty(a)?null!=c&&ln(o,a):r?Dt(e,a,c):null!=c&&It(e,a,c))}switch(t){case"input":le(e),Wt(e,n);break;case"textarea":le(e),Jt(e,n);break;case"option":null!=n.value&&e.setAttribute("value",n.value);break;case"select":e.multiple=!!n.multiple,t=n.value,null!=t?Kt(e,!!n.multiple,t,!1):null!=n.defaultValue&&Kt(e,!!n.multiple,n.defaultValue,!0);break;default:"function"==typeof l.onClick&&(e.onclick=wn)}}function un(e,t,n,o,r){var l=null;switch(t){case"input":n=Yt(e,n),o=Yt(e,o),l=[];break;case"option":n=Vt(e,n),o=Vt(e,o),l=[];break;case"select":n=xn({},n,{value:void 0}),o=xn({},o,{value:void 0}),l=[];break;case"textarea":n=Bt(e,n),o=Bt(e,o),l=[];break;default:"function"!=typeof n.onClick&&"function"==typeof o.onClick&&(e.onclick=wn)}on(t,o,ol);var a,i;e=null;for(a in n)if(!o.hasOwnProperty(a)&&n.hasOwnProperty(a)&&null!=n[a])if("style"===a)for(i in t=n[a])t.hasOwnProperty(i)&&(e||(e={}),e[i]="");else"dangerouslySetInnerHTML"!==a&&"children"!==a&&"suppressContentEditableWarning"!==a&&"suppressHydrationWarning"!==a&&"autoFocus"!==a&&(Bn.hasOwnProperty(a)?l||(l=[]):(l=l||[]).push(a,null));for(a in o){var c=o[a];if(t=null!=n?n[a]:void 0,o.hasOwnProperty(a)&&c!==t&&(null!=c||null!=t))if("style"===a)if(t){for(i in t)!t.hasOwnProperty(i)||c&&c.hasOwnProperty(i)||(e||(e={}),e[i]="");for(i in c)c.hasOwnProperty(i)&&t[i]!==c[i]&&(e||(e={}),e[i]=c[i])}else e||(l||(l=[]),l.push(a,e)),e=c;else"dangerouslySetInnerHTML"===a?(c=c?c.__html:void 0,t=t?t.__html:void 0,null!=c&&t!==c&&(l=l||[]).push(a,""+c)):"children"===a?t===c||"string"!=typeof c&&"number"!=typeof c||(l=l||[]).push(a,""+c):"suppressContentEditableWarning"!==a&&"suppressHydrationWarning"!==a&&(Bn.hasOwnProperty(a)?(null!=c&&ln(r,a),l||t===c||(l=[])):(l=l||[]).push(a,c))}return e&&(l=l||[]).push("style",e),l}function dn(e,t,n,o,r){"input"===n&&"radio"===r.type&&null!=r.name&&Ft(e,r),rn(n,o),o=rn(n,r);for(var l=0;l<t.length;l+=2){var a=t[l],i=t[l+1];"style"===a?nn(e,i,ol):"dangerouslySetInnerHTML"===a?Jr(e,i):"children"===a?tn(e,i):o?null!=i?Dt(e,a,i):e.removeAttribute(a):null!=i?It(e,a,i):At(e,a)}switch(n){case"input":zt(e,r);break;case"textarea":Zt(e,r);break;case"select":e._wrapperState.initialValue=void 0,t=e._wrapperState.wasMultiple,e._wrapperState.wasMultiple=!!r.multiple,n=r.value,null!=n?Kt(e,!!r.multiple,n,!1):t!==!!r.multiple&&(null!=r.defaultValue?Kt(e,!!r.multiple,r.defaultValue,!0):Kt(e,!!r.multiple,r.multiple?[]:"",!1))}}function fn(e,t,n,o,r){switch(t){case"iframe":case"object":Re("topLoad","load",e);break;case"video":case"audio":for(var l in rl)rl.hasOwnProperty(l)&&Re(l,rl[l],e);break;case"source":Re("topError","error",e);break;case"img":case"image":Re("topError","error",e),Re("topLoad","load",e);break;case"form":Re("topReset","reset",e),Re("topSubmit","submit",e);break;case"details":Re("topToggle","toggle",e);break;case"input":qt(e,n),Re("topInvalid","invalid",e),ln(r,"onChange");break;case"select":Gt(e,n),Re("topInvalid","invalid",e),ln(r,"onChange");break;case"textarea":Qt(e,n),Re("topInvalid","invalid",e),ln(r,"onChange")}on(t,n,ol),o=null;for(var a in n)n.hasOwnProperty(a)&&(l=n[a],"children"===a?"string"==typeof l?e.textContent!==l&&(o=["children",l]):"number"==typeof l&&e.textContent!==""+l&&(o=["children",""+l]):Bn.hasOwnProperty(a)&&null!=l&&ln(r,a));switch(t){case"input":le(e),Wt(e,n);break;case"textarea":le(e),Jt(e,n);break;case"select":case"option":break;default:"function"==typeof n.onClick&&(e.onclick=wn)}return o}function pn(e,t){return e.nodeValue!==t}function bn(e){return!(!e||1!==e.nodeType&&9!==e.nodeType&&11!==e.nodeType&&(8!==e.nodeType||" react-mount-point-unstable "!==e.nodeValue))}function hn(e){return!(!(e=e?9===e.nodeType?e.documentElement:e.firstChild:null)||1!==e.nodeType||!e.hasAttribute("data-reactroot"))}function mn(e,t,n,r,l){bn(n)||o("200");var a=n._reactRootContainer;if(a)cl.updateContainer(t,a,e,l);else{if(!(r=r||hn(n)))for(a=void 0;a=n.lastChild;)n.removeChild(a);var i=cl.createContainer(n,r);a=n._reactRootContainer=i,cl.unbatchedUpdates(function(){cl.updateContainer(t,i,e,l)})}return cl.getPublicRootInstance(a)}function gn(e,t){var n=2<arguments.length&&void 0!==arguments[2]?arguments[2]:null;return bn(t)||o("200"),Ut(e,t,null,n)}function _n(e,t){this._reactRootContainer=cl.createContainer(e,t)}var vn=n(1),yn=n(48),xn=n(13),wn=n(14),kn=n(49),En=n(50),Cn=n(51),On=n(52),Pn=n(55),Mn=n(18);vn||o("227");var Ln={children:!0,dangerouslySetInnerHTML:!0,defaultValue:!0,defaultChecked:!0,innerHTML:!0,suppressContentEditableWarning:!0,suppressHydrationWarning:!0,style:!0},Rn={MUST_USE_PROPERTY:1,HAS_BOOLEAN_VALUE:4,HAS_NUMERIC_VALUE:8,HAS_POSITIVE_NUMERIC_VALUE:24,HAS_OVERLOADED_BOOLEAN_VALUE:32,HAS_STRING_BOOLEAN_VALUE:64,injectDOMPropertyConfig:function(e){var t=Rn,n=e.Properties||{},l=e.DOMAttributeNamespaces||{},a=e.DOMAttributeNames||{};e=e.DOMMutationMethods||{};for(var i in n){jn.hasOwnProperty(i)&&o("48",i);var c=i.toLowerCase(),s=n[i];c={attributeName:c,attributeNamespace:null,propertyName:i,mutationMethod:null,mustUseProperty:r(s,t.MUST_USE_PROPERTY),hasBooleanValue:r(s,t.HAS_BOOLEAN_VALUE),hasNumericValue:r(s,t.HAS_NUMERIC_VALUE),hasPositiveNumericValue:r(s,t.HAS_POSITIVE_NUMERIC_VALUE),hasOverloadedBooleanValue:r(s,t.HAS_OVERLOADED_BOOLEAN_VALUE),hasStringBooleanValue:r(s,t.HAS_STRING_BOOLEAN_VALUE)},1>=c.hasBooleanValue+c.hasNumericValue+c.hasOverloadedBooleanValue||o("50",i),a.hasOwnProperty(i)&&(c.attributeName=a[i]),l.hasOwnProperty(i)&&(c.attributeNamespace=l[i]),e.hasOwnProperty(i)&&(c.mutationMethod=e[i]),jn[i]=c}}},jn={},Sn=Rn,Tn=Sn.MUST_USE_PROPERTY,Hn=Sn.HAS_BOOLEAN_VALUE,Un=Sn.HAS_NUMERIC_VALUE,Nn=Sn.HAS_POSITIVE_NUMERIC_VALUE,In=Sn.HAS_OVERLOADED_BOOLEAN_VALUE,Dn=Sn.HAS_STRING_BOOLEAN_VALUE,An={Properties:{allowFullScreen:Hn,async:Hn,autoFocus:Hn,autoPlay:Hn,capture:In,checked:Tn|Hn,cols:Nn,contentEditable:Dn,controls:Hn,default:Hn,defer:Hn,disabled:Hn,download:In,draggable:Dn,formNoValidate:Hn,hidden:Hn,loop:Hn,multiple:Tn|Hn,muted:Tn|Hn,noValidate:Hn,open:Hn,playsInline:Hn,readOnly:Hn,required:Hn,reversed:Hn,rows:Nn,rowSpan:Un,scoped:Hn,seamless:Hn,selected:Tn|Hn,size:Nn,start:Un,span:Nn,spellCheck:Dn,style:0,tabIndex:0,itemScope:Hn,acceptCharset:0,className:0,htmlFor:0,httpEquiv:0,value:Dn},DOMAttributeNames:{acceptCharset:"accept-charset",className:"class",htmlFor:"for",httpEquiv:"http-equiv"},DOMMutationMethods:{value:function(e,t){if(null==t)return e.removeAttribute("value");"number"!==e.type||!1===e.hasAttribute("value")?e.setAttribute("value",""+t):e.validity&&!e.validity.badInput&&e.ownerDocument.activeElement!==e&&e.setAttribute("value",""+t)}}},Yn=Sn.HAS_STRING_BOOLEAN_VALUE,qn={xlink:"http://www.w3.org/1999/xlink",xml:"http://www.w3.org/XML/1998/namespace"},Fn={Properties:{autoReverse:Yn,externalResourcesRequired:Yn,preserveAlpha:Yn},DOMAttributeNames:{autoReverse:"autoReverse",externalResourcesRequired:"externalResourcesRequired",preserveAlpha:"preserveAlpha"},DOMAttributeNamespaces:{xlinkActuate:qn.xlink,xlinkArcrole:qn.xlink,xlinkHref:qn.xlink,xlinkRole:qn.xlink,xlinkShow:qn.xlink,xlinkTitle:qn.xlink,xlinkType:qn.xlink,xmlBase:qn.xml,xmlLang:qn.xml,xmlSpace:qn.xml}},zn=/[\-\:]([a-z])/g;"accent-height alignment-baseline arabic-form baseline-shift cap-height clip-path clip-rule color-interpolation color-interpolation-filters color-profile color-rendering dominant-baseline enable-background fill-opacity fill-rule flood-color flood-opacity font-family font-size font-size-adjust font-stretch font-style font-variant font-weight glyph-name glyph-orientation-horizontal glyph-orientation-vertical horiz-adv-x horiz-origin-x image-rendering letter-spacing lighting-color marker-end marker-mid marker-start overline-position overline-thickness paint-order panose-1 pointer-events rendering-intent shape-rendering stop-color stop-opacity strikethrough-position strikethrough-thickness stroke-dasharray stroke-dashoffset stroke-linecap stroke-linejoin stroke-miterlimit stroke-opacity stroke-width text-anchor text-decoration text-rendering underline-position underline-thickness unicode-bidi unicode-range units-per-em v-alphabetic v-hanging v-ideographic v-mathematical vector-effect vert-adv-y vert-origin-x vert-origin-y word-spacing writing-mode x-height xlink:actuate xlink:arcrole xlink:href xlink:role xlink:show xlink:title xlink:type xml:base xmlns:xlink xml:lang xml:space".split(" ").forEach(function(e){var t=e.replace(zn,i);Fn.Properties[t]=0,Fn.DOMAttributeNames[t]=e}),Sn.injectDOMPropertyConfig(An),Sn.injectDOMPropertyConfig(Fn);var Wn={_caughtError:null,_hasCaughtError:!1,_rethrowError:null,_hasRethrowError:!1,injection:{injectErrorUtils:function(e){"function"!=typeof e.invokeGuardedCallback&&o("197"),c=e.invokeGuardedCallback}},invokeGuardedCallback:function(e,t,n,o,r,l,a,i,s){c.apply(Wn,arguments)},invokeGuardedCallbackAndCatchFirstError:function(e,t,n,o,r,l,a,i,c){if(Wn.invokeGuardedCallback.apply(this,arguments),Wn.hasCaughtError()){var s=Wn.clearCaughtError();Wn._hasRethrowError||(Wn._hasRethrowError=!0,Wn._rethrowError=s)}},rethrowCaughtError:function(){return s.apply(Wn,arguments)},hasCaughtError:function(){return Wn._hasCaughtError},clearCaughtError:function(){if(Wn._hasCaughtError){var e=Wn._caughtError;return Wn._caughtError=null,Wn._hasCaughtError=!1,e}o("198")}},Xn=null,Vn={},Kn=[],Gn={},Bn={},Qn={},Zn=Object.freeze({plugins:Kn,eventNameDispatchConfigs:Gn,registrationNameModules:Bn,registrationNameDependencies:Qn,possibleRegistrationNames:null,injectEventPluginOrder:f,injectEventPluginsByName:p}),Jn=null,$n=null,eo=null,to=null,no={injectEventPluginOrder:f,injectEventPluginsByName:p},oo=Object.freeze({injection:no,getListener:y,extractEvents:x,enqueueEvents:w,processEventQueue:k}),ro=Math.random().toString(36).slice(2),lo="__reactInternalInstance$"+ro,ao="__reactEventHandlers$"+ro,io=Object.freeze({precacheFiberNode:function(e,t){t[lo]=e},getClosestInstanceFromNode:E,getInstanceFromNode:function(e){return e=e[lo],!e||5!==e.tag&&6!==e.tag?null:e},getNodeFromInstance:C,getFiberCurrentPropsFromNode:O,updateFiberProps:function(e,t){e[ao]=t}}),co=Object.freeze({accumulateTwoPhaseDispatches:H,accumulateTwoPhaseDispatchesSkipTarget:function(e){m(e,j)},accumulateEnterLeaveDispatches:U,accumulateDirectDispatches:function(e){m(e,T)}}),so=null,uo={_root:null,_startText:null,_fallbackText:null},fo="dispatchConfig _targetInst nativeEvent isDefaultPrevented isPropagationStopped _dispatchListeners _dispatchInstances".split(" "),po={type:null,target:null,currentTarget:wn.thatReturnsNull,eventPhase:null,bubbles:null,cancelable:null,timeStamp:function(e){return e.timeStamp||Date.now()},defaultPrevented:null,isTrusted:null};xn(A.prototype,{preventDefault:function(){this.defaultPrevented=!0;var e=this.nativeEvent;e&&(e.preventDefault?e.preventDefault():"unknown"!=typeof e.returnValue&&(e.returnValue=!1),this.isDefaultPrevented=wn.thatReturnsTrue)},stopPropagation:function(){var e=this.nativeEvent;e&&(e.stopPropagation?e.stopPropagation():"unknown"!=typeof e.cancelBubble&&(e.cancelBubble=!0),this.isPropagationStopped=wn.thatReturnsTrue)},persist:function(){this.isPersistent=wn.thatReturnsTrue},isPersistent:wn.thatReturnsFalse,destructor:function(){var e,t=this.constructor.Interface;for(e in t)this[e]=null;for(t=0;t<fo.length;t++)this[fo[t]]=null}}),A.Interface=po,A.augmentClass=function(e,t){function n(){}n.prototype=this.prototype;var o=new n;xn(o,e.prototype),e.prototype=o,e.prototype.constructor=e,e.Interface=xn({},this.Interface,t),e.augmentClass=this.augmentClass,F(e)},F(A),A.augmentClass(z,{data:null}),A.augmentClass(W,{data:null});var bo=[9,13,27,32],ho=yn.canUseDOM&&"CompositionEvent"in window,mo=null;yn.canUseDOM&&"documentMode"in document&&(mo=document.documentMode);var go;if(go=yn.canUseDOM&&"TextEvent"in window&&!mo){var _o=window.opera;go=!("object"==typeof _o&&"function"==typeof _o.version&&12>=parseInt(_o.version(),10))}var vo,yo=go,xo=yn.canUseDOM&&(!ho||mo&&8<mo&&11>=mo),wo=String.fromCharCode(32),ko={beforeInput:{phasedRegistrationNames:{bubbled:"onBeforeInput",captured:"onBeforeInputCapture"},dependencies:["topCompositionEnd","topKeyPress","topTextInput","topPaste"]},compositionEnd:{phasedRegistrationNames:{bubbled:"onCompositionEnd",captured:"onCompositionEndCapture"},dependencies:"topBlur topCompositionEnd topKeyDown topKeyPress topKeyUp topMouseDown".split(" ")},compositionStart:{phasedRegistrationNames:{bubbled:"onCompositionStart",captured:"onCompositionStartCapture"},dependencies:"topBlur topCompositionStart topKeyDown topKeyPress topKeyUp topMouseDown".split(" ")},compositionUpdate:{phasedRegistrationNames:{bubbled:"onCompositionUpdate",captured:"onCompositionUpdateCapture"},dependencies:"topBlur topCompositionUpdate topKeyDown topKeyPress topKeyUp topMouseDown".split(" ")}},Eo=!1,Co=!1,Oo={eventTypes:ko,extractEvents:function(e,t,n,o){var r;if(ho)e:{switch(e){case"topCompositionStart":var l=ko.compositionStart;break e;case"topCompositionEnd":l=ko.compositionEnd;break e;case"topCompositionUpdate":l=ko.compositionUpdate;break e}l=void 0}else Co?X(e,n)&&(l=ko.compositionEnd):"topKeyDown"===e&&229===n.keyCode&&(l=ko.compositionStart);return l?(xo&&(Co||l!==ko.compositionStart?l===ko.compositionEnd&&Co&&(r=I()):(uo._root=o,uo._startText=D(),Co=!0)),l=z.getPooled(l,t,n,o),r?l.data=r:null!==(r=V(n))&&(l.data=r),H(l),r=l):r=null,(e=yo?K(e,n):G(e,n))?(t=W.getPooled(ko.beforeInput,t,n,o),t.data=e,H(t)):t=null,[r,t]}},Po=null,Mo=null,Lo=null,Ro={injectFiberControlledHostComponent:function(e){Po=e}},jo=Object.freeze({injection:Ro,enqueueStateRestore:Q,restoreStateIfNeeded:Z}),So=!1,To={color:!0,date:!0,datetime:!0,"datetime-local":!0,email:!0,month:!0,number:!0,password:!0,range:!0,search:!0,tel:!0,text:!0,time:!0,url:!0,week:!0};yn.canUseDOM&&(vo=document.implementation&&document.implementation.hasFeature&&!0!==document.implementation.hasFeature("",""));var Ho={change:{phasedRegistrationNames:{bubbled:"onChange",captured:"onChangeCapture"},dependencies:"topBlur topChange topClick topFocus topInput topKeyDown topKeyUp topSelectionChange".split(" ")}},Uo=null,No=null,Io=!1;yn.canUseDOM&&(Io=ne("input")&&(!document.documentMode||9<document.documentMode));var Do={eventTypes:Ho,_isInputEventSupported:Io,extractEvents:function(e,t,n,o){var r=t?C(t):window,l=r.nodeName&&r.nodeName.toLowerCase();if("select"===l||"input"===l&&"file"===r.type)var a=ue;else if(ee(r))if(Io)a=me;else{a=be;var i=pe}else!(l=r.nodeName)||"input"!==l.toLowerCase()||"checkbox"!==r.type&&"radio"!==r.type||(a=he);if(a&&(a=a(e,t)))return ie(a,n,o);i&&i(e,r,t),"topBlur"===e&&null!=t&&(e=t._wrapperState||r._wrapperState)&&e.controlled&&"number"===r.type&&(e=""+r.value,r.getAttribute("value")!==e&&r.setAttribute("value",e))}};A.augmentClass(ge,{view:null,detail:null});var Ao={Alt:"altKey",Control:"ctrlKey",Meta:"metaKey",Shift:"shiftKey"};ge.augmentClass(ye,{screenX:null,screenY:null,clientX:null,clientY:null,pageX:null,pageY:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,getModifierState:ve,button:null,buttons:null,relatedTarget:function(e){return e.relatedTarget||(e.fromElement===e.srcElement?e.toElement:e.fromElement)}});var Yo={mouseEnter:{registrationName:"onMouseEnter",dependencies:["topMouseOut","topMouseOver"]},mouseLeave:{registrationName:"onMouseLeave",dependencies:["topMouseOut","topMouseOver"]}},qo={eventTypes:Yo,extractEvents:function(e,t,n,o){if("topMouseOver"===e&&(n.relatedTarget||n.fromElement)||"topMouseOut"!==e&&"topMouseOver"!==e)return null;var r=o.window===o?o:(r=o.ownerDocument)?r.defaultView||r.parentWindow:window;if("topMouseOut"===e?(e=t,t=(t=n.relatedTarget||n.toElement)?E(t):null):e=null,e===t)return null;var l=null==e?r:C(e);r=null==t?r:C(t);var a=ye.getPooled(Yo.mouseLeave,e,n,o);return a.type="mouseleave",a.target=l,a.relatedTarget=r,n=ye.getPooled(Yo.mouseEnter,t,n,o),n.type="mouseenter",n.target=r,n.relatedTarget=l,U(a,n,e,t),[a,n]}},Fo=vn.__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED.ReactCurrentOwner,zo=[],Wo=!0,Xo=void 0,Vo=Object.freeze({get _enabled(){return Wo},get _handleTopLevel(){return Xo},setHandleTopLevel:function(e){Xo=e},setEnabled:Le,isEnabled:function(){return Wo},trapBubbledEvent:Re,trapCapturedEvent:je,dispatchEvent:Se}),Ko={animationend:Te("Animation","AnimationEnd"),animationiteration:Te("Animation","AnimationIteration"),animationstart:Te("Animation","AnimationStart"),transitionend:Te("Transition","TransitionEnd")},Go={},Bo={};yn.canUseDOM&&(Bo=document.createElement("div").style,"AnimationEvent"in window||(delete Ko.animationend.animation,delete Ko.animationiteration.animation,delete Ko.animationstart.animation),"TransitionEvent"in window||delete Ko.transitionend.transition);var Qo={topAbort:"abort",topAnimationEnd:He("animationend")||"animationend",topAnimationIteration:He("animationiteration")||"animationiteration",topAnimationStart:He("animationstart")||"animationstart",topBlur:"blur",topCancel:"cancel",topCanPlay:"canplay",topCanPlayThrough:"canplaythrough",topChange:"change",topClick:"click",topClose:"close",topCompositionEnd:"compositionend",topCompositionStart:"compositionstart",topCompositionUpdate:"compositionupdate",topContextMenu:"contextmenu",topCopy:"copy",topCut:"cut",topDoubleClick:"dblclick",topDrag:"drag",topDragEnd:"dragend",topDragEnter:"dragenter",topDragExit:"dragexit",topDragLeave:"dragleave",topDragOver:"dragover",topDragStart:"dragstart",topDrop:"drop",topDurationChange:"durationchange",topEmptied:"emptied",topEncrypted:"encrypted",topEnded:"ended",topError:"error",topFocus:"focus",topInput:"input",topKeyDown:"keydown",topKeyPress:"keypress",topKeyUp:"keyup",topLoadedData:"loadeddata",topLoad:"load",topLoadedMetadata:"loadedmetadata",topLoadStart:"loadstart",topMouseDown:"mousedown",topMouseMove:"mousemove",topMouseOut:"mouseout",topMouseOver:"mouseover",topMouseUp:"mouseup",topPaste:"paste",topPause:"pause",topPlay:"play",topPlaying:"playing",topProgress:"progress",topRateChange:"ratechange",topScroll:"scroll",topSeeked:"seeked",topSeeking:"seeking",topSelectionChange:"selectionchange",topStalled:"stalled",topSuspend:"suspend",topTextInput:"textInput",topTimeUpdate:"timeupdate",topToggle:"toggle",topTouchCancel:"touchcancel",topTouchEnd:"touchend",topTouchMove:"touchmove",topTouchStart:"touchstart",topTransitionEnd:He("transitionend")||"transitionend",topVolumeChange:"volumechange",topWaiting:"waiting",topWheel:"wheel"},Zo={},Jo=0,$o="_reactListenersID"+(""+Math.random()).slice(2),er=yn.canUseDOM&&"documentMode"in document&&11>=document.documentMode,tr={select:{phasedRegistrationNames:{bubbled:"onSelect",captured:"onSelectCapture"},dependencies:"topBlur topContextMenu topFocus topKeyDown topKeyUp topMouseDown topMouseUp topSelectionChange".split(" ")}},nr=null,or=null,rr=null,lr=!1,ar={eventTypes:tr,extractEvents:function(e,t,n,o){var r,l=o.window===o?o.document:9===o.nodeType?o:o.ownerDocument;if(!(r=!l)){e:{l=Ue(l),r=Qn.onSelect;for(var a=0;a<r.length;a++){var i=r[a];if(!l.hasOwnProperty(i)||!l[i]){l=!1;break e}}l=!0}r=!l}if(r)return null;switch(l=t?C(t):window,e){case"topFocus":(ee(l)||"true"===l.contentEditable)&&(nr=l,or=t,rr=null);break;case"topBlur":rr=or=nr=null;break;case"topMouseDown":lr=!0;break;case"topContextMenu":case"topMouseUp":return lr=!1,Ae(n,o);case"topSelectionChange":if(er)break;case"topKeyDown":case"topKeyUp":return Ae(n,o)}return null}};A.augmentClass(Ye,{animationName:null,elapsedTime:null,pseudoElement:null}),A.augmentClass(qe,{clipboardData:function(e){return"clipboardData"in e?e.clipboardData:window.clipboardData}}),ge.augmentClass(Fe,{relatedTarget:null});var ir={Esc:"Escape",Spacebar:" ",Left:"ArrowLeft",Up:"ArrowUp",Right:"ArrowRight",Down:"ArrowDown",Del:"Delete",Win:"OS",Menu:"ContextMenu",Apps:"ContextMenu",Scroll:"ScrollLock",MozPrintableKey:"Unidentified"},cr={8:"Backspace",9:"Tab",12:"Clear",13:"Enter",16:"Shift",17:"Control",18:"Alt",19:"Pause",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",45:"Insert",46:"Delete",112:"F1",113:"F2",114:"F3",115:"F4",116:"F5",117:"F6",118:"F7",119:"F8",120:"F9",121:"F10",122:"F11",123:"F12",144:"NumLock",145:"ScrollLock",224:"Meta"};ge.augmentClass(We,{key:function(e){if(e.key){var t=ir[e.key]||e.key;if("Unidentified"!==t)return t}return"keypress"===e.type?(e=ze(e),13===e?"Enter":String.fromCharCode(e)):"keydown"===e.type||"keyup"===e.type?cr[e.keyCode]||"Unidentified":""},location:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,repeat:null,locale:null,getModifierState:ve,charCode:function(e){return"keypress"===e.type?ze(e):0},keyCode:function(e){return"keydown"===e.type||"keyup"===e.type?e.keyCode:0},which:function(e){return"keypress"===e.type?ze(e):"keydown"===e.type||"keyup"===e.type?e.keyCode:0}}),ye.augmentClass(Xe,{dataTransfer:null}),ge.augmentClass(Ve,{touches:null,targetTouches:null,changedTouches:null,altKey:null,metaKey:null,ctrlKey:null,shiftKey:null,getModifierState:ve}),A.augmentClass(Ke,{propertyName:null,elapsedTime:null,pseudoElement:null}),ye.augmentClass(Ge,{deltaX:function(e){return"deltaX"in e?e.deltaX:"wheelDeltaX"in e?-e.wheelDeltaX:0},deltaY:function(e){return"deltaY"in e?e.deltaY:"wheelDeltaY"in e?-e.wheelDeltaY:"wheelDelta"in e?-e.wheelDelta:0},deltaZ:null,deltaMode:null});var sr={},ur={};"abort animationEnd animationIteration animationStart blur cancel canPlay canPlayThrough click close contextMenu copy cut doubleClick drag dragEnd dragEnter dragExit dragLeave dragOver dragStart drop durationChange emptied encrypted ended error focus input invalid keyDown keyPress keyUp load loadedData loadedMetadata loadStart mouseDown mouseMove mouseOut mouseOver mouseUp paste pause play playing progress rateChange reset scroll seeked seeking stalled submit suspend timeUpdate toggle touchCancel touchEnd touchMove touchStart transitionEnd volumeChange waiting wheel".split(" ").forEach(function(e){var t=e[0].toUpperCase()+e.slice(1),n="on"+t;t="top"+t,n={phasedRegistrationNames:{bubbled:n,captured:n+"Capture"},dependencies:[t]},sr[e]=n,ur[t]=n});var dr={eventTypes:sr,extractEvents:function(e,t,n,o){var r=ur[e];if(!r)return null;switch(e){case"topKeyPress":if(0===ze(n))return null;case"topKeyDown":case"topKeyUp":e=We;break;case"topBlur":case"topFocus":e=Fe;break;case"topClick":if(2===n.button)return null;case"topDoubleClick":case"topMouseDown":case"topMouseMove":case"topMouseUp":case"topMouseOut":case"topMouseOver":case"topContextMenu":e=ye;break;case"topDrag":case"topDragEnd":case"topDragEnter":case"topDragExit":case"topDragLeave":case"topDragOver":case"topDragStart":case"topDrop":e=Xe;break;case"topTouchCancel":case"topTouchEnd":case"topTouchMove":case"topTouchStart":e=Ve;break;case"topAnimationEnd":case"topAnimationIteration":case"topAnimationStart":e=Ye;break;case"topTransitionEnd":e=Ke;break;case"topScroll":e=ge;break;case"topWheel":e=Ge;break;case"topCopy":case"topCut":case"topPaste":e=qe;break;default:e=A}return t=e.getPooled(r,t,n,o),H(t),t}};Xo=function(e,t,n,o){e=x(e,t,n,o),w(e),k(!1)},no.injectEventPluginOrder("ResponderEventPlugin SimpleEventPlugin TapEventPlugin EnterLeaveEventPlugin ChangeEventPlugin SelectEventPlugin BeforeInputEventPlugin".split(" ")),Jn=io.getFiberCurrentPropsFromNode,$n=io.getInstanceFromNode,eo=io.getNodeFromInstance,no.injectEventPluginsByName({SimpleEventPlugin:dr,EnterLeaveEventPlugin:qo,ChangeEventPlugin:Do,SelectEventPlugin:ar,BeforeInputEventPlugin:Oo});var fr=[],pr=-1;new Set;var br={current:Mn},hr={current:!1},mr=Mn,gr=null,_r=null,vr="function"==typeof Symbol&&Symbol.for,yr=vr?Symbol.for("react.element"):60103,xr=vr?Symbol.for("react.call"):60104,wr=vr?Symbol.for("react.return"):60105,kr=vr?Symbol.for("react.portal"):60106,Er=vr?Symbol.for("react.fragment"):60107,Cr="function"==typeof Symbol&&Symbol.iterator,Or=Array.isArray,Pr=Pt(!0),Mr=Pt(!1),Lr={},Rr=Object.freeze({default:Ht}),jr=Rr&&Ht||Rr,Sr=jr.default?jr.default:jr,Tr="object"==typeof performance&&"function"==typeof performance.now,Hr=void 0;Hr=Tr?function(){return performance.now()}:function(){return Date.now()};var Ur=void 0,Nr=void 0;if(yn.canUseDOM)if("function"!=typeof requestIdleCallback||"function"!=typeof cancelIdleCallback){var Ir,Dr=null,Ar=!1,Yr=-1,qr=!1,Fr=0,zr=33,Wr=33;Ir=Tr?{didTimeout:!1,timeRemaining:function(){var e=Fr-performance.now();return 0<e?e:0}}:{didTimeout:!1,timeRemaining:function(){var e=Fr-Date.now();return 0<e?e:0}};var Xr="__reactIdleCallback$"+Math.random().toString(36).slice(2);window.addEventListener("message",function(e){if(e.source===window&&e.data===Xr){if(Ar=!1,e=Hr(),0>=Fr-e){if(!(-1!==Yr&&Yr<=e))return void(qr||(qr=!0,requestAnimationFrame(Vr)));Ir.didTimeout=!0}else Ir.didTimeout=!1;Yr=-1,e=Dr,Dr=null,null!==e&&e(Ir)}},!1);var Vr=function(e){qr=!1;var t=e-Fr+Wr;t<Wr&&zr<Wr?(8>t&&(t=8),Wr=t<zr?zr:t):zr=t,Fr=e+Wr,Ar||(Ar=!0,window.postMessage(Xr,"*"))};Ur=function(e,t){return Dr=e,null!=t&&"number"==typeof t.timeout&&(Yr=Hr()+t.timeout),qr||(qr=!0,requestAnimationFrame(Vr)),0},Nr=function(){Dr=null,Ar=!1,Yr=-1}}else Ur=window.requestIdleCallback,Nr=window.cancelIdleCallback;else Ur=function(e){return setTimeout(function(){e({timeRemaining:function(){return 1/0}})})},Nr=function(e){clearTimeout(e)};var Kr=/^[:A-Z_a-z\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u02FF\u0370-\u037D\u037F-\u1FFF\u200C-\u200D\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD][:A-Z_a-z\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u02FF\u0370-\u037D\u037F-\u1FFF\u200C-\u200D\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD\-.0-9\u00B7\u0300-\u036F\u203F-\u2040]*$/,Gr={},Br={},Qr={html:"http://www.w3.org/1999/xhtml",mathml:"http://www.w3.org/1998/Math/MathML",svg:"http://www.w3.org/2000/svg"},Zr=void 0,Jr=function(e){return"undefined"!=typeof MSApp&&MSApp.execUnsafeLocalFunction?function(t,n,o,r){MSApp.execUnsafeLocalFunction(function(){return e(t,n)})}:e}(function(e,t){if(e.namespaceURI!==Qr.svg||"innerHTML"in e)e.innerHTML=t;else{for(Zr=Zr||document.createElement("div"),Zr.innerHTML="<svg>"+t+"</svg>",t=Zr.firstChild;e.firstChild;)e.removeChild(e.firstChild);for(;t.firstChild;)e.appendChild(t.firstChild)}}),$r={animationIterationCount:!0,borderImageOutset:!0,borderImageSlice:!0,borderImageWidth:!0,boxFlex:!0,boxFlexGroup:!0,boxOrdinalGroup:!0,columnCount:!0,columns:!0,flex:!0,flexGrow:!0,flexPositive:!0,flexShrink:!0,flexNegative:!0,flexOrder:!0,gridRow:!0,gridRowEnd:!0,gridRowSpan:!0,gridRowStart:!0,gridColumn:!0,gridColumnEnd:!0,gridColumnSpan:!0,gridColumnStart:!0,fontWeight:!0,lineClamp:!0,lineHeight:!0,opacity:!0,order:!0,orphans:!0,tabSize:!0,widows:!0,zIndex:!0,zoom:!0,fillOpacity:!0,floodOpacity:!0,stopOpacity:!0,strokeDasharray:!0,strokeDashoffset:!0,strokeMiterlimit:!0,strokeOpacity:!0,strokeWidth:!0},el=["Webkit","ms","Moz","O"];Object.keys($r).forEach(function(e){el.forEach(function(t){t=t+e.charAt(0).toUpperCase()+e.substring(1),$r[t]=$r[e]})});var tl=xn({menuitem:!0},{area:!0,base:!0,br:!0,col:!0,embed:!0,hr:!0,img:!0,input:!0,keygen:!0,link:!0,meta:!0,param:!0,source:!0,track:!0,wbr:!0}),nl=Qr.html,ol=wn.thatReturns(""),rl={topAbort:"abort",topCanPlay:"canplay",topCanPlayThrough:"canplaythrough",topDurationChange:"durationchange",topEmptied:"emptied",topEncrypted:"encrypted",topEnded:"ended",topError:"error",topLoadedData:"loadeddata",topLoadedMetadata:"loadedmetadata",topLoadStart:"loadstart",topPause:"pause",topPlay:"play",topPlaying:"playing",topProgress:"progress",topRateChange:"ratechange",topSeeked:"seeked",topSeeking:"seeking",topStalled:"stalled",topSuspend:"suspend",topTimeUpdate:"timeupdate",topVolumeChange:"volumechange",topWaiting:"waiting"},ll=Object.freeze({createElement:an,createTextNode:cn,setInitialProperties:sn,diffProperties:un,updateProperties:dn,diffHydratedProperties:fn,diffHydratedText:pn,warnForUnmatchedText:function(){},warnForDeletedHydratableElement:function(){},warnForDeletedHydratableText:function(){},warnForInsertedHydratedElement:function(){},warnForInsertedHydratedText:function(){},restoreControlledState:function(e,t,n){switch(t){case"input":if(zt(e,n),t=n.name,"radio"===n.type&&null!=t){for(n=e;n.parentNode;)n=n.parentNode;for(n=n.querySelectorAll("input[name="+JSON.stringify(""+t)+'][type="radio"]'),t=0;t<n.length;t++){var r=n[t];if(r!==e&&r.form===e.form){var l=O(r);l||o("90"),ae(r),zt(r,l)}}}break;case"textarea":Zt(e,n);break;case"select":null!=(t=n.value)&&Kt(e,!!n.multiple,t,!1)}}});Ro.injectFiberControlledHostComponent(ll);var al=null,il=null,cl=Sr({getRootHostContext:function(e){var t=e.nodeType;switch(t){case 9:case 11:e=(e=e.documentElement)?e.namespaceURI:en(null,"");break;default:t=8===t?e.parentNode:e,e=t.namespaceURI||null,t=t.tagName,e=en(e,t)}return e},getChildHostContext:function(e,t){return en(e,t)},getPublicInstance:function(e){return e},prepareForCommit:function(){al=Wo;var e=En();if(De(e)){if("selectionStart"in e)var t={start:e.selectionStart,end:e.selectionEnd};else e:{var n=window.getSelection&&window.getSelection();if(n&&0!==n.rangeCount){t=n.anchorNode;var o=n.anchorOffset,r=n.focusNode;n=n.focusOffset;try{t.nodeType,r.nodeType}catch(e){t=null;break e}var l=0,a=-1,i=-1,c=0,s=0,u=e,d=null;t:for(;;){for(var f;u!==t||0!==o&&3!==u.nodeType||(a=l+o),u!==r||0!==n&&3!==u.nodeType||(i=l+n),3===u.nodeType&&(l+=u.nodeValue.length),null!==(f=u.firstChild);)d=u,u=f;for(;;){if(u===e)break t;if(d===t&&++c===o&&(a=l),d===r&&++s===n&&(i=l),null!==(f=u.nextSibling))break;u=d,d=u.parentNode}u=f}t=-1===a||-1===i?null:{start:a,end:i}}else t=null}t=t||{start:0,end:0}}else t=null;il={focusedElem:e,selectionRange:t},Le(!1)},resetAfterCommit:function(){var e=il,t=En(),n=e.focusedElem,o=e.selectionRange;if(t!==n&&On(document.documentElement,n)){if(De(n))if(t=o.start,e=o.end,void 0===e&&(e=t),"selectionStart"in n)n.selectionStart=t,n.selectionEnd=Math.min(e,n.value.length);else if(window.getSelection){t=window.getSelection();var r=n[N()].length;e=Math.min(o.start,r),o=void 0===o.end?e:Math.min(o.end,r),!t.extend&&e>o&&(r=o,o=e,e=r),r=Ie(n,e);var l=Ie(n,o);if(r&&l&&(1!==t.rangeCount||t.anchorNode!==r.node||t.anchorOffset!==r.offset||t.focusNode!==l.node||t.focusOffset!==l.offset)){var a=document.createRange();a.setStart(r.node,r.offset),t.removeAllRanges(),e>o?(t.addRange(a),t.extend(l.node,l.offset)):(a.setEnd(l.node,l.offset),t.addRange(a))}}for(t=[],e=n;e=e.parentNode;)1===e.nodeType&&t.push({element:e,left:e.scrollLeft,top:e.scrollTop});for(Pn(n),n=0;n<t.length;n++)e=t[n],e.element.scrollLeft=e.left,e.element.scrollTop=e.top}il=null,Le(al),al=null},createInstance:function(e,t,n,o,r){return e=an(e,t,n,o),e[lo]=r,e[ao]=t,e},appendInitialChild:function(e,t){e.appendChild(t)},finalizeInitialChildren:function(e,t,n,o){sn(e,t,n,o);e:{switch(t){case"button":case"input":case"select":case"textarea":e=!!n.autoFocus;break e}e=!1}return e},prepareUpdate:function(e,t,n,o,r){return un(e,t,n,o,r)},shouldSetTextContent:function(e,t){return"textarea"===e||"string"==typeof t.children||"number"==typeof t.children||"object"==typeof t.dangerouslySetInnerHTML&&null!==t.dangerouslySetInnerHTML&&"string"==typeof t.dangerouslySetInnerHTML.__html},shouldDeprioritizeSubtree:function(e,t){return!!t.hidden},createTextInstance:function(e,t,n,o){return e=cn(e,t),e[lo]=o,e},now:Hr,mutation:{commitMount:function(e){e.focus()},commitUpdate:function(e,t,n,o,r){e[ao]=r,dn(e,t,n,o,r)},resetTextContent:function(e){e.textContent=""},commitTextUpdate:function(e,t,n){e.nodeValue=n},appendChild:function(e,t){e.appendChild(t)},appendChildToContainer:function(e,t){8===e.nodeType?e.parentNode.insertBefore(t,e):e.appendChild(t)},insertBefore:function(e,t,n){e.insertBefore(t,n)},insertInContainerBefore:function(e,t,n){8===e.nodeType?e.parentNode.insertBefore(t,n):e.insertBefore(t,n)},removeChild:function(e,t){e.removeChild(t)},removeChildFromContainer:function(e,t){8===e.nodeType?e.parentNode.removeChild(t):e.removeChild(t)}},hydration:{canHydrateInstance:function(e,t){return 1!==e.nodeType||t.toLowerCase()!==e.nodeName.toLowerCase()?null:e},canHydrateTextInstance:function(e,t){return""===t||3!==e.nodeType?null:e},getNextHydratableSibling:function(e){for(e=e.nextSibling;e&&1!==e.nodeType&&3!==e.nodeType;)e=e.nextSibling;return e},getFirstHydratableChild:function(e){for(e=e.firstChild;e&&1!==e.nodeType&&3!==e.nodeType;)e=e.nextSibling;return e},hydrateInstance:function(e,t,n,o,r,l){return e[lo]=l,e[ao]=n,fn(e,t,n,r,o)},hydrateTextInstance:function(e,t,n){return e[lo]=n,pn(e,t)},didNotMatchHydratedContainerTextInstance:function(){},didNotMatchHydratedTextInstance:function(){},didNotHydrateContainerInstance:function(){},didNotHydrateInstance:function(){},didNotFindHydratableContainerInstance:function(){},didNotFindHydratableContainerTextInstance:function(){},didNotFindHydratableInstance:function(){},didNotFindHydratableTextInstance:function(){}},scheduleDeferredCallback:Ur,cancelDeferredCallback:Nr,useSyncScheduling:!0});J=cl.batchedUpdates,_n.prototype.render=function(e,t){cl.updateContainer(e,this._reactRootContainer,null,t)},_n.prototype.unmount=function(e){cl.updateContainer(null,this._reactRootContainer,null,e)};var sl={createPortal:gn,findDOMNode:function(e){if(null==e)return null;if(1===e.nodeType)return e;var t=e._reactInternalFiber;if(t)return cl.findHostInstance(t);"function"==typeof e.render?o("188"):o("213",Object.keys(e))},hydrate:function(e,t,n){return mn(null,e,t,!0,n)},render:function(e,t,n){return mn(null,e,t,!1,n)},unstable_renderSubtreeIntoContainer:function(e,t,n,r){return(null==e||void 0===e._reactInternalFiber)&&o("38"),mn(e,t,n,!1,r)},unmountComponentAtNode:function(e){return bn(e)||o("40"),!!e._reactRootContainer&&(cl.unbatchedUpdates(function(){mn(null,null,e,!1,function(){e._reactRootContainer=null})}),!0)},unstable_createPortal:gn,unstable_batchedUpdates:$,unstable_deferredUpdates:cl.deferredUpdates,flushSync:cl.flushSync,__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED:{EventPluginHub:oo,EventPluginRegistry:Zn,EventPropagators:co,ReactControlledComponent:jo,ReactDOMComponentTree:io,ReactDOMEventListener:Vo}};cl.injectIntoDevTools({findFiberByHostInstance:E,bundleType:0,version:"16.2.0",rendererPackageName:"react-dom"});var ul=Object.freeze({default:sl}),dl=ul&&sl||ul;e.exports=dl.default?dl.default:dl},function(e,t,n){"use strict";var o=!("undefined"==typeof window||!window.document||!window.document.createElement),r={canUseDOM:o,canUseWorkers:"undefined"!=typeof Worker,canUseEventListeners:o&&!(!window.addEventListener&&!window.attachEvent),canUseViewport:o&&!!window.screen,isInWorker:!o};e.exports=r},function(e,t,n){"use strict";var o=n(14),r={listen:function(e,t,n){return e.addEventListener?(e.addEventListener(t,n,!1),{remove:function(){e.removeEventListener(t,n,!1)}}):e.attachEvent?(e.attachEvent("on"+t,n),{remove:function(){e.detachEvent("on"+t,n)}}):void 0},capture:function(e,t,n){return e.addEventListener?(e.addEventListener(t,n,!0),{remove:function(){e.removeEventListener(t,n,!0)}}):{remove:o}},registerDefault:function(){}};e.exports=r},function(e,t,n){"use strict";function o(e){if(void 0===(e=e||("undefined"!=typeof document?document:void 0)))return null;try{return e.activeElement||e.body}catch(t){return e.body}}e.exports=o},function(e,t,n){"use strict";function o(e,t){return e===t?0!==e||0!==t||1/e==1/t:e!==e&&t!==t}function r(e,t){if(o(e,t))return!0;if("object"!=typeof e||null===e||"object"!=typeof t||null===t)return!1;var n=Object.keys(e),r=Object.keys(t);if(n.length!==r.length)return!1;for(var a=0;a<n.length;a++)if(!l.call(t,n[a])||!o(e[n[a]],t[n[a]]))return!1;return!0}var l=Object.prototype.hasOwnProperty;e.exports=r},function(e,t,n){"use strict";function o(e,t){return!(!e||!t)&&(e===t||!r(e)&&(r(t)?o(e,t.parentNode):"contains"in e?e.contains(t):!!e.compareDocumentPosition&&!!(16&e.compareDocumentPosition(t))))}var r=n(53);e.exports=o},function(e,t,n){"use strict";function o(e){return r(e)&&3==e.nodeType}var r=n(54);e.exports=o},function(e,t,n){"use strict";function o(e){var t=e?e.ownerDocument||e:document,n=t.defaultView||window;return!(!e||!("function"==typeof n.Node?e instanceof n.Node:"object"==typeof e&&"number"==typeof e.nodeType&&"string"==typeof e.nodeName))}e.exports=o},function(e,t,n){"use strict";function o(e){try{e.focus()}catch(e){}}e.exports=o},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(15),p=o(f),b=n(57),h=o(b),m=n(7),g=o(m),_=n(8),v=o(_),y=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){return s.default.createElement("div",{className:g.default.tableResponsive},s.default.createElement("table",{className:g.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("th",null,"Name"),s.default.createElement("th",null,"Arguments"),s.default.createElement("th",null,"Started At"),s.default.createElement("th",null,"Check-in At"),s.default.createElement("th",null,"Check-in")),this.props.worker.map(function(e){return s.default.createElement("tr",{key:e.worker_id},s.default.createElement("td",null,e.job_name),s.default.createElement("td",null,e.args_json),s.default.createElement("td",null,s.default.createElement(p.default,{ts:e.started_at})),s.default.createElement("td",null,s.default.createElement(p.default,{ts:e.checkin_at})),s.default.createElement("td",null,e.checkin))}))))}}]),t}(s.default.Component);y.propTypes={worker:d.default.arrayOf(d.default.object).isRequired};var x=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,c=Array(i),s=0;s<i;s++)c[s]=arguments[s];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(c))),o.state={busyWorker:[],workerPool:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"componentWillMount",value:function(){var e=this;this.props.busyWorkerURL&&fetch(this.props.busyWorkerURL).then(function(e){return e.json()}).then(function(t){t&&e.setState({busyWorker:t})}),this.props.workerPoolURL&&fetch(this.props.workerPoolURL).then(function(e){return e.json()}).then(function(t){var n=[];t.map(function(e){""!=e.host&&n.push(e)}),e.setState({workerPool:n})})}},{key:"getBusyPoolWorker",value:function(e){var t=[];return this.state.busyWorker.map(function(n){e.worker_ids.includes(n.worker_id)&&t.push(n)}),t}},{key:"render",value:function(){var e=this;return s.default.createElement("section",null,s.default.createElement("header",null,"Processes"),s.default.createElement("p",null,this.state.workerPool.length," Worker process(es). ",this.state.busyWorker.length," active worker(s) out of ",this.workerCount,"."),this.state.workerPool.map(function(t){var n=e.getBusyPoolWorker(t);return s.default.createElement("div",{key:t.worker_pool_id,className:(0,v.default)(g.default.panel,g.default.panelDefault)},s.default.createElement("div",{className:g.default.tableResponsive},s.default.createElement("table",{className:g.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("td",null,t.host,": ",t.pid),s.default.createElement("td",null,"Started ",s.default.createElement(p.default,{ts:t.started_at})),s.default.createElement("td",null,"Last Heartbeat ",s.default.createElement(p.default,{ts:t.heartbeat_at})),s.default.createElement("td",null,"Concurrency ",t.concurrency)),s.default.createElement("tr",null,s.default.createElement("td",{colSpan:"4"},"Servicing ",s.default.createElement(h.default,{item:t.job_names}),".")),s.default.createElement("tr",null,s.default.createElement("td",{colSpan:"4"},n.length," active worker(s) and ",t.worker_ids.length-n.length," idle.")),s.default.createElement("tr",null,s.default.createElement("td",{colSpan:"4"},s.default.createElement("div",{className:(0,v.default)(g.default.panel,g.default.panelDefault)},s.default.createElement(y,{worker:n}))))))))}))}},{key:"workerCount",get:function(){var e=0;return this.state.workerPool.map(function(t){e+=t.worker_ids.length}),e}}]),t}(s.default.Component);x.propTypes={busyWorkerURL:d.default.string,workerPoolURL:d.default.string},t.default=x},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(58),p=o(f),b=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){var e=this;return s.default.createElement("ul",{className:p.default.ul},this.props.item.map(function(t,n){return n<3?s.default.createElement("li",{key:n,className:p.default.li},t):3==n?s.default.createElement("li",{key:n,className:p.default.li},e.props.item.length-3," more"):void 0}))}}]),t}(s.default.Component);b.propTypes={item:d.default.arrayOf(d.default.string).isRequired},t.default=b},function(e,t,n){var o=n(59);"string"==typeof o&&(o=[[e.i,o,""]]);var r={hmr:!0};r.transform=void 0;n(30)(o,r);o.locals&&(e.exports=o.locals)},function(e,t,n){t=e.exports=n(29)(void 0),t.push([e.i,'._223iE-ul {\n  display: inline;\n  list-style: none;\n  padding: 0;\n}\n\n._14w3w-li {\n  display: inline;\n}\n\n._14w3w-li:after {\n  content: ", ";\n}\n\n._14w3w-li:last-child:before {\n  content: "and "\n}\n\n._14w3w-li:last-child:after {\n  content: "";\n}\n',""]),t.locals={ul:"_223iE-ul",li:"_14w3w-li"}},function(e,t){e.exports=function(e){var t="undefined"!=typeof window&&window.location;if(!t)throw new Error("fixUrls requires window.location");if(!e||"string"!=typeof e)return e;var n=t.protocol+"//"+t.host,o=n+t.pathname.replace(/\/[^\/]*$/,"/");return e.replace(/url\s*\(((?:[^)(]|\((?:[^)(]+|\([^)(]*\))*\))*)\)/gi,function(e,t){var r=t.trim().replace(/^"(.*)"$/,function(e,t){return t}).replace(/^'(.*)'$/,function(e,t){return t});if(/^(#|data:|http:\/\/|https:\/\/|file:\/\/\/)/i.test(r))return e;var l;return l=0===r.indexOf("//")?r:0===r.indexOf("/")?n+r:o+r.replace(/^\.\//,""),"url("+JSON.stringify(l)+")"})}},function(e,t,n){t=e.exports=n(29)(void 0),t.push([e.i,'/*!\n * Bootstrap v3.3.5 (http://getbootstrap.com)\n * Copyright 2011-2016 Twitter, Inc.\n * Licensed under MIT (https://github.com/twbs/bootstrap/blob/master/LICENSE)\n */\n\n/*!\n * Generated using the Bootstrap Customizer (http://getbootstrap.com/customize/?id=da9e4e258238275a8c6ec9d23bbb2e92)\n * Config saved to config.json and https://gist.github.com/da9e4e258238275a8c6ec9d23bbb2e92\n *//*!\n * Bootstrap v3.3.6 (http://getbootstrap.com)\n * Copyright 2011-2015 Twitter, Inc.\n * Licensed under MIT (https://github.com/twbs/bootstrap/blob/master/LICENSE)\n *//*! normalize.css v3.0.3 | MIT License | github.com/necolas/normalize.css */html{font-family:sans-serif;-ms-text-size-adjust:100%;-webkit-text-size-adjust:100%}body{margin:0}article,aside,details,figcaption,figure,footer,header,hgroup,main,menu,nav,section,summary{display:block}audio,canvas,progress,video{display:inline-block;vertical-align:baseline}audio:not([controls]){display:none;height:0}[hidden],template{display:none}a{background-color:transparent}a:active,a:hover{outline:0}abbr[title]{border-bottom:1px dotted}b,strong{font-weight:bold}dfn{font-style:italic}h1{font-size:2em;margin:0.67em 0}mark{background:#ff0;color:#000}small{font-size:80%}sub,sup{font-size:75%;line-height:0;position:relative;vertical-align:baseline}sup{top:-0.5em}sub{bottom:-0.25em}img{border:0}svg:not(:root){overflow:hidden}figure{margin:1em 40px}hr{-webkit-box-sizing:content-box;-moz-box-sizing:content-box;box-sizing:content-box;height:0}pre{overflow:auto}code,kbd,pre,samp{font-family:monospace, monospace;font-size:1em}button,input,optgroup,select,textarea{color:inherit;font:inherit;margin:0}button{overflow:visible}button,select{text-transform:none}button,html input[type="button"],input[type="reset"],input[type="submit"]{-webkit-appearance:button;cursor:pointer}button[disabled],html input[disabled]{cursor:default}button::-moz-focus-inner,input::-moz-focus-inner{border:0;padding:0}input{line-height:normal}input[type="checkbox"],input[type="radio"]{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box;padding:0}input[type="number"]::-webkit-inner-spin-button,input[type="number"]::-webkit-outer-spin-button{height:auto}input[type="search"]{-webkit-appearance:textfield;-webkit-box-sizing:content-box;-moz-box-sizing:content-box;box-sizing:content-box}input[type="search"]::-webkit-search-cancel-button,input[type="search"]::-webkit-search-decoration{-webkit-appearance:none}fieldset{border:1px solid #c0c0c0;margin:0 2px;padding:0.35em 0.625em 0.75em}legend{border:0;padding:0}textarea{overflow:auto}optgroup{font-weight:bold}table{border-collapse:collapse;border-spacing:0}td,th{padding:0}/*! Source: https://github.com/h5bp/html5-boilerplate/blob/master/src/css/main.css */@media print{*,*:before,*:after{background:transparent !important;color:#000 !important;-webkit-box-shadow:none !important;box-shadow:none !important;text-shadow:none !important}a,a:visited{text-decoration:underline}a[href]:after{content:" (" attr(href) ")"}abbr[title]:after{content:" (" attr(title) ")"}a[href^="#"]:after,a[href^="javascript:"]:after{content:""}pre,blockquote{border:1px solid #999;page-break-inside:avoid}thead{display:table-header-group}tr,img{page-break-inside:avoid}img{max-width:100% !important}p,h2,h3{orphans:3;widows:3}h2,h3{page-break-after:avoid}.xz-aB-navbar{display:none}._3-XUl-btn>.WfXRY-caret,.xQM4X-dropup>._3-XUl-btn>.WfXRY-caret{border-top-color:#000 !important}._3gkGS-label{border:1px solid #000}.fHH5l-table{border-collapse:collapse !important}.fHH5l-table td,.fHH5l-table th{background-color:#fff !important}._3qUkR-table-bordered th,._3qUkR-table-bordered td{border:1px solid #ddd !important}}*{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box}*:before,*:after{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box}html{font-size:10px;-webkit-tap-highlight-color:rgba(0,0,0,0)}body{font-family:"Helvetica Neue",Helvetica,Arial,sans-serif;font-size:14px;line-height:1.42857143;color:#333;background-color:#fff}input,button,select,textarea{font-family:inherit;font-size:inherit;line-height:inherit}a{color:#337ab7;text-decoration:none}a:hover,a:focus{color:#23527c;text-decoration:underline}a:focus{outline:thin dotted;outline:5px auto -webkit-focus-ring-color;outline-offset:-2px}figure{margin:0}img{vertical-align:middle}._2zRts-img-responsive{display:block;max-width:100%;height:auto}._141wW-img-rounded{border-radius:6px}._3mPVB-img-thumbnail{padding:4px;line-height:1.42857143;background-color:#fff;border:1px solid #ddd;border-radius:4px;-webkit-transition:all .2s ease-in-out;-o-transition:all .2s ease-in-out;transition:all .2s ease-in-out;display:inline-block;max-width:100%;height:auto}._1pGGm-img-circle{border-radius:50%}hr{margin-top:20px;margin-bottom:20px;border:0;border-top:1px solid #eee}._2pd-Z-sr-only{position:absolute;width:1px;height:1px;margin:-1px;padding:0;overflow:hidden;clip:rect(0, 0, 0, 0);border:0}._2oRwu-sr-only-focusable:active,._2oRwu-sr-only-focusable:focus{position:static;width:auto;height:auto;margin:0;overflow:visible;clip:auto}[role="button"]{cursor:pointer}h1,h2,h3,h4,h5,h6,.FcDB9-h1,._2RtE8-h2,._2Hz9Y-h3,._20UE0-h4,._3ig4X-h5,._1IWbi-h6{font-family:inherit;font-weight:500;line-height:1.1;color:inherit}h1 small,h2 small,h3 small,h4 small,h5 small,h6 small,.FcDB9-h1 small,._2RtE8-h2 small,._2Hz9Y-h3 small,._20UE0-h4 small,._3ig4X-h5 small,._1IWbi-h6 small,h1 ._1xqEr-small,h2 ._1xqEr-small,h3 ._1xqEr-small,h4 ._1xqEr-small,h5 ._1xqEr-small,h6 ._1xqEr-small,.FcDB9-h1 ._1xqEr-small,._2RtE8-h2 ._1xqEr-small,._2Hz9Y-h3 ._1xqEr-small,._20UE0-h4 ._1xqEr-small,._3ig4X-h5 ._1xqEr-small,._1IWbi-h6 ._1xqEr-small{font-weight:normal;line-height:1;color:#777}h1,.FcDB9-h1,h2,._2RtE8-h2,h3,._2Hz9Y-h3{margin-top:20px;margin-bottom:10px}h1 small,.FcDB9-h1 small,h2 small,._2RtE8-h2 small,h3 small,._2Hz9Y-h3 small,h1 ._1xqEr-small,.FcDB9-h1 ._1xqEr-small,h2 ._1xqEr-small,._2RtE8-h2 ._1xqEr-small,h3 ._1xqEr-small,._2Hz9Y-h3 ._1xqEr-small{font-size:65%}h4,._20UE0-h4,h5,._3ig4X-h5,h6,._1IWbi-h6{margin-top:10px;margin-bottom:10px}h4 small,._20UE0-h4 small,h5 small,._3ig4X-h5 small,h6 small,._1IWbi-h6 small,h4 ._1xqEr-small,._20UE0-h4 ._1xqEr-small,h5 ._1xqEr-small,._3ig4X-h5 ._1xqEr-small,h6 ._1xqEr-small,._1IWbi-h6 ._1xqEr-small{font-size:75%}h1,.FcDB9-h1{font-size:36px}h2,._2RtE8-h2{font-size:30px}h3,._2Hz9Y-h3{font-size:24px}h4,._20UE0-h4{font-size:18px}h5,._3ig4X-h5{font-size:14px}h6,._1IWbi-h6{font-size:12px}p{margin:0 0 10px}.BToLm-lead{margin-bottom:20px;font-size:16px;font-weight:300;line-height:1.4}@media (min-width:768px){.BToLm-lead{font-size:21px}}small,._1xqEr-small{font-size:85%}mark,._1CUfl-mark{background-color:#fcf8e3;padding:.2em}._1dEhG-text-left{text-align:left}._13-RL-text-right{text-align:right}._12Qkk-text-center{text-align:center}._2IBJo-text-justify{text-align:justify}._2D83n-text-nowrap{white-space:nowrap}._3pILW-text-lowercase{text-transform:lowercase}.EHGdF-text-uppercase{text-transform:uppercase}.NKPoz-text-capitalize{text-transform:capitalize}.TLMiz-text-muted{color:#777}.m-Vt1-text-primary{color:#337ab7}a.m-Vt1-text-primary:hover,a.m-Vt1-text-primary:focus{color:#286090}._1gJQD-text-success{color:#3c763d}a._1gJQD-text-success:hover,a._1gJQD-text-success:focus{color:#2b542c}._3nKGJ-text-info{color:#31708f}a._3nKGJ-text-info:hover,a._3nKGJ-text-info:focus{color:#245269}._1L8Pc-text-warning{color:#8a6d3b}a._1L8Pc-text-warning:hover,a._1L8Pc-text-warning:focus{color:#66512c}._2Qeqx-text-danger{color:#a94442}a._2Qeqx-text-danger:hover,a._2Qeqx-text-danger:focus{color:#843534}.VG86S-bg-primary{color:#fff;background-color:#337ab7}a.VG86S-bg-primary:hover,a.VG86S-bg-primary:focus{background-color:#286090}.Fh09a-bg-success{background-color:#dff0d8}a.Fh09a-bg-success:hover,a.Fh09a-bg-success:focus{background-color:#c1e2b3}._3D-5o-bg-info{background-color:#d9edf7}a._3D-5o-bg-info:hover,a._3D-5o-bg-info:focus{background-color:#afd9ee}._23YNn-bg-warning{background-color:#fcf8e3}a._23YNn-bg-warning:hover,a._23YNn-bg-warning:focus{background-color:#f7ecb5}._1g67A-bg-danger{background-color:#f2dede}a._1g67A-bg-danger:hover,a._1g67A-bg-danger:focus{background-color:#e4b9b9}._3ayLh-page-header{padding-bottom:9px;margin:40px 0 20px;border-bottom:1px solid #eee}ul,ol{margin-top:0;margin-bottom:10px}ul ul,ol ul,ul ol,ol ol{margin-bottom:0}._2tb6R-list-unstyled{padding-left:0;list-style:none}._2aPiu-list-inline{padding-left:0;list-style:none;margin-left:-5px}._2aPiu-list-inline>li{display:inline-block;padding-left:5px;padding-right:5px}dl{margin-top:0;margin-bottom:20px}dt,dd{line-height:1.42857143}dt{font-weight:bold}dd{margin-left:0}@media (min-width:768px){._2DZom-dl-horizontal dt{float:left;width:160px;clear:left;text-align:right;overflow:hidden;text-overflow:ellipsis;white-space:nowrap}._2DZom-dl-horizontal dd{margin-left:180px}}abbr[title],abbr[data-original-title]{cursor:help;border-bottom:1px dotted #777}._39JkD-initialism{font-size:90%;text-transform:uppercase}blockquote{padding:10px 20px;margin:0 0 20px;font-size:17.5px;border-left:5px solid #eee}blockquote p:last-child,blockquote ul:last-child,blockquote ol:last-child{margin-bottom:0}blockquote footer,blockquote small,blockquote ._1xqEr-small{display:block;font-size:80%;line-height:1.42857143;color:#777}blockquote footer:before,blockquote small:before,blockquote ._1xqEr-small:before{content:\'\\2014   \\A0\'}._3OkIL-blockquote-reverse,blockquote._1DwIR-pull-right{padding-right:15px;padding-left:0;border-right:5px solid #eee;border-left:0;text-align:right}._3OkIL-blockquote-reverse footer:before,blockquote._1DwIR-pull-right footer:before,._3OkIL-blockquote-reverse small:before,blockquote._1DwIR-pull-right small:before,._3OkIL-blockquote-reverse ._1xqEr-small:before,blockquote._1DwIR-pull-right ._1xqEr-small:before{content:\'\'}._3OkIL-blockquote-reverse footer:after,blockquote._1DwIR-pull-right footer:after,._3OkIL-blockquote-reverse small:after,blockquote._1DwIR-pull-right small:after,._3OkIL-blockquote-reverse ._1xqEr-small:after,blockquote._1DwIR-pull-right ._1xqEr-small:after{content:\'\\A0   \\2014\'}address{margin-bottom:20px;font-style:normal;line-height:1.42857143}.GfVfY-container{margin-right:auto;margin-left:auto;padding-left:15px;padding-right:15px}@media (min-width:768px){.GfVfY-container{width:750px}}@media (min-width:992px){.GfVfY-container{width:970px}}@media (min-width:1200px){.GfVfY-container{width:1170px}}._1TgHS-container-fluid{margin-right:auto;margin-left:auto;padding-left:15px;padding-right:15px}._3XjgV-row{margin-left:-15px;margin-right:-15px}._4I1Yd-col-xs-1, .ZjKa3-col-sm-1, ._2qQXc-col-md-1, ._20ta3-col-lg-1, ._2Dnpy-col-xs-2, ._1i2ug-col-sm-2, ._2t37W-col-md-2, ._2Hl3y-col-lg-2, .OXp8_-col-xs-3, ._2Cn5F-col-sm-3, .Ab3eH-col-md-3, ._26GsY-col-lg-3, ._2RAtz-col-xs-4, .fa0Ir-col-sm-4, .hp0Eu-col-md-4, ._17v9q-col-lg-4, ._2Y3wR-col-xs-5, ._3h_BG-col-sm-5, .s-Mk2-col-md-5, ._3poT2-col-lg-5, ._2LLfi-col-xs-6, ._2uvrS-col-sm-6, ._3aWb5-col-md-6, ._1kKt6-col-lg-6, ._1lY1V-col-xs-7, ._3MZfg-col-sm-7, ._3cJo6-col-md-7, ._3xzR0-col-lg-7, ._2HxFx-col-xs-8, ._2gidE-col-sm-8, ._2PRaS-col-md-8, .LJ--s-col-lg-8, ._2oOfj-col-xs-9, ._26BI4-col-sm-9, ._2DhdG-col-md-9, ._3q6rI-col-lg-9, .pmiBX-col-xs-10, .s5knd-col-sm-10, ._1oNCM-col-md-10, ._2DYWZ-col-lg-10, .yWnii-col-xs-11, .liNUF-col-sm-11, ._2CmUe-col-md-11, ._1qjSD-col-lg-11, ._2dgc--col-xs-12, ._3ylC5-col-sm-12, ._2DCWc-col-md-12, ._2FNrn-col-lg-12{position:relative;min-height:1px;padding-left:15px;padding-right:15px}._4I1Yd-col-xs-1, ._2Dnpy-col-xs-2, .OXp8_-col-xs-3, ._2RAtz-col-xs-4, ._2Y3wR-col-xs-5, ._2LLfi-col-xs-6, ._1lY1V-col-xs-7, ._2HxFx-col-xs-8, ._2oOfj-col-xs-9, .pmiBX-col-xs-10, .yWnii-col-xs-11, ._2dgc--col-xs-12{float:left}._2dgc--col-xs-12{width:100%}.yWnii-col-xs-11{width:91.66666667%}.pmiBX-col-xs-10{width:83.33333333%}._2oOfj-col-xs-9{width:75%}._2HxFx-col-xs-8{width:66.66666667%}._1lY1V-col-xs-7{width:58.33333333%}._2LLfi-col-xs-6{width:50%}._2Y3wR-col-xs-5{width:41.66666667%}._2RAtz-col-xs-4{width:33.33333333%}.OXp8_-col-xs-3{width:25%}._2Dnpy-col-xs-2{width:16.66666667%}._4I1Yd-col-xs-1{width:8.33333333%}.uk8LQ-col-xs-pull-12{right:100%}.nQP-X-col-xs-pull-11{right:91.66666667%}._3W2H_-col-xs-pull-10{right:83.33333333%}._18qIh-col-xs-pull-9{right:75%}.CEgpl-col-xs-pull-8{right:66.66666667%}._3UVMh-col-xs-pull-7{right:58.33333333%}._1PEhv-col-xs-pull-6{right:50%}.VxFvW-col-xs-pull-5{right:41.66666667%}._3Wqpt-col-xs-pull-4{right:33.33333333%}._12Zed-col-xs-pull-3{right:25%}._3p-tQ-col-xs-pull-2{right:16.66666667%}.RDEqX-col-xs-pull-1{right:8.33333333%}._2F1WQ-col-xs-pull-0{right:auto}._1APvI-col-xs-push-12{left:100%}._3C73D-col-xs-push-11{left:91.66666667%}.h56Hx-col-xs-push-10{left:83.33333333%}._1P93P-col-xs-push-9{left:75%}._2Nbww-col-xs-push-8{left:66.66666667%}._1K3eA-col-xs-push-7{left:58.33333333%}._2nBcT-col-xs-push-6{left:50%}._3petP-col-xs-push-5{left:41.66666667%}._2IqqW-col-xs-push-4{left:33.33333333%}.MCtu6-col-xs-push-3{left:25%}._3PMdr-col-xs-push-2{left:16.66666667%}._1wU93-col-xs-push-1{left:8.33333333%}._1mQQp-col-xs-push-0{left:auto}._29EZj-col-xs-offset-12{margin-left:100%}._2hf-s-col-xs-offset-11{margin-left:91.66666667%}.PUiPu-col-xs-offset-10{margin-left:83.33333333%}._26WFR-col-xs-offset-9{margin-left:75%}._1cCHe-col-xs-offset-8{margin-left:66.66666667%}._3XTt9-col-xs-offset-7{margin-left:58.33333333%}._3ruq1-col-xs-offset-6{margin-left:50%}._2Jvdw-col-xs-offset-5{margin-left:41.66666667%}._3Lc1D-col-xs-offset-4{margin-left:33.33333333%}._1MGVb-col-xs-offset-3{margin-left:25%}._3VELV-col-xs-offset-2{margin-left:16.66666667%}._1qTH1-col-xs-offset-1{margin-left:8.33333333%}._3p4mP-col-xs-offset-0{margin-left:0}@media (min-width:768px){.ZjKa3-col-sm-1, ._1i2ug-col-sm-2, ._2Cn5F-col-sm-3, .fa0Ir-col-sm-4, ._3h_BG-col-sm-5, ._2uvrS-col-sm-6, ._3MZfg-col-sm-7, ._2gidE-col-sm-8, ._26BI4-col-sm-9, .s5knd-col-sm-10, .liNUF-col-sm-11, ._3ylC5-col-sm-12{float:left}._3ylC5-col-sm-12{width:100%}.liNUF-col-sm-11{width:91.66666667%}.s5knd-col-sm-10{width:83.33333333%}._26BI4-col-sm-9{width:75%}._2gidE-col-sm-8{width:66.66666667%}._3MZfg-col-sm-7{width:58.33333333%}._2uvrS-col-sm-6{width:50%}._3h_BG-col-sm-5{width:41.66666667%}.fa0Ir-col-sm-4{width:33.33333333%}._2Cn5F-col-sm-3{width:25%}._1i2ug-col-sm-2{width:16.66666667%}.ZjKa3-col-sm-1{width:8.33333333%}._2lgaN-col-sm-pull-12{right:100%}._9DE1R-col-sm-pull-11{right:91.66666667%}.kEzk--col-sm-pull-10{right:83.33333333%}.Nt2jd-col-sm-pull-9{right:75%}._3swPC-col-sm-pull-8{right:66.66666667%}._3HVdW-col-sm-pull-7{right:58.33333333%}.wTEFa-col-sm-pull-6{right:50%}.TLqCy-col-sm-pull-5{right:41.66666667%}._15_JC-col-sm-pull-4{right:33.33333333%}.kKugK-col-sm-pull-3{right:25%}._1gfwZ-col-sm-pull-2{right:16.66666667%}.ULGBP-col-sm-pull-1{right:8.33333333%}._2761W-col-sm-pull-0{right:auto}.vVqsv-col-sm-push-12{left:100%}._3rrzB-col-sm-push-11{left:91.66666667%}._16iHZ-col-sm-push-10{left:83.33333333%}._3gO4_-col-sm-push-9{left:75%}._2iTaN-col-sm-push-8{left:66.66666667%}._15MqT-col-sm-push-7{left:58.33333333%}._3WBCD-col-sm-push-6{left:50%}._3yi6x-col-sm-push-5{left:41.66666667%}.Hi_tc-col-sm-push-4{left:33.33333333%}._3CD2Y-col-sm-push-3{left:25%}._2KPVu-col-sm-push-2{left:16.66666667%}._1vrte-col-sm-push-1{left:8.33333333%}._2iGSL-col-sm-push-0{left:auto}._2gBOF-col-sm-offset-12{margin-left:100%}._1nkq--col-sm-offset-11{margin-left:91.66666667%}._3cbLF-col-sm-offset-10{margin-left:83.33333333%}._1YqUk-col-sm-offset-9{margin-left:75%}.l5LT2-col-sm-offset-8{margin-left:66.66666667%}._1_lx0-col-sm-offset-7{margin-left:58.33333333%}.QftRx-col-sm-offset-6{margin-left:50%}._1CiAA-col-sm-offset-5{margin-left:41.66666667%}._3KOyM-col-sm-offset-4{margin-left:33.33333333%}._3X8SS-col-sm-offset-3{margin-left:25%}._2uxv1-col-sm-offset-2{margin-left:16.66666667%}._3LDLJ-col-sm-offset-1{margin-left:8.33333333%}._2qEtK-col-sm-offset-0{margin-left:0}}@media (min-width:992px){._2qQXc-col-md-1, ._2t37W-col-md-2, .Ab3eH-col-md-3, .hp0Eu-col-md-4, .s-Mk2-col-md-5, ._3aWb5-col-md-6, ._3cJo6-col-md-7, ._2PRaS-col-md-8, ._2DhdG-col-md-9, ._1oNCM-col-md-10, ._2CmUe-col-md-11, ._2DCWc-col-md-12{float:left}._2DCWc-col-md-12{width:100%}._2CmUe-col-md-11{width:91.66666667%}._1oNCM-col-md-10{width:83.33333333%}._2DhdG-col-md-9{width:75%}._2PRaS-col-md-8{width:66.66666667%}._3cJo6-col-md-7{width:58.33333333%}._3aWb5-col-md-6{width:50%}.s-Mk2-col-md-5{width:41.66666667%}.hp0Eu-col-md-4{width:33.33333333%}.Ab3eH-col-md-3{width:25%}._2t37W-col-md-2{width:16.66666667%}._2qQXc-col-md-1{width:8.33333333%}.bjQSr-col-md-pull-12{right:100%}._2R0gT-col-md-pull-11{right:91.66666667%}._1ywn5-col-md-pull-10{right:83.33333333%}.Y3m-j-col-md-pull-9{right:75%}._2kyk9-col-md-pull-8{right:66.66666667%}.dnrMG-col-md-pull-7{right:58.33333333%}._1zNYf-col-md-pull-6{right:50%}._1lgxH-col-md-pull-5{right:41.66666667%}.fI_-S-col-md-pull-4{right:33.33333333%}._1yC1r-col-md-pull-3{right:25%}._2ruK1-col-md-pull-2{right:16.66666667%}._3pkxQ-col-md-pull-1{right:8.33333333%}._2NDft-col-md-pull-0{right:auto}._2dklO-col-md-push-12{left:100%}._2O3TS-col-md-push-11{left:91.66666667%}._3H7Y4-col-md-push-10{left:83.33333333%}._2PIS0-col-md-push-9{left:75%}._1qQQi-col-md-push-8{left:66.66666667%}.Y8MRT-col-md-push-7{left:58.33333333%}._3_TWQ-col-md-push-6{left:50%}._3KOrj-col-md-push-5{left:41.66666667%}._2PuS8-col-md-push-4{left:33.33333333%}._2zI-_-col-md-push-3{left:25%}._3Drij-col-md-push-2{left:16.66666667%}._1M7-4-col-md-push-1{left:8.33333333%}._1kPaw-col-md-push-0{left:auto}._3PwfG-col-md-offset-12{margin-left:100%}.sHbiw-col-md-offset-11{margin-left:91.66666667%}._3geq1-col-md-offset-10{margin-left:83.33333333%}._3ypJc-col-md-offset-9{margin-left:75%}.ydDuS-col-md-offset-8{margin-left:66.66666667%}._1MS3B-col-md-offset-7{margin-left:58.33333333%}._1A80h-col-md-offset-6{margin-left:50%}._3g3p_-col-md-offset-5{margin-left:41.66666667%}._2FjQc-col-md-offset-4{margin-left:33.33333333%}.Xebzn-col-md-offset-3{margin-left:25%}.Xw9rm-col-md-offset-2{margin-left:16.66666667%}.CToHd-col-md-offset-1{margin-left:8.33333333%}.oXEcL-col-md-offset-0{margin-left:0}}@media (min-width:1200px){._20ta3-col-lg-1, ._2Hl3y-col-lg-2, ._26GsY-col-lg-3, ._17v9q-col-lg-4, ._3poT2-col-lg-5, ._1kKt6-col-lg-6, ._3xzR0-col-lg-7, .LJ--s-col-lg-8, ._3q6rI-col-lg-9, ._2DYWZ-col-lg-10, ._1qjSD-col-lg-11, ._2FNrn-col-lg-12{float:left}._2FNrn-col-lg-12{width:100%}._1qjSD-col-lg-11{width:91.66666667%}._2DYWZ-col-lg-10{width:83.33333333%}._3q6rI-col-lg-9{width:75%}.LJ--s-col-lg-8{width:66.66666667%}._3xzR0-col-lg-7{width:58.33333333%}._1kKt6-col-lg-6{width:50%}._3poT2-col-lg-5{width:41.66666667%}._17v9q-col-lg-4{width:33.33333333%}._26GsY-col-lg-3{width:25%}._2Hl3y-col-lg-2{width:16.66666667%}._20ta3-col-lg-1{width:8.33333333%}._32_we-col-lg-pull-12{right:100%}._24eEw-col-lg-pull-11{right:91.66666667%}._29qaq-col-lg-pull-10{right:83.33333333%}._1S7Yo-col-lg-pull-9{right:75%}._2CgOB-col-lg-pull-8{right:66.66666667%}._3Jv0L-col-lg-pull-7{right:58.33333333%}._1pHMz-col-lg-pull-6{right:50%}._2zvsy-col-lg-pull-5{right:41.66666667%}.sFsmY-col-lg-pull-4{right:33.33333333%}.Ui3Ia-col-lg-pull-3{right:25%}.WmgXv-col-lg-pull-2{right:16.66666667%}._2KMEm-col-lg-pull-1{right:8.33333333%}.QWRTc-col-lg-pull-0{right:auto}._2FOMW-col-lg-push-12{left:100%}.IdWzr-col-lg-push-11{left:91.66666667%}._3qw3b-col-lg-push-10{left:83.33333333%}._3LXj0-col-lg-push-9{left:75%}._16qiV-col-lg-push-8{left:66.66666667%}._2OQk6-col-lg-push-7{left:58.33333333%}._3o1se-col-lg-push-6{left:50%}._2dmyK-col-lg-push-5{left:41.66666667%}._3pru9-col-lg-push-4{left:33.33333333%}._2lBUY-col-lg-push-3{left:25%}._3FuDW-col-lg-push-2{left:16.66666667%}._2YWH--col-lg-push-1{left:8.33333333%}._1iVNw-col-lg-push-0{left:auto}.uQRYD-col-lg-offset-12{margin-left:100%}._1tWxb-col-lg-offset-11{margin-left:91.66666667%}._10I1r-col-lg-offset-10{margin-left:83.33333333%}._3zXRb-col-lg-offset-9{margin-left:75%}._3HNmx-col-lg-offset-8{margin-left:66.66666667%}._3mIkn-col-lg-offset-7{margin-left:58.33333333%}._22SUB-col-lg-offset-6{margin-left:50%}._1CR4c-col-lg-offset-5{margin-left:41.66666667%}._1AWkt-col-lg-offset-4{margin-left:33.33333333%}._2BzzA-col-lg-offset-3{margin-left:25%}._3Egxi-col-lg-offset-2{margin-left:16.66666667%}._3QHjG-col-lg-offset-1{margin-left:8.33333333%}._3jTkc-col-lg-offset-0{margin-left:0}}table{background-color:transparent}caption{padding-top:8px;padding-bottom:8px;color:#777;text-align:left}th{text-align:left}.fHH5l-table{width:100%;max-width:100%;margin-bottom:20px}.fHH5l-table>thead>tr>th,.fHH5l-table>tbody>tr>th,.fHH5l-table>tfoot>tr>th,.fHH5l-table>thead>tr>td,.fHH5l-table>tbody>tr>td,.fHH5l-table>tfoot>tr>td{padding:8px;line-height:1.42857143;vertical-align:top;border-top:1px solid #ddd}.fHH5l-table>thead>tr>th{vertical-align:bottom;border-bottom:2px solid #ddd}.fHH5l-table>caption+thead>tr:first-child>th,.fHH5l-table>colgroup+thead>tr:first-child>th,.fHH5l-table>thead:first-child>tr:first-child>th,.fHH5l-table>caption+thead>tr:first-child>td,.fHH5l-table>colgroup+thead>tr:first-child>td,.fHH5l-table>thead:first-child>tr:first-child>td{border-top:0}.fHH5l-table>tbody+tbody{border-top:2px solid #ddd}.fHH5l-table .fHH5l-table{background-color:#fff}._1lZ-G-table-condensed>thead>tr>th,._1lZ-G-table-condensed>tbody>tr>th,._1lZ-G-table-condensed>tfoot>tr>th,._1lZ-G-table-condensed>thead>tr>td,._1lZ-G-table-condensed>tbody>tr>td,._1lZ-G-table-condensed>tfoot>tr>td{padding:5px}._3qUkR-table-bordered{border:1px solid #ddd}._3qUkR-table-bordered>thead>tr>th,._3qUkR-table-bordered>tbody>tr>th,._3qUkR-table-bordered>tfoot>tr>th,._3qUkR-table-bordered>thead>tr>td,._3qUkR-table-bordered>tbody>tr>td,._3qUkR-table-bordered>tfoot>tr>td{border:1px solid #ddd}._3qUkR-table-bordered>thead>tr>th,._3qUkR-table-bordered>thead>tr>td{border-bottom-width:2px}._2ysFO-table-striped>tbody>tr:nth-of-type(odd){background-color:#f9f9f9}._3Qd5Z-table-hover>tbody>tr:hover{background-color:#f5f5f5}table col[class*="col-"]{position:static;float:none;display:table-column}table td[class*="col-"],table th[class*="col-"]{position:static;float:none;display:table-cell}.fHH5l-table>thead>tr>td._2tvYY-active,.fHH5l-table>tbody>tr>td._2tvYY-active,.fHH5l-table>tfoot>tr>td._2tvYY-active,.fHH5l-table>thead>tr>th._2tvYY-active,.fHH5l-table>tbody>tr>th._2tvYY-active,.fHH5l-table>tfoot>tr>th._2tvYY-active,.fHH5l-table>thead>tr._2tvYY-active>td,.fHH5l-table>tbody>tr._2tvYY-active>td,.fHH5l-table>tfoot>tr._2tvYY-active>td,.fHH5l-table>thead>tr._2tvYY-active>th,.fHH5l-table>tbody>tr._2tvYY-active>th,.fHH5l-table>tfoot>tr._2tvYY-active>th{background-color:#f5f5f5}._3Qd5Z-table-hover>tbody>tr>td._2tvYY-active:hover,._3Qd5Z-table-hover>tbody>tr>th._2tvYY-active:hover,._3Qd5Z-table-hover>tbody>tr._2tvYY-active:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2tvYY-active,._3Qd5Z-table-hover>tbody>tr._2tvYY-active:hover>th{background-color:#e8e8e8}.fHH5l-table>thead>tr>td._2OgG2-success,.fHH5l-table>tbody>tr>td._2OgG2-success,.fHH5l-table>tfoot>tr>td._2OgG2-success,.fHH5l-table>thead>tr>th._2OgG2-success,.fHH5l-table>tbody>tr>th._2OgG2-success,.fHH5l-table>tfoot>tr>th._2OgG2-success,.fHH5l-table>thead>tr._2OgG2-success>td,.fHH5l-table>tbody>tr._2OgG2-success>td,.fHH5l-table>tfoot>tr._2OgG2-success>td,.fHH5l-table>thead>tr._2OgG2-success>th,.fHH5l-table>tbody>tr._2OgG2-success>th,.fHH5l-table>tfoot>tr._2OgG2-success>th{background-color:#dff0d8}._3Qd5Z-table-hover>tbody>tr>td._2OgG2-success:hover,._3Qd5Z-table-hover>tbody>tr>th._2OgG2-success:hover,._3Qd5Z-table-hover>tbody>tr._2OgG2-success:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2OgG2-success,._3Qd5Z-table-hover>tbody>tr._2OgG2-success:hover>th{background-color:#d0e9c6}.fHH5l-table>thead>tr>td._2ItI5-info,.fHH5l-table>tbody>tr>td._2ItI5-info,.fHH5l-table>tfoot>tr>td._2ItI5-info,.fHH5l-table>thead>tr>th._2ItI5-info,.fHH5l-table>tbody>tr>th._2ItI5-info,.fHH5l-table>tfoot>tr>th._2ItI5-info,.fHH5l-table>thead>tr._2ItI5-info>td,.fHH5l-table>tbody>tr._2ItI5-info>td,.fHH5l-table>tfoot>tr._2ItI5-info>td,.fHH5l-table>thead>tr._2ItI5-info>th,.fHH5l-table>tbody>tr._2ItI5-info>th,.fHH5l-table>tfoot>tr._2ItI5-info>th{background-color:#d9edf7}._3Qd5Z-table-hover>tbody>tr>td._2ItI5-info:hover,._3Qd5Z-table-hover>tbody>tr>th._2ItI5-info:hover,._3Qd5Z-table-hover>tbody>tr._2ItI5-info:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2ItI5-info,._3Qd5Z-table-hover>tbody>tr._2ItI5-info:hover>th{background-color:#c4e3f3}.fHH5l-table>thead>tr>td.KrRM8-warning,.fHH5l-table>tbody>tr>td.KrRM8-warning,.fHH5l-table>tfoot>tr>td.KrRM8-warning,.fHH5l-table>thead>tr>th.KrRM8-warning,.fHH5l-table>tbody>tr>th.KrRM8-warning,.fHH5l-table>tfoot>tr>th.KrRM8-warning,.fHH5l-table>thead>tr.KrRM8-warning>td,.fHH5l-table>tbody>tr.KrRM8-warning>td,.fHH5l-table>tfoot>tr.KrRM8-warning>td,.fHH5l-table>thead>tr.KrRM8-warning>th,.fHH5l-table>tbody>tr.KrRM8-warning>th,.fHH5l-table>tfoot>tr.KrRM8-warning>th{background-color:#fcf8e3}._3Qd5Z-table-hover>tbody>tr>td.KrRM8-warning:hover,._3Qd5Z-table-hover>tbody>tr>th.KrRM8-warning:hover,._3Qd5Z-table-hover>tbody>tr.KrRM8-warning:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>.KrRM8-warning,._3Qd5Z-table-hover>tbody>tr.KrRM8-warning:hover>th{background-color:#faf2cc}.fHH5l-table>thead>tr>td.CwCgN-danger,.fHH5l-table>tbody>tr>td.CwCgN-danger,.fHH5l-table>tfoot>tr>td.CwCgN-danger,.fHH5l-table>thead>tr>th.CwCgN-danger,.fHH5l-table>tbody>tr>th.CwCgN-danger,.fHH5l-table>tfoot>tr>th.CwCgN-danger,.fHH5l-table>thead>tr.CwCgN-danger>td,.fHH5l-table>tbody>tr.CwCgN-danger>td,.fHH5l-table>tfoot>tr.CwCgN-danger>td,.fHH5l-table>thead>tr.CwCgN-danger>th,.fHH5l-table>tbody>tr.CwCgN-danger>th,.fHH5l-table>tfoot>tr.CwCgN-danger>th{background-color:#f2dede}._3Qd5Z-table-hover>tbody>tr>td.CwCgN-danger:hover,._3Qd5Z-table-hover>tbody>tr>th.CwCgN-danger:hover,._3Qd5Z-table-hover>tbody>tr.CwCgN-danger:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>.CwCgN-danger,._3Qd5Z-table-hover>tbody>tr.CwCgN-danger:hover>th{background-color:#ebcccc}._3oGxj-table-responsive{overflow-x:auto;min-height:0.01%}@media screen and (max-width:767px){._3oGxj-table-responsive{width:100%;margin-bottom:15px;overflow-y:hidden;-ms-overflow-style:-ms-autohiding-scrollbar;border:1px solid #ddd}._3oGxj-table-responsive>.fHH5l-table{margin-bottom:0}._3oGxj-table-responsive>.fHH5l-table>thead>tr>th,._3oGxj-table-responsive>.fHH5l-table>tbody>tr>th,._3oGxj-table-responsive>.fHH5l-table>tfoot>tr>th,._3oGxj-table-responsive>.fHH5l-table>thead>tr>td,._3oGxj-table-responsive>.fHH5l-table>tbody>tr>td,._3oGxj-table-responsive>.fHH5l-table>tfoot>tr>td{white-space:nowrap}._3oGxj-table-responsive>._3qUkR-table-bordered{border:0}._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:first-child{border-left:0}._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:last-child{border-right:0}._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>th,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>th,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>td,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>td{border-bottom:0}}._3-XUl-btn{display:inline-block;margin-bottom:0;font-weight:normal;text-align:center;vertical-align:middle;-ms-touch-action:manipulation;touch-action:manipulation;cursor:pointer;background-image:none;border:1px solid transparent;white-space:nowrap;padding:6px 12px;font-size:14px;line-height:1.42857143;border-radius:4px;-webkit-user-select:none;-moz-user-select:none;-ms-user-select:none;user-select:none}._3-XUl-btn:focus,._3-XUl-btn:active:focus,._3-XUl-btn._2tvYY-active:focus,._3-XUl-btn._2eLCD-focus,._3-XUl-btn:active._2eLCD-focus,._3-XUl-btn._2tvYY-active._2eLCD-focus{outline:thin dotted;outline:5px auto -webkit-focus-ring-color;outline-offset:-2px}._3-XUl-btn:hover,._3-XUl-btn:focus,._3-XUl-btn._2eLCD-focus{color:#333;text-decoration:none}._3-XUl-btn:active,._3-XUl-btn._2tvYY-active{outline:0;background-image:none;-webkit-box-shadow:inset 0 3px 5px rgba(0,0,0,0.125);box-shadow:inset 0 3px 5px rgba(0,0,0,0.125)}._3-XUl-btn._2UPmc-disabled,._3-XUl-btn[disabled],fieldset[disabled] ._3-XUl-btn{cursor:not-allowed;opacity:.65;filter:alpha(opacity=65);-webkit-box-shadow:none;box-shadow:none}a._3-XUl-btn._2UPmc-disabled,fieldset[disabled] a._3-XUl-btn{pointer-events:none}._7-4ed-btn-default{color:#333;background-color:#fff;border-color:#ccc}._7-4ed-btn-default:focus,._7-4ed-btn-default._2eLCD-focus{color:#333;background-color:#e6e6e6;border-color:#8c8c8c}._7-4ed-btn-default:hover{color:#333;background-color:#e6e6e6;border-color:#adadad}._7-4ed-btn-default:active,._7-4ed-btn-default._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default{color:#333;background-color:#e6e6e6;border-color:#adadad}._7-4ed-btn-default:active:hover,._7-4ed-btn-default._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default:hover,._7-4ed-btn-default:active:focus,._7-4ed-btn-default._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default:focus,._7-4ed-btn-default:active._2eLCD-focus,._7-4ed-btn-default._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default._2eLCD-focus{color:#333;background-color:#d4d4d4;border-color:#8c8c8c}._7-4ed-btn-default:active,._7-4ed-btn-default._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default{background-image:none}._7-4ed-btn-default._2UPmc-disabled:hover,._7-4ed-btn-default[disabled]:hover,fieldset[disabled] ._7-4ed-btn-default:hover,._7-4ed-btn-default._2UPmc-disabled:focus,._7-4ed-btn-default[disabled]:focus,fieldset[disabled] ._7-4ed-btn-default:focus,._7-4ed-btn-default._2UPmc-disabled._2eLCD-focus,._7-4ed-btn-default[disabled]._2eLCD-focus,fieldset[disabled] ._7-4ed-btn-default._2eLCD-focus{background-color:#fff;border-color:#ccc}._7-4ed-btn-default ._1XX3z-badge{color:#fff;background-color:#333}._1VbPL-btn-primary{color:#fff;background-color:#337ab7;border-color:#2e6da4}._1VbPL-btn-primary:focus,._1VbPL-btn-primary._2eLCD-focus{color:#fff;background-color:#286090;border-color:#122b40}._1VbPL-btn-primary:hover{color:#fff;background-color:#286090;border-color:#204d74}._1VbPL-btn-primary:active,._1VbPL-btn-primary._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary{color:#fff;background-color:#286090;border-color:#204d74}._1VbPL-btn-primary:active:hover,._1VbPL-btn-primary._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary:hover,._1VbPL-btn-primary:active:focus,._1VbPL-btn-primary._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary:focus,._1VbPL-btn-primary:active._2eLCD-focus,._1VbPL-btn-primary._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary._2eLCD-focus{color:#fff;background-color:#204d74;border-color:#122b40}._1VbPL-btn-primary:active,._1VbPL-btn-primary._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary{background-image:none}._1VbPL-btn-primary._2UPmc-disabled:hover,._1VbPL-btn-primary[disabled]:hover,fieldset[disabled] ._1VbPL-btn-primary:hover,._1VbPL-btn-primary._2UPmc-disabled:focus,._1VbPL-btn-primary[disabled]:focus,fieldset[disabled] ._1VbPL-btn-primary:focus,._1VbPL-btn-primary._2UPmc-disabled._2eLCD-focus,._1VbPL-btn-primary[disabled]._2eLCD-focus,fieldset[disabled] ._1VbPL-btn-primary._2eLCD-focus{background-color:#337ab7;border-color:#2e6da4}._1VbPL-btn-primary ._1XX3z-badge{color:#337ab7;background-color:#fff}._1P0kt-btn-success{color:#fff;background-color:#5cb85c;border-color:#4cae4c}._1P0kt-btn-success:focus,._1P0kt-btn-success._2eLCD-focus{color:#fff;background-color:#449d44;border-color:#255625}._1P0kt-btn-success:hover{color:#fff;background-color:#449d44;border-color:#398439}._1P0kt-btn-success:active,._1P0kt-btn-success._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success{color:#fff;background-color:#449d44;border-color:#398439}._1P0kt-btn-success:active:hover,._1P0kt-btn-success._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success:hover,._1P0kt-btn-success:active:focus,._1P0kt-btn-success._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success:focus,._1P0kt-btn-success:active._2eLCD-focus,._1P0kt-btn-success._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success._2eLCD-focus{color:#fff;background-color:#398439;border-color:#255625}._1P0kt-btn-success:active,._1P0kt-btn-success._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success{background-image:none}._1P0kt-btn-success._2UPmc-disabled:hover,._1P0kt-btn-success[disabled]:hover,fieldset[disabled] ._1P0kt-btn-success:hover,._1P0kt-btn-success._2UPmc-disabled:focus,._1P0kt-btn-success[disabled]:focus,fieldset[disabled] ._1P0kt-btn-success:focus,._1P0kt-btn-success._2UPmc-disabled._2eLCD-focus,._1P0kt-btn-success[disabled]._2eLCD-focus,fieldset[disabled] ._1P0kt-btn-success._2eLCD-focus{background-color:#5cb85c;border-color:#4cae4c}._1P0kt-btn-success ._1XX3z-badge{color:#5cb85c;background-color:#fff}._25yw2-btn-info{color:#fff;background-color:#5bc0de;border-color:#46b8da}._25yw2-btn-info:focus,._25yw2-btn-info._2eLCD-focus{color:#fff;background-color:#31b0d5;border-color:#1b6d85}._25yw2-btn-info:hover{color:#fff;background-color:#31b0d5;border-color:#269abc}._25yw2-btn-info:active,._25yw2-btn-info._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info{color:#fff;background-color:#31b0d5;border-color:#269abc}._25yw2-btn-info:active:hover,._25yw2-btn-info._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info:hover,._25yw2-btn-info:active:focus,._25yw2-btn-info._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info:focus,._25yw2-btn-info:active._2eLCD-focus,._25yw2-btn-info._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info._2eLCD-focus{color:#fff;background-color:#269abc;border-color:#1b6d85}._25yw2-btn-info:active,._25yw2-btn-info._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info{background-image:none}._25yw2-btn-info._2UPmc-disabled:hover,._25yw2-btn-info[disabled]:hover,fieldset[disabled] ._25yw2-btn-info:hover,._25yw2-btn-info._2UPmc-disabled:focus,._25yw2-btn-info[disabled]:focus,fieldset[disabled] ._25yw2-btn-info:focus,._25yw2-btn-info._2UPmc-disabled._2eLCD-focus,._25yw2-btn-info[disabled]._2eLCD-focus,fieldset[disabled] ._25yw2-btn-info._2eLCD-focus{background-color:#5bc0de;border-color:#46b8da}._25yw2-btn-info ._1XX3z-badge{color:#5bc0de;background-color:#fff}.-tgFO-btn-warning{color:#fff;background-color:#f0ad4e;border-color:#eea236}.-tgFO-btn-warning:focus,.-tgFO-btn-warning._2eLCD-focus{color:#fff;background-color:#ec971f;border-color:#985f0d}.-tgFO-btn-warning:hover{color:#fff;background-color:#ec971f;border-color:#d58512}.-tgFO-btn-warning:active,.-tgFO-btn-warning._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning{color:#fff;background-color:#ec971f;border-color:#d58512}.-tgFO-btn-warning:active:hover,.-tgFO-btn-warning._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning:hover,.-tgFO-btn-warning:active:focus,.-tgFO-btn-warning._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning:focus,.-tgFO-btn-warning:active._2eLCD-focus,.-tgFO-btn-warning._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning._2eLCD-focus{color:#fff;background-color:#d58512;border-color:#985f0d}.-tgFO-btn-warning:active,.-tgFO-btn-warning._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning{background-image:none}.-tgFO-btn-warning._2UPmc-disabled:hover,.-tgFO-btn-warning[disabled]:hover,fieldset[disabled] .-tgFO-btn-warning:hover,.-tgFO-btn-warning._2UPmc-disabled:focus,.-tgFO-btn-warning[disabled]:focus,fieldset[disabled] .-tgFO-btn-warning:focus,.-tgFO-btn-warning._2UPmc-disabled._2eLCD-focus,.-tgFO-btn-warning[disabled]._2eLCD-focus,fieldset[disabled] .-tgFO-btn-warning._2eLCD-focus{background-color:#f0ad4e;border-color:#eea236}.-tgFO-btn-warning ._1XX3z-badge{color:#f0ad4e;background-color:#fff}._1fOWb-btn-danger{color:#fff;background-color:#d9534f;border-color:#d43f3a}._1fOWb-btn-danger:focus,._1fOWb-btn-danger._2eLCD-focus{color:#fff;background-color:#c9302c;border-color:#761c19}._1fOWb-btn-danger:hover{color:#fff;background-color:#c9302c;border-color:#ac2925}._1fOWb-btn-danger:active,._1fOWb-btn-danger._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger{color:#fff;background-color:#c9302c;border-color:#ac2925}._1fOWb-btn-danger:active:hover,._1fOWb-btn-danger._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger:hover,._1fOWb-btn-danger:active:focus,._1fOWb-btn-danger._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger:focus,._1fOWb-btn-danger:active._2eLCD-focus,._1fOWb-btn-danger._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger._2eLCD-focus{color:#fff;background-color:#ac2925;border-color:#761c19}._1fOWb-btn-danger:active,._1fOWb-btn-danger._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger{background-image:none}._1fOWb-btn-danger._2UPmc-disabled:hover,._1fOWb-btn-danger[disabled]:hover,fieldset[disabled] ._1fOWb-btn-danger:hover,._1fOWb-btn-danger._2UPmc-disabled:focus,._1fOWb-btn-danger[disabled]:focus,fieldset[disabled] ._1fOWb-btn-danger:focus,._1fOWb-btn-danger._2UPmc-disabled._2eLCD-focus,._1fOWb-btn-danger[disabled]._2eLCD-focus,fieldset[disabled] ._1fOWb-btn-danger._2eLCD-focus{background-color:#d9534f;border-color:#d43f3a}._1fOWb-btn-danger ._1XX3z-badge{color:#d9534f;background-color:#fff}._2k5vU-btn-link{color:#337ab7;font-weight:normal;border-radius:0}._2k5vU-btn-link,._2k5vU-btn-link:active,._2k5vU-btn-link._2tvYY-active,._2k5vU-btn-link[disabled],fieldset[disabled] ._2k5vU-btn-link{background-color:transparent;-webkit-box-shadow:none;box-shadow:none}._2k5vU-btn-link,._2k5vU-btn-link:hover,._2k5vU-btn-link:focus,._2k5vU-btn-link:active{border-color:transparent}._2k5vU-btn-link:hover,._2k5vU-btn-link:focus{color:#23527c;text-decoration:underline;background-color:transparent}._2k5vU-btn-link[disabled]:hover,fieldset[disabled] ._2k5vU-btn-link:hover,._2k5vU-btn-link[disabled]:focus,fieldset[disabled] ._2k5vU-btn-link:focus{color:#777;text-decoration:none}._4Y8qI-btn-lg,._29CyS-btn-group-lg>._3-XUl-btn{padding:10px 16px;font-size:18px;line-height:1.3333333;border-radius:6px}._30Oip-btn-sm,._2eEOC-btn-group-sm>._3-XUl-btn{padding:5px 10px;font-size:12px;line-height:1.5;border-radius:3px}._2kCvE-btn-xs,._1A2Tx-btn-group-xs>._3-XUl-btn{padding:1px 5px;font-size:12px;line-height:1.5;border-radius:3px}._3micb-btn-block{display:block;width:100%}._3micb-btn-block+._3micb-btn-block{margin-top:5px}input[type="submit"]._3micb-btn-block,input[type="reset"]._3micb-btn-block,input[type="button"]._3micb-btn-block{width:100%}._2yrMO-btn-group,.eNKyK-btn-group-vertical{position:relative;display:inline-block;vertical-align:middle}._2yrMO-btn-group>._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn{position:relative;float:left}._2yrMO-btn-group>._3-XUl-btn:hover,.eNKyK-btn-group-vertical>._3-XUl-btn:hover,._2yrMO-btn-group>._3-XUl-btn:focus,.eNKyK-btn-group-vertical>._3-XUl-btn:focus,._2yrMO-btn-group>._3-XUl-btn:active,.eNKyK-btn-group-vertical>._3-XUl-btn:active,._2yrMO-btn-group>._3-XUl-btn._2tvYY-active,.eNKyK-btn-group-vertical>._3-XUl-btn._2tvYY-active{z-index:2}._2yrMO-btn-group ._3-XUl-btn+._3-XUl-btn,._2yrMO-btn-group ._3-XUl-btn+._2yrMO-btn-group,._2yrMO-btn-group ._2yrMO-btn-group+._3-XUl-btn,._2yrMO-btn-group ._2yrMO-btn-group+._2yrMO-btn-group{margin-left:-1px}._1TX6t-btn-toolbar{margin-left:-5px}._1TX6t-btn-toolbar ._3-XUl-btn,._1TX6t-btn-toolbar ._2yrMO-btn-group,._1TX6t-btn-toolbar ._2lgpZ-input-group{float:left}._1TX6t-btn-toolbar>._3-XUl-btn,._1TX6t-btn-toolbar>._2yrMO-btn-group,._1TX6t-btn-toolbar>._2lgpZ-input-group{margin-left:5px}._2yrMO-btn-group>._3-XUl-btn:not(:first-child):not(:last-child):not(._2KnYE-dropdown-toggle){border-radius:0}._2yrMO-btn-group>._3-XUl-btn:first-child{margin-left:0}._2yrMO-btn-group>._3-XUl-btn:first-child:not(:last-child):not(._2KnYE-dropdown-toggle){border-bottom-right-radius:0;border-top-right-radius:0}._2yrMO-btn-group>._3-XUl-btn:last-child:not(:first-child),._2yrMO-btn-group>._2KnYE-dropdown-toggle:not(:first-child){border-bottom-left-radius:0;border-top-left-radius:0}._2yrMO-btn-group>._2yrMO-btn-group{float:left}._2yrMO-btn-group>._2yrMO-btn-group:not(:first-child):not(:last-child)>._3-XUl-btn{border-radius:0}._2yrMO-btn-group>._2yrMO-btn-group:first-child:not(:last-child)>._3-XUl-btn:last-child,._2yrMO-btn-group>._2yrMO-btn-group:first-child:not(:last-child)>._2KnYE-dropdown-toggle{border-bottom-right-radius:0;border-top-right-radius:0}._2yrMO-btn-group>._2yrMO-btn-group:last-child:not(:first-child)>._3-XUl-btn:first-child{border-bottom-left-radius:0;border-top-left-radius:0}._2yrMO-btn-group ._2KnYE-dropdown-toggle:active,._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle{outline:0}._2yrMO-btn-group>._3-XUl-btn+._2KnYE-dropdown-toggle{padding-left:8px;padding-right:8px}._2yrMO-btn-group>._4Y8qI-btn-lg+._2KnYE-dropdown-toggle{padding-left:12px;padding-right:12px}._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle{-webkit-box-shadow:inset 0 3px 5px rgba(0,0,0,0.125);box-shadow:inset 0 3px 5px rgba(0,0,0,0.125)}._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle._2k5vU-btn-link{-webkit-box-shadow:none;box-shadow:none}._3-XUl-btn .WfXRY-caret{margin-left:0}._4Y8qI-btn-lg .WfXRY-caret{border-width:5px 5px 0;border-bottom-width:0}.xQM4X-dropup ._4Y8qI-btn-lg .WfXRY-caret{border-width:0 5px 5px}.eNKyK-btn-group-vertical>._3-XUl-btn,.eNKyK-btn-group-vertical>._2yrMO-btn-group,.eNKyK-btn-group-vertical>._2yrMO-btn-group>._3-XUl-btn{display:block;float:none;width:100%;max-width:100%}.eNKyK-btn-group-vertical>._2yrMO-btn-group>._3-XUl-btn{float:none}.eNKyK-btn-group-vertical>._3-XUl-btn+._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn+._2yrMO-btn-group,.eNKyK-btn-group-vertical>._2yrMO-btn-group+._3-XUl-btn,.eNKyK-btn-group-vertical>._2yrMO-btn-group+._2yrMO-btn-group{margin-top:-1px;margin-left:0}.eNKyK-btn-group-vertical>._3-XUl-btn:not(:first-child):not(:last-child){border-radius:0}.eNKyK-btn-group-vertical>._3-XUl-btn:first-child:not(:last-child){border-top-right-radius:4px;border-top-left-radius:4px;border-bottom-right-radius:0;border-bottom-left-radius:0}.eNKyK-btn-group-vertical>._3-XUl-btn:last-child:not(:first-child){border-top-right-radius:0;border-top-left-radius:0;border-bottom-right-radius:4px;border-bottom-left-radius:4px}.eNKyK-btn-group-vertical>._2yrMO-btn-group:not(:first-child):not(:last-child)>._3-XUl-btn{border-radius:0}.eNKyK-btn-group-vertical>._2yrMO-btn-group:first-child:not(:last-child)>._3-XUl-btn:last-child,.eNKyK-btn-group-vertical>._2yrMO-btn-group:first-child:not(:last-child)>._2KnYE-dropdown-toggle{border-bottom-right-radius:0;border-bottom-left-radius:0}.eNKyK-btn-group-vertical>._2yrMO-btn-group:last-child:not(:first-child)>._3-XUl-btn:first-child{border-top-right-radius:0;border-top-left-radius:0}.gzM9c-btn-group-justified{display:table;width:100%;table-layout:fixed;border-collapse:separate}.gzM9c-btn-group-justified>._3-XUl-btn,.gzM9c-btn-group-justified>._2yrMO-btn-group{float:none;display:table-cell;width:1%}.gzM9c-btn-group-justified>._2yrMO-btn-group ._3-XUl-btn{width:100%}.gzM9c-btn-group-justified>._2yrMO-btn-group ._5aG9w-dropdown-menu{left:auto}[data-toggle="buttons"]>._3-XUl-btn input[type="radio"],[data-toggle="buttons"]>._2yrMO-btn-group>._3-XUl-btn input[type="radio"],[data-toggle="buttons"]>._3-XUl-btn input[type="checkbox"],[data-toggle="buttons"]>._2yrMO-btn-group>._3-XUl-btn input[type="checkbox"]{position:absolute;clip:rect(0, 0, 0, 0);pointer-events:none}._18V3M-nav{margin-bottom:0;padding-left:0;list-style:none}._18V3M-nav>li{position:relative;display:block}._18V3M-nav>li>a{position:relative;display:block;padding:10px 15px}._18V3M-nav>li>a:hover,._18V3M-nav>li>a:focus{text-decoration:none;background-color:#eee}._18V3M-nav>li._2UPmc-disabled>a{color:#777}._18V3M-nav>li._2UPmc-disabled>a:hover,._18V3M-nav>li._2UPmc-disabled>a:focus{color:#777;text-decoration:none;background-color:transparent;cursor:not-allowed}._18V3M-nav .cl_nk-open>a,._18V3M-nav .cl_nk-open>a:hover,._18V3M-nav .cl_nk-open>a:focus{background-color:#eee;border-color:#337ab7}._18V3M-nav ._3jwhe-nav-divider{height:1px;margin:9px 0;overflow:hidden;background-color:#e5e5e5}._18V3M-nav>li>a>img{max-width:none}._2ciYP-nav-tabs{border-bottom:1px solid #ddd}._2ciYP-nav-tabs>li{float:left;margin-bottom:-1px}._2ciYP-nav-tabs>li>a{margin-right:2px;line-height:1.42857143;border:1px solid transparent;border-radius:4px 4px 0 0}._2ciYP-nav-tabs>li>a:hover{border-color:#eee #eee #ddd}._2ciYP-nav-tabs>li._2tvYY-active>a,._2ciYP-nav-tabs>li._2tvYY-active>a:hover,._2ciYP-nav-tabs>li._2tvYY-active>a:focus{color:#555;background-color:#fff;border:1px solid #ddd;border-bottom-color:transparent;cursor:default}._2ciYP-nav-tabs._3nLJA-nav-justified{width:100%;border-bottom:0}._2ciYP-nav-tabs._3nLJA-nav-justified>li{float:none}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{text-align:center;margin-bottom:5px}._2ciYP-nav-tabs._3nLJA-nav-justified>._3oGVD-dropdown ._5aG9w-dropdown-menu{top:auto;left:auto}@media (min-width:768px){._2ciYP-nav-tabs._3nLJA-nav-justified>li{display:table-cell;width:1%}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-bottom:0}}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-right:0;border-radius:4px}._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:hover,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:focus{border:1px solid #ddd}@media (min-width:768px){._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{border-bottom:1px solid #ddd;border-radius:4px 4px 0 0}._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:hover,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:focus{border-bottom-color:#fff}}._3i5kW-nav-pills>li{float:left}._3i5kW-nav-pills>li>a{border-radius:4px}._3i5kW-nav-pills>li+li{margin-left:2px}._3i5kW-nav-pills>li._2tvYY-active>a,._3i5kW-nav-pills>li._2tvYY-active>a:hover,._3i5kW-nav-pills>li._2tvYY-active>a:focus{color:#fff;background-color:#337ab7}._3pfVZ-nav-stacked>li{float:none}._3pfVZ-nav-stacked>li+li{margin-top:2px;margin-left:0}._3nLJA-nav-justified{width:100%}._3nLJA-nav-justified>li{float:none}._3nLJA-nav-justified>li>a{text-align:center;margin-bottom:5px}._3nLJA-nav-justified>._3oGVD-dropdown ._5aG9w-dropdown-menu{top:auto;left:auto}@media (min-width:768px){._3nLJA-nav-justified>li{display:table-cell;width:1%}._3nLJA-nav-justified>li>a{margin-bottom:0}}._18sQL-nav-tabs-justified{border-bottom:0}._18sQL-nav-tabs-justified>li>a{margin-right:0;border-radius:4px}._18sQL-nav-tabs-justified>._2tvYY-active>a,._18sQL-nav-tabs-justified>._2tvYY-active>a:hover,._18sQL-nav-tabs-justified>._2tvYY-active>a:focus{border:1px solid #ddd}@media (min-width:768px){._18sQL-nav-tabs-justified>li>a{border-bottom:1px solid #ddd;border-radius:4px 4px 0 0}._18sQL-nav-tabs-justified>._2tvYY-active>a,._18sQL-nav-tabs-justified>._2tvYY-active>a:hover,._18sQL-nav-tabs-justified>._2tvYY-active>a:focus{border-bottom-color:#fff}}.FgbPD-tab-content>._2q1VF-tab-pane{display:none}.FgbPD-tab-content>._2tvYY-active{display:block}._2ciYP-nav-tabs ._5aG9w-dropdown-menu{margin-top:-1px;border-top-right-radius:0;border-top-left-radius:0}.n15Mn-pagination{display:inline-block;padding-left:0;margin:20px 0;border-radius:4px}.n15Mn-pagination>li{display:inline}.n15Mn-pagination>li>a,.n15Mn-pagination>li>span{position:relative;float:left;padding:6px 12px;line-height:1.42857143;text-decoration:none;color:#337ab7;background-color:#fff;border:1px solid #ddd;margin-left:-1px}.n15Mn-pagination>li:first-child>a,.n15Mn-pagination>li:first-child>span{margin-left:0;border-bottom-left-radius:4px;border-top-left-radius:4px}.n15Mn-pagination>li:last-child>a,.n15Mn-pagination>li:last-child>span{border-bottom-right-radius:4px;border-top-right-radius:4px}.n15Mn-pagination>li>a:hover,.n15Mn-pagination>li>span:hover,.n15Mn-pagination>li>a:focus,.n15Mn-pagination>li>span:focus{z-index:2;color:#23527c;background-color:#eee;border-color:#ddd}.n15Mn-pagination>._2tvYY-active>a,.n15Mn-pagination>._2tvYY-active>span,.n15Mn-pagination>._2tvYY-active>a:hover,.n15Mn-pagination>._2tvYY-active>span:hover,.n15Mn-pagination>._2tvYY-active>a:focus,.n15Mn-pagination>._2tvYY-active>span:focus{z-index:3;color:#fff;background-color:#337ab7;border-color:#337ab7;cursor:default}.n15Mn-pagination>._2UPmc-disabled>span,.n15Mn-pagination>._2UPmc-disabled>span:hover,.n15Mn-pagination>._2UPmc-disabled>span:focus,.n15Mn-pagination>._2UPmc-disabled>a,.n15Mn-pagination>._2UPmc-disabled>a:hover,.n15Mn-pagination>._2UPmc-disabled>a:focus{color:#777;background-color:#fff;border-color:#ddd;cursor:not-allowed}.L5I4g-pagination-lg>li>a,.L5I4g-pagination-lg>li>span{padding:10px 16px;font-size:18px;line-height:1.3333333}.L5I4g-pagination-lg>li:first-child>a,.L5I4g-pagination-lg>li:first-child>span{border-bottom-left-radius:6px;border-top-left-radius:6px}.L5I4g-pagination-lg>li:last-child>a,.L5I4g-pagination-lg>li:last-child>span{border-bottom-right-radius:6px;border-top-right-radius:6px}._3rY2q-pagination-sm>li>a,._3rY2q-pagination-sm>li>span{padding:5px 10px;font-size:12px;line-height:1.5}._3rY2q-pagination-sm>li:first-child>a,._3rY2q-pagination-sm>li:first-child>span{border-bottom-left-radius:3px;border-top-left-radius:3px}._3rY2q-pagination-sm>li:last-child>a,._3rY2q-pagination-sm>li:last-child>span{border-bottom-right-radius:3px;border-top-right-radius:3px}.bv_Mo-panel{margin-bottom:20px;background-color:#fff;border:1px solid transparent;border-radius:4px;-webkit-box-shadow:0 1px 1px rgba(0,0,0,0.05);box-shadow:0 1px 1px rgba(0,0,0,0.05)}._2wvLn-panel-body{padding:15px}._31mw8-panel-heading{padding:10px 15px;border-bottom:1px solid transparent;border-top-right-radius:3px;border-top-left-radius:3px}._31mw8-panel-heading>._3oGVD-dropdown ._2KnYE-dropdown-toggle{color:inherit}._112QQ-panel-title{margin-top:0;margin-bottom:0;font-size:16px;color:inherit}._112QQ-panel-title>a,._112QQ-panel-title>small,._112QQ-panel-title>._1xqEr-small,._112QQ-panel-title>small>a,._112QQ-panel-title>._1xqEr-small>a{color:inherit}._1qtb5-panel-footer{padding:10px 15px;background-color:#f5f5f5;border-top:1px solid #ddd;border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._1vMKW-list-group,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group{margin-bottom:0}.bv_Mo-panel>._1vMKW-list-group .rhZLN-list-group-item,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group .rhZLN-list-group-item{border-width:1px 0;border-radius:0}.bv_Mo-panel>._1vMKW-list-group:first-child .rhZLN-list-group-item:first-child,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group:first-child .rhZLN-list-group-item:first-child{border-top:0;border-top-right-radius:3px;border-top-left-radius:3px}.bv_Mo-panel>._1vMKW-list-group:last-child .rhZLN-list-group-item:last-child,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group:last-child .rhZLN-list-group-item:last-child{border-bottom:0;border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._31mw8-panel-heading+._162Ti-panel-collapse>._1vMKW-list-group .rhZLN-list-group-item:first-child{border-top-right-radius:0;border-top-left-radius:0}._31mw8-panel-heading+._1vMKW-list-group .rhZLN-list-group-item:first-child{border-top-width:0}._1vMKW-list-group+._1qtb5-panel-footer{border-top-width:0}.bv_Mo-panel>.fHH5l-table,.bv_Mo-panel>._3oGxj-table-responsive>.fHH5l-table,.bv_Mo-panel>._162Ti-panel-collapse>.fHH5l-table{margin-bottom:0}.bv_Mo-panel>.fHH5l-table caption,.bv_Mo-panel>._3oGxj-table-responsive>.fHH5l-table caption,.bv_Mo-panel>._162Ti-panel-collapse>.fHH5l-table caption{padding-left:15px;padding-right:15px}.bv_Mo-panel>.fHH5l-table:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child{border-top-right-radius:3px;border-top-left-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child{border-top-left-radius:3px;border-top-right-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child td:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:first-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child th:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:first-child{border-top-left-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child td:last-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:last-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child th:last-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:last-child{border-top-right-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child{border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child{border-bottom-left-radius:3px;border-bottom-right-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:first-child{border-bottom-left-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:last-child{border-bottom-right-radius:3px}.bv_Mo-panel>._2wvLn-panel-body+.fHH5l-table,.bv_Mo-panel>._2wvLn-panel-body+._3oGxj-table-responsive,.bv_Mo-panel>.fHH5l-table+._2wvLn-panel-body,.bv_Mo-panel>._3oGxj-table-responsive+._2wvLn-panel-body{border-top:1px solid #ddd}.bv_Mo-panel>.fHH5l-table>tbody:first-child>tr:first-child th,.bv_Mo-panel>.fHH5l-table>tbody:first-child>tr:first-child td{border-top:0}.bv_Mo-panel>._3qUkR-table-bordered,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered{border:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:first-child{border-left:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:last-child{border-right:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr:first-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr:first-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:first-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:first-child>td,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr:first-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr:first-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:first-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:first-child>th{border-bottom:0}.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:last-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr:last-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:last-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr:last-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>th{border-bottom:0}.bv_Mo-panel>._3oGxj-table-responsive{border:0;margin-bottom:0}._2V8WR-panel-group{margin-bottom:20px}._2V8WR-panel-group .bv_Mo-panel{margin-bottom:0;border-radius:4px}._2V8WR-panel-group .bv_Mo-panel+.bv_Mo-panel{margin-top:5px}._2V8WR-panel-group ._31mw8-panel-heading{border-bottom:0}._2V8WR-panel-group ._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body,._2V8WR-panel-group ._31mw8-panel-heading+._162Ti-panel-collapse>._1vMKW-list-group{border-top:1px solid #ddd}._2V8WR-panel-group ._1qtb5-panel-footer{border-top:0}._2V8WR-panel-group ._1qtb5-panel-footer+._162Ti-panel-collapse ._2wvLn-panel-body{border-bottom:1px solid #ddd}._2diFa-panel-default{border-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading{color:#333;background-color:#f5f5f5;border-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading ._1XX3z-badge{color:#f5f5f5;background-color:#333}._2diFa-panel-default>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#ddd}._1I52t-panel-primary{border-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading{color:#fff;background-color:#337ab7;border-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading ._1XX3z-badge{color:#337ab7;background-color:#fff}._1I52t-panel-primary>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#337ab7}._1mEg2-panel-success{border-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading{color:#3c763d;background-color:#dff0d8;border-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading ._1XX3z-badge{color:#dff0d8;background-color:#3c763d}._1mEg2-panel-success>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#d6e9c6}._2rUCo-panel-info{border-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading{color:#31708f;background-color:#d9edf7;border-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading ._1XX3z-badge{color:#d9edf7;background-color:#31708f}._2rUCo-panel-info>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#bce8f1}._1ozkU-panel-warning{border-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading{color:#8a6d3b;background-color:#fcf8e3;border-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading ._1XX3z-badge{color:#fcf8e3;background-color:#8a6d3b}._1ozkU-panel-warning>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#faebcc}._3dZ2--panel-danger{border-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading{color:#a94442;background-color:#f2dede;border-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading ._1XX3z-badge{color:#f2dede;background-color:#a94442}._3dZ2--panel-danger>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#ebccd1}._2U5Vc-clearfix:before,._2U5Vc-clearfix:after,._2DZom-dl-horizontal dd:before,._2DZom-dl-horizontal dd:after,.GfVfY-container:before,.GfVfY-container:after,._1TgHS-container-fluid:before,._1TgHS-container-fluid:after,._3XjgV-row:before,._3XjgV-row:after,._1TX6t-btn-toolbar:before,._1TX6t-btn-toolbar:after,.eNKyK-btn-group-vertical>._2yrMO-btn-group:before,.eNKyK-btn-group-vertical>._2yrMO-btn-group:after,._18V3M-nav:before,._18V3M-nav:after,._2wvLn-panel-body:before,._2wvLn-panel-body:after{content:" ";display:table}._2U5Vc-clearfix:after,._2DZom-dl-horizontal dd:after,.GfVfY-container:after,._1TgHS-container-fluid:after,._3XjgV-row:after,._1TX6t-btn-toolbar:after,.eNKyK-btn-group-vertical>._2yrMO-btn-group:after,._18V3M-nav:after,._2wvLn-panel-body:after{clear:both}._1efU5-center-block{display:block;margin-left:auto;margin-right:auto}._1DwIR-pull-right{float:right !important}._3XXWK-pull-left{float:left !important}.mceZN-hide{display:none !important}._3CqPx-show{display:block !important}._2ZgjX-invisible{visibility:hidden}._1y8Wm-text-hide{font:0/0 a;color:transparent;text-shadow:none;background-color:transparent;border:0}._15mml-hidden{display:none !important}.-JzKj-affix{position:fixed}@-ms-viewport{width:device-width}._3Pjmx-visible-xs,.vFgce-visible-sm,._3qTZ9-visible-md,.tk8mW-visible-lg{display:none !important}._1Dhak-visible-xs-block,._2NJ-T-visible-xs-inline,._1rjwZ-visible-xs-inline-block,._2cGpj-visible-sm-block,._14OBx-visible-sm-inline,._1IBCe-visible-sm-inline-block,._1b70N-visible-md-block,._2FNrX-visible-md-inline,._1MCNX-visible-md-inline-block,.yzCgL-visible-lg-block,._1Ad52-visible-lg-inline,._2hCmO-visible-lg-inline-block{display:none !important}@media (max-width:767px){._3Pjmx-visible-xs{display:block !important}table._3Pjmx-visible-xs{display:table !important}tr._3Pjmx-visible-xs{display:table-row !important}th._3Pjmx-visible-xs,td._3Pjmx-visible-xs{display:table-cell !important}}@media (max-width:767px){._1Dhak-visible-xs-block{display:block !important}}@media (max-width:767px){._2NJ-T-visible-xs-inline{display:inline !important}}@media (max-width:767px){._1rjwZ-visible-xs-inline-block{display:inline-block !important}}@media (min-width:768px) and (max-width:991px){.vFgce-visible-sm{display:block !important}table.vFgce-visible-sm{display:table !important}tr.vFgce-visible-sm{display:table-row !important}th.vFgce-visible-sm,td.vFgce-visible-sm{display:table-cell !important}}@media (min-width:768px) and (max-width:991px){._2cGpj-visible-sm-block{display:block !important}}@media (min-width:768px) and (max-width:991px){._14OBx-visible-sm-inline{display:inline !important}}@media (min-width:768px) and (max-width:991px){._1IBCe-visible-sm-inline-block{display:inline-block !important}}@media (min-width:992px) and (max-width:1199px){._3qTZ9-visible-md{display:block !important}table._3qTZ9-visible-md{display:table !important}tr._3qTZ9-visible-md{display:table-row !important}th._3qTZ9-visible-md,td._3qTZ9-visible-md{display:table-cell !important}}@media (min-width:992px) and (max-width:1199px){._1b70N-visible-md-block{display:block !important}}@media (min-width:992px) and (max-width:1199px){._2FNrX-visible-md-inline{display:inline !important}}@media (min-width:992px) and (max-width:1199px){._1MCNX-visible-md-inline-block{display:inline-block !important}}@media (min-width:1200px){.tk8mW-visible-lg{display:block !important}table.tk8mW-visible-lg{display:table !important}tr.tk8mW-visible-lg{display:table-row !important}th.tk8mW-visible-lg,td.tk8mW-visible-lg{display:table-cell !important}}@media (min-width:1200px){.yzCgL-visible-lg-block{display:block !important}}@media (min-width:1200px){._1Ad52-visible-lg-inline{display:inline !important}}@media (min-width:1200px){._2hCmO-visible-lg-inline-block{display:inline-block !important}}@media (max-width:767px){._1qlNJ-hidden-xs{display:none !important}}@media (min-width:768px) and (max-width:991px){._1UcP8-hidden-sm{display:none !important}}@media (min-width:992px) and (max-width:1199px){.TILkE-hidden-md{display:none !important}}@media (min-width:1200px){._2wXaD-hidden-lg{display:none !important}}._3ADjb-visible-print{display:none !important}@media print{._3ADjb-visible-print{display:block !important}table._3ADjb-visible-print{display:table !important}tr._3ADjb-visible-print{display:table-row !important}th._3ADjb-visible-print,td._3ADjb-visible-print{display:table-cell !important}}._3gdWc-visible-print-block{display:none !important}@media print{._3gdWc-visible-print-block{display:block !important}}._2HCJf-visible-print-inline{display:none !important}@media print{._2HCJf-visible-print-inline{display:inline !important}}._2oyVc-visible-print-inline-block{display:none !important}@media print{._2oyVc-visible-print-inline-block{display:inline-block !important}}@media print{.xjc1G-hidden-print{display:none !important}}',""]),t.locals={navbar:"xz-aB-navbar",btn:"_3-XUl-btn",caret:"WfXRY-caret",dropup:"xQM4X-dropup",label:"_3gkGS-label",table:"fHH5l-table","table-bordered":"_3qUkR-table-bordered",tableBordered:"_3qUkR-table-bordered","img-responsive":"_2zRts-img-responsive",imgResponsive:"_2zRts-img-responsive","img-rounded":"_141wW-img-rounded",imgRounded:"_141wW-img-rounded","img-thumbnail":"_3mPVB-img-thumbnail",imgThumbnail:"_3mPVB-img-thumbnail","img-circle":"_1pGGm-img-circle",imgCircle:"_1pGGm-img-circle","sr-only":"_2pd-Z-sr-only",srOnly:"_2pd-Z-sr-only","sr-only-focusable":"_2oRwu-sr-only-focusable",srOnlyFocusable:"_2oRwu-sr-only-focusable",h1:"FcDB9-h1",h2:"_2RtE8-h2",h3:"_2Hz9Y-h3",h4:"_20UE0-h4",h5:"_3ig4X-h5",h6:"_1IWbi-h6",small:"_1xqEr-small",lead:"BToLm-lead",mark:"_1CUfl-mark","text-left":"_1dEhG-text-left",textLeft:"_1dEhG-text-left","text-right":"_13-RL-text-right",textRight:"_13-RL-text-right","text-center":"_12Qkk-text-center",textCenter:"_12Qkk-text-center","text-justify":"_2IBJo-text-justify",textJustify:"_2IBJo-text-justify","text-nowrap":"_2D83n-text-nowrap",textNowrap:"_2D83n-text-nowrap","text-lowercase":"_3pILW-text-lowercase",textLowercase:"_3pILW-text-lowercase","text-uppercase":"EHGdF-text-uppercase",textUppercase:"EHGdF-text-uppercase","text-capitalize":"NKPoz-text-capitalize",textCapitalize:"NKPoz-text-capitalize","text-muted":"TLMiz-text-muted",textMuted:"TLMiz-text-muted","text-primary":"m-Vt1-text-primary",textPrimary:"m-Vt1-text-primary","text-success":"_1gJQD-text-success",textSuccess:"_1gJQD-text-success","text-info":"_3nKGJ-text-info",textInfo:"_3nKGJ-text-info","text-warning":"_1L8Pc-text-warning",textWarning:"_1L8Pc-text-warning","text-danger":"_2Qeqx-text-danger",textDanger:"_2Qeqx-text-danger","bg-primary":"VG86S-bg-primary",bgPrimary:"VG86S-bg-primary","bg-success":"Fh09a-bg-success",bgSuccess:"Fh09a-bg-success","bg-info":"_3D-5o-bg-info",bgInfo:"_3D-5o-bg-info","bg-warning":"_23YNn-bg-warning",bgWarning:"_23YNn-bg-warning","bg-danger":"_1g67A-bg-danger",bgDanger:"_1g67A-bg-danger","page-header":"_3ayLh-page-header",pageHeader:"_3ayLh-page-header","list-unstyled":"_2tb6R-list-unstyled",listUnstyled:"_2tb6R-list-unstyled","list-inline":"_2aPiu-list-inline",listInline:"_2aPiu-list-inline","dl-horizontal":"_2DZom-dl-horizontal",dlHorizontal:"_2DZom-dl-horizontal",initialism:"_39JkD-initialism","blockquote-reverse":"_3OkIL-blockquote-reverse",blockquoteReverse:"_3OkIL-blockquote-reverse","pull-right":"_1DwIR-pull-right",pullRight:"_1DwIR-pull-right",container:"GfVfY-container","container-fluid":"_1TgHS-container-fluid",containerFluid:"_1TgHS-container-fluid",row:"_3XjgV-row","col-xs-1":"_4I1Yd-col-xs-1",colXs1:"_4I1Yd-col-xs-1","col-sm-1":"ZjKa3-col-sm-1",colSm1:"ZjKa3-col-sm-1","col-md-1":"_2qQXc-col-md-1",colMd1:"_2qQXc-col-md-1","col-lg-1":"_20ta3-col-lg-1",colLg1:"_20ta3-col-lg-1","col-xs-2":"_2Dnpy-col-xs-2",colXs2:"_2Dnpy-col-xs-2","col-sm-2":"_1i2ug-col-sm-2",colSm2:"_1i2ug-col-sm-2","col-md-2":"_2t37W-col-md-2",colMd2:"_2t37W-col-md-2","col-lg-2":"_2Hl3y-col-lg-2",colLg2:"_2Hl3y-col-lg-2","col-xs-3":"OXp8_-col-xs-3",colXs3:"OXp8_-col-xs-3","col-sm-3":"_2Cn5F-col-sm-3",colSm3:"_2Cn5F-col-sm-3","col-md-3":"Ab3eH-col-md-3",colMd3:"Ab3eH-col-md-3","col-lg-3":"_26GsY-col-lg-3",colLg3:"_26GsY-col-lg-3","col-xs-4":"_2RAtz-col-xs-4",colXs4:"_2RAtz-col-xs-4","col-sm-4":"fa0Ir-col-sm-4",colSm4:"fa0Ir-col-sm-4","col-md-4":"hp0Eu-col-md-4",colMd4:"hp0Eu-col-md-4","col-lg-4":"_17v9q-col-lg-4",colLg4:"_17v9q-col-lg-4","col-xs-5":"_2Y3wR-col-xs-5",colXs5:"_2Y3wR-col-xs-5","col-sm-5":"_3h_BG-col-sm-5",colSm5:"_3h_BG-col-sm-5","col-md-5":"s-Mk2-col-md-5",colMd5:"s-Mk2-col-md-5","col-lg-5":"_3poT2-col-lg-5",colLg5:"_3poT2-col-lg-5","col-xs-6":"_2LLfi-col-xs-6",colXs6:"_2LLfi-col-xs-6","col-sm-6":"_2uvrS-col-sm-6",colSm6:"_2uvrS-col-sm-6","col-md-6":"_3aWb5-col-md-6",colMd6:"_3aWb5-col-md-6","col-lg-6":"_1kKt6-col-lg-6",colLg6:"_1kKt6-col-lg-6","col-xs-7":"_1lY1V-col-xs-7",colXs7:"_1lY1V-col-xs-7","col-sm-7":"_3MZfg-col-sm-7",colSm7:"_3MZfg-col-sm-7","col-md-7":"_3cJo6-col-md-7",colMd7:"_3cJo6-col-md-7","col-lg-7":"_3xzR0-col-lg-7",colLg7:"_3xzR0-col-lg-7","col-xs-8":"_2HxFx-col-xs-8",colXs8:"_2HxFx-col-xs-8","col-sm-8":"_2gidE-col-sm-8",colSm8:"_2gidE-col-sm-8","col-md-8":"_2PRaS-col-md-8",colMd8:"_2PRaS-col-md-8","col-lg-8":"LJ--s-col-lg-8",colLg8:"LJ--s-col-lg-8","col-xs-9":"_2oOfj-col-xs-9",colXs9:"_2oOfj-col-xs-9","col-sm-9":"_26BI4-col-sm-9",colSm9:"_26BI4-col-sm-9","col-md-9":"_2DhdG-col-md-9",colMd9:"_2DhdG-col-md-9","col-lg-9":"_3q6rI-col-lg-9",colLg9:"_3q6rI-col-lg-9","col-xs-10":"pmiBX-col-xs-10",colXs10:"pmiBX-col-xs-10","col-sm-10":"s5knd-col-sm-10",colSm10:"s5knd-col-sm-10","col-md-10":"_1oNCM-col-md-10",colMd10:"_1oNCM-col-md-10","col-lg-10":"_2DYWZ-col-lg-10",colLg10:"_2DYWZ-col-lg-10","col-xs-11":"yWnii-col-xs-11",colXs11:"yWnii-col-xs-11","col-sm-11":"liNUF-col-sm-11",colSm11:"liNUF-col-sm-11","col-md-11":"_2CmUe-col-md-11",colMd11:"_2CmUe-col-md-11","col-lg-11":"_1qjSD-col-lg-11",colLg11:"_1qjSD-col-lg-11","col-xs-12":"_2dgc--col-xs-12",colXs12:"_2dgc--col-xs-12","col-sm-12":"_3ylC5-col-sm-12",colSm12:"_3ylC5-col-sm-12","col-md-12":"_2DCWc-col-md-12",colMd12:"_2DCWc-col-md-12","col-lg-12":"_2FNrn-col-lg-12",colLg12:"_2FNrn-col-lg-12","col-xs-pull-12":"uk8LQ-col-xs-pull-12",colXsPull12:"uk8LQ-col-xs-pull-12","col-xs-pull-11":"nQP-X-col-xs-pull-11",colXsPull11:"nQP-X-col-xs-pull-11","col-xs-pull-10":"_3W2H_-col-xs-pull-10",colXsPull10:"_3W2H_-col-xs-pull-10","col-xs-pull-9":"_18qIh-col-xs-pull-9",colXsPull9:"_18qIh-col-xs-pull-9","col-xs-pull-8":"CEgpl-col-xs-pull-8",colXsPull8:"CEgpl-col-xs-pull-8","col-xs-pull-7":"_3UVMh-col-xs-pull-7",colXsPull7:"_3UVMh-col-xs-pull-7","col-xs-pull-6":"_1PEhv-col-xs-pull-6",colXsPull6:"_1PEhv-col-xs-pull-6","col-xs-pull-5":"VxFvW-col-xs-pull-5",colXsPull5:"VxFvW-col-xs-pull-5","col-xs-pull-4":"_3Wqpt-col-xs-pull-4",colXsPull4:"_3Wqpt-col-xs-pull-4","col-xs-pull-3":"_12Zed-col-xs-pull-3",colXsPull3:"_12Zed-col-xs-pull-3","col-xs-pull-2":"_3p-tQ-col-xs-pull-2",colXsPull2:"_3p-tQ-col-xs-pull-2","col-xs-pull-1":"RDEqX-col-xs-pull-1",colXsPull1:"RDEqX-col-xs-pull-1","col-xs-pull-0":"_2F1WQ-col-xs-pull-0",colXsPull0:"_2F1WQ-col-xs-pull-0","col-xs-push-12":"_1APvI-col-xs-push-12",colXsPush12:"_1APvI-col-xs-push-12","col-xs-push-11":"_3C73D-col-xs-push-11",colXsPush11:"_3C73D-col-xs-push-11","col-xs-push-10":"h56Hx-col-xs-push-10",colXsPush10:"h56Hx-col-xs-push-10","col-xs-push-9":"_1P93P-col-xs-push-9",colXsPush9:"_1P93P-col-xs-push-9","col-xs-push-8":"_2Nbww-col-xs-push-8",colXsPush8:"_2Nbww-col-xs-push-8","col-xs-push-7":"_1K3eA-col-xs-push-7",colXsPush7:"_1K3eA-col-xs-push-7","col-xs-push-6":"_2nBcT-col-xs-push-6",colXsPush6:"_2nBcT-col-xs-push-6","col-xs-push-5":"_3petP-col-xs-push-5",colXsPush5:"_3petP-col-xs-push-5","col-xs-push-4":"_2IqqW-col-xs-push-4",colXsPush4:"_2IqqW-col-xs-push-4","col-xs-push-3":"MCtu6-col-xs-push-3",colXsPush3:"MCtu6-col-xs-push-3","col-xs-push-2":"_3PMdr-col-xs-push-2",colXsPush2:"_3PMdr-col-xs-push-2","col-xs-push-1":"_1wU93-col-xs-push-1",colXsPush1:"_1wU93-col-xs-push-1","col-xs-push-0":"_1mQQp-col-xs-push-0",colXsPush0:"_1mQQp-col-xs-push-0","col-xs-offset-12":"_29EZj-col-xs-offset-12",colXsOffset12:"_29EZj-col-xs-offset-12","col-xs-offset-11":"_2hf-s-col-xs-offset-11",colXsOffset11:"_2hf-s-col-xs-offset-11","col-xs-offset-10":"PUiPu-col-xs-offset-10",colXsOffset10:"PUiPu-col-xs-offset-10","col-xs-offset-9":"_26WFR-col-xs-offset-9",colXsOffset9:"_26WFR-col-xs-offset-9","col-xs-offset-8":"_1cCHe-col-xs-offset-8",colXsOffset8:"_1cCHe-col-xs-offset-8","col-xs-offset-7":"_3XTt9-col-xs-offset-7",colXsOffset7:"_3XTt9-col-xs-offset-7","col-xs-offset-6":"_3ruq1-col-xs-offset-6",colXsOffset6:"_3ruq1-col-xs-offset-6","col-xs-offset-5":"_2Jvdw-col-xs-offset-5",colXsOffset5:"_2Jvdw-col-xs-offset-5","col-xs-offset-4":"_3Lc1D-col-xs-offset-4",colXsOffset4:"_3Lc1D-col-xs-offset-4","col-xs-offset-3":"_1MGVb-col-xs-offset-3",colXsOffset3:"_1MGVb-col-xs-offset-3","col-xs-offset-2":"_3VELV-col-xs-offset-2",colXsOffset2:"_3VELV-col-xs-offset-2","col-xs-offset-1":"_1qTH1-col-xs-offset-1",colXsOffset1:"_1qTH1-col-xs-offset-1","col-xs-offset-0":"_3p4mP-col-xs-offset-0",colXsOffset0:"_3p4mP-col-xs-offset-0","col-sm-pull-12":"_2lgaN-col-sm-pull-12",colSmPull12:"_2lgaN-col-sm-pull-12","col-sm-pull-11":"_9DE1R-col-sm-pull-11",colSmPull11:"_9DE1R-col-sm-pull-11","col-sm-pull-10":"kEzk--col-sm-pull-10",colSmPull10:"kEzk--col-sm-pull-10","col-sm-pull-9":"Nt2jd-col-sm-pull-9",colSmPull9:"Nt2jd-col-sm-pull-9","col-sm-pull-8":"_3swPC-col-sm-pull-8",colSmPull8:"_3swPC-col-sm-pull-8","col-sm-pull-7":"_3HVdW-col-sm-pull-7",colSmPull7:"_3HVdW-col-sm-pull-7","col-sm-pull-6":"wTEFa-col-sm-pull-6",colSmPull6:"wTEFa-col-sm-pull-6","col-sm-pull-5":"TLqCy-col-sm-pull-5",colSmPull5:"TLqCy-col-sm-pull-5","col-sm-pull-4":"_15_JC-col-sm-pull-4",colSmPull4:"_15_JC-col-sm-pull-4","col-sm-pull-3":"kKugK-col-sm-pull-3",colSmPull3:"kKugK-col-sm-pull-3","col-sm-pull-2":"_1gfwZ-col-sm-pull-2",colSmPull2:"_1gfwZ-col-sm-pull-2","col-sm-pull-1":"ULGBP-col-sm-pull-1",colSmPull1:"ULGBP-col-sm-pull-1","col-sm-pull-0":"_2761W-col-sm-pull-0",colSmPull0:"_2761W-col-sm-pull-0","col-sm-push-12":"vVqsv-col-sm-push-12",colSmPush12:"vVqsv-col-sm-push-12","col-sm-push-11":"_3rrzB-col-sm-push-11",colSmPush11:"_3rrzB-col-sm-push-11","col-sm-push-10":"_16iHZ-col-sm-push-10",colSmPush10:"_16iHZ-col-sm-push-10","col-sm-push-9":"_3gO4_-col-sm-push-9",colSmPush9:"_3gO4_-col-sm-push-9","col-sm-push-8":"_2iTaN-col-sm-push-8",colSmPush8:"_2iTaN-col-sm-push-8","col-sm-push-7":"_15MqT-col-sm-push-7",colSmPush7:"_15MqT-col-sm-push-7","col-sm-push-6":"_3WBCD-col-sm-push-6",colSmPush6:"_3WBCD-col-sm-push-6","col-sm-push-5":"_3yi6x-col-sm-push-5",colSmPush5:"_3yi6x-col-sm-push-5","col-sm-push-4":"Hi_tc-col-sm-push-4",colSmPush4:"Hi_tc-col-sm-push-4","col-sm-push-3":"_3CD2Y-col-sm-push-3",colSmPush3:"_3CD2Y-col-sm-push-3","col-sm-push-2":"_2KPVu-col-sm-push-2",colSmPush2:"_2KPVu-col-sm-push-2","col-sm-push-1":"_1vrte-col-sm-push-1",colSmPush1:"_1vrte-col-sm-push-1","col-sm-push-0":"_2iGSL-col-sm-push-0",colSmPush0:"_2iGSL-col-sm-push-0","col-sm-offset-12":"_2gBOF-col-sm-offset-12",colSmOffset12:"_2gBOF-col-sm-offset-12","col-sm-offset-11":"_1nkq--col-sm-offset-11",colSmOffset11:"_1nkq--col-sm-offset-11","col-sm-offset-10":"_3cbLF-col-sm-offset-10",colSmOffset10:"_3cbLF-col-sm-offset-10","col-sm-offset-9":"_1YqUk-col-sm-offset-9",colSmOffset9:"_1YqUk-col-sm-offset-9","col-sm-offset-8":"l5LT2-col-sm-offset-8",colSmOffset8:"l5LT2-col-sm-offset-8","col-sm-offset-7":"_1_lx0-col-sm-offset-7",colSmOffset7:"_1_lx0-col-sm-offset-7","col-sm-offset-6":"QftRx-col-sm-offset-6",colSmOffset6:"QftRx-col-sm-offset-6","col-sm-offset-5":"_1CiAA-col-sm-offset-5",colSmOffset5:"_1CiAA-col-sm-offset-5","col-sm-offset-4":"_3KOyM-col-sm-offset-4",colSmOffset4:"_3KOyM-col-sm-offset-4","col-sm-offset-3":"_3X8SS-col-sm-offset-3",colSmOffset3:"_3X8SS-col-sm-offset-3","col-sm-offset-2":"_2uxv1-col-sm-offset-2",colSmOffset2:"_2uxv1-col-sm-offset-2","col-sm-offset-1":"_3LDLJ-col-sm-offset-1",colSmOffset1:"_3LDLJ-col-sm-offset-1","col-sm-offset-0":"_2qEtK-col-sm-offset-0",colSmOffset0:"_2qEtK-col-sm-offset-0","col-md-pull-12":"bjQSr-col-md-pull-12",colMdPull12:"bjQSr-col-md-pull-12","col-md-pull-11":"_2R0gT-col-md-pull-11",colMdPull11:"_2R0gT-col-md-pull-11","col-md-pull-10":"_1ywn5-col-md-pull-10",colMdPull10:"_1ywn5-col-md-pull-10","col-md-pull-9":"Y3m-j-col-md-pull-9",colMdPull9:"Y3m-j-col-md-pull-9","col-md-pull-8":"_2kyk9-col-md-pull-8",colMdPull8:"_2kyk9-col-md-pull-8","col-md-pull-7":"dnrMG-col-md-pull-7",colMdPull7:"dnrMG-col-md-pull-7","col-md-pull-6":"_1zNYf-col-md-pull-6",colMdPull6:"_1zNYf-col-md-pull-6","col-md-pull-5":"_1lgxH-col-md-pull-5",colMdPull5:"_1lgxH-col-md-pull-5","col-md-pull-4":"fI_-S-col-md-pull-4",colMdPull4:"fI_-S-col-md-pull-4","col-md-pull-3":"_1yC1r-col-md-pull-3",colMdPull3:"_1yC1r-col-md-pull-3","col-md-pull-2":"_2ruK1-col-md-pull-2",colMdPull2:"_2ruK1-col-md-pull-2","col-md-pull-1":"_3pkxQ-col-md-pull-1",colMdPull1:"_3pkxQ-col-md-pull-1","col-md-pull-0":"_2NDft-col-md-pull-0",colMdPull0:"_2NDft-col-md-pull-0","col-md-push-12":"_2dklO-col-md-push-12",colMdPush12:"_2dklO-col-md-push-12","col-md-push-11":"_2O3TS-col-md-push-11",colMdPush11:"_2O3TS-col-md-push-11","col-md-push-10":"_3H7Y4-col-md-push-10",colMdPush10:"_3H7Y4-col-md-push-10","col-md-push-9":"_2PIS0-col-md-push-9",colMdPush9:"_2PIS0-col-md-push-9","col-md-push-8":"_1qQQi-col-md-push-8",colMdPush8:"_1qQQi-col-md-push-8","col-md-push-7":"Y8MRT-col-md-push-7",colMdPush7:"Y8MRT-col-md-push-7","col-md-push-6":"_3_TWQ-col-md-push-6",colMdPush6:"_3_TWQ-col-md-push-6","col-md-push-5":"_3KOrj-col-md-push-5",colMdPush5:"_3KOrj-col-md-push-5","col-md-push-4":"_2PuS8-col-md-push-4",colMdPush4:"_2PuS8-col-md-push-4","col-md-push-3":"_2zI-_-col-md-push-3",colMdPush3:"_2zI-_-col-md-push-3","col-md-push-2":"_3Drij-col-md-push-2",colMdPush2:"_3Drij-col-md-push-2","col-md-push-1":"_1M7-4-col-md-push-1",colMdPush1:"_1M7-4-col-md-push-1","col-md-push-0":"_1kPaw-col-md-push-0",colMdPush0:"_1kPaw-col-md-push-0","col-md-offset-12":"_3PwfG-col-md-offset-12",colMdOffset12:"_3PwfG-col-md-offset-12","col-md-offset-11":"sHbiw-col-md-offset-11",colMdOffset11:"sHbiw-col-md-offset-11","col-md-offset-10":"_3geq1-col-md-offset-10",colMdOffset10:"_3geq1-col-md-offset-10","col-md-offset-9":"_3ypJc-col-md-offset-9",colMdOffset9:"_3ypJc-col-md-offset-9","col-md-offset-8":"ydDuS-col-md-offset-8",colMdOffset8:"ydDuS-col-md-offset-8","col-md-offset-7":"_1MS3B-col-md-offset-7",colMdOffset7:"_1MS3B-col-md-offset-7","col-md-offset-6":"_1A80h-col-md-offset-6",colMdOffset6:"_1A80h-col-md-offset-6","col-md-offset-5":"_3g3p_-col-md-offset-5",colMdOffset5:"_3g3p_-col-md-offset-5","col-md-offset-4":"_2FjQc-col-md-offset-4",colMdOffset4:"_2FjQc-col-md-offset-4","col-md-offset-3":"Xebzn-col-md-offset-3",colMdOffset3:"Xebzn-col-md-offset-3","col-md-offset-2":"Xw9rm-col-md-offset-2",colMdOffset2:"Xw9rm-col-md-offset-2","col-md-offset-1":"CToHd-col-md-offset-1",colMdOffset1:"CToHd-col-md-offset-1","col-md-offset-0":"oXEcL-col-md-offset-0",colMdOffset0:"oXEcL-col-md-offset-0","col-lg-pull-12":"_32_we-col-lg-pull-12",colLgPull12:"_32_we-col-lg-pull-12","col-lg-pull-11":"_24eEw-col-lg-pull-11",colLgPull11:"_24eEw-col-lg-pull-11","col-lg-pull-10":"_29qaq-col-lg-pull-10",colLgPull10:"_29qaq-col-lg-pull-10","col-lg-pull-9":"_1S7Yo-col-lg-pull-9",colLgPull9:"_1S7Yo-col-lg-pull-9","col-lg-pull-8":"_2CgOB-col-lg-pull-8",colLgPull8:"_2CgOB-col-lg-pull-8","col-lg-pull-7":"_3Jv0L-col-lg-pull-7",colLgPull7:"_3Jv0L-col-lg-pull-7","col-lg-pull-6":"_1pHMz-col-lg-pull-6",colLgPull6:"_1pHMz-col-lg-pull-6","col-lg-pull-5":"_2zvsy-col-lg-pull-5",colLgPull5:"_2zvsy-col-lg-pull-5","col-lg-pull-4":"sFsmY-col-lg-pull-4",colLgPull4:"sFsmY-col-lg-pull-4","col-lg-pull-3":"Ui3Ia-col-lg-pull-3",colLgPull3:"Ui3Ia-col-lg-pull-3","col-lg-pull-2":"WmgXv-col-lg-pull-2",colLgPull2:"WmgXv-col-lg-pull-2","col-lg-pull-1":"_2KMEm-col-lg-pull-1",colLgPull1:"_2KMEm-col-lg-pull-1","col-lg-pull-0":"QWRTc-col-lg-pull-0",colLgPull0:"QWRTc-col-lg-pull-0","col-lg-push-12":"_2FOMW-col-lg-push-12",colLgPush12:"_2FOMW-col-lg-push-12","col-lg-push-11":"IdWzr-col-lg-push-11",colLgPush11:"IdWzr-col-lg-push-11","col-lg-push-10":"_3qw3b-col-lg-push-10",colLgPush10:"_3qw3b-col-lg-push-10","col-lg-push-9":"_3LXj0-col-lg-push-9",colLgPush9:"_3LXj0-col-lg-push-9","col-lg-push-8":"_16qiV-col-lg-push-8",colLgPush8:"_16qiV-col-lg-push-8","col-lg-push-7":"_2OQk6-col-lg-push-7",colLgPush7:"_2OQk6-col-lg-push-7","col-lg-push-6":"_3o1se-col-lg-push-6",colLgPush6:"_3o1se-col-lg-push-6","col-lg-push-5":"_2dmyK-col-lg-push-5",colLgPush5:"_2dmyK-col-lg-push-5","col-lg-push-4":"_3pru9-col-lg-push-4",colLgPush4:"_3pru9-col-lg-push-4","col-lg-push-3":"_2lBUY-col-lg-push-3",colLgPush3:"_2lBUY-col-lg-push-3","col-lg-push-2":"_3FuDW-col-lg-push-2",colLgPush2:"_3FuDW-col-lg-push-2","col-lg-push-1":"_2YWH--col-lg-push-1",colLgPush1:"_2YWH--col-lg-push-1","col-lg-push-0":"_1iVNw-col-lg-push-0",colLgPush0:"_1iVNw-col-lg-push-0","col-lg-offset-12":"uQRYD-col-lg-offset-12",colLgOffset12:"uQRYD-col-lg-offset-12","col-lg-offset-11":"_1tWxb-col-lg-offset-11",colLgOffset11:"_1tWxb-col-lg-offset-11","col-lg-offset-10":"_10I1r-col-lg-offset-10",colLgOffset10:"_10I1r-col-lg-offset-10","col-lg-offset-9":"_3zXRb-col-lg-offset-9",colLgOffset9:"_3zXRb-col-lg-offset-9","col-lg-offset-8":"_3HNmx-col-lg-offset-8",colLgOffset8:"_3HNmx-col-lg-offset-8","col-lg-offset-7":"_3mIkn-col-lg-offset-7",colLgOffset7:"_3mIkn-col-lg-offset-7","col-lg-offset-6":"_22SUB-col-lg-offset-6",colLgOffset6:"_22SUB-col-lg-offset-6","col-lg-offset-5":"_1CR4c-col-lg-offset-5",colLgOffset5:"_1CR4c-col-lg-offset-5","col-lg-offset-4":"_1AWkt-col-lg-offset-4",colLgOffset4:"_1AWkt-col-lg-offset-4","col-lg-offset-3":"_2BzzA-col-lg-offset-3",colLgOffset3:"_2BzzA-col-lg-offset-3","col-lg-offset-2":"_3Egxi-col-lg-offset-2",colLgOffset2:"_3Egxi-col-lg-offset-2","col-lg-offset-1":"_3QHjG-col-lg-offset-1",colLgOffset1:"_3QHjG-col-lg-offset-1","col-lg-offset-0":"_3jTkc-col-lg-offset-0",colLgOffset0:"_3jTkc-col-lg-offset-0","table-condensed":"_1lZ-G-table-condensed",tableCondensed:"_1lZ-G-table-condensed","table-striped":"_2ysFO-table-striped",tableStriped:"_2ysFO-table-striped","table-hover":"_3Qd5Z-table-hover",tableHover:"_3Qd5Z-table-hover",active:"_2tvYY-active",success:"_2OgG2-success",info:"_2ItI5-info",warning:"KrRM8-warning",danger:"CwCgN-danger","table-responsive":"_3oGxj-table-responsive",tableResponsive:"_3oGxj-table-responsive",focus:"_2eLCD-focus",disabled:"_2UPmc-disabled","btn-default":"_7-4ed-btn-default",btnDefault:"_7-4ed-btn-default",open:"cl_nk-open","dropdown-toggle":"_2KnYE-dropdown-toggle",dropdownToggle:"_2KnYE-dropdown-toggle",badge:"_1XX3z-badge","btn-primary":"_1VbPL-btn-primary",btnPrimary:"_1VbPL-btn-primary","btn-success":"_1P0kt-btn-success",btnSuccess:"_1P0kt-btn-success","btn-info":"_25yw2-btn-info",btnInfo:"_25yw2-btn-info","btn-warning":"-tgFO-btn-warning",btnWarning:"-tgFO-btn-warning","btn-danger":"_1fOWb-btn-danger",btnDanger:"_1fOWb-btn-danger","btn-link":"_2k5vU-btn-link",btnLink:"_2k5vU-btn-link","btn-lg":"_4Y8qI-btn-lg",btnLg:"_4Y8qI-btn-lg","btn-group-lg":"_29CyS-btn-group-lg",btnGroupLg:"_29CyS-btn-group-lg","btn-sm":"_30Oip-btn-sm",btnSm:"_30Oip-btn-sm","btn-group-sm":"_2eEOC-btn-group-sm",btnGroupSm:"_2eEOC-btn-group-sm","btn-xs":"_2kCvE-btn-xs",btnXs:"_2kCvE-btn-xs","btn-group-xs":"_1A2Tx-btn-group-xs",btnGroupXs:"_1A2Tx-btn-group-xs","btn-block":"_3micb-btn-block",btnBlock:"_3micb-btn-block","btn-group":"_2yrMO-btn-group",btnGroup:"_2yrMO-btn-group","btn-group-vertical":"eNKyK-btn-group-vertical",btnGroupVertical:"eNKyK-btn-group-vertical","btn-toolbar":"_1TX6t-btn-toolbar",btnToolbar:"_1TX6t-btn-toolbar","input-group":"_2lgpZ-input-group",inputGroup:"_2lgpZ-input-group","btn-group-justified":"gzM9c-btn-group-justified",btnGroupJustified:"gzM9c-btn-group-justified","dropdown-menu":"_5aG9w-dropdown-menu",dropdownMenu:"_5aG9w-dropdown-menu",nav:"_18V3M-nav","nav-divider":"_3jwhe-nav-divider",navDivider:"_3jwhe-nav-divider","nav-tabs":"_2ciYP-nav-tabs",navTabs:"_2ciYP-nav-tabs","nav-justified":"_3nLJA-nav-justified",navJustified:"_3nLJA-nav-justified",dropdown:"_3oGVD-dropdown","nav-pills":"_3i5kW-nav-pills",navPills:"_3i5kW-nav-pills","nav-stacked":"_3pfVZ-nav-stacked",navStacked:"_3pfVZ-nav-stacked","nav-tabs-justified":"_18sQL-nav-tabs-justified",navTabsJustified:"_18sQL-nav-tabs-justified","tab-content":"FgbPD-tab-content",tabContent:"FgbPD-tab-content","tab-pane":"_2q1VF-tab-pane",tabPane:"_2q1VF-tab-pane",pagination:"n15Mn-pagination","pagination-lg":"L5I4g-pagination-lg",paginationLg:"L5I4g-pagination-lg","pagination-sm":"_3rY2q-pagination-sm",paginationSm:"_3rY2q-pagination-sm",panel:"bv_Mo-panel","panel-body":"_2wvLn-panel-body",panelBody:"_2wvLn-panel-body","panel-heading":"_31mw8-panel-heading",panelHeading:"_31mw8-panel-heading","panel-title":"_112QQ-panel-title",panelTitle:"_112QQ-panel-title","panel-footer":"_1qtb5-panel-footer",panelFooter:"_1qtb5-panel-footer","list-group":"_1vMKW-list-group",listGroup:"_1vMKW-list-group","panel-collapse":"_162Ti-panel-collapse",panelCollapse:"_162Ti-panel-collapse","list-group-item":"rhZLN-list-group-item",listGroupItem:"rhZLN-list-group-item","panel-group":"_2V8WR-panel-group",panelGroup:"_2V8WR-panel-group","panel-default":"_2diFa-panel-default",panelDefault:"_2diFa-panel-default","panel-primary":"_1I52t-panel-primary",panelPrimary:"_1I52t-panel-primary","panel-success":"_1mEg2-panel-success",panelSuccess:"_1mEg2-panel-success","panel-info":"_2rUCo-panel-info",panelInfo:"_2rUCo-panel-info","panel-warning":"_1ozkU-panel-warning",panelWarning:"_1ozkU-panel-warning","panel-danger":"_3dZ2--panel-danger",panelDanger:"_3dZ2--panel-danger",clearfix:"_2U5Vc-clearfix","center-block":"_1efU5-center-block",centerBlock:"_1efU5-center-block","pull-left":"_3XXWK-pull-left",pullLeft:"_3XXWK-pull-left",hide:"mceZN-hide",show:"_3CqPx-show",invisible:"_2ZgjX-invisible","text-hide":"_1y8Wm-text-hide",textHide:"_1y8Wm-text-hide",hidden:"_15mml-hidden",affix:"-JzKj-affix","visible-xs":"_3Pjmx-visible-xs",visibleXs:"_3Pjmx-visible-xs","visible-sm":"vFgce-visible-sm",visibleSm:"vFgce-visible-sm","visible-md":"_3qTZ9-visible-md",visibleMd:"_3qTZ9-visible-md","visible-lg":"tk8mW-visible-lg",visibleLg:"tk8mW-visible-lg","visible-xs-block":"_1Dhak-visible-xs-block",visibleXsBlock:"_1Dhak-visible-xs-block","visible-xs-inline":"_2NJ-T-visible-xs-inline",visibleXsInline:"_2NJ-T-visible-xs-inline","visible-xs-inline-block":"_1rjwZ-visible-xs-inline-block",visibleXsInlineBlock:"_1rjwZ-visible-xs-inline-block","visible-sm-block":"_2cGpj-visible-sm-block",visibleSmBlock:"_2cGpj-visible-sm-block","visible-sm-inline":"_14OBx-visible-sm-inline",visibleSmInline:"_14OBx-visible-sm-inline","visible-sm-inline-block":"_1IBCe-visible-sm-inline-block",visibleSmInlineBlock:"_1IBCe-visible-sm-inline-block","visible-md-block":"_1b70N-visible-md-block",visibleMdBlock:"_1b70N-visible-md-block","visible-md-inline":"_2FNrX-visible-md-inline",visibleMdInline:"_2FNrX-visible-md-inline","visible-md-inline-block":"_1MCNX-visible-md-inline-block",visibleMdInlineBlock:"_1MCNX-visible-md-inline-block","visible-lg-block":"yzCgL-visible-lg-block",visibleLgBlock:"yzCgL-visible-lg-block","visible-lg-inline":"_1Ad52-visible-lg-inline",visibleLgInline:"_1Ad52-visible-lg-inline","visible-lg-inline-block":"_2hCmO-visible-lg-inline-block",visibleLgInlineBlock:"_2hCmO-visible-lg-inline-block","hidden-xs":"_1qlNJ-hidden-xs",hiddenXs:"_1qlNJ-hidden-xs","hidden-sm":"_1UcP8-hidden-sm",hiddenSm:"_1UcP8-hidden-sm","hidden-md":"TILkE-hidden-md",hiddenMd:"TILkE-hidden-md","hidden-lg":"_2wXaD-hidden-lg",hiddenLg:"_2wXaD-hidden-lg","visible-print":"_3ADjb-visible-print",visiblePrint:"_3ADjb-visible-print","visible-print-block":"_3gdWc-visible-print-block",visiblePrintBlock:"_3gdWc-visible-print-block","visible-print-inline":"_2HCJf-visible-print-inline",visiblePrintInline:"_2HCJf-visible-print-inline","visible-print-inline-block":"_2oyVc-visible-print-inline-block",visiblePrintInlineBlock:"_2oyVc-visible-print-inline-block","hidden-print":"xjc1G-hidden-print",hiddenPrint:"xjc1G-hidden-print"}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(19),p=o(f),b=n(15),h=o(b),m=n(7),g=o(m),_=n(8),v=o(_),y=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,c=Array(i),s=0;s<i;s++)c[s]=arguments[s];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(c))),o.state={selected:[],page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.fetchURL&&fetch(this.props.fetchURL+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({selected:[],count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"checked",value:function(e){return this.state.selected.includes(e)}},{key:"check",value:function(e){var t=this.state.selected.indexOf(e);t>=0?this.state.selected.splice(t,1):this.state.selected.push(e),this.setState({selected:this.state.selected})}},{key:"checkAll",value:function(){var e=this;this.state.selected.length>0?this.setState({selected:[]}):(this.state.jobs.map(function(t){e.state.selected.push(t)}),this.setState({selected:this.state.selected}))}},{key:"deleteAll",value:function(){var e=this;this.props.deleteAllURL&&fetch(this.props.deleteAllURL,{method:"post",headers:{"Content-Type":"application/json"}}).then(function(){e.updatePage(1)})}},{key:"deleteSelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.deleteURL&&t.push(fetch(e.props.deleteURL+"/"+n.died_at+"/"+n.id,{method:"post",headers:{"Content-Type":"application/json"}}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"retryAll",value:function(){var e=this;this.props.retryAllURL&&fetch(this.props.retryAllURL,{method:"post",headers:{"Content-Type":"application/json"}}).then(function(){e.updatePage(1)})}},{key:"retrySelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.retryURL&&t.push(fetch(e.props.retryURL+"/"+n.died_at+"/"+n.id,{method:"post",headers:{"Content-Type":"application/json"}}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"render",value:function(){var e=this;return s.default.createElement("div",null,s.default.createElement("div",{className:(0,v.default)(g.default.panel,g.default.panelDefault)},s.default.createElement("div",{className:g.default.panelHeading},"Dead Jobs"),s.default.createElement("div",{className:g.default.panelBody},s.default.createElement("p",null,this.state.count," job(s) are dead."),s.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),s.default.createElement("div",{className:g.default.tableResponsive},s.default.createElement("table",{className:g.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("th",null,s.default.createElement("input",{type:"checkbox",checked:this.state.selected.length>0,onChange:function(){return e.checkAll()}})),s.default.createElement("th",null,"Name"),s.default.createElement("th",null,"Arguments"),s.default.createElement("th",null,"Error"),s.default.createElement("th",null,"Died At")),this.state.jobs.map(function(t){return s.default.createElement("tr",{key:t.id},s.default.createElement("td",null,s.default.createElement("input",{type:"checkbox",checked:e.checked(t),onChange:function(){return e.check(t)}})),s.default.createElement("td",null,t.name),s.default.createElement("td",null,JSON.stringify(t.args)),s.default.createElement("td",null,t.err),s.default.createElement("td",null,s.default.createElement(h.default,{ts:t.t})))}))))),s.default.createElement("div",{className:g.default.btnGroup,role:"group"},s.default.createElement("button",{type:"button",className:(0,v.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.deleteSelected()}},"Delete Selected Jobs"),s.default.createElement("button",{type:"button",className:(0,v.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.retrySelected()}},"Retry Selected Jobs"),s.default.createElement("button",{type:"button",className:(0,v.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.deleteAll()}},"Delete All Jobs"),s.default.createElement("button",{type:"button",className:(0,v.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.retryAll()}},"Retry All Jobs")))}}]),t}(s.default.Component);y.propTypes={fetchURL:d.default.string,deleteURL:d.default.string,deleteAllURL:d.default.string,retryURL:d.default.string,retryAllURL:d.default.string},t.default=y},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(7),p=o(f),b=n(8),h=o(b),m=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,c=Array(i),s=0;s<i;s++)c[s]=arguments[s];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(c))),o.state={queues:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"componentWillMount",value:function(){var e=this;this.props.url&&fetch(this.props.url).then(function(e){return e.json()}).then(function(t){e.setState({queues:t})})}},{key:"render",value:function(){return s.default.createElement("div",{className:(0,h.default)(p.default.panel,p.default.panelDefault)},s.default.createElement("div",{className:p.default.panelHeading},"queues"),s.default.createElement("div",{className:p.default.panelBody},s.default.createElement("p",null,this.state.queues.length," queue(s) with a total of ",this.queuedCount," item(s) queued.")),s.default.createElement("div",{className:p.default.tableResponsive},s.default.createElement("table",{className:p.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("th",null,"Name"),s.default.createElement("th",null,"Count"),s.default.createElement("th",null,"Latency (seconds)")),this.state.queues.map(function(e){return s.default.createElement("tr",{key:e.job_name},s.default.createElement("td",null,e.job_name),s.default.createElement("td",null,e.count),s.default.createElement("td",null,e.latency))})))))}},{key:"queuedCount",get:function(){var e=0;return this.state.queues.map(function(t){e+=t.count}),e}}]),t}(s.default.Component);m.propTypes={url:d.default.string},t.default=m},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(19),p=o(f),b=n(15),h=o(b),m=n(7),g=o(m),_=n(8),v=o(_),y=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,c=Array(i),s=0;s<i;s++)c[s]=arguments[s];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(c))),o.state={page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return s.default.createElement("div",{className:(0,v.default)(g.default.panel,g.default.panelDefault)},s.default.createElement("div",{className:g.default.panelHeading},"Retry Jobs"),s.default.createElement("div",{className:g.default.panelBody},s.default.createElement("p",null,this.state.count," job(s) scheduled to be retried."),s.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),s.default.createElement("div",{className:g.default.tableResponsive},s.default.createElement("table",{className:g.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("th",null,"Name"),s.default.createElement("th",null,"Arguments"),s.default.createElement("th",null,"Error"),s.default.createElement("th",null,"Retry At")),this.state.jobs.map(function(e){return s.default.createElement("tr",{key:e.id},s.default.createElement("td",null,e.name),s.default.createElement("td",null,JSON.stringify(e.args)),s.default.createElement("td",null,e.err),s.default.createElement("td",null,s.default.createElement(h.default,{ts:e.t})))})))))}}]),t}(s.default.Component);y.propTypes={url:d.default.string},t.default=y},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),c=n(1),s=o(c),u=n(0),d=o(u),f=n(19),p=o(f),b=n(15),h=o(b),m=n(7),g=o(m),_=n(8),v=o(_),y=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,c=Array(i),s=0;s<i;s++)c[s]=arguments[s];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(c))),o.state={page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return s.default.createElement("div",{className:(0,v.default)(g.default.panel,g.default.panelDefault)},s.default.createElement("div",{className:g.default.panelHeading},"Scheduled Jobs"),s.default.createElement("div",{className:g.default.panelBody},s.default.createElement("p",null,this.state.count," job(s) scheduled."),s.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),s.default.createElement("div",{className:g.default.tableResponsive},s.default.createElement("table",{className:g.default.table},s.default.createElement("tbody",null,s.default.createElement("tr",null,s.default.createElement("th",null,"Name"),s.default.createElement("th",null,"Arguments"),s.default.createElement("th",null,"Scheduled For")),this.state.jobs.map(function(e){return s.default.createElement("tr",{key:e.id},s.default.createElement("td",null,e.name),s.default.createElement("td",null,JSON.stringify(e.args)),s.default.createElement("td",null,s.default.createElement(h.default,{ts:e.run_at})))})))))}}]),t}(s.default.Component);y.propTypes={url:d.default.string},t.default=y},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0});var o=n(67);n.d(t,"Router",function(){return o.a});var r=n(34);n.d(t,"Link",function(){return r.a});var l=n(75);n.d(t,"IndexLink",function(){return l.a});var a=n(76);n.d(t,"withRouter",function(){return a.a});var i=n(78);n.d(t,"IndexRedirect",function(){return i.a});var c=n(79);n.d(t,"IndexRoute",function(){return c.a});var s=n(35);n.d(t,"Redirect",function(){return s.a});var u=n(80);n.d(t,"Route",function(){return u.a});var d=n(5);n.d(t,"createRoutes",function(){return d.b});var f=n(21);n.d(t,"RouterContext",function(){return f.a});var p=n(23);n.d(t,"locationShape",function(){return p.a}),n.d(t,"routerShape",function(){return p.b});var b=n(81);n.d(t,"match",function(){return b.a});var h=n(39);n.d(t,"useRouterHistory",function(){return h.a});var m=n(10);n.d(t,"formatPattern",function(){return m.a});var g=n(86);n.d(t,"applyRouterMiddleware",function(){return g.a});var _=n(87);n.d(t,"browserHistory",function(){return _.a});var v=n(90);n.d(t,"hashHistory",function(){return v.a});var y=n(36);n.d(t,"createMemoryHistory",function(){return y.a})},function(e,t,n){"use strict";function o(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}var r=n(2),l=n.n(r),a=n(1),i=n.n(a),c=n(3),s=n.n(c),u=n(0),d=(n.n(u),n(31)),f=n(12),p=n(21),b=n(5),h=n(33),m=(n(9),Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}),g={history:u.object,children:f.d,routes:f.d,render:u.func,createElement:u.func,onError:u.func,onUpdate:u.func,matchContext:u.object},_=s()({displayName:"Router",propTypes:g,getDefaultProps:function(){return{render:function(e){return i.a.createElement(p.a,e)}}},getInitialState:function(){return{location:null,routes:null,params:null,components:null}},handleError:function(e){if(!this.props.onError)throw e;this.props.onError.call(this,e)},createRouterObject:function(e){var t=this.props.matchContext;if(t)return t.router;var n=this.props.history;return Object(h.b)(n,this.transitionManager,e)},createTransitionManager:function(){var e=this.props.matchContext;if(e)return e.transitionManager;var t=this.props.history,n=this.props,o=n.routes,r=n.children;return t.getCurrentLocation||l()(!1),Object(d.a)(t,Object(b.b)(o||r))},componentWillMount:function(){var e=this;this.transitionManager=this.createTransitionManager(),this.router=this.createRouterObject(this.state),this._unlisten=this.transitionManager.listen(function(t,n){t?e.handleError(t):(Object(h.a)(e.router,n),e.setState(n,e.props.onUpdate))})},componentWillReceiveProps:function(e){},componentWillUnmount:function(){this._unlisten&&this._unlisten()},render:function(){var e=this.state,t=e.location,n=e.routes,r=e.params,l=e.components,a=this.props,i=a.createElement,c=a.render,s=o(a,["createElement","render"]);return null==t?null:(Object.keys(g).forEach(function(e){return delete s[e]}),c(m({},s,{router:this.router,location:t,routes:n,params:r,components:l,createElement:i})))}});t.a=_},function(e,t,n){"use strict";function o(e){return e}function r(e,t,n){function r(e,t){var n=_.hasOwnProperty(t)?_[t]:null;w.hasOwnProperty(t)&&i("OVERRIDE_BASE"===n,"ReactClassInterface: You are attempting to override `%s` from your class specification. Ensure that your method names do not overlap with React methods.",t),e&&i("DEFINE_MANY"===n||"DEFINE_MANY_MERGED"===n,"ReactClassInterface: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",t)}function s(e,n){if(n){i("function"!=typeof n,"ReactClass: You're attempting to use a component class or function as a mixin. Instead, just use a regular object."),i(!t(n),"ReactClass: You're attempting to use a component as a mixin. Instead, just use a regular object.");var o=e.prototype,l=o.__reactAutoBindPairs;n.hasOwnProperty(c)&&v.mixins(e,n.mixins);for(var a in n)if(n.hasOwnProperty(a)&&a!==c){var s=n[a],u=o.hasOwnProperty(a);if(r(u,a),v.hasOwnProperty(a))v[a](e,s);else{var d=_.hasOwnProperty(a),b="function"==typeof s,h=b&&!d&&!u&&!1!==n.autobind;if(h)l.push(a,s),o[a]=s;else if(u){var m=_[a];i(d&&("DEFINE_MANY_MERGED"===m||"DEFINE_MANY"===m),"ReactClass: Unexpected spec policy %s for key %s when mixing in component specs.",m,a),"DEFINE_MANY_MERGED"===m?o[a]=f(o[a],s):"DEFINE_MANY"===m&&(o[a]=p(o[a],s))}else o[a]=s}}}else;}function u(e,t){if(t)for(var n in t){var o=t[n];if(t.hasOwnProperty(n)){var r=n in v;i(!r,'ReactClass: You are attempting to define a reserved property, `%s`, that shouldn\'t be on the "statics" key. Define it as an instance property instead; it will still be accessible on the constructor.',n);var l=n in e;i(!l,"ReactClass: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",n),e[n]=o}}}function d(e,t){i(e&&t&&"object"==typeof e&&"object"==typeof t,"mergeIntoWithNoDuplicateKeys(): Cannot merge non-objects.");for(var n in t)t.hasOwnProperty(n)&&(i(void 0===e[n],"mergeIntoWithNoDuplicateKeys(): Tried to merge two objects with the same key: `%s`. This conflict may be due to a mixin; in particular, this may be caused by two getInitialState() or getDefaultProps() methods returning objects with clashing keys.",n),e[n]=t[n]);return e}function f(e,t){return function(){var n=e.apply(this,arguments),o=t.apply(this,arguments);if(null==n)return o;if(null==o)return n;var r={};return d(r,n),d(r,o),r}}function p(e,t){return function(){e.apply(this,arguments),t.apply(this,arguments)}}function b(e,t){var n=t.bind(e);return n}function h(e){for(var t=e.__reactAutoBindPairs,n=0;n<t.length;n+=2){var o=t[n],r=t[n+1];e[o]=b(e,r)}}function m(e){var t=o(function(e,o,r){this.__reactAutoBindPairs.length&&h(this),this.props=e,this.context=o,this.refs=a,this.updater=r||n,this.state=null;var l=this.getInitialState?this.getInitialState():null;i("object"==typeof l&&!Array.isArray(l),"%s.getInitialState(): must return an object or null",t.displayName||"ReactCompositeComponent"),this.state=l});t.prototype=new k,t.prototype.constructor=t,t.prototype.__reactAutoBindPairs=[],g.forEach(s.bind(null,t)),s(t,y),s(t,e),s(t,x),t.getDefaultProps&&(t.defaultProps=t.getDefaultProps()),i(t.prototype.render,"createClass(...): Class specification must implement a `render` method.");for(var r in _)t.prototype[r]||(t.prototype[r]=null);return t}var g=[],_={mixins:"DEFINE_MANY",statics:"DEFINE_MANY",propTypes:"DEFINE_MANY",contextTypes:"DEFINE_MANY",childContextTypes:"DEFINE_MANY",getDefaultProps:"DEFINE_MANY_MERGED",getInitialState:"DEFINE_MANY_MERGED",getChildContext:"DEFINE_MANY_MERGED",render:"DEFINE_ONCE",componentWillMount:"DEFINE_MANY",componentDidMount:"DEFINE_MANY",componentWillReceiveProps:"DEFINE_MANY",shouldComponentUpdate:"DEFINE_ONCE",componentWillUpdate:"DEFINE_MANY",componentDidUpdate:"DEFINE_MANY",componentWillUnmount:"DEFINE_MANY",updateComponent:"OVERRIDE_BASE"},v={displayName:function(e,t){e.displayName=t},mixins:function(e,t){if(t)for(var n=0;n<t.length;n++)s(e,t[n])},childContextTypes:function(e,t){e.childContextTypes=l({},e.childContextTypes,t)},contextTypes:function(e,t){e.contextTypes=l({},e.contextTypes,t)},getDefaultProps:function(e,t){e.getDefaultProps?e.getDefaultProps=f(e.getDefaultProps,t):e.getDefaultProps=t},propTypes:function(e,t){e.propTypes=l({},e.propTypes,t)},statics:function(e,t){u(e,t)},autobind:function(){}},y={componentDidMount:function(){this.__isMounted=!0}},x={componentWillUnmount:function(){this.__isMounted=!1}},w={replaceState:function(e,t){this.updater.enqueueReplaceState(this,e,t)},isMounted:function(){return!!this.__isMounted}},k=function(){};return l(k.prototype,e.prototype,w),m}var l=n(13),a=n(18),i=n(28),c="mixins";e.exports=r},function(e,t,n){"use strict";function o(e,t,n){return!!e.path&&Object(l.b)(e.path).some(function(e){return t.params[e]!==n.params[e]})}function r(e,t){var n=e&&e.routes,r=t.routes,l=void 0,a=void 0,i=void 0;if(n){var c=!1;l=n.filter(function(n){if(c)return!0;var l=-1===r.indexOf(n)||o(n,e,t);return l&&(c=!0),l}),l.reverse(),i=[],a=[],r.forEach(function(e){var t=-1===n.indexOf(e),o=-1!==l.indexOf(e);t||o?i.push(e):a.push(e)})}else l=[],a=[],i=r;return{leaveRoutes:l,changeRoutes:a,enterRoutes:i}}var l=n(10);t.a=r},function(e,t,n){"use strict";function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function r(){function e(e,t,n,o){var r=e.length<n,l=function(){for(var n=arguments.length,o=Array(n),l=0;l<n;l++)o[l]=arguments[l];if(e.apply(t,o),r){(0,o[o.length-1])()}};return o.add(l),l}function t(t){return t.reduce(function(t,n){return n.onEnter&&t.push(e(n.onEnter,n,3,s)),t},[])}function n(t){return t.reduce(function(t,n){return n.onChange&&t.push(e(n.onChange,n,4,u)),t},[])}function o(e,t,n){function o(e){r=e}if(!e)return void n();var r=void 0;Object(l.a)(e,function(e,n,l){t(e,o,function(e){e||r?l(e,r):n()})},n)}function r(e,n,r){s.clear();var l=t(e);return o(l.length,function(e,t,o){var r=function(){s.has(l[e])&&(o.apply(void 0,arguments),s.remove(l[e]))};l[e](n,t,r)},r)}function i(e,t,r,l){u.clear();var a=n(e);return o(a.length,function(e,n,o){var l=function(){u.has(a[e])&&(o.apply(void 0,arguments),u.remove(a[e]))};a[e](t,r,n,l)},l)}function c(e,t){for(var n=0,o=e.length;n<o;++n)e[n].onLeave&&e[n].onLeave.call(e[n],t)}var s=new a,u=new a;return{runEnterHooks:r,runChangeHooks:i,runLeaveHooks:c}}t.a=r;var l=n(20),a=function e(){var t=this;o(this,e),this.hooks=[],this.add=function(e){return t.hooks.push(e)},this.remove=function(e){return t.hooks=t.hooks.filter(function(t){return t!==e})},this.has=function(e){return-1!==t.hooks.indexOf(e)},this.clear=function(){return t.hooks=[]}}},function(e,t,n){"use strict";function o(e,t){if(e==t)return!0;if(null==e||null==t)return!1;if(Array.isArray(e))return Array.isArray(t)&&e.length===t.length&&e.every(function(e,n){return o(e,t[n])});if("object"===(void 0===e?"undefined":s(e))){for(var n in e)if(Object.prototype.hasOwnProperty.call(e,n))if(void 0===e[n]){if(void 0!==t[n])return!1}else{if(!Object.prototype.hasOwnProperty.call(t,n))return!1;if(!o(e[n],t[n]))return!1}return!0}return String(e)===String(t)}function r(e,t){return"/"!==t.charAt(0)&&(t="/"+t),"/"!==e.charAt(e.length-1)&&(e+="/"),"/"!==t.charAt(t.length-1)&&(t+="/"),t===e}function l(e,t,n){for(var o=e,r=[],l=[],a=0,i=t.length;a<i;++a){var s=t[a],u=s.path||"";if("/"===u.charAt(0)&&(o=e,r=[],l=[]),null!==o&&u){var d=Object(c.c)(u,o);if(d?(o=d.remainingPathname,r=[].concat(r,d.paramNames),l=[].concat(l,d.paramValues)):o=null,""===o)return r.every(function(e,t){return String(l[t])===String(n[e])})}}return!1}function a(e,t){return null==t?null==e:null==e||o(e,t)}function i(e,t,n,o,i){var c=e.pathname,s=e.query;return null!=n&&("/"!==c.charAt(0)&&(c="/"+c),!!(r(c,n.pathname)||!t&&l(c,o,i))&&a(s,n.query))}t.a=i;var c=n(10),s="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e}},function(e,t,n){"use strict";function o(e,t,n){if(t.component||t.components)return void n(null,t.component||t.components);var o=t.getComponent||t.getComponents;if(o){var r=o.call(t,e,n);Object(a.a)(r)&&r.then(function(e){return n(null,e)},n)}else n()}function r(e,t){Object(l.b)(e.routes,function(t,n,r){o(e,t,r)},t)}var l=n(20),a=n(32);t.a=r},function(e,t,n){"use strict";function o(e,t,n,o,r){if(e.childRoutes)return[null,e.childRoutes];if(!e.getChildRoutes)return[];var l=!0,i=void 0,c={location:t,params:a(n,o)},s=e.getChildRoutes(c,function(e,t){if(t=!e&&Object(f.b)(t),l)return void(i=[e,t]);r(e,t)});return Object(u.a)(s)&&s.then(function(e){return r(null,Object(f.b)(e))},r),l=!1,i}function r(e,t,n,l,i){if(e.indexRoute)i(null,e.indexRoute);else if(e.getIndexRoute){var c={location:t,params:a(n,l)},d=e.getIndexRoute(c,function(e,t){i(e,!e&&Object(f.b)(t)[0])});Object(u.a)(d)&&d.then(function(e){return i(null,Object(f.b)(e)[0])},i)}else if(e.childRoutes||e.getChildRoutes){var p=function(e,o){if(e)return void i(e);var a=o.filter(function(e){return!e.path});Object(s.a)(a.length,function(e,o,i){r(a[e],t,n,l,function(t,n){if(t||n){var r=[a[e]].concat(Array.isArray(n)?n:[n]);i(t,r)}else o()})},function(e,t){i(null,t)})},b=o(e,t,n,l,p);b&&p.apply(void 0,b)}else i()}function l(e,t,n){return t.reduce(function(e,t,o){var r=n&&n[o];return Array.isArray(e[t])?e[t].push(r):e[t]=t in e?[e[t],r]:r,e},e)}function a(e,t){return l({},e,t)}function i(e,t,n,l,i,s){var u=e.path||"";if("/"===u.charAt(0)&&(n=t.pathname,l=[],i=[]),null!==n&&u){try{var f=Object(d.c)(u,n);f?(n=f.remainingPathname,l=[].concat(l,f.paramNames),i=[].concat(i,f.paramValues)):n=null}catch(e){s(e)}if(""===n){var p={routes:[e],params:a(l,i)};return void r(e,t,l,i,function(e,t){if(e)s(e);else{if(Array.isArray(t)){var n;(n=p.routes).push.apply(n,t)}else t&&p.routes.push(t);s(null,p)}})}}if(null!=n||e.childRoutes){var b=function(o,r){o?s(o):r?c(r,t,function(t,n){t?s(t):n?(n.routes.unshift(e),s(null,n)):s()},n,l,i):s()},h=o(e,t,l,i,b);h&&b.apply(void 0,h)}else s()}function c(e,t,n,o){var r=arguments.length>4&&void 0!==arguments[4]?arguments[4]:[],l=arguments.length>5&&void 0!==arguments[5]?arguments[5]:[];void 0===o&&("/"!==t.pathname.charAt(0)&&(t=p({},t,{pathname:"/"+t.pathname})),o=t.pathname),Object(s.a)(e.length,function(n,a,c){i(e[n],t,o,r,l,function(e,t){e||t?c(e,t):a()})},n)}t.a=c;var s=n(20),u=n(32),d=n(10),f=(n(9),n(5)),p=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}},function(e,t,n){"use strict";function o(e,t){var n={};return e.path?(Object(r.b)(e.path).forEach(function(e){Object.prototype.hasOwnProperty.call(t,e)&&(n[e]=t[e])}),n):n}var r=n(10);t.a=o},function(e,t,n){"use strict";var o=n(1),r=n.n(o),l=n(3),a=n.n(l),i=n(34),c=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},s=a()({displayName:"IndexLink",render:function(){return r.a.createElement(i.a,c({},this.props,{onlyActiveOnIndex:!0}))}});t.a=s},function(e,t,n){"use strict";function o(e){return e.displayName||e.name||"Component"}function r(e,t){var n=t&&t.withRef,r=u()({displayName:"WithRouter",mixins:[Object(p.b)("router")],contextTypes:{router:b.b},propTypes:{router:b.b},getWrappedInstance:function(){return n||a()(!1),this.wrappedInstance},render:function(){var t=this,o=this.props.router||this.context.router;if(!o)return c.a.createElement(e,this.props);var r=o.params,l=o.location,a=o.routes,i=h({},this.props,{router:o,params:r,location:l,routes:a});return n&&(i.ref=function(e){t.wrappedInstance=e}),c.a.createElement(e,i)}});return r.displayName="withRouter("+o(e)+")",r.WrappedComponent=e,f()(r,e)}t.a=r;var l=n(2),a=n.n(l),i=n(1),c=n.n(i),s=n(3),u=n.n(s),d=n(77),f=n.n(d),p=n(22),b=n(23),h=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e}},function(e,t,n){"use strict";var o={childContextTypes:!0,contextTypes:!0,defaultProps:!0,displayName:!0,getDefaultProps:!0,mixins:!0,propTypes:!0,type:!0},r={name:!0,length:!0,prototype:!0,caller:!0,arguments:!0,arity:!0},l="function"==typeof Object.getOwnPropertySymbols;e.exports=function(e,t,n){if("string"!=typeof t){var a=Object.getOwnPropertyNames(t);l&&(a=a.concat(Object.getOwnPropertySymbols(t)));for(var i=0;i<a.length;++i)if(!(o[a[i]]||r[a[i]]||n&&n[a[i]]))try{e[a[i]]=t[a[i]]}catch(e){}}return e}},function(e,t,n){"use strict";var o=n(3),r=n.n(o),l=n(0),a=(n.n(l),n(9),n(2)),i=n.n(a),c=n(35),s=n(12),u=r()({displayName:"IndexRedirect",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=c.a.createRouteFromReactElement(e))}},propTypes:{to:l.string.isRequired,query:l.object,state:l.object,onEnter:s.c,children:s.c},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";var o=n(3),r=n.n(o),l=n(0),a=(n.n(l),n(9),n(2)),i=n.n(a),c=n(5),s=n(12),u=r()({displayName:"IndexRoute",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=Object(c.a)(e))}},propTypes:{path:s.c,component:s.a,components:s.b,getComponent:l.func,getComponents:l.func},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";var o=n(3),r=n.n(o),l=n(0),a=(n.n(l),n(2)),i=n.n(a),c=n(5),s=n(12),u=r()({displayName:"Route",statics:{createRouteFromReactElement:c.a},propTypes:{path:l.string,component:s.a,components:s.b,getComponent:l.func,getComponents:l.func},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";function o(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function r(e,t){var n=e.history,r=e.routes,a=e.location,p=o(e,["history","routes","location"]);n||a||i()(!1),n=n||Object(c.a)(p);var b=Object(s.a)(n,Object(u.b)(r));a=a?n.createLocation(a):n.getCurrentLocation(),b.match(a,function(e,o,r){var a=void 0;if(r){var i=Object(d.b)(n,b,r);a=f({},r,{router:i,matchContext:{transitionManager:b,router:i}})}t(e,o&&n.createLocation(o,l.REPLACE),a)})}var l=n(16),a=(n.n(l),n(2)),i=n.n(a),c=n(36),s=n(31),u=n(5),d=n(33),f=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.a=r},function(e,t,n){"use strict";function o(e){switch(e.arrayFormat){case"index":return function(t,n,o){return null===n?[l(t,e),"[",o,"]"].join(""):[l(t,e),"[",l(o,e),"]=",l(n,e)].join("")};case"bracket":return function(t,n){return null===n?l(t,e):[l(t,e),"[]=",l(n,e)].join("")};default:return function(t,n){return null===n?l(t,e):[l(t,e),"=",l(n,e)].join("")}}}function r(e){var t;switch(e.arrayFormat){case"index":return function(e,n,o){if(t=/\[(\d*)\]$/.exec(e),e=e.replace(/\[\d*\]$/,""),!t)return void(o[e]=n);void 0===o[e]&&(o[e]={}),o[e][t[1]]=n};case"bracket":return function(e,n,o){return t=/(\[\])$/.exec(e),e=e.replace(/\[\]$/,""),t?void 0===o[e]?void(o[e]=[n]):void(o[e]=[].concat(o[e],n)):void(o[e]=n)};default:return function(e,t,n){if(void 0===n[e])return void(n[e]=t);n[e]=[].concat(n[e],t)}}}function l(e,t){return t.encode?t.strict?i(e):encodeURIComponent(e):e}function a(e){return Array.isArray(e)?e.sort():"object"==typeof e?a(Object.keys(e)).sort(function(e,t){return Number(e)-Number(t)}).map(function(t){return e[t]}):e}var i=n(83),c=n(13);t.extract=function(e){return e.split("?")[1]||""},t.parse=function(e,t){t=c({arrayFormat:"none"},t);var n=r(t),o=Object.create(null);return"string"!=typeof e?o:(e=e.trim().replace(/^(\?|#|&)/,""))?(e.split("&").forEach(function(e){var t=e.replace(/\+/g," ").split("="),r=t.shift(),l=t.length>0?t.join("="):void 0;l=void 0===l?null:decodeURIComponent(l),n(decodeURIComponent(r),l,o)}),Object.keys(o).sort().reduce(function(e,t){var n=o[t];return Boolean(n)&&"object"==typeof n&&!Array.isArray(n)?e[t]=a(n):e[t]=n,e},Object.create(null))):o},t.stringify=function(e,t){t=c({encode:!0,strict:!0,arrayFormat:"none"},t);var n=o(t);return e?Object.keys(e).sort().map(function(o){var r=e[o];if(void 0===r)return"";if(null===r)return l(o,t);if(Array.isArray(r)){var a=[];return r.slice().forEach(function(e){void 0!==e&&a.push(n(o,e,a.length))}),a.join("&")}return l(o,t)+"="+l(r,t)}).filter(function(e){return e.length>0}).join("&"):""}},function(e,t,n){"use strict";e.exports=function(e){return encodeURIComponent(e).replace(/[!'()*]/g,function(e){return"%"+e.charCodeAt(0).toString(16).toUpperCase()})}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},l=n(4),a=(o(l),n(2)),i=o(a),c=n(11),s=n(6),u=n(25),d=o(u),f=n(16),p=function(e){return e.filter(function(e){return e.state}).reduce(function(e,t){return e[t.key]=t.state,e},{})},b=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};Array.isArray(e)?e={entries:e}:"string"==typeof e&&(e={entries:[e]});var t=function(){var e=h[m],t=(0,s.createPath)(e),n=void 0,o=void 0;e.key&&(n=e.key,o=v(n));var l=(0,s.parsePath)(t);return(0,c.createLocation)(r({},l,{state:o}),void 0,n)},n=function(e){var t=m+e;return t>=0&&t<h.length},o=function(e){if(e&&n(e)){m+=e;var o=t();u.transitionTo(r({},o,{action:f.POP}))}},l=function(e){m+=1,m<h.length&&h.splice(m),h.push(e),_(e.key,e.state)},a=function(e){h[m]=e,_(e.key,e.state)},u=(0,d.default)(r({},e,{getCurrentLocation:t,pushLocation:l,replaceLocation:a,go:o})),b=e,h=b.entries,m=b.current;"string"==typeof h?h=[h]:Array.isArray(h)||(h=["/"]),h=h.map(function(e){return(0,c.createLocation)(e)}),null==m?m=h.length-1:m>=0&&m<h.length||(0,i.default)(!1);var g=p(h),_=function(e,t){return g[e]=t},v=function(e){return g[e]};return r({},u,{canGo:n})};t.default=b},function(e,t,n){"use strict";t.__esModule=!0;t.loopAsync=function(e,t,n){var o=0,r=!1,l=!1,a=!1,i=void 0,c=function(){for(var e=arguments.length,t=Array(e),o=0;o<e;o++)t[o]=arguments[o];if(r=!0,l)return void(i=t);n.apply(void 0,t)};!function s(){if(!r&&(a=!0,!l)){for(l=!0;!r&&o<e&&a;)a=!1,t(o++,s,c);if(l=!1,r)return void n.apply(void 0,i);o>=e&&a&&(r=!0,n())}}()}},function(e,t,n){"use strict";var o=n(1),r=n.n(o),l=n(21),a=(n(9),Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e});t.a=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];var i=t.map(function(e){return e.renderRouterContext}).filter(Boolean),c=t.map(function(e){return e.renderRouteComponent}).filter(Boolean),s=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:o.createElement;return function(t,n){return c.reduceRight(function(e,t){return t(e,n)},e(t,n))}};return function(e){return i.reduceRight(function(t,n){return n(t,e)},r.a.createElement(l.a,a({},e,{createElement:s(e.createElement)})))}}},function(e,t,n){"use strict";var o=n(88),r=n.n(o),l=n(41);t.a=Object(l.a)(r.a)},function(e,t,n){"use strict";function o(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(2),i=r(a),c=n(26),s=n(27),u=o(s),d=n(89),f=o(d),p=n(17),b=n(25),h=r(b),m=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};c.canUseDOM||(0,i.default)(!1);var t=e.forceRefresh||!(0,p.supportsHistory)(),n=t?f:u,o=n.getUserConfirmation,r=n.getCurrentLocation,a=n.pushLocation,s=n.replaceLocation,d=n.go,b=(0,h.default)(l({getUserConfirmation:o},e,{getCurrentLocation:r,pushLocation:a,replaceLocation:s,go:d})),m=0,g=void 0,_=function(e,t){1==++m&&(g=u.startListener(b.transitionTo));var n=t?b.listenBefore(e):b.listen(e);return function(){n(),0==--m&&g()}};return l({},b,{listenBefore:function(e){return _(e,!0)},listen:function(e){return _(e,!1)}})};t.default=m},function(e,t,n){"use strict";t.__esModule=!0,t.replaceLocation=t.pushLocation=t.getCurrentLocation=t.go=t.getUserConfirmation=void 0;var o=n(27);Object.defineProperty(t,"getUserConfirmation",{enumerable:!0,get:function(){return o.getUserConfirmation}}),Object.defineProperty(t,"go",{enumerable:!0,get:function(){return o.go}});var r=n(11),l=n(6);t.getCurrentLocation=function(){return(0,r.createLocation)(window.location)},t.pushLocation=function(e){return window.location.href=(0,l.createPath)(e),!1},t.replaceLocation=function(e){return window.location.replace((0,l.createPath)(e)),!1}},function(e,t,n){"use strict";var o=n(91),r=n.n(o),l=n(41);t.a=Object(l.a)(r.a)},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},l=n(4),a=(o(l),n(2)),i=o(a),c=n(26),s=n(17),u=n(92),d=function(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}(u),f=n(25),p=o(f),b=function(e){return"/"===e.charAt(0)?e:"/"+e},h={hashbang:{encodePath:function(e){return"!"===e.charAt(0)?e:"!"+e},decodePath:function(e){return"!"===e.charAt(0)?e.substring(1):e}},noslash:{encodePath:function(e){return"/"===e.charAt(0)?e.substring(1):e},decodePath:b},slash:{encodePath:b,decodePath:b}},m=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};c.canUseDOM||(0,i.default)(!1);var t=e.queryKey,n=e.hashType;"string"!=typeof t&&(t="_k"),null==n&&(n="slash"),n in h||(n="slash");var o=h[n],l=d.getUserConfirmation,a=function(){return d.getCurrentLocation(o,t)},u=function(e){return d.pushLocation(e,o,t)},f=function(e){return d.replaceLocation(e,o,t)},b=(0,p.default)(r({getUserConfirmation:l},e,{getCurrentLocation:a,pushLocation:u,replaceLocation:f,go:d.go})),m=0,g=void 0,_=function(e,n){1==++m&&(g=d.startListener(b.transitionTo,o,t));var r=n?b.listenBefore(e):b.listen(e);return function(){r(),0==--m&&g()}},v=function(e){return _(e,!0)},y=function(e){return _(e,!1)};(0,s.supportsGoWithoutReloadUsingHash)();return r({},b,{listenBefore:v,listen:y,go:function(e){b.go(e)},createHref:function(e){return"#"+o.encodePath(b.createHref(e))}})};t.default=m},function(e,t,n){"use strict";t.__esModule=!0,t.replaceLocation=t.pushLocation=t.startListener=t.getCurrentLocation=t.go=t.getUserConfirmation=void 0;var o=n(27);Object.defineProperty(t,"getUserConfirmation",{enumerable:!0,get:function(){return o.getUserConfirmation}}),Object.defineProperty(t,"go",{enumerable:!0,get:function(){return o.go}});var r=n(4),l=(function(e){e&&e.__esModule}(r),n(11)),a=n(17),i=n(40),c=n(6),s=function(){var e=window.location.href,t=e.indexOf("#");return-1===t?"":e.substring(t+1)},u=function(e){return window.location.hash=e},d=function(e){var t=window.location.href.indexOf("#");window.location.replace(window.location.href.slice(0,t>=0?t:0)+"#"+e)},f=t.getCurrentLocation=function(e,t){var n=e.decodePath(s()),o=(0,c.getQueryStringValueFromPath)(n,t),r=void 0;o&&(n=(0,c.stripQueryStringValueFromPath)(n,t),r=(0,i.readState)(o));var a=(0,c.parsePath)(n);return a.state=r,(0,l.createLocation)(a,void 0,o)},p=void 0,b=(t.startListener=function(e,t,n){var o=function(){var o=s(),r=t.encodePath(o);if(o!==r)d(r);else{var l=f(t,n);if(p&&l.key&&p.key===l.key)return;p=l,e(l)}},r=s(),l=t.encodePath(r);return r!==l&&d(l),(0,a.addEventListener)(window,"hashchange",o),function(){return(0,a.removeEventListener)(window,"hashchange",o)}},function(e,t,n,o){var r=e.state,l=e.key,a=t.encodePath((0,c.createPath)(e));void 0!==r&&(a=(0,c.addQueryStringValueToPath)(a,n,l),(0,i.saveState)(l,r)),p=e,o(a)});t.pushLocation=function(e,t,n){return b(e,t,n,function(e){s()!==e&&u(e)})},t.replaceLocation=function(e,t,n){return b(e,t,n,function(e){s()!==e&&d(e)})}}]);
\ No newline at end of file
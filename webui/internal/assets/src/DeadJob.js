@@ -0,0 +1,67 @@
+import React from 'react';
+import PropTypes from 'prop-types';
+import UnixTime from './UnixTime';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+export default class DeadJob extends React.Component {
+  static propTypes = {
+    url: PropTypes.string,
+  }
+
+  state = {
+    job: null,
+    error: null
+  }
+
+  fetch() {
+    if (!this.props.url) {
+      return;
+    }
+    fetch(this.props.url).
+      then((resp) => resp.json()).
+      then((data) => {
+        if (data.error) {
+          this.setState({job: null, error: data.error});
+        } else {
+          this.setState({job: data, error: null});
+        }
+      });
+  }
+
+  componentWillMount() {
+    this.fetch();
+  }
+
+  render() {
+    let job = this.state.job;
+    return (
+      <div className={cx(styles.panel, styles.panelDefault)}>
+        <div className={styles.panelHeading}>Dead Job</div>
+        <div className={styles.panelBody}>
+          {this.state.error && <p className={styles.textDanger}>{this.state.error}</p>}
+          {job &&
+          <dl>
+            <dt>Name</dt>
+            <dd>{job.name}</dd>
+            <dt>ID</dt>
+            <dd>{job.id}</dd>
+            <dt>Arguments</dt>
+            <dd><pre>{JSON.stringify(job.args, null, 2)}</pre></dd>
+            <dt>First Enqueued At</dt>
+            <dd><UnixTime ts={job.first_t || job.t} /></dd>
+            <dt>Fails</dt>
+            <dd>{job.fails}</dd>
+            <dt>Died At</dt>
+            <dd><UnixTime ts={job.died_at} /></dd>
+            <dt>Error</dt>
+            <dd><pre>{job.err}</pre></dd>
+            {job.backtrace && <dt>Backtrace</dt>}
+            {job.backtrace && <dd><pre>{job.backtrace}</pre></dd>}
+          </dl>
+          }
+        </div>
+      </div>
+    );
+  }
+}
@@ -0,0 +1,25 @@
+import './TestSetup';
+import expect from 'expect';
+import DeadJob from './DeadJob';
+import React from 'react';
+import { mount } from 'enzyme';
+
+describe('DeadJob', () => {
+  it('shows the job', () => {
+    let deadJob = mount(<DeadJob />);
+
+    deadJob.setState({
+      job: {id: 1, name: 'test', args: {}, t: 1467760800, died_at: 1467760821, fails: 3, err: 'err1', backtrace: 'goroutine 1 [running]'}
+    });
+
+    expect(deadJob.text()).toMatch(/err1/);
+    expect(deadJob.text()).toMatch(/goroutine 1 \[running\]/);
+  });
+
+  it('shows errors', () => {
+    let deadJob = mount(<DeadJob />);
+
+    deadJob.setState({error: 'job not found'});
+    expect(deadJob.text()).toMatch(/job not found/);
+  });
+});
@@ -2,9 +2,12 @@ import React from 'react';
 import PropTypes from 'prop-types';
 import PageList from './PageList';
 import UnixTime from './UnixTime';
+import { Link } from 'react-router';
 import styles from './bootstrap.min.css';
 import cx from './cx';
 
+const maxErrLength = 100;
+
 export default class DeadJobs extends React.Component {
   static propTypes = {
     fetchURL: PropTypes.string,
@@ -12,13 +15,15 @@ export default class DeadJobs extends React.Component {
     deleteAllURL: PropTypes.string,
     retryURL: PropTypes.string,
     retryAllURL: PropTypes.string,
+    exportURL: PropTypes.string,
   }
 
   state = {
     selected: [],
     page: 1,
     count: 0,
-    jobs: []
+    jobs: [],
+    readOnly: false
   }
 
   fetch() {
@@ -31,7 +36,8 @@ export default class DeadJobs extends React.Component {
         this.setState({
           selected: [],
           count: data.count,
-          jobs: data.jobs
+          jobs: data.jobs,
+          readOnly: !!data.read_only
         });
       });
   }
@@ -77,21 +83,22 @@ export default class DeadJobs extends React.Component {
     if (!this.props.deleteAllURL) {
       return;
     }
-    fetch(this.props.deleteAllURL, {method: 'post'}).then(() => {
+    fetch(this.props.deleteAllURL, {method: 'post', headers: {'Content-Type': 'application/json'}}).then(() => {
       this.updatePage(1);
     });
   }
 
-  deleteSelected() {
-    let p = [];
-    this.state.selected.map((job) => {
-      if (!this.props.deleteURL) {
-        return;
-      }
-      p.push(fetch(`${this.props.deleteURL}/${job.died_at}/${job.id}`, {method: 'post'}));
+  get selectedRefs() {
+    return this.state.selected.map((job) => {
+      return {died_at: job.died_at, job_id: job.id};
     });
+  }
 
-    Promise.all(p).then(() => {
+  deleteSelected() {
+    if (!this.props.deleteURL) {
+      return;
+    }
+    fetch(this.props.deleteURL, {method: 'post', headers: {'Content-Type': 'application/json'}, body: JSON.stringify(this.selectedRefs)}).then(() => {
       this.fetch();
     });
   }
@@ -100,21 +107,16 @@ export default class DeadJobs extends React.Component {
     if (!this.props.retryAllURL) {
       return;
     }
-    fetch(this.props.retryAllURL, {method: 'post'}).then(() => {
+    fetch(this.props.retryAllURL, {method: 'post', headers: {'Content-Type': 'application/json'}}).then(() => {
       this.updatePage(1);
     });
   }
 
   retrySelected() {
-    let p = [];
-    this.state.selected.map((job) => {
-      if (!this.props.retryURL) {
-        return;
-      }
-      p.push(fetch(`${this.props.retryURL}/${job.died_at}/${job.id}`, {method: 'post'}));
-    });
-
-    Promise.all(p).then(() => {
+    if (!this.props.retryURL) {
+      return;
+    }
+    fetch(this.props.retryURL, {method: 'post', headers: {'Content-Type': 'application/json'}, body: JSON.stringify(this.selectedRefs)}).then(() => {
       this.fetch();
     });
   }
@@ -126,13 +128,16 @@ export default class DeadJobs extends React.Component {
           <div className={styles.panelHeading}>Dead Jobs</div>
           <div className={styles.panelBody}>
             <p>{this.state.count} job(s) are dead.</p>
+            {this.props.exportURL &&
+            <p>Download as <a href={`${this.props.exportURL}?format=json`}>JSON</a> or <a href={`${this.props.exportURL}?format=csv`}>CSV</a>.</p>
+            }
             <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
           </div>
           <div className={styles.tableResponsive}>
             <table className={styles.table}>
               <tbody>
                 <tr>
-                  <th><input type="checkbox" checked={this.state.selected.length > 0} onChange={() => this.checkAll()}/></th>
+                  {!this.state.readOnly && <th><input type="checkbox" checked={this.state.selected.length > 0} onChange={() => this.checkAll()}/></th>}
                   <th>Name</th>
                   <th>Arguments</th>
                   <th>Error</th>
@@ -142,10 +147,10 @@ export default class DeadJobs extends React.Component {
                   this.state.jobs.map((job) => {
                     return (
                       <tr key={job.id}>
-                        <td><input type="checkbox" checked={this.checked(job)} onChange={() => this.check(job)}/></td>
-                        <td>{job.name}</td>
+                        {!this.state.readOnly && <td><input type="checkbox" checked={this.checked(job)} onChange={() => this.check(job)}/></td>}
+                        <td><Link to={`/dead_jobs/${job.died_at}/${job.id}`}>{job.name}</Link></td>
                         <td>{JSON.stringify(job.args)}</td>
-                        <td>{job.err}</td>
+                        <td>{job.err && job.err.length > maxErrLength ? `${job.err.slice(0, maxErrLength)}…` : job.err}</td>
                         <td><UnixTime ts={job.t} /></td>
                       </tr>
                     );
@@ -155,12 +160,14 @@ export default class DeadJobs extends React.Component {
             </table>
           </div>
         </div>
+        {!this.state.readOnly &&
         <div className={styles.btnGroup} role="group">
           <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.deleteSelected()}>Delete Selected Jobs</button>
           <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.retrySelected()}>Retry Selected Jobs</button>
           <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.deleteAll()}>Delete All Jobs</button>
           <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.retryAll()}>Retry All Jobs</button>
         </div>
+        }
       </div>
     );
   }
@@ -95,4 +95,34 @@ describe('DeadJobs', () => {
     pageList.at(0).props().jumpTo(2)();
     expect(deadJobs.state().page).toEqual(2);
   });
+
+  it('refers to the selected jobs', () => {
+    let deadJobs = mount(<DeadJobs />);
+
+    deadJobs.setState({
+      count: 2,
+      jobs: [
+        {id: 1, name: 'test', args: {}, t: 1467760821, died_at: 1467760830, err: 'err1'},
+        {id: 2, name: 'test2', args: {}, t: 1467760822, died_at: 1467760831, err: 'err2'}
+      ]
+    });
+    deadJobs.find('input').at(2).simulate('change');
+
+    expect(deadJobs.instance().selectedRefs).toEqual([{died_at: 1467760831, job_id: 2}]);
+  });
+
+  it('hides buttons in read-only mode', () => {
+    let deadJobs = mount(<DeadJobs />);
+
+    deadJobs.setState({
+      count: 1,
+      jobs: [
+        {id: 1, name: 'test', args: {}, t: 1467760821, err: 'err1'}
+      ],
+      readOnly: true
+    });
+
+    expect(deadJobs.find('input').length).toEqual(0);
+    expect(deadJobs.find('button').length).toEqual(0);
+  });
 });
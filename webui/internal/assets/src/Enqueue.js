@@ -0,0 +1,67 @@
+import React from 'react';
+import PropTypes from 'prop-types';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+export default class Enqueue extends React.Component {
+  static propTypes = {
+    url: PropTypes.string,
+  }
+
+  state = {
+    name: '',
+    args: '{}',
+    delay: 0,
+    result: null,
+    error: null
+  }
+
+  submit(e) {
+    e.preventDefault();
+    let args;
+    try {
+      args = JSON.parse(this.state.args);
+    } catch (err) {
+      this.setState({result: null, error: `Arguments aren't valid JSON: ${err.message}`});
+      return;
+    }
+
+    let body = {name: this.state.name, args: args, delay_seconds: Number(this.state.delay)};
+    fetch(this.props.url, {method: 'post', headers: {'Content-Type': 'application/json'}, body: JSON.stringify(body)}).
+      then((resp) => resp.json()).
+      then((data) => {
+        if (data.error) {
+          this.setState({result: null, error: data.error});
+        } else {
+          this.setState({result: data, error: null});
+        }
+      });
+  }
+
+  render() {
+    return (
+      <div className={cx(styles.panel, styles.panelDefault)}>
+        <div className={styles.panelHeading}>Enqueue Job</div>
+        <div className={styles.panelBody}>
+          <form onSubmit={(e) => this.submit(e)}>
+            <div className={styles.formGroup}>
+              <label>Name</label>
+              <input className={styles.formControl} type="text" value={this.state.name} onChange={(e) => this.setState({name: e.target.value})} />
+            </div>
+            <div className={styles.formGroup}>
+              <label>Arguments (JSON)</label>
+              <textarea className={styles.formControl} rows="4" value={this.state.args} onChange={(e) => this.setState({args: e.target.value})} />
+            </div>
+            <div className={styles.formGroup}>
+              <label>Delay (seconds)</label>
+              <input className={styles.formControl} type="number" min="0" value={this.state.delay} onChange={(e) => this.setState({delay: e.target.value})} />
+            </div>
+            <button className={cx(styles.btn, styles.btnPrimary)} type="submit">Enqueue</button>
+          </form>
+          {this.state.error && <p className={styles.textDanger}>{this.state.error}</p>}
+          {this.state.result && <p>Enqueued job {this.state.result.id}.</p>}
+        </div>
+      </div>
+    );
+  }
+}
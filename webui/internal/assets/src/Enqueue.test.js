@@ -0,0 +1,24 @@
+import './TestSetup';
+import expect from 'expect';
+import Enqueue from './Enqueue';
+import React from 'react';
+import { mount } from 'enzyme';
+
+describe('Enqueue', () => {
+  it('rejects invalid args', () => {
+    let enqueue = mount(<Enqueue />);
+
+    enqueue.setState({name: 'test', args: '{nope'});
+    enqueue.find('form').simulate('submit');
+
+    expect(enqueue.state().error).toMatch(/valid JSON/);
+    expect(enqueue.state().result).toEqual(null);
+  });
+
+  it('shows the enqueued job', () => {
+    let enqueue = mount(<Enqueue />);
+
+    enqueue.setState({result: {id: 'abc', name: 'test', args: {}}});
+    expect(enqueue.text()).toMatch(/Enqueued job abc/);
+  });
+});
@@ -5,6 +5,25 @@ import ShortList from './ShortList';
 import styles from './bootstrap.min.css';
 import cx from './cx';
 
+function progress(worker) {
+  if (!worker.progress_total) {
+    return '';
+  }
+  return `${Math.floor(100 * worker.progress_done / worker.progress_total)}%`;
+}
+
+// deployment describes the build a worker pool is running, as set by its version and labels.
+function deployment(pool) {
+  let parts = [];
+  if (pool.version) {
+    parts.push(`Version ${pool.version}`);
+  }
+  Object.keys(pool.labels || {}).sort().map((key) => {
+    parts.push(`${key}=${pool.labels[key]}`);
+  });
+  return parts.join(', ');
+}
+
 class BusyWorkers extends React.Component {
   static propTypes = {
     worker: PropTypes.arrayOf(PropTypes.object).isRequired,
@@ -21,6 +40,7 @@ class BusyWorkers extends React.Component {
               <th>Started At</th>
               <th>Check-in At</th>
               <th>Check-in</th>
+              <th>Progress</th>
             </tr>
             {
               this.props.worker.map((worker) => {
@@ -31,6 +51,7 @@ class BusyWorkers extends React.Component {
                     <td><UnixTime ts={worker.started_at}/></td>
                     <td><UnixTime ts={worker.checkin_at}/></td>
                     <td>{worker.checkin}</td>
+                    <td>{progress(worker)}</td>
                   </tr>
                 );
               })
@@ -90,6 +111,10 @@ export default class Processes extends React.Component {
     return count;
   }
 
+  get staleCount() {
+    return this.state.workerPool.filter((pool) => pool.stale).length;
+  }
+
   getBusyPoolWorker(pool) {
     let workers = [];
     this.state.busyWorker.map((worker) => {
@@ -105,6 +130,7 @@ export default class Processes extends React.Component {
       <section>
         <header>Processes</header>
         <p>{this.state.workerPool.length} Worker process(es). {this.state.busyWorker.length} active worker(s) out of {this.workerCount}.</p>
+        {this.staleCount > 0 && <p className={styles.textDanger}>{this.staleCount} process(es) stopped sending heartbeats, and will be reaped.</p>}
         {
           this.state.workerPool.map((pool) => {
             let busyWorker = this.getBusyPoolWorker(pool);
@@ -116,9 +142,15 @@ export default class Processes extends React.Component {
                       <tr>
                         <td>{pool.host}: {pool.pid}</td>
                         <td>Started <UnixTime ts={pool.started_at}/></td>
-                        <td>Last Heartbeat <UnixTime ts={pool.heartbeat_at}/></td>
+                        <td className={pool.stale ? styles.textDanger : ''}>Last Heartbeat <UnixTime ts={pool.heartbeat_at}/>{pool.stale && ' (stale)'}</td>
                         <td>Concurrency {pool.concurrency}</td>
                       </tr>
+                      {
+                        deployment(pool) &&
+                          <tr>
+                            <td colSpan="4">{deployment(pool)}</td>
+                          </tr>
+                      }
                       <tr>
                         <td colSpan="4">Servicing <ShortList item={pool.job_names} />.</td>
                       </tr>
@@ -49,4 +49,96 @@ describe('Processes', () => {
     expect(busyWorkers.at(0).props().worker).toEqual(expectedBusyWorker);
     expect(processes.instance().getBusyPoolWorker(processes.state().workerPool[0])).toEqual(expectedBusyWorker);
   });
+
+  it('shows progress', () => {
+    let processes = mount(<Processes />);
+
+    processes.setState({
+      busyWorker: [
+        {
+          worker_id: '1',
+          job_name: 'export',
+          started_at: 1467753603,
+          args_json: '{}',
+          progress_done: 30,
+          progress_total: 120
+        }
+      ],
+      workerPool: [
+        {
+          worker_pool_id: '1',
+          started_at: 1467753603,
+          heartbeat_at: 1467753603,
+          job_names: ['export'],
+          concurrency: 1,
+          host: 'web51',
+          pid: 123,
+          worker_ids: ['1']
+        }
+      ]
+    });
+
+    let busyWorkers = processes.find('BusyWorkers');
+    expect(busyWorkers.find('td').last().text()).toEqual('25%');
+  });
+
+  it('shows deployments', () => {
+    let processes = mount(<Processes />);
+
+    processes.setState({
+      busyWorker: [],
+      workerPool: [
+        {
+          worker_pool_id: '1',
+          started_at: 1467753603,
+          heartbeat_at: 1467753603,
+          job_names: ['export'],
+          concurrency: 1,
+          host: 'worker-7d9f8',
+          pid: 123,
+          worker_ids: ['1'],
+          version: 'a1b2c3d',
+          labels: {region: 'eu-west-1', app: 'billing'}
+        }
+      ]
+    });
+
+    expect(processes.find('td').at(0).text()).toEqual('worker-7d9f8: 123');
+    expect(processes.find('td').at(4).text()).toEqual('Version a1b2c3d, app=billing, region=eu-west-1');
+  });
+
+  it('flags stale pools', () => {
+    let processes = mount(<Processes />);
+
+    processes.setState({
+      busyWorker: [],
+      workerPool: [
+        {
+          worker_pool_id: '1',
+          started_at: 1467753603,
+          heartbeat_at: 1467753603,
+          job_names: ['export'],
+          concurrency: 1,
+          host: 'web51',
+          pid: 123,
+          worker_ids: ['1'],
+          stale: true
+        },
+        {
+          worker_pool_id: '2',
+          started_at: 1467753603,
+          heartbeat_at: 1467753693,
+          job_names: ['export'],
+          concurrency: 1,
+          host: 'web52',
+          pid: 456,
+          worker_ids: ['2']
+        }
+      ]
+    });
+
+    expect(processes.instance().staleCount).toEqual(1);
+    expect(processes.find('p').at(1).text()).toEqual('1 process(es) stopped sending heartbeats, and will be reaped.');
+    expect(processes.find('td').at(2).text()).toContain('(stale)');
+  });
 });
@@ -6,6 +6,7 @@ import cx from './cx';
 export default class Queues extends React.Component {
   static propTypes = {
     url: PropTypes.string,
+    streamURL: PropTypes.string,
   }
 
   state = {
@@ -21,6 +22,20 @@ export default class Queues extends React.Component {
       then((data) => {
         this.setState({queues: data});
       });
+
+    // Keep the counts up to date with the updates pushed by the server.
+    if (this.props.streamURL && typeof EventSource !== 'undefined') {
+      this.source = new EventSource(this.props.streamURL);
+      this.source.onmessage = (e) => {
+        this.setState({queues: JSON.parse(e.data).queues});
+      };
+    }
+  }
+
+  componentWillUnmount() {
+    if (this.source) {
+      this.source.close();
+    }
   }
 
   get queuedCount() {
@@ -44,14 +59,16 @@ export default class Queues extends React.Component {
               <tr>
                 <th>Name</th>
                 <th>Count</th>
+                <th>In progress</th>
                 <th>Latency (seconds)</th>
               </tr>
               {
                 this.state.queues.map((queue) => {
                   return (
-                    <tr key={queue.job_name}>
+                    <tr key={queue.job_name} className={queue.alert ? styles.danger : undefined}>
                       <td>{queue.job_name}</td>
                       <td>{queue.count}</td>
+                      <td>{queue.in_progress}</td>
                       <td>{queue.latency}</td>
                     </tr>
                   );
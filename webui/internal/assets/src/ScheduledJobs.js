@@ -8,12 +8,14 @@ import cx from './cx';
 export default class ScheduledJobs extends React.Component {
   static propTypes = {
     url: PropTypes.string,
+    runURL: PropTypes.string,
   }
 
   state = {
     page: 1,
     count: 0,
-    jobs: []
+    jobs: [],
+    readOnly: false
   }
 
   fetch() {
@@ -25,7 +27,8 @@ export default class ScheduledJobs extends React.Component {
       then((data) => {
         this.setState({
           count: data.count,
-          jobs: data.jobs
+          jobs: data.jobs,
+          readOnly: !!data.read_only
         });
       });
   }
@@ -34,6 +37,15 @@ export default class ScheduledJobs extends React.Component {
     this.fetch();
   }
 
+  runNow(job) {
+    if (!this.props.runURL) {
+      return;
+    }
+    fetch(`${this.props.runURL}/${job.run_at}/${job.id}`, {method: 'post', headers: {'Content-Type': 'application/json'}}).then(() => {
+      this.fetch();
+    });
+  }
+
   updatePage(page) {
     this.setState({page: page}, this.fetch);
   }
@@ -53,6 +65,7 @@ export default class ScheduledJobs extends React.Component {
                 <th>Name</th>
                 <th>Arguments</th>
                 <th>Scheduled For</th>
+                {!this.state.readOnly && <th></th>}
               </tr>
               {
                 this.state.jobs.map((job) => {
@@ -61,6 +74,7 @@ export default class ScheduledJobs extends React.Component {
                       <td>{job.name}</td>
                       <td>{JSON.stringify(job.args)}</td>
                       <td><UnixTime ts={job.run_at} /></td>
+                      {!this.state.readOnly && <td><button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.runNow(job)}>Run Now</button></td>}
                     </tr>
                   );
                 })
@@ -21,6 +21,17 @@ describe('ScheduledJobs', () => {
     expect(scheduledJobs.state().jobs.length).toEqual(2);
   });
 
+  it('hides run now when read-only', () => {
+    let scheduledJobs = mount(<ScheduledJobs />);
+    let jobs = [{id: 1, name: 'test', args: {}, run_at: 1467760821}];
+
+    scheduledJobs.setState({count: 1, jobs: jobs});
+    expect(scheduledJobs.find('button').length).toEqual(1);
+
+    scheduledJobs.setState({readOnly: true});
+    expect(scheduledJobs.find('button').length).toEqual(0);
+  });
+
   it('has pages', () => {
     let scheduledJobs = mount(<ScheduledJobs />);
 
@@ -6,6 +6,8 @@ import DeadJobs from './DeadJobs';
 import Queues from './Queues';
 import RetryJobs from './RetryJobs';
 import ScheduledJobs from './ScheduledJobs';
+import Enqueue from './Enqueue';
+import DeadJob from './DeadJob';
 import { Router, Route, Link, IndexRedirect, hashHistory } from 'react-router';
 import styles from './bootstrap.min.css';
 import cx from './cx';
@@ -36,6 +38,7 @@ class App extends React.Component {
                 <li><Link to="/retry_jobs">Retry Jobs</Link></li>
                 <li><Link to="/scheduled_jobs">Scheduled Jobs</Link></li>
                 <li><Link to="/dead_jobs">Dead Jobs</Link></li>
+                <li><Link to="/enqueue">Enqueue Job</Link></li>
               </ul>
             </nav>
           </aside>
@@ -51,18 +54,23 @@ render(
   <Router history={hashHistory}>
     <Route path="/" component={App}>
       <Route path="/processes" component={ () => <Processes busyWorkerURL={App.apiURL("/busy_workers")} workerPoolURL={App.apiURL("/worker_pools")} /> } />
-      <Route path="/queues" component={ () => <Queues url={App.apiURL("/queues")} /> } />
+      <Route path="/queues" component={ () => <Queues url={App.apiURL("/queues")} streamURL={App.apiURL("/stream")} /> } />
       <Route path="/retry_jobs" component={ () => <RetryJobs url={App.apiURL("/retry_jobs")} /> } />
-      <Route path="/scheduled_jobs" component={ () => <ScheduledJobs url={App.apiURL("/scheduled_jobs")} /> } />
+      <Route path="/scheduled_jobs" component={ () => <ScheduledJobs url={App.apiURL("/scheduled_jobs")} runURL={App.apiURL("/run_scheduled_job")} /> } />
       <Route path="/dead_jobs" component={ () =>
         <DeadJobs
           fetchURL={App.apiURL("/dead_jobs")}
-          retryURL={App.apiURL("/retry_dead_job")}
+          retryURL={App.apiURL("/retry_dead_jobs")}
           retryAllURL={App.apiURL("/retry_all_dead_jobs")}
-          deleteURL={App.apiURL("/delete_dead_job")}
+          deleteURL={App.apiURL("/delete_dead_jobs")}
           deleteAllURL={App.apiURL("/delete_all_dead_jobs")}
+          exportURL={App.apiURL("/dead_jobs/export")}
         />
       } />
+      <Route path="/dead_jobs/:died_at/:job_id" component={ (props) =>
+        <DeadJob url={App.apiURL(`/dead_jobs/${props.params.died_at}/${props.params.job_id}`)} />
+      } />
+      <Route path="/enqueue" component={ () => <Enqueue url={App.apiURL("/enqueue")} /> } />
       <IndexRedirect from="" to="/processes" />
     </Route>
   </Router>,
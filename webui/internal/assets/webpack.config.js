@@ -1,5 +1,4 @@
 var path = require('path');
-var webpack = require('webpack');
 
 var BUILD_DIR = path.resolve(__dirname, 'build');
 var APP_DIR = path.resolve(__dirname, 'src');
@@ -8,21 +7,26 @@ module.exports = {
   entry: APP_DIR + '/index.js',
   output: { path: BUILD_DIR, filename: 'work.js' },
   module: {
-    loaders: [
+    rules: [
       {
         test: /\.js$/,
-        loader: 'babel-loader',
         exclude: /node_modules/,
+        use: 'babel-loader'
       },
       {
         test: /\.css$/,
-        loader: 'style-loader!css-loader?modules&camelCase&-url&localIdentName=[hash:base64:5]-[local]'
+        use: [
+          'style-loader',
+          {
+            loader: 'css-loader',
+            options: { modules: true, camelCase: true, url: false, localIdentName: '[hash:base64:5]-[local]' }
+          }
+        ]
       },
       {
         test: /\.(png|woff|woff2|eot|ttf|svg)(\?[#a-z_]+)?$/,
-        loader: 'url'
+        use: 'url-loader'
       }
     ]
   }
 };
-
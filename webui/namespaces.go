@@ -0,0 +1,112 @@
+package webui
+
+import (
+	gocontext "context"
+	"fmt"
+	"html"
+	"net/url"
+
+	"github.com/gocraft/web"
+	work "github.com/teamwork/work/v2"
+)
+
+// NamespaceSummary totals up the queues in a namespace for the overview.
+type NamespaceSummary struct {
+	Namespace      string `json:"namespace"`
+	Queues         int    `json:"queues"`
+	QueuedCount    int64  `json:"queued_count"`
+	MaxLatency     int64  `json:"max_latency"`
+	ScheduledCount int64  `json:"scheduled_count"`
+	RetryCount     int64  `json:"retry_count"`
+	DeadCount      int64  `json:"dead_count"`
+}
+
+// WithNamespaces sets the namespaces listed in the overview. By default they're found by scanning Redis, which can be
+// slow with a big keyspace.
+func WithNamespaces(namespaces ...string) Option {
+	return func(s *Server) {
+		s.namespaces = namespaces
+	}
+}
+
+func (c *context) namespaceSummaries(ctx gocontext.Context) ([]*NamespaceSummary, error) {
+	namespaces := c.namespaces
+	if namespaces == nil {
+		var err error
+		namespaces, err = work.NamespacesContext(ctx, c.pool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	summaries := make([]*NamespaceSummary, 0, len(namespaces))
+	for _, ns := range namespaces {
+		nsclient := work.NewClient(ns, c.pool).WithContext(ctx)
+		queues, err := nsclient.Queues()
+		if err != nil {
+			return nil, err
+		}
+		_, scheduled, err := nsclient.ScheduledJobs(1)
+		if err != nil {
+			return nil, err
+		}
+		_, retry, err := nsclient.RetryJobs(1)
+		if err != nil {
+			return nil, err
+		}
+		_, dead, err := nsclient.DeadJobs(1)
+		if err != nil {
+			return nil, err
+		}
+
+		summary := &NamespaceSummary{
+			Namespace:      ns,
+			Queues:         len(queues),
+			ScheduledCount: scheduled,
+			RetryCount:     retry,
+			DeadCount:      dead,
+		}
+		for _, q := range queues {
+			summary.QueuedCount += q.Count
+			if q.Latency > summary.MaxLatency {
+				summary.MaxLatency = q.Latency
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, nil
+}
+
+func (c *context) namespacesJSON(rw web.ResponseWriter, r *web.Request) {
+	summaries, err := c.namespaceSummaries(r.Context())
+	render(rw, summaries, err)
+}
+
+// overview is the index page, linking to each namespace's UI.
+func (c *context) overview(rw web.ResponseWriter, r *web.Request) {
+	rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+	summaries, err := c.namespaceSummaries(r.Context())
+	if err != nil {
+		rw.WriteHeader(500)
+		fmt.Fprintf(rw, "<p>Error listing namespaces: %s</p>\n", html.EscapeString(err.Error()))
+		return
+	}
+
+	fmt.Fprintln(rw, "<h2>Welcome to workwebui.</h2>")
+	if len(summaries) == 0 {
+		fmt.Fprintln(rw, "<h4>No namespaces found. Please provide a namespace in the url.</h4>")
+		fmt.Fprintf(rw, "<h4>Example: <a href='http://localhost%s%sns/'>http://localhost%s%sns/</a></h4>\n",
+			c.hostPort, c.basePath, c.hostPort, c.basePath)
+		return
+	}
+
+	fmt.Fprintln(rw, "<table>")
+	fmt.Fprintln(rw, "<tr><th>Namespace</th><th>Queues</th><th>Queued</th><th>Max latency (seconds)</th><th>Scheduled</th><th>Retrying</th><th>Dead</th></tr>")
+	for _, s := range summaries {
+		fmt.Fprintf(rw, "<tr><td><a href='%s/'>%s</a></td><td>%d</td><td>%d</td><td>%d</td><td>%d</td><td>%d</td><td>%d</td></tr>\n",
+			html.EscapeString(url.PathEscape(s.Namespace)), html.EscapeString(s.Namespace),
+			s.Queues, s.QueuedCount, s.MaxLatency, s.ScheduledCount, s.RetryCount, s.DeadCount)
+	}
+	fmt.Fprintln(rw, "</table>")
+}
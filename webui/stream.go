@@ -0,0 +1,98 @@
+package webui
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gocraft/web"
+	work "github.com/teamwork/work/v2"
+)
+
+const defaultStreamInterval = 5 * time.Second
+
+// streamUpdate is the data pushed by the /:namespace/stream endpoint.
+type streamUpdate struct {
+	Queues         []queueStatus `json:"queues"`
+	BusyWorkers    int           `json:"busy_workers"`
+	ScheduledCount int64         `json:"scheduled_count"`
+	RetryCount     int64         `json:"retry_count"`
+	DeadCount      int64         `json:"dead_count"`
+}
+
+// WithStreamInterval sets how often the /:namespace/stream endpoint pushes updates. It defaults to 5 seconds.
+func WithStreamInterval(interval time.Duration) Option {
+	return func(s *Server) {
+		s.streamInterval = interval
+	}
+}
+
+func (c *context) streamStats(nsclient *work.Client) (*streamUpdate, error) {
+	queues, err := nsclient.Queues()
+	if err != nil {
+		return nil, err
+	}
+	observations, err := nsclient.WorkerObservations()
+	if err != nil {
+		return nil, err
+	}
+	_, scheduled, err := nsclient.ScheduledJobs(1)
+	if err != nil {
+		return nil, err
+	}
+	_, retry, err := nsclient.RetryJobs(1)
+	if err != nil {
+		return nil, err
+	}
+	_, dead, err := nsclient.DeadJobs(1)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &streamUpdate{
+		Queues:         c.queueStatuses(queues),
+		ScheduledCount: scheduled,
+		RetryCount:     retry,
+		DeadCount:      dead,
+	}
+	for _, ob := range observations {
+		if ob.IsBusy {
+			stats.BusyWorkers++
+		}
+	}
+	return stats, nil
+}
+
+// stream pushes streamUpdates as Server-Sent Events until the client goes away or the server is stopped. Errors getting
+// the stats are sent as "error" events.
+func (c *context) stream(rw web.ResponseWriter, r *web.Request) {
+	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+
+	ticker := time.NewTicker(c.streamInterval)
+	defer ticker.Stop()
+
+	for {
+		stats, err := c.streamStats(nsclient)
+		var data []byte
+		if err == nil {
+			data, err = json.Marshal(stats)
+		}
+		if err != nil {
+			data, _ = json.Marshal(map[string]string{"error": err.Error()})
+			fmt.Fprintf(rw, "event: error\ndata: %s\n\n", data)
+		} else {
+			fmt.Fprintf(rw, "data: %s\n\n", data)
+		}
+		rw.Flush()
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-c.stopStreams:
+			return
+		case <-ticker.C:
+		}
+	}
+}
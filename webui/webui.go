@@ -1,41 +1,118 @@
 package webui
 
 import (
+	"bytes"
+	gocontext "context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
-	"github.com/braintree/manners"
 	"github.com/gocraft/web"
-	"github.com/gomodule/redigo/redis"
 	work "github.com/teamwork/work/v2"
 	"github.com/teamwork/work/v2/webui/internal/assets"
 )
 
 // Server implements an HTTP server which exposes a JSON API to view and manage gocraft/work items.
 type Server struct {
-	pool     *redis.Pool
+	pool     work.Pool
 	hostPort string
-	server   *manners.GracefulServer
+	server   *http.Server
 	wg       sync.WaitGroup
+	stopOnce sync.Once
 	router   *web.Router
+
+	thresholds     map[string]Threshold
+	auth           func(http.Handler) http.Handler
+	enqueueEnabled bool // see WithEnqueue
+	readOnly       bool
+	namespaces     []string
+	basePath       string
+
+	streamInterval time.Duration
+	stopStreams    chan struct{}
+}
+
+// Option configures a Server. See NewServer.
+type Option func(*Server)
+
+// WithReadOnly disables the endpoints that retry and delete dead jobs, and hides their buttons in the UI, so the server
+// can safely be used for dashboards.
+func WithReadOnly() Option {
+	return func(s *Server) {
+		s.readOnly = true
+	}
+}
+
+// WithBasePath serves the UI and API under basePath, eg, "/work/", for use behind a proxy that routes by path. Requests
+// must still include basePath when they reach the server.
+func WithBasePath(basePath string) Option {
+	return func(s *Server) {
+		s.basePath = basePath
+	}
+}
+
+// WithTimeouts sets the read and write timeouts of the HTTP server. Zero means no timeout, which is the default. A write
+// timeout also cuts off the /:namespace/stream endpoint, so should be longer than clients are expected to stay connected.
+func WithTimeouts(read, write time.Duration) Option {
+	return func(s *Server) {
+		s.server.ReadTimeout = read
+		s.server.WriteTimeout = write
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used by StartTLS.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(s *Server) {
+		s.server.TLSConfig = config
+	}
 }
 
 type context struct {
 	*Server
 }
 
+// NewHandler returns the UI and API as an http.Handler, to mount in an existing HTTP server, eg, behind its own auth
+// middleware, instead of listening on a port of its own. To mount it under a path, pass WithBasePath with that path:
+//
+//	mux.Handle("/work/", webui.NewHandler(pool, webui.WithBasePath("/work/")))
+func NewHandler(pool work.Pool, opts ...Option) http.Handler {
+	return NewServer(pool, "", opts...).server.Handler
+}
+
 // NewServer creates and returns a new server. The hostPort param is the address to bind on to expose the API.
-func NewServer(pool *redis.Pool, hostPort string) *Server {
+func NewServer(pool work.Pool, hostPort string, opts ...Option) *Server {
 	router := web.New(context{})
 	server := &Server{
 		pool:     pool,
 		hostPort: hostPort,
-		server:   manners.NewWithServer(&http.Server{Addr: hostPort, Handler: router}),
+		server:   &http.Server{Addr: hostPort, Handler: router},
 		router:   router,
+
+		streamInterval: defaultStreamInterval,
+		stopStreams:    make(chan struct{}),
 	}
+	for _, opt := range opts {
+		opt(server)
+	}
+	if trimmed := strings.Trim(server.basePath, "/"); trimmed != "" {
+		server.basePath = "/" + trimmed + "/"
+	} else {
+		server.basePath = "/"
+	}
+	if server.basePath != "/" {
+		server.server.Handler = stripBasePath(server.basePath, router)
+	}
+	if server.auth != nil {
+		server.server.Handler = server.auth(server.server.Handler)
+	}
+	server.server.Handler = server.withHealthChecks(server.server.Handler)
 
 	router.Middleware(func(c *context, rw web.ResponseWriter, r *web.Request, next web.NextMiddlewareFunc) {
 		c.Server = server
@@ -45,51 +122,106 @@ func NewServer(pool *redis.Pool, hostPort string) *Server {
 		rw.Header().Set("Content-Type", "application/json; charset=utf-8")
 		next(rw, r)
 	})
+	router.Middleware(func(c *context, rw web.ResponseWriter, r *web.Request, next web.NextMiddlewareFunc) {
+		if c.readOnly && r.Method != http.MethodGet && r.Method != http.MethodHead {
+			rw.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(rw, `{"error": "read-only mode"}`)
+			return
+		}
+		next(rw, r)
+	})
+	router.Middleware(rejectCrossSite)
 	router.Get("/:namespace/queues", (*context).queues)
+	router.Get("/:namespace/queues/history", (*context).queueDepthHistory)
+	router.Get("/:namespace/queues/:job_name/jobs", (*context).queuedJobs)
 	router.Get("/:namespace/worker_pools", (*context).workerPools)
+	router.Get("/:namespace/worker_pools/:pool_id", (*context).workerPool)
 	router.Get("/:namespace/busy_workers", (*context).busyWorkers)
+	router.Get("/:namespace/periodic_jobs", (*context).periodicJobs)
 	router.Get("/:namespace/retry_jobs", (*context).retryJobs)
 	router.Get("/:namespace/scheduled_jobs", (*context).scheduledJobs)
 	router.Get("/:namespace/dead_jobs", (*context).deadJobs)
+	router.Get("/:namespace/dead_jobs/export", (*context).exportDeadJobs)
+	router.Get("/:namespace/dead_jobs/:died_at:\\d.*/:job_id", (*context).deadJob)
+	router.Get("/:namespace/alerts", (*context).alerts)
+	router.Get("/:namespace/stream", (*context).stream)
+	router.Get("/:namespace/stats", (*context).stats)
+	router.Get("/:namespace/stats/history", (*context).statsHistory)
+	router.Get("/:namespace/stats/jobs", (*context).jobTypeStats)
 	router.Post("/:namespace/delete_dead_job/:died_at:\\d.*/:job_id", (*context).deleteDeadJob)
 	router.Post("/:namespace/retry_dead_job/:died_at:\\d.*/:job_id", (*context).retryDeadJob)
+	router.Post("/:namespace/queues/:job_name/delete_job/:job_id", (*context).deleteQueuedJob)
+	router.Post("/:namespace/queues/:job_name/flush", (*context).flushQueue)
+	router.Post("/:namespace/run_scheduled_job/:scheduled_for:\\d.*/:job_id", (*context).runScheduledJob)
+	router.Post("/:namespace/enqueue", (*context).enqueue)
+	router.Post("/:namespace/delete_dead_jobs", (*context).deleteDeadJobs)
+	router.Post("/:namespace/retry_dead_jobs", (*context).retryDeadJobs)
 	router.Post("/:namespace/delete_all_dead_jobs", (*context).deleteAllDeadJobs)
+	router.Post("/:namespace/dead_jobs/:job_name/delete_all", (*context).deleteDeadJobsByName)
+	router.Post("/:namespace/dead_jobs/:job_name/retry_all", (*context).retryDeadJobsByName)
 	router.Post("/:namespace/retry_all_dead_jobs", (*context).retryAllDeadJobs)
 
-	router.Get("/", func(c *context, rw web.ResponseWriter, req *web.Request) {
-		rw.Header().Set("Content-Type", "text/html; charset=utf-8")
-		fmt.Fprintln(rw, "<h2>Welcome to workwebui.</h2>")
-		fmt.Fprintln(rw, "<h4>Please provide a namespace in the url.</h4>")
-		fmt.Fprintf(rw, "<h4>Example: <a href='http://localhost%s/ns/'>http://localhost%s/ns/</a></h4>",
-			hostPort, hostPort)
-	})
+	router.Get("/namespaces", (*context).namespacesJSON)
+	router.Get("/", (*context).overview)
 
 	//
 	// Build the HTML page:
 	//
+	index, indexErr := assets.Asset("index.html")
+	// The page is built to load work.js from the root.
+	index = bytes.Replace(index, []byte(`src="/work.js"`), []byte(`src="`+server.basePath+`work.js"`), 1)
+	script, scriptErr := assets.Asset("work.js")
+	indexETag, scriptETag := assetETag(index), assetETag(script)
+
 	assetRouter := router.Subrouter(context{}, "")
 	assetRouter.Get("/:namespace", func(c *context, rw web.ResponseWriter, req *web.Request) {
 		rw.Header().Set("Content-Type", "text/html; charset=utf-8")
-		b, err := assets.Asset("index.html")
-		if err != nil {
+		if indexErr != nil {
 			rw.WriteHeader(http.StatusNotFound)
 			return
 		}
-		rw.Write(b)
+		serveAsset(rw, req.Request, indexETag, index)
 	})
 	assetRouter.Get("/work.js", func(c *context, rw web.ResponseWriter, req *web.Request) {
 		rw.Header().Set("Content-Type", "application/javascript; charset=utf-8")
-		b, err := assets.Asset("work.js")
-		if err != nil {
+		if scriptErr != nil {
 			rw.WriteHeader(http.StatusNotFound)
 			return
 		}
-		rw.Write(b)
+		serveAsset(rw, req.Request, scriptETag, script)
 	})
 
 	return server
 }
 
+// assetETag returns a strong ETag for an asset's contents.
+func assetETag(b []byte) string {
+	sum := sha256.Sum256(b)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// serveAsset serves one of the embedded assets. They're only cached until they've been revalidated with their ETag,
+// since their names don't change from one version to the next.
+func serveAsset(rw http.ResponseWriter, r *http.Request, etag string, b []byte) {
+	rw.Header().Set("ETag", etag)
+	rw.Header().Set("Cache-Control", "no-cache")
+	http.ServeContent(rw, r, "", time.Time{}, bytes.NewReader(b))
+}
+
+// stripBasePath removes basePath from the start of the requests' paths before they're passed to h, redirecting
+// requests to basePath without its trailing slash.
+func stripBasePath(basePath string, h http.Handler) http.Handler {
+	prefix := strings.TrimSuffix(basePath, "/")
+	stripped := http.StripPrefix(prefix, h)
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == prefix {
+			http.Redirect(rw, r, basePath, http.StatusMovedPermanently)
+			return
+		}
+		stripped.ServeHTTP(rw, r)
+	})
+}
+
 // Start starts the server listening for requests on the hostPort specified in NewServer.
 func (w *Server) Start() {
 	w.wg.Add(1)
@@ -99,26 +231,92 @@ func (w *Server) Start() {
 	}(w)
 }
 
+// StartTLS is like Start, but serves HTTPS. The certificate and key files can be left empty if the config passed to
+// WithTLSConfig has the certificates.
+func (w *Server) StartTLS(certFile, keyFile string) {
+	w.wg.Add(1)
+	go func(w *Server) {
+		w.server.ListenAndServeTLS(certFile, keyFile)
+		w.wg.Done()
+	}(w)
+}
+
+// Shutdown stops the server from accepting new requests and waits for those in progress to finish, or for ctx to be
+// done, in which case it returns ctx's error.
+func (w *Server) Shutdown(ctx gocontext.Context) error {
+	w.stopOnce.Do(func() {
+		close(w.stopStreams)
+	})
+	err := w.server.Shutdown(ctx)
+	if err != nil {
+		return err
+	}
+	w.wg.Wait()
+	return nil
+}
+
 // Stop stops the server and blocks until it has finished.
 func (w *Server) Stop() {
-	w.server.Close()
-	w.wg.Wait()
+	w.Shutdown(gocontext.Background())
+}
+
+// client returns a client for the request's namespace whose calls give up once the request is done, eg, because the
+// browser went away or the server is shutting down.
+func (c *context) client(r *web.Request) *work.Client {
+	return work.NewClient(r.PathParams["namespace"], c.pool).WithContext(r.Context())
 }
 
 func (c *context) queues(rw web.ResponseWriter, r *web.Request) {
-	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
-	response, err := nsclient.Queues()
-	render(rw, response, err)
+	nsclient := c.client(r)
+	queues, err := nsclient.Queues()
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	render(rw, c.queueStatuses(queues), err)
+}
+
+// queueStatus is a queue flagged with whether it's over its Threshold.
+type queueStatus struct {
+	*work.Queue
+	Alert bool `json:"alert,omitempty"`
+}
+
+func (c *context) queueStatuses(queues []*work.Queue) []queueStatus {
+	statuses := make([]queueStatus, 0, len(queues))
+	for _, q := range queues {
+		statuses = append(statuses, queueStatus{Queue: q, Alert: len(c.breaches(q)) > 0})
+	}
+	return statuses
 }
 
 func (c *context) workerPools(rw web.ResponseWriter, r *web.Request) {
-	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	nsclient := c.client(r)
+	nsclient.HideStalePools(r.URL.Query().Get("hide_stale") == "true")
 	response, err := nsclient.WorkerPoolHeartbeats()
 	render(rw, response, err)
 }
 
+func (c *context) workerPool(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	response, err := nsclient.WorkerPoolStatus(r.PathParams["pool_id"])
+	if err == work.ErrWorkerPoolNotFound {
+		rw.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(rw, `{"error": "%s"}`, err.Error())
+		return
+	}
+	render(rw, response, err)
+}
+
+func (c *context) periodicJobs(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	response, err := nsclient.PeriodicJobs()
+	render(rw, response, err)
+}
+
 func (c *context) busyWorkers(rw web.ResponseWriter, r *web.Request) {
-	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	nsclient := c.client(r)
 	observations, err := nsclient.WorkerObservations()
 	if err != nil {
 		renderError(rw, err)
@@ -136,14 +334,14 @@ func (c *context) busyWorkers(rw web.ResponseWriter, r *web.Request) {
 }
 
 func (c *context) retryJobs(rw web.ResponseWriter, r *web.Request) {
-	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	nsclient := c.client(r)
 	page, err := parsePage(r)
 	if err != nil {
 		renderError(rw, err)
 		return
 	}
 
-	jobs, count, err := nsclient.RetryJobs(page)
+	jobs, count, err := nsclient.FilterRetryJobs(page, parseFilter(r))
 	if err != nil {
 		renderError(rw, err)
 		return
@@ -157,52 +355,222 @@ func (c *context) retryJobs(rw web.ResponseWriter, r *web.Request) {
 	render(rw, response, err)
 }
 
-func (c *context) scheduledJobs(rw web.ResponseWriter, r *web.Request) {
-	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+func (c *context) queuedJobs(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
 	page, err := parsePage(r)
 	if err != nil {
 		renderError(rw, err)
 		return
 	}
 
-	jobs, count, err := nsclient.ScheduledJobs(page)
+	jobs, count, err := nsclient.QueuedJobs(r.PathParams["job_name"], page)
 	if err != nil {
 		renderError(rw, err)
 		return
 	}
 
 	response := struct {
-		Count int64                `json:"count"`
-		Jobs  []*work.ScheduledJob `json:"jobs"`
+		Count int64       `json:"count"`
+		Jobs  []*work.Job `json:"jobs"`
 	}{Count: count, Jobs: jobs}
 
 	render(rw, response, err)
 }
 
+func (c *context) scheduledJobs(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	page, err := parsePage(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	jobs, count, err := nsclient.FilterScheduledJobs(page, parseFilter(r))
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	response := struct {
+		Count    int64                `json:"count"`
+		Jobs     []*work.ScheduledJob `json:"jobs"`
+		ReadOnly bool                 `json:"read_only,omitempty"`
+	}{Count: count, Jobs: jobs, ReadOnly: c.readOnly}
+
+	render(rw, response, err)
+}
+
 func (c *context) deadJobs(rw web.ResponseWriter, r *web.Request) {
-	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	nsclient := c.client(r)
 	page, err := parsePage(r)
 	if err != nil {
 		renderError(rw, err)
 		return
 	}
 
-	jobs, count, err := nsclient.DeadJobs(page)
+	jobs, count, err := nsclient.FilterDeadJobs(page, parseFilter(r))
 	if err != nil {
 		renderError(rw, err)
 		return
 	}
 
+	// Backtraces can be long, so they're left to the dead job endpoint.
+	for _, job := range jobs {
+		job.Backtrace = ""
+	}
+
 	response := struct {
-		Count int64           `json:"count"`
-		Jobs  []*work.DeadJob `json:"jobs"`
-	}{Count: count, Jobs: jobs}
+		Count    int64           `json:"count"`
+		Jobs     []*work.DeadJob `json:"jobs"`
+		ReadOnly bool            `json:"read_only,omitempty"`
+	}{Count: count, Jobs: jobs, ReadOnly: c.readOnly}
 
 	render(rw, response, err)
 }
 
+func (c *context) deadJob(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	diedAt, err := strconv.ParseInt(r.PathParams["died_at"], 10, 64)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	job, err := nsclient.DeadJob(diedAt, r.PathParams["job_id"])
+	if err == work.ErrJobNotFound {
+		rw.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(rw, `{"error": "%s"}`, err.Error())
+		return
+	}
+	render(rw, job, err)
+}
+
+func (c *context) stats(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	stats, err := nsclient.Stats()
+	render(rw, stats, err)
+}
+
+func (c *context) statsHistory(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	if err := r.ParseForm(); err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	d := time.Hour
+	if rangeStr := r.Form.Get("range"); rangeStr != "" {
+		var err error
+		d, err = time.ParseDuration(rangeStr)
+		if err != nil {
+			renderError(rw, err)
+			return
+		}
+	}
+
+	history, err := nsclient.ThroughputHistory(d)
+	render(rw, history, err)
+}
+
+// queueDepthHistory returns the queue depths recorded by a work.QueueDepthSampler over range, as for statsHistory.
+func (c *context) queueDepthHistory(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	if err := r.ParseForm(); err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	d := time.Hour
+	if rangeStr := r.Form.Get("range"); rangeStr != "" {
+		var err error
+		d, err = time.ParseDuration(rangeStr)
+		if err != nil {
+			renderError(rw, err)
+			return
+		}
+	}
+
+	history, err := nsclient.QueueDepthHistory(d)
+	render(rw, history, err)
+}
+
+func (c *context) jobTypeStats(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	stats, err := nsclient.JobTypeStats()
+	render(rw, stats, err)
+}
+
+// enqueueRequest is the body of a request to the enqueue endpoint.
+type enqueueRequest struct {
+	Name         string                 `json:"name"`
+	Args         map[string]interface{} `json:"args"`
+	DelaySeconds int64                  `json:"delay_seconds"`
+}
+
+// enqueue enqueues a job of a known type, eg, for support to run a one off job. It's only enabled with WithAuth,
+// WithBasicAuth or WithEnqueue, since anyone who can reach it can run any job.
+func (c *context) enqueue(rw web.ResponseWriter, r *web.Request) {
+	if c.auth == nil && !c.enqueueEnabled {
+		rw.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(rw, `{"error": "enqueueing jobs requires auth"}`)
+		return
+	}
+
+	ns := r.PathParams["namespace"]
+	var req enqueueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(rw, `{"error": %q}`, err.Error())
+		return
+	}
+
+	queues, err := c.client(r).Queues()
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+	known := false
+	for _, q := range queues {
+		if q.JobName == req.Name {
+			known = true
+			break
+		}
+	}
+	if !known {
+		rw.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(rw, `{"error": %q}`, "unknown job "+req.Name)
+		return
+	}
+
+	enqueuer := work.NewEnqueuer(ns, c.pool)
+	if req.DelaySeconds > 0 {
+		job, err := enqueuer.EnqueueInContext(r.Context(), req.Name, req.DelaySeconds, req.Args)
+		render(rw, job, err)
+		return
+	}
+	job, err := enqueuer.EnqueueContext(r.Context(), req.Name, req.Args)
+	render(rw, job, err)
+}
+
+func (c *context) runScheduledJob(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	scheduledFor, err := strconv.ParseInt(r.PathParams["scheduled_for"], 10, 64)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	err = nsclient.RunScheduledJobNow(scheduledFor, r.PathParams["job_id"])
+	if err == work.ErrNotEnqueued {
+		rw.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(rw, `{"error": "%s"}`, err.Error())
+		return
+	}
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
 func (c *context) deleteDeadJob(rw web.ResponseWriter, r *web.Request) {
-	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	nsclient := c.client(r)
 	diedAt, err := strconv.ParseInt(r.PathParams["died_at"], 10, 64)
 	if err != nil {
 		renderError(rw, err)
@@ -214,8 +582,22 @@ func (c *context) deleteDeadJob(rw web.ResponseWriter, r *web.Request) {
 	render(rw, map[string]string{"status": "ok"}, err)
 }
 
+func (c *context) deleteQueuedJob(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	err := nsclient.DeleteQueuedJob(r.PathParams["job_name"], r.PathParams["job_id"])
+
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) flushQueue(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	count, err := nsclient.FlushQueue(r.PathParams["job_name"])
+
+	render(rw, map[string]int64{"count": count}, err)
+}
+
 func (c *context) retryDeadJob(rw web.ResponseWriter, r *web.Request) {
-	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	nsclient := c.client(r)
 	diedAt, err := strconv.ParseInt(r.PathParams["died_at"], 10, 64)
 	if err != nil {
 		renderError(rw, err)
@@ -227,14 +609,52 @@ func (c *context) retryDeadJob(rw web.ResponseWriter, r *web.Request) {
 	render(rw, map[string]string{"status": "ok"}, err)
 }
 
+func (c *context) deleteDeadJobs(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	var jobs []work.DeadJobRef
+	if err := json.NewDecoder(r.Body).Decode(&jobs); err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	count, err := nsclient.DeleteDeadJobs(jobs)
+
+	render(rw, map[string]interface{}{"status": "ok", "count": count}, err)
+}
+
+func (c *context) retryDeadJobs(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	var jobs []work.DeadJobRef
+	if err := json.NewDecoder(r.Body).Decode(&jobs); err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	count, err := nsclient.RetryDeadJobs(jobs)
+
+	render(rw, map[string]interface{}{"status": "ok", "count": count}, err)
+}
+
+func (c *context) deleteDeadJobsByName(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	count, err := nsclient.DeleteDeadJobsByName(r.PathParams["job_name"])
+	render(rw, map[string]interface{}{"status": "ok", "count": count}, err)
+}
+
+func (c *context) retryDeadJobsByName(rw web.ResponseWriter, r *web.Request) {
+	nsclient := c.client(r)
+	count, err := nsclient.RetryDeadJobsByName(r.PathParams["job_name"])
+	render(rw, map[string]interface{}{"status": "ok", "count": count}, err)
+}
+
 func (c *context) deleteAllDeadJobs(rw web.ResponseWriter, r *web.Request) {
-	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	nsclient := c.client(r)
 	err := nsclient.DeleteAllDeadJobs()
 	render(rw, map[string]string{"status": "ok"}, err)
 }
 
 func (c *context) retryAllDeadJobs(rw web.ResponseWriter, r *web.Request) {
-	nsclient := work.NewClient(r.PathParams["namespace"], c.pool)
+	nsclient := c.client(r)
 	err := nsclient.RetryAllDeadJobs()
 	render(rw, map[string]string{"status": "ok"}, err)
 }
@@ -258,6 +678,17 @@ func renderError(rw http.ResponseWriter, err error) {
 	fmt.Fprintf(rw, `{"error": "%s"}`, err.Error())
 }
 
+// parseFilter reads a work.JobFilter from the query parameters name, args (ArgsContains), arg_path and arg_value. It
+// must be called after parsePage.
+func parseFilter(r *web.Request) work.JobFilter {
+	return work.JobFilter{
+		Name:         r.Form.Get("name"),
+		ArgsContains: r.Form.Get("args"),
+		ArgPath:      r.Form.Get("arg_path"),
+		ArgValue:     r.Form.Get("arg_value"),
+	}
+}
+
 func parsePage(r *web.Request) (uint, error) {
 	err := r.ParseForm()
 	if err != nil {
@@ -1,10 +1,16 @@
 package webui
 
 import (
+	"bufio"
+	"bytes"
+	gocontext "context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -24,6 +30,20 @@ func TestWebUIStartStop(t *testing.T) {
 	s.Stop()
 }
 
+func TestWebUIShutdown(t *testing.T) {
+	s := NewServer(nil, "127.0.0.1:0", WithTimeouts(5*time.Second, time.Minute))
+	assert.Equal(t, 5*time.Second, s.server.ReadTimeout)
+	assert.Equal(t, time.Minute, s.server.WriteTimeout)
+
+	s.Start()
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, s.Shutdown(ctx))
+
+	// Stopping again is harmless.
+	s.Stop()
+}
+
 type TestContext struct{}
 
 func TestWebUIQueues(t *testing.T) {
@@ -82,6 +102,223 @@ func TestWebUIQueues(t *testing.T) {
 	assert.EqualValues(t, 0, foomap["latency"])
 }
 
+func TestWebUIAlerts(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := work.NewWorkerPool(TestContext{}, 10, ns, pool)
+	wp.Job("wat", func(job *work.Job) error { return nil })
+	wp.Job("foo", func(job *work.Job) error { return nil })
+	wp.Start()
+	wp.Stop()
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+	_, err := enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(pool, ":6666", WithThresholds(map[string]Threshold{DefaultThreshold: {MaxCount: 2}}))
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/%s/alerts", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 503, recorder.Code)
+
+	var alerts []*Alert
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &alerts))
+	if assert.Len(t, alerts, 1) {
+		assert.Equal(t, "wat", alerts[0].JobName)
+		assert.EqualValues(t, 3, alerts[0].Count)
+		assert.Equal(t, []string{"count"}, alerts[0].Breached)
+	}
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", fmt.Sprintf("/%s/queues", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	var queues []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &queues))
+	assert.Len(t, queues, 2)
+	assert.Equal(t, "foo", queues[0]["job_name"])
+	assert.Nil(t, queues[0]["alert"])
+	assert.Equal(t, "wat", queues[1]["job_name"])
+	assert.Equal(t, true, queues[1]["alert"])
+
+	// Queues with their own threshold aren't checked against the default one.
+	s = NewServer(pool, ":6666", WithThresholds(map[string]Threshold{
+		DefaultThreshold: {MaxCount: 2},
+		"wat":            {MaxCount: 10},
+	}))
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", fmt.Sprintf("/%s/alerts", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "[]", recorder.Body.String())
+}
+
+func TestWebUIThresholdBreaches(t *testing.T) {
+	c := &context{Server: NewServer(nil, ":6666", WithThresholds(map[string]Threshold{
+		"wat": {MaxCount: 10, MaxLatency: time.Minute},
+		"foo": {MaxLatency: time.Minute},
+	}))}
+
+	assert.Nil(t, c.breaches(&work.Queue{JobName: "wat", Count: 10, Latency: 60}))
+	assert.Equal(t, []string{"count"}, c.breaches(&work.Queue{JobName: "wat", Count: 11, Latency: 60}))
+	assert.Equal(t, []string{"count", "latency"}, c.breaches(&work.Queue{JobName: "wat", Count: 11, Latency: 61}))
+	assert.Equal(t, []string{"latency"}, c.breaches(&work.Queue{JobName: "foo", Count: 1000, Latency: 61}))
+	assert.Nil(t, c.breaches(&work.Queue{JobName: "bar", Count: 1000, Latency: 1000}))
+}
+
+func TestWebUINamespaces(t *testing.T) {
+	pool := newTestPool(":6379")
+	cleanKeyspace("work", pool)
+	cleanKeyspace("other", pool)
+
+	for _, ns := range []string{"work", "other"} {
+		wp := work.NewWorkerPool(TestContext{}, 1, ns, pool)
+		wp.Job("wat", func(job *work.Job) error { return nil })
+		wp.Start()
+		wp.Stop()
+	}
+	enqueuer := work.NewEnqueuer("other", pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(pool, ":6666")
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/namespaces", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	var summaries []*NamespaceSummary
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &summaries))
+	byNamespace := make(map[string]*NamespaceSummary)
+	for _, s := range summaries {
+		byNamespace[s.Namespace] = s
+	}
+	if assert.Contains(t, byNamespace, "other") {
+		assert.Equal(t, 1, byNamespace["other"].Queues)
+		assert.EqualValues(t, 2, byNamespace["other"].QueuedCount)
+	}
+	assert.Contains(t, byNamespace, "work")
+
+	s = NewServer(pool, ":6666", WithNamespaces("other"))
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "<a href='other/'>other</a>")
+	assert.NotContains(t, recorder.Body.String(), "<a href='work/'>")
+}
+
+func TestWebUIStream(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := work.NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *work.Job) error { return nil })
+	wp.Start()
+	wp.Stop()
+	_, err := work.NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(pool, ":6666", WithStreamInterval(10*time.Millisecond))
+	ts := httptest.NewServer(s.router)
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/" + ns + "/stream")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	scanner := bufio.NewScanner(resp.Body)
+	var events []*streamUpdate
+	for len(events) < 2 && scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		var stats streamUpdate
+		assert.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &stats))
+		events = append(events, &stats)
+	}
+	if assert.Len(t, events, 2) {
+		if assert.Len(t, events[0].Queues, 1) {
+			assert.Equal(t, "wat", events[0].Queues[0].JobName)
+			assert.EqualValues(t, 1, events[0].Queues[0].Count)
+		}
+		assert.Equal(t, 0, events[0].BusyWorkers)
+		assert.EqualValues(t, 0, events[0].DeadCount)
+	}
+}
+
+func TestWebUIStats(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := work.NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *work.Job) error { return nil })
+	_, err := work.NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	s := NewServer(pool, ":6666")
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/%s/stats", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	var stats work.Stats
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &stats))
+	assert.EqualValues(t, 1, stats.Processed)
+	assert.EqualValues(t, 0, stats.Failed)
+}
+
+func TestWebUIStatsHistory(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := work.NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *work.Job) error { return nil })
+	_, err := work.NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	s := NewServer(pool, ":6666")
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/%s/stats/history?range=10m", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	var history []*work.Throughput
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &history))
+	if assert.Len(t, history, 10) {
+		var processed int64
+		for _, h := range history {
+			processed += h.Processed
+		}
+		assert.EqualValues(t, 1, processed)
+	}
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", fmt.Sprintf("/%s/stats/history?range=lots", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 500, recorder.Code)
+}
+
 func TestWebUIWorkerPools(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -120,6 +357,92 @@ func TestWebUIWorkerPools(t *testing.T) {
 	// NOTE: WorkerPoolStatus is tested elsewhere.
 }
 
+func TestWebUIPeriodicJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := work.NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *work.Job) error { return nil })
+	wp.PeriodicallyEnqueue("0 0 * * * *", "wat")
+	wp.Start()
+	defer wp.Stop()
+
+	time.Sleep(20 * time.Millisecond)
+
+	s := NewServer(pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/%s/periodic_jobs", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	var res []*work.PeriodicJob
+	err := json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "wat", res[0].Name)
+		assert.Equal(t, "0 0 * * * *", res[0].Spec)
+		assert.True(t, res[0].NextDueAt > time.Now().Unix())
+	}
+}
+
+func TestWebUIWorkerPool(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	wp := work.NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(job *work.Job) error {
+		close(started)
+		<-finish
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+	defer close(finish)
+
+	_, err := work.NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+	<-started
+	time.Sleep(5 * time.Millisecond) // need to let obsever process
+
+	hbs, err := work.NewClient(ns, pool).WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	if !assert.Len(t, hbs, 1) {
+		return
+	}
+
+	s := NewServer(pool, ":6666")
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/%s/worker_pools/%s", ns, hbs[0].WorkerPoolID), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	var res work.WorkerPoolStatus
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, hbs[0].WorkerPoolID, res.WorkerPoolID)
+	assert.EqualValues(t, 2, res.Concurrency)
+	assert.Equal(t, []string{"wat"}, res.JobNames)
+	if assert.Len(t, res.Workers, 2) {
+		busy := 0
+		for _, ob := range res.Workers {
+			if ob.IsBusy {
+				busy++
+				assert.Equal(t, "wat", ob.JobName)
+			}
+		}
+		assert.Equal(t, 1, busy)
+	}
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", fmt.Sprintf("/%s/worker_pools/nope", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 404, recorder.Code)
+}
+
 func TestWebUIBusyWorkers(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -258,6 +581,191 @@ func TestWebUIScheduledJobs(t *testing.T) {
 	}
 }
 
+func TestWebUIQueuedJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("watter", work.Q{"a": 1})
+	assert.Nil(t, err)
+	_, err = enqueuer.Enqueue("watter", work.Q{"a": 2})
+	assert.Nil(t, err)
+
+	s := NewServer(pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/%s/queues/watter/jobs?page=1", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res struct {
+		Count int64 `json:"count"`
+		Jobs  []struct {
+			Name string                 `json:"name"`
+			Args map[string]interface{} `json:"args"`
+		} `json:"jobs"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, 2, res.Count)
+	if assert.Len(t, res.Jobs, 2) {
+		assert.Equal(t, "watter", res.Jobs[0].Name)
+		assert.EqualValues(t, 1, res.Jobs[0].Args["a"])
+		assert.EqualValues(t, 2, res.Jobs[1].Args["a"])
+	}
+}
+
+func TestWebUIDeadJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	wp := work.NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("wat", work.JobOptions{MaxFails: 1}, func(job *work.Job) error {
+		panic("dayam")
+	})
+	enqueued, err := work.NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	s := NewServer(pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/%s/dead_jobs", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res struct {
+		Jobs []*work.DeadJob `json:"jobs"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	if !assert.Equal(t, 1, len(res.Jobs)) {
+		return
+	}
+	assert.Equal(t, "", res.Jobs[0].Backtrace)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", fmt.Sprintf("/%s/dead_jobs/%d/%s", ns, res.Jobs[0].DiedAt, enqueued.ID), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var job work.DeadJob
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &job))
+	assert.Equal(t, "dayam", job.LastErr)
+	assert.Contains(t, job.Backtrace, "goroutine")
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", fmt.Sprintf("/%s/dead_jobs/%d/%s", ns, res.Jobs[0].DiedAt, "nope"), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 404, recorder.Code)
+}
+
+func TestWebUIExportDeadJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	wp := work.NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("wat", work.JobOptions{MaxFails: 1}, func(job *work.Job) error {
+		return fmt.Errorf("sorry, kid")
+	})
+	enqueuer := work.NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", work.Q{"i": i})
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	s := NewServer(pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/%s/dead_jobs/export", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, `attachment; filename="testwork-dead-jobs.json"`, recorder.Header().Get("Content-Disposition"))
+	var jobs []*work.DeadJob
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &jobs))
+	assert.Equal(t, 3, len(jobs))
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", fmt.Sprintf("/%s/dead_jobs/export?format=csv&arg_path=i&arg_value=1", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	records, err := csv.NewReader(recorder.Body).ReadAll()
+	assert.NoError(t, err)
+	if assert.Equal(t, 2, len(records)) {
+		assert.Equal(t, deadJobsCSVHeader, records[0])
+		assert.Equal(t, "wat", records[1][2])
+		assert.Equal(t, `{"i":1}`, records[1][3])
+		assert.Equal(t, "sorry, kid", records[1][5])
+	}
+
+	// An empty export is still valid JSON.
+	cleanKeyspace(ns, pool)
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", fmt.Sprintf("/%s/dead_jobs/export?format=json", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &jobs))
+	assert.Equal(t, 0, len(jobs))
+}
+
+func TestWebUIExportDeadJobsFormat(t *testing.T) {
+	s := NewServer(nil, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/ns/dead_jobs/export?format=xml", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 400, recorder.Code)
+}
+
+func TestWebUIRequestContext(t *testing.T) {
+	pool := newTestPool(":6379")
+	s := NewServer(pool, ":6666")
+
+	for _, path := range []string{"/work/queues", "/work/dead_jobs", "/namespaces"} {
+		ctx, cancel := gocontext.WithCancel(gocontext.Background())
+		cancel()
+		recorder := httptest.NewRecorder()
+		request, _ := http.NewRequestWithContext(ctx, "GET", path, nil)
+		s.router.ServeHTTP(recorder, request)
+		assert.Equal(t, 500, recorder.Code, path)
+		assert.Contains(t, recorder.Body.String(), "context canceled", path)
+	}
+}
+
+func TestWebUIRunScheduledJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	job, err := enqueuer.EnqueueIn("watter", 3600, nil)
+	assert.Nil(t, err)
+
+	s := NewServer(pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := newPostRequest(fmt.Sprintf("/%s/run_scheduled_job/%d/%s", ns, job.RunAt, job.ID), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	queues, err := work.NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(queues)) {
+		assert.EqualValues(t, 1, queues[0].Count)
+	}
+
+	recorder = httptest.NewRecorder()
+	request, _ = newPostRequest(fmt.Sprintf("/%s/run_scheduled_job/%d/%s", ns, job.RunAt, job.ID), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 404, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), work.ErrNotEnqueued.Error())
+}
+
 func TestWebUIDeadJobs(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "testwork"
@@ -311,12 +819,12 @@ func TestWebUIDeadJobs(t *testing.T) {
 
 	// Ok, now let's retry one and delete one.
 	recorder = httptest.NewRecorder()
-	request, _ = http.NewRequest("POST", fmt.Sprintf("/%s/delete_dead_job/%d/%s", ns, diedAt0, id0), nil)
+	request, _ = newPostRequest(fmt.Sprintf("/%s/delete_dead_job/%d/%s", ns, diedAt0, id0), nil)
 	s.router.ServeHTTP(recorder, request)
 	assert.Equal(t, 200, recorder.Code)
 
 	recorder = httptest.NewRecorder()
-	request, _ = http.NewRequest("POST", fmt.Sprintf("/%s/retry_dead_job/%d/%s", ns, diedAt1, id1), nil)
+	request, _ = newPostRequest(fmt.Sprintf("/%s/retry_dead_job/%d/%s", ns, diedAt1, id1), nil)
 	s.router.ServeHTTP(recorder, request)
 	assert.Equal(t, 200, recorder.Code)
 
@@ -346,6 +854,79 @@ func TestWebUIDeadJobs(t *testing.T) {
 	}
 }
 
+func TestWebUIDeadJobsDeleteRetrySelected(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+
+	wp := work.NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("wat", work.JobOptions{Priority: 1, MaxFails: 1}, func(job *work.Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	client := work.NewClient(ns, pool)
+	jobs, _, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 3)
+
+	s := NewServer(pool, ":6666")
+	post := func(path string, refs []work.DeadJobRef) map[string]interface{} {
+		body, _ := json.Marshal(refs)
+		recorder := httptest.NewRecorder()
+		request, _ := newPostRequest(fmt.Sprintf("/%s/%s", ns, path), bytes.NewReader(body))
+		s.router.ServeHTTP(recorder, request)
+		assert.Equal(t, 200, recorder.Code)
+		var res map[string]interface{}
+		assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+		return res
+	}
+
+	res := post("delete_dead_jobs", []work.DeadJobRef{
+		{DiedAt: jobs[0].DiedAt, JobID: jobs[0].ID},
+		{DiedAt: jobs[0].DiedAt, JobID: "gone"},
+	})
+	assert.EqualValues(t, 1, res["count"])
+
+	res = post("retry_dead_jobs", []work.DeadJobRef{
+		{DiedAt: jobs[1].DiedAt, JobID: jobs[1].ID},
+		{DiedAt: jobs[2].DiedAt, JobID: jobs[2].ID},
+	})
+	assert.EqualValues(t, 2, res["count"])
+
+	_, count, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	if assert.Len(t, queues, 1) {
+		assert.EqualValues(t, 2, queues[0].Count)
+	}
+
+	// And by name
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	res = post("dead_jobs/wat/retry_all", nil)
+	assert.EqualValues(t, 2, res["count"])
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	res = post("dead_jobs/wat/delete_all", nil)
+	assert.EqualValues(t, 2, res["count"])
+	res = post("retry_dead_jobs", nil)
+	assert.EqualValues(t, 0, res["count"])
+}
+
 func TestWebUIDeadJobsDeleteRetryAll(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "testwork"
@@ -385,9 +966,18 @@ func TestWebUIDeadJobsDeleteRetryAll(t *testing.T) {
 	assert.EqualValues(t, 2, res.Count)
 	assert.Equal(t, 2, len(res.Jobs))
 
+	// Filter them
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", fmt.Sprintf("/%s/dead_jobs?name=nope", ns), nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, res.Count)
+
 	// Ok, now let's retry all
 	recorder = httptest.NewRecorder()
-	request, _ = http.NewRequest("POST", fmt.Sprintf("/%s/retry_all_dead_jobs", ns), nil)
+	request, _ = newPostRequest(fmt.Sprintf("/%s/retry_all_dead_jobs", ns), nil)
 	s.router.ServeHTTP(recorder, request)
 	assert.Equal(t, 200, recorder.Code)
 
@@ -433,7 +1023,7 @@ func TestWebUIDeadJobsDeleteRetryAll(t *testing.T) {
 
 	// Now delete them:
 	recorder = httptest.NewRecorder()
-	request, _ = http.NewRequest("POST", fmt.Sprintf("/%s/delete_all_dead_jobs", ns), nil)
+	request, _ = newPostRequest(fmt.Sprintf("/%s/delete_all_dead_jobs", ns), nil)
 	s.router.ServeHTTP(recorder, request)
 	assert.Equal(t, 200, recorder.Code)
 
@@ -447,6 +1037,137 @@ func TestWebUIDeadJobsDeleteRetryAll(t *testing.T) {
 	assert.EqualValues(t, 0, res.Count)
 }
 
+func TestWebUIReadOnly(t *testing.T) {
+	pool := newTestPool(":6379")
+	s := NewServer(pool, ":6666", WithReadOnly())
+
+	for _, path := range []string{
+		"/ns/delete_dead_job/1467760821/abc",
+		"/ns/retry_dead_job/1467760821/abc",
+		"/ns/delete_all_dead_jobs",
+		"/ns/retry_all_dead_jobs",
+	} {
+		recorder := httptest.NewRecorder()
+		request, _ := newPostRequest(path, nil)
+		s.router.ServeHTTP(recorder, request)
+		assert.Equal(t, 403, recorder.Code, path)
+	}
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/ns/", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+}
+
+func TestWebUIEnqueue(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	// Disabled without auth.
+	s := NewServer(pool, ":6666")
+	recorder := httptest.NewRecorder()
+	request, _ := newPostRequest("/work/enqueue", strings.NewReader(`{"name": "wat"}`))
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 403, recorder.Code)
+
+	s = NewServer(pool, ":6666", WithBasicAuth("admin", "hunter2"))
+	enqueue := func(body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request, _ := newPostRequest("/work/enqueue", strings.NewReader(body))
+		request.SetBasicAuth("admin", "hunter2")
+		s.server.Handler.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	recorder = enqueue(`{"name": "nope"}`)
+	assert.Equal(t, 400, recorder.Code)
+
+	recorder = enqueue(`{"name": "wat", "args": {"a": 1}}`)
+	assert.Equal(t, 200, recorder.Code)
+	var job work.Job
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &job))
+	assert.Equal(t, "wat", job.Name)
+	assert.EqualValues(t, 1, job.ArgInt64("a"))
+
+	recorder = enqueue(`{"name": "wat", "delay_seconds": 300}`)
+	assert.Equal(t, 200, recorder.Code)
+	var scheduled work.ScheduledJob
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &scheduled))
+	assert.True(t, scheduled.RunAt > time.Now().Unix())
+
+	queues, err := work.NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(queues))
+	assert.EqualValues(t, 2, queues[0].Count)
+}
+
+func TestWebUIEnqueueWithoutAuth(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	_, err := work.NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	// Handlers mounted behind the app's own auth can opt in.
+	h := NewHandler(pool, WithEnqueue())
+	recorder := httptest.NewRecorder()
+	request, _ := newPostRequest("/work/enqueue", strings.NewReader(`{"name": "wat"}`))
+	h.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+}
+
+func TestWebUIRejectsCrossSiteRequests(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	s := NewServer(pool, ":6666", WithBasicAuth("admin", "hunter2"))
+	post := func(contentType string, headers map[string]string) int {
+		recorder := httptest.NewRecorder()
+		request, _ := http.NewRequest("POST", "http://work.example.com/work/delete_all_dead_jobs", nil)
+		request.SetBasicAuth("admin", "hunter2")
+		if contentType != "" {
+			request.Header.Set("Content-Type", contentType)
+		}
+		for k, v := range headers {
+			request.Header.Set(k, v)
+		}
+		s.server.Handler.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	// Forms can't send JSON.
+	assert.Equal(t, 415, post("", nil))
+	assert.Equal(t, 415, post("application/x-www-form-urlencoded", nil))
+	assert.Equal(t, 415, post("text/plain", nil))
+
+	assert.Equal(t, 403, post("application/json", map[string]string{"Origin": "https://evil.example.com"}))
+	assert.Equal(t, 403, post("application/json", map[string]string{"Origin": "null"}))
+	assert.Equal(t, 403, post("application/json", map[string]string{"Sec-Fetch-Site": "cross-site"}))
+
+	assert.Equal(t, 200, post("application/json", nil))
+	assert.Equal(t, 200, post("application/json; charset=utf-8", map[string]string{"Origin": "http://work.example.com"}))
+	assert.Equal(t, 200, post("application/json", map[string]string{
+		"Origin":           "https://work.example.org",
+		"X-Forwarded-Host": "work.example.org",
+	}))
+	assert.Equal(t, 200, post("application/json", map[string]string{"Sec-Fetch-Site": "same-origin"}))
+
+	// Reads aren't affected.
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "http://work.example.com/work/queues", nil)
+	request.SetBasicAuth("admin", "hunter2")
+	request.Header.Set("Origin", "https://evil.example.com")
+	s.server.Handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+}
+
 func TestWebUIAssets(t *testing.T) {
 	pool := newTestPool(":6379")
 	s := NewServer(pool, ":6666")
@@ -460,6 +1181,111 @@ func TestWebUIAssets(t *testing.T) {
 	recorder = httptest.NewRecorder()
 	request, _ = http.NewRequest("GET", "/work.js", nil)
 	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "application/javascript; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "no-cache", recorder.Header().Get("Cache-Control"))
+	etag := recorder.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/work.js", nil)
+	request.Header.Set("If-None-Match", etag)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 304, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+}
+
+func TestWebUIBasicAuth(t *testing.T) {
+	pool := newTestPool(":6379")
+	s := NewServer(pool, ":6666", WithBasicAuth("admin", "hunter2"))
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/ns/", nil)
+	s.server.Handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 401, recorder.Code)
+	assert.Equal(t, `Basic realm="workwebui"`, recorder.Header().Get("WWW-Authenticate"))
+
+	recorder = httptest.NewRecorder()
+	request, _ = newPostRequest("/ns/delete_all_dead_jobs", nil)
+	request.SetBasicAuth("admin", "wrong")
+	s.server.Handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 401, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/ns/", nil)
+	request.SetBasicAuth("admin", "hunter2")
+	s.server.Handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Regexp(t, "html", recorder.Body.String())
+}
+
+func TestWebUINewHandler(t *testing.T) {
+	pool := newTestPool(":6379")
+	mux := http.NewServeMux()
+	mux.Handle("/work/", NewHandler(pool, WithBasePath("/work/")))
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/work/ns/", nil)
+	mux.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `src="/work/work.js"`)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/work/work.js", nil)
+	mux.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+}
+
+func TestWebUIHealthChecks(t *testing.T) {
+	pool := newTestPool(":6379")
+	s := NewServer(pool, ":6666", WithBasicAuth("admin", "hunter2"), WithBasePath("/work/"))
+
+	for _, path := range []string{"/healthz", "/readyz"} {
+		recorder := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", path, nil)
+		s.server.Handler.ServeHTTP(recorder, request)
+		assert.Equal(t, 200, recorder.Code, path)
+		assert.JSONEq(t, `{"status": "ok"}`, recorder.Body.String(), path)
+	}
+
+	// Not ready without Redis.
+	s = NewServer(newTestPool("127.0.0.1:1"), ":6666")
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/readyz", nil)
+	s.server.Handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 503, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/healthz", nil)
+	s.server.Handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+}
+
+func TestWebUIBasePath(t *testing.T) {
+	pool := newTestPool(":6379")
+	s := NewServer(pool, ":6666", WithBasePath("/projects/prod/work"))
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/projects/prod/work/ns/", nil)
+	s.server.Handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `<script src="/projects/prod/work/work.js">`)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/projects/prod/work/work.js", nil)
+	s.server.Handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/projects/prod/work", nil)
+	s.server.Handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 301, recorder.Code)
+	assert.Equal(t, "/projects/prod/work/", recorder.Header().Get("Location"))
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/ns/", nil)
+	s.server.Handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 404, recorder.Code)
 }
 
 func TestWebUIIndex(t *testing.T) {
@@ -473,6 +1299,16 @@ func TestWebUIIndex(t *testing.T) {
 	assert.Regexp(t, "Welcome", body)
 }
 
+// newPostRequest returns a POST request as the UI makes it, as JSON.
+func newPostRequest(url string, body io.Reader) (*http.Request, error) {
+	request, err := http.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return request, nil
+}
+
 func newTestPool(addr string) *redis.Pool {
 	return &redis.Pool{
 		MaxActive:   3,
@@ -1,40 +1,114 @@
 package work
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
+	"sync/atomic"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
 
-const fetchKeysPerJobType = 6
+const (
+	fetchKeysPerJobType = 10
+
+	// Jobs with a MaxConcurrency hold a lease on one of the job type's concurrency slots while they run. The lease is
+	// renewed while the job is running, so if the process dies the slot is reclaimed once the lease expires.
+	concurrencyLeaseTTL         = 30 * time.Second
+	concurrencyLeaseRenewPeriod = 10 * time.Second
+
+	// How many jobs a fetch moves to the waiting lists of their busy partitions before trying the next job queue.
+	maxPartitionWaits = 10
+
+	// How long a partition stays locked if the job holding it is never finished, eg, because it was lost.
+	partitionLockTTL = 24 * time.Hour
+
+	// How long a periodic job's run lock is held if the run is never finished, unless its job type has a Timeout.
+	runLockTTL = 24 * time.Hour
+
+	// How often to check whether a job whose handler takes a context has been cancelled with Client.CancelJob.
+	cancellationCheckPeriod = time.Second
+
+	// How long a handler has to return once its job's JobOptions.Timeout has elapsed and its context is cancelled. If
+	// it doesn't, the worker abandons it, leaving it running, and fails the job with ErrJobTimeout. Until then, the
+	// job keeps its concurrency slot and the worker doesn't fetch another job.
+	jobTimeoutGracePeriod = 5 * time.Second
+)
+
+// The scripts run for single jobs, which are the same for every worker.
+var (
+	redisClaimIdempotencyKeyScript   = redis.NewScript(1, redisLuaClaimIdempotencyKey)
+	redisReleaseIdempotencyKeyScript = redis.NewScript(1, redisLuaReleaseIdempotencyKey)
+	redisReleasePartitionScript      = redis.NewScript(3, redisLuaReleasePartition)
+	redisReleaseRunLockScript        = redis.NewScript(1, redisLuaReleaseRunLock)
+)
 
 type worker struct {
 	workerID      string
 	poolID        string
 	namespace     string
-	pool          *redis.Pool
+	pool          Pool
 	jobTypes      map[string]*jobType
 	sleepBackoffs []int64
 	middleware    []*middlewareHandler
 	contextType   reflect.Type
+	metricsSink   MetricsSink
+	logger        Logger
+	hooks         *lifecycleHooks
+
+	// dedicatedTo is the name of the only job type the worker fetches, if it's one of a job type's
+	// JobOptions.DedicatedWorkers. Other workers fetch the job types without dedicated workers.
+	dedicatedTo string
 
 	redisFetchScript *redis.Script
 	sampler          prioritySampler
+	atMostOnceQueues []interface{} // the queues of the job types with JobOptions.AtMostOnce
 	*observer
 
+	// fetchStrategy is how the worker fetches jobs. With FetchBlocking, waitForJobs signals wakeChan when the worker
+	// should try to fetch a job again.
+	fetchStrategy FetchStrategy
+	notifyKeys    []string
+	wakeChan      chan struct{}
+
+	// idle is set, atomically, while the worker is sleeping or waiting because it found no job. When it finds one again,
+	// it calls wakeIdle, which signals nudgeChan of the pool's other idle workers so they fetch without waiting out their
+	// sleep backoffs.
+	idle      int32
+	wakeIdle  func(w *worker)
+	nudgeChan chan struct{}
+
+	// backend is the pool's Backend. With BackendStreams, the worker fetches from streamKeys once the job queues are
+	// empty.
+	backend                Backend
+	streamKeys             []interface{}
+	redisFetchStreamScript *redis.Script
+
+	// ctx is passed to context-aware handlers and is cancelled when the worker is stopped
+	ctx    context.Context
+	cancel context.CancelFunc
+
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
 
+	// paused is set, atomically, while the pool is paused. See WorkerPool.Pause.
+	paused int32
+
+	// abandoned is set, atomically, when the pool stops without waiting for the job in progress, and puts it back on
+	// its queue itself.
+	abandoned int32
+
 	drainChan        chan struct{}
 	doneDrainingChan chan struct{}
 }
 
-func newWorker(namespace string, poolID string, pool *redis.Pool, contextType reflect.Type, middleware []*middlewareHandler, jobTypes map[string]*jobType, sleepBackoffs []int64) *worker {
+func newWorker(namespace string, poolID string, pool Pool, contextType reflect.Type, middleware []*middlewareHandler, jobTypes map[string]*jobType, sleepBackoffs []int64, logger Logger) *worker {
 	workerID := makeIdentifier()
-	ob := newObserver(namespace, pool, workerID)
+	ob := newObserver(namespace, pool, workerID, logger)
 
 	if len(sleepBackoffs) == 0 {
 		sleepBackoffs = sleepBackoffsInMilliseconds
@@ -47,6 +121,8 @@ func newWorker(namespace string, poolID string, pool *redis.Pool, contextType re
 		pool:          pool,
 		contextType:   contextType,
 		sleepBackoffs: sleepBackoffs,
+		logger:        logger,
+		hooks:         &lifecycleHooks{},
 
 		observer: ob,
 
@@ -55,6 +131,9 @@ func newWorker(namespace string, poolID string, pool *redis.Pool, contextType re
 
 		drainChan:        make(chan struct{}),
 		doneDrainingChan: make(chan struct{}),
+
+		wakeChan:  make(chan struct{}, 1),
+		nudgeChan: make(chan struct{}, 1),
 	}
 
 	w.updateMiddlewareAndJobTypes(middleware, jobTypes)
@@ -66,32 +145,83 @@ func newWorker(namespace string, poolID string, pool *redis.Pool, contextType re
 func (w *worker) updateMiddlewareAndJobTypes(middleware []*middlewareHandler, jobTypes map[string]*jobType) {
 	w.middleware = middleware
 	sampler := prioritySampler{}
+	numFetched := 0
+	var atMostOnceQueues []interface{}
+	notifyKeys := make([]string, 0, len(jobTypes))
+	streamKeys := make([]interface{}, 0, len(jobTypes)*streamKeysPerJobType)
 	for _, jt := range jobTypes {
-		sampler.add(jt.Priority,
-			redisKeyJobs(w.namespace, jt.Name),
-			redisKeyJobsInProgress(w.namespace, w.poolID, jt.Name),
+		if w.dedicatedTo != "" && jt.Name != w.dedicatedTo || w.dedicatedTo == "" && jt.DedicatedWorkers > 0 {
+			continue
+		}
+		notifyKeys = append(notifyKeys, redisKeyJobsNotify(w.namespace, jt.Name))
+		streamKeys = append(streamKeys,
+			redisKeyJobsStream(w.namespace, jt.Name),
 			redisKeyJobsPaused(w.namespace, jt.Name),
 			redisKeyJobsLock(w.namespace, jt.Name),
-			redisKeyJobsLockInfo(w.namespace, jt.Name),
-			redisKeyJobsConcurrency(w.namespace, jt.Name))
+			redisKeyJobsLockInfo(w.namespace, jt.Name))
+
+		// Each shard is sampled on its own, sharing the job type's priority between them.
+		shards, priority := 1, jt.Priority
+		if jt.Shards > 1 {
+			shards, priority = int(jt.Shards), jt.Priority/jt.Shards
+			if priority == 0 {
+				priority = 1
+			}
+		}
+		for shard := 0; shard < shards; shard++ {
+			numFetched++
+			if jt.AtMostOnce {
+				atMostOnceQueues = append(atMostOnceQueues, redisKeyJobsShard(w.namespace, jt.Name, shard))
+			}
+			sampler.add(priority,
+				redisKeyJobsShard(w.namespace, jt.Name, shard),
+				redisKeyJobsInProgress(w.namespace, w.poolID, jt.Name),
+				redisKeyJobsPaused(w.namespace, jt.Name),
+				redisKeyJobsLock(w.namespace, jt.Name),
+				redisKeyJobsLockInfo(w.namespace, jt.Name),
+				redisKeyJobsConcurrency(w.namespace, jt.Name),
+				redisKeyJobsPriority(w.namespace, jt.Name),
+				redisKeyJobsRateLimit(w.namespace, jt.Name),
+				redisKeyJobsLeases(w.namespace, jt.Name),
+				redisKeyJobsPartition(w.namespace, jt.Name))
+		}
 	}
 	w.sampler = sampler
+	w.atMostOnceQueues = atMostOnceQueues
+	w.notifyKeys = notifyKeys
+	w.streamKeys = streamKeys
 	w.jobTypes = jobTypes
-	w.redisFetchScript = redis.NewScript(len(jobTypes)*fetchKeysPerJobType, redisLuaFetchJob)
+	w.redisFetchScript = redis.NewScript(numFetched*fetchKeysPerJobType, redisLuaFetchJob)
+	w.redisFetchStreamScript = redis.NewScript(len(streamKeys), redisLuaFetchStreamJob)
 }
 
 func (w *worker) start() {
+	w.ctx, w.cancel = context.WithCancel(context.Background())
 	go w.loop()
 	go w.observer.start()
 }
 
 func (w *worker) stop() {
+	w.cancel()
+	w.stopChan <- struct{}{}
+	<-w.doneStoppingChan
+	w.observer.drain()
+	w.observer.stop()
+}
+
+// finish stops the worker like stop, but lets the job in progress finish without cancelling its context.
+func (w *worker) finish() {
 	w.stopChan <- struct{}{}
 	<-w.doneStoppingChan
+	w.cancel()
 	w.observer.drain()
 	w.observer.stop()
 }
 
+func (w *worker) abandon() {
+	atomic.StoreInt32(&w.abandoned, 1)
+}
+
 func (w *worker) drain() {
 	w.drainChan <- struct{}{}
 	<-w.doneDrainingChan
@@ -102,6 +232,7 @@ var sleepBackoffsInMilliseconds = []int64{0, 10, 100, 1000, 5000}
 
 func (w *worker) loop() {
 	var drained bool
+	var waiting bool // whether waitForJobs is running
 	var consequtiveNoJobs int64
 
 	// Begin immediately. We'll change the duration on each tick with a timer.Reset()
@@ -116,12 +247,35 @@ func (w *worker) loop() {
 		case <-w.drainChan:
 			drained = true
 			timer.Reset(0)
+		case <-w.wakeChan:
+			waiting = false
+			timer.Reset(0)
+		case <-w.nudgeChan:
+			timer.Reset(0)
 		case <-timer.C:
-			job, err := w.fetchJob()
+			// Stopping takes priority, so that a worker being stopped once it finishes its job doesn't start another.
+			select {
+			case <-w.stopChan:
+				w.doneStoppingChan <- struct{}{}
+				return
+			default:
+			}
+			if atomic.LoadInt32(&w.paused) == 1 {
+				if drained {
+					w.doneDrainingChan <- struct{}{}
+					drained = false
+				}
+				timer.Reset(time.Duration(w.sleepBackoffs[len(w.sleepBackoffs)-1]) * time.Millisecond)
+				continue
+			}
+			job, err := w.fetchNextJob()
 			if err != nil {
-				logError("worker.fetch", err)
+				logError(w.logger, "worker.fetch", err)
 				timer.Reset(10 * time.Millisecond)
 			} else if job != nil {
+				if atomic.SwapInt32(&w.idle, 0) == 1 && w.wakeIdle != nil {
+					w.wakeIdle(w)
+				}
 				w.processJob(job)
 				consequtiveNoJobs = 0
 				timer.Reset(0)
@@ -130,6 +284,14 @@ func (w *worker) loop() {
 					w.doneDrainingChan <- struct{}{}
 					drained = false
 				}
+				atomic.StoreInt32(&w.idle, 1)
+				if w.fetchStrategy == FetchBlocking {
+					if !waiting {
+						waiting = true
+						go w.waitForJobs(w.notifyKeys)
+					}
+					continue
+				}
 				consequtiveNoJobs++
 				idx := consequtiveNoJobs
 				if idx >= int64(len(w.sleepBackoffs)) {
@@ -141,17 +303,64 @@ func (w *worker) loop() {
 	}
 }
 
+// waitForJobs blocks until a job is enqueued onto one of the queues with notifyKeys, or for the worker's longest sleep
+// backoff, rounded up to a second, and then signals wakeChan. It's used instead of sleeping with FetchBlocking.
+func (w *worker) waitForJobs(notifyKeys []string) {
+	defer func() { w.wakeChan <- struct{}{} }()
+
+	timeout := time.Duration(w.sleepBackoffs[len(w.sleepBackoffs)-1]) * time.Millisecond
+	timeout = (timeout + time.Second - 1).Truncate(time.Second)
+	if timeout < time.Second {
+		timeout = time.Second
+	}
+	if len(notifyKeys) == 0 {
+		time.Sleep(timeout)
+		return
+	}
+
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	args := redis.Args{}.AddFlat(notifyKeys).Add(int64(timeout / time.Second))
+	var err error
+	if cwt, ok := conn.(redis.ConnWithTimeout); ok {
+		// Don't let the connection's read timeout cut the wait short
+		_, err = cwt.DoWithTimeout(timeout+time.Second, "BRPOP", args...)
+	} else {
+		_, err = conn.Do("BRPOP", args...)
+	}
+	if err != nil {
+		logError(w.logger, "worker.wait_for_jobs", err)
+		time.Sleep(timeout)
+	}
+}
+
+// fetchNextJob fetches the next job for the worker to run, or nil if there aren't any.
+func (w *worker) fetchNextJob() (*Job, error) {
+	job, err := w.fetchJob()
+	if err == nil && job == nil && w.backend == BackendStreams && len(w.streamKeys) > 0 {
+		return w.fetchStreamJob()
+	}
+	return job, err
+}
+
 func (w *worker) fetchJob() (*Job, error) {
 	// resort queues
 	// NOTE: we could optimize this to only resort every second, or something.
 	w.sampler.sample()
 	numKeys := len(w.sampler.samples) * fetchKeysPerJobType
-	var scriptArgs = make([]interface{}, 0, numKeys+1)
+	var scriptArgs = make([]interface{}, 0, numKeys+5+len(w.atMostOnceQueues))
 
 	for _, s := range w.sampler.samples {
-		scriptArgs = append(scriptArgs, s.redisJobs, s.redisJobsInProg, s.redisJobsPaused, s.redisJobsLock, s.redisJobsLockInfo, s.redisJobsMaxConcurrency) // KEYS[1-6 * N]
+		scriptArgs = append(scriptArgs, s.redisJobs, s.redisJobsInProg, s.redisJobsPaused, s.redisJobsLock, s.redisJobsLockInfo, s.redisJobsMaxConcurrency, s.redisJobsPriority, s.redisJobsRateLimit, s.redisJobsLeases, s.redisJobsPartition) // KEYS[1-10 * N]
 	}
-	scriptArgs = append(scriptArgs, w.poolID) // ARGV[1]
+	nowMs := time.Now().UnixNano() / 1000 / 1000
+	scriptArgs = append(scriptArgs, w.poolID)                                 // ARGV[1]
+	scriptArgs = append(scriptArgs, nowMs)                                    // ARGV[2]
+	scriptArgs = append(scriptArgs, w.workerID)                               // ARGV[3]
+	scriptArgs = append(scriptArgs, nowMs+concurrencyLeaseTTL.Milliseconds()) // ARGV[4]
+	scriptArgs = append(scriptArgs, partitionLockTTL.Milliseconds())          // ARGV[5]
+	scriptArgs = append(scriptArgs, w.atMostOnceQueues...)                    // ARGV[6...]
 	conn := w.pool.Get()
 	defer conn.Close()
 
@@ -162,8 +371,8 @@ func (w *worker) fetchJob() (*Job, error) {
 		return nil, err
 	}
 
-	if len(values) != 3 {
-		return nil, fmt.Errorf("need 3 elements back")
+	if len(values) != 3 && len(values) != 4 {
+		return nil, fmt.Errorf("need 3 or 4 elements back")
 	}
 
 	rawJSON, ok := values[0].([]byte)
@@ -186,12 +395,25 @@ func (w *worker) fetchJob() (*Job, error) {
 		return nil, err
 	}
 
+	if len(values) == 4 {
+		partition, ok := values[3].([]byte)
+		if !ok {
+			return nil, fmt.Errorf("response partition not bytes")
+		}
+		job.partition = string(partition)
+	}
+
 	return job, nil
 }
 
-func (w *worker) processJob(job *Job) {
+// processJob runs job and deals with its outcome, returning the error it failed with, if it did.
+func (w *worker) processJob(job *Job) error {
+	jt := w.jobTypes[job.Name]
+	uniqueUntilComplete := job.Unique && jt != nil && jt.UniqueMode == UniqueUntilComplete
+	uniqueKey := job.UniqueKey
+	partition, partitionHolder := job.partition, job.ID
 	if job.Unique {
-		updatedJob := w.getAndDeleteUniqueJob(job)
+		updatedJob := w.getUniqueJob(job, !uniqueUntilComplete)
 		// This is to support the old way of doing it, where we used the job off the queue and just deleted the unique key
 		// Going forward the job on the queue will always be just a placeholder, and we will be replacing it with the
 		// updated job extracted here
@@ -199,55 +421,256 @@ func (w *worker) processJob(job *Job) {
 			job = updatedJob
 		}
 	}
+	if jt != nil && jt.PartitionKey != "" {
+		if _, ok := job.Args[jt.PartitionKey]; ok && partition == "" && job.codec != nil && job.streamID == "" {
+			// Enqueued with encoded args before any worker pool recorded that its job type is partitioned.
+			logError(w.logger, "process_job.unpartitioned", fmt.Errorf("job %s of %q has encoded args without its partition arg, so it isn't partitioned", job.ID, job.Name))
+		}
+		// Keep the partition arg readable if the job's retried.
+		job.partitionArg = jt.PartitionKey
+	}
+	if jt != nil && job.expired(jt.ExpiresIn, nowEpochSeconds()) {
+		w.discardJob(job, uniqueUntilComplete, uniqueKey, partition, partitionHolder)
+		runHooks(w.hooks.onExpired, job, nil)
+		return nil
+	}
+	if job.IdempotencyKey != "" && jt != nil {
+		claimed, err := w.claimIdempotencyKey(jt, job)
+		if err != nil {
+			// Rather than risk running the job twice, it's put back on its queue to be claimed again later.
+			logError(w.logger, "worker.claim_idempotency_key", err)
+			fate := terminateAndRequeue(w, job, terminateOnly)
+			if partition != "" {
+				fate = terminateAndReleasePartition(w, job, partition, partitionHolder, fate)
+			}
+			w.removeJobFromInProgress(job, fate)
+			return nil
+		}
+		if !claimed {
+			// A job with the same key is running or has already succeeded.
+			w.discardJob(job, uniqueUntilComplete, uniqueKey, partition, partitionHolder)
+			return nil
+		}
+	}
+	if job.RunLock != "" && jt != nil && !w.acquireRunLock(jt, job) {
+		// The previous run of this periodic job is still going.
+		w.removeJobFromInProgress(job, terminateOnly)
+		return nil
+	}
 	var runErr error
-	jt := w.jobTypes[job.Name]
+	var duration time.Duration
 	if jt == nil {
 		runErr = fmt.Errorf("stray job: no handler")
-		logError("process_job.stray", runErr)
+		logError(w.logger, "process_job.stray", runErr)
 	} else {
 		w.observeStarted(job.Name, job.ID, job.Args)
 		job.observer = w.observer // for Checkin
 		job.aliveChecker = w.alive
 		job.PoolID = w.poolID
-		_, runErr = runJob(job, w.contextType, w.middleware, jt)
+		job.maxFails = jt.MaxFails
+		var doneRenewing chan struct{}
+		if jt.MaxConcurrency > 0 {
+			doneRenewing = make(chan struct{})
+			go w.renewConcurrencyLease(job.Name, doneRenewing)
+		} else if job.streamID != "" {
+			doneRenewing = make(chan struct{})
+			go w.renewStreamClaim(job, doneRenewing)
+		}
+		// A job with a timeout is run on a copy, in case its handler overruns and has to be abandoned. Otherwise the worker
+		// carries on with the copy, as the handler may have set its result.
+		running := job
+		if jt.Timeout > 0 {
+			running = job.clone()
+		}
+		ctx := w.ctx
+		var doneWatching chan struct{}
+		if jt.IsGenericContext {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(w.ctx)
+			defer cancel()
+			doneWatching = make(chan struct{})
+			go w.watchForCancellation(running, cancel, doneWatching)
+		}
+		runHooks(w.hooks.onStart, job, nil)
+		startedAt := time.Now()
+		runErr = runJobWithTimeout(ctx, jt.Timeout, jobTimeoutGracePeriod, running, w.contextType, w.middleware, jt)
+		duration = time.Since(startedAt)
+		if runErr == errJobAbandoned {
+			logError(w.logger, "process_job.abandoned", runErr)
+			runErr = ErrJobTimeout
+			if running.wasCancelled() {
+				atomic.StoreInt32(&job.killed, 1)
+			}
+		} else {
+			job = running
+		}
+		var panicErr *panicError
+		if errors.As(runErr, &panicErr) {
+			logError(w.logger, "runJob.panic", runErr)
+			runHooks(w.hooks.onPanic, job, runErr)
+		}
+		if doneRenewing != nil {
+			close(doneRenewing)
+		}
+		if doneWatching != nil {
+			close(doneWatching)
+		}
 		w.observeDone(job.Name, job.ID, runErr)
 	}
 
+	if atomic.LoadInt32(&w.abandoned) == 1 && (jt == nil || !jt.AtMostOnce) {
+		// The job is already back on its queue. Jobs run at most once aren't put back, so they're finished as usual.
+		return runErr
+	}
+
+	// Whether the job is retried is decided once, as JobOptions.RetryIf may not give the same answer twice, and
+	// everything below must agree on it.
 	fate := terminateOnly
+	retry := false
 	if runErr != nil {
 		job.failed(runErr)
-		fate = w.jobFate(jt, job)
+		retry = willRetry(jt, job, runErr)
+		fate = w.jobFate(jt, job, runErr, retry)
+	}
+	if (job.StoreResult || job.result != nil) && !retry {
+		fate = terminateAndStoreResult(w, jt, job, runErr, fate)
+	}
+	if runErr == nil {
+		fate = terminateAndEnqueueNext(w, job, fate)
+	}
+	if runErr == nil && uniqueUntilComplete {
+		fate = terminateAndReleaseUniqueLock(w, job, uniqueKey, fate)
 	}
+	if partition != "" {
+		fate = terminateAndReleasePartition(w, job, partition, partitionHolder, fate)
+	}
+	if job.RunLock != "" && jt != nil {
+		fate = terminateAndReleaseRunLock(w, job, fate)
+	}
+	if job.IdempotencyKey != "" && jt != nil && !retry {
+		fate = terminateAndFinishIdempotencyKey(w, jt, job, runErr == nil, fate)
+	}
+	fate = terminateAndRecordStats(w, job.Name, duration, runErr, fate)
 	w.removeJobFromInProgress(job, fate)
+	w.runDoneHooks(jt, job, runErr, retry)
+
+	if w.metricsSink != nil && jt != nil {
+		w.reportMetrics(jt, job, duration, runErr, retry)
+	}
+	return runErr
 }
 
-func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
-	var uniqueKey string
-	var err error
+func (w *worker) reportMetrics(jt *jobType, job *Job, duration time.Duration, runErr error, retry bool) {
+	tags := []string{"job:" + job.Name}
+	status := "ok"
+	if runErr != nil {
+		status = "failed"
+	}
 
-	if job.UniqueKey != "" {
-		uniqueKey = job.UniqueKey
-	} else { // For jobs put in queue prior to this change. In the future this can be deleted as there will always be a UniqueKey
-		uniqueKey, err = redisKeyUniqueJob(w.namespace, job.Name, job.Args)
-		if err != nil {
-			logError("worker.delete_unique_job.key", err)
-			return nil
+	w.metricsSink.Count("jobs.processed", 1, tags)
+	w.metricsSink.Timing("jobs.duration", duration, append(tags, "status:"+status))
+	if runErr == nil {
+		return
+	}
+
+	w.metricsSink.Count("jobs.failed", 1, tags)
+	if retry {
+		w.metricsSink.Count("jobs.retried", 1, tags)
+	} else if jt.SkipDead {
+		return
+	} else if jt.DeadLetterQueue != "" {
+		w.metricsSink.Count("jobs.dead_lettered", 1, tags)
+	} else {
+		w.metricsSink.Count("jobs.dead", 1, tags)
+	}
+}
+
+// runDoneHooks calls the lifecycle hooks for a job that has finished running and been moved to wherever it's going,
+// retry being whether it failed and is being retried.
+func (w *worker) runDoneHooks(jt *jobType, job *Job, runErr error, retry bool) {
+	if runErr == nil {
+		runHooks(w.hooks.onSuccess, job, nil)
+		return
+	}
+
+	runHooks(w.hooks.onFailure, job, runErr)
+	if retry {
+		runHooks(w.hooks.onRetry, job, runErr)
+	} else if jt != nil && jt.SkipDead {
+		return
+	} else if jt != nil && jt.DeadLetterQueue != "" {
+		runHooks(w.hooks.onDeadLettered, job, runErr)
+	} else {
+		runHooks(w.hooks.onDead, job, runErr)
+	}
+}
+
+// watchForCancellation calls cancel once job is cancelled with Client.CancelJob, checking until done is closed.
+func (w *worker) watchForCancellation(job *Job, cancel context.CancelFunc, done <-chan struct{}) {
+	ticker := time.NewTicker(cancellationCheckPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			alive, err := job.Alive()
+			if err != nil {
+				logError(w.logger, "worker.watch_for_cancellation", err)
+				continue
+			}
+			if !alive {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// renewConcurrencyLease keeps extending the worker's lease on a concurrency slot for jobName until done is closed.
+func (w *worker) renewConcurrencyLease(jobName string, done <-chan struct{}) {
+	ticker := time.NewTicker(concurrencyLeaseRenewPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			conn := w.pool.Get()
+			expiresAt := time.Now().Add(concurrencyLeaseTTL).UnixNano() / 1000 / 1000
+			if _, err := conn.Do("ZADD", redisKeyJobsLeases(w.namespace, jobName), "XX", expiresAt, w.workerID); err != nil {
+				logError(w.logger, "worker.renew_concurrency_lease", err)
+			}
+			conn.Close()
 		}
 	}
+}
+
+// getUniqueJob returns the job stored under job's unique key, releasing the uniqueness lock if release is set.
+func (w *worker) getUniqueJob(job *Job, release bool) *Job {
+	uniqueKey, err := w.uniqueJobKey(job)
+	if err != nil {
+		logError(w.logger, "worker.delete_unique_job.key", err)
+		return nil
+	}
 
 	conn := w.pool.Get()
 	defer conn.Close()
 
 	rawJSON, err := redis.Bytes(conn.Do("GET", uniqueKey))
 	if err != nil {
-		logError("worker.delete_unique_job.get", err)
+		logError(w.logger, "worker.delete_unique_job.get", err)
 		return nil
 	}
 
-	_, err = conn.Do("DEL", uniqueKey)
-	if err != nil {
-		logError("worker.delete_unique_job.del", err)
-		return nil
+	if release {
+		_, err = conn.Do("DEL", uniqueKey)
+		if err != nil {
+			logError(w.logger, "worker.delete_unique_job.del", err)
+			return nil
+		}
 	}
 
 	// Previous versions did not support updated arguments and just set key to 1, so in these cases we should do nothing.
@@ -259,13 +682,63 @@ func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
 	// The job pulled off the queue was just a placeholder with no args, so replace it
 	jobWithArgs, err := newJob(rawJSON, job.dequeuedFrom, job.inProgQueue)
 	if err != nil {
-		logError("worker.delete_unique_job.updated_job", err)
+		logError(w.logger, "worker.delete_unique_job.updated_job", err)
 		return nil
 	}
 
 	return jobWithArgs
 }
 
+// claimIdempotencyKey claims the job's idempotency key, returning false if another job with the key is running or has
+// succeeded.
+func (w *worker) claimIdempotencyKey(jt *jobType, job *Job) (bool, error) {
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	key := redisKeyIdempotency(w.namespace, job.Name, job.IdempotencyKey)
+	res, err := redis.String(redisClaimIdempotencyKeyScript.Do(conn, key, job.ID, jt.IdempotencyTTL.Milliseconds()))
+	if err != nil {
+		return false, err
+	}
+	return res == "ok", nil
+}
+
+// acquireRunLock takes job's run lock, returning false if another run of the periodic job holds it. A job requeued
+// after its worker died already holds it. If Redis can't be reached, the job is run anyway.
+func (w *worker) acquireRunLock(jt *jobType, job *Job) bool {
+	ttl := runLockTTL
+	if jt.Timeout > 0 {
+		ttl = jt.Timeout + time.Minute
+	}
+
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	key := redisKeyRunLock(w.namespace, job.RunLock)
+	_, err := redis.String(conn.Do("SET", key, job.ID, "PX", ttl.Milliseconds(), "NX"))
+	if err == nil {
+		return true
+	} else if err != redis.ErrNil {
+		logError(w.logger, "worker.acquire_run_lock.set", err)
+		return true
+	}
+
+	holder, err := redis.String(conn.Do("GET", key))
+	if err != nil && err != redis.ErrNil {
+		logError(w.logger, "worker.acquire_run_lock.get", err)
+		return true
+	}
+	return holder == job.ID
+}
+
+func (w *worker) uniqueJobKey(job *Job) (string, error) {
+	if job.UniqueKey != "" {
+		return job.UniqueKey, nil
+	}
+	// For jobs put in queue prior to this change. In the future this can be deleted as there will always be a UniqueKey
+	return redisKeyUniqueJob(w.namespace, job.Name, job.Args)
+}
+
 func (w *worker) alive(job *Job) (bool, error) {
 	conn := w.pool.Get()
 	defer conn.Close()
@@ -286,7 +759,7 @@ func (w *worker) alive(job *Job) (bool, error) {
 	if err != nil {
 		// This error isn't critical to the alive status, so just log it rather
 		// than return the error, which kills the handler
-		logError("worker.jobalive.del", err)
+		logError(w.logger, "worker.jobalive.del", err)
 	}
 
 	return false, nil
@@ -297,32 +770,54 @@ func (w *worker) removeJobFromInProgress(job *Job, fate terminateOp) {
 	defer conn.Close()
 
 	conn.Send("MULTI")
-	conn.Send("LREM", job.inProgQueue, 1, job.rawJSON)
+	if job.streamID != "" {
+		conn.Send("XACK", job.dequeuedFrom, streamGroup, job.streamID)
+		conn.Send("XDEL", job.dequeuedFrom, job.streamID)
+	} else {
+		conn.Send("LREM", job.inProgQueue, 1, job.rawJSON)
+	}
 	conn.Send("DECR", redisKeyJobsLock(w.namespace, job.Name))
 	conn.Send("HINCRBY", redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID, -1)
+	conn.Send("ZREM", redisKeyJobsLeases(w.namespace, job.Name), w.workerID)
 	fate(conn)
 	if _, err := conn.Do("EXEC"); err != nil {
-		logError("worker.remove_job_from_in_progress.lrem", err)
+		logError(w.logger, "worker.remove_job_from_in_progress.lrem", err)
 	}
 }
 
 type terminateOp func(conn redis.Conn)
 
 func terminateOnly(_ redis.Conn) { return }
-func terminateAndRetry(w *worker, jt *jobType, job *Job) terminateOp {
+func terminateAndRetry(w *worker, jt *jobType, job *Job, runErr error) terminateOp {
+	rawJSON, err := job.serialize()
+	if err != nil {
+		logError(w.logger, "worker.terminate_and_retry.serialize", err)
+		return terminateOnly
+	}
+	return func(conn redis.Conn) {
+		conn.Send("ZADD", redisKeyRetry(w.namespace), nowEpochSeconds()+jt.calcBackoff(job, runErr), rawJSON)
+	}
+}
+
+// terminateAndRetryInPlace puts a failed StrictFIFO job back at the head of its queue, so nothing enqueued after it can
+// run first, and pauses the queue until its backoff is up. A queue that's already paused stays as it was.
+func terminateAndRetryInPlace(w *worker, jt *jobType, job *Job, runErr error) terminateOp {
 	rawJSON, err := job.serialize()
 	if err != nil {
-		logError("worker.terminate_and_retry.serialize", err)
+		logError(w.logger, "worker.terminate_and_retry_in_place.serialize", err)
 		return terminateOnly
 	}
 	return func(conn redis.Conn) {
-		conn.Send("ZADD", redisKeyRetry(w.namespace), nowEpochSeconds()+jt.calcBackoff(job), rawJSON)
+		conn.Send("RPUSH", redisKeyJobs(w.namespace, job.Name), rawJSON)
+		if backoff := jt.calcBackoff(job, runErr); backoff > 0 {
+			conn.Send("SET", redisKeyJobsPaused(w.namespace, job.Name), "1", "EX", backoff, "NX")
+		}
 	}
 }
 func terminateAndDead(w *worker, job *Job) terminateOp {
 	rawJSON, err := job.serialize()
 	if err != nil {
-		logError("worker.terminate_and_dead.serialize", err)
+		logError(w.logger, "worker.terminate_and_dead.serialize", err)
 		return terminateOnly
 	}
 	return func(conn redis.Conn) {
@@ -335,19 +830,173 @@ func terminateAndDead(w *worker, job *Job) terminateOp {
 	}
 }
 
-func (w *worker) jobFate(jt *jobType, job *Job) terminateOp {
-	if jt != nil {
-		failsRemaining := int64(jt.MaxFails) - job.Fails
-		if failsRemaining > 0 {
-			return terminateAndRetry(w, jt, job)
+// terminateAndDeadLetter enqueues a job that exhausted its retries as a new job on its job type's DeadLetterQueue.
+func terminateAndDeadLetter(w *worker, jt *jobType, job *Job) terminateOp {
+	namespace := jt.DeadLetterNamespace
+	if namespace == "" {
+		namespace = w.namespace
+	}
+	letter := &Job{
+		Name:       jt.DeadLetterQueue,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       job.Args,
+		DeadLetter: &DeadLetter{
+			Namespace:       w.namespace,
+			Name:            job.Name,
+			ID:              job.ID,
+			Fails:           job.Fails,
+			LastErr:         job.LastErr,
+			FailedAt:        job.FailedAt,
+			FirstEnqueuedAt: job.FirstEnqueuedAt,
+		},
+		codec:         job.codec,
+		compressAbove: job.compressAbove,
+	}
+	if lt := w.jobTypes[letter.Name]; lt != nil && namespace == w.namespace {
+		letter.partitionArg = lt.PartitionKey
+	}
+	rawJSON, err := letter.serialize()
+	if err != nil {
+		logError(w.logger, "worker.terminate_and_dead_letter.serialize", err)
+		return terminateAndDead(w, job)
+	}
+	return func(conn redis.Conn) {
+		conn.Send("LPUSH", redisKeyJobs(namespace, letter.Name), rawJSON)
+		conn.Send("SADD", redisKeyKnownJobs(namespace), letter.Name)
+		conn.Send("LPUSH", redisKeyJobsNotify(namespace, letter.Name), 1)
+		conn.Send("LTRIM", redisKeyJobsNotify(namespace, letter.Name), 0, maxJobNotifications-1)
+		conn.Send("PUBLISH", redisChannelJobsWake(namespace, letter.Name), 1)
+	}
+}
+
+// discardJob removes a job from its in-progress queue without running it, eg, as it's expired, releasing what it holds.
+func (w *worker) discardJob(job *Job, uniqueUntilComplete bool, uniqueKey, partition, partitionHolder string) {
+	fate := terminateOnly
+	if uniqueUntilComplete {
+		fate = terminateAndReleaseUniqueLock(w, job, uniqueKey, fate)
+	}
+	if partition != "" {
+		fate = terminateAndReleasePartition(w, job, partition, partitionHolder, fate)
+	}
+	w.removeJobFromInProgress(job, fate)
+}
+
+func terminateAndStoreResult(w *worker, jt *jobType, job *Job, runErr error, fate terminateOp) terminateOp {
+	res := &JobResult{
+		JobID:      job.ID,
+		Result:     job.result,
+		FinishedAt: nowEpochSeconds(),
+	}
+	if runErr != nil {
+		res.Err = runErr.Error()
+	}
+	rawJSON, err := json.Marshal(res)
+	if err != nil {
+		logError(w.logger, "worker.terminate_and_store_result.serialize", err)
+		return fate
+	}
+
+	ttl := defaultResultTTL
+	if jt != nil && jt.ResultTTL > 0 {
+		ttl = jt.ResultTTL
+	}
+
+	return func(conn redis.Conn) {
+		fate(conn)
+		conn.Send("SET", redisKeyJobResult(w.namespace, job.ID), rawJSON, "PX", ttl.Milliseconds())
+	}
+}
+
+func terminateAndReleasePartition(w *worker, job *Job, partition, holderID string, fate terminateOp) terminateOp {
+	return func(conn redis.Conn) {
+		fate(conn)
+		redisReleasePartitionScript.Send(conn,
+			redisKeyJobsPartitionLock(w.namespace, job.Name, partition),
+			redisKeyJobsPartitionWaiting(w.namespace, job.Name, partition),
+			redisKeyJobs(w.namespace, job.Name),
+			holderID)
+	}
+}
+
+func terminateAndReleaseRunLock(w *worker, job *Job, fate terminateOp) terminateOp {
+	return func(conn redis.Conn) {
+		fate(conn)
+		redisReleaseRunLockScript.Send(conn, redisKeyRunLock(w.namespace, job.RunLock), job.ID)
+	}
+}
+
+// terminateAndFinishIdempotencyKey marks the job's idempotency key as done if it succeeded, so duplicates are skipped,
+// or else releases it, unless it's been claimed by another job since, so the job can be enqueued again.
+func terminateAndFinishIdempotencyKey(w *worker, jt *jobType, job *Job, succeeded bool, fate terminateOp) terminateOp {
+	key := redisKeyIdempotency(w.namespace, job.Name, job.IdempotencyKey)
+	if succeeded {
+		return func(conn redis.Conn) {
+			fate(conn)
+			conn.Send("SET", key, "done", "PX", jt.IdempotencyTTL.Milliseconds())
+		}
+	}
+	return func(conn redis.Conn) {
+		fate(conn)
+		redisReleaseIdempotencyKeyScript.Send(conn, key, job.ID)
+	}
+}
+
+// terminateAndRequeue puts a job back on the queue it was fetched from, as it was, to be run again later.
+func terminateAndRequeue(w *worker, job *Job, fate terminateOp) terminateOp {
+	queue := string(job.dequeuedFrom)
+	if job.streamID != "" {
+		queue = redisKeyJobs(w.namespace, job.Name)
+	}
+	return func(conn redis.Conn) {
+		fate(conn)
+		conn.Send("LPUSH", queue, job.rawJSON)
+	}
+}
+
+func terminateAndReleaseUniqueLock(w *worker, job *Job, uniqueKey string, fate terminateOp) terminateOp {
+	if uniqueKey == "" {
+		var err error
+		if uniqueKey, err = w.uniqueJobKey(job); err != nil {
+			logError(w.logger, "worker.terminate_and_release_unique_lock.key", err)
+			return fate
 		}
-		if jt.SkipDead {
-			return terminateOnly
+	}
+	return func(conn redis.Conn) {
+		fate(conn)
+		conn.Send("DEL", uniqueKey)
+	}
+}
+
+// jobFate returns what to do with a job that failed with runErr, retry being whether it's retried, as per willRetry.
+func (w *worker) jobFate(jt *jobType, job *Job, runErr error, retry bool) terminateOp {
+	if retry {
+		if jt.StrictFIFO {
+			return terminateAndRetryInPlace(w, jt, job, runErr)
 		}
+		return terminateAndRetry(w, jt, job, runErr)
+	}
+	if jt != nil && jt.SkipDead {
+		return terminateOnly
+	}
+	if jt != nil && jt.DeadLetterQueue != "" {
+		return terminateAndDeadLetter(w, jt, job)
 	}
 	return terminateAndDead(w, job)
 }
 
+// willRetry reports whether a job that failed with runErr should be retried: it must have retries left, not be run at
+// most once, not have been cancelled, and runErr must be retryable as per ErrNoRetry and JobOptions.RetryIf.
+func willRetry(jt *jobType, job *Job, runErr error) bool {
+	if jt == nil || jt.AtMostOnce || int64(jt.MaxFails)-job.Fails <= 0 || job.wasCancelled() {
+		return false
+	}
+	if errors.Is(runErr, ErrNoRetry) {
+		return false
+	}
+	return jt.RetryIf == nil || jt.RetryIf(runErr)
+}
+
 // Default algorithm returns an fastly increasing backoff counter which grows in an unbounded fashion
 func defaultBackoffCalculator(job *Job) int64 {
 	fails := job.Fails
@@ -1,12 +1,15 @@
 package work
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
-	"github.com/gomodule/redigo/redis"
 	"github.com/robfig/cron/v3"
 )
 
@@ -15,8 +18,28 @@ type WorkerPool struct {
 	workerPoolID  string
 	concurrency   uint
 	namespace     string // eg, "myapp-work"
-	pool          *redis.Pool
+	pool          Pool
 	sleepBackoffs []int64
+	logger        Logger
+	metricsSink   MetricsSink
+	hooks         *lifecycleHooks
+	stopTimeout   time.Duration
+
+	heartbeatPeriod time.Duration // see WorkerPoolOptions; 0 means the default
+	reapPeriod      time.Duration
+	requeuePeriod   time.Duration
+	fetchStrategy   FetchStrategy
+	backend         Backend
+
+	version  string // sent with the heartbeat, see WorkerPoolOptions
+	hostname string
+	labels   map[string]string
+
+	deadJobWebhookURL string
+	deadJobChannel    string
+	deadJobMaxCount   int64
+	deadJobMaxAge     time.Duration
+	deadJobArchiver   DeadJobArchiver
 
 	contextType  reflect.Type
 	jobTypes     map[string]*jobType
@@ -24,12 +47,19 @@ type WorkerPool struct {
 	started      bool
 	periodicJobs []*periodicJob
 
+	paused           int32      // accessed atomically; see Pause
+	workersMu        sync.Mutex // guards workers, concurrency and periodicJobs, which can change after Start
 	workers          []*worker
+	dedicatedWorkers []*worker // see JobOptions.DedicatedWorkers
 	heartbeater      *workerPoolHeartbeater
 	retrier          *requeuer
 	scheduler        *requeuer
 	deadPoolReaper   *deadPoolReaper
+	deadJobTrimmer   *deadJobTrimmer
 	periodicEnqueuer *periodicEnqueuer
+	wakeSubscriber   *wakeSubscriber // see FetchPubSub
+	autoscaleOpts    *AutoscaleOptions
+	autoscaler       *autoscaler
 }
 
 type jobType struct {
@@ -39,9 +69,18 @@ type jobType struct {
 	IsGeneric      bool
 	GenericHandler GenericHandler
 	DynamicHandler reflect.Value
+
+	IsGenericContext      bool
+	GenericContextHandler GenericContextHandler
 }
 
-func (jt *jobType) calcBackoff(j *Job) int64 {
+// calcBackoff returns how many seconds to wait before retrying j, which failed with runErr: the delay runErr asks for
+// with RetryIn, if it does, or else the job type's backoff.
+func (jt *jobType) calcBackoff(j *Job, runErr error) int64 {
+	var retryIn *retryInError
+	if errors.As(runErr, &retryIn) {
+		return backoffSeconds(retryIn.delay)
+	}
 	if jt.Backoff == nil {
 		return defaultBackoffCalculator(j)
 	}
@@ -51,7 +90,8 @@ func (jt *jobType) calcBackoff(j *Job) int64 {
 // You may provide your own backoff function for retrying failed jobs or use the builtin one.
 // Returns the number of seconds to wait until the next attempt.
 //
-// The builtin backoff calculator provides an exponentially increasing wait function.
+// The builtin backoff calculator provides an exponentially increasing wait function. ExponentialBackoff, LinearBackoff
+// and FixedBackoff make other common strategies.
 type BackoffCalculator func(job *Job) int64
 
 // JobOptions can be passed to JobWithOptions.
@@ -61,16 +101,143 @@ type JobOptions struct {
 	SkipDead       bool              // If true, don't send failed jobs to the dead queue when retries are exhausted.
 	MaxConcurrency uint              // Max number of jobs to keep in flight (default is 0, meaning no max)
 	Backoff        BackoffCalculator // If not set, uses the default backoff algorithm
+	Timeout        time.Duration     // Max time a job may run before it's aborted and failed with ErrJobTimeout (default is 0, meaning no timeout)
+	MaxPerSecond   float64           // Max number of jobs to start per second across all worker pools (default is 0, meaning no max)
+	ResultTTL      time.Duration     // How long to keep job results for, at least a second (default is 24 hours)
+	UniqueTTL      time.Duration     // How long a unique job's lock is held for if the job isn't processed (default is 24 hours)
+	UniqueMode     UniqueMode        // When a unique job's lock is released (default is UniqueUntilStart)
+	RetryIf        func(error) bool  // If set, failed jobs are only retried if this returns true for their error
+
+	// IdempotencyTTL is how long the idempotency key of a job enqueued with Enqueuer.EnqueueWithIdempotencyKey is kept
+	// once the job succeeds, and so how long duplicates of it are skipped for (default is 24 hours).
+	IdempotencyTTL time.Duration
+
+	// ExpiresIn is how long jobs of this type are worth running for, eg, a typing notification. Jobs that are fetched
+	// once they've waited longer than this since they were enqueued, or were due if they were scheduled, are discarded
+	// instead of run late, and the OnJobExpired hooks are called. It's rounded down to a second. Retries don't extend
+	// it. Enqueuer.EnqueueWithExpiry overrides it for a single job.
+	ExpiresIn time.Duration
+
+	// DedicatedWorkers is how many workers to run just for this job type, on top of the pool's concurrency. If set, the
+	// pool's other workers leave this job type to them, so it can't hold up other job types, nor be held up by them.
+	DedicatedWorkers uint
+
+	// StrictFIFO runs jobs of this type one at a time across all worker pools, in the order they were enqueued. A
+	// failed job is retried at the head of the queue, holding up the jobs behind it until it succeeds or dies. It
+	// implies a MaxConcurrency of 1. Jobs enqueued with a priority other than PriorityNormal still skip the queue, and
+	// delayed jobs join its tail when they're due.
+	StrictFIFO bool
+
+	// PartitionKey is the name of a job arg, eg, "account_id", to partition jobs of this type by. Jobs with the same
+	// value for it run one at a time across all worker pools, in the order they're fetched, while jobs with different
+	// values run in parallel. Jobs without it aren't partitioned. A failed job doesn't hold up its partition while it
+	// waits to be retried.
+	PartitionKey string
+
+	// DeadLetterQueue is the name of a job type, eg, "repair_payment", that failed jobs of this type are enqueued as once
+	// their retries are exhausted, instead of being moved to the dead queue, so a worker pool can remediate them. They
+	// keep their arguments, and their DeadLetter describes the job that failed. DeadLetterNamespace enqueues them in
+	// another namespace, rather than the pool's. SkipDead takes precedence. The OnJobDeadLettered hooks are called instead
+	// of the OnJobDead hooks.
+	DeadLetterQueue     string
+	DeadLetterNamespace string
+
+	// Shards splits the job type's queue into this many queues, which the workers fetch from in turn, so that a job
+	// type enqueued at a very high rate isn't held up by a single Redis list. Enqueuers spread jobs over the shards
+	// round-robin or, if ShardKey is set, by a hash of the job's ShardKey arg, eg, "account_id". Jobs that are retried,
+	// scheduled or reaped go to the first shard. Enqueuers learn of a change to Shards within a minute, so lower it
+	// only once the shards being removed are empty.
+	Shards   uint
+	ShardKey string
+
+	// AtMostOnce acks jobs of this type before they're run rather than after, for jobs where running twice is worse
+	// than occasionally not running at all. A job is taken off its queue as it's fetched, so if its worker pool dies
+	// while running it, it's lost instead of being requeued by the reaper, and a job that fails isn't retried: it's
+	// moved to the dead queue, or its DeadLetterQueue, unless SkipDead is set.
+	AtMostOnce bool
+}
+
+// PeriodicOptions can be passed to PeriodicallyEnqueueWithOptions.
+type PeriodicOptions struct {
+	Location *time.Location         // Time zone the spec is in, eg, so a job can run at 02:00 Dublin time (default is the local time zone)
+	Args     map[string]interface{} // Args to enqueue the job with, which must be JSON encodable
+
+	// SkipIfRunning skips a run of the job if the previous one is still running, on any worker pool, eg, so a heavy
+	// nightly job that overruns doesn't run twice at once. A skipped run is dropped, rather than run later.
+	SkipIfRunning bool
 }
 
-// WorkerPoolOptions can be passed to NewWorkerPoolWithOptions.
+// UniqueMode controls how long a job enqueued with one of the EnqueueUnique methods stops duplicates from being
+// enqueued.
+type UniqueMode int
+
+const (
+	// UniqueUntilStart releases the uniqueness lock as soon as the job is dequeued, so a duplicate can be enqueued
+	// while the job is running.
+	UniqueUntilStart UniqueMode = iota
+
+	// UniqueUntilComplete holds the uniqueness lock until the job succeeds, including while it's running and waiting
+	// to be retried. Duplicates enqueued in the meantime aren't enqueued.
+	UniqueUntilComplete
+)
+
+// WorkerPoolOptions can be passed to NewWorkerPoolWithOptions, on its own or along with other Options. Its zero fields
+// are left as they are.
 type WorkerPoolOptions struct {
-	SleepBackoffs []int64 // Sleep backoffs in milliseconds
+	SleepBackoffs []int64     // Sleep backoffs in milliseconds
+	MetricsSink   MetricsSink // If set, metrics about each job run are sent to it, eg, a StatsDSink
+	Logger        Logger      // If set, errors are logged to it instead of stdout, eg, a *slog.Logger
+
+	// If set, Stop only waits this long for the jobs in progress, as per StopWithContext.
+	StopTimeout time.Duration
+
+	// How often to send the pool's heartbeat (default is 5 seconds). A pool that hasn't sent one for twice this long is
+	// considered dead and reaped, so every pool in a namespace should use the same HeartbeatPeriod.
+	HeartbeatPeriod time.Duration
+
+	// How often to look for dead worker pools to reap (default is 10 minutes, plus up to 30 seconds of jitter).
+	ReapPeriod time.Duration
+
+	// How often to move scheduled jobs and jobs waiting to be retried onto their queues once they're due (default is 1
+	// second). Lower it for lower latency, or raise it to reduce the load on Redis from many worker pools.
+	RequeuePeriod time.Duration
+
+	// How workers fetch jobs from Redis (default is FetchPolling).
+	FetchStrategy FetchStrategy
+
+	// What jobs are queued on (default is BackendLists). The pool sets it for its namespace when it starts, so the
+	// enqueuers follow it within 5 minutes, and every pool in a namespace should use the same Backend.
+	Backend Backend
+
+	// If set, a DeadJobNotification is POSTed to DeadJobWebhookURL and/or published to the Redis DeadJobChannel
+	// whenever a job is moved to the dead queue.
+	DeadJobWebhookURL string
+	DeadJobChannel    string
+
+	// If set, the pool trims the dead queue every minute so it can't grow without bound: jobs that died more than
+	// DeadJobMaxAge ago are deleted, and then the oldest jobs over DeadJobMaxCount. 0 means no limit.
+	DeadJobMaxCount int64
+	DeadJobMaxAge   time.Duration
+
+	// If set, the dead jobs trimmed as per DeadJobMaxCount and DeadJobMaxAge are passed to DeadJobArchiver before
+	// they're deleted, eg, ArchiveDeadJobsTo(file).
+	DeadJobArchiver DeadJobArchiver
+
+	// If set, sent with the pool's heartbeat so that Client.WorkerPoolHeartbeats and the webui can tie the pool to a
+	// deployment: Version, eg, a git SHA, Hostname, eg, a Kubernetes pod name, which is sent instead of the host's name,
+	// and Labels, which are added to any set by other options.
+	Version  string
+	Hostname string
+	Labels   map[string]string
 }
 
 // GenericHandler is a job handler without any custom context.
 type GenericHandler func(*Job) error
 
+// GenericContextHandler is a job handler without any custom context that receives a context.Context. The context is
+// cancelled when the worker pool is stopped, so long running handlers can use it to return early.
+type GenericContextHandler func(context.Context, *Job) error
+
 // GenericMiddlewareHandler is a middleware without any custom context.
 type GenericMiddlewareHandler func(*Job, NextMiddlewareFunc) error
 
@@ -85,34 +252,159 @@ type middlewareHandler struct {
 
 // NewWorkerPool creates a new worker pool. ctx should be a struct literal whose type will be used for middleware and handlers.
 // concurrency specifies how many workers to spin up - each worker can process jobs concurrently.
-func NewWorkerPool(ctx interface{}, concurrency uint, namespace string, pool *redis.Pool) *WorkerPool {
-	return NewWorkerPoolWithOptions(ctx, concurrency, namespace, pool, WorkerPoolOptions{})
+func NewWorkerPool(ctx interface{}, concurrency uint, namespace string, pool Pool) *WorkerPool {
+	return NewWorkerPoolWithOptions(ctx, concurrency, namespace, pool)
 }
 
 // NewWorkerPoolWithOptions creates a new worker pool as per the NewWorkerPool function, but permits you to specify
-// additional options such as sleep backoffs.
-func NewWorkerPoolWithOptions(ctx interface{}, concurrency uint, namespace string, pool *redis.Pool, workerPoolOpts WorkerPoolOptions) *WorkerPool {
+// additional options such as sleep backoffs, eg:
+//
+//	wp := work.NewWorkerPoolWithOptions(Context{}, 10, "myapp-work", redisPool,
+//		work.WithLogger(logger),
+//		work.WithReapPeriod(time.Minute),
+//		work.WithMiddleware((*Context).Log),
+//	)
+//
+// Options are applied in order, so a later one overrides an earlier one. A WorkerPoolOptions is an Option too.
+func NewWorkerPoolWithOptions(ctx interface{}, concurrency uint, namespace string, pool Pool, opts ...Option) *WorkerPool {
 	if pool == nil {
-		panic("NewWorkerPool needs a non-nil *redis.Pool")
+		panic("NewWorkerPool needs a non-nil Pool")
 	}
 
 	ctxType := reflect.TypeOf(ctx)
 	validateContextType(ctxType)
 	wp := &WorkerPool{
-		workerPoolID:  makeIdentifier(),
-		concurrency:   concurrency,
-		namespace:     namespace,
-		pool:          pool,
-		sleepBackoffs: workerPoolOpts.SleepBackoffs,
-		contextType:   ctxType,
-		jobTypes:      make(map[string]*jobType),
+		workerPoolID: makeIdentifier(),
+		concurrency:  concurrency,
+		namespace:    namespace,
+		pool:         pool,
+		contextType:  ctxType,
+		jobTypes:     make(map[string]*jobType),
+		hooks:        &lifecycleHooks{},
+	}
+
+	for _, opt := range opts {
+		opt.apply(wp)
+	}
+	if _, ok := pool.(*doerPool); ok && wp.fetchStrategy == FetchPubSub {
+		panic("work: FetchPubSub can't be used with a NewDoerPool pool, as it can't subscribe to channels")
 	}
 
 	for i := uint(0); i < wp.concurrency; i++ {
-		w := newWorker(wp.namespace, wp.workerPoolID, wp.pool, wp.contextType, nil, wp.jobTypes, wp.sleepBackoffs)
+		wp.workers = append(wp.workers, wp.newWorker())
+	}
+
+	if wp.deadJobWebhookURL != "" || wp.deadJobChannel != "" {
+		n := newDeadJobNotifier(namespace, pool, wp.deadJobWebhookURL, wp.deadJobChannel, wp.logger)
+		wp.OnJobDead(n.notify)
+	}
+
+	return wp
+}
+
+func (wp *WorkerPool) newWorker() *worker {
+	w := newWorker(wp.namespace, wp.workerPoolID, wp.pool, wp.contextType, wp.middleware, wp.jobTypes, wp.sleepBackoffs, wp.logger)
+	w.metricsSink = wp.metricsSink
+	w.fetchStrategy = wp.fetchStrategy
+	w.backend = wp.backend
+	w.hooks = wp.hooks
+	w.paused = atomic.LoadInt32(&wp.paused)
+	w.wakeIdle = wp.wakeIdleWorkers
+	return w
+}
+
+// wakeIdleWorkers has the pool's idle workers that fetch the same job types as w fetch again straight away, rather than
+// when their sleep backoff is up, as w finding a job after being idle suggests that more are on their way.
+func (wp *WorkerPool) wakeIdleWorkers(w *worker) {
+	wp.nudgeIdleWorkers(func(other *worker) bool {
+		return other != w && other.dedicatedTo == w.dedicatedTo
+	})
+}
+
+// wakeWorkersFor has the pool's idle workers that fetch jobs named jobName fetch again straight away. It's called by
+// the wake subscriber with FetchPubSub when such a job is enqueued.
+func (wp *WorkerPool) wakeWorkersFor(jobName string) {
+	var dedicated bool
+	if jt, ok := wp.jobTypes[jobName]; ok {
+		dedicated = jt.DedicatedWorkers > 0
+	}
+	wp.nudgeIdleWorkers(func(w *worker) bool {
+		if dedicated {
+			return w.dedicatedTo == jobName
+		}
+		return w.dedicatedTo == ""
+	})
+}
+
+// nudgeIdleWorkers signals the idle workers that wake returns true for to fetch, without blocking on those that
+// already have been.
+func (wp *WorkerPool) nudgeIdleWorkers(wake func(w *worker) bool) {
+	for _, w := range wp.currentWorkers() {
+		if atomic.LoadInt32(&w.idle) == 0 || !wake(w) {
+			continue
+		}
+		select {
+		case w.nudgeChan <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// currentWorkers returns the pool's workers as of now.
+func (wp *WorkerPool) currentWorkers() []*worker {
+	wp.workersMu.Lock()
+	defer wp.workersMu.Unlock()
+	workers := append([]*worker(nil), wp.workers...)
+	return append(workers, wp.dedicatedWorkers...)
+}
+
+// SetConcurrency changes how many workers the pool runs, starting or stopping workers if it's already started. Stopped
+// workers finish their jobs in progress first, which SetConcurrency waits for. Jobs in progress aren't interrupted.
+func (wp *WorkerPool) SetConcurrency(concurrency uint) {
+	wp.workersMu.Lock()
+	var added, removed []*worker
+	for i := wp.concurrency; i < concurrency; i++ {
+		w := wp.newWorker()
 		wp.workers = append(wp.workers, w)
+		added = append(added, w)
 	}
+	if concurrency < wp.concurrency {
+		removed = wp.workers[concurrency:]
+		wp.workers = wp.workers[:concurrency:concurrency]
+	}
+	wp.concurrency = concurrency
+	started := wp.started
+	wp.workersMu.Unlock()
 
+	if !started {
+		return
+	}
+
+	for _, w := range added {
+		w.start()
+	}
+	wg := sync.WaitGroup{}
+	for _, w := range removed {
+		wg.Add(1)
+		go func(w *worker) {
+			w.finish()
+			wg.Done()
+		}(w)
+	}
+	wg.Wait()
+	wp.heartbeater.update(concurrency, wp.workerIDs())
+}
+
+func (wp *WorkerPool) currentConcurrency() uint {
+	wp.workersMu.Lock()
+	defer wp.workersMu.Unlock()
+	return wp.concurrency
+}
+
+// Autoscale has the pool adjust its concurrency between opts.MinConcurrency and opts.MaxConcurrency, once started,
+// according to how long jobs are waiting in its queues. See AutoscaleOptions.
+func (wp *WorkerPool) Autoscale(opts AutoscaleOptions) *WorkerPool {
+	wp.autoscaleOpts = &opts
 	return wp
 }
 
@@ -134,7 +426,7 @@ func (wp *WorkerPool) Middleware(fn interface{}) *WorkerPool {
 
 	wp.middleware = append(wp.middleware, mw)
 
-	for _, w := range wp.workers {
+	for _, w := range wp.currentWorkers() {
 		w.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
 	}
 
@@ -145,6 +437,7 @@ func (wp *WorkerPool) Middleware(fn interface{}) *WorkerPool {
 // fn can take one of these forms:
 // (*ContextType).func(*Job) error, (ContextType matches the type of ctx specified when creating a pool)
 // func(*Job) error, for the generic handler format.
+// func(context.Context, *Job) error, for the generic handler format that respects cancellation.
 func (wp *WorkerPool) Job(name string, fn interface{}) *WorkerPool {
 	return wp.JobWithOptions(name, JobOptions{}, fn)
 }
@@ -153,6 +446,12 @@ func (wp *WorkerPool) Job(name string, fn interface{}) *WorkerPool {
 // such as a job's priority, retry count, and whether to send dead jobs to the dead job queue or trash them.
 func (wp *WorkerPool) JobWithOptions(name string, jobOpts JobOptions, fn interface{}) *WorkerPool {
 	jobOpts = applyDefaultsAndValidate(jobOpts)
+	if wp.backend == BackendStreams && (jobOpts.MaxConcurrency > 0 || jobOpts.MaxPerSecond > 0 || jobOpts.StrictFIFO || jobOpts.PartitionKey != "" || jobOpts.Shards > 1 || jobOpts.AtMostOnce) {
+		panic("work: BackendStreams can't be used with JobOptions.MaxConcurrency, MaxPerSecond, StrictFIFO, PartitionKey, Shards or AtMostOnce")
+	}
+	if jobOpts.DeadLetterQueue == name && (jobOpts.DeadLetterNamespace == "" || jobOpts.DeadLetterNamespace == wp.namespace) {
+		panic("work: JobOptions.DeadLetterQueue can't be the job's own queue")
+	}
 
 	vfn := reflect.ValueOf(fn)
 	validateHandlerType(wp.contextType, vfn)
@@ -164,11 +463,24 @@ func (wp *WorkerPool) JobWithOptions(name string, jobOpts JobOptions, fn interfa
 	if gh, ok := fn.(func(*Job) error); ok {
 		jt.IsGeneric = true
 		jt.GenericHandler = gh
+	} else if gch, ok := fn.(func(context.Context, *Job) error); ok {
+		jt.IsGenericContext = true
+		jt.GenericContextHandler = gch
 	}
 
 	wp.jobTypes[name] = jt
 
-	for _, w := range wp.workers {
+	if jobOpts.DedicatedWorkers > 0 {
+		wp.workersMu.Lock()
+		for i := uint(0); i < jobOpts.DedicatedWorkers; i++ {
+			w := wp.newWorker()
+			w.dedicatedTo = name
+			wp.dedicatedWorkers = append(wp.dedicatedWorkers, w)
+		}
+		wp.workersMu.Unlock()
+	}
+
+	for _, w := range wp.currentWorkers() {
 		w.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
 	}
 
@@ -177,21 +489,102 @@ func (wp *WorkerPool) JobWithOptions(name string, jobOpts JobOptions, fn interfa
 
 // PeriodicallyEnqueue will periodically enqueue jobName according to the cron-based spec.
 // The spec format is based on https://godoc.org/github.com/robfig/cron, which is a relatively standard cron format.
-// Note that the first value is the seconds!
+// Note that the first value is the seconds! Specs with 5 fields, starting with the minutes, work too.
 // If you have multiple worker pools on different machines, they'll all coordinate and only enqueue your job once.
+// It can be called after Start, eg, when schedules are reloaded from configuration. See also RemovePeriodicJob.
 func (wp *WorkerPool) PeriodicallyEnqueue(spec string, jobName string) *WorkerPool {
+	return wp.PeriodicallyEnqueueWithOptions(spec, jobName, PeriodicOptions{})
+}
+
+// PeriodicallyEnqueueWithArgs is like PeriodicallyEnqueue, enqueueing the job with args, which must be JSON encodable.
+// The same job can be enqueued according to the same spec with different args, eg, for each tenant.
+// Example: wp.PeriodicallyEnqueueWithArgs("0 0 * * * *", "report", work.Q{"tenant_id": 4})
+func (wp *WorkerPool) PeriodicallyEnqueueWithArgs(spec string, jobName string, args map[string]interface{}) *WorkerPool {
+	return wp.PeriodicallyEnqueueWithOptions(spec, jobName, PeriodicOptions{Args: args})
+}
+
+// PeriodicallyEnqueueWithOptions is like PeriodicallyEnqueue, with options for the periodic job.
+// Example: wp.PeriodicallyEnqueueWithOptions("0 0 2 * * *", "backup", work.PeriodicOptions{Location: dublin})
+func (wp *WorkerPool) PeriodicallyEnqueueWithOptions(spec string, jobName string, opts PeriodicOptions) *WorkerPool {
 	p := cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
 
 	schedule, err := p.Parse(spec)
 	if err != nil {
 		panic(err)
 	}
+	if s, ok := schedule.(*cron.SpecSchedule); ok && opts.Location != nil {
+		s.Location = opts.Location
+	}
+
+	pj, err := newPeriodicJob(jobName, spec, schedule, opts)
+	if err != nil {
+		panic(err)
+	}
+	wp.workersMu.Lock()
+	wp.periodicJobs = append(wp.periodicJobs, pj)
+	started := wp.started
+	wp.workersMu.Unlock()
+
+	if started {
+		if err := wp.periodicEnqueuer.add(pj); err != nil {
+			logError(wp.logger, "worker_pool.periodically_enqueue", err)
+		}
+		wp.heartbeater.setPeriodicJobs(wp.currentPeriodicJobs())
+	}
+
+	return wp
+}
+
+// RemovePeriodicJob stops periodically enqueueing jobName according to spec, as set up with PeriodicallyEnqueue or its
+// variants, whatever the args or other options. If the pool is started, the runs it has already scheduled are
+// unscheduled too. Other worker pools keep enqueueing the job if they've set it up themselves.
+func (wp *WorkerPool) RemovePeriodicJob(spec string, jobName string) *WorkerPool {
+	return wp.removePeriodicJobs(func(pj *periodicJob) bool {
+		return pj.jobName == jobName && pj.spec == spec
+	})
+}
+
+// RemovePeriodicJobWithArgs is like RemovePeriodicJob, but only for the periodic job with args, as set up with
+// PeriodicallyEnqueueWithArgs.
+func (wp *WorkerPool) RemovePeriodicJobWithArgs(spec string, jobName string, args map[string]interface{}) *WorkerPool {
+	rawArgs, err := encodePeriodicArgs(args)
+	if err != nil {
+		logError(wp.logger, "worker_pool.remove_periodic_job_with_args", err)
+		return wp
+	}
+	return wp.removePeriodicJobs(func(pj *periodicJob) bool {
+		return pj.jobName == jobName && pj.spec == spec && pj.rawArgs == rawArgs
+	})
+}
 
-	wp.periodicJobs = append(wp.periodicJobs, &periodicJob{jobName: jobName, spec: spec, schedule: schedule})
+func (wp *WorkerPool) removePeriodicJobs(match func(*periodicJob) bool) *WorkerPool {
+	wp.workersMu.Lock()
+	periodicJobs := wp.periodicJobs[:0:0]
+	for _, pj := range wp.periodicJobs {
+		if !match(pj) {
+			periodicJobs = append(periodicJobs, pj)
+		}
+	}
+	wp.periodicJobs = periodicJobs
+	started := wp.started
+	wp.workersMu.Unlock()
+
+	if started {
+		if err := wp.periodicEnqueuer.remove(match); err != nil {
+			logError(wp.logger, "worker_pool.remove_periodic_job", err)
+		}
+		wp.heartbeater.setPeriodicJobs(wp.currentPeriodicJobs())
+	}
 
 	return wp
 }
 
+func (wp *WorkerPool) currentPeriodicJobs() []*periodicJob {
+	wp.workersMu.Lock()
+	defer wp.workersMu.Unlock()
+	return append([]*periodicJob(nil), wp.periodicJobs...)
+}
+
 // Start starts the workers and associated processes.
 func (wp *WorkerPool) Start() {
 	if wp.started {
@@ -201,46 +594,166 @@ func (wp *WorkerPool) Start() {
 
 	// TODO: we should cleanup stale keys on startup from previously registered jobs
 	wp.writeConcurrencyControlsToRedis()
+	wp.writeBackendToRedis()
 	go wp.writeKnownJobsToRedis()
 
-	for _, w := range wp.workers {
-		go w.start()
+	for _, w := range wp.currentWorkers() {
+		w.start()
 	}
 
-	wp.heartbeater = newWorkerPoolHeartbeater(wp.namespace, wp.pool, wp.workerPoolID, wp.jobTypes, wp.concurrency, wp.workerIDs())
+	wp.heartbeater = newWorkerPoolHeartbeater(wp.namespace, wp.pool, wp.workerPoolID, wp.jobTypes, wp.concurrency, wp.workerIDs(), wp.logger)
+	if wp.heartbeatPeriod > 0 {
+		wp.heartbeater.beatPeriod = wp.heartbeatPeriod
+	}
+	wp.heartbeater.setMetadata(wp.version, wp.hostname, wp.labels)
+	wp.heartbeater.setPeriodicJobs(wp.currentPeriodicJobs())
 	wp.heartbeater.start()
 	wp.startRequeuers()
-	wp.periodicEnqueuer = newPeriodicEnqueuer(wp.namespace, wp.pool, wp.periodicJobs)
+	wp.periodicEnqueuer = newPeriodicEnqueuer(wp.namespace, wp.pool, wp.currentPeriodicJobs(), wp.logger)
 	wp.periodicEnqueuer.start()
+	if wp.fetchStrategy == FetchPubSub {
+		jobNames := make([]string, 0, len(wp.jobTypes))
+		for name := range wp.jobTypes {
+			jobNames = append(jobNames, name)
+		}
+		wp.wakeSubscriber = newWakeSubscriber(wp.namespace, wp.pool, jobNames, wp.wakeWorkersFor, wp.logger)
+		wp.wakeSubscriber.start()
+	}
+	if wp.autoscaleOpts != nil {
+		wp.autoscaler = newAutoscaler(wp, *wp.autoscaleOpts)
+		wp.autoscaler.start()
+	}
 }
 
-// Stop stops the workers and associated processes.
+// Stop stops the workers and associated processes. It waits for the jobs in progress to finish, or for the pool's
+// WorkerPoolOptions.StopTimeout as per StopWithContext.
 func (wp *WorkerPool) Stop() {
+	ctx := context.Background()
+	if wp.stopTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, wp.stopTimeout)
+		defer cancel()
+	}
+	wp.StopWithContext(ctx)
+}
+
+// StopWithContext stops the pool like Stop, but only waits for the jobs in progress until ctx is done. Jobs still
+// running then are put back on their queues to be run again, eg, by another pool, and the pool stops without waiting
+// for their handlers to return. It returns ctx's error if it had to give up on any jobs.
+func (wp *WorkerPool) StopWithContext(ctx context.Context) error {
+	if !wp.started {
+		return nil
+	}
+	wp.started = false
+	wp.stopAutoscaler()
+
+	done := make(chan struct{})
+	go func() {
+		wg := sync.WaitGroup{}
+		for _, w := range wp.currentWorkers() {
+			wg.Add(1)
+			go func(w *worker) {
+				w.stop()
+				wg.Done()
+			}(w)
+		}
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		wp.stopProcesses()
+		return nil
+	case <-ctx.Done():
+	}
+
+	for _, w := range wp.currentWorkers() {
+		w.abandon()
+	}
+	wp.stopProcesses()
+	jobNames := make([]string, 0, len(wp.jobTypes))
+	for k := range wp.jobTypes {
+		jobNames = append(jobNames, k)
+	}
+	if _, err := wp.deadPoolReaper.requeueInProgressJobs(wp.workerPoolID, jobNames); err != nil {
+		logError(wp.logger, "worker_pool.stop.requeue_in_progress", err)
+	}
+	return ctx.Err()
+}
+
+// DrainAndStop stops the pool without losing any work, eg, for blue/green deploys. Unlike Stop, it lets the jobs in
+// progress finish without cancelling their contexts. It stops fetching new jobs, waits for the jobs in progress, moves
+// the retry and scheduled jobs that are due onto their queues for other pools to pick up, and then stops the pool.
+// Unlike Drain, it doesn't wait for the queues to be empty.
+func (wp *WorkerPool) DrainAndStop() {
 	if !wp.started {
 		return
 	}
 	wp.started = false
+	wp.stopAutoscaler()
 
 	wg := sync.WaitGroup{}
-	for _, w := range wp.workers {
+	for _, w := range wp.currentWorkers() {
 		wg.Add(1)
 		go func(w *worker) {
-			w.stop()
+			w.finish()
 			wg.Done()
 		}(w)
 	}
 	wg.Wait()
+	wp.retrier.drain()
+	wp.scheduler.drain()
+	wp.stopProcesses()
+}
+
+// stopAutoscaler stops the autoscaler, if any, first thing when stopping, so that it doesn't start workers as they're
+// being stopped.
+func (wp *WorkerPool) stopAutoscaler() {
+	if wp.autoscaler != nil {
+		wp.autoscaler.stop()
+		wp.autoscaler = nil
+	}
+}
+
+// stopProcesses stops the processes started alongside the workers.
+func (wp *WorkerPool) stopProcesses() {
 	wp.heartbeater.stop()
 	wp.retrier.stop()
 	wp.scheduler.stop()
 	wp.deadPoolReaper.stop()
+	if wp.deadJobTrimmer != nil {
+		wp.deadJobTrimmer.stop()
+		wp.deadJobTrimmer = nil
+	}
 	wp.periodicEnqueuer.stop()
+	if wp.wakeSubscriber != nil {
+		wp.wakeSubscriber.stop()
+		wp.wakeSubscriber = nil
+	}
+}
+
+// Pause stops the workers from fetching new jobs, eg, during a database migration, until Resume is called. Unlike Stop,
+// the heartbeat, requeuers and periodic enqueuer keep running. Jobs in progress aren't interrupted.
+func (wp *WorkerPool) Pause() {
+	atomic.StoreInt32(&wp.paused, 1)
+	for _, w := range wp.currentWorkers() {
+		atomic.StoreInt32(&w.paused, 1)
+	}
+}
+
+// Resume lets the workers fetch jobs again after Pause. They pick up where they left off within a few seconds.
+func (wp *WorkerPool) Resume() {
+	atomic.StoreInt32(&wp.paused, 0)
+	for _, w := range wp.currentWorkers() {
+		atomic.StoreInt32(&w.paused, 0)
+	}
 }
 
 // Drain drains all jobs in the queue before returning. Note that if jobs are added faster than we can process them, this function wouldn't return.
 func (wp *WorkerPool) Drain() {
 	wg := sync.WaitGroup{}
-	for _, w := range wp.workers {
+	for _, w := range wp.currentWorkers() {
 		wg.Add(1)
 		go func(w *worker) {
 			w.drain()
@@ -250,22 +763,72 @@ func (wp *WorkerPool) Drain() {
 	wg.Wait()
 }
 
+// RunNext fetches the next job the pool's workers would run, or the next of the jobs named jobNames if any are given, and
+// runs it on the calling goroutine. It returns the job, or nil if there wasn't one, and the error the job failed with.
+// It's meant for tests, on a pool that isn't started; see the worktest package. Job types with DedicatedWorkers are only
+// run if they're named on their own.
+func (wp *WorkerPool) RunNext(jobNames ...string) (*Job, error) {
+	jobTypes := wp.jobTypes
+	if len(jobNames) > 0 {
+		jobTypes = make(map[string]*jobType, len(jobNames))
+		for _, name := range jobNames {
+			if jt, ok := wp.jobTypes[name]; ok {
+				jobTypes[name] = jt
+			}
+		}
+	}
+	wp.writeConcurrencyControlsToRedis()
+	wp.writeBackendToRedis()
+
+	w := wp.newWorker()
+	if len(jobNames) == 1 {
+		w.dedicatedTo = jobNames[0]
+	}
+	w.updateMiddlewareAndJobTypes(wp.middleware, jobTypes)
+	w.ctx, w.cancel = context.WithCancel(context.Background())
+	defer w.cancel()
+
+	job, err := w.fetchNextJob()
+	if err != nil || job == nil {
+		return nil, err
+	}
+	return job, w.processJob(job)
+}
+
 func (wp *WorkerPool) startRequeuers() {
 	jobNames := make([]string, 0, len(wp.jobTypes))
 	for k := range wp.jobTypes {
 		jobNames = append(jobNames, k)
 	}
-	wp.retrier = newRequeuer(wp.namespace, wp.pool, redisKeyRetry(wp.namespace), jobNames)
-	wp.scheduler = newRequeuer(wp.namespace, wp.pool, redisKeyScheduled(wp.namespace), jobNames)
-	wp.deadPoolReaper = newDeadPoolReaper(wp.namespace, wp.pool, jobNames)
+	wp.retrier = newRequeuer(wp.namespace, wp.pool, redisKeyRetry(wp.namespace), jobNames, wp.logger)
+	wp.scheduler = newRequeuer(wp.namespace, wp.pool, redisKeyScheduled(wp.namespace), jobNames, wp.logger)
+	wp.deadPoolReaper = newDeadPoolReaper(wp.namespace, wp.pool, jobNames, wp.logger)
+	wp.deadPoolReaper.workerPoolID = wp.workerPoolID
+	wp.deadPoolReaper.onReap = wp.hooks.onReap
+	if wp.requeuePeriod > 0 {
+		wp.retrier.period = wp.requeuePeriod
+		wp.scheduler.period = wp.requeuePeriod
+	}
+	if wp.reapPeriod > 0 {
+		wp.deadPoolReaper.reapPeriod = wp.reapPeriod
+	}
+	if wp.heartbeatPeriod > 0 {
+		wp.deadPoolReaper.deadTime = 2 * wp.heartbeatPeriod
+	}
 	wp.retrier.start()
 	wp.scheduler.start()
 	wp.deadPoolReaper.start()
+	if wp.deadJobMaxCount > 0 || wp.deadJobMaxAge > 0 {
+		wp.deadJobTrimmer = newDeadJobTrimmer(wp.namespace, wp.pool, wp.deadJobMaxCount, wp.deadJobMaxAge, wp.logger)
+		wp.deadJobTrimmer.archive = wp.deadJobArchiver
+		wp.deadJobTrimmer.start()
+	}
 }
 
 func (wp *WorkerPool) workerIDs() []string {
-	wids := make([]string, 0, len(wp.workers))
-	for _, w := range wp.workers {
+	workers := wp.currentWorkers()
+	wids := make([]string, 0, len(workers))
+	for _, w := range workers {
 		wids = append(wids, w.workerID)
 	}
 	sort.Strings(wids)
@@ -287,7 +850,7 @@ func (wp *WorkerPool) writeKnownJobsToRedis() {
 	}
 
 	if _, err := conn.Do("SADD", jobNames...); err != nil {
-		logError("write_known_jobs", err)
+		logError(wp.logger, "write_known_jobs", err)
 	}
 }
 
@@ -300,7 +863,30 @@ func (wp *WorkerPool) writeConcurrencyControlsToRedis() {
 	defer conn.Close()
 	for jobName, jobType := range wp.jobTypes {
 		if _, err := conn.Do("SET", redisKeyJobsConcurrency(wp.namespace, jobName), jobType.MaxConcurrency); err != nil {
-			logError("write_concurrency_controls_max_concurrency", err)
+			logError(wp.logger, "write_concurrency_controls_max_concurrency", err)
+		}
+		if _, err := conn.Do("HSET", redisKeyJobsRateLimit(wp.namespace, jobName), "max_per_second", jobType.MaxPerSecond); err != nil {
+			logError(wp.logger, "write_concurrency_controls_max_per_second", err)
+		}
+		if _, err := conn.Do("SET", redisKeyJobsUniqueTTL(wp.namespace, jobName), int64(jobType.UniqueTTL/time.Second)); err != nil {
+			logError(wp.logger, "write_concurrency_controls_unique_ttl", err)
+		}
+		var err error
+		if jobType.PartitionKey != "" {
+			_, err = conn.Do("SET", redisKeyJobsPartition(wp.namespace, jobName), jobType.PartitionKey)
+		} else {
+			_, err = conn.Do("DEL", redisKeyJobsPartition(wp.namespace, jobName))
+		}
+		if err != nil {
+			logError(wp.logger, "write_concurrency_controls_partition_key", err)
+		}
+		if jobType.Shards > 1 {
+			_, err = conn.Do("HMSET", redisKeyJobsShards(wp.namespace, jobName), "count", jobType.Shards, "key", jobType.ShardKey)
+		} else {
+			_, err = conn.Do("DEL", redisKeyJobsShards(wp.namespace, jobName))
+		}
+		if err != nil {
+			logError(wp.logger, "write_concurrency_controls_shards", err)
 		}
 	}
 }
@@ -354,6 +940,11 @@ func instructiveMessage(vfn reflect.Value, addingType string, yourType string, a
 	str += "* func (c *" + ctxString + ") YourFunctionName(" + args + ") error  // or,\n"
 	str += "* func YourFunctionName(c *" + ctxString + ", " + args + ") error\n"
 	str += "*\n"
+	if yourType == "handler" {
+		str += "* // If you want your " + yourType + " to respect cancellation when the pool is stopped:\n"
+		str += "* func YourFunctionName(ctx context.Context, " + args + ") error\n"
+		str += "*\n"
+	}
 	str += "* Unfortunately, your function has this signature: " + vfn.Type().String() + "\n"
 	str += "*\n"
 	str += strings.Repeat("*", 120) + "\n"
@@ -383,12 +974,13 @@ func isValidHandlerType(ctxType reflect.Type, vfn reflect.Value) bool {
 	}
 
 	var j *Job
+	var c *context.Context
 	if numIn == 1 {
 		if fnType.In(0) != reflect.TypeOf(j) {
 			return false
 		}
 	} else if numIn == 2 {
-		if fnType.In(0) != reflect.PtrTo(ctxType) {
+		if fnType.In(0) != reflect.PtrTo(ctxType) && fnType.In(0) != reflect.TypeOf(c).Elem() {
 			return false
 		}
 		if fnType.In(1) != reflect.TypeOf(j) {
@@ -457,9 +1049,43 @@ func applyDefaultsAndValidate(jobOpts JobOptions) JobOptions {
 		jobOpts.MaxFails = 4
 	}
 
+	if jobOpts.ResultTTL == 0 {
+		jobOpts.ResultTTL = defaultResultTTL
+	} else if jobOpts.ResultTTL < time.Second {
+		panic("work: JobOptions.ResultTTL must be at least a second")
+	}
+
+	if jobOpts.IdempotencyTTL == 0 {
+		jobOpts.IdempotencyTTL = defaultIdempotencyTTL
+	}
+
+	if jobOpts.UniqueTTL == 0 {
+		jobOpts.UniqueTTL = defaultUniqueTTL
+	} else if jobOpts.UniqueTTL < time.Second {
+		panic("work: JobOptions.UniqueTTL must be at least a second")
+	}
+
 	if jobOpts.Priority > 100000 {
 		panic("work: JobOptions.Priority must be between 1 and 100000")
 	}
 
+	if jobOpts.MaxPerSecond < 0 {
+		panic("work: JobOptions.MaxPerSecond must not be negative")
+	}
+
+	if jobOpts.ExpiresIn != 0 && jobOpts.ExpiresIn < time.Second {
+		panic("work: JobOptions.ExpiresIn must be at least a second")
+	}
+
+	if jobOpts.StrictFIFO {
+		if jobOpts.MaxConcurrency > 1 {
+			panic("work: JobOptions.StrictFIFO can't be used with a MaxConcurrency above 1")
+		}
+		if jobOpts.Shards > 1 {
+			panic("work: JobOptions.StrictFIFO can't be used with Shards")
+		}
+		jobOpts.MaxConcurrency = 1
+	}
+
 	return jobOpts
 }
@@ -2,8 +2,11 @@ package work
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -29,6 +32,8 @@ func TestWorkerPoolHandlerValidations(t *testing.T) {
 	}{
 		{func(j *Job) error { return nil }, true},
 		{func(c *tstCtx, j *Job) error { return nil }, true},
+		{func(ctx context.Context, j *Job) error { return nil }, true},
+		{func(ctx context.Context, j *Job) {}, false},
 		{func(c *tstCtx, j *Job) {}, false},
 		{func(c *tstCtx, j *Job) string { return "" }, false},
 		{func(c *tstCtx, j *Job) (error, string) { return nil, "" }, false},
@@ -84,6 +89,198 @@ func TestWorkerPoolStartStop(t *testing.T) {
 	wp.Stop()
 }
 
+func TestWorkerPoolPeriods(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{
+		HeartbeatPeriod: 2 * time.Second,
+		ReapPeriod:      time.Minute,
+		RequeuePeriod:   10 * time.Millisecond,
+	})
+	var ran int32
+	wp.Job("wat", func(job *Job) error {
+		atomic.AddInt32(&ran, 1)
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+
+	assert.Equal(t, 2*time.Second, wp.heartbeater.beatPeriod)
+	assert.Equal(t, 4*time.Second, wp.deadPoolReaper.deadTime)
+	assert.Equal(t, time.Minute, wp.deadPoolReaper.reapPeriod)
+	assert.Equal(t, 10*time.Millisecond, wp.scheduler.period)
+	assert.Equal(t, 10*time.Millisecond, wp.retrier.period)
+
+	// A scheduled job is picked up well before the default period of a second
+	_, err := NewEnqueuer(ns, pool).EnqueueIn("wat", 0, nil)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&ran) == 1 }, 500*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestWorkerPoolFetchBlocking(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	// Polling, the job wouldn't be picked up for 5 seconds
+	wp := NewWorkerPoolWithOptions(TestContext{}, 2, ns, pool, WithFetchStrategy(FetchBlocking), WithSleepBackoffs(5000))
+	var ran int32
+	wp.Job("wat", func(job *Job) error {
+		atomic.AddInt32(&ran, 1)
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+	time.Sleep(100 * time.Millisecond) // let the workers find nothing to do and start waiting
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&ran) == 1 }, time.Second, 10*time.Millisecond)
+
+	_, err = enqueuer.EnqueueIn("wat", 0, nil)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&ran) == 2 }, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestWorkerPoolFetchPubSub(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	// Polling, the job wouldn't be picked up for 5 seconds
+	wp := NewWorkerPoolWithOptions(TestContext{}, 2, ns, pool, WithFetchStrategy(FetchPubSub), WithSleepBackoffs(5000))
+	var ran int32
+	wp.Job("wat", func(job *Job) error {
+		atomic.AddInt32(&ran, 1)
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+	time.Sleep(100 * time.Millisecond) // let the workers find nothing to do and the pool subscribe
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&ran) == 1 }, time.Second, 10*time.Millisecond)
+
+	_, err = enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&ran) == 2 }, time.Second, 10*time.Millisecond)
+
+	_, err = enqueuer.EnqueueIn("wat", 0, nil)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&ran) == 3 }, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestWorkerPoolWakeWorkersFor(t *testing.T) {
+	wp := NewWorkerPool(TestContext{}, 2, "work", newTestPool(":0"))
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.JobWithOptions("taw", JobOptions{DedicatedWorkers: 1}, func(job *Job) error { return nil })
+	for _, w := range wp.currentWorkers() {
+		atomic.StoreInt32(&w.idle, 1)
+	}
+
+	wp.wakeWorkersFor("taw")
+	assert.Len(t, wp.dedicatedWorkers[0].nudgeChan, 1)
+	assert.Len(t, wp.workers[0].nudgeChan, 0)
+
+	wp.wakeWorkersFor("wat")
+	assert.Len(t, wp.workers[0].nudgeChan, 1)
+	assert.Len(t, wp.workers[1].nudgeChan, 1)
+	assert.Len(t, wp.dedicatedWorkers[0].nudgeChan, 1)
+}
+
+func TestWorkerPoolPeriodicJobsAfterStart(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1468359453)
+	defer resetNowEpochSecondsMock()
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("foo", func(job *Job) error { return nil })
+	wp.PeriodicallyEnqueue("0 * * * * *", "foo")
+
+	// Stop the pool's periodic enqueuer from running when it starts, so as not to race with the test.
+	conn := pool.Get()
+	_, err := conn.Do("SET", redisKeyLastPeriodicEnqueue(ns), nowEpochSeconds())
+	conn.Close()
+	assert.NoError(t, err)
+
+	wp.Start()
+	defer wp.Stop()
+	assert.NoError(t, wp.periodicEnqueuer.enqueue())
+
+	// Added after Start, its runs are scheduled straight away.
+	wp.PeriodicallyEnqueue("30 * * * * *", "foo")
+	assert.EqualValues(t, 8, zsetSize(pool, redisKeyScheduled(ns)))
+
+	wp.heartbeater.heartbeat()
+	periodicJobs, err := NewClient(ns, pool).PeriodicJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, []*PeriodicJob{
+		{Name: "foo", Spec: "0 * * * * *", LastEnqueuedAt: 1468359453, NextDueAt: 1468359480},
+		{Name: "foo", Spec: "30 * * * * *", LastEnqueuedAt: 1468359453, NextDueAt: 1468359510},
+	}, periodicJobs)
+
+	// Removing it unschedules its runs.
+	wp.RemovePeriodicJob("0 * * * * *", "foo")
+	assert.EqualValues(t, 4, zsetSize(pool, redisKeyScheduled(ns)))
+
+	wp.heartbeater.heartbeat()
+	periodicJobs, err = NewClient(ns, pool).PeriodicJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, []*PeriodicJob{{Name: "foo", Spec: "30 * * * * *", LastEnqueuedAt: 1468359453, NextDueAt: 1468359510}}, periodicJobs)
+}
+
+func TestWorkerPoolPeriodicSkipIfRunning(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "nightly"
+	cleanKeyspace(ns, pool)
+
+	var runs int64
+	release := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job(job1, func(job *Job) error {
+		atomic.AddInt64(&runs, 1)
+		<-release
+		return nil
+	})
+
+	// Two runs of the periodic job, due at once.
+	pj, err := newPeriodicJob(job1, "0 * * * * *", nil, PeriodicOptions{SkipIfRunning: true})
+	assert.NoError(t, err)
+	conn := pool.Get()
+	for _, epoch := range []int64{1468359480, 1468359540} {
+		rawJSON, err := (&Job{Name: job1, ID: fmt.Sprintf("%s:%d", pj.id, epoch), EnqueuedAt: epoch, RunLock: pj.id}).serialize()
+		assert.NoError(t, err)
+		_, err = conn.Do("LPUSH", redisKeyJobs(ns, job1), rawJSON)
+		assert.NoError(t, err)
+	}
+	conn.Close()
+
+	wp.Start()
+	for i := 0; i < 100 && listSize(pool, redisKeyJobs(ns, job1)) > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wp.Drain()
+	wp.Stop()
+
+	// The second run was skipped as the first was still running, and the first released its lock.
+	assert.EqualValues(t, 1, atomic.LoadInt64(&runs))
+	conn = pool.Get()
+	defer conn.Close()
+	exists, err := redis.Bool(conn.Do("EXISTS", redisKeyRunLock(ns, pj.id)))
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
 func TestWorkerPoolValidations(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -112,6 +309,25 @@ func TestWorkerPoolValidations(t *testing.T) {
 
 		wp.Job("wat", TestWorkerPoolValidations)
 	}()
+
+	func() {
+		defer func() {
+			if panicErr := recover(); panicErr != nil {
+				assert.Regexp(t, "StrictFIFO can't be used with a MaxConcurrency above 1", fmt.Sprintf("%v", panicErr))
+			} else {
+				t.Errorf("expected a panic when using StrictFIFO with a MaxConcurrency")
+			}
+		}()
+
+		wp.JobWithOptions("wat", JobOptions{StrictFIFO: true, MaxConcurrency: 2}, func(job *Job) error { return nil })
+	}()
+
+	assert.PanicsWithValue(t, "work: JobOptions.ExpiresIn must be at least a second", func() {
+		wp.JobWithOptions("wat", JobOptions{ExpiresIn: time.Millisecond}, func(job *Job) error { return nil })
+	})
+	assert.PanicsWithValue(t, "work: JobOptions.ResultTTL must be at least a second", func() {
+		wp.JobWithOptions("wat", JobOptions{ResultTTL: 500 * time.Millisecond}, func(job *Job) error { return nil })
+	})
 }
 
 func TestWorkersPoolRunSingleThreaded(t *testing.T) {
@@ -206,6 +422,347 @@ func TestWorkerPoolPauseSingleThreadedJobs(t *testing.T) {
 	assert.EqualValues(t, 0, hgetInt64(pool, redisKeyJobsLockInfo(ns, job1), wp.workerPoolID))
 }
 
+func TestWorkerPoolStopCancelsContext(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	started := make(chan struct{})
+	var ctxErr error
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job(job1, func(ctx context.Context, job *Job) error {
+		close(started)
+		<-ctx.Done()
+		ctxErr = ctx.Err()
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, nil)
+	assert.Nil(t, err)
+
+	wp.Start()
+	<-started
+	wp.Stop()
+
+	assert.Equal(t, context.Canceled, ctxErr)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
+}
+
+func TestWorkerPoolDrainAndStop(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{})
+	var ctxErr error
+	var processed int64
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job(job1, func(ctx context.Context, job *Job) error {
+		if atomic.AddInt64(&processed, 1) == 1 {
+			close(started)
+		}
+		time.Sleep(100 * time.Millisecond)
+		ctxErr = ctx.Err()
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue(job1, nil)
+		assert.NoError(t, err)
+	}
+
+	wp.Start()
+	<-started
+	// Make a scheduled job due, for DrainAndStop to requeue.
+	_, err := enqueuer.EnqueueIn(job1, 0, nil)
+	assert.NoError(t, err)
+	wp.DrainAndStop()
+
+	// The job in progress finished without being cancelled, and no more were started.
+	assert.NoError(t, ctxErr)
+	assert.EqualValues(t, 1, atomic.LoadInt64(&processed))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+}
+
+func TestWorkerPoolStopWithContext(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job(job1, func(job *Job) error {
+		close(started)
+		<-release // ignores the pool stopping
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.Enqueue(job1, nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, wp.StopWithContext(ctx))
+
+	// The job was put back on its queue.
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+	assert.Equal(t, job.ID, jobOnQueue(pool, redisKeyJobs(ns, job1)).ID)
+}
+
+func TestWorkerPoolPauseResume(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+
+	var processed int64
+	wp := NewWorkerPool(TestContext{}, 3, ns, pool)
+	wp.Job(job1, func(job *Job) error {
+		atomic.AddInt64(&processed, 1)
+		return nil
+	})
+
+	wp.Start()
+	defer wp.Stop()
+	wp.Pause()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 5; i++ {
+		_, err := enqueuer.Enqueue(job1, nil)
+		assert.NoError(t, err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	wp.Drain() // returns straight away while paused
+	assert.EqualValues(t, 0, atomic.LoadInt64(&processed))
+	assert.EqualValues(t, 5, listSize(pool, redisKeyJobs(ns, job1)))
+
+	// Still heartbeating while paused.
+	heartbeats, err := NewClient(ns, pool).WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(heartbeats))
+
+	wp.Resume()
+	deadline := time.Now().Add(10 * time.Second)
+	for atomic.LoadInt64(&processed) < 5 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.EqualValues(t, 5, atomic.LoadInt64(&processed))
+}
+
+func TestWorkerPoolSetConcurrency(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+
+	var processed int64
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job(job1, func(job *Job) error {
+		atomic.AddInt64(&processed, 1)
+		return nil
+	})
+
+	// Before starting, it just changes the number of workers.
+	wp.SetConcurrency(3)
+	assert.Equal(t, 3, len(wp.workerIDs()))
+
+	wp.Start()
+	defer wp.Stop()
+
+	wp.SetConcurrency(5)
+	assert.Equal(t, 5, len(wp.workerIDs()))
+	wp.SetConcurrency(1)
+	assert.Equal(t, 1, len(wp.workerIDs()))
+
+	wp.heartbeater.heartbeat()
+	heartbeats, err := NewClient(ns, pool).WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(heartbeats)) {
+		assert.EqualValues(t, 1, heartbeats[0].Concurrency)
+		assert.Equal(t, wp.workerIDs(), heartbeats[0].WorkerIDs)
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 5; i++ {
+		_, err := enqueuer.Enqueue(job1, nil)
+		assert.NoError(t, err)
+	}
+	wp.Drain()
+	assert.EqualValues(t, 5, atomic.LoadInt64(&processed))
+}
+
+func TestWorkerPoolDedicatedWorkers(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var processed int64
+	handler := func(job *Job) error {
+		atomic.AddInt64(&processed, 1)
+		return nil
+	}
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("send_email", JobOptions{DedicatedWorkers: 1}, handler)
+	wp.Job("other", handler)
+
+	// The shared workers leave send_email to its dedicated worker.
+	fetches := func(w *worker) []string {
+		var queues []string
+		for _, s := range w.sampler.samples {
+			queues = append(queues, s.redisJobs)
+		}
+		return queues
+	}
+	workers := wp.currentWorkers()
+	if assert.Equal(t, 3, len(workers)) {
+		assert.Equal(t, []string{redisKeyJobs(ns, "other")}, fetches(workers[0]))
+		assert.Equal(t, []string{redisKeyJobs(ns, "other")}, fetches(workers[1]))
+		assert.Equal(t, []string{redisKeyJobs(ns, "send_email")}, fetches(workers[2]))
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("send_email", nil)
+		assert.NoError(t, err)
+		_, err = enqueuer.Enqueue("other", nil)
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 6, atomic.LoadInt64(&processed))
+}
+
+func TestWorkerPoolStrictFIFO(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+
+	var mu sync.Mutex
+	var order []int64
+	wp := NewWorkerPool(TestContext{}, 5, ns, pool)
+	wp.JobWithOptions(job1, JobOptions{StrictFIFO: true, Backoff: func(*Job) int64 { return 0 }}, func(job *Job) error {
+		mu.Lock()
+		defer mu.Unlock()
+		n := job.ArgInt64("n")
+		order = append(order, n)
+		if n == 1 && job.Fails < 2 {
+			return fmt.Errorf("sorry kid")
+		}
+		return nil
+	})
+	assert.EqualValues(t, 1, wp.jobTypes[job1].MaxConcurrency)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 4; i++ {
+		_, err := enqueuer.Enqueue(job1, Q{"n": i})
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// The failing job is retried before anything behind it runs.
+	assert.Equal(t, []int64{0, 1, 1, 1, 2, 3}, order)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+}
+
+func TestWorkerPoolPartitionKey(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+
+	var mu sync.Mutex
+	running := map[string]int{}
+	order := map[string][]int64{}
+	var maxRunning, overlapping int
+	wp := NewWorkerPool(TestContext{}, 6, ns, pool)
+	wp.JobWithOptions(job1, JobOptions{PartitionKey: "account_id"}, func(job *Job) error {
+		account := job.ArgString("account_id")
+		mu.Lock()
+		running[account]++
+		if running[account] > 1 {
+			overlapping++
+		}
+		total := 0
+		for _, n := range running {
+			total += n
+		}
+		if total > maxRunning {
+			maxRunning = total
+		}
+		order[account] = append(order[account], job.ArgInt64("n"))
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		running[account]--
+		mu.Unlock()
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 5; i++ {
+		for _, account := range []string{"a", "b", "c"} {
+			_, err := enqueuer.Enqueue(job1, Q{"account_id": account, "n": i})
+			assert.NoError(t, err)
+		}
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// Jobs for the same account never overlap and run in order, while different accounts run in parallel.
+	assert.Equal(t, 0, overlapping)
+	assert.True(t, maxRunning > 1)
+	for _, account := range []string{"a", "b", "c"} {
+		assert.Equal(t, []int64{0, 1, 2, 3, 4}, order[account], account)
+		assert.EqualValues(t, 0, listSize(pool, redisKeyJobsPartitionWaiting(ns, job1, account)))
+	}
+}
+
+func TestWorkerPoolRateLimit(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+
+	var processed int64
+	wp := NewWorkerPool(TestContext{}, 5, ns, pool)
+	wp.JobWithOptions(job1, JobOptions{MaxPerSecond: 5}, func(job *Job) error {
+		atomic.AddInt64(&processed, 1)
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 30; i++ {
+		_, err := enqueuer.Enqueue(job1, nil)
+		assert.Nil(t, err)
+	}
+
+	sleepBackoffsInMilliseconds = []int64{10, 10, 10, 10, 10}
+	wp.Start()
+	time.Sleep(time.Second)
+	wp.Stop()
+
+	// A full bucket of 5, plus 5 more refilled over the second
+	n := atomic.LoadInt64(&processed)
+	assert.True(t, n >= 5, "processed too few jobs: %d", n)
+	assert.True(t, n <= 11, "processed too many jobs: %d", n)
+	assert.EqualValues(t, 30-n, listSize(pool, redisKeyJobs(ns, job1)))
+}
+
 // Test Helpers
 func (t *TestContext) SleepyJob(job *Job) error {
 	sleepTime := time.Duration(job.ArgInt64("sleep"))
@@ -224,3 +781,31 @@ func setupTestWorkerPool(pool *redis.Pool, namespace, jobName string, concurrenc
 	sleepBackoffsInMilliseconds = []int64{10, 10, 10, 10, 10}
 	return wp
 }
+
+func TestWorkerPoolDeadLetterQueueValidation(t *testing.T) {
+	wp := NewWorkerPool(TestContext{}, 1, "work", newTestPool(":0"))
+	handler := func(job *Job) error { return nil }
+	assert.PanicsWithValue(t, "work: JobOptions.DeadLetterQueue can't be the job's own queue", func() {
+		wp.JobWithOptions("wat", JobOptions{DeadLetterQueue: "wat"}, handler)
+	})
+	assert.NotPanics(t, func() {
+		wp.JobWithOptions("wat", JobOptions{DeadLetterQueue: "wat", DeadLetterNamespace: "repairs"}, handler)
+	})
+}
+
+func TestWorkerPoolWakeIdleWorkers(t *testing.T) {
+	wp := NewWorkerPool(TestContext{}, 3, "work", newTestPool(":0"))
+	wp.JobWithOptions("wat", JobOptions{DedicatedWorkers: 1}, func(job *Job) error { return nil })
+
+	busy, idle, other := wp.workers[0], wp.workers[1], wp.workers[2]
+	atomic.StoreInt32(&idle.idle, 1)
+	atomic.StoreInt32(&wp.dedicatedWorkers[0].idle, 1)
+
+	wp.wakeIdleWorkers(other)
+	wp.wakeIdleWorkers(other) // doesn't block on a worker that's already been nudged
+
+	assert.Len(t, idle.nudgeChan, 1)
+	assert.Len(t, busy.nudgeChan, 0)
+	assert.Len(t, other.nudgeChan, 0)
+	assert.Len(t, wp.dedicatedWorkers[0].nudgeChan, 0)
+}
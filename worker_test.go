@@ -1,8 +1,11 @@
 package work
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -61,7 +64,7 @@ func TestWorkerBasics(t *testing.T) {
 	_, err = enqueuer.Enqueue(job3, Q{"a": 3})
 	assert.Nil(t, err)
 
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
 	w.start()
 	w.drain()
 	w.stop()
@@ -88,6 +91,338 @@ func TestWorkerBasics(t *testing.T) {
 	assert.EqualValues(t, 0, len(h))
 }
 
+func TestWorkerJobPriorities(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	var order []string
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			order = append(order, job.ArgString("p"))
+			return nil
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueWithPriority(job1, PriorityLow, Q{"p": "low"})
+	assert.Nil(t, err)
+	_, err = enqueuer.Enqueue(job1, Q{"p": "normal1"})
+	assert.Nil(t, err)
+	_, err = enqueuer.EnqueueWithPriority(job1, PriorityHigh, Q{"p": "high"})
+	assert.Nil(t, err)
+	_, err = enqueuer.Enqueue(job1, Q{"p": "normal2"})
+	assert.Nil(t, err)
+	_, err = enqueuer.EnqueueWithPriority(job1, PriorityCritical, Q{"p": "critical"})
+	assert.Nil(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.Equal(t, []string{"critical", "high", "normal1", "normal2", "low"}, order)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyJobsPriority(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+}
+
+func TestWorkerJobResults(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 1, ResultTTL: time.Minute},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			if job.ArgBool("fail") {
+				return fmt.Errorf("sorry kid")
+			}
+			return job.SetResult(map[string]int64{"sum": job.ArgInt64("a") + job.ArgInt64("b")})
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	ok, err := enqueuer.Enqueue(job1, Q{"a": 1, "b": 2})
+	assert.Nil(t, err)
+	failed, err := enqueuer.Enqueue(job1, Q{"fail": true})
+	assert.Nil(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	client := NewClient(ns, pool)
+	res, err := client.JobResult(ok.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, res) {
+		assert.Equal(t, ok.ID, res.JobID)
+		assert.Equal(t, "", res.Err)
+		var sum map[string]int64
+		assert.NoError(t, res.Unmarshal(&sum))
+		assert.EqualValues(t, 3, sum["sum"])
+	}
+
+	ttl, err := redis.Int64(pool.Get().Do("TTL", redisKeyJobResult(ns, ok.ID)))
+	assert.NoError(t, err)
+	assert.True(t, ttl > 0 && ttl <= 60)
+
+	// Failed jobs that didn't set a result and weren't enqueued with EnqueueAndWait don't store one.
+	res, err = client.JobResult(failed.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestWorkerJobChain(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var calls []string
+	var notifyArgs map[string]interface{}
+	jobTypes := make(map[string]*jobType)
+	for _, name := range []string{"resize", "upload", "notify"} {
+		name := name
+		jobTypes[name] = &jobType{
+			Name:       name,
+			JobOptions: JobOptions{Priority: 1, MaxFails: 1, SkipDead: true},
+			IsGeneric:  true,
+			GenericHandler: func(job *Job) error {
+				calls = append(calls, name)
+				switch name {
+				case "resize":
+					return job.SetResult(Q{"path": "/tmp/" + job.ArgString("image") + ".png"})
+				case "upload":
+					if job.ArgBool("fail") {
+						return fmt.Errorf("upload failed")
+					}
+					return job.SetResult(Q{"url": "https://example.com" + job.ArgString("path"), "bucket": "result"})
+				default:
+					notifyArgs = job.Args
+					return nil
+				}
+			},
+		}
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueChain(Chain("resize", Q{"image": "cat"}).
+		Then("upload", Q{"bucket": "images"}).
+		Then("notify", Q{"user_id": 2}))
+	assert.NoError(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.Equal(t, []string{"resize", "upload", "notify"}, calls)
+	assert.Equal(t, "https://example.com/tmp/cat.png", notifyArgs["url"])
+	assert.Equal(t, "images", notifyArgs["bucket"])
+	assert.EqualValues(t, 2, notifyArgs["user_id"])
+
+	// A step that fails stops the chain.
+	calls = nil
+	_, err = enqueuer.EnqueueChain(Chain("resize", Q{"image": "dog"}).
+		Then("upload", Q{"fail": true}).
+		Then("notify", nil))
+	assert.NoError(t, err)
+
+	w = newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.Equal(t, []string{"resize", "upload"}, calls)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "notify")))
+
+	_, err = enqueuer.EnqueueChain(nil)
+	assert.Error(t, err)
+}
+
+func TestWorkerJobChainWakesWorkers(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	psc := redis.PubSubConn{Conn: pool.Get()}
+	defer psc.Close()
+	assert.NoError(t, psc.Subscribe(redisChannelJobsWake(ns, "upload")))
+	psc.Receive() // the subscription
+
+	_, err := NewEnqueuer(ns, pool).EnqueueChain(Chain("resize", nil).Then("upload", nil))
+	assert.NoError(t, err)
+	jobTypes := map[string]*jobType{"resize": {
+		Name:           "resize",
+		JobOptions:     JobOptions{Priority: 1, MaxFails: 1},
+		IsGeneric:      true,
+		GenericHandler: func(job *Job) error { return nil },
+	}}
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "upload")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsNotify(ns, "upload")))
+	msg, ok := psc.ReceiveWithTimeout(time.Second).(redis.Message)
+	if assert.True(t, ok) {
+		assert.Equal(t, redisChannelJobsWake(ns, "upload"), msg.Channel)
+	}
+}
+func TestWorkerUniqueUntilComplete(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	job2 := "job2"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	var dupWhileRunning *Job
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3, UniqueMode: UniqueUntilComplete},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			var err error
+			dupWhileRunning, err = enqueuer.EnqueueUnique(job1, Q{"a": 1})
+			return err
+		},
+	}
+	jobTypes[job2] = &jobType{
+		Name:       job2,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3, UniqueMode: UniqueUntilComplete},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			return fmt.Errorf("sorry kid")
+		},
+	}
+
+	job, err := enqueuer.EnqueueUnique(job1, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	job, err = enqueuer.EnqueueUnique(job2, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	// The lock was held while job1 ran and released once it succeeded.
+	assert.Nil(t, dupWhileRunning)
+	job, err = enqueuer.EnqueueUnique(job1, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// job2 failed and is waiting to be retried, so it's still locked.
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	job, err = enqueuer.EnqueueUnique(job2, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+}
+
+func TestWorkerCancelJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan string, 1)
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:             job1,
+		JobOptions:       JobOptions{Priority: 1, MaxFails: 3},
+		IsGenericContext: true,
+		GenericContextHandler: func(ctx context.Context, job *Job) error {
+			started <- job.ID
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(10 * time.Second):
+				return nil
+			}
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, nil)
+	assert.NoError(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.start()
+	jobID := <-started
+	begin := time.Now()
+	assert.NoError(t, NewClient(ns, pool).CancelJob(jobID))
+	w.drain()
+	w.stop()
+
+	assert.True(t, time.Since(begin) < 5*time.Second)
+	// Cancelled jobs go straight to the dead queue rather than being retried.
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+}
+
+func TestWorkerConcurrencyLeases(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	var ran int64
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxConcurrency: 1},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			atomic.AddInt64(&ran, 1)
+			return nil
+		},
+	}
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("SET", redisKeyJobsConcurrency(ns, job1), 1)
+	assert.NoError(t, err)
+
+	// A live worker elsewhere holds the only slot
+	nowMs := time.Now().UnixNano() / 1000 / 1000
+	_, err = conn.Do("ZADD", redisKeyJobsLeases(ns, job1), nowMs+60000, "liveworker")
+	assert.NoError(t, err)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err = enqueuer.Enqueue(job1, nil)
+	assert.Nil(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	job, err := w.fetchJob()
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	// Once the lease expires, eg, because that worker crashed, the slot is reclaimed
+	_, err = conn.Do("ZADD", redisKeyJobsLeases(ns, job1), nowMs-1, "liveworker")
+	assert.NoError(t, err)
+
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.EqualValues(t, 1, ran)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyJobsLeases(ns, job1)))
+}
+
 func TestWorkerInProgress(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -111,7 +446,7 @@ func TestWorkerInProgress(t *testing.T) {
 	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
 	assert.Nil(t, err)
 
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
 	w.start()
 
 	// instead of w.forceIter(), we'll wait for 10 milliseconds to let the job start
@@ -162,7 +497,7 @@ func TestWorkerRetry(t *testing.T) {
 	enqueuer := NewEnqueuer(ns, pool)
 	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
 	assert.Nil(t, err)
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
 	w.start()
 	w.drain()
 	w.stop()
@@ -214,7 +549,7 @@ func TestWorkerRetryWithCustomBackoff(t *testing.T) {
 	enqueuer := NewEnqueuer(ns, pool)
 	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
 	assert.Nil(t, err)
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
 	w.start()
 	w.drain()
 	w.stop()
@@ -271,7 +606,7 @@ func TestWorkerDead(t *testing.T) {
 	assert.Nil(t, err)
 	_, err = enqueuer.Enqueue(job2, nil)
 	assert.Nil(t, err)
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
 	w.start()
 	w.drain()
 	w.stop()
@@ -301,6 +636,407 @@ func TestWorkerDead(t *testing.T) {
 	assert.True(t, (nowEpochSeconds()-job.FailedAt) <= 2)
 }
 
+func TestWorkerNoRetry(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	job2 := "job2"
+	cleanKeyspace(ns, pool)
+
+	errValidation := fmt.Errorf("validation failed")
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			return fmt.Errorf("bad address: %w", ErrNoRetry)
+		},
+	}
+	jobTypes[job2] = &jobType{
+		Name: job2,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3, RetryIf: func(err error) bool {
+			return err != errValidation
+		}},
+		IsGeneric: true,
+		GenericHandler: func(job *Job) error {
+			if job.ArgBool("invalid") {
+				return errValidation
+			}
+			return fmt.Errorf("timeout")
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, nil)
+	assert.Nil(t, err)
+	_, err = enqueuer.Enqueue(job2, Q{"invalid": true})
+	assert.Nil(t, err)
+	_, err = enqueuer.Enqueue(job2, Q{"invalid": false})
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	// Only the timed out job2 is retried.
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyDead(ns)))
+	_, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, "timeout", job.LastErr)
+}
+
+func TestWorkerRetryIfCalledOnce(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var calls int64
+	var retried, dead int64
+	sink := &testMetricsSink{counts: make(map[string]int64)}
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{MetricsSink: sink})
+	wp.JobWithOptions("flaky", JobOptions{MaxFails: 3, RetryIf: func(err error) bool {
+		// Only the first answer counts.
+		return atomic.AddInt64(&calls, 1) == 1
+	}}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+	wp.OnJobRetry(func(job *Job, err error) { atomic.AddInt64(&retried, 1) }).
+		OnJobDead(func(job *Job, err error) { atomic.AddInt64(&dead, 1) })
+
+	_, err := NewEnqueuer(ns, pool).Enqueue("flaky", nil)
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 1, atomic.LoadInt64(&calls))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 1, atomic.LoadInt64(&retried))
+	assert.EqualValues(t, 0, atomic.LoadInt64(&dead))
+	assert.EqualValues(t, 1, sink.counts["jobs.retried job:flaky"])
+	assert.EqualValues(t, 0, sink.counts["jobs.dead job:flaky"])
+}
+
+func TestWorkerAtMostOnce(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	var inProgress []int64
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3, AtMostOnce: true},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			// The job's acked before it's run, so it's not there for the reaper to requeue.
+			inProgress = append(inProgress, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+			if job.ArgBool("fail") {
+				return fmt.Errorf("sorry kid")
+			}
+			return nil
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"fail": false})
+	assert.Nil(t, err)
+	_, err = enqueuer.Enqueue(job1, Q{"fail": true})
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.Equal(t, []int64{0, 0}, inProgress)
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+	assert.EqualValues(t, 0, hgetInt64(pool, redisKeyJobsLockInfo(ns, job1), "1"))
+
+	// The failed job isn't retried.
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+}
+
+func TestWorkerIdempotencyKey(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	var ran []string
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 1, IdempotencyTTL: time.Minute},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			ran = append(ran, job.IdempotencyKey)
+			if job.ArgBool("fail") {
+				return fmt.Errorf("sorry kid")
+			}
+			return nil
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, args := range []Q{{"fail": false}, {"fail": false}} {
+		_, err := enqueuer.EnqueueWithIdempotencyKey(job1, "a", args)
+		assert.NoError(t, err)
+	}
+	_, err := enqueuer.EnqueueWithIdempotencyKey(job1, "b", Q{"fail": true})
+	assert.NoError(t, err)
+
+	// A job that's requeued by the reaper still holds its key.
+	requeued, err := enqueuer.EnqueueWithIdempotencyKey(job1, "c", nil)
+	assert.NoError(t, err)
+	conn := pool.Get()
+	_, err = conn.Do("SET", redisKeyIdempotency(ns, job1, "c"), requeued.ID)
+	assert.NoError(t, err)
+	conn.Close()
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.Equal(t, []string{"a", "b", "c"}, ran)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+
+	conn = pool.Get()
+	defer conn.Close()
+	done, err := redis.String(conn.Do("GET", redisKeyIdempotency(ns, job1, "a")))
+	assert.NoError(t, err)
+	assert.Equal(t, "done", done)
+	ttl, err := redis.Int64(conn.Do("PTTL", redisKeyIdempotency(ns, job1, "a")))
+	assert.NoError(t, err)
+	assert.True(t, ttl > 0 && ttl <= time.Minute.Milliseconds())
+
+	// The failed job's key is released, so it can be enqueued again.
+	exists, err := redis.Bool(conn.Do("EXISTS", redisKeyIdempotency(ns, job1, "b")))
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = enqueuer.EnqueueWithIdempotencyKey(job1, "", nil)
+	assert.Error(t, err)
+}
+
+func TestWorkerIdempotencyKeyRequeuedOnError(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	var ran int
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 1, IdempotencyTTL: time.Minute},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			ran++
+			return nil
+		},
+	}
+
+	_, err := NewEnqueuer(ns, pool).EnqueueWithIdempotencyKey(job1, "a", nil)
+	assert.NoError(t, err)
+	// Claiming a key of the wrong type fails, as it would if Redis couldn't be reached.
+	conn := pool.Get()
+	_, err = conn.Do("HSET", redisKeyIdempotency(ns, job1, "a"), "x", "y")
+	assert.NoError(t, err)
+	conn.Close()
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+	w.logger = &testLogger{}
+	job, err := w.fetchNextJob()
+	assert.NoError(t, err)
+	assert.NoError(t, w.processJob(job))
+
+	assert.Equal(t, 0, ran)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
+}
+
+func TestWillRetryAtMostOnce(t *testing.T) {
+	jt := &jobType{JobOptions: JobOptions{MaxFails: 3}}
+	assert.True(t, willRetry(jt, &Job{Fails: 1}, fmt.Errorf("sorry kid")))
+	jt.AtMostOnce = true
+	assert.False(t, willRetry(jt, &Job{Fails: 1}, fmt.Errorf("sorry kid")))
+}
+
+type testMetricsSink struct {
+	mtx     sync.Mutex
+	counts  map[string]int64
+	timings []string
+}
+
+func (s *testMetricsSink) Count(name string, value int64, tags []string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.counts[name+" "+strings.Join(tags, ",")] += value
+}
+
+func (s *testMetricsSink) Timing(name string, d time.Duration, tags []string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.timings = append(s.timings, name+" "+strings.Join(tags, ","))
+}
+
+func TestWorkerMetricsSink(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	sink := &testMetricsSink{counts: make(map[string]int64)}
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{MetricsSink: sink})
+	wp.JobWithOptions("ok", JobOptions{MaxFails: 3}, func(job *Job) error {
+		return nil
+	})
+	wp.JobWithOptions("flaky", JobOptions{MaxFails: 3}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+	wp.JobWithOptions("broken", JobOptions{MaxFails: 1}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, name := range []string{"ok", "ok", "flaky", "broken"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, map[string]int64{
+		"jobs.processed job:ok":     2,
+		"jobs.processed job:flaky":  1,
+		"jobs.failed job:flaky":     1,
+		"jobs.retried job:flaky":    1,
+		"jobs.processed job:broken": 1,
+		"jobs.failed job:broken":    1,
+		"jobs.dead job:broken":      1,
+	}, sink.counts)
+	assert.Len(t, sink.timings, 4)
+	assert.Contains(t, sink.timings, "jobs.duration job:broken,status:failed")
+}
+
+func TestWorkerLogger(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	logger := &testLogger{}
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{Logger: logger})
+	wp.JobWithOptions("wat", JobOptions{MaxFails: 1}, func(job *Job) error {
+		panic("dayam")
+	})
+
+	_, err := NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, []string{"runJob.panic [error dayam]"}, logger.messages())
+}
+
+func TestWorkerLifecycleHooks(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var mu sync.Mutex
+	events := make(map[string][]string)
+	record := func(event string) JobEventHandler {
+		return func(job *Job, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			got := event
+			if err != nil {
+				got += " " + err.Error()
+			}
+			events[job.Name] = append(events[job.Name], got)
+		}
+	}
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("ok", JobOptions{MaxFails: 3}, func(job *Job) error {
+		return nil
+	})
+	wp.JobWithOptions("flaky", JobOptions{MaxFails: 3}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+	wp.JobWithOptions("broken", JobOptions{MaxFails: 1}, func(job *Job) error {
+		panic("dayam")
+	})
+	wp.OnJobStart(record("start")).
+		OnJobSuccess(record("success")).
+		OnJobFailure(record("failure")).
+		OnJobRetry(record("retry")).
+		OnJobDead(record("dead")).
+		OnPanic(record("panic"))
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, name := range []string{"ok", "flaky", "broken"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, map[string][]string{
+		"ok":     {"start", "success"},
+		"flaky":  {"start", "failure sorry kid", "retry sorry kid"},
+		"broken": {"start", "panic dayam", "failure dayam", "dead dayam"},
+	}, events)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+}
+
+func TestWorkerExpiredJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var ran, expired []string
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{ExpiresIn: time.Minute}, func(job *Job) error {
+		ran = append(ran, job.ArgString("a"))
+		return nil
+	})
+	wp.OnJobExpired(func(job *Job, err error) {
+		expired = append(expired, job.ArgString("a"))
+	})
+
+	now := nowEpochSeconds()
+	conn := pool.Get()
+	for _, j := range []*Job{
+		{Name: "wat", ID: "1", EnqueuedAt: now - 120, Args: Q{"a": "stale"}},
+		{Name: "wat", ID: "2", EnqueuedAt: now - 30, Args: Q{"a": "fresh"}},
+		{Name: "wat", ID: "3", EnqueuedAt: now - 30, ExpiresAt: now - 10, Args: Q{"a": "overridden"}},
+	} {
+		rawJSON, err := j.serialize()
+		assert.NoError(t, err)
+		_, err = conn.Do("LPUSH", redisKeyJobs(ns, "wat"), rawJSON)
+		assert.NoError(t, err)
+	}
+	conn.Close()
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, []string{"fresh"}, ran)
+	assert.Equal(t, []string{"stale", "overridden"}, expired)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, "wat")))
+}
+
 func TestWorkersPaused(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -324,7 +1060,7 @@ func TestWorkersPaused(t *testing.T) {
 	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
 	assert.Nil(t, err)
 
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
 	// pause the jobs prior to starting
 	err = pauseJobs(ns, job1, pool)
 	assert.Nil(t, err)
@@ -405,6 +1141,27 @@ func BenchmarkJobProcessing(b *testing.B) {
 	wp.Stop()
 }
 
+func BenchmarkFetchEmptyQueues(b *testing.B) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	jobTypes := make(map[string]*jobType)
+	for i := 0; i < 50; i++ {
+		name := fmt.Sprintf("job%d", i)
+		jobTypes[name] = &jobType{Name: name, JobOptions: JobOptions{Priority: 1, MaxConcurrency: 10, MaxPerSecond: 100}}
+	}
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil, nil)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := w.fetchJob(); err != nil {
+			panic(err)
+		}
+	}
+}
+
 func newTestPool(addr string) *redis.Pool {
 	return &redis.Pool{
 		MaxActive:   10,
@@ -631,3 +1388,54 @@ func TestWorkerPoolStop(t *testing.T) {
 		t.Errorf("Expected that jobs queue was not completely emptied.")
 	}
 }
+
+func TestWorkerDeadLetterQueue(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	cleanKeyspace("repairs", pool)
+
+	sink := &testMetricsSink{counts: make(map[string]int64)}
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{MetricsSink: sink})
+	var deadLettered, dead []string
+	wp.OnJobDeadLettered(func(job *Job, err error) { deadLettered = append(deadLettered, job.Name) }).
+		OnJobDead(func(job *Job, err error) { dead = append(dead, job.Name) })
+	wp.JobWithOptions("charge", JobOptions{MaxFails: 1, DeadLetterQueue: "repair_charge"}, func(job *Job) error {
+		return fmt.Errorf("card declined")
+	})
+	var repaired *Job
+	wp.Job("repair_charge", func(job *Job) error {
+		repaired = job
+		return nil
+	})
+	wp.JobWithOptions("refund", JobOptions{MaxFails: 1, DeadLetterQueue: "repair_refund", DeadLetterNamespace: "repairs"}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	charge, err := enqueuer.Enqueue("charge", Q{"amount": 10})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("refund", nil)
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	if assert.NotNil(t, repaired) && assert.NotNil(t, repaired.DeadLetter) {
+		assert.EqualValues(t, 10, repaired.ArgInt64("amount"))
+		assert.Equal(t, "charge", repaired.DeadLetter.Name)
+		assert.Equal(t, charge.ID, repaired.DeadLetter.ID)
+		assert.Equal(t, ns, repaired.DeadLetter.Namespace)
+		assert.Equal(t, "card declined", repaired.DeadLetter.LastErr)
+		assert.EqualValues(t, 1, repaired.DeadLetter.Fails)
+	}
+
+	// Dead letters for another namespace wait for its pools.
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs("repairs", "repair_refund")))
+
+	assert.ElementsMatch(t, []string{"charge", "refund"}, deadLettered)
+	assert.Empty(t, dead)
+	assert.EqualValues(t, 1, sink.counts["jobs.dead_lettered job:charge"])
+	assert.EqualValues(t, 0, sink.counts["jobs.dead job:charge"])
+}
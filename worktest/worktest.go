@@ -0,0 +1,128 @@
+// Package worktest has helpers for application tests that enqueue and run jobs against a test Redis:
+//
+//	func TestSignUp(t *testing.T) {
+//		signUp(enqueuer, "a@example.com")
+//		worktest.Enqueued(t, client, "send_email", work.Q{"addr": "a@example.com"})
+//
+//		_, err := worktest.DrainQueue(pool, "send_email")
+//		assert.NoError(t, err)
+//	}
+//
+// The pool shouldn't be started, so that jobs are only run by the test. For unit tests that don't need Redis, see the
+// memstore package.
+package worktest
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	work "github.com/teamwork/work/v2"
+)
+
+// errFound stops Client.EachQueuedJob once a job's been found.
+var errFound = errors.New("found")
+
+// Enqueued asserts that a job named jobName is waiting on its queue with args, failing t if not. It returns whether the
+// assertion passed.
+func Enqueued(t testing.TB, client *work.Client, jobName string, args work.Q) bool {
+	t.Helper()
+	job, err := FindEnqueued(client, jobName, args)
+	if err != nil {
+		t.Errorf("worktest: looking for a queued %q job: %v", jobName, err)
+		return false
+	}
+	if job == nil {
+		t.Errorf("worktest: no %q job is queued with args %v", jobName, args)
+		return false
+	}
+	return true
+}
+
+// NotEnqueued asserts that no job named jobName is waiting on its queue with args, failing t if there is. It returns
+// whether the assertion passed.
+func NotEnqueued(t testing.TB, client *work.Client, jobName string, args work.Q) bool {
+	t.Helper()
+	job, err := FindEnqueued(client, jobName, args)
+	if err != nil {
+		t.Errorf("worktest: looking for a queued %q job: %v", jobName, err)
+		return false
+	}
+	if job != nil {
+		t.Errorf("worktest: a %q job is queued with args %v", jobName, args)
+		return false
+	}
+	return true
+}
+
+// FindEnqueued returns the first job named jobName that's waiting on its queue with args, or nil if there isn't one.
+// args are compared as they'd be decoded from JSON, so eg, the ints in args match the float64s in the job.
+func FindEnqueued(client *work.Client, jobName string, args work.Q) (*work.Job, error) {
+	want, err := normalize(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *work.Job
+	err = client.Unredacted().EachQueuedJob(jobName, func(job *work.Job) error {
+		got, err := normalize(job.Args)
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(got, want) {
+			found = job
+			return errFound
+		}
+		return nil
+	})
+	if err != nil && err != errFound {
+		return nil, err
+	}
+	return found, nil
+}
+
+// PerformNext runs the next job the pool's workers would run, returning it, or nil if there wasn't one, and the error it
+// failed with. It's WorkerPool.RunNext.
+func PerformNext(pool *work.WorkerPool) (*work.Job, error) {
+	return pool.RunNext()
+}
+
+// DrainQueue runs the jobs named jobName until there are none left to run, including any they enqueue. It returns how
+// many it ran, and the first error one of them failed with.
+//
+// Jobs that fail are retried or moved to the dead queue as usual, rather than run again. Jobs that can't be run yet,
+// eg, because their queue is paused, are left on it.
+func DrainQueue(pool *work.WorkerPool, jobName string) (int, error) {
+	var n int
+	var firstErr error
+	for {
+		job, err := pool.RunNext(jobName)
+		if job == nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return n, firstErr
+		}
+		n++
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+}
+
+// normalize returns args as they'd be decoded from JSON, with no args being an empty map.
+func normalize(args map[string]interface{}) (map[string]interface{}, error) {
+	rawJSON, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(rawJSON, &normalized); err != nil {
+		return nil, err
+	}
+	if normalized == nil {
+		normalized = map[string]interface{}{}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,123 @@
+package worktest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	work "github.com/teamwork/work/v2"
+)
+
+type testContext struct{}
+
+func TestEnqueuedAndDrainQueue(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "worktest"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	client := work.NewClient(ns, pool)
+	wp := work.NewWorkerPool(testContext{}, 1, ns, pool)
+	var sent []string
+	wp.Job("send_email", func(job *work.Job) error {
+		sent = append(sent, job.ArgString("addr"))
+		if job.ArgInt64("attempt") > 0 {
+			return fmt.Errorf("bounced")
+		}
+		return nil
+	})
+	wp.Job("sign_up", func(job *work.Job) error {
+		_, err := enqueuer.Enqueue("send_email", work.Q{"addr": job.ArgString("addr")})
+		return err
+	})
+
+	_, err := enqueuer.Enqueue("send_email", work.Q{"addr": "a@example.com", "attempt": 0})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("send_email", work.Q{"addr": "b@example.com", "attempt": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("sign_up", work.Q{"addr": "c@example.com"})
+	assert.NoError(t, err)
+
+	assert.True(t, Enqueued(t, client, "send_email", work.Q{"addr": "a@example.com", "attempt": 0}))
+	assert.True(t, NotEnqueued(t, client, "send_email", work.Q{"addr": "a@example.com"}))
+	assert.True(t, NotEnqueued(t, client, "send_email", work.Q{"addr": "c@example.com"}))
+
+	n, err := DrainQueue(wp, "send_email")
+	assert.EqualError(t, err, "bounced")
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, sent)
+
+	job, err := PerformNext(wp)
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.Equal(t, "sign_up", job.Name)
+	}
+	assert.True(t, Enqueued(t, client, "send_email", work.Q{"addr": "c@example.com"}))
+
+	job, err = PerformNext(wp)
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	job, err = PerformNext(wp)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+}
+
+func TestEnqueuedRedactedArgs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "worktest"
+	cleanKeyspace(ns, pool)
+	work.RedactArgs("worktest_login", "password")
+
+	_, err := work.NewEnqueuer(ns, pool).Enqueue("worktest_login", work.Q{"user": "bob", "password": "hunter2"})
+	assert.NoError(t, err)
+	assert.True(t, Enqueued(t, work.NewClient(ns, pool), "worktest_login", work.Q{"user": "bob", "password": "hunter2"}))
+}
+
+func TestEnqueuedFails(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "worktest"
+	cleanKeyspace(ns, pool)
+
+	mock := &mockT{TB: t}
+	assert.False(t, Enqueued(mock, work.NewClient(ns, pool), "send_email", nil))
+	assert.Equal(t, []string{`worktest: no "send_email" job is queued with args map[]`}, mock.errors)
+}
+
+// mockT records the errors reported to it instead of failing the test.
+type mockT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *mockT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func newTestPool(addr string) *redis.Pool {
+	return &redis.Pool{
+		MaxActive:   3,
+		MaxIdle:     3,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+		Wait: true,
+	}
+}
+
+func cleanKeyspace(namespace string, pool *redis.Pool) {
+	conn := pool.Get()
+	defer conn.Close()
+
+	keys, err := redis.Strings(conn.Do("KEYS", namespace+"*"))
+	if err != nil {
+		panic("could not get keys: " + err.Error())
+	}
+	for _, k := range keys {
+		if _, err := conn.Do("DEL", k); err != nil {
+			panic("could not del: " + err.Error())
+		}
+	}
+}